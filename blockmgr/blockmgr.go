@@ -99,6 +99,9 @@ type BlockMgr struct {
 	//从远端接收到块
 	blocksCh chan []*types.Block
 
+	//从远端接收到状态树节点
+	stateRspCh chan *types.StateRsp
+
 	//所有需要同步的任务列表
 	allTasks *heightSortedMap
 
@@ -141,7 +144,7 @@ func (blockMgr *BlockMgr) Api() []app.API {
 }
 
 func (blockMgr *BlockMgr) CommandFlags() ([]cli.Command, []cli.Flag) {
-	return nil, []cli.Flag{}
+	return nil, []cli.Flag{SyncModeFlag}
 }
 
 func NewBlockMgr(config *BlockMgrConfig, homeDir string, cs chain.ChainServiceInterface, p2pservice p2pService.P2P) *BlockMgr {
@@ -152,6 +155,7 @@ func NewBlockMgr(config *BlockMgrConfig, homeDir string, cs chain.ChainServiceIn
 
 	blockMgr.headerHashCh = make(chan []*syncHeaderHash)
 	blockMgr.blocksCh = make(chan []*types.Block)
+	blockMgr.stateRspCh = make(chan *types.StateRsp, 1)
 	blockMgr.allTasks = newHeightSortedMap()
 	//blockMgr.pendingSyncTasks = make(map[*time.Timer]map[crypto.Hash]uint64)
 	blockMgr.state = event.StopSyncBlock
@@ -200,8 +204,22 @@ func NewBlockMgr(config *BlockMgrConfig, homeDir string, cs chain.ChainServiceIn
 }
 
 func (blockMgr *BlockMgr) Init(executeContext *app.ExecuteContext) error {
+	ctx := executeContext.Cli
+	if ctx.GlobalIsSet(SyncModeFlag.Name) {
+		blockMgr.Config.SyncMode = ctx.GlobalString(SyncModeFlag.Name)
+	}
+	switch blockMgr.Config.SyncMode {
+	case "", SyncModeFull:
+	case SyncModeFast:
+		if blockMgr.Config.Checkpoint == nil {
+			return ErrNoCheckpoint
+		}
+	default:
+		return ErrSyncMode
+	}
 	blockMgr.headerHashCh = make(chan []*syncHeaderHash)
 	blockMgr.blocksCh = make(chan []*types.Block)
+	blockMgr.stateRspCh = make(chan *types.StateRsp, 1)
 	blockMgr.allTasks = newHeightSortedMap()
 	blockMgr.syncTimerCh = make(chan *time.Timer, 1)
 	blockMgr.state = event.StopSyncBlock
@@ -249,7 +267,7 @@ func (blockMgr *BlockMgr) Init(executeContext *app.ExecuteContext) error {
 }
 
 func (blockMgr *BlockMgr) Start(executeContext *app.ExecuteContext) error {
-	blockMgr.transactionPool.Start(blockMgr.ChainService.NewBlockFeed(), blockMgr.ChainService.BestChain().Tip().StateRoot)
+	blockMgr.transactionPool.Start(blockMgr.ChainService.NewBlockFeed(), blockMgr.ChainService.DetachBlockFeed(), blockMgr.ChainService.BestChain().Tip().StateRoot)
 	go blockMgr.synchronise()
 	go blockMgr.syncTxs()
 	return nil
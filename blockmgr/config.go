@@ -1,8 +1,24 @@
 package blockmgr
 
+import "github.com/drep-project/DREP-Chain/crypto"
+
+const (
+	SyncModeFull = "full" //从创世块开始执行所有区块
+	SyncModeFast = "fast" //下载检查点区块的状态，之前的区块校验后只保存不执行
+)
+
 type BlockMgrConfig struct {
 	GasPrice    OracleConfig `json:"gasprice"`
 	JournalFile string       `json:"journalFile"`
+	SyncMode    string       `json:"syncMode,omitempty"`
+	//快速同步必须配置的可信检查点，同步的块链必须经过该块
+	Checkpoint *SyncCheckpoint `json:"checkpoint,omitempty"`
+}
+
+// SyncCheckpoint is a block trusted by the node operator, fast sync download the state of it
+type SyncCheckpoint struct {
+	Height uint64      `json:"height"`
+	Hash   crypto.Hash `json:"hash"`
 }
 
 type OracleConfig struct {
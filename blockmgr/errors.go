@@ -22,4 +22,10 @@ var (
 	ErrBalance               = errors.New("not enough balance")
 	ErrNotSupportRenameAlias = errors.New("not suppport rename alias")
 	ErrNoCommonAncesstor     = errors.New("no common ancesstor")
+	ErrSyncMode              = errors.New("unknown sync mode, use full or fast")
+	ErrGetStateTimeout       = errors.New("fetch state nodes timeout")
+	ErrPivotBlock            = errors.New("pivot block not matched header")
+	ErrNoCheckpoint          = errors.New("fast sync need a trusted checkpoint in config")
+	ErrCheckpoint            = errors.New("block of peer at checkpoint height not matched the checkpoint")
+	ErrNoStateNodes          = errors.New("peer has none of the state nodes requested")
 )
@@ -0,0 +1,273 @@
+package blockmgr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/drep-project/DREP-Chain/chain"
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/common/event"
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+//本地低于可信检查点且对端已经到达检查点时才做快速同步，中断后从本地的tip继续，快速同步完成后由fetchBlocks继续同步检查点之后的块
+func (blockMgr *BlockMgr) needFastSync(peer types.PeerInfoInterface) bool {
+	checkpoint := blockMgr.Config.Checkpoint
+	return blockMgr.Config.SyncMode == SyncModeFast &&
+		checkpoint != nil &&
+		blockMgr.ChainService.BestChain().Height() < checkpoint.Height &&
+		peer.GetHeight() >= checkpoint.Height
+}
+
+//快速同步：
+//1 确认对端在检查点高度的块就是配置的可信检查点
+//2 按高度下载块，块头链、交易根和共识签名都校验后只保存不执行
+//3 出块节点从换届高度的状态中读取，每到换届高度下载该块的状态并把tip设置为该块，用于校验下一届的块签名
+//4 最后下载检查点块的状态并把tip设置为检查点块
+func (blockMgr *BlockMgr) fastSync(peer types.PeerInfoInterface) error {
+	blockMgr.syncBlockEvent.Send(event.SyncBlockEvent{EventType: event.StartSyncBlock})
+	defer blockMgr.syncBlockEvent.Send(event.SyncBlockEvent{EventType: event.StopSyncBlock})
+
+	checkpoint := blockMgr.Config.Checkpoint
+	log.WithField("checkpoint", checkpoint.Height).WithField("ip", peer.GetAddr()).Info("start fast sync")
+	blockMgr.clearSyncCh()
+
+	headers, err := blockMgr.fetchHeaderHashs(peer, checkpoint.Height, 1)
+	if err != nil {
+		return err
+	}
+	if *headers[0].headerHash != checkpoint.Hash {
+		return ErrCheckpoint
+	}
+	changeInterval, err := blockMgr.changeInterval()
+	if err != nil {
+		return err
+	}
+
+	for from := blockMgr.ChainService.BestChain().Height() + 1; from <= checkpoint.Height; {
+		to := checkpoint.Height
+		if changeInterval > 0 {
+			epoch := (from + changeInterval - 1) / changeInterval * changeInterval
+			if epoch < to {
+				to = epoch
+			}
+		}
+		block, err := blockMgr.fetchBlocksWithoutState(peer, from, to)
+		if err != nil {
+			return err
+		}
+		if to == checkpoint.Height && *block.Header.Hash() != checkpoint.Hash {
+			return ErrCheckpoint
+		}
+		err = blockMgr.fetchState(peer, crypto.Bytes2Hash(block.Header.StateRoot))
+		if err != nil {
+			return err
+		}
+		err = blockMgr.ChainService.CommitSyncedState(block.Header.Hash())
+		if err != nil {
+			return err
+		}
+		from = to + 1
+	}
+	return nil
+}
+
+//出块节点换届的块数，由共识服务写入数据库
+func (blockMgr *BlockMgr) changeInterval() (uint64, error) {
+	trieStore, err := store.TrieStoreFromStore(blockMgr.DatabaseService.LevelDb(), blockMgr.ChainService.BestChain().Tip().StateRoot)
+	if err != nil {
+		return 0, err
+	}
+	return trieStore.GetChangeInterval()
+}
+
+//按批次获取from到to的块头和块，返回高度为to的块
+func (blockMgr *BlockMgr) fetchBlocksWithoutState(peer types.PeerInfoInterface, from, to uint64) (*types.Block, error) {
+	var lastBlock *types.Block
+	for from <= to {
+		count := uint64(maxHeaderHashCountReq)
+		if to-from+1 < count {
+			count = to - from + 1
+		}
+		headers, err := blockMgr.fetchHeaderHashs(peer, from, count)
+		if err != nil {
+			return nil, err
+		}
+		blocks, err := blockMgr.fetchBlockBodies(peer, headers)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blocks {
+			err = blockMgr.ChainService.InsertBlockWithoutState(block)
+			if err != nil && err != chain.ErrBlockExsist {
+				return nil, fmt.Errorf("insert block %d: %v", block.Header.Height, err)
+			}
+			lastBlock = block
+		}
+		from += uint64(len(headers))
+		log.WithField("Height", from-1).WithField("to", to).Info("fast sync blocks")
+	}
+	if lastBlock == nil || lastBlock.Header.Height != to {
+		return nil, ErrPivotBlock
+	}
+	return lastBlock, nil
+}
+
+func (blockMgr *BlockMgr) fetchHeaderHashs(peer types.PeerInfoInterface, from, count uint64) ([]*syncHeaderHash, error) {
+	err := blockMgr.requestHeaders(peer, from, count)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.After(time.Second * maxNetworkTimeout)
+	for {
+		select {
+		case headers := <-blockMgr.headerHashCh:
+			//忽略之前请求的迟到回复
+			if len(headers) == 0 || headers[0].height != from || uint64(len(headers)) > count {
+				continue
+			}
+			return headers, nil
+		case <-timeout:
+			return nil, ErrGetHeaderHashTimeout
+		}
+	}
+}
+
+//获取一批块头对应的块，按高度顺序返回
+func (blockMgr *BlockMgr) fetchBlockBodies(peer types.PeerInfoInterface, headers []*syncHeaderHash) ([]*types.Block, error) {
+	blocks := make([]*types.Block, len(headers))
+	index := make(map[crypto.Hash]int, len(headers))
+	hashs := make([]crypto.Hash, 0, len(headers))
+	for i, header := range headers {
+		if block, err := blockMgr.ChainService.GetBlockByHash(header.headerHash); err == nil {
+			blocks[i] = block
+			continue
+		}
+		index[*header.headerHash] = i
+		hashs = append(hashs, *header.headerHash)
+	}
+	if len(hashs) == 0 {
+		return blocks, nil
+	}
+
+	peer.SetReqTime(time.Now())
+	err := blockMgr.P2pServer.Send(peer.GetMsgRW(), types.MsgTypeBlockReq, &types.BlockReq{BlockHashs: hashs})
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.After(time.Second * maxNetworkTimeout)
+	for len(index) > 0 {
+		select {
+		case resp := <-blockMgr.blocksCh:
+			//回复的多个消息可能乱序到达，按hash放回对应的位置
+			for _, block := range resp {
+				i, ok := index[*block.Header.Hash()]
+				if !ok {
+					continue
+				}
+				blocks[i] = block
+				delete(index, *block.Header.Hash())
+			}
+		case <-timeout:
+			return nil, ErrGetBlockTimeout
+		}
+	}
+	return blocks, nil
+}
+
+//下载root下本地缺少的状态树节点，节点写入磁盘前校验hash，只有子树完整的节点才会写入，中断后可以继续
+func (blockMgr *BlockMgr) fetchState(peer types.PeerInfoInterface, root crypto.Hash) error {
+	db := blockMgr.DatabaseService.LevelDb()
+	sched := trie.NewSync(root, db)
+	total := 0
+	for sched.Pending() > 0 {
+		hashes := sched.Missing(maxStateNodeCountReq)
+		if len(hashes) == 0 {
+			return fmt.Errorf("state sync stalled with %d pending nodes", sched.Pending())
+		}
+		select {
+		case <-blockMgr.stateRspCh:
+		default:
+		}
+		peer.SetReqTime(time.Now())
+		err := blockMgr.P2pServer.Send(peer.GetMsgRW(), types.MsgTypeStateReq, &types.StateReq{Root: root, Hashes: hashes})
+		if err != nil {
+			return err
+		}
+
+		var rsp *types.StateRsp
+		select {
+		case rsp = <-blockMgr.stateRspCh:
+		case <-time.After(time.Second * maxNetworkTimeout):
+			return ErrGetStateTimeout
+		}
+
+		requested := make(map[crypto.Hash]struct{}, len(hashes))
+		for _, hash := range hashes {
+			requested[hash] = struct{}{}
+		}
+		results := make([]trie.SyncResult, 0, len(rsp.Nodes))
+		if rsp.Root == root {
+			for _, data := range rsp.Nodes {
+				hash := crypto.Keccak256Hash(data)
+				if _, ok := requested[hash]; !ok {
+					continue
+				}
+				delete(requested, hash)
+				results = append(results, trie.SyncResult{Hash: hash, Data: data})
+			}
+		}
+		//对端没有这个状态，可能已经被裁剪
+		if len(results) == 0 {
+			return ErrNoStateNodes
+		}
+		if _, index, err := sched.Process(results); err != nil {
+			return fmt.Errorf("process state node %x: %v", results[index].Hash, err)
+		}
+		sched.Retry(hashes)
+
+		batch := db.NewBatch()
+		err = sched.Commit(batch)
+		if err != nil {
+			return err
+		}
+		err = batch.Write()
+		if err != nil {
+			return err
+		}
+		total += len(results)
+		log.WithField("nodes", total).WithField("pending", sched.Pending()).Debug("fast sync state")
+	}
+	log.WithField("root", root.String()).WithField("nodes", total).Info("fast sync state finished")
+	return nil
+}
+
+//返回本地磁盘上有的状态树节点
+func (blockMgr *BlockMgr) handleStateReq(peer types.PeerInfoInterface, req *types.StateReq) {
+	db := blockMgr.DatabaseService.LevelDb()
+	nodes := make([][]byte, 0, len(req.Hashes))
+	size := 0
+	for i, hash := range req.Hashes {
+		if i >= maxStateNodeCountReq || size >= maxStateRspSize {
+			break
+		}
+		data, err := db.Get(hash[:])
+		if err != nil || len(data) == 0 {
+			continue
+		}
+		nodes = append(nodes, data)
+		size += len(data)
+	}
+	blockMgr.P2pServer.Send(peer.GetMsgRW(), types.MsgTypeStateRsp, &types.StateRsp{Root: req.Root, Nodes: nodes})
+}
+
+func (blockMgr *BlockMgr) handleStateRsp(peer types.PeerInfoInterface, rsp *types.StateRsp) {
+	peer.CalcAverageRtt()
+	//没有在等待的同步时丢弃
+	select {
+	case blockMgr.stateRspCh <- rsp:
+	default:
+	}
+}
@@ -0,0 +1,13 @@
+package blockmgr
+
+import (
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	SyncModeFlag = cli.StringFlag{
+		Name:  "syncmode",
+		Usage: `Blockchain sync mode ("full" or "fast", fast sync need a trusted checkpoint in config)`,
+		Value: SyncModeFull,
+	}
+)
@@ -134,6 +134,18 @@ func (blockMgr *BlockMgr) dealMsg(peer *types.PeerInfo, rw p2p.MsgReadWriter) er
 				return errors.Wrapf(ErrDecodeMsg, "HeaderRsp msg:%v err:%v", msg, err)
 			}
 			go blockMgr.handleHeaderRsp(peer, &resp)
+		case types.MsgTypeStateReq:
+			var req types.StateReq
+			if err := msg.Decode(&req); err != nil {
+				return errors.Wrapf(ErrDecodeMsg, "StateReq msg:%v err:%v", msg, err)
+			}
+			go blockMgr.handleStateReq(peer, &req)
+		case types.MsgTypeStateRsp:
+			var resp types.StateRsp
+			if err := msg.Decode(&resp); err != nil {
+				return errors.Wrapf(ErrDecodeMsg, "StateRsp msg:%v err:%v", msg, err)
+			}
+			go blockMgr.handleStateRsp(peer, &resp)
 		}
 	}
 
@@ -50,6 +50,13 @@ func (blockMgr *BlockMgr) synchronise() {
 		currentHeight := blockMgr.ChainService.BestChain().Height()
 		if pi.GetHeight() > currentHeight {
 			log.Info("need sync  ", pi.GetHeight(), ">", currentHeight)
+			if blockMgr.needFastSync(pi) {
+				err := blockMgr.fastSync(pi)
+				if err != nil {
+					log.WithField("Reason", err).Warn("fast sync from peer")
+					return
+				}
+			}
 			err := blockMgr.fetchBlocks(pi)
 			if err != nil {
 				log.WithField("Reason", err).Warn("sync block from peer")
@@ -31,6 +31,12 @@ func (chainBlockValidator *TemplateBlockValidator) ExecuteBlock(context *chain.B
 	if len(context.Block.Data.TxList) < 0 {
 		return nil
 	}
+	if err := chain.ReleaseTimeLocks(context.TrieStore, context.Block.Header); err != nil {
+		return err
+	}
+	if err := chain.ReleaseExpiredAliases(context.TrieStore, context.Block.Header); err != nil {
+		return err
+	}
 
 	finalTxs := make([]*types.Transaction, 0, len(context.Block.Data.TxList))
 	finalReceipts := make([]*types.Receipt, 0, len(context.Block.Data.TxList))
@@ -123,9 +129,10 @@ func (chainBlockValidator *TemplateBlockValidator) RouteTransaction(context *cha
 			}
 			// Create a new receipt for the transaction, storing the intermediate root and gasRemained used by the tx
 			// based on the eip phase, we're passing whether the root touch-delete accounts.
-			receipt := types.NewReceipt(crypto.ZeroHash[:], ret.ContractTxExecuteFail, txContext.GasUsed())
+			receipt := types.NewExecuteReceipt(crypto.ZeroHash[:], ret, txContext.GasUsed())
 			receipt.TxHash = *tx.TxHash()
 			receipt.GasUsed = txContext.GasUsed()
+			receipt.ContractAddress = ret.ContractAddr
 			// if the transaction created a contract, store the creation address in the receipt.
 			if tx.To() == nil || tx.To().IsEmpty() {
 				receipt.ContractAddress = crypto.CreateAddress(*from, tx.Nonce())
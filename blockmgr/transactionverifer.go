@@ -2,6 +2,7 @@ package blockmgr
 
 import (
 	"github.com/drep-project/DREP-Chain/chain"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/types"
 )
 
@@ -11,13 +12,25 @@ func (blockMgr *BlockMgr) verifyTransaction(tx *types.Transaction) error {
 	//db := blockMgr.ChainService.GetCurrentState()
 	//from, err := tx.From()
 
+	tip := blockMgr.ChainService.BestChain().Tip()
+	// Transactions signed for another chain must not be replayed on this chain
+	if params.IsChainIdFork(tip.Height+1) && tx.ChainId() != blockMgr.ChainService.ChainID() {
+		return chain.ErrTxChainId
+	}
+
 	// Transactions can't be negative. This may never happen using RLP decoded
 	// transactions but may occur if you create a transaction using the RPC.
 	if tx.Amount().Sign() < 0 {
 		return ErrNegativeAmount
 	}
 
-	tip := blockMgr.ChainService.BestChain().Tip()
+	// The tx can be packaged in next block at the earliest, its version must be active there
+	if err := tx.CheckVersion(tip.Height + 1); err != nil {
+		return err
+	}
+	if tx.Expired(tip.Height + 1) {
+		return chain.ErrTxExpired
+	}
 	// Check the transaction doesn't exceed the current
 	// block limit gas.
 	if tip.GasLimit.Uint64() < tx.Gas() {
@@ -40,15 +53,34 @@ func (blockMgr *BlockMgr) verifyTransaction(tx *types.Transaction) error {
 		log.WithField("gas", gas).WithField("tx.gas", tx.Gas()).Error("gas exceed tx gaslimit ")
 		return ErrReachGasLimit
 	}
-	if tx.Type() == types.SetAliasType {
+	// Reject the payload which can not be decoded by the codec registered for tx type
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		return err
+	}
+	// Sponsor pay for the gas, the signature of it must be recoverable
+	if _, err := tx.Sponsor(); err != nil {
+		return err
+	}
+	if tx.IsMultiSig() {
 		from, err := tx.From()
 		if err != nil {
 			return err
 		}
-		newAlias := tx.GetData()
-		if newAlias == nil {
-			return chain.ErrUnsupportAliasChar
+		trieQuery, err := chain.NewTrieQuery(blockMgr.DatabaseService.LevelDb(), tip.StateRoot)
+		if err != nil {
+			return err
+		}
+		if err := chain.VerifyMultiSig(trieQuery.GetMultiSigAccount(from), tx); err != nil {
+			return err
+		}
+	}
+	if tx.Type() == types.SetAliasType {
+		from, err := tx.From()
+		if err != nil {
+			return err
 		}
+		newAlias := []byte(*payload.(*types.AliasData))
 		if err := chain.CheckAlias(newAlias); err != nil {
 			return err
 		}
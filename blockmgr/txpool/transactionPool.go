@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/drep-project/DREP-Chain/chain/store"
 	"math/big"
+	"sort"
 	"sync"
 	"time"
 
@@ -40,8 +41,13 @@ type TransactionPool struct {
 	pendingNonce     map[crypto.CommonAddress]uint64
 	eventNewBlockSub event.Subscription
 	newBlockChan     chan *types.ChainEvent
+	detachBlockSub   event.Subscription
+	detachBlockChan  chan *types.Block
 	quit             chan struct{}
 
+	//主链重组时被回滚的块中的交易，新主链的块到来后重新加入池子
+	detachedTxs []*types.Transaction
+
 	// 提供pending交易订阅
 	txFeed event.Feed
 
@@ -81,6 +87,7 @@ func NewTransactionPool(chainStore store.StoreInterface, journalPath string) *Tr
 	pool.queue = make(map[crypto.CommonAddress]*txList)
 	pool.pending = make(map[crypto.CommonAddress]*txList)
 	pool.newBlockChan = make(chan *types.ChainEvent)
+	pool.detachBlockChan = make(chan *types.Block)
 	pool.pendingNonce = make(map[crypto.CommonAddress]uint64)
 
 	pool.allTxs = make(map[string]*types.Transaction)
@@ -416,7 +423,7 @@ END:
 }
 
 //Start 开启交易池
-func (pool *TransactionPool) Start(feed *event.Feed, tipRoot []byte) {
+func (pool *TransactionPool) Start(feed *event.Feed, detachFeed *event.Feed, tipRoot []byte) {
 	b := pool.chainStore.RecoverTrie(tipRoot)
 	if !b {
 		log.WithField("recoverRet", b).Error("tx pool")
@@ -427,12 +434,14 @@ func (pool *TransactionPool) Start(feed *event.Feed, tipRoot []byte) {
 
 	go pool.checkUpdate()
 	pool.eventNewBlockSub = feed.Subscribe(pool.newBlockChan)
+	pool.detachBlockSub = detachFeed.Subscribe(pool.detachBlockChan)
 }
 
 //Stop 停止交易池
 func (pool *TransactionPool) Stop() {
 	close(pool.quit)
 	pool.eventNewBlockSub.Unsubscribe()
+	pool.detachBlockSub.Unsubscribe()
 	pool.journal.close()
 }
 
@@ -483,6 +492,11 @@ func (pool *TransactionPool) checkUpdate() {
 			pool.mu.Unlock()
 		case block := <-pool.newBlockChan:
 			pool.adjust(block.Block)
+		case block := <-pool.detachBlockChan:
+			//链先发送被回滚的块，再发送新主链的块，回滚的交易等到新主链的状态可用时再处理
+			pool.mu.Lock()
+			pool.detachedTxs = append(pool.detachedTxs, block.Data.TxList...)
+			pool.mu.Unlock()
 		case <-pool.quit:
 			return
 		}
@@ -496,6 +510,14 @@ func (pool *TransactionPool) adjust(block *types.Block) {
 		log.WithField("recoverRet", b).WithField("h:", block.Header.Height).Error("RecoverTrie")
 	}
 
+	pool.mu.Lock()
+	if len(pool.detachedTxs) > 0 {
+		pool.reinjectTxs(pool.detachedTxs)
+		pool.detachedTxs = nil
+	}
+	pool.eliminateExpiredHeightTxs(block.Header.Height)
+	pool.mu.Unlock()
+
 	addrMap := make(map[crypto.CommonAddress]struct{})
 	var addrs []*crypto.CommonAddress
 	for _, tx := range block.Data.TxList {
@@ -504,6 +526,13 @@ func (pool *TransactionPool) adjust(block *types.Block) {
 			addrMap[*addr] = struct{}{}
 			addrs = append(addrs, addr)
 		}
+		// the balance of sponsor changed too, its own transactions need to be filtered
+		if sponsor, _ := tx.Sponsor(); sponsor != nil {
+			if _, ok := addrMap[*sponsor]; !ok {
+				addrMap[*sponsor] = struct{}{}
+				addrs = append(addrs, sponsor)
+			}
+		}
 	}
 
 	if len(addrs) > 0 {
@@ -528,12 +557,101 @@ func (pool *TransactionPool) adjust(block *types.Block) {
 				}
 			}
 
+			pool.filterUnpayable(&addr, block.Header.Height, block.Header.GasLimit.Uint64())
 			pool.syncToPending(&addr)
 			log.WithField("addr", addr.Hex()).WithField("max tx.nonce", nonce).WithField("txpool tx count", len(pool.allTxs)).Trace("clear txpool")
 		}
 	}
 }
 
+//重新加入被回滚的交易，该地址池中原有的交易一起按照当前状态的nonce重新排队
+//nonce已经被新主链使用的交易不再加入，之后的块包含的交易由adjust清理
+func (pool *TransactionPool) reinjectTxs(detached []*types.Transaction) {
+	txsByAddr := make(map[crypto.CommonAddress][]*types.Transaction)
+	for _, tx := range detached {
+		if _, ok := pool.allTxs[tx.TxHash().String()]; ok {
+			continue
+		}
+		from, err := tx.From()
+		if err != nil {
+			continue
+		}
+		txsByAddr[*from] = append(txsByAddr[*from], tx)
+	}
+
+	for addr, txs := range txsByAddr {
+		addr := addr
+		for _, maplist := range []map[crypto.CommonAddress]*txList{pool.pending, pool.queue} {
+			if list, ok := maplist[addr]; ok {
+				for _, tx := range list.Flatten() {
+					delete(pool.allTxs, tx.TxHash().String())
+					pool.allPricedTxs.Remove(tx)
+					txs = append(txs, tx)
+				}
+				delete(maplist, addr)
+			}
+		}
+		delete(pool.pendingNonce, addr)
+		nonce := pool.getTransactionCount(&addr)
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].Nonce() < txs[j].Nonce()
+		})
+
+		_, isLocal := pool.locals[addr]
+		reinjected := 0
+		for _, tx := range txs {
+			if tx.Nonce() < nonce {
+				continue
+			}
+			err := pool.addTx(tx, isLocal)
+			if err != nil {
+				log.WithField("tx nonce", tx.Nonce()).WithField("from", addr.String()).WithField("Reason", err).Debug("reinject tx")
+				continue
+			}
+			reinjected++
+		}
+		log.WithField("addr", addr.Hex()).WithField("nonce", nonce).WithField("count", reinjected).Info("reinject detached txs")
+	}
+}
+
+//删除余额已经不足以支付的交易，代付交易的gas按照sponsor的余额检查
+func (pool *TransactionPool) filterUnpayable(addr *crypto.CommonAddress, height uint64, gasLimit uint64) {
+	balance := pool.chainStore.GetBalance(addr, height)
+	sponsorFunds := func(sponsor *crypto.CommonAddress) *big.Int {
+		return pool.chainStore.GetBalance(sponsor, height)
+	}
+
+	for i, maplist := range []map[crypto.CommonAddress]*txList{pool.pending, pool.queue} {
+		if list, ok := maplist[*addr]; ok {
+			removed, invalids := list.Filter(balance, gasLimit, sponsorFunds)
+			pool.dropTxs(addr, append(removed, invalids...), i == 0, "tx unpayable")
+		}
+	}
+}
+
+//删除超过ValidUntilHeight，不能被打包进下一个块的交易
+func (pool *TransactionPool) eliminateExpiredHeightTxs(height uint64) {
+	for i, maplist := range []map[crypto.CommonAddress]*txList{pool.pending, pool.queue} {
+		for addr, list := range maplist {
+			removed, invalids := list.Expire(height + 1)
+			pool.dropTxs(&addr, append(removed, invalids...), i == 0, "tx valid height expire")
+		}
+	}
+}
+
+//清理已经从txList中移除的交易
+func (pool *TransactionPool) dropTxs(addr *crypto.CommonAddress, txs []*types.Transaction, pending bool, reason string) {
+	for _, tx := range txs {
+		log.WithField("tx nonce", tx.Nonce()).WithField("from", addr.String()).Info(reason)
+		delete(pool.allTxs, tx.TxHash().String())
+		pool.allPricedTxs.Remove(tx)
+		// pending nonce go back to the first removed tx, nonce after it must be filled again
+		if pending && tx.Nonce() < pool.getTransactionCount(addr) {
+			pool.pendingNonce[*addr] = tx.Nonce()
+		}
+	}
+}
+
 //GetTransactionCount 获取总的交易个数，即获取地址对应的nonce
 func (pool *TransactionPool) GetTransactionCount(address *crypto.CommonAddress) uint64 {
 	pool.mu.Lock()
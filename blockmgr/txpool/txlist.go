@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"container/heap"
+	"math"
 	"math/big"
 	"sort"
 
@@ -264,36 +265,44 @@ func (l *txList) Forward(threshold uint64) []*types.Transaction {
 // post-removal maintenance. Strict-mode invalidated transactions are also
 // returned.
 //
-// This method uses the cached costcap and gascap to quickly decide if there's even
-// a point in calculating all the costs or if the balance covers all. If the threshold
-// is lower than the costgas cap, the caps will be reset to a new high after removing
-// the newly invalidated transactions.
-func (l *txList) Filter(costLimit *big.Int, gasLimit uint64) ([]types.Transaction, []types.Transaction) {
-	// If all transactions are below the threshold, short circuit
-	//if l.costcap.Cmp(costLimit) <= 0 && l.gascap <= gasLimit {
-	//	return nil, nil
-	//}
-	//l.costcap = new(big.Int).Set(costLimit) // Lower the caps to the thresholds
-	//l.gascap = gasLimit
-	//
-	//// Filter out all the transactions above the account's funds
-	//removed := l.txs.Filter(func(tx *types.Transaction) bool { return tx.Cost().Cmp(costLimit) > 0 || tx.Gas() > gasLimit })
-	//
-	//// If the list was strict, filter anything above the lowest nonce
-	//var invalids []types.Transaction
-	//
-	//if l.strict && len(removed) > 0 {
-	//	lowest := uint64(math.MaxUint64)
-	//	for _, tx := range removed {
-	//		if nonce := tx.Nonce(); lowest > nonce {
-	//			lowest = nonce
-	//		}
-	//	}
-	//	invalids = l.txs.Filter(func(tx *types.Transaction) bool { return tx.Nonce() > lowest })
-	//}
-	//return removed, invalids
-
-	return nil, nil
+// The sender of sponsored transaction only pay for the amount, the gas of it is
+// checked against the balance of sponsor returned by sponsorFunds.
+func (l *txList) Filter(costLimit *big.Int, gasLimit uint64, sponsorFunds func(sponsor *crypto.CommonAddress) *big.Int) ([]*types.Transaction, []*types.Transaction) {
+	// Filter out all the transactions above the account's funds
+	return l.filter(func(tx *types.Transaction) bool {
+		if tx.SenderCost().Cmp(costLimit) > 0 || tx.Gas() > gasLimit {
+			return true
+		}
+		sponsor, err := tx.Sponsor()
+		if err != nil {
+			return true
+		}
+		return sponsor != nil && tx.GasCost().Cmp(sponsorFunds(sponsor)) > 0
+	})
+}
+
+// Expire removes all transactions from the list which can not be included in the
+// block of given height. Strict-mode invalidated transactions are also returned.
+func (l *txList) Expire(height uint64) ([]*types.Transaction, []*types.Transaction) {
+	return l.filter(func(tx *types.Transaction) bool { return tx.Expired(height) })
+}
+
+func (l *txList) filter(filter func(*types.Transaction) bool) ([]*types.Transaction, []*types.Transaction) {
+	removed := l.txs.Filter(filter)
+
+	// If the list was strict, filter anything above the lowest nonce
+	var invalids []*types.Transaction
+
+	if l.strict && len(removed) > 0 {
+		lowest := uint64(math.MaxUint64)
+		for _, tx := range removed {
+			if nonce := tx.Nonce(); lowest > nonce {
+				lowest = nonce
+			}
+		}
+		invalids = l.txs.Filter(func(tx *types.Transaction) bool { return tx.Nonce() > lowest })
+	}
+	return removed, invalids
 }
 
 // Cap places a hard limit on the number of items, returning all transactions
@@ -0,0 +1,280 @@
+package chain
+
+import (
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+	"math/big"
+)
+
+/**********************alias manage********************/
+
+const aliasLeaseYearHeight = 6220800 //租用别名按年计费，一年的出块高度
+
+type TransferAliasTxSelector struct {
+}
+
+func (transferAliasTxSelector *TransferAliasTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.TransferAliasType
+}
+
+type ReleaseAliasTxSelector struct {
+}
+
+func (releaseAliasTxSelector *ReleaseAliasTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.ReleaseAliasType
+}
+
+type LeaseAliasTxSelector struct {
+}
+
+func (leaseAliasTxSelector *LeaseAliasTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.LeaseAliasType
+}
+
+var (
+	_ = (ITransactionSelector)((*TransferAliasTxSelector)(nil))
+	_ = (ITransactionSelector)((*ReleaseAliasTxSelector)(nil))
+	_ = (ITransactionSelector)((*LeaseAliasTxSelector)(nil))
+	_ = (ITransactionValidator)((*TransferAliasTransactionProcessor)(nil))
+	_ = (ITransactionValidator)((*ReleaseAliasTransactionProcessor)(nil))
+	_ = (ITransactionValidator)((*LeaseAliasTransactionProcessor)(nil))
+)
+
+//TransferAliasTransactionProcessor bind the alias of sender to the recipient,
+//the recipient must have no alias, a leased alias keep its expire height
+type TransferAliasTransactionProcessor struct {
+}
+
+func (transferAliasTransactionProcessor *TransferAliasTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	alias := store.GetStorageAlias(from)
+	if alias == "" {
+		etr.Txerror = ErrNoAlias
+		return etr
+	}
+	if *tx.To() == *from {
+		etr.Txerror = ErrAliasTransferSelf
+		return etr
+	}
+	if store.GetStorageAlias(tx.To()) != "" {
+		etr.Txerror = ErrNotSupportRenameAlias
+		return etr
+	}
+	err := context.UseGas(params.AliasGas * uint64(len(alias)))
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.AliasTransfer(from, tx.To(), alias)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	lease, err := store.GetAliasLease(alias)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	if lease != nil {
+		lease.Owner = *tx.To()
+		err = store.PutAliasLease(lease)
+		if err != nil {
+			etr.Txerror = err
+			return etr
+		}
+	}
+	err = store.AddAliasHistory(&types.AliasRecord{
+		Alias:  alias,
+		Owner:  *tx.To(),
+		Action: types.AliasTransferAction,
+		Height: context.header.Height,
+		TxHash: *tx.TxHash(),
+	})
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
+
+//ReleaseAliasTransactionProcessor unbind the alias of sender, the alias can be set by anyone after that
+type ReleaseAliasTransactionProcessor struct {
+}
+
+func (releaseAliasTransactionProcessor *ReleaseAliasTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	alias := store.GetStorageAlias(from)
+	if alias == "" {
+		etr.Txerror = ErrNoAlias
+		return etr
+	}
+	err := releaseAlias(store, alias)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.AddAliasHistory(&types.AliasRecord{
+		Alias:  alias,
+		Owner:  *from,
+		Action: types.AliasReleaseAction,
+		Height: context.header.Height,
+		TxHash: *tx.TxHash(),
+	})
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
+
+//LeaseAliasTransactionProcessor set an alias for a period of blocks, or renew the alias already leased by sender,
+//the fee is the price of setting the alias permanently prorated by the period
+type LeaseAliasTransactionProcessor struct {
+}
+
+func (leaseAliasTransactionProcessor *LeaseAliasTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	leaseData := payload.(*types.AliasLeaseData)
+	alias := leaseData.Alias
+	if err := CheckAlias([]byte(alias)); err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	action := types.AliasLeaseAction
+	var lease *types.AliasLease
+	switch store.GetStorageAlias(from) {
+	case "":
+		err = store.AliasSet(from, alias)
+		if err != nil {
+			etr.Txerror = err
+			return etr
+		}
+		lease = &types.AliasLease{
+			Alias:        alias,
+			Owner:        *from,
+			ExpireHeight: context.header.Height + leaseData.Period,
+		}
+	case alias:
+		lease, err = store.GetAliasLease(alias)
+		if err != nil {
+			etr.Txerror = err
+			return etr
+		}
+		if lease == nil {
+			etr.Txerror = ErrAliasNotLeased
+			return etr
+		}
+		lease.ExpireHeight += leaseData.Period
+		action = types.AliasRenewAction
+	default:
+		etr.Txerror = ErrNotSupportRenameAlias
+		return etr
+	}
+	if lease.ExpireHeight-context.header.Height > types.MaxAliasLeasePeriod {
+		etr.Txerror = types.ErrAliasLeasePeriod
+		return etr
+	}
+
+	err = context.UseGas(params.AliasGas * uint64(len(alias)))
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	drepFee := aliasFee([]byte(alias))
+	drepFee.Mul(drepFee, new(big.Int).SetUint64(leaseData.Period))
+	drepFee.Div(drepFee, big.NewInt(aliasLeaseYearHeight))
+	err = payAliasFee(context, drepFee)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.PutAliasLease(lease)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.AddAliasHistory(&types.AliasRecord{
+		Alias:  alias,
+		Owner:  *from,
+		Action: action,
+		Height: context.header.Height,
+		TxHash: *tx.TxHash(),
+	})
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
+
+func releaseAlias(trieStore store.StoreInterface, alias string) error {
+	lease, err := trieStore.GetAliasLease(alias)
+	if err != nil {
+		return err
+	}
+	if lease != nil {
+		err = trieStore.DelAliasLease(alias)
+		if err != nil {
+			return err
+		}
+	}
+	return trieStore.AliasDelete(alias)
+}
+
+//ReleaseExpiredAliases unbind the leased aliases which expired before the block, called before execute the transactions of block
+func ReleaseExpiredAliases(trieStore store.StoreInterface, header *types.BlockHeader) error {
+	leases, err := trieStore.PopExpiredAliasLeases(header.Height)
+	if err != nil {
+		return err
+	}
+	for _, lease := range leases {
+		err = releaseAlias(trieStore, lease.Alias)
+		if err != nil {
+			return err
+		}
+		err = trieStore.AddAliasHistory(&types.AliasRecord{
+			Alias:  lease.Alias,
+			Owner:  lease.Owner,
+			Action: types.AliasExpireAction,
+			Height: header.Height,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
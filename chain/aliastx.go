@@ -35,12 +35,22 @@ func (aliasTransactionProcessor *AliasTransactionProcessor) ExecuteTransaction(c
 	from := context.From()
 	store := context.TrieStore()
 	tx := context.Tx()
-	alias := tx.GetData()
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return &etr
+	}
+	alias := []byte(*payload.(*types.AliasData))
 	if err := CheckAlias(alias); err != nil {
 		etr.Txerror = err
 		return &etr
 	}
-	err := store.AliasSet(from, string(alias))
+	aliasFork := params.IsAliasFork(context.header.Height)
+	if aliasFork && store.GetStorageAlias(from) != "" {
+		etr.Txerror = ErrNotSupportRenameAlias
+		return &etr
+	}
+	err = store.AliasSet(from, string(alias))
 	if err != nil {
 		etr.Txerror = err
 		return &etr
@@ -50,6 +60,36 @@ func (aliasTransactionProcessor *AliasTransactionProcessor) ExecuteTransaction(c
 		etr.Txerror = err
 		return &etr
 	}
+	//别名费的计算和扣除与升级前一致
+	err = payAliasFee(context, aliasFee(alias))
+	if err != nil {
+		etr.Txerror = err
+		return &etr
+	}
+	if aliasFork {
+		err = store.AddAliasHistory(&types.AliasRecord{
+			Alias:  string(alias),
+			Owner:  *from,
+			Action: types.AliasSetAction,
+			Height: context.header.Height,
+			TxHash: *tx.TxHash(),
+		})
+		if err != nil {
+			etr.Txerror = err
+			return &etr
+		}
+	}
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return &etr
+	}
+
+	return &etr
+}
+
+//aliasFee the extra price of an alias paid to hole address, the shorter the more expensive
+func aliasFee(alias []byte) *big.Int {
 	// extra price
 	type LenPriceCacler struct {
 		LenMatch func() bool
@@ -123,41 +163,37 @@ func (aliasTransactionProcessor *AliasTransactionProcessor) ExecuteTransaction(c
 			},
 		},
 	}
-	var drepFee *big.Int
 	for _, calcer := range calcers {
 		if calcer.LenMatch() {
-			drepFee = calcer.Fee()
-			break
+			return calcer.Fee()
 		}
 	}
+	return big.NewInt(0)
 
+}
+
+//payAliasFee minus alias fee from sender and put it to hole address
+func payAliasFee(context *ExecuteTransactionContext, drepFee *big.Int) error {
+	from := context.From()
+	store := context.TrieStore()
 	//minus alias fee from from account
 	originBalance := store.GetBalance(from, context.header.Height)
 	leftBalance := originBalance.Sub(originBalance, drepFee)
 	if leftBalance.Sign() < 0 {
-		etr.Txerror = ErrBalance
-		return &etr
+		return ErrBalance
 	}
-	err = store.PutBalance(from, context.header.Height, leftBalance)
+	err := store.PutBalance(from, context.header.Height, leftBalance)
 	if err != nil {
-		etr.Txerror = err
-		return &etr
+		return err
 	}
 	// put alias fee to hole address
 	zeroAddressBalance := store.GetBalance(&params.HoleAddress, context.header.Height)
 	zeroAddressBalance = zeroAddressBalance.Add(zeroAddressBalance, drepFee)
 	err = store.PutBalance(&params.HoleAddress, context.header.Height, zeroAddressBalance)
 	if err != nil {
-		etr.Txerror = err
-		return &etr
+		return err
 	}
-	err = store.PutNonce(from, tx.Nonce()+1)
-	if err != nil {
-		etr.Txerror = err
-		return &etr
-	}
-
-	return &etr
+	return nil
 }
 
 func CheckAlias(alias []byte) error {
@@ -0,0 +1,57 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestSetAliasFork(t *testing.T) {
+	defer func(height uint64) { params.AliasForkHeight = height }(params.AliasForkHeight)
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	genesis := chainService.genesisBlock.Header
+	gasPrice, gasLimit := big.NewInt(1), big.NewInt(100000)
+	setAlias := func(alias string, nonce uint64) *types.Transaction {
+		return tester.sign(tester.priv, types.NewAliasTransaction(alias, gasPrice, gasLimit, nonce))
+	}
+	history := func(block *types.Block, alias string) []*types.AliasRecord {
+		trieStore, err := store.TrieStoreFromStore(tester.gen.DatabaseService.LevelDb(), block.Header.StateRoot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := trieStore.GetAliasHistory(alias)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return records
+	}
+
+	//升级前可以重设别名，不记录历史
+	a1 := tester.makeBlock(genesis, 1, setAlias("aliasnumberone", 0))
+	a2 := tester.makeBlock(a1.Header, 1, setAlias("aliasnumbertwo", 1))
+	tester.process(chainService, a1, a2)
+	if records := history(a2, "aliasnumberone"); len(records) != 0 {
+		t.Fatalf("alias history recorded before fork, %v", records)
+	}
+
+	params.AliasForkHeight = 0
+	b1 := tester.makeBlock(genesis, 2, setAlias("aliasnumberone", 0))
+	if records := history(b1, "aliasnumberone"); len(records) != 1 || records[0].Action != types.AliasSetAction {
+		t.Fatalf("alias history mismatch, %v", records)
+	}
+	trieStore, err := store.TrieStoreFromStore(tester.gen.DatabaseService.LevelDb(), b1.Header.StateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b2 := tester.makeBlock(b1.Header, 1)
+	gp := new(GasPool).AddGas(b2.Header.GasLimit.Uint64())
+	context := NewBlockExecuteContext(trieStore, gp, tester.gen.chainStore, b2)
+	validator := NewChainBlockValidator(tester.gen)
+	if _, _, err := validator.RouteTransaction(context, gp, setAlias("aliasnumbertwo", 1)); err != ErrNotSupportRenameAlias {
+		t.Fatalf("expect %v, got %v", ErrNotSupportRenameAlias, err)
+	}
+}
@@ -0,0 +1,103 @@
+package chain
+
+import (
+	"encoding/json"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+/**********************batch********************/
+
+type BatchTxSelector struct {
+}
+
+func (batchTxSelector *BatchTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.BatchType
+}
+
+var (
+	_ = (ITransactionSelector)((*BatchTxSelector)(nil))
+	_ = (ITransactionValidator)((*BatchTransactionProcessor)(nil))
+)
+
+//BatchTransactionProcessor route every operation of batch to the processor registered for its type,
+//all operations are executed under one snapshot, either all of them apply or none does
+type BatchTransactionProcessor struct {
+	chain ChainServiceInterface
+}
+
+func NewBatchTransactionProcessor(chain ChainServiceInterface) *BatchTransactionProcessor {
+	return &BatchTransactionProcessor{chain: chain}
+}
+
+func (processor *BatchTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	tx := context.Tx()
+	store := context.TrieStore()
+
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	batch := payload.(*types.BatchData)
+
+	snap := store.CopyState()
+	logs := make([]*types.Log, 0, len(batch.Operations))
+	for i := range batch.Operations {
+		subTx := batch.SubTransaction(tx, i)
+		opReceipt, opLogs, err := processor.executeOperation(context, subTx, i)
+		if err != nil {
+			store.RevertState(snap)
+			etr.Txerror = err
+			return etr
+		}
+		opReceipt.Index = i
+		data, _ := json.Marshal(opReceipt)
+		logs = append(logs, &types.Log{TxType: types.BatchType, TxHash: *tx.TxHash(), Data: data, Height: context.header.Height, TxIndex: uint(i)})
+		for _, log := range opLogs {
+			log.TxHash = *tx.TxHash()
+			logs = append(logs, log)
+		}
+	}
+
+	err = store.PutNonce(context.From(), tx.Nonce()+1)
+	if err != nil {
+		store.RevertState(snap)
+		etr.Txerror = err
+		return etr
+	}
+	etr.ContractTxLog = logs
+	return etr
+}
+
+func (processor *BatchTransactionProcessor) executeOperation(context *ExecuteTransactionContext, subTx *types.Transaction, index int) (*types.BatchOperationReceipt, []*types.Log, error) {
+	// every operation pay for a basic transaction
+	if err := context.UseGas(params.TxGas); err != nil {
+		return nil, nil, err
+	}
+	subContext := NewExecuteTransactionContext(context.blockContext, context.trieStore, context.gp, context.from, subTx)
+	subContext.gasRemained = context.gasRemained
+	subContext.opIndex = index
+	subContext.initialGas = context.gasRemained
+
+	for selector, txValidator := range processor.chain.TransactionValidators() {
+		if selector.Select(subTx) {
+			ret := txValidator.ExecuteTransaction(subContext)
+			if ret.Txerror != nil {
+				return nil, nil, ret.Txerror
+			}
+			if ret.ContractTxExecuteFail {
+				return nil, nil, ErrBatchOperationFail
+			}
+			context.gasRemained = subContext.gasRemained
+			receipt := &types.BatchOperationReceipt{
+				Type:    subTx.Type(),
+				GasUsed: subContext.GasUsed(),
+				Result:  ret.TxResult,
+			}
+			return receipt, ret.ContractTxLog, nil
+		}
+	}
+	return nil, nil, ErrUnsupportTxType
+}
@@ -33,6 +33,13 @@ type IBlockValidator interface {
 	ExecuteBlock(context *BlockExecuteContext) error
 }
 
+// IFinalityValidator is implemented by the block validator of a consensus whose proof
+// makes a block irreversible. IsFinal is only called on blocks of the main chain, it must
+// verify the proof itself since blocks of a trusted import are connected without VerifyBody.
+type IFinalityValidator interface {
+	IsFinal(block *types.Block) bool
+}
+
 type BlockExecuteContext struct {
 	TrieStore store.StoreInterface
 	Gp        *GasPool
@@ -42,6 +49,8 @@ type BlockExecuteContext struct {
 	GasFee    *big.Int
 	Logs      []*types.Log
 	Receipts  types.Receipts
+	Stats     *BlockExecutionStats //不为nil时记录每个交易的执行开销
+	Reward    *types.BlockReward   //共识计算的奖励分配，块验证通过后才保存
 }
 
 func NewBlockExecuteContext(trieStore store.StoreInterface, gp *GasPool, dbStore *ChainStore, block *types.Block) *BlockExecuteContext {
@@ -77,7 +86,7 @@ func NewChainBlockValidator(chainService *ChainService) *ChainBlockValidator {
 
 func (chainBlockValidator *ChainBlockValidator) VerifyHeader(header, parent *types.BlockHeader) error {
 	// Verify chainId  matched
-	if header.ChainId != chainBlockValidator.chain.ChainID() {
+	if params.IsChainIdFork(header.Height) && header.ChainId != chainBlockValidator.chain.ChainID() {
 		return ErrChainId
 	}
 	// Verify version  matched
@@ -130,23 +139,43 @@ func (chainBlockValidator *ChainBlockValidator) ExecuteBlock(context *BlockExecu
 	if len(context.Block.Data.TxList) < 0 {
 		return nil
 	}
+	// locks matured at this block are released before any transaction touch the balances
+	if err := ReleaseTimeLocks(context.TrieStore, context.Block.Header); err != nil {
+		return err
+	}
+	if err := ReleaseExpiredAliases(context.TrieStore, context.Block.Header); err != nil {
+		return err
+	}
+
+	if chainBlockValidator.chain.Config.ParallelExec && len(context.Block.Data.TxList) > 1 {
+		if executed, err := chainBlockValidator.executeParallel(context); executed {
+			if err != nil {
+				return err
+			}
+			return chainBlockValidator.saveReceipts(context)
+		}
+	}
 
 	for i, t := range context.Block.Data.TxList {
 		receipt, gasUsed, err := chainBlockValidator.RouteTransaction(context, context.Gp, t)
 		if err != nil {
 			return err
 		}
-		if err == nil {
-			gasUsedBig := new(big.Int).SetUint64(gasUsed)
-			context.AddGasUsed(gasUsedBig)
-			gasFee := new(big.Int).Mul(gasUsedBig, t.GasPrice())
-			context.AddGasFee(gasFee)
-		} else {
-			return err
-		}
-		context.Receipts[i] = receipt
-		context.Logs = append(context.Logs, receipt.Logs...)
+		context.addReceipt(i, t, receipt, gasUsed)
 	}
+	return chainBlockValidator.saveReceipts(context)
+}
+
+func (context *BlockExecuteContext) addReceipt(i int, tx *types.Transaction, receipt *types.Receipt, gasUsed uint64) {
+	gasUsedBig := new(big.Int).SetUint64(gasUsed)
+	context.AddGasUsed(gasUsedBig)
+	gasFee := new(big.Int).Mul(gasUsedBig, tx.GasPrice())
+	context.AddGasFee(gasFee)
+	context.Receipts[i] = receipt
+	context.Logs = append(context.Logs, receipt.Logs...)
+}
+
+func (chainBlockValidator *ChainBlockValidator) saveReceipts(context *BlockExecuteContext) error {
 	//TODO check whether gasRemained exceed max value
 	newReceiptRoot := chainBlockValidator.chain.DeriveReceiptRoot(context.Receipts)
 	if newReceiptRoot != context.Block.Header.ReceiptRoot {
@@ -195,19 +224,21 @@ func (chainBlockValidator *ChainBlockValidator) RouteTransaction(context *BlockE
 	for selector, txValidator := range chainBlockValidator.chain.transactionValidator {
 		if selector.Select(tx) {
 			exit = true
+			profiled := context.profileTx(tx)
 			etr := txValidator.ExecuteTransaction(txContext)
 			if etr.Txerror != nil {
-				return nil, 0, err
+				return nil, 0, etr.Txerror
 			}
 			err = txContext.RefundCoin()
 			if err != nil {
 				return nil, 0, err
 			}
+			profiled(txContext.GasUsed())
 			//context.trieAccountStore.CacheToTrie()
 			// Create a new receipt for the transaction, storing the intermediate root and gasRemained used by the tx
 			// based on the eip phase, we're passing whether the root touch-delete accounts.
 			//crypto.ZeroHash[:]
-			receipt := types.NewReceipt(crypto.ZeroHash[:], etr.ContractTxExecuteFail, txContext.GasUsed())
+			receipt := types.NewExecuteReceipt(crypto.ZeroHash[:], etr, txContext.GasUsed())
 			receipt.TxHash = *tx.TxHash()
 			receipt.GasUsed = txContext.GasUsed()
 			receipt.ContractAddress = etr.ContractAddr
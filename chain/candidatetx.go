@@ -45,12 +45,12 @@ func (processor *CandidateTransactionProcessor) ExecuteTransaction(context *Exec
 		return etr
 	}
 
-	cd := types.CandidateData{}
-	if err := cd.Unmarshal(tx.GetData()); nil != err {
+	payload, err := context.Payload()
+	if nil != err {
 		etr.Txerror = err
 		return etr
 	}
-	err = store.CandidateCredit(from, tx.Amount(), tx.GetData(), context.header.Height)
+	err = store.CandidateCredit(from, tx.Amount(), payload.(*types.CandidateData), context.header.Height)
 	if err != nil {
 		etr.Txerror = err
 		return etr
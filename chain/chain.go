@@ -13,9 +13,11 @@ import (
 
 	"github.com/drep-project/DREP-Chain/common"
 	"github.com/drep-project/DREP-Chain/common/event"
+	"github.com/drep-project/DREP-Chain/common/trie"
 	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/crypto/sha3"
 	"github.com/drep-project/DREP-Chain/database"
+	"github.com/drep-project/DREP-Chain/database/dbinterface"
 	"github.com/drep-project/binary"
 
 	rpc2 "github.com/drep-project/DREP-Chain/pkgs/rpc"
@@ -25,9 +27,11 @@ import (
 var (
 	RootChain          types.ChainIdType
 	DefaultChainConfig = &ChainConfig{
-		RemotePort:  55556,
-		ChainId:     RootChain,
-		GenesisAddr: params.HoleAddress,
+		RemotePort:     55556,
+		ChainId:        RootChain,
+		GenesisAddr:    params.HoleAddress,
+		GCMode:         GCModeArchive,
+		StateRetention: DefaultStateRetention,
 	}
 	span = uint64(params.MaxGasLimit / 360)
 )
@@ -43,6 +47,7 @@ type ChainServiceInterface interface {
 	//DefaultChainConfig
 	GetBlockHeaderByHash(hash *crypto.Hash) (*types.BlockHeader, error)
 	GetBlockHeaderByHeight(number uint64) (*types.BlockHeader, error)
+	GetStateHeader(blockNrOrHash *types.BlockNumberOrHash) (*types.BlockHeader, error)
 
 	GetHeader(hash crypto.Hash, number uint64) *types.BlockHeader
 	GetCurrentHeader() *types.BlockHeader
@@ -51,6 +56,8 @@ type ChainServiceInterface interface {
 	BestChain() *ChainView
 	CalcGasLimit(parent *types.BlockHeader, gasFloor, gasCeil uint64) *big.Int
 	ProcessBlock(block *types.Block) (bool, bool, error)
+	InsertBlockWithoutState(block *types.Block) error
+	CommitSyncedState(hash *crypto.Hash) error
 	NewBlockFeed() *event.Feed
 	GetLogsFeed() *event.Feed
 	GetRMLogsFeed() *event.Feed
@@ -97,6 +104,12 @@ type ChainService struct {
 	logsFeed        event.Feed
 	rmLogsFeed      event.Feed
 
+	statePruner *statePruner
+	//最近执行的块的耗时、gas和状态读写统计
+	profiler *executionProfiler
+	//主链上最高的不可回滚的区块，之前的区块不再重组
+	finalizedNode *types.BlockNode
+
 	blockValidator       BlockValidators
 	transactionValidator map[ITransactionSelector]ITransactionValidator
 	genesisProcess       []IGenesisProcess
@@ -156,6 +169,8 @@ type ChainState struct {
 //}
 
 func (chainService *ChainService) Init(executeContext *app.ExecuteContext) error {
+	chainService.chainId = chainService.Config.ChainId
+	chainService.profiler = newExecutionProfiler()
 	chainService.blockIndex = NewBlockIndex()
 	chainService.bestChain = NewChainView(nil)
 	chainService.chainStore = &ChainStore{chainService.DatabaseService.LevelDb()}
@@ -171,6 +186,14 @@ func (chainService *ChainService) Init(executeContext *app.ExecuteContext) error
 		&CancelVoteTxSelector{}:      &CancelVoteTransactionProcessor{},
 		&CandidateTxSelector{}:       &CandidateTransactionProcessor{},
 		&CancelCandidateTxSelector{}: &CancelCandidateTransactionProcessor{},
+		&BatchTxSelector{}:           NewBatchTransactionProcessor(chainService),
+		&MultiSigTxSelector{}:        &MultiSigTransactionProcessor{},
+		&TimeLockTxSelector{}:        &TimeLockTransactionProcessor{},
+		&CancelTimeLockTxSelector{}:  &CancelTimeLockTransactionProcessor{},
+		&TransferAliasTxSelector{}:   &TransferAliasTransactionProcessor{},
+		&ReleaseAliasTxSelector{}:    &ReleaseAliasTransactionProcessor{},
+		&LeaseAliasTxSelector{}:      &LeaseAliasTransactionProcessor{},
+		&RedelegateTxSelector{}:      &RedelegateTransactionProcessor{},
 	}
 
 	var err error
@@ -186,6 +209,11 @@ func (chainService *ChainService) Init(executeContext *app.ExecuteContext) error
 	}
 	hash := chainService.genesisBlock.Header.Hash()
 	if !chainService.chainStore.HasBlock(hash) {
+		//引用计数需要从第一个写入的状态节点开始，只能在新建的数据库上开启
+		err = trie.EnableRefCount(chainService.DatabaseService.LevelDb())
+		if err != nil {
+			return err
+		}
 		chainService.genesisBlock, err = chainService.ProcessGenesisBlock(chainService.Config.GenesisAddr)
 		err = chainService.createChainState()
 		if err != nil {
@@ -202,11 +230,17 @@ func (chainService *ChainService) Init(executeContext *app.ExecuteContext) error
 		log.Error("InitStates err:", err)
 		return err
 	}
+	err = chainService.indexMainChainVotes()
+	if err != nil {
+		log.Error("index votes err:", err)
+		return err
+	}
+	chainService.statePruner = newStatePruner(chainService.Config, chainService.chainStore)
 	chainService.apis = []app.API{
 		{
 			Namespace: MODULENAME,
 			Version:   "1.0",
-			Service:   NewChainApi(chainService.DatabaseService.LevelDb(), chainService.BestChain(), chainService.chainStore),
+			Service:   NewChainApi(chainService.DatabaseService.LevelDb(), chainService.BestChain(), chainService.chainStore, chainService.profiler),
 			Public:    true,
 		},
 	}
@@ -214,6 +248,12 @@ func (chainService *ChainService) Init(executeContext *app.ExecuteContext) error
 }
 
 func (chainService *ChainService) Start(executeContext *app.ExecuteContext) error {
+	if executeContext.Cli != nil {
+		switch executeContext.Cli.Command.Name {
+		case exportCommand.Name, importCommand.Name:
+			return chainService.runCommand(executeContext)
+		}
+	}
 	return nil
 }
 
@@ -261,6 +301,31 @@ func (chainService *ChainService) GetBlockByHash(hash *crypto.Hash) (*types.Bloc
 	return block, nil
 }
 
+// indexMainChainVotes index the votes of the main chain blocks connected before the vote index existed,
+// it runs once on a database, later blocks are indexed while they are connected
+func (chainService *ChainService) indexMainChainVotes() error {
+	if chainService.chainStore.VotesIndexed() {
+		return nil
+	}
+	tip := chainService.bestChain.Height()
+	for height := uint64(1); height <= tip; height++ {
+		node := chainService.bestChain.NodeByHeight(height)
+		if node == nil {
+			continue
+		}
+		//同步状态时跳过的区块没有区块体
+		block, err := chainService.chainStore.GetBlock(node.Hash)
+		if err != nil {
+			continue
+		}
+		err = chainService.chainStore.IndexVotes(block)
+		if err != nil {
+			return err
+		}
+	}
+	return chainService.chainStore.PutVotesIndexed()
+}
+
 func (chainService *ChainService) GetBlockHeaderByHash(hash *crypto.Hash) (*types.BlockHeader, error) {
 	blockNode, ok := chainService.blockIndex.Index[*hash]
 	if !ok {
@@ -289,6 +354,48 @@ func (chainService *ChainService) GetBlockHeaderByHeight(number uint64) (*types.
 	return &header, nil
 }
 
+// GetStateHeader return the header of the block selected by blockNrOrHash to query state on,
+// nil selects the tip. It fails with ErrStatePruned if the state of the block is not kept.
+func (chainService *ChainService) GetStateHeader(blockNrOrHash *types.BlockNumberOrHash) (*types.BlockHeader, error) {
+	return stateHeader(chainService.chainStore, chainService.bestChain, blockNrOrHash)
+}
+
+func stateHeader(chainStore *ChainStore, chainView *ChainView, blockNrOrHash *types.BlockNumberOrHash) (*types.BlockHeader, error) {
+	var header *types.BlockHeader
+	switch {
+	case blockNrOrHash.Latest():
+		tip := chainView.Tip().Header()
+		header = &tip
+	case blockNrOrHash.BlockHash != nil:
+		var err error
+		header, err = chainStore.GetBlockHeader(blockNrOrHash.BlockHash)
+		if err != nil {
+			return nil, ErrBlockNotFound
+		}
+	default:
+		node := chainView.NodeByHeight(uint64(*blockNrOrHash.BlockNumber))
+		if node == nil {
+			return nil, ErrBlockNotFound
+		}
+		nodeHeader := node.Header()
+		header = &nodeHeader
+	}
+	if !hasState(chainStore, header.StateRoot) {
+		return nil, ErrStatePruned
+	}
+	return header, nil
+}
+
+// hasState report whether the root node of a state is on disk, the whole state is
+// released together with its root when pruning
+func hasState(db dbinterface.KeyValueReader, root []byte) bool {
+	if len(root) == 0 || crypto.Bytes2Hash(root) == trie.EmptyRoot {
+		return true
+	}
+	ok, err := db.Has(root)
+	return err == nil && ok
+}
+
 func (chainService *ChainService) getTxHashes(ts []*types.Transaction) ([][]byte, error) {
 	txHashes := make([][]byte, len(ts))
 	for i, tx := range ts {
@@ -316,8 +423,7 @@ func (cs *ChainService) DeriveReceiptRoot(receipts []*types.Receipt) crypto.Hash
 	}
 	receiptsHashes := make([][]byte, len(receipts))
 	for i, receipt := range receipts {
-		b, _ := binary.Marshal(receipt)
-		receiptsHashes[i] = sha3.Keccak256(b)
+		receiptsHashes[i] = receipt.ReceiptHash().Bytes()
 	}
 	merkle := common.NewMerkle(receiptsHashes)
 	receiptRoot := crypto.Hash{}
@@ -414,7 +520,7 @@ func (chainService *ChainService) Api() []app.API {
 }
 
 func (chainService *ChainService) CommandFlags() ([]cli.Command, []cli.Flag) {
-	return nil, []cli.Flag{}
+	return []cli.Command{exportCommand, importCommand}, []cli.Flag{}
 }
 
 // Config
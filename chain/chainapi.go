@@ -27,13 +27,15 @@ type ChainApi struct {
 	store     dbinterface.KeyValueStore
 	chainView *ChainView
 	dbQuery   *ChainStore
+	profiler  *executionProfiler
 }
 
-func NewChainApi(store dbinterface.KeyValueStore, chainView *ChainView, dbQuery *ChainStore) *ChainApi {
+func NewChainApi(store dbinterface.KeyValueStore, chainView *ChainView, dbQuery *ChainStore, profiler *executionProfiler) *ChainApi {
 	return &ChainApi{
 		store:     store,
 		chainView: chainView,
 		dbQuery:   dbQuery,
+		profiler:  profiler,
 	}
 }
 
@@ -98,6 +100,50 @@ func (chain *ChainApi) GetMaxHeight() uint64 {
 	return chain.chainView.Tip().Height
 }
 
+/*
+ name: getFinalizedBlock
+ usage: 获取主链上最高的不可回滚区块，该区块及之前的区块不会再被重组，没有区块被共识证明为最终时返回创世块
+ params:
+	1. 无
+ return: 区块明细信息
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getFinalizedBlock","params":[], "id": 3}' -H "Content-Type:application/json"
+ response:
+{
+  "jsonrpc": "2.0",
+  "id": 3,
+  "result": {
+    "Hash": "0xcfa283a5b591da5a15971bf62fffae87e649bcf749776f4c83ffe50e65920f8e",
+    "ChainId": "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+    "Version": 1,
+    "PreviousHash": "0x1717b4b9f740cebeb2659886122a29c0876ed906dd05370319fee4ecf219b1e9",
+    "GasLimit": 180000000,
+    "GasUsed": 0,
+    "Height": 1,
+    "Timestamp": 1559272779,
+    "StateRoot": "0xd7bd5b3af4f2f1fb3d484743052c2e911f9fb7b04131660912244347508f16a9",
+    "TxRoot": "0x",
+    "LeaderAddress": "0x0374bf9c8ea268b5548686685dda4a74fc95903ca7c440e5b187a718b595c1f374",
+    "MinorAddresses": [
+      "0x0374bf9c8ea268b5548686685dda4a74fc95903ca7c440e5b187a718b595c1f374",
+      "0x02f11cfd138eaaaba5f8c0a7f1f2791bdabd0b0c404734dceac820aa9b683bfb1a",
+      "0x03949aad279a32536ce20f0957c9c6ba592532ea70e5f174332bed4c94382354e3",
+      "0x0263bc5628fa7033727d14b5d6714ac7d6a5d34bc5db994a896f54499f12db9b0b"
+    ],
+    "Txs": [
+
+    ]
+  }
+}
+*/
+func (chain *ChainApi) GetFinalizedBlock() (*types.Block, error) {
+	hash, err := chain.dbQuery.GetFinalizedHash()
+	if err != nil {
+		//the genesis block is never reverted
+		hash = chain.chainView.Genesis().Hash
+	}
+	return chain.dbQuery.GetBlock(hash)
+}
+
 /*
  name: getBlockGasInfo
  usage: 获取gas相关信息
@@ -135,24 +181,96 @@ func (chain *ChainApi) GetBlockGasInfo() string {
 	return string(ret)
 }
 
+/*
+ name: getBlockExecutionStats
+ usage: 获取本节点执行区块的耗时统计，按交易类型给出执行时间(纳秒)、gas和状态读写次数，只保留最近执行的256个块
+ params:
+	1. 区块高度
+ return: 区块执行统计，各个区块验证器的耗时和各类交易的开销
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getBlockExecutionStats","params":[1024], "id": 3}' -H "Content-Type:application/json"
+ response:
+{
+  "jsonrpc": "2.0",
+  "id": 3,
+  "result": {
+    "Height": 1024,
+    "Hash": "0x2ad1b8d1ef5a8b1adbd0a2c8e5a04b6c9c1f0a6ed3a4f62c8c2d0a1f6e4b7c95",
+    "TxCount": 2,
+    "GasUsed": 60000,
+    "Time": 1735210,
+    "Validators": [
+      {"Name": "ChainBlockValidator", "Time": 1201520},
+      {"Name": "BlockMultiSigValidator", "Time": 533690}
+    ],
+    "TxTypes": [
+      {"Type": 0, "Count": 2, "Time": 412300, "GasUsed": 60000, "Reads": 8, "Writes": 6}
+    ]
+  }
+}
+*/
+func (chain *ChainApi) GetBlockExecutionStats(height uint64) (*BlockExecutionStats, error) {
+	node := chain.chainView.NodeByHeight(height)
+	if node == nil {
+		return nil, ErrBlockNotFound
+	}
+	stats, ok := chain.profiler.blockStats(node.Hash)
+	if !ok {
+		return nil, ErrNoExecutionStats
+	}
+	return stats, nil
+}
+
+/*
+ name: getExecutionCounters
+ usage: 获取节点启动以来执行区块的累计统计，用于评估区块gas上限
+ params:
+	1. 无
+ return: 执行的块数、交易数、gas和耗时(纳秒)，单个块的最大耗时和最大gas，按交易类型累计的开销
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getExecutionCounters","params":[], "id": 3}' -H "Content-Type:application/json"
+ response:
+{
+  "jsonrpc": "2.0",
+  "id": 3,
+  "result": {
+    "Blocks": 1024,
+    "Txs": 3120,
+    "GasUsed": 93600000,
+    "Time": 2163820911,
+    "MaxBlockTime": 12846217,
+    "MaxBlockGas": 360000,
+    "TxTypes": [
+      {"Type": 0, "Count": 3120, "Time": 640319220, "GasUsed": 93600000, "Reads": 12480, "Writes": 9360}
+    ]
+  }
+}
+*/
+func (chain *ChainApi) GetExecutionCounters() *ExecutionCounters {
+	return chain.profiler.snapshot()
+}
+
 /*
  name: getBalance
  usage: 查询地址余额
  params:
 	1. 待查询地址
- return: 地址中的账号余额
- example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getBalance","params":["0x8a8e541ddd1272d53729164c70197221a3c27486"], "id": 3}' -H "Content-Type:application/json"
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: 地址在该区块状态中的账号余额，区块状态已被裁剪时返回错误
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getBalance","params":["0x8a8e541ddd1272d53729164c70197221a3c27486", 10000], "id": 3}' -H "Content-Type:application/json"
  response:
    {"jsonrpc":"2.0","id":3,"result":9987999999999984000000}
 */
-func (chain *ChainApi) GetBalance(addr crypto.CommonAddress) string {
-	store, err := store.TrieStoreFromStore(chain.store, chain.chainView.Tip().StateRoot)
+func (chain *ChainApi) GetBalance(addr crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) (string, error) {
+	header, err := stateHeader(chain.dbQuery, chain.chainView, blockNrOrHash)
 	if err != nil {
-		return ""
+		return "", err
 	}
-	big := store.GetBalance(&addr, chain.chainView.tip().Height)
+	store, err := store.TrieStoreFromStore(chain.store, header.StateRoot)
+	if err != nil {
+		return "", err
+	}
+	big := store.GetBalance(&addr, header.Height)
 
-	return big.String()
+	return big.String(), nil
 }
 
 /*
@@ -160,29 +278,86 @@ func (chain *ChainApi) GetBalance(addr crypto.CommonAddress) string {
  usage: 查询地址在链上的nonce
  params:
 	1. 待查询地址
- return: 链上nonce
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: 该区块状态中的nonce，区块状态已被裁剪时返回错误
  example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getNonce","params":["0x8a8e541ddd1272d53729164c70197221a3c27486"], "id": 3}' -H "Content-Type:application/json"
  response:
    {"jsonrpc":"2.0","id":3,"result":0}
 */
-func (chain *ChainApi) GetNonce(addr crypto.CommonAddress) uint64 {
-	trieQuery, _ := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
-	return trieQuery.GetNonce(&addr)
+func (chain *ChainApi) GetNonce(addr crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) (uint64, error) {
+	trieQuery, err := chain.stateQuery(blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	return trieQuery.GetNonce(&addr), nil
 }
 
 /*
- name: getNonce
+ name: getReputation
  usage: 查询地址的名誉值
  params:
 	1. 待查询地址
- return: 地址对应的名誉值
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: 地址在该区块状态中对应的名誉值，区块状态已被裁剪时返回错误
  example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getReputation","params":["0x8a8e541ddd1272d53729164c70197221a3c27486"], "id": 3}' -H "Content-Type:application/json"
  response:
    {"jsonrpc":"2.0","id":3,"result":1}
 */
-func (chain *ChainApi) GetReputation(addr crypto.CommonAddress) *big.Int {
-	trieQuery, _ := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
-	return trieQuery.GetReputation(&addr)
+func (chain *ChainApi) GetReputation(addr crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) (*big.Int, error) {
+	trieQuery, err := chain.stateQuery(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetReputation(&addr), nil
+}
+
+/*
+ name: getAccountProof
+ usage: 获取账户状态的默克尔证明，用于轻节点在不信任节点的情况下验证账户余额等状态
+ params:
+	1. 待查询地址
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: 账户存储，区块StateRoot以及从StateRoot到账户存储的trie节点，可用chain.VerifyAccountProof验证
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getAccountProof","params":["0x8a8e541ddd1272d53729164c70197221a3c27486", 10000], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":{"Address":"0x8a8e541ddd1272d53729164c70197221a3c27486","Height":10000,"StateRoot":"0xd7bd5b3af4f2f1fb3d484743052c2e911f9fb7b04131660912244347508f16a9","Storage":{"Balance":9987999999999984000000,"Reputation":1,"Nonce":3,"ByteCode":"0x","CodeHash":"0x0000000000000000000000000000000000000000000000000000000000000000","Alias":"","BalanceMap":null,"MultiSig":null},"Proof":["0xf90211a0...","0xf871808080..."]}}
+*/
+func (chain *ChainApi) GetAccountProof(addr crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) (*AccountProof, error) {
+	header, err := stateHeader(chain.dbQuery, chain.chainView, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	trieQuery, err := NewTrieQuery(chain.store, header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	key := accountKey(&addr)
+	var nodes trie.ProofList
+	err = trieQuery.trie.Prove(key, 0, &nodes)
+	if err != nil {
+		return nil, err
+	}
+	proof := &AccountProof{
+		Address:   addr,
+		Height:    header.Height,
+		StateRoot: crypto.Bytes2Hash(header.StateRoot),
+		Proof:     make([]common.Bytes, len(nodes)),
+	}
+	for i, node := range nodes {
+		proof.Proof[i] = node
+	}
+	value, err := trieQuery.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if value != nil {
+		proof.Storage = &types.Storage{}
+		err = binary.Unmarshal(value, proof.Storage)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return proof, nil
 }
 
 /*
@@ -225,6 +400,49 @@ func (chain *ChainApi) GetTransactionByBlockHeightAndIndex(height uint64, index
 	return block.Data.TxList[index], nil
 }
 
+/*
+ name: getTransactionProof
+ usage: 获取交易在区块TxRoot中的包含证明，可作为充值等交易上链的简洁证明
+ params:
+	1. 交易hash
+ return: 交易所在区块hash、高度、TxRoot以及默克尔认证路径，可用chain.VerifyTransactionProof验证
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getTransactionProof","params":["0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":{"TxHash":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9","BlockHash":"0x2b6e3a3a41e8e1a9a6c0b9f2a9e2b6d0f1d1e0c9b8a7f6e5d4c3b2a1f0e9d8c7","Height":1024,"TxRoot":"0x5b1c3e9a4f7d2c6b8a0e1f3d5c7b9a2e4f6d8c0b1a3e5f7d9c2b4a6e8f0d1c3b","Proof":{"Index":2,"Siblings":["0x","0x9e1f3a5c7b2d4e6f8a0c1b3d5e7f9a2c4b6d8e0f1a3c5b7d9e2f4a6c8b0d1e3f"]}}}
+*/
+func (chain *ChainApi) GetTransactionProof(txHash crypto.Hash) (*TransactionProof, error) {
+	receipt := chain.dbQuery.GetReceipt(txHash)
+	if receipt == nil {
+		return nil, ErrReceiptNotFound
+	}
+	block, err := chain.dbQuery.GetBlock(&receipt.BlockHash)
+	if err != nil {
+		return nil, ErrBlockNotFound
+	}
+	leaves := make([][]byte, len(block.Data.TxList))
+	index := -1
+	for i, tx := range block.Data.TxList {
+		leaves[i] = tx.TxHash().Bytes()
+		if *tx.TxHash() == txHash {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, ErrTxIndexOutOfRange
+	}
+	merkleProof, err := common.NewMerkle(leaves).Proof(index)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionProof{
+		TxHash:    txHash,
+		BlockHash: receipt.BlockHash,
+		Height:    block.Header.Height,
+		TxRoot:    block.Header.TxRoot,
+		Proof:     merkleProof,
+	}, nil
+}
+
 /*
  name: getAliasByAddress
  usage: 根据地址获取地址对应的别名
@@ -260,15 +478,58 @@ func (chain *ChainApi) GetAddressByAlias(alias string) (*crypto.CommonAddress, e
  usage: 根据txhash获取receipt信息
  params:
 	1. txhash
- return: receipt
+ return: receipt，Status: 1成功 2合约revert(RevertReason为revert数据) 3gas耗尽 0合约执行出错(FailReason为错误信息)
  example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getReceipt","params":["0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"], "id": 3}' -H "Content-Type:application/json"
  response:
-   {"jsonrpc":"2.0","id":3,"result":""}
+   {"jsonrpc":"2.0","id":3,"result":{"PostState":"0x","Status":2,"CumulativeGasUsed":24530,"Logs":[],"TxHash":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9","ContractAddress":"0x0000000000000000000000000000000000000000","GasUsed":24530,"BlockHash":"0x2b6e3a3a41e8e1a9a6c0b9f2a9e2b6d0f1d1e0c9b8a7f6e5d4c3b2a1f0e9d8c7","BlockNumber":1024,"RevertReason":"0x08c379a0","FailReason":"evm: execution reverted"}}
 */
 func (chain *ChainApi) GetReceipt(txHash crypto.Hash) *types.Receipt {
 	return chain.dbQuery.GetReceipt(txHash)
 }
 
+/*
+ name: getReceiptProof
+ usage: 获取receipt在区块ReceiptRoot中的包含证明
+ params:
+	1. 交易hash
+ return: receipt，所在区块高度、ReceiptRoot以及默克尔认证路径，可用chain.VerifyReceiptProof验证
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getReceiptProof","params":["0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":{"Receipt":{"PostState":"0x","Status":1,"CumulativeGasUsed":21000,"Logs":[],"TxHash":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9","ContractAddress":"0x0000000000000000000000000000000000000000","GasUsed":21000,"BlockHash":"0x2b6e3a3a41e8e1a9a6c0b9f2a9e2b6d0f1d1e0c9b8a7f6e5d4c3b2a1f0e9d8c7","BlockNumber":1024,"RevertReason":"0x","FailReason":""},"Height":1024,"ReceiptRoot":"0x5b1c3e9a4f7d2c6b8a0e1f3d5c7b9a2e4f6d8c0b1a3e5f7d9c2b4a6e8f0d1c3b","Proof":{"Index":1,"Siblings":["0x9e1f3a5c7b2d4e6f8a0c1b3d5e7f9a2c4b6d8e0f1a3c5b7d9e2f4a6c8b0d1e3f"]}}}
+*/
+func (chain *ChainApi) GetReceiptProof(txHash crypto.Hash) (*ReceiptProof, error) {
+	receipt := chain.dbQuery.GetReceipt(txHash)
+	if receipt == nil {
+		return nil, ErrReceiptNotFound
+	}
+	header, err := chain.dbQuery.GetBlockHeader(&receipt.BlockHash)
+	if err != nil {
+		return nil, ErrBlockNotFound
+	}
+	receipts := chain.dbQuery.GetReceipts(receipt.BlockHash)
+	leaves := make([][]byte, len(receipts))
+	index := -1
+	for i, r := range receipts {
+		leaves[i] = receiptLeaf(r)
+		if r.TxHash == txHash {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, ErrReceiptNotFound
+	}
+	merkleProof, err := common.NewMerkle(leaves).Proof(index)
+	if err != nil {
+		return nil, err
+	}
+	return &ReceiptProof{
+		Receipt:     receipts[index],
+		Height:      header.Height,
+		ReceiptRoot: header.ReceiptRoot,
+		Proof:       merkleProof,
+	}, nil
+}
+
 /*
  name: getLogs
  usage: 根据txhash获取交易log信息
@@ -330,19 +591,183 @@ func (chain *ChainApi) GetCancelCreditDetail(txHash crypto.Hash) []*types.Cancel
 	return nil
 }
 
+/*
+ name: getBatchReceipts
+ usage: 根据txhash获取批量交易中每个操作的执行结果
+ params:
+	1. txhash
+ return: []
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getBatchReceipts","params":["0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":[{"Index":0,"Type":0,"GasUsed":0,"Result":"0x"},{"Index":1,"Type":4,"GasUsed":0,"Result":"0x"}]}
+*/
+func (chain *ChainApi) GetBatchReceipts(txHash crypto.Hash) []*types.BatchOperationReceipt {
+	rt := chain.dbQuery.GetReceipt(txHash)
+	if rt == nil {
+		return nil
+	}
+	receipts := make([]*types.BatchOperationReceipt, 0)
+	for _, log := range rt.Logs {
+		if log.TxType == types.BatchType {
+			receipt := &types.BatchOperationReceipt{}
+			err := json.Unmarshal(log.Data, receipt)
+			if err == nil {
+				receipts = append(receipts, receipt)
+			}
+		}
+	}
+	return receipts
+}
+
 /*
  name: getByteCode
  usage: 根据地址获取bytecode
  params:
 	1. 地址
- return: bytecode
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: bytecode，区块状态已被裁剪时返回错误
  example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getByteCode","params":["0x8a8e541ddd1272d53729164c70197221a3c27486"], "id": 3}' -H "Content-Type:application/json"
  response:
    {"jsonrpc":"2.0","id":3,"result":"0x00"}
 */
-func (chain *ChainApi) GetByteCode(addr *crypto.CommonAddress) hexutil.Bytes {
-	trieQuery, _ := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
-	return trieQuery.GetByteCode(addr)
+func (chain *ChainApi) GetByteCode(addr *crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) (hexutil.Bytes, error) {
+	trieQuery, err := chain.stateQuery(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetByteCode(addr), nil
+}
+
+// stateQuery open the state of the block selected by blockNrOrHash, nil selects the tip
+func (chain *ChainApi) stateQuery(blockNrOrHash *types.BlockNumberOrHash) (*TrieQuery, error) {
+	header, err := stateHeader(chain.dbQuery, chain.chainView, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return NewTrieQuery(chain.store, header.StateRoot)
+}
+
+/*
+ name: getStorageAt
+ usage: 根据合约地址和slot获取合约storage中的值
+ params:
+	1. 合约地址
+	2. slot
+ return: slot中存储的值
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getStorageAt","params":["0xecfb51e10aa4c146bf6c12eee090339c99841efc","0x0"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":"0x01"}
+*/
+func (chain *ChainApi) GetStorageAt(addr *crypto.CommonAddress, slot hexutil.Big) (hexutil.Bytes, error) {
+	trieQuery, err := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetContractState(addr, slot.ToInt().Bytes())
+}
+
+/*
+ name: getMultiSigAccount
+ usage: 根据地址获取多签账户的公钥集合和签名阈值
+ params:
+	1. 多签账户地址
+ return: 多签账户信息，非多签账户返回null
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getMultiSigAccount","params":["0x2a4a5a6e3eb0ae1d4a7e1d4e0ab0aad58db5a4a6"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":{"Threshold":2,"PubKeys":["0x03177b8e4ef31f4f801ce00260db1b04cc501287e828692a404fdbc46c7ad6ff26","0x02e5a3b1f0f9d6e3e8b1f6e6c55b5d5c3b9dbe3c6ba4db3c4bfa7a2d0a2c8f3b1a","0x0373c1d2ab4f0b2c1e6e5d5f3e9d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c"]}}
+*/
+func (chain *ChainApi) GetMultiSigAccount(addr crypto.CommonAddress) (*types.MultiSigAccount, error) {
+	trieQuery, err := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetMultiSigAccount(&addr), nil
+}
+
+/*
+ name: getTimeLock
+ usage: 根据锁定id获取未到期的锁定
+ params:
+	1. 锁定id
+ return: 锁定信息，已到期释放或已撤销返回null
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getTimeLock","params":["0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":{"Id":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9","From":"0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","To":"0x300fc5a14e578be28c64627c0e7e321771c58cd4","Lock":{"CreditHeight":10000,"CreditValue":"0x111"},"UnlockTime":0,"Revocable":true}}
+*/
+func (chain *ChainApi) GetTimeLock(id crypto.Hash) (*types.TimeLock, error) {
+	trieQuery, err := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetTimeLock(&id)
+}
+
+/*
+ name: getTimeLocks
+ usage: 获取地址发出或接收的所有未到期锁定
+ params:
+	1. 地址
+ return: 锁定列表
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getTimeLocks","params":["0x300fc5a14e578be28c64627c0e7e321771c58cd4"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":[{"Id":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9","From":"0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","To":"0x300fc5a14e578be28c64627c0e7e321771c58cd4","Lock":{"CreditHeight":10000,"CreditValue":"0x111"},"UnlockTime":0,"Revocable":true}]}
+*/
+func (chain *ChainApi) GetTimeLocks(addr crypto.CommonAddress) ([]*types.TimeLock, error) {
+	trieQuery, err := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := trieQuery.GetTimeLockIds(&addr)
+	if err != nil {
+		return nil, err
+	}
+	locks := []*types.TimeLock{}
+	for _, id := range ids {
+		lock, err := trieQuery.GetTimeLock(&id)
+		if err != nil {
+			return nil, err
+		}
+		if lock != nil {
+			locks = append(locks, lock)
+		}
+	}
+	return locks, nil
+}
+
+/*
+ name: getAliasLease
+ usage: 获取租用中别名的持有人以及到期高度
+ params:
+	1. 别名
+ return: 租用信息，别名未被租用（永久设置或不存在）返回null
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getAliasLease","params":["tom123"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":{"Alias":"tom123","Owner":"0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","ExpireHeight":6230800}}
+*/
+func (chain *ChainApi) GetAliasLease(alias string) (*types.AliasLease, error) {
+	trieQuery, err := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetAliasLease(alias)
+}
+
+/*
+ name: getAliasHistory
+ usage: 获取别名的所有变更记录，包括设置、转移、释放、租用、续租以及到期
+ params:
+	1. 别名
+ return: 按发生顺序排列的变更记录，Action: 0设置 1转移 2释放 3租用 4续租 5到期
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getAliasHistory","params":["tom123"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":[{"Alias":"tom123","Owner":"0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","Action":0,"Height":10000,"TxHash":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"},{"Alias":"tom123","Owner":"0x300fc5a14e578be28c64627c0e7e321771c58cd4","Action":1,"Height":10020,"TxHash":"0x1a3b2f8e4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7"}]}
+*/
+func (chain *ChainApi) GetAliasHistory(alias string) ([]*types.AliasRecord, error) {
+	trieQuery, err := NewTrieQuery(chain.store, chain.chainView.Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return trieQuery.GetAliasHistory(alias)
 }
 
 /*
@@ -375,6 +800,52 @@ func (chain *ChainApi) GetCancelCreditDetails(addr *crypto.CommonAddress) string
 	return trieQuery.GetCancelCreditDetails(addr)
 }
 
+/*
+ name: getVoteDetails
+ usage: 获取地址投出的所有有效票，包括给自己的抵押，以及撤销后到期时取回的金额。升级前的区块在节点启动时从主链补建索引，通过状态同步启动的节点不包含同步点之前区块中的投票
+ params:
+	1. 地址
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: 投票列表，MaturityHeight是现在撤销时本金取回的高度。链上不发放利息，Interest为0，ProjectedPayout为本金
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getVoteDetails","params":["0xd05d5f324ada3c418e14cd6b497f2f36d60ba607"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":[{"Candidate":"0x300fc5a14e578be28c64627c0e7e321771c58cd4","Amount":"0x3635c9adc5dea00000","Height":1329,"Interest":"0x0","MaturityHeight":10100,"ProjectedPayout":"0x3635c9adc5dea00000"}]}
+*/
+func (chain *ChainApi) GetVoteDetails(addr crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) ([]*types.VoteDetail, error) {
+	header, err := stateHeader(chain.dbQuery, chain.chainView, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	store, err := store.TrieStoreFromStore(chain.store, header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return store.GetVoteDetails(&addr, chain.dbQuery.GetVotedAddrs(&addr), header.Height)
+}
+
+/*
+ name: getUnbondingDetails
+ usage: 获取地址已撤销、还没有退回余额的抵押和到期高度
+ params:
+	1. 地址
+	2. 区块高度或区块hash（可选，默认为最新区块）
+ return: 撤销列表，到达MaturityHeight后金额计入余额
+ example: curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"chain_getUnbondingDetails","params":["0xd05d5f324ada3c418e14cd6b497f2f36d60ba607"], "id": 3}' -H "Content-Type:application/json"
+ response:
+   {"jsonrpc":"2.0","id":3,"result":[{"Height":10000,"Amount":"0x3635c9adc5dea00000","MaturityHeight":10100,"Matured":false}]}
+*/
+func (chain *ChainApi) GetUnbondingDetails(addr crypto.CommonAddress, blockNrOrHash *types.BlockNumberOrHash) ([]*types.UnbondingDetail, error) {
+	header, err := stateHeader(chain.dbQuery, chain.chainView, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	store, err := store.TrieStoreFromStore(chain.store, header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	return store.GetUnbondingDetails(&addr, header.Height)
+}
+
 /*
  name: GetCandidateAddrs
  usage: 获取所有候选节点地址和对应的信任值
@@ -527,6 +998,81 @@ func (trieQuery *TrieQuery) GetCodeHash(addr *crypto.CommonAddress) crypto.Hash
 	return storage.CodeHash
 }
 
+func (trieQuery *TrieQuery) GetContractState(addr *crypto.CommonAddress, key []byte) ([]byte, error) {
+	value, err := trieQuery.trie.TryGet(store.ContractStateKey(addr, key))
+	if err != nil || value != nil {
+		return value, err
+	}
+	return trieQuery.trie.TryGet(store.LegacyContractStateKey(addr, key))
+}
+
+func (trieQuery *TrieQuery) GetMultiSigAccount(addr *crypto.CommonAddress) *types.MultiSigAccount {
+	value, err := trieQuery.trie.TryGet(store.MultiSigKey(addr))
+	if err != nil || value == nil {
+		return nil
+	}
+	account := &types.MultiSigAccount{}
+	if err := binary.Unmarshal(value, account); err != nil {
+		return nil
+	}
+	return account
+}
+
+func (trieQuery *TrieQuery) GetTimeLock(id *crypto.Hash) (*types.TimeLock, error) {
+	value, err := trieQuery.trie.TryGet(sha3.Keccak256([]byte(store.TimeLockStorage + id.String())))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	lock := &types.TimeLock{}
+	err = binary.Unmarshal(value, lock)
+	if err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+func (trieQuery *TrieQuery) GetTimeLockIds(addr *crypto.CommonAddress) ([]crypto.Hash, error) {
+	value, err := trieQuery.trie.TryGet(store.TimeLockAddrKey(addr))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	ids := []crypto.Hash{}
+	err = binary.Unmarshal(value, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (trieQuery *TrieQuery) GetAliasLease(alias string) (*types.AliasLease, error) {
+	value, err := trieQuery.trie.TryGet(sha3.Keccak256([]byte(store.AliasLeaseStorage + alias)))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	lease := &types.AliasLease{}
+	err = binary.Unmarshal(value, lease)
+	if err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+func (trieQuery *TrieQuery) GetAliasHistory(alias string) ([]*types.AliasRecord, error) {
+	value, err := trieQuery.trie.TryGet(sha3.Keccak256([]byte(store.AliasHistory + alias)))
+	if err != nil {
+		return nil, err
+	}
+	records := []*types.AliasRecord{}
+	if value == nil {
+		return records, nil
+	}
+	err = binary.Unmarshal(value, &records)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
 func (trieQuery *TrieQuery) GetReputation(addr *crypto.CommonAddress) *big.Int {
 	storage, _ := trieQuery.GetStorage(addr)
 	return &storage.Reputation
@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestVoteAndUnbondingDetails(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	from := crypto.PubkeyToAddress(tester.priv.PubKey())
+	candidate := crypto.CommonAddress{1}
+
+	vote := tester.sign(tester.priv, types.NewVoteTransaction(candidate, big.NewInt(500000000), big.NewInt(1), big.NewInt(30000), 0))
+	b1 := tester.makeBlock(chainService.genesisBlock.Header, 1, vote)
+	cancel := tester.sign(tester.priv, types.NewCancelVoteTransaction(candidate, big.NewInt(200000000), big.NewInt(1), big.NewInt(30000), 1))
+	b2 := tester.makeBlock(b1.Header, 1, cancel)
+	tester.process(chainService, b1, b2)
+
+	api := NewChainApi(chainService.DatabaseService.LevelDb(), chainService.BestChain(), chainService.chainStore, chainService.profiler)
+	votes, err := api.GetVoteDetails(from, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(votes) != 1 {
+		t.Fatalf("have %d votes, want 1", len(votes))
+	}
+	v := votes[0]
+	if v.Candidate != candidate || v.Amount.ToInt().Int64() != 300000000 || v.Height != 1 || v.MaturityHeight != 2+100 {
+		t.Fatalf("unexpected vote %+v", v)
+	}
+	//the chain pays no interest
+	if v.Interest.ToInt().Sign() != 0 || v.ProjectedPayout.ToInt().Cmp(v.Amount.ToInt()) != 0 {
+		t.Fatalf("interest %v payout %v, want 0 %v", v.Interest.ToInt(), v.ProjectedPayout.ToInt(), v.Amount.ToInt())
+	}
+
+	unbondings, err := api.GetUnbondingDetails(from, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unbondings) != 1 {
+		t.Fatalf("have %d unbondings, want 1", len(unbondings))
+	}
+	u := unbondings[0]
+	if u.Height != 2 || u.Amount.ToInt().Int64() != 200000000 || u.MaturityHeight != 102 || u.Matured {
+		t.Fatalf("unexpected unbonding %+v", u)
+	}
+
+	//the last of the vote is cancelled
+	b3 := tester.makeBlock(b2.Header, 1, tester.sign(tester.priv, types.NewCancelVoteTransaction(candidate, big.NewInt(300000000), big.NewInt(1), big.NewInt(30000), 2)))
+	tester.process(chainService, b3)
+	if votes, _ = api.GetVoteDetails(from, nil); len(votes) != 0 {
+		t.Fatalf("have %d votes after cancel, want 0", len(votes))
+	}
+	if unbondings, _ = api.GetUnbondingDetails(from, nil); len(unbondings) != 2 {
+		t.Fatalf("have %d unbondings, want 2", len(unbondings))
+	}
+
+	//votes of batch operations to an address not a candidate are found through the index of the blocks
+	other := crypto.CommonAddress{2}
+	batch, err := types.NewBatchTransaction([]types.BatchOperation{
+		types.NewBatchOperation(types.TransferType, other, big.NewInt(1), nil),
+		types.NewBatchOperation(types.VoteCreditType, other, big.NewInt(100000000), nil),
+	}, big.NewInt(1), big.NewInt(200000), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b4 := tester.makeBlock(b3.Header, 1, tester.sign(tester.priv, batch))
+	tester.process(chainService, b4)
+	votes, err = api.GetVoteDetails(from, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(votes) != 1 || votes[0].Candidate != other || votes[0].Amount.ToInt().Int64() != 100000000 {
+		t.Fatalf("votes of batch %+v", votes)
+	}
+	state, _ := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), b4.Header.StateRoot)
+	if votes, _ := state.GetVoteDetails(&from, nil, 4); len(votes) != 0 {
+		t.Fatal("vote to a non candidate found without the index")
+	}
+}
@@ -0,0 +1,46 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestChainIdFork(t *testing.T) {
+	defer func(height uint64) { params.ChainIdForkHeight = height }(params.ChainIdForkHeight)
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	genesis := chainService.genesisBlock.Header
+	otherChain := chainService.ChainID() + 1
+
+	tx := types.NewTransaction(crypto.CommonAddress{1}, big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0)
+	tx.Data.ChainId = otherChain
+	tester.sign(tester.priv, tx)
+
+	//升级前不检查chainId，交易照常执行
+	block := tester.makeBlock(genesis, 1, tx)
+	header := *block.Header
+	header.ChainId = otherChain
+	validator := NewChainBlockValidator(chainService)
+	if err := validator.VerifyHeader(&header, genesis); err == ErrChainId {
+		t.Fatal("chainId of header checked before fork")
+	}
+
+	params.ChainIdForkHeight = 0
+	if err := validator.VerifyHeader(&header, genesis); err != ErrChainId {
+		t.Fatalf("expect %v, got %v", ErrChainId, err)
+	}
+	trieStore, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), genesis.StateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gp := new(GasPool).AddGas(block.Header.GasLimit.Uint64())
+	context := NewBlockExecuteContext(trieStore, gp, chainService.chainStore, block)
+	if _, _, err := validator.RouteTransaction(context, gp, tx); err != ErrTxChainId {
+		t.Fatalf("expect %v, got %v", ErrTxChainId, err)
+	}
+}
@@ -14,6 +14,9 @@ var (
 	ChainStatePrefix = []byte("chainState_")
 	BlockPrefix      = []byte("block_")
 	BlockNodePrefix  = []byte("blockNode_")
+
+	FinalizedBlockKey = []byte("finalizedBlock")
+	VotesIndexedKey   = []byte("votesIndexed") //主链上所有区块的投票都已经索引
 )
 
 type ChainStore struct {
@@ -27,7 +30,11 @@ func (chainStore *ChainStore) PutReceipt(txHash crypto.Hash, receipt *types.Rece
 	if err != nil {
 		return err
 	}
-	return chainStore.Put(key, value)
+	err = chainStore.Put(key, value)
+	if err != nil {
+		return err
+	}
+	return chainStore.putReceiptFailure(txHash, receipt.Failure())
 }
 
 func (chainStore *ChainStore) GetReceipt(txHash crypto.Hash) *types.Receipt {
@@ -44,6 +51,7 @@ func (chainStore *ChainStore) GetReceipt(txHash crypto.Hash) *types.Receipt {
 	if err != nil {
 		return nil
 	}
+	receipt.SetFailure(chainStore.getReceiptFailure(txHash))
 	return receipt
 }
 
@@ -67,6 +75,11 @@ func (chainStore *ChainStore) GetReceipts(blockHash crypto.Hash) []*types.Receip
 	if err != nil {
 		return make([]*types.Receipt, 0)
 	}
+	for _, receipt := range receipts {
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			receipt.SetFailure(chainStore.getReceiptFailure(receipt.TxHash))
+		}
+	}
 	return receipts
 }
 
@@ -75,6 +88,182 @@ func (chainStore *ChainStore) DeleteReceipts(blockHash crypto.Hash) error {
 	return chainStore.Delete(key)
 }
 
+func (chainStore *ChainStore) DeleteReceipt(txHash crypto.Hash) error {
+	key := sha3.Keccak256([]byte("receipt_" + txHash.String()))
+	err := chainStore.Delete(key)
+	if err != nil {
+		return err
+	}
+	return chainStore.Delete(receiptFailureKey(txHash))
+}
+
+//receipt的失败信息单独存储，不改变receipt原来的编码
+func receiptFailureKey(txHash crypto.Hash) []byte {
+	return sha3.Keccak256([]byte("receiptFailure_" + txHash.String()))
+}
+
+func (chainStore *ChainStore) putReceiptFailure(txHash crypto.Hash, failure *types.ReceiptFailure) error {
+	if failure == nil {
+		return chainStore.Delete(receiptFailureKey(txHash))
+	}
+	value, err := binary.Marshal(failure)
+	if err != nil {
+		return err
+	}
+	return chainStore.Put(receiptFailureKey(txHash), value)
+}
+
+func (chainStore *ChainStore) getReceiptFailure(txHash crypto.Hash) *types.ReceiptFailure {
+	value, err := chainStore.Get(receiptFailureKey(txHash))
+	if err != nil || value == nil {
+		return nil
+	}
+	failure := &types.ReceiptFailure{}
+	err = binary.Unmarshal(value, failure)
+	if err != nil {
+		return nil
+	}
+	return failure
+}
+
+// PutBlockReward save how the reward of a block is distributed
+func (chainStore *ChainStore) PutBlockReward(blockHash crypto.Hash, reward *types.BlockReward) error {
+	key := sha3.Keccak256([]byte("blockReward_" + blockHash.String()))
+	value, err := binary.Marshal(reward)
+	if err != nil {
+		return err
+	}
+	return chainStore.Put(key, value)
+}
+
+func (chainStore *ChainStore) GetBlockReward(blockHash crypto.Hash) *types.BlockReward {
+	key := sha3.Keccak256([]byte("blockReward_" + blockHash.String()))
+	value, err := chainStore.Get(key)
+	if err != nil {
+		return nil
+	}
+	reward := &types.BlockReward{}
+	err = binary.Unmarshal(value, reward)
+	if err != nil {
+		return nil
+	}
+	return reward
+}
+
+func (chainStore *ChainStore) DeleteBlockReward(blockHash crypto.Hash) error {
+	key := sha3.Keccak256([]byte("blockReward_" + blockHash.String()))
+	return chainStore.Delete(key)
+}
+
+// DeleteBlockReceipts remove the receipts and the reward record of a block leaving the main chain, the
+// receipt of a transaction is kept if it is already written again by the block including it in the new chain
+func (chainStore *ChainStore) DeleteBlockReceipts(block *types.Block) error {
+	blockHash := *block.Header.Hash()
+	for _, tx := range block.Data.TxList {
+		receipt := chainStore.GetReceipt(*tx.TxHash())
+		if receipt == nil || receipt.BlockHash != blockHash {
+			continue
+		}
+		err := chainStore.DeleteReceipt(*tx.TxHash())
+		if err != nil {
+			return err
+		}
+	}
+	err := chainStore.DeleteBlockReward(blockHash)
+	if err != nil {
+		return err
+	}
+	return chainStore.DeleteReceipts(blockHash)
+}
+
+func votedAddrsKey(addr *crypto.CommonAddress) []byte {
+	return sha3.Keccak256([]byte("votedAddrs_" + addr.String()))
+}
+
+// GetVotedAddrs return the candidates addr voted in the blocks indexed by IndexVotes, some of the
+// votes may be cancelled or be in blocks not in the main chain
+func (chainStore *ChainStore) GetVotedAddrs(addr *crypto.CommonAddress) []crypto.CommonAddress {
+	value, err := chainStore.Get(votedAddrsKey(addr))
+	if err != nil {
+		return nil
+	}
+	addrs := []crypto.CommonAddress{}
+	err = binary.Unmarshal(value, &addrs)
+	if err != nil {
+		return nil
+	}
+	return addrs
+}
+
+// VotesIndexed report whether the votes of the blocks connected before IndexVotes existed are indexed
+func (chainStore *ChainStore) VotesIndexed() bool {
+	has, err := chainStore.Has(VotesIndexedKey)
+	return err == nil && has
+}
+
+func (chainStore *ChainStore) PutVotesIndexed() error {
+	return chainStore.Put(VotesIndexedKey, []byte{1})
+}
+
+// IndexVotes record the candidates voted by the vote and redelegate txs of block, the batch
+// operations included. Addresses are never removed, so the index covers the votes in the state of
+// every block and the votes are read from the stake of the candidates
+func (chainStore *ChainStore) IndexVotes(block *types.Block) error {
+	voted := make(map[crypto.CommonAddress][]crypto.CommonAddress)
+	addVote := func(tx *types.Transaction, from *crypto.CommonAddress) {
+		if tx.Type() == types.VoteCreditType || tx.Type() == types.RedelegateType {
+			voted[*from] = append(voted[*from], *tx.To())
+		}
+	}
+	for _, tx := range block.Data.TxList {
+		from, err := tx.From()
+		if err != nil {
+			continue
+		}
+		addVote(tx, from)
+		if tx.Type() != types.BatchType {
+			continue
+		}
+		payload, err := tx.DecodePayload()
+		if err != nil {
+			continue
+		}
+		batch := payload.(*types.BatchData)
+		for i := range batch.Operations {
+			addVote(batch.SubTransaction(tx, i), from)
+		}
+	}
+
+	for from, candidates := range voted {
+		from := from
+		addrs := chainStore.GetVotedAddrs(&from)
+		seen := make(map[crypto.CommonAddress]bool)
+		for _, addr := range addrs {
+			seen[addr] = true
+		}
+		changed := false
+		for _, candidate := range candidates {
+			if !seen[candidate] {
+				seen[candidate] = true
+				addrs = append(addrs, candidate)
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		value, err := binary.Marshal(addrs)
+		if err != nil {
+			return err
+		}
+		err = chainStore.Put(votedAddrsKey(&from), value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (chainStore *ChainStore) PutBlock(block *types.Block) error {
 	hash := block.Header.Hash()
 	key := append(BlockPrefix, hash[:]...)
@@ -153,6 +342,20 @@ func (chainStore *ChainStore) GetBlockNode(hash *crypto.Hash, height uint64) (*t
 	return blockHeader, types.BlockStatus(status), nil
 }
 
+func (chainStore *ChainStore) PutFinalizedHash(hash *crypto.Hash) error {
+	return chainStore.Put(FinalizedBlockKey, hash[:])
+}
+
+// GetFinalizedHash return the hash of the highest final block in the main chain
+func (chainStore *ChainStore) GetFinalizedHash() (*crypto.Hash, error) {
+	value, err := chainStore.Get(FinalizedBlockKey)
+	if err != nil {
+		return nil, err
+	}
+	hash := crypto.BytesToHash(value)
+	return &hash, nil
+}
+
 func (chainStore *ChainStore) BlockNodeCount() int64 {
 	count := int64(64)
 	iter := chainStore.NewIteratorWithPrefix(BlockNodePrefix)
@@ -198,6 +401,14 @@ func (chainStore *ChainStore) RollBack(height uint64, hash *crypto.Hash) (error,
 		return err, 0
 	}
 
+	//删除收据
+	if block, getErr := chainStore.GetBlock(hash); getErr == nil {
+		err = chainStore.DeleteBlockReceipts(block)
+		if err != nil {
+			return err, 0
+		}
+	}
+
 	//删除block
 	func() {
 		key := append(BlockPrefix, hash[:]...)
@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestReceiptFailureStore(t *testing.T) {
+	tester := newReorgTester(t)
+	chainStore := tester.newChain().chainStore
+
+	txHash, blockHash := crypto.Hash{1}, crypto.Hash{2}
+	receipt := &types.Receipt{Status: types.ReceiptStatusReverted, TxHash: txHash, BlockHash: blockHash, RevertReason: []byte{1}, FailReason: "evm: execution reverted"}
+	if err := chainStore.PutReceipt(txHash, receipt); err != nil {
+		t.Fatal(err)
+	}
+	if err := chainStore.PutReceipts(blockHash, []*types.Receipt{receipt}); err != nil {
+		t.Fatal(err)
+	}
+	check := func(got *types.Receipt) {
+		if got == nil || !bytes.Equal(got.RevertReason, []byte{1}) || got.FailReason != receipt.FailReason {
+			t.Fatalf("failure information lost, got %v", got)
+		}
+	}
+	check(chainStore.GetReceipt(txHash))
+	check(chainStore.GetReceipts(blockHash)[0])
+
+	//同一交易在另一分支执行成功，失败信息被清除
+	succeeded := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: txHash, BlockHash: crypto.Hash{3}}
+	if err := chainStore.PutReceipt(txHash, succeeded); err != nil {
+		t.Fatal(err)
+	}
+	if got := chainStore.GetReceipt(txHash); got == nil || got.FailReason != "" || got.RevertReason != nil {
+		t.Fatalf("stale failure information, got %v", got)
+	}
+}
+
+//升级前连接的区块在启动时从主链补建投票索引
+func TestIndexMainChainVotes(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	from := crypto.PubkeyToAddress(tester.priv.PubKey())
+	candidateA, candidateB := crypto.CommonAddress{1}, crypto.CommonAddress{2}
+	gasPrice, gasLimit := big.NewInt(1), big.NewInt(30000)
+
+	b1 := tester.makeBlock(chainService.genesisBlock.Header, 1, tester.sign(tester.priv, types.NewVoteTransaction(candidateA, big.NewInt(100), gasPrice, gasLimit, 0)))
+	b2 := tester.makeBlock(b1.Header, 1, tester.sign(tester.priv, types.NewVoteTransaction(candidateB, big.NewInt(100), gasPrice, gasLimit, 1)))
+	tester.process(chainService, b1, b2)
+	if !chainService.chainStore.VotesIndexed() {
+		t.Fatal("votes of new database not marked indexed")
+	}
+
+	//模拟升级前的数据库
+	chainStore := chainService.chainStore
+	if err := chainStore.Delete(votedAddrsKey(&from)); err != nil {
+		t.Fatal(err)
+	}
+	if err := chainStore.Delete(VotesIndexedKey); err != nil {
+		t.Fatal(err)
+	}
+	if addrs := chainStore.GetVotedAddrs(&from); len(addrs) != 0 {
+		t.Fatalf("unexpected voted addrs %v", addrs)
+	}
+	if err := chainService.indexMainChainVotes(); err != nil {
+		t.Fatal(err)
+	}
+	addrs := chainStore.GetVotedAddrs(&from)
+	if len(addrs) != 2 || addrs[0] != candidateA || addrs[1] != candidateB {
+		t.Fatalf("voted addrs %v, want %v", addrs, []crypto.CommonAddress{candidateA, candidateB})
+	}
+	if !chainStore.VotesIndexed() {
+		t.Fatal("votes not marked indexed")
+	}
+}
@@ -5,9 +5,19 @@ import (
 	"github.com/drep-project/DREP-Chain/types"
 )
 
+const (
+	GCModeArchive = "archive" //保留所有区块的状态，供浏览器等查询历史状态
+	GCModeFull    = "full"    //只保留最近StateRetention个区块的状态，更早的状态节点被回收
+
+	DefaultStateRetention = 128
+)
+
 type ChainConfig struct {
-	RemotePort  int                  `json:"remoteport"`
-	RootChain   types.ChainIdType    `json:"rootChain,omitempty"`
-	ChainId     types.ChainIdType    `json:"chainId,omitempty"`
-	GenesisAddr crypto.CommonAddress `json:"genesisaddr"`
+	RemotePort     int                  `json:"remoteport"`
+	RootChain      types.ChainIdType    `json:"rootChain,omitempty"`
+	ChainId        types.ChainIdType    `json:"chainId,omitempty"`
+	GenesisAddr    crypto.CommonAddress `json:"genesisaddr"`
+	GCMode         string               `json:"gcmode,omitempty"`         //状态存储模式 archive/full
+	StateRetention uint64               `json:"stateretention,omitempty"` //full模式下保留状态的区块数
+	ParallelExec   bool                 `json:"parallelexec,omitempty"`   //并行执行块中的交易，结果与顺序执行相同
 }
@@ -21,6 +21,7 @@ var (
 	ErrNotMathcedStateRoot       = errors.New("state root not matched")
 	ErrGasUsed                   = errors.New("gasRemained used not matched")
 	ErrChainId                   = errors.New("chainId not matched")
+	ErrTxChainId                 = errors.New("transaction chainId not matched, maybe signed for another chain")
 	ErrVersion                   = errors.New("version not matched")
 	ErrPreHash                   = errors.New("previous hash not matched")
 	ErrBlockExsist               = errors.New("already have block")
@@ -34,7 +35,27 @@ var (
 	ErrTooShortAlias             = errors.New("alias too short")
 	ErrTooLongAlias              = errors.New("alias too long")
 	ErrUnsupportAliasChar        = errors.New("alias only support number and letter")
+	ErrNoAlias                   = errors.New("sender has no alias")
+	ErrAliasTransferSelf         = errors.New("can not transfer alias to self")
+	ErrAliasNotLeased            = errors.New("alias is not leased, can not renew")
 	ErrReceiptRoot               = errors.New("receipt root not match")
+	ErrBatchOperationFail        = errors.New("operation in batch execute fail")
+	ErrMultiSigAccountExist      = errors.New("multisig account already registered")
+	ErrNotMultiSigAccount        = errors.New("sender is not a multisig account")
+	ErrTimeLockMatured           = errors.New("time lock already matured")
+	ErrTimeLockAmount            = errors.New("time lock amount must be positive")
+	ErrTimeLockNotFound          = errors.New("time lock not found or released")
+	ErrTimeLockNotOwner          = errors.New("time lock not created by sender")
+	ErrTimeLockIrrevocable       = errors.New("time lock is not revocable")
+	ErrTxExpired                 = errors.New("transaction expired, valid until height has passed")
+	ErrReceiptNotFound           = errors.New("receipt not found")
+	ErrInvalidProof              = errors.New("proof not match the root")
+	ErrStatePruned               = errors.New("state of the block is pruned, query a recent block or an archive node")
+	ErrStateNotSynced            = errors.New("state of the block is not completely downloaded")
+	ErrReorgFinalized            = errors.New("block forks the chain below the finalized block")
+	ErrNoExecutionStats          = errors.New("execution stats not recorded, the block is not executed recently by this node")
+	ErrRedelegateSameCandidate   = errors.New("redelegate to the candidate credit is moved from")
+	ErrRedelegateSelf            = errors.New("can not redelegate the credit of candidate self")
 
 	ErrNoStorage   = errors.New("no account storage found")
 	ErrKeyNotFound = errors.New("key not found")
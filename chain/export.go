@@ -0,0 +1,191 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/drep-project/DREP-Chain/app"
+	"github.com/drep-project/DREP-Chain/types"
+	drepbinary "github.com/drep-project/binary"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	//每个导出的区块前有4字节长度，之后为binary编码的区块
+	maxExportBlockSize = 64 * 1024 * 1024
+	exportLogInterval  = 1000
+)
+
+var (
+	exportFileMagic = []byte("DREPBLKS")
+
+	ErrExportFileFormat = errors.New("not a drep block export file")
+)
+
+var (
+	ExportStartFlag = cli.Uint64Flag{
+		Name:  "start",
+		Usage: "height of the first block to export",
+		Value: 1,
+	}
+	ExportEndFlag = cli.Uint64Flag{
+		Name:  "end",
+		Usage: "height of the last block to export, default the current height",
+	}
+	ImportTrustedFlag = cli.BoolFlag{
+		Name:  "trusted",
+		Usage: "the file is trusted, skip re-verifying block signatures while importing",
+	}
+
+	exportCommand = cli.Command{
+		Name:      "export",
+		Usage:     "Export blocks in height order to a file",
+		ArgsUsage: "<filename>",
+		Flags:     []cli.Flag{ExportStartFlag, ExportEndFlag},
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+Blocks are written in height order with the binary encoding of the chain, the
+file can be imported by another node to bootstrap without syncing from peers.`,
+	}
+	importCommand = cli.Command{
+		Name:      "import",
+		Usage:     "Import blocks from an export file",
+		ArgsUsage: "<filename>",
+		Flags:     []cli.Flag{ImportTrustedFlag},
+		Category:  "BLOCKCHAIN COMMANDS",
+		Description: `
+Every block is processed as if received from peers, blocks already in the chain
+are skipped. With --trusted the signatures of blocks are not verified again, all
+transactions are still executed and the state roots checked.`,
+	}
+)
+
+// runCommand execute export and import commands, and quit the app when finished
+func (chainService *ChainService) runCommand(executeContext *app.ExecuteContext) error {
+	ctx := executeContext.Cli
+	if ctx.NArg() < 1 {
+		return fmt.Errorf("%s need a file name", ctx.Command.Name)
+	}
+	var err error
+	switch ctx.Command.Name {
+	case exportCommand.Name:
+		end := chainService.BestChain().Height()
+		if ctx.IsSet(ExportEndFlag.Name) {
+			end = ctx.Uint64(ExportEndFlag.Name)
+		}
+		err = chainService.ExportChain(ctx.Args().First(), ctx.Uint64(ExportStartFlag.Name), end)
+	case importCommand.Name:
+		err = chainService.ImportChain(ctx.Args().First(), ctx.Bool(ImportTrustedFlag.Name))
+	}
+	if err != nil {
+		return err
+	}
+	close(executeContext.Quit)
+	return nil
+}
+
+// ExportChain write the blocks of the main chain from start to end height to file
+func (chainService *ChainService) ExportChain(fileName string, start, end uint64) error {
+	if end > chainService.BestChain().Height() {
+		end = chainService.BestChain().Height()
+	}
+	if start > end {
+		return fmt.Errorf("export start %d beyond end %d", start, end)
+	}
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+	_, err = writer.Write(exportFileMagic)
+	if err != nil {
+		return err
+	}
+
+	lenBuf := make([]byte, 4)
+	for height := start; height <= end; height++ {
+		block, err := chainService.GetBlockByHeight(height)
+		if err != nil {
+			return fmt.Errorf("export block %d: %v", height, err)
+		}
+		data, err := drepbinary.Marshal(block)
+		if err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint32(lenBuf, uint32(len(data)))
+		if _, err = writer.Write(lenBuf); err != nil {
+			return err
+		}
+		if _, err = writer.Write(data); err != nil {
+			return err
+		}
+		if height%exportLogInterval == 0 {
+			log.WithField("Height", height).Info("exporting blocks")
+		}
+	}
+	err = writer.Flush()
+	if err != nil {
+		return err
+	}
+	log.WithField("start", start).WithField("end", end).WithField("file", fileName).Info("export blocks finished")
+	return nil
+}
+
+// ImportChain process the blocks in an export file, blocks already in the chain are skipped.
+// A trusted file skip the signature checks of consensus, blocks are still executed.
+func (chainService *ChainService) ImportChain(fileName string, trusted bool) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	magic := make([]byte, len(exportFileMagic))
+	if _, err = io.ReadFull(reader, magic); err != nil || !bytes.Equal(magic, exportFileMagic) {
+		return ErrExportFileFormat
+	}
+
+	imported, skipped := 0, 0
+	lenBuf := make([]byte, 4)
+	for {
+		_, err = io.ReadFull(reader, lenBuf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		size := binary.BigEndian.Uint32(lenBuf)
+		if size > maxExportBlockSize {
+			return ErrExportFileFormat
+		}
+		data := make([]byte, size)
+		if _, err = io.ReadFull(reader, data); err != nil {
+			return err
+		}
+		block := &types.Block{}
+		if err = drepbinary.Unmarshal(data, block); err != nil {
+			return err
+		}
+		if chainService.BlockExists(block.Header.Hash()) {
+			skipped++
+			continue
+		}
+		_, _, err = chainService.processBlock(block, trusted)
+		if err != nil {
+			return fmt.Errorf("import block %d: %v", block.Header.Height, err)
+		}
+		imported++
+		if block.Header.Height%exportLogInterval == 0 {
+			log.WithField("Height", block.Header.Height).Info("importing blocks")
+		}
+	}
+	log.WithField("imported", imported).WithField("skipped", skipped).WithField("Height", chainService.BestChain().Height()).Info("import blocks finished")
+	return nil
+}
@@ -0,0 +1,130 @@
+package chain
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+//rejectBodyValidator stand for the validator of consensus, the body of every block fails its check
+type rejectBodyValidator struct{}
+
+func (*rejectBodyValidator) VerifyHeader(header, parent *types.BlockHeader) error { return nil }
+func (*rejectBodyValidator) VerifyBody(block *types.Block) error {
+	return errors.New("signature rejected")
+}
+func (*rejectBodyValidator) ExecuteBlock(context *BlockExecuteContext) error { return nil }
+
+func exportTestBlocks(tester *reorgTester) (*ChainService, []*types.Block) {
+	chainService := tester.newChain()
+	blocks := []*types.Block{}
+	parent := chainService.genesisBlock.Header
+	for i := uint64(0); i < 4; i++ {
+		block := tester.makeBlock(parent, 1, tester.transfer(i, crypto.CommonAddress{byte(i + 1)}))
+		blocks = append(blocks, block)
+		parent = block.Header
+	}
+	tester.process(chainService, blocks...)
+	return chainService, blocks
+}
+
+func TestExportImportChain(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "drep-export")
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "blocks")
+
+	tester := newReorgTester(t)
+	source, blocks := exportTestBlocks(tester)
+	if err := source.ExportChain(fileName, 1, source.BestChain().Height()); err != nil {
+		t.Fatal(err)
+	}
+
+	chainService := tester.newChain()
+	if err := chainService.ImportChain(fileName, false); err != nil {
+		t.Fatal(err)
+	}
+	tester.checkState(chainService, blocks, crypto.CommonAddress{1}, crypto.CommonAddress{4})
+	for _, block := range blocks {
+		tester.checkReceipts(chainService, block, true)
+	}
+	//blocks already in the chain are skipped
+	if err := chainService.ImportChain(fileName, false); err != nil {
+		t.Fatal(err)
+	}
+
+	//the body check of consensus is skipped only for a trusted file
+	chainService = tester.newChain()
+	chainService.AddBlockValidator(&rejectBodyValidator{})
+	if err := chainService.ImportChain(fileName, false); err == nil {
+		t.Fatal("blocks with rejected signatures imported from an untrusted file")
+	}
+	if chainService.BestChain().Height() != 0 {
+		t.Fatalf("untrusted import stopped at height %d, want 0", chainService.BestChain().Height())
+	}
+	if err := chainService.ImportChain(fileName, true); err != nil {
+		t.Fatal(err)
+	}
+	tester.checkState(chainService, blocks)
+	//later blocks from peers are verified again
+	next := tester.makeBlock(blocks[len(blocks)-1].Header, 1, tester.transfer(4, crypto.CommonAddress{5}))
+	if _, _, err := chainService.ProcessBlock(next); err == nil {
+		t.Fatal("block with rejected signature accepted after trusted import")
+	}
+}
+
+func TestImportCorruptedChain(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "drep-export")
+	defer os.RemoveAll(dir)
+	fileName := filepath.Join(dir, "blocks")
+
+	tester := newReorgTester(t)
+	source, blocks := exportTestBlocks(tester)
+	if err := source.ExportChain(fileName, 1, source.BestChain().Height()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	importData := func(data []byte) (*ChainService, error) {
+		corrupted := filepath.Join(dir, "corrupted")
+		if err := ioutil.WriteFile(corrupted, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		chainService := tester.newChain()
+		return chainService, chainService.ImportChain(corrupted, false)
+	}
+
+	//not an export file
+	if _, err := importData(append([]byte("NOTBLOCK"), data[len(exportFileMagic):]...)); err != ErrExportFileFormat {
+		t.Fatalf("import file with wrong magic, err %v", err)
+	}
+
+	//the last block is truncated, the blocks before it are kept
+	chainService, err := importData(data[:len(data)-10])
+	if err == nil {
+		t.Fatal("truncated file imported")
+	}
+	tester.checkState(chainService, blocks[:len(blocks)-1])
+
+	//a byte of the last block is changed, the block does not match its header any more
+	changed := append([]byte{}, data...)
+	changed[len(changed)-1] ^= 0xff
+	chainService, err = importData(changed)
+	if err == nil {
+		t.Fatal("corrupted block imported")
+	}
+	tester.checkState(chainService, blocks[:len(blocks)-1])
+
+	//a block length beyond the limit
+	oversize := append([]byte{}, exportFileMagic...)
+	oversize = append(oversize, 0xff, 0xff, 0xff, 0xff)
+	if _, err := importData(oversize); err != ErrExportFileFormat {
+		t.Fatalf("import oversize block, err %v", err)
+	}
+}
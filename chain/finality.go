@@ -0,0 +1,43 @@
+package chain
+
+import (
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+// finalize mark a block just connected to the main chain final if the validator of
+// consensus proves it, all of its ancestors become final too
+func (chainService *ChainService) finalize(block *types.Block, node *types.BlockNode) {
+	final := false
+	for _, blockValidator := range chainService.BlockValidator() {
+		if finalityValidator, ok := blockValidator.(IFinalityValidator); ok && finalityValidator.IsFinal(block) {
+			final = true
+			break
+		}
+	}
+	if !final {
+		return
+	}
+	for n := node; n != nil && !chainService.blockIndex.NodeStatus(n).KnownFinal(); n = n.Parent {
+		chainService.blockIndex.SetStatusFlags(n, types.StatusFinal)
+	}
+	chainService.flushIndexState()
+	err := chainService.chainStore.PutFinalizedHash(node.Hash)
+	if err != nil {
+		log.WithField("Reason", err).Warn("Error saving finalized block")
+	}
+	chainService.finalizedNode = node
+}
+
+// checkFinalized refuse a block forking the main chain below the finalized block, such a
+// block could only be in the main chain by reverting a final block
+func (chainService *ChainService) checkFinalized(prevNode *types.BlockNode) error {
+	finalized := chainService.finalizedNode
+	if finalized == nil {
+		return nil
+	}
+	fork := chainService.BestChain().FindFork(prevNode)
+	if fork == nil || fork.Height < finalized.Height {
+		return ErrReorgFinalized
+	}
+	return nil
+}
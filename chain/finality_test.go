@@ -0,0 +1,58 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+//finalityValidator stand for the validator of a consensus proving the finality of the blocks in final
+type finalityValidator struct {
+	rejectBodyValidator
+	final map[crypto.Hash]bool
+}
+
+func (validator *finalityValidator) VerifyBody(block *types.Block) error { return nil }
+func (validator *finalityValidator) IsFinal(block *types.Block) bool {
+	return validator.final[*block.Header.Hash()]
+}
+
+func TestFinalize(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	validator := &finalityValidator{final: make(map[crypto.Hash]bool)}
+	chainService.AddBlockValidator(validator)
+
+	addrA, addrB := crypto.CommonAddress{1}, crypto.CommonAddress{2}
+	genesis := chainService.genesisBlock.Header
+	a1 := tester.makeBlock(genesis, 1, tester.transfer(0, addrA))
+	a2 := tester.makeBlock(a1.Header, 1, tester.transfer(1, addrA))
+	a3 := tester.makeBlock(a2.Header, 1, tester.transfer(2, addrA))
+	validator.final[*a2.Header.Hash()] = true
+	tester.process(chainService, a1, a2, a3)
+
+	for _, block := range []*types.Block{a1, a2, a3} {
+		node := chainService.blockIndex.LookupNode(block.Header.Hash())
+		final := chainService.blockIndex.NodeStatus(node).KnownFinal()
+		if final != (block != a3) {
+			t.Fatalf("block %d final %v", block.Header.Height, final)
+		}
+	}
+	hash, err := chainService.chainStore.GetFinalizedHash()
+	if err != nil || *hash != *a2.Header.Hash() {
+		t.Fatalf("finalized hash %v, want block 2, err %v", hash, err)
+	}
+
+	//a longer branch forking below the final block is refused
+	b2 := tester.makeBlock(a1.Header, 2, tester.transfer(1, addrB))
+	if _, _, err := chainService.ProcessBlock(b2); err != ErrReorgFinalized {
+		t.Fatalf("fork below the final block, err %v", err)
+	}
+
+	//a branch forking after the final block still reorganizes the chain
+	c3 := tester.makeBlock(a2.Header, 2, tester.transfer(2, addrB))
+	c4 := tester.makeBlock(c3.Header, 1, tester.transfer(3, addrB))
+	tester.process(chainService, c3, c4)
+	tester.checkState(chainService, []*types.Block{a1, a2, c3, c4}, addrA, addrB)
+}
@@ -0,0 +1,56 @@
+package chain
+
+import (
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+/**********************multisig********************/
+
+type MultiSigTxSelector struct {
+}
+
+func (multiSigTxSelector *MultiSigTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.RegisterMultiSigType
+}
+
+var (
+	_ = (ITransactionSelector)((*MultiSigTxSelector)(nil))
+	_ = (ITransactionValidator)((*MultiSigTransactionProcessor)(nil))
+)
+
+//MultiSigTransactionProcessor register the key set and threshold to the address derived from them,
+//the sender only pay for the registration, transactions of the new account must be signed by its holders
+type MultiSigTransactionProcessor struct {
+}
+
+func (multiSigTransactionProcessor *MultiSigTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	account := payload.(*types.MultiSigAccount)
+	addr := account.Address()
+	if store.GetMultiSigAccount(&addr) != nil {
+		etr.Txerror = ErrMultiSigAccountExist
+		return etr
+	}
+	err = store.PutMultiSigAccount(&addr, account)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	etr.ContractAddr = addr
+	return etr
+}
@@ -0,0 +1,95 @@
+package chain
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+// parallelTxResult is a transaction executed on its own overlay of the block state
+type parallelTxResult struct {
+	overlay *store.Store
+	receipt *types.Receipt
+	gasUsed uint64
+	stats   *BlockExecutionStats
+	err     error
+}
+
+// executeParallel execute the transactions of block optimistically in parallel, each transaction
+// run on an overlay of the state before the block. The results are then applied in the order of
+// the block, a transaction reading a key written by the transactions before it is executed again
+// on the current state, so the state and the receipts are the same as executing one by one.
+// It return false if the state of context can not be overlaid.
+func (chainBlockValidator *ChainBlockValidator) executeParallel(context *BlockExecuteContext) (bool, error) {
+	state, ok := context.TrieStore.(*store.Store)
+	if !ok {
+		return false, nil
+	}
+	if _, ok := state.Overlay(); !ok {
+		return false, nil
+	}
+
+	txs := context.Block.Data.TxList
+	results := make([]*parallelTxResult, len(txs))
+	workers := make(chan struct{}, runtime.NumCPU())
+	wg := sync.WaitGroup{}
+	for i, tx := range txs {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, tx *types.Transaction) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			results[i] = chainBlockValidator.executeOnOverlay(context, state, tx)
+		}(i, tx)
+	}
+	wg.Wait()
+
+	reexecuted := 0
+	written := make(map[string]struct{})
+	for i, tx := range txs {
+		result := results[i]
+		//前面的交易修改了这个交易读取的数据，在当前状态上重新执行
+		if result.overlay.Conflict(written) {
+			result = chainBlockValidator.executeOnOverlay(context, state, tx)
+			reexecuted++
+		}
+		if result.err != nil {
+			return true, result.err
+		}
+		//与顺序执行一样先扣除交易的gas，执行完后退还剩余的gas
+		if err := context.Gp.SubGas(tx.Gas()); err != nil {
+			return true, err
+		}
+		context.Gp.AddGas(tx.Gas() - result.gasUsed)
+
+		result.overlay.Merge(written)
+		if context.Stats != nil {
+			for _, txStats := range result.stats.TxTypes {
+				context.Stats.addTx(txStats)
+			}
+		}
+		context.addReceipt(i, tx, result.receipt, result.gasUsed)
+	}
+	log.WithField("Height", context.Block.Header.Height).WithField("txs", len(txs)).WithField("reexecuted", reexecuted).Debug("parallel execute block")
+	return true, nil
+}
+
+// executeOnOverlay execute tx on a new overlay of state with a gas pool of its own
+func (chainBlockValidator *ChainBlockValidator) executeOnOverlay(context *BlockExecuteContext, state *store.Store, tx *types.Transaction) *parallelTxResult {
+	overlay, _ := state.Overlay()
+	txContext := *context
+	txContext.TrieStore = overlay
+	txContext.Gp = new(GasPool).AddGas(tx.Gas())
+	txContext.Stats = nil
+	if context.Stats != nil {
+		txContext.Stats = &BlockExecutionStats{txTypes: make(map[types.TxType]*TxTypeStats)}
+	}
+
+	result := &parallelTxResult{overlay: overlay, stats: txContext.Stats}
+	result.receipt, result.gasUsed, result.err = chainBlockValidator.RouteTransaction(&txContext, txContext.Gp, tx)
+	return result
+}
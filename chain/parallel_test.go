@@ -0,0 +1,170 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	mrand "math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+//testContract stand for the vm in the tests of chain, calling a contract of storeCode add the
+//first byte of the input to the value at slot 0, or revert if it is zero, and calling a contract
+//of suicideCode delete it
+type testContract struct{}
+
+var (
+	storeCode   = []byte("store")
+	suicideCode = []byte("suicide")
+)
+
+func (*testContract) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.CreateContractType || tx.Type() == types.CallContractType
+}
+
+func (*testContract) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	store := context.TrieStore()
+	tx := context.Tx()
+	if err := store.PutNonce(context.From(), tx.Nonce()+1); err != nil {
+		return &types.ExecuteTransactionResult{Txerror: err}
+	}
+	if tx.Type() == types.CreateContractType {
+		addr := crypto.CreateAddress(*context.From(), tx.Nonce())
+		return &types.ExecuteTransactionResult{Txerror: store.PutByteCode(&addr, tx.GetData()), ContractAddr: addr}
+	}
+
+	to := context.To()
+	code := store.GetByteCode(&to)
+	if bytes.Equal(code, suicideCode) {
+		return &types.ExecuteTransactionResult{Txerror: store.DeleteStorage(&to)}
+	}
+	fail := func(status uint64) *types.ExecuteTransactionResult {
+		return &types.ExecuteTransactionResult{ContractTxExecuteFail: true, ContractTxStatus: status}
+	}
+	input := tx.GetData()
+	if !bytes.Equal(code, storeCode) || len(input) == 0 || input[0] == 0 {
+		return fail(types.ReceiptStatusReverted)
+	}
+	if err := context.UseGas(params.SstoreSetGas); err != nil {
+		context.UseGas(context.GasRemained())
+		return fail(types.ReceiptStatusOutOfGas)
+	}
+	value, err := store.GetContractState(&to, []byte{0})
+	if err != nil {
+		return &types.ExecuteTransactionResult{Txerror: err}
+	}
+	value = new(big.Int).Add(new(big.Int).SetBytes(value), big.NewInt(int64(input[0]))).Bytes()
+	return &types.ExecuteTransactionResult{Txerror: store.PutContractState(&to, []byte{0}, value)}
+}
+
+//TestParallelExecution process the same blocks sequentially and in parallel, both must get the
+//state root and the receipts in the headers built by the sequential executor
+func TestParallelExecution(t *testing.T) {
+	//代付交易需发送方在扩展中指定代付地址
+	defer func(height uint64) { params.TxExtensionForkHeight = height }(params.TxExtensionForkHeight)
+	params.TxExtensionForkHeight = 0
+	tester := newReorgTester(t)
+	tester.addTxValidator(&testContract{}, &testContract{})
+	sequential := tester.newChain()
+	config := *DefaultChainConfig
+	config.ParallelExec = true
+	parallel := tester.newChainWithConfig(&config)
+
+	keys := make([]*secp256k1.PrivateKey, 8)
+	addrs := make([]crypto.CommonAddress, len(keys))
+	funds := make([]*types.Transaction, len(keys))
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey(rand.Reader)
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PubKey())
+		funds[i] = tester.transferFrom(tester.priv, uint64(i), addrs[i], big.NewInt(10000000))
+	}
+	owner := crypto.PubkeyToAddress(tester.priv.PubKey())
+	nonce := uint64(len(keys))
+	deploy := func(code []byte) crypto.CommonAddress {
+		funds = append(funds, tester.sign(tester.priv, types.NewContractTransaction(code, big.NewInt(1), big.NewInt(200000), nonce)))
+		nonce++
+		return crypto.CreateAddress(owner, nonce-1)
+	}
+	contract := deploy(storeCode)
+	suicides := []crypto.CommonAddress{deploy(suicideCode), deploy(suicideCode)}
+	blocks := []*types.Block{tester.makeBlock(sequential.genesisBlock.Header, 1, funds...)}
+
+	//random transfers between the funded accounts and some new ones, sponsored transfers and
+	//calls of the same contract, many of them read the nonce, the balance or the storage written
+	//by a transaction before them
+	random := mrand.New(mrand.NewSource(1))
+	nonces := make([]uint64, len(keys))
+	randomTx := func() *types.Transaction {
+		from := random.Intn(len(keys))
+		defer func() { nonces[from]++ }()
+		input := []byte{byte(random.Intn(4))}
+		switch random.Intn(5) {
+		case 0:
+			return tester.sign(keys[from], types.NewCallContractTransaction(contract, input, big.NewInt(0), big.NewInt(1), big.NewInt(100000), nonces[from]))
+		case 1:
+			//out of gas while storing unless reverted
+			return tester.sign(keys[from], types.NewCallContractTransaction(contract, input, big.NewInt(0), big.NewInt(1), big.NewInt(35000), nonces[from]))
+		case 2:
+			sponsor := keys[random.Intn(len(keys))]
+			tx := types.NewTransaction(addrs[random.Intn(len(addrs))], big.NewInt(int64(random.Intn(1000)+1)), big.NewInt(1), big.NewInt(40000), nonces[from])
+			if err := tx.CommitSponsor(crypto.PubkeyToAddress(sponsor.PubKey())); err != nil {
+				t.Fatal(err)
+			}
+			tx = tester.sign(keys[from], tx)
+			sponsorSig, err := secp256k1.SignCompact(sponsor, tx.SponsorHash(), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tx.SetSponsor(sponsorSig); err != nil {
+				t.Fatal(err)
+			}
+			return tx
+		}
+		to := addrs[random.Intn(len(addrs))]
+		if random.Intn(3) == 0 {
+			to = crypto.CommonAddress{byte(random.Intn(4) + 1)}
+		}
+		return tester.transferFrom(keys[from], nonces[from], to, big.NewInt(int64(random.Intn(1000)+1)))
+	}
+	for height := 0; height < 5; height++ {
+		txs := make([]*types.Transaction, 0, 20)
+		for i := 0; i < 20; i++ {
+			txs = append(txs, randomTx())
+		}
+		blocks = append(blocks, tester.makeBlock(blocks[len(blocks)-1].Header, 1, txs...))
+	}
+	//contracts deleted by self destruct
+	txs := []*types.Transaction{randomTx(), randomTx()}
+	for i, addr := range suicides {
+		txs = append(txs, tester.sign(tester.priv, types.NewCallContractTransaction(addr, nil, big.NewInt(0), big.NewInt(1), big.NewInt(100000), nonce+uint64(i))), randomTx())
+	}
+	blocks = append(blocks, tester.makeBlock(blocks[len(blocks)-1].Header, 1, txs...))
+
+	tester.process(sequential, blocks...)
+	tester.process(parallel, blocks...)
+	tester.checkState(parallel, blocks, append(addrs, contract, suicides[0], suicides[1])...)
+	status := make(map[uint64]int)
+	for _, block := range blocks {
+		receipts := sequential.chainStore.GetReceipts(*block.Header.Hash())
+		for _, receipt := range receipts {
+			status[receipt.Status]++
+		}
+		have, _ := json.Marshal(parallel.chainStore.GetReceipts(*block.Header.Hash()))
+		want, _ := json.Marshal(receipts)
+		if !reflect.DeepEqual(have, want) {
+			t.Fatalf("receipts of block %d mismatch:\nhave %s\nwant %s", block.Header.Height, have, want)
+		}
+	}
+	for _, s := range []uint64{types.ReceiptStatusSuccessful, types.ReceiptStatusReverted, types.ReceiptStatusOutOfGas} {
+		if status[s] == 0 {
+			t.Fatalf("no receipt of status %d, receipts %v", s, status)
+		}
+	}
+}
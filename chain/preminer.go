@@ -56,7 +56,10 @@ func (NewPreminerGenesisProcessor *PreminerGenesisProcessor) Genesis(context *Ge
 			}
 			addr := crypto.PubkeyToAddress(miner.Pubkey)
 
-			context.Store().CandidateCredit(&addr, new(big.Int).SetUint64(10), minerBytes, 0)
+			candidate := &types.CandidateData{}
+			if err := candidate.Unmarshal(minerBytes); err == nil {
+				context.Store().CandidateCredit(&addr, new(big.Int).SetUint64(10), candidate, 0)
+			}
 			context.store.AddCandidateAddr(&addr)
 
 			addrs = append(addrs, addr)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/drep-project/DREP-Chain/chain/store"
 	"math/big"
+	"time"
 
 	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/params"
@@ -15,6 +16,12 @@ import (
 )
 
 func (chainService *ChainService) ProcessBlock(block *types.Block) (bool, bool, error) {
+	return chainService.processBlock(block, false)
+}
+
+// processBlock handle a new block, the consensus signatures of a trusted block are not verified.
+// Orphans waiting for the block are never trusted
+func (chainService *ChainService) processBlock(block *types.Block, trusted bool) (bool, bool, error) {
 	chainService.addBlockSync.Lock()
 	defer chainService.addBlockSync.Unlock()
 	blockHash := block.Header.Hash()
@@ -36,7 +43,7 @@ func (chainService *ChainService) ProcessBlock(block *types.Block) (bool, bool,
 		chainService.addOrphanBlock(block)
 		return false, true, nil
 	}
-	isMainChain, err := chainService.acceptBlock(block)
+	isMainChain, err := chainService.acceptBlock(block, trusted)
 	if err != nil {
 		return false, false, err
 	}
@@ -76,7 +83,7 @@ func (chainService *ChainService) processOrphans(hash *crypto.Hash) error {
 			i--
 
 			// Potentially accept the block into the block chain.
-			_, err := chainService.acceptBlock(orphan.Block)
+			_, err := chainService.acceptBlock(orphan.Block, false)
 			if err != nil {
 				return err
 			}
@@ -93,18 +100,26 @@ func (chainService *ChainService) processOrphans(hash *crypto.Hash) error {
 func (chainService *ChainService) AcceptBlock(block *types.Block) (inMainChain bool, err error) {
 	chainService.addBlockSync.Lock()
 	defer chainService.addBlockSync.Unlock()
-	return chainService.acceptBlock(block)
+	return chainService.acceptBlock(block, false)
 
 }
 
-func (chainService *ChainService) acceptBlock(block *types.Block) (inMainChain bool, err error) {
+func (chainService *ChainService) acceptBlock(block *types.Block, trusted bool) (inMainChain bool, err error) {
 	prevNode := chainService.blockIndex.LookupNode(&block.Header.PreviousHash)
+	err = chainService.checkFinalized(prevNode)
+	if err != nil {
+		return false, err
+	}
 	preBlock := prevNode.Header()
 	for _, blockValidator := range chainService.BlockValidator() {
 		err = blockValidator.VerifyHeader(block.Header, &preBlock)
 		if err != nil {
 			return false, err
 		}
+		//blocks from a trusted export file skip the signature checks of consensus
+		if _, ok := blockValidator.(*ChainBlockValidator); trusted && !ok {
+			continue
+		}
 		err = blockValidator.VerifyBody(block)
 		if err != nil {
 			return false, err
@@ -125,20 +140,21 @@ func (chainService *ChainService) acceptBlock(block *types.Block) (inMainChain b
 	if err != nil {
 		return false, err
 	}
-	trieStore, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), prevNode.StateRoot)
-	if err != nil {
-		return false, err
-	}
 
 	if block.Header.PreviousHash.IsEqual(chainService.BestChain().Tip().Hash) {
+		trieStore, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), prevNode.StateRoot)
+		if err != nil {
+			return false, err
+		}
 		context, err := chainService.connectBlock(trieStore, block, newNode)
 		if err != nil {
 			return false, err
 		}
 
 		chainService.markState(trieStore, newNode)
+		chainService.finalize(block, newNode)
 		//SetTip has save tip but block not saving
-		chainService.notifyBlock(block, context.Logs)
+		chainService.notifyBlock(block, context.Receipts, context.Logs)
 		return true, nil
 	}
 
@@ -152,7 +168,7 @@ func (chainService *ChainService) acceptBlock(block *types.Block) (inMainChain b
 
 	// Reorganize the chain.
 	log.WithField("hash", newNode.Hash).Info("REORGANIZE: Block is causing a reorganize.")
-	err = chainService.reorganizeChain(trieStore, detachNodes, attachNodes)
+	err = chainService.reorganizeChain(detachNodes, attachNodes)
 
 	// Either getReorganizeNodes or reorganizeChain could have made unsaved
 	// changes to the block index, so flush regardless of whether there was an
@@ -169,11 +185,14 @@ func (chainService *ChainService) connectBlock(trieStore store.StoreInterface, b
 	gp := new(GasPool).AddGas(block.Header.GasLimit.Uint64())
 	//process transaction
 	context = NewBlockExecuteContext(trieStore, gp, chainService.chainStore, block)
+	context.Stats = newBlockExecutionStats(block)
 	for _, blockValidator := range chainService.BlockValidator() {
+		start := time.Now()
 		err := blockValidator.ExecuteBlock(context)
 		if err != nil {
 			return context, err
 		}
+		context.Stats.addValidator(blockValidator, time.Since(start))
 		//logs = append(logs,allLogs...)
 	}
 
@@ -197,7 +216,14 @@ func (chainService *ChainService) connectBlock(trieStore store.StoreInterface, b
 		err = errors.Wrapf(ErrGasUsed, "%d not matched %d", block.Header.GasUsed.Uint64(), context.GasUsed.Uint64())
 	}
 
+	if err == nil && context.Reward != nil {
+		err = chainService.chainStore.PutBlockReward(*block.Header.Hash(), context.Reward)
+	}
 	if err == nil {
+		err = chainService.chainStore.IndexVotes(block)
+	}
+	if err == nil {
+		chainService.profiler.record(context.Stats)
 		chainService.blockIndex.SetStatusFlags(newNode, types.StatusValid)
 		chainService.flushIndexState()
 	} else {
@@ -270,56 +296,68 @@ func (chainService *ChainService) getReorganizeNodes(node *types.BlockNode) (*li
 	return detachNodes, attachNodes
 }
 
-func (chainService *ChainService) reorganizeChain(db store.StoreInterface, detachNodes, attachNodes *list.List) error {
-	if detachNodes.Len() == 0 && attachNodes.Len() == 0 {
+//重组主链：在分叉点的状态上依次执行新分支的块，全部成功后才切换tip，然后删除旧分支块的收据
+//新分支中途执行失败时tip保持不变，旧分支的状态和收据都还在
+func (chainService *ChainService) reorganizeChain(detachNodes, attachNodes *list.List) error {
+	if attachNodes.Len() == 0 {
 		return nil
 	}
-	if detachNodes.Len() != 0 {
-		elem := detachNodes.Back()
-		lastBlock := elem.Value.(*types.BlockNode)
-		height := lastBlock.Height - 1
-		//Consider rollback
-		//	db.Rollback2Block(height, lastBlock.Hash)
-		log.WithField("Height", height).Info("REORGANIZE:RollBack state root")
-		chainService.markState(db, lastBlock.Parent)
-		elem = detachNodes.Front()
-		for elem != nil {
-			blockNode := elem.Value.(*types.BlockNode)
-			block, err := chainService.chainStore.GetBlock(blockNode.Hash)
-			if err != nil {
-				return err
-			}
-			chainService.notifyDetachBlock(block)
-			elem = elem.Next()
+	forkNode := attachNodes.Front().Value.(*types.BlockNode).Parent
+	log.WithField("Height", forkNode.Height).Info("REORGANIZE:RollBack state root")
+	db, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), forkNode.StateRoot)
+	if err != nil {
+		return errors.Wrapf(ErrStatePruned, "fork point %d", forkNode.Height)
+	}
+
+	attachBlocks := make([]*types.Block, 0, attachNodes.Len())
+	contexts := make([]*BlockExecuteContext, 0, attachNodes.Len())
+	for elem := attachNodes.Front(); elem != nil; elem = elem.Next() {
+		blockNode := elem.Value.(*types.BlockNode)
+		block, err := chainService.chainStore.GetBlock(blockNode.Hash)
+		if err != nil {
+			return err
 		}
+		context, err := chainService.connectBlock(db, block, blockNode)
+		if err != nil {
+			return err
+		}
+		chainService.commitState(db, blockNode)
+		attachBlocks = append(attachBlocks, block)
+		contexts = append(contexts, context)
 	}
+	chainService.BestChain().SetTip(attachNodes.Back().Value.(*types.BlockNode))
 
-	if attachNodes.Len() != 0 && detachNodes.Len() != 0 {
-		elem := attachNodes.Front()
-		for elem != nil { //
-			blockNode := elem.Value.(*types.BlockNode)
-			block, err := chainService.chainStore.GetBlock(blockNode.Hash)
-			if err != nil {
-				return err
-			}
-			context, err := chainService.connectBlock(db, block, blockNode)
-			if err != nil {
-				return err
-			}
-			chainService.markState(db, blockNode)
-			chainService.notifyBlock(block, context.Logs)
-			log.WithField("Height", blockNode.Height).WithField("Hash", blockNode.Hash).Info("REORGANIZE:Append New Block")
-			elem = elem.Next()
+	for elem := detachNodes.Front(); elem != nil; elem = elem.Next() {
+		blockNode := elem.Value.(*types.BlockNode)
+		block, err := chainService.chainStore.GetBlock(blockNode.Hash)
+		if err != nil {
+			return err
 		}
+		chainService.notifyDetachBlock(block)
+		err = chainService.chainStore.DeleteBlockReceipts(block)
+		if err != nil {
+			return err
+		}
+		log.WithField("Height", blockNode.Height).WithField("Hash", blockNode.Hash).Info("REORGANIZE:Detach Block")
+	}
+
+	elem := attachNodes.Front()
+	for i, block := range attachBlocks {
+		blockNode := elem.Value.(*types.BlockNode)
+		chainService.finalize(block, blockNode)
+		chainService.notifyBlock(block, contexts[i].Receipts, contexts[i].Logs)
+		log.WithField("Height", blockNode.Height).WithField("Hash", blockNode.Hash).Info("REORGANIZE:Append New Block")
+		elem = elem.Next()
 	}
 	return nil
 }
 
-func (chainService *ChainService) notifyBlock(block *types.Block, logs []*types.Log) {
+func (chainService *ChainService) notifyBlock(block *types.Block, receipts []*types.Receipt, logs []*types.Log) {
 	chainEvent := types.ChainEvent{
-		Block: block,
-		Hash:  *block.Header.Hash(),
-		Logs:  logs,
+		Block:    block,
+		Hash:     *block.Header.Hash(),
+		Receipts: receipts,
+		Logs:     logs,
 	}
 	chainService.newBlockFeed.Send(&chainEvent)
 
@@ -344,9 +382,20 @@ func (chainService *ChainService) notifyDetachBlock(block *types.Block) {
 }
 
 func (chainService *ChainService) markState(db store.StoreInterface, blockNode *types.BlockNode) {
+	chainService.commitState(db, blockNode)
+	chainService.BestChain().SetTip(blockNode)
+}
+
+//把块执行后的状态写入磁盘，并按照裁剪窗口释放旧的状态
+func (chainService *ChainService) commitState(db store.StoreInterface, blockNode *types.BlockNode) {
 	db.Commit()
 	db.TrieDB().Commit(crypto.Bytes2Hash(blockNode.StateRoot), true)
-	chainService.BestChain().SetTip(blockNode)
+	if chainService.statePruner != nil {
+		err := chainService.statePruner.Prune(db.TrieDB(), blockNode.Height, crypto.Bytes2Hash(blockNode.StateRoot))
+		if err != nil {
+			log.WithField("Height", blockNode.Height).WithField("err", err).Error("prune state")
+		}
+	}
 }
 
 //TODO improves the performan
@@ -396,7 +445,7 @@ func (chainService *ChainService) InitStates() error {
 	tip := lastNode
 	for {
 		if tip.Height != 0 {
-			_, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), lastNode.StateRoot)
+			_, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), tip.StateRoot)
 			if err == nil {
 
 				break
@@ -414,7 +463,7 @@ func (chainService *ChainService) InitStates() error {
 			//去除内存中节点信息
 			chainService.blockIndex.ClearNode(tip)
 		} else {
-			_, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), lastNode.StateRoot)
+			_, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), tip.StateRoot)
 			if err == nil {
 				break
 			}
@@ -438,6 +487,7 @@ func (chainService *ChainService) InitStates() error {
 	// them as valid if they aren't already marked as such.  This
 	// is a safe assumption as all the block before the current tip
 	// are valid by definition.
+	chainService.finalizedNode = nil
 	for iterNode := tip; iterNode != nil; iterNode = iterNode.Parent {
 		// If this isn't already marked as valid in the index, then
 		// we'll mark it as valid now to ensure consistency once
@@ -446,6 +496,21 @@ func (chainService *ChainService) InitStates() error {
 			log.WithField("Block", iterNode.Hash).WithField("height", iterNode.Height).Info("ancestor of chain tip not marked as valid, upgrading to valid for consistency")
 			chainService.blockIndex.SetStatusFlags(iterNode, types.StatusValid)
 		}
+		// The final block above the tip may be rolled back with its state, the
+		// highest final ancestor of the tip is the finalized block.
+		if chainService.finalizedNode == nil && iterNode.Status.KnownFinal() {
+			chainService.finalizedNode = iterNode
+			err = chainService.chainStore.PutFinalizedHash(iterNode.Hash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if chainService.finalizedNode == nil {
+		err = chainService.chainStore.Delete(FinalizedBlockKey)
+		if err != nil {
+			return err
+		}
 	}
 
 	// As we might have updated the index after it was loaded, we'll
@@ -0,0 +1,198 @@
+package chain
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+const (
+	maxProfiledBlocks = 256 //内存中保留最近执行的多少个块的统计
+)
+
+// TxTypeStats is the cost of executing the transactions of one type, time is in nanoseconds
+type TxTypeStats struct {
+	Type    types.TxType
+	Count   uint64
+	Time    time.Duration
+	GasUsed uint64
+	Reads   uint64 //读取状态的次数
+	Writes  uint64 //写入状态的次数
+}
+
+func (stats *TxTypeStats) add(other *TxTypeStats) {
+	stats.Count += other.Count
+	stats.Time += other.Time
+	stats.GasUsed += other.GasUsed
+	stats.Reads += other.Reads
+	stats.Writes += other.Writes
+}
+
+// ValidatorStats is the time spent in the ExecuteBlock of a block validator
+type ValidatorStats struct {
+	Name string
+	Time time.Duration
+}
+
+// BlockExecutionStats is where the time goes when a block is connected to the chain
+type BlockExecutionStats struct {
+	Height     uint64
+	Hash       crypto.Hash
+	TxCount    uint64
+	GasUsed    uint64
+	Time       time.Duration
+	Validators []*ValidatorStats
+	TxTypes    []*TxTypeStats
+
+	txTypes map[types.TxType]*TxTypeStats
+}
+
+func newBlockExecutionStats(block *types.Block) *BlockExecutionStats {
+	return &BlockExecutionStats{
+		Height:  block.Header.Height,
+		Hash:    *block.Header.Hash(),
+		TxCount: block.Data.TxCount,
+		txTypes: make(map[types.TxType]*TxTypeStats),
+	}
+}
+
+func (stats *BlockExecutionStats) addValidator(validator IBlockValidator, elapsed time.Duration) {
+	stats.Validators = append(stats.Validators, &ValidatorStats{
+		Name: reflect.TypeOf(validator).Elem().Name(),
+		Time: elapsed,
+	})
+	stats.Time += elapsed
+}
+
+func (stats *BlockExecutionStats) addTx(txStats *TxTypeStats) {
+	typeStats, ok := stats.txTypes[txStats.Type]
+	if !ok {
+		typeStats = &TxTypeStats{Type: txStats.Type}
+		stats.txTypes[txStats.Type] = typeStats
+		stats.TxTypes = append(stats.TxTypes, typeStats)
+	}
+	typeStats.add(txStats)
+	stats.GasUsed += txStats.GasUsed
+}
+
+// ExecutionCounters aggregate the stats of all blocks executed since the node started
+type ExecutionCounters struct {
+	Blocks       uint64
+	Txs          uint64
+	GasUsed      uint64
+	Time         time.Duration
+	MaxBlockTime time.Duration
+	MaxBlockGas  uint64
+	TxTypes      []*TxTypeStats
+}
+
+// executionProfiler keep the stats of the recently executed blocks and the counters since start
+type executionProfiler struct {
+	lock     sync.RWMutex
+	blocks   map[crypto.Hash]*BlockExecutionStats
+	order    []crypto.Hash
+	counters ExecutionCounters
+	txTypes  map[types.TxType]*TxTypeStats
+}
+
+func newExecutionProfiler() *executionProfiler {
+	return &executionProfiler{
+		blocks:  make(map[crypto.Hash]*BlockExecutionStats),
+		txTypes: make(map[types.TxType]*TxTypeStats),
+	}
+}
+
+func (profiler *executionProfiler) record(stats *BlockExecutionStats) {
+	sort.Slice(stats.TxTypes, func(i, j int) bool {
+		return stats.TxTypes[i].Type < stats.TxTypes[j].Type
+	})
+
+	profiler.lock.Lock()
+	defer profiler.lock.Unlock()
+	if _, ok := profiler.blocks[stats.Hash]; !ok {
+		profiler.order = append(profiler.order, stats.Hash)
+	}
+	profiler.blocks[stats.Hash] = stats
+	if len(profiler.order) > maxProfiledBlocks {
+		delete(profiler.blocks, profiler.order[0])
+		profiler.order = profiler.order[1:]
+	}
+
+	counters := &profiler.counters
+	counters.Blocks++
+	counters.Txs += stats.TxCount
+	counters.GasUsed += stats.GasUsed
+	counters.Time += stats.Time
+	if stats.Time > counters.MaxBlockTime {
+		counters.MaxBlockTime = stats.Time
+	}
+	if stats.GasUsed > counters.MaxBlockGas {
+		counters.MaxBlockGas = stats.GasUsed
+	}
+	for _, txStats := range stats.TxTypes {
+		typeStats, ok := profiler.txTypes[txStats.Type]
+		if !ok {
+			typeStats = &TxTypeStats{Type: txStats.Type}
+			profiler.txTypes[txStats.Type] = typeStats
+		}
+		typeStats.add(txStats)
+	}
+}
+
+func (profiler *executionProfiler) blockStats(hash *crypto.Hash) (*BlockExecutionStats, bool) {
+	profiler.lock.RLock()
+	defer profiler.lock.RUnlock()
+	stats, ok := profiler.blocks[*hash]
+	return stats, ok
+}
+
+func (profiler *executionProfiler) snapshot() *ExecutionCounters {
+	profiler.lock.RLock()
+	defer profiler.lock.RUnlock()
+	counters := profiler.counters
+	counters.TxTypes = make([]*TxTypeStats, 0, len(profiler.txTypes))
+	for _, typeStats := range profiler.txTypes {
+		copied := *typeStats
+		counters.TxTypes = append(counters.TxTypes, &copied)
+	}
+	sort.Slice(counters.TxTypes, func(i, j int) bool {
+		return counters.TxTypes[i].Type < counters.TxTypes[j].Type
+	})
+	return &counters
+}
+
+// profileTx start measuring the execution of a transaction, the returned func record it
+// with the gas used once the transaction is executed
+func (context *BlockExecuteContext) profileTx(tx *types.Transaction) func(gasUsed uint64) {
+	if context.Stats == nil {
+		return func(uint64) {}
+	}
+	var reads, writes uint64
+	counter, counted := context.TrieStore.(stateAccessCounter)
+	if counted {
+		reads, writes = counter.AccessCount()
+	}
+	start := time.Now()
+	return func(gasUsed uint64) {
+		txStats := &TxTypeStats{
+			Type:    tx.Type(),
+			Count:   1,
+			Time:    time.Since(start),
+			GasUsed: gasUsed,
+		}
+		if counted {
+			newReads, newWrites := counter.AccessCount()
+			txStats.Reads, txStats.Writes = newReads-reads, newWrites-writes
+		}
+		context.Stats.addTx(txStats)
+	}
+}
+
+// stateAccessCounter is implemented by the state store counting its reads and writes
+type stateAccessCounter interface {
+	AccessCount() (reads uint64, writes uint64)
+}
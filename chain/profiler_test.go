@@ -0,0 +1,44 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestBlockExecutionStats(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	to := crypto.CommonAddress{1}
+
+	block := tester.makeBlock(chainService.genesisBlock.Header, 1, tester.transfer(0, to), tester.transfer(1, to))
+	tester.process(chainService, block)
+
+	api := NewChainApi(chainService.DatabaseService.LevelDb(), chainService.BestChain(), chainService.chainStore, chainService.profiler)
+	stats, err := api.GetBlockExecutionStats(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Hash != *block.Header.Hash() || stats.GasUsed != block.Header.GasUsed.Uint64() || len(stats.Validators) != 1 {
+		t.Fatalf("unexpected block stats %+v", stats)
+	}
+	if len(stats.TxTypes) != 1 {
+		t.Fatalf("have %d tx types, want 1", len(stats.TxTypes))
+	}
+	transfers := stats.TxTypes[0]
+	if transfers.Type != types.TransferType || transfers.Count != 2 || transfers.GasUsed != stats.GasUsed {
+		t.Fatalf("unexpected transfer stats %+v", transfers)
+	}
+	if transfers.Reads == 0 || transfers.Writes == 0 {
+		t.Fatalf("state access not counted: %d reads, %d writes", transfers.Reads, transfers.Writes)
+	}
+	if _, err := api.GetBlockExecutionStats(0); err != ErrNoExecutionStats {
+		t.Fatalf("genesis stats: have %v, want %v", err, ErrNoExecutionStats)
+	}
+
+	counters := api.GetExecutionCounters()
+	if counters.Blocks != 1 || counters.Txs != 2 || counters.GasUsed != stats.GasUsed || counters.MaxBlockGas != stats.GasUsed {
+		t.Fatalf("unexpected counters %+v", counters)
+	}
+}
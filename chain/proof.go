@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+// 账户状态证明，Proof为从StateRoot到账户存储的trie节点
+type AccountProof struct {
+	Address   crypto.CommonAddress
+	Height    uint64
+	StateRoot crypto.Hash
+	Storage   *types.Storage //账户不存在时为nil，Proof证明其不存在
+	Proof     []common.Bytes
+}
+
+// receipt包含证明，Proof为receipt在区块ReceiptRoot默克尔树中的认证路径
+type ReceiptProof struct {
+	Receipt     *types.Receipt
+	Height      uint64
+	ReceiptRoot crypto.Hash
+	Proof       *common.MerkleProof
+}
+
+// 交易包含证明，Proof为交易hash在区块TxRoot默克尔树中的认证路径
+type TransactionProof struct {
+	TxHash    crypto.Hash
+	BlockHash crypto.Hash
+	Height    uint64
+	TxRoot    common.Bytes
+	Proof     *common.MerkleProof
+}
+
+// accountKey is the key of the account storage in the state trie, the same as the store use
+func accountKey(addr *crypto.CommonAddress) []byte {
+	return sha3.Keccak256([]byte(store.AddressStorage + addr.Hex()))
+}
+
+// receiptLeaf hash the receipt as it is when ReceiptRoot is derived, the block hash and
+// post state are filled after the root is known
+func receiptLeaf(receipt *types.Receipt) []byte {
+	leaf := *receipt
+	leaf.BlockHash = crypto.Hash{}
+	leaf.PostState = crypto.ZeroHash[:]
+	return leaf.ReceiptHash().Bytes()
+}
+
+// VerifyAccountProof check the proof against a state root trusted by the caller, and
+// return the storage of the account it proves. A nil storage means the account does
+// not exist in that state.
+func VerifyAccountProof(stateRoot crypto.Hash, addr crypto.CommonAddress, proof []common.Bytes) (*types.Storage, error) {
+	nodes := make(trie.ProofList, len(proof))
+	for i, node := range proof {
+		nodes[i] = node
+	}
+	value, err := trie.VerifySecureProof(stateRoot, accountKey(&addr), nodes.NodeSet())
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	storage := &types.Storage{}
+	err = binary.Unmarshal(value, storage)
+	if err != nil {
+		return nil, err
+	}
+	return storage, nil
+}
+
+// VerifyReceiptProof check the receipt is included in the block whose ReceiptRoot is
+// trusted by the caller
+func VerifyReceiptProof(receiptRoot crypto.Hash, receipt *types.Receipt, proof *common.MerkleProof) error {
+	if receipt == nil || !common.VerifyMerkleProof(receiptRoot[:], receiptLeaf(receipt), proof) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// VerifyTransactionProof check the tx is included in the block whose TxRoot is trusted
+// by the caller, the leaves of TxRoot are the tx hashes
+func VerifyTransactionProof(txRoot []byte, txHash crypto.Hash, proof *common.MerkleProof) error {
+	if !common.VerifyMerkleProof(txRoot, txHash[:], proof) {
+		return ErrInvalidProof
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"encoding/json"
+
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+/**********************redelegate********************/
+
+type RedelegateTxSelector struct {
+}
+
+func (redelegateTxSelector *RedelegateTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.RedelegateType
+}
+
+var (
+	_ = (ITransactionSelector)((*RedelegateTxSelector)(nil))
+	_ = (ITransactionValidator)((*RedelegateTransactionProcessor)(nil))
+)
+
+//RedelegateTransactionProcessor move the vote credit of sender from one candidate to another in place,
+//the credit keep its original heights and is never unbonded, a sender redelegate once per change interval
+type RedelegateTransactionProcessor struct {
+}
+
+func (processor *RedelegateTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	oldCandidate := payload.(*types.RedelegateData).From
+	if oldCandidate == *tx.To() {
+		etr.Txerror = ErrRedelegateSameCandidate
+		return etr
+	}
+	//投给自己的是候选人的抵押，只能通过撤销候选取回
+	if oldCandidate == *from || *tx.To() == *from {
+		etr.Txerror = ErrRedelegateSelf
+		return etr
+	}
+
+	moved, err := store.RedelegateCredit(from, &oldCandidate, tx.To(), tx.Amount(), context.header.Height)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	data, _ := json.Marshal(&types.CancelCreditDetail{PrincipalData: moved})
+	etr.ContractTxLog = []*types.Log{{TxType: tx.Type(), TxHash: *tx.TxHash(), Data: data, Height: context.header.Height, TxIndex: 0}}
+
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
@@ -0,0 +1,82 @@
+package chain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestRedelegate(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	from := crypto.PubkeyToAddress(tester.priv.PubKey())
+	candidateA, candidateB := crypto.CommonAddress{1}, crypto.CommonAddress{2}
+	gasPrice, gasLimit := big.NewInt(1), big.NewInt(30000)
+
+	b1 := tester.makeBlock(chainService.genesisBlock.Header, 1, tester.sign(tester.priv, types.NewVoteTransaction(candidateA, big.NewInt(500000000), gasPrice, gasLimit, 0)))
+	b2 := tester.makeBlock(b1.Header, 1, tester.sign(tester.priv, types.NewVoteTransaction(candidateA, big.NewInt(100000000), gasPrice, gasLimit, 1)))
+	redelegate, err := types.NewRedelegateTransaction(candidateA, candidateB, big.NewInt(550000000), gasPrice, gasLimit, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b3 := tester.makeBlock(b2.Header, 1, tester.sign(tester.priv, redelegate))
+	tester.process(chainService, b1, b2, b3)
+
+	//the oldest credit is moved first and keep its height
+	api := NewChainApi(chainService.DatabaseService.LevelDb(), chainService.BestChain(), chainService.chainStore, chainService.profiler)
+	votes, err := api.GetVoteDetails(from, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[crypto.CommonAddress][][2]int64{
+		candidateA: {{2, 50000000}},
+		candidateB: {{1, 500000000}, {2, 50000000}},
+	}
+	have := map[crypto.CommonAddress][][2]int64{}
+	for _, vote := range votes {
+		have[vote.Candidate] = append(have[vote.Candidate], [2]int64{int64(vote.Height), vote.Amount.ToInt().Int64()})
+	}
+	for candidate, values := range want {
+		if len(have[candidate]) != len(values) {
+			t.Fatalf("votes of %s: have %v, want %v", candidate.String(), have[candidate], values)
+		}
+		for i := range values {
+			if have[candidate][i] != values[i] {
+				t.Fatalf("votes of %s: have %v, want %v", candidate.String(), have[candidate], values)
+			}
+		}
+	}
+	//nothing is unbonding, the balance only pay for the votes and the gas
+	if unbondings, _ := api.GetUnbondingDetails(from, nil); len(unbondings) != 0 {
+		t.Fatalf("have %d unbondings, want 0", len(unbondings))
+	}
+
+	//the next redelegation wait for the change interval
+	state, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), chainService.BestChain().Tip().StateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.RedelegateCredit(&from, &candidateB, &candidateA, big.NewInt(1), 4); err != store.ErrRedelegateCooldown {
+		t.Fatalf("redelegate in cooldown: have %v, want %v", err, store.ErrRedelegateCooldown)
+	}
+	if _, err := state.RedelegateCredit(&from, &candidateB, &candidateA, big.NewInt(550000000), 3+100); err != nil {
+		t.Fatal(err)
+	}
+	votes, err = state.GetVoteDetails(&from, chainService.chainStore.GetVotedAddrs(&from), 103)
+	if err != nil {
+		t.Fatal(err)
+	}
+	heights := []uint64{}
+	for _, vote := range votes {
+		if vote.Candidate != candidateA {
+			t.Fatalf("unexpected vote to %s after moving back", vote.Candidate.String())
+		}
+		heights = append(heights, vote.Height)
+	}
+	if len(heights) != 3 || heights[0] != 1 || heights[1] != 2 || heights[2] != 2 {
+		t.Fatalf("vote heights after moving back: have %v, want [1 2 2]", heights)
+	}
+}
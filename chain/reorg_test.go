@@ -0,0 +1,278 @@
+package chain
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/app"
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/database"
+	"github.com/drep-project/DREP-Chain/database/memorydb"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+type reorgTester struct {
+	t    *testing.T
+	priv *secp256k1.PrivateKey
+	//gen holds the state of every generated block, the blocks of all branches are built on it
+	gen     *ChainService
+	genesis json.RawMessage
+	//txValidators are added to every chain like the services processing their own txs
+	txValidators map[ITransactionSelector]ITransactionValidator
+}
+
+func newReorgTester(t *testing.T) *reorgTester {
+	priv, _ := crypto.GenerateKey(rand.Reader)
+	genesis, _ := json.Marshal(map[string][]Preminer{
+		"Preminer": {{Addr: crypto.PubkeyToAddress(priv.PubKey()), Value: *big.NewInt(1000000000)}},
+	})
+	tester := &reorgTester{t: t, priv: priv, genesis: genesis, txValidators: make(map[ITransactionSelector]ITransactionValidator)}
+	tester.gen = tester.newChain()
+	return tester
+}
+
+func (tester *reorgTester) newChain() *ChainService {
+	return tester.newChainWithConfig(DefaultChainConfig)
+}
+
+func (tester *reorgTester) newChainWithConfig(config *ChainConfig) *ChainService {
+	db := memorydb.New()
+	//written by the consensus service before the chain is loaded
+	changeInterval := make([]byte, 8)
+	binary.BigEndian.PutUint64(changeInterval, 100)
+	db.Put([]byte(store.ChangeInterval), changeInterval)
+	chainService := &ChainService{
+		DatabaseService: database.NewDatabaseService(db),
+		Config:          config,
+	}
+	err := chainService.Init(&app.ExecuteContext{PhaseConfig: map[string]json.RawMessage{"genesis": tester.genesis}})
+	if err != nil {
+		tester.t.Fatal(err)
+	}
+	for selector, validator := range tester.txValidators {
+		chainService.AddTransactionValidator(selector, validator)
+	}
+	return chainService
+}
+
+//addTxValidator add the validator to the chain generating blocks and the chains created after
+func (tester *reorgTester) addTxValidator(selector ITransactionSelector, validator ITransactionValidator) {
+	tester.txValidators[selector] = validator
+	tester.gen.AddTransactionValidator(selector, validator)
+}
+
+func (tester *reorgTester) transfer(nonce uint64, to crypto.CommonAddress) *types.Transaction {
+	return tester.transferFrom(tester.priv, nonce, to, big.NewInt(100))
+}
+
+func (tester *reorgTester) transferFrom(priv *secp256k1.PrivateKey, nonce uint64, to crypto.CommonAddress, amount *big.Int) *types.Transaction {
+	return tester.sign(priv, types.NewTransaction(to, amount, big.NewInt(1), big.NewInt(30000), nonce))
+}
+
+func (tester *reorgTester) sign(priv *secp256k1.PrivateKey, tx *types.Transaction) *types.Transaction {
+	sig, err := secp256k1.SignCompact(priv, tx.TxHash().Bytes(), true)
+	if err != nil {
+		tester.t.Fatal(err)
+	}
+	tx.Sig = sig
+	return tx
+}
+
+//makeBlock execute txs on the state of parent and return a valid child, delay tells blocks
+//at the same height of different branches apart
+func (tester *reorgTester) makeBlock(parent *types.BlockHeader, delay uint64, txs ...*types.Transaction) *types.Block {
+	gen := tester.gen
+	block := &types.Block{
+		Header: &types.BlockHeader{
+			ChainId:      gen.ChainID(),
+			Version:      common.Version,
+			PreviousHash: *parent.Hash(),
+			GasLimit:     *gen.CalcGasLimit(parent, params.MinGasLimit, params.MaxGasLimit),
+			Height:       parent.Height + 1,
+			Timestamp:    parent.Timestamp + delay,
+			TxRoot:       gen.DeriveMerkleRoot(txs),
+		},
+		Data: &types.BlockData{TxCount: uint64(len(txs)), TxList: txs},
+	}
+	validator := NewChainBlockValidator(gen)
+
+	//the receipt root is in the header, execute once to get it
+	trieStore, err := store.TrieStoreFromStore(gen.DatabaseService.LevelDb(), parent.StateRoot)
+	if err != nil {
+		tester.t.Fatal(err)
+	}
+	gp := new(GasPool).AddGas(block.Header.GasLimit.Uint64())
+	context := NewBlockExecuteContext(trieStore, gp, gen.chainStore, block)
+	receipts := make([]*types.Receipt, len(txs))
+	for i, tx := range txs {
+		receipt, gasUsed, err := validator.RouteTransaction(context, gp, tx)
+		if err != nil {
+			tester.t.Fatal(err)
+		}
+		receipts[i] = receipt
+		context.AddGasUsed(new(big.Int).SetUint64(gasUsed))
+	}
+	block.Header.GasUsed = *context.GasUsed
+	block.Header.ReceiptRoot = gen.DeriveReceiptRoot(receipts)
+
+	trieStore, _ = store.TrieStoreFromStore(gen.DatabaseService.LevelDb(), parent.StateRoot)
+	context = NewBlockExecuteContext(trieStore, new(GasPool).AddGas(block.Header.GasLimit.Uint64()), gen.chainStore, block)
+	err = validator.ExecuteBlock(context)
+	if err != nil {
+		tester.t.Fatal(err)
+	}
+	stateRoot := trieStore.GetStateRoot()
+	err = trieStore.TrieDB().Commit(crypto.Bytes2Hash(stateRoot), false)
+	if err != nil {
+		tester.t.Fatal(err)
+	}
+	//the hash cached while executing is not of the complete header
+	header := block.Header
+	block.Header = &types.BlockHeader{
+		ChainId:      header.ChainId,
+		Version:      header.Version,
+		PreviousHash: header.PreviousHash,
+		GasLimit:     header.GasLimit,
+		GasUsed:      header.GasUsed,
+		Height:       header.Height,
+		Timestamp:    header.Timestamp,
+		StateRoot:    stateRoot,
+		TxRoot:       header.TxRoot,
+		ReceiptRoot:  header.ReceiptRoot,
+	}
+	return block
+}
+
+func (tester *reorgTester) process(chainService *ChainService, blocks ...*types.Block) {
+	for _, block := range blocks {
+		_, _, err := chainService.ProcessBlock(block)
+		if err != nil {
+			tester.t.Fatalf("process block %d: %v", block.Header.Height, err)
+		}
+	}
+}
+
+//checkState compare the state at the tip of chainService with a chain processing the blocks
+//of the expected main chain without any fork
+func (tester *reorgTester) checkState(chainService *ChainService, mainChain []*types.Block, addrs ...crypto.CommonAddress) {
+	ref := tester.newChain()
+	tester.process(ref, mainChain...)
+
+	tip := chainService.BestChain().Tip()
+	refTip := ref.BestChain().Tip()
+	if *tip.Hash != *refTip.Hash {
+		tester.t.Fatalf("tip mismatch: have %d %s, want %d %s", tip.Height, tip.Hash, refTip.Height, refTip.Hash)
+	}
+	have, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), tip.StateRoot)
+	if err != nil {
+		tester.t.Fatal(err)
+	}
+	want, _ := store.TrieStoreFromStore(ref.DatabaseService.LevelDb(), refTip.StateRoot)
+	if !bytes.Equal(have.GetStateRoot(), want.GetStateRoot()) {
+		tester.t.Fatalf("state root mismatch: have %x, want %x", have.GetStateRoot(), want.GetStateRoot())
+	}
+	addrs = append(addrs, crypto.PubkeyToAddress(tester.priv.PubKey()))
+	for _, addr := range addrs {
+		addr := addr
+		if have.GetBalance(&addr, tip.Height).Cmp(want.GetBalance(&addr, tip.Height)) != 0 || have.GetNonce(&addr) != want.GetNonce(&addr) {
+			tester.t.Fatalf("account %s mismatch: have %v/%d, want %v/%d", addr.String(),
+				have.GetBalance(&addr, tip.Height), have.GetNonce(&addr), want.GetBalance(&addr, tip.Height), want.GetNonce(&addr))
+		}
+	}
+}
+
+func (tester *reorgTester) checkReceipts(chainService *ChainService, block *types.Block, inMainChain bool) {
+	receipts := chainService.chainStore.GetReceipts(*block.Header.Hash())
+	if inMainChain != (len(receipts) == len(block.Data.TxList)) {
+		tester.t.Fatalf("block %d has %d receipts, in main chain %v", block.Header.Height, len(receipts), inMainChain)
+	}
+	for _, tx := range block.Data.TxList {
+		receipt := chainService.chainStore.GetReceipt(*tx.TxHash())
+		if inMainChain && (receipt == nil || receipt.BlockHash != *block.Header.Hash()) {
+			tester.t.Fatalf("receipt of tx %s not point to block %d", tx.TxHash(), block.Header.Height)
+		}
+		if !inMainChain && receipt != nil && receipt.BlockHash == *block.Header.Hash() {
+			tester.t.Fatalf("receipt of tx %s still point to detached block %d", tx.TxHash(), block.Header.Height)
+		}
+	}
+}
+
+func TestReorganizeChain(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	detached := make(chan *types.Block, 10)
+	sub := chainService.DetachBlockFeed().Subscribe(detached)
+	defer sub.Unsubscribe()
+
+	addrA, addrB, addrC := crypto.CommonAddress{1}, crypto.CommonAddress{2}, crypto.CommonAddress{3}
+	shared := tester.transfer(0, addrC)
+	genesis := chainService.genesisBlock.Header
+
+	a1 := tester.makeBlock(genesis, 1, shared)
+	a2 := tester.makeBlock(a1.Header, 1, tester.transfer(1, addrA))
+	tester.process(chainService, a1, a2)
+
+	//a longer branch forking at genesis, the shared tx is included in both branches
+	b1 := tester.makeBlock(genesis, 2, shared, tester.transfer(1, addrB))
+	b2 := tester.makeBlock(b1.Header, 1, tester.transfer(2, addrB))
+	b3 := tester.makeBlock(b2.Header, 1, tester.transfer(3, addrB))
+	tester.process(chainService, b1, b2)
+	if *chainService.BestChain().Tip().Hash != *a2.Header.Hash() {
+		t.Fatal("reorganized to a branch not longer than the main chain")
+	}
+	tester.process(chainService, b3)
+	tester.checkState(chainService, []*types.Block{b1, b2, b3}, addrA, addrB, addrC)
+	tester.checkReceipts(chainService, a2, false)
+	for _, block := range []*types.Block{b1, b2, b3} {
+		tester.checkReceipts(chainService, block, true)
+	}
+	for _, want := range []*types.Block{a2, a1} {
+		if have := <-detached; *have.Header.Hash() != *want.Header.Hash() {
+			t.Fatalf("detached block %d, want %d", have.Header.Height, want.Header.Height)
+		}
+	}
+
+	//back to the first branch
+	a3 := tester.makeBlock(a2.Header, 1, tester.transfer(2, addrA))
+	a4 := tester.makeBlock(a3.Header, 1, tester.transfer(3, addrA))
+	tester.process(chainService, a3, a4)
+	tester.checkState(chainService, []*types.Block{a1, a2, a3, a4}, addrA, addrB, addrC)
+	for _, block := range []*types.Block{a1, a2, a3, a4} {
+		tester.checkReceipts(chainService, block, true)
+	}
+	for _, block := range []*types.Block{b2, b3} {
+		tester.checkReceipts(chainService, block, false)
+	}
+}
+
+func TestReorganizeChainFailure(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	addrA, addrB := crypto.CommonAddress{1}, crypto.CommonAddress{2}
+	genesis := chainService.genesisBlock.Header
+
+	a1 := tester.makeBlock(genesis, 1, tester.transfer(0, addrA))
+	a2 := tester.makeBlock(a1.Header, 1, tester.transfer(1, addrA))
+	tester.process(chainService, a1, a2)
+
+	b1 := tester.makeBlock(genesis, 2, tester.transfer(0, addrB))
+	b2 := tester.makeBlock(b1.Header, 1, tester.transfer(1, addrB))
+	b3 := tester.makeBlock(b2.Header, 1, tester.transfer(2, addrB))
+	b3.Header.ReceiptRoot = crypto.Hash{}
+	tester.process(chainService, b1, b2)
+	if _, _, err := chainService.ProcessBlock(b3); err == nil {
+		t.Fatal("reorganized to an invalid branch")
+	}
+	//the main chain is kept as it is when the new branch fail halfway
+	tester.checkState(chainService, []*types.Block{a1, a2}, addrA, addrB)
+	tester.checkReceipts(chainService, a1, true)
+	tester.checkReceipts(chainService, a2, true)
+}
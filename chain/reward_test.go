@@ -0,0 +1,42 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+//rewardValidator stand for the consensus distributing the reward of every block
+type rewardValidator struct {
+	rejectBodyValidator
+}
+
+func (validator *rewardValidator) VerifyBody(block *types.Block) error { return nil }
+func (validator *rewardValidator) ExecuteBlock(context *BlockExecuteContext) error {
+	context.Reward = &types.BlockReward{Height: context.Block.Header.Height}
+	return nil
+}
+
+func TestBlockRewardRecord(t *testing.T) {
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	chainService.AddBlockValidator(&rewardValidator{})
+
+	genesis := chainService.genesisBlock.Header
+	a1 := tester.makeBlock(genesis, 1, tester.transfer(0, crypto.CommonAddress{1}))
+	tester.process(chainService, a1)
+	if reward := chainService.chainStore.GetBlockReward(*a1.Header.Hash()); reward == nil || reward.Height != 1 {
+		t.Fatalf("reward of accepted block %v", reward)
+	}
+
+	//the reward is computed before the state root is checked, nothing is saved for the rejected block
+	a2 := tester.makeBlock(a1.Header, 1, tester.transfer(1, crypto.CommonAddress{1}))
+	a2.Header.StateRoot = a1.Header.StateRoot
+	if _, _, err := chainService.ProcessBlock(a2); err == nil {
+		t.Fatal("block with wrong state root accepted")
+	}
+	if reward := chainService.chainStore.GetBlockReward(*a2.Header.Hash()); reward != nil {
+		t.Fatal("reward of rejected block saved")
+	}
+}
@@ -0,0 +1,124 @@
+package chain
+
+import (
+	"encoding/binary"
+
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+)
+
+var (
+	StateRootsPrefix  = []byte("stateRoots_")       //高度 -> 该高度上提交过的状态根
+	StatePrunedHeight = []byte("statePrunedHeight") //该高度及以下的状态根引用都已释放
+)
+
+// statePruner keep a reference on the state root of each of the last retention blocks,
+// the reference of older roots are released so that the trie nodes no longer reached
+// are deleted from disk
+type statePruner struct {
+	chainStore *ChainStore
+	retention  uint64
+}
+
+func newStatePruner(config *ChainConfig, chainStore *ChainStore) *statePruner {
+	if config.GCMode != GCModeFull {
+		return nil
+	}
+	if !trie.RefCountEnabled(chainStore) {
+		log.Warn("state pruning disabled, the database is created before reference counting, run as archive node")
+		return nil
+	}
+	retention := config.StateRetention
+	if retention == 0 {
+		retention = DefaultStateRetention
+	}
+	log.WithField("retention", retention).Info("state pruning enabled")
+	return &statePruner{
+		chainStore: chainStore,
+		retention:  retention,
+	}
+}
+
+// Prune retain the state root just committed at height, and release the roots of
+// the blocks falling out of the retention window
+func (pruner *statePruner) Prune(triedb *trie.Database, height uint64, root crypto.Hash) error {
+	//the genesis state is never released
+	if height == 0 {
+		return nil
+	}
+	prunedHeight := pruner.prunedHeight()
+	if height > prunedHeight {
+		err := triedb.ReferenceRoot(root)
+		if err != nil {
+			return err
+		}
+		roots := append(pruner.roots(height), root)
+		err = pruner.chainStore.Put(stateRootsKey(height), encodeStateRoots(roots))
+		if err != nil {
+			return err
+		}
+	}
+	if height <= pruner.retention {
+		return nil
+	}
+	target := height - pruner.retention
+	for h := prunedHeight + 1; h <= target; h++ {
+		roots := pruner.roots(h)
+		//progress is saved before release, a crash leaves some garbage nodes but never
+		//release a root twice
+		err := pruner.chainStore.Put(StatePrunedHeight, encodeHeight(h))
+		if err != nil {
+			return err
+		}
+		err = pruner.chainStore.Delete(stateRootsKey(h))
+		if err != nil {
+			return err
+		}
+		for _, root := range roots {
+			deleted, err := triedb.DereferenceRoot(root)
+			if err != nil {
+				return err
+			}
+			log.WithField("Height", h).WithField("root", root.String()).WithField("nodes", deleted).Debug("prune state")
+		}
+	}
+	return nil
+}
+
+func (pruner *statePruner) prunedHeight() uint64 {
+	value, err := pruner.chainStore.Get(StatePrunedHeight)
+	if err != nil || len(value) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
+
+func (pruner *statePruner) roots(height uint64) []crypto.Hash {
+	value, err := pruner.chainStore.Get(stateRootsKey(height))
+	if err != nil {
+		return nil
+	}
+	roots := make([]crypto.Hash, 0, len(value)/crypto.HashLength)
+	for i := 0; i+crypto.HashLength <= len(value); i += crypto.HashLength {
+		roots = append(roots, crypto.BytesToHash(value[i:i+crypto.HashLength]))
+	}
+	return roots
+}
+
+func stateRootsKey(height uint64) []byte {
+	return append(append([]byte{}, StateRootsPrefix...), encodeHeight(height)...)
+}
+
+func encodeStateRoots(roots []crypto.Hash) []byte {
+	value := make([]byte, 0, len(roots)*crypto.HashLength)
+	for _, root := range roots {
+		value = append(value, root[:]...)
+	}
+	return value
+}
+
+func encodeHeight(height uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, height)
+	return buf
+}
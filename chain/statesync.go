@@ -0,0 +1,83 @@
+package chain
+
+import (
+	"encoding/hex"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+// InsertBlockWithoutState store a block below the pivot of a state sync without executing it.
+// The header chain, the tx root and the signatures of consensus are verified, so the state the
+// consensus reads the producers from must be synced and committed by CommitSyncedState first.
+// The tip is not moved until CommitSyncedState, blocks inserted by an interrupted sync have no
+// state and are rolled back by InitStates on restart.
+func (chainService *ChainService) InsertBlockWithoutState(block *types.Block) error {
+	chainService.addBlockSync.Lock()
+	defer chainService.addBlockSync.Unlock()
+	if chainService.BlockExists(block.Header.Hash()) {
+		return ErrBlockExsist
+	}
+	prevNode := chainService.blockIndex.LookupNode(&block.Header.PreviousHash)
+	if prevNode == nil {
+		return ErrBlockNotFound
+	}
+	preBlock := prevNode.Header()
+	for _, blockValidator := range chainService.BlockValidator() {
+		err := blockValidator.VerifyHeader(block.Header, &preBlock)
+		if err != nil {
+			return err
+		}
+		err = blockValidator.VerifyBody(block)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := chainService.chainStore.PutBlock(block)
+	if err != nil {
+		return err
+	}
+	//块不执行，投票的索引也要建立
+	err = chainService.chainStore.IndexVotes(block)
+	if err != nil {
+		return err
+	}
+	newNode := types.NewBlockNode(block.Header, prevNode)
+	//ancestors of the pivot are valid once its state is verified against the header
+	newNode.Status = types.StatusDataStored | types.StatusValid
+	chainService.blockIndex.AddNode(newNode)
+	return chainService.blockIndex.FlushToDB(chainService.chainStore.PutBlockNode)
+}
+
+// CommitSyncedState move the tip to a block of a state sync once its state is completely
+// downloaded, blocks after it are processed as usual. The state root is retained by the
+// pruner like the state of an executed block, and released once out of the retention window.
+func (chainService *ChainService) CommitSyncedState(hash *crypto.Hash) error {
+	chainService.addBlockSync.Lock()
+	defer chainService.addBlockSync.Unlock()
+	node := chainService.blockIndex.LookupNode(hash)
+	if node == nil {
+		return ErrBlockNotFound
+	}
+	if node.Height <= chainService.BestChain().Height() {
+		return nil
+	}
+	if !hasState(chainService.DatabaseService.LevelDb(), node.StateRoot) {
+		return ErrStateNotSynced
+	}
+	trieStore, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), node.StateRoot)
+	if err != nil {
+		return err
+	}
+	if chainService.statePruner != nil {
+		err = chainService.statePruner.Prune(trieStore.TrieDB(), node.Height, crypto.Bytes2Hash(node.StateRoot))
+		if err != nil {
+			return err
+		}
+	}
+	chainService.BestChain().SetTip(node)
+	log.WithField("Height", node.Height).WithField("Hash", hex.EncodeToString(node.Hash.Bytes())).Info("state sync committed")
+	return nil
+}
@@ -0,0 +1,77 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+)
+
+//syncState copy the state of root from source to the database of chainService like a state sync
+func syncState(t *testing.T, source, chainService *ChainService, root []byte) {
+	srcDb := source.DatabaseService.LevelDb()
+	diskdb := chainService.DatabaseService.LevelDb()
+	sched := trie.NewSync(crypto.Bytes2Hash(root), diskdb)
+	for sched.Pending() > 0 {
+		hashes := sched.Missing(0)
+		results := make([]trie.SyncResult, len(hashes))
+		for i, hash := range hashes {
+			data, _ := srcDb.Get(hash[:])
+			results[i] = trie.SyncResult{Hash: hash, Data: data}
+		}
+		if _, index, err := sched.Process(results); err != nil {
+			t.Fatalf("process state node #%d: %v", index, err)
+		}
+		batch := diskdb.NewBatch()
+		if err := sched.Commit(batch); err != nil {
+			t.Fatal(err)
+		}
+		batch.Write()
+	}
+}
+
+func TestInsertBlockWithoutState(t *testing.T) {
+	tester := newReorgTester(t)
+	source, blocks := exportTestBlocks(tester)
+
+	//the signatures of consensus are verified for blocks without state
+	chainService := tester.newChain()
+	chainService.AddBlockValidator(&rejectBodyValidator{})
+	if err := chainService.InsertBlockWithoutState(blocks[0]); err == nil {
+		t.Fatal("block with rejected signature inserted without state")
+	}
+
+	config := *DefaultChainConfig
+	config.GCMode = GCModeFull
+	config.StateRetention = 2
+	chainService = tester.newChainWithConfig(&config)
+	for _, block := range blocks[:3] {
+		if err := chainService.InsertBlockWithoutState(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pivot := blocks[2]
+	if err := chainService.CommitSyncedState(pivot.Header.Hash()); err != ErrStateNotSynced {
+		t.Fatalf("state committed before synced, err %v", err)
+	}
+	syncState(t, source, chainService, pivot.Header.StateRoot)
+	if err := chainService.CommitSyncedState(pivot.Header.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if *chainService.BestChain().Tip().Hash != *pivot.Header.Hash() {
+		t.Fatalf("tip not moved to the synced block, height %d", chainService.BestChain().Height())
+	}
+
+	//the synced state is released once out of the retention window
+	next := blocks[3]
+	for i := uint64(4); i < 7; i++ {
+		next = tester.makeBlock(next.Header, 1)
+		blocks = append(blocks, next)
+	}
+	tester.process(chainService, blocks[3:]...)
+	tester.checkState(chainService, blocks, crypto.CommonAddress{1}, crypto.CommonAddress{4})
+	if _, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), pivot.Header.StateRoot); err == nil {
+		t.Fatal("synced state not released out of the retention window")
+	}
+}
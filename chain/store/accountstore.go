@@ -11,8 +11,10 @@ import (
 )
 
 const (
-	AliasPrefix    = "alias"
-	AddressStorage = "AddressStorage" //以地址作为KEY的对象存储
+	AliasPrefix     = "alias"
+	AddressStorage  = "AddressStorage"  //以地址作为KEY的对象存储
+	ContractStorage = "ContractStorage" //合约地址下的storage slot
+	MultiSigStorage = "MultiSigStorage" //以地址作为KEY,存储多签账户的公钥集合，不放在Storage中以免改变账户的编码
 )
 
 var (
@@ -215,6 +217,66 @@ func (trieStore *trieAccountStore) GetCodeHash(addr *crypto.CommonAddress) crypt
 	return storage.CodeHash
 }
 
+//MultiSigKey returns the trie key of the multisig key set registered for addr
+func MultiSigKey(addr *crypto.CommonAddress) []byte {
+	return sha3.Keccak256([]byte(MultiSigStorage + addr.Hex()))
+}
+
+func (trieStore *trieAccountStore) GetMultiSigAccount(addr *crypto.CommonAddress) *types.MultiSigAccount {
+	value, err := trieStore.storeDB.Get(MultiSigKey(addr))
+	if err != nil || value == nil {
+		return nil
+	}
+	account := &types.MultiSigAccount{}
+	if err := binary.Unmarshal(value, account); err != nil {
+		return nil
+	}
+	return account
+}
+
+func (trieStore *trieAccountStore) PutMultiSigAccount(addr *crypto.CommonAddress, account *types.MultiSigAccount) error {
+	value, err := binary.Marshal(account)
+	if err != nil {
+		return err
+	}
+	return trieStore.storeDB.Put(MultiSigKey(addr), value)
+}
+
+//ContractStateKey returns the trie key of a storage slot, namespaced by the contract address
+func ContractStateKey(addr *crypto.CommonAddress, key []byte) []byte {
+	return sha3.Keccak256([]byte(ContractStorage+addr.Hex()), key)
+}
+
+//LegacyContractStateKey returns the trie key the evm stored a storage slot under before ContractStorageForkHeight
+func LegacyContractStateKey(addr *crypto.CommonAddress, key []byte) []byte {
+	return new(big.Int).SetBytes(sha3.HashS256(addr.Bytes(), key)).Bytes()
+}
+
+//GetContractState 读取合约storage slot，还没有迁移的slot从升级前的key读取
+func (trieStore *trieAccountStore) GetContractState(addr *crypto.CommonAddress, key []byte) ([]byte, error) {
+	value, err := trieStore.storeDB.Get(ContractStateKey(addr, key))
+	if err != nil || value != nil {
+		return value, err
+	}
+	return trieStore.storeDB.Get(LegacyContractStateKey(addr, key))
+}
+
+//PutContractState 写入合约storage slot，同时删除升级前的key，slot在第一次写入时迁移
+func (trieStore *trieAccountStore) PutContractState(addr *crypto.CommonAddress, key []byte, value []byte) error {
+	legacyKey := LegacyContractStateKey(addr, key)
+	legacy, err := trieStore.storeDB.Get(legacyKey)
+	if err != nil {
+		return err
+	}
+	if legacy != nil {
+		err = trieStore.storeDB.Delete(legacyKey)
+		if err != nil {
+			return err
+		}
+	}
+	return trieStore.storeDB.Put(ContractStateKey(addr, key), value)
+}
+
 func (trieStore *trieAccountStore) GetReputation(addr *crypto.CommonAddress) *big.Int {
 	storage, _ := trieStore.GetStorage(addr)
 	if storage == nil {
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/database/leveldb"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestContractStateNamespace(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+
+	store := storeInterface.(*Store)
+	b := store.RecoverTrie([]byte{})
+	if b != true {
+		t.Fatal("recover trie err")
+	}
+
+	pri, _ := crypto.GenerateKey(rand.Reader)
+	contractA := crypto.PubkeyToAddress(pri.PubKey())
+	pri, _ = crypto.GenerateKey(rand.Reader)
+	contractB := crypto.PubkeyToAddress(pri.PubKey())
+
+	slot := []byte{0}
+	if err := store.PutContractState(&contractA, slot, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutContractState(&contractB, slot, []byte{2}); err != nil {
+		t.Fatal(err)
+	}
+
+	valueA, err := store.GetContractState(&contractA, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(valueA, []byte{1}) {
+		t.Fatalf("contract A slot 0 overwritten, got %x", valueA)
+	}
+	valueB, err := store.GetContractState(&contractB, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(valueB, []byte{2}) {
+		t.Fatalf("contract B slot 0 mismatch, got %x", valueB)
+	}
+}
+
+func TestContractStateMigration(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	store := storeInterface.(*Store)
+
+	pri, _ := crypto.GenerateKey(rand.Reader)
+	contract := crypto.PubkeyToAddress(pri.PubKey())
+	slot := []byte{0}
+
+	//written by a block before ContractStorageForkHeight
+	legacyKey := LegacyContractStateKey(&contract, slot)
+	if err := store.Put(legacyKey, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	value, err := store.GetContractState(&contract, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value, []byte{1}) {
+		t.Fatalf("legacy slot not read, got %x", value)
+	}
+
+	if err := store.PutContractState(&contract, slot, []byte{2}); err != nil {
+		t.Fatal(err)
+	}
+	value, _ = store.Get(legacyKey)
+	if value != nil {
+		t.Fatalf("legacy slot not deleted after migration, got %x", value)
+	}
+	value, _ = store.GetContractState(&contract, slot)
+	if !bytes.Equal(value, []byte{2}) {
+		t.Fatalf("migrated slot mismatch, got %x", value)
+	}
+}
+
+//多签公钥集合单独存储，账户Storage的编码保持不变
+func TestMultiSigAccountKeepStorage(t *testing.T) {
+	defer os.RemoveAll("./test")
+	store := newTestStore(t).(*Store)
+
+	pri, _ := crypto.GenerateKey(rand.Reader)
+	addr := crypto.PubkeyToAddress(pri.PubKey())
+	if err := store.PutBalance(&addr, 0, coins(1)); err != nil {
+		t.Fatal(err)
+	}
+	storageKey := sha3.Keccak256([]byte(AddressStorage + addr.Hex()))
+	before, _ := store.account.storeDB.Get(storageKey)
+
+	account := &types.MultiSigAccount{Threshold: 1, PubKeys: []*secp256k1.PublicKey{pri.PubKey()}}
+	if err := store.PutMultiSigAccount(&addr, account); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := store.account.storeDB.Get(storageKey)
+	if !bytes.Equal(before, after) {
+		t.Fatal("multisig key set changed the encoding of account storage")
+	}
+	got := store.GetMultiSigAccount(&addr)
+	if got == nil || got.Threshold != 1 || len(got.PubKeys) != 1 {
+		t.Fatalf("multisig account mismatch, got %v", got)
+	}
+	other := crypto.CommonAddress{1}
+	if store.GetMultiSigAccount(&other) != nil {
+		t.Fatal("unexpected multisig account")
+	}
+}
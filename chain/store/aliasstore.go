@@ -0,0 +1,195 @@
+package store
+
+import (
+	"strconv"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+const (
+	AliasLeaseHeight  = "AliasLeaseHeight"  //以到期高度作为KEY,存储在该高度到期的租用别名
+	AliasLeaseStorage = "AliasLeaseStorage" //以别名作为KEY,存储租用信息
+	AliasHistory      = "AliasHistory"      //以别名作为KEY,存储别名的变更记录
+)
+
+//AliasDelete remove the binding of alias, both the alias key and the alias in owner storage
+func (trieStore *trieAccountStore) AliasDelete(alias string) error {
+	if !trieStore.AliasExist(alias) {
+		return nil
+	}
+	owner, err := trieStore.AliasGet(alias)
+	if err != nil {
+		return err
+	}
+	err = trieStore.storeDB.Delete([]byte(AliasPrefix + alias))
+	if err != nil {
+		return err
+	}
+	if trieStore.GetStorageAlias(owner) == alias {
+		return trieStore.setStorageAlias(owner, "")
+	}
+	return nil
+}
+
+//AliasTransfer bind the alias of from to another address which has no alias
+func (trieStore *trieAccountStore) AliasTransfer(from, to *crypto.CommonAddress, alias string) error {
+	err := trieStore.AliasPut(alias, to.Bytes())
+	if err != nil {
+		return err
+	}
+	err = trieStore.setStorageAlias(from, "")
+	if err != nil {
+		return err
+	}
+	return trieStore.setStorageAlias(to, alias)
+}
+
+func aliasLeaseKey(alias string) []byte {
+	return sha3.Keccak256([]byte(AliasLeaseStorage + alias))
+}
+
+func (trieStore *trieAccountStore) GetAliasLease(alias string) (*types.AliasLease, error) {
+	value, err := trieStore.storeDB.Get(aliasLeaseKey(alias))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	lease := &types.AliasLease{}
+	err = binary.Unmarshal(value, lease)
+	if err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+func aliasLeaseHeightKey(height uint64) []byte {
+	return []byte(AliasLeaseHeight + strconv.FormatUint(height, 10))
+}
+
+func (trieStore *trieAccountStore) getAliases(key []byte) ([]string, error) {
+	value, err := trieStore.storeDB.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	aliases := []string{}
+	if value == nil {
+		return aliases, nil
+	}
+	err = binary.Unmarshal(value, &aliases)
+	if err != nil {
+		return nil, err
+	}
+	return aliases, nil
+}
+
+func (trieStore *trieAccountStore) putAliases(key []byte, aliases []string) error {
+	if len(aliases) == 0 {
+		return trieStore.storeDB.Delete(key)
+	}
+	value, err := binary.Marshal(aliases)
+	if err != nil {
+		return err
+	}
+	return trieStore.storeDB.Put(key, value)
+}
+
+//PutAliasLease create or update the lease of alias, the lease is indexed by its expire height
+func (trieStore *trieAccountStore) PutAliasLease(lease *types.AliasLease) error {
+	old, err := trieStore.GetAliasLease(lease.Alias)
+	if err != nil {
+		return err
+	}
+	value, err := binary.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	err = trieStore.storeDB.Put(aliasLeaseKey(lease.Alias), value)
+	if err != nil {
+		return err
+	}
+	if old != nil && old.ExpireHeight == lease.ExpireHeight {
+		return nil
+	}
+	key := aliasLeaseHeightKey(lease.ExpireHeight)
+	aliases, err := trieStore.getAliases(key)
+	if err != nil {
+		return err
+	}
+	return trieStore.putAliases(key, append(aliases, lease.Alias))
+}
+
+// DelAliasLease delete the lease, the index entries of it are dropped when they are visited
+func (trieStore *trieAccountStore) DelAliasLease(alias string) error {
+	return trieStore.storeDB.Delete(aliasLeaseKey(alias))
+}
+
+// PopExpiredAliasLeases remove the leases ending at the block before height from the index and
+// return them. It must be called at every height so that no height index is skipped
+func (trieStore *trieAccountStore) PopExpiredAliasLeases(height uint64) ([]*types.AliasLease, error) {
+	expired := []*types.AliasLease{}
+	if height == 0 {
+		return expired, nil
+	}
+	key := aliasLeaseHeightKey(height - 1)
+	aliases, err := trieStore.getAliases(key)
+	if err != nil {
+		return nil, err
+	}
+	visited := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		//释放后又租用的别名可能在同一高度出现两次
+		if visited[alias] {
+			continue
+		}
+		visited[alias] = true
+		lease, err := trieStore.GetAliasLease(alias)
+		if err != nil {
+			return nil, err
+		}
+		//已释放或已续租
+		if lease == nil || lease.ExpireHeight != height-1 {
+			continue
+		}
+		expired = append(expired, lease)
+	}
+	if len(aliases) > 0 {
+		err = trieStore.storeDB.Delete(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return expired, nil
+}
+
+func (trieStore *trieAccountStore) GetAliasHistory(alias string) ([]*types.AliasRecord, error) {
+	value, err := trieStore.storeDB.Get(sha3.Keccak256([]byte(AliasHistory + alias)))
+	if err != nil {
+		return nil, err
+	}
+	records := []*types.AliasRecord{}
+	if value == nil {
+		return records, nil
+	}
+	err = binary.Unmarshal(value, &records)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (trieStore *trieAccountStore) AddAliasHistory(record *types.AliasRecord) error {
+	records, err := trieStore.GetAliasHistory(record.Alias)
+	if err != nil {
+		return err
+	}
+	value, err := binary.Marshal(append(records, record))
+	if err != nil {
+		return err
+	}
+	return trieStore.storeDB.Put(sha3.Keccak256([]byte(AliasHistory+record.Alias)), value)
+}
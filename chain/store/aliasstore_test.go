@@ -0,0 +1,120 @@
+package store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/database/leveldb"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestAliasTransferAndDelete(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+
+	store := storeInterface.(*Store)
+	if !store.RecoverTrie([]byte{}) {
+		t.Fatal("recover trie err")
+	}
+
+	from := crypto.CommonAddress{1}
+	to := crypto.CommonAddress{2}
+	if err := store.AliasSet(&from, "tom123"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.AliasTransfer(&from, &to, "tom123"); err != nil {
+		t.Fatal(err)
+	}
+	if store.GetStorageAlias(&from) != "" || store.GetStorageAlias(&to) != "tom123" {
+		t.Fatal("alias not transferred in storage")
+	}
+	owner, err := store.AliasGet("tom123")
+	if err != nil || *owner != to {
+		t.Fatalf("alias owner mismatch, %v %v", owner, err)
+	}
+
+	if err := store.AliasDelete("tom123"); err != nil {
+		t.Fatal(err)
+	}
+	if store.AliasExist("tom123") || store.GetStorageAlias(&to) != "" {
+		t.Fatal("alias still bound after delete")
+	}
+	if err := store.AliasSet(&from, "tom123"); err != nil {
+		t.Fatalf("released alias can not be set again, %v", err)
+	}
+}
+
+func TestAliasLeaseAndHistory(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+
+	store := storeInterface.(*Store)
+	if !store.RecoverTrie([]byte{}) {
+		t.Fatal("recover trie err")
+	}
+
+	for _, alias := range []string{"alias1", "alias2", "alias3"} {
+		if err := store.PutAliasLease(&types.AliasLease{Alias: alias, ExpireHeight: 10}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	//renewing a lease moves it to the index of the new expire height
+	if err := store.PutAliasLease(&types.AliasLease{Alias: "alias1", ExpireHeight: 20}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DelAliasLease("alias2"); err != nil {
+		t.Fatal(err)
+	}
+	//released and leased again with the same expire height
+	if err := store.DelAliasLease("alias3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.PutAliasLease(&types.AliasLease{Alias: "alias3", ExpireHeight: 10}); err != nil {
+		t.Fatal(err)
+	}
+	leases, err := store.PopExpiredAliasLeases(10)
+	if err != nil || len(leases) != 0 {
+		t.Fatalf("leases not expired yet, %v %v", leases, err)
+	}
+	leases, err = store.PopExpiredAliasLeases(11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(leases) != 1 || leases[0].Alias != "alias3" {
+		t.Fatalf("unexpected expired leases %v", leases)
+	}
+	if leases, _ = store.PopExpiredAliasLeases(11); len(leases) != 0 {
+		t.Fatalf("expired leases popped twice, %v", leases)
+	}
+	leases, err = store.PopExpiredAliasLeases(21)
+	if err != nil || len(leases) != 1 || leases[0].Alias != "alias1" {
+		t.Fatalf("renewed lease not expired, %v %v", leases, err)
+	}
+	lease, err := store.GetAliasLease("alias1")
+	if err != nil || lease == nil || lease.ExpireHeight != 20 {
+		t.Fatalf("alias lease mismatch, %v %v", lease, err)
+	}
+
+	actions := []types.AliasAction{types.AliasLeaseAction, types.AliasRenewAction, types.AliasExpireAction}
+	for i, action := range actions {
+		if err := store.AddAliasHistory(&types.AliasRecord{Alias: "alias1", Action: action, Height: uint64(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records, err := store.GetAliasHistory("alias1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != len(actions) {
+		t.Fatalf("expect %d records, got %d", len(actions), len(records))
+	}
+	for i, record := range records {
+		if record.Action != actions[i] || record.Height != uint64(i) {
+			t.Fatalf("record %d mismatch %v", i, record)
+		}
+	}
+}
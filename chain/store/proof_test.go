@@ -0,0 +1,68 @@
+package store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/database/leveldb"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+func TestAccountProof(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	store, err := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 50; i++ {
+		addr := crypto.CommonAddress{byte(i), 2}
+		if err := store.PutNonce(&addr, uint64(i+1)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store.Commit()
+	root := crypto.Bytes2Hash(store.GetStateRoot())
+	if err := store.TrieDB().Commit(root, false); err != nil {
+		t.Fatal(err)
+	}
+
+	stateTrie, err := trie.NewSecure(root, trie.NewDatabase(diskDB))
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := func(addr crypto.CommonAddress) []byte {
+		return sha3.Keccak256([]byte(AddressStorage + addr.Hex()))
+	}
+
+	addr := crypto.CommonAddress{7, 2}
+	var proof trie.ProofList
+	if err := stateTrie.Prove(key(addr), 0, &proof); err != nil {
+		t.Fatal(err)
+	}
+	value, err := trie.VerifySecureProof(root, key(addr), proof.NodeSet())
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage := &types.Storage{}
+	if err := binary.Unmarshal(value, storage); err != nil || storage.Nonce != 8 {
+		t.Fatalf("proved storage mismatch, %v %v", storage, err)
+	}
+	if _, err := trie.VerifySecureProof(crypto.Hash{1}, key(addr), proof.NodeSet()); err == nil {
+		t.Fatal("proof verified against a wrong root")
+	}
+
+	absent := crypto.CommonAddress{0xff, 0xff}
+	proof = nil
+	if err := stateTrie.Prove(key(absent), 0, &proof); err != nil {
+		t.Fatal(err)
+	}
+	value, err = trie.VerifySecureProof(root, key(absent), proof.NodeSet())
+	if err != nil || value != nil {
+		t.Fatalf("absent account should be proved empty, %v %v", value, err)
+	}
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/database/leveldb"
+)
+
+func TestPruneStateRoot(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	if err := trie.EnableRefCount(diskDB); err != nil {
+		t.Fatal(err)
+	}
+
+	commit := func(root []byte, nonces map[crypto.CommonAddress]uint64) []byte {
+		store, err := TrieStoreFromStore(diskDB, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for addr, nonce := range nonces {
+			addr := addr
+			if err := store.PutNonce(&addr, nonce); err != nil {
+				t.Fatal(err)
+			}
+		}
+		store.Commit()
+		newRoot := store.GetStateRoot()
+		if err := store.TrieDB().Commit(crypto.Bytes2Hash(newRoot), true); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.TrieDB().ReferenceRoot(crypto.Bytes2Hash(newRoot)); err != nil {
+			t.Fatal(err)
+		}
+		return newRoot
+	}
+
+	nonces := make(map[crypto.CommonAddress]uint64)
+	for i := 0; i < 50; i++ {
+		nonces[crypto.CommonAddress{byte(i), 1}] = uint64(i + 1)
+	}
+	root1 := commit(trie.EmptyRoot[:], nonces)
+	root2 := commit(root1, map[crypto.CommonAddress]uint64{{3, 1}: 100})
+
+	store, _ := TrieStoreFromStore(diskDB, root2)
+	deleted, err := store.TrieDB().DereferenceRoot(crypto.Bytes2Hash(root1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted == 0 {
+		t.Fatal("no trie node released with the old root")
+	}
+	if _, err := TrieStoreFromStore(diskDB, root1); err == nil {
+		t.Fatal("pruned state root still recoverable")
+	}
+
+	store, err = TrieStoreFromStore(diskDB, root2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for addr, nonce := range nonces {
+		addr := addr
+		if addr == (crypto.CommonAddress{3, 1}) {
+			nonce = 100
+		}
+		if store.GetNonce(&addr) != nonce {
+			t.Fatalf("nonce of %s lost after prune", addr.String())
+		}
+	}
+}
+
+func TestPruneStateRootConcurrentCommit(t *testing.T) {
+	defer os.RemoveAll("./test_concurrent")
+	diskDB, _ := leveldb.New("./test_concurrent", 16, 512, "")
+	if err := trie.EnableRefCount(diskDB); err != nil {
+		t.Fatal(err)
+	}
+
+	//账户0的nonce每轮不同，其余账户的nonce在两个值之间交替，新提交的节点正是上上个状态根被释放的节点
+	nonceAt := func(i int, round uint64) uint64 {
+		if i == 0 {
+			return round
+		}
+		return round%2 + uint64(i)
+	}
+	commit := func(root []byte, round uint64) []byte {
+		store, err := TrieStoreFromStore(diskDB, root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 200; i++ {
+			addr := crypto.CommonAddress{byte(i), 2}
+			if err := store.PutNonce(&addr, nonceAt(i, round)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		store.Commit()
+		newRoot := store.GetStateRoot()
+		if err := store.TrieDB().Commit(crypto.Bytes2Hash(newRoot), false); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.TrieDB().ReferenceRoot(crypto.Bytes2Hash(newRoot)); err != nil {
+			t.Fatal(err)
+		}
+		return newRoot
+	}
+
+	roots := make(chan []byte, 100)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for root := range roots {
+			store, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+			if _, err := store.TrieDB().DereferenceRoot(crypto.Bytes2Hash(root)); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+
+	//释放上一个状态根的同时，在当前状态上提交下一个状态
+	root := trie.EmptyRoot[:]
+	for round := uint64(1); round <= 100; round++ {
+		parent := root
+		root = commit(parent, round)
+		if round > 1 {
+			roots <- parent
+		}
+	}
+	close(roots)
+	wg.Wait()
+
+	store, err := TrieStoreFromStore(diskDB, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 200; i++ {
+		addr := crypto.CommonAddress{byte(i), 2}
+		if store.GetNonce(&addr) != nonceAt(i, 100) {
+			t.Fatalf("nonce of %s lost after concurrent prune", addr.String())
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/drep-project/DREP-Chain/common"
 	"github.com/drep-project/DREP-Chain/params"
 	"math/big"
+	"sort"
 
 	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/crypto/sha3"
@@ -16,6 +17,12 @@ import (
 const (
 	CandidateAddrs             = "CandidateAddrs" //参与竞选出块节点的地址集合
 	StakeStorage               = "StakeStorage"   //以地址作为KEY,存储stake相关内容
+	RedelegateHeight           = "Redelegate"     //以地址作为KEY,存储该地址上次转投的高度
+	SlashInfo                  = "SlashInfo"      //以地址作为KEY,存储候选人的惩罚记录
+	JailedAddrs                = "JailedAddrs"    //被暂停出块资格的候选人地址集合
+	UnbondingCredit            = "Unbonding"      //以候选人地址作为KEY,存储从该候选人撤销、尚未到期的抵押
+	RedelegatedCredit          = "Redelegated"    //以候选人地址作为KEY,存储从该候选人转投出去、仍在惩罚期内的票
+	slashRateBase              = 1000             //罚没比例的单位为千分之一
 	registerPledgeLimit uint64 = 1000000          //候选节点需要抵押币的总数,单位1drep
 	interestRate               = 1000000 * 12     //每个存储高度，奖励的利率
 
@@ -24,6 +31,10 @@ const (
 	oneYearHeight    = 6220800 //12个月出块高度
 )
 
+var (
+	ErrRedelegateCooldown = errors.New("redelegate again within the change interval of last redelegation")
+)
+
 type trieStakeStore struct {
 	store *StoreDB
 }
@@ -318,6 +329,9 @@ func (trieStore *trieStakeStore) cancelCredit(fromAddr, toAddr *crypto.CommonAdd
 	}
 
 	err := trieStore.putStakeStorage(fromAddr, storage)
+	if err == nil && params.IsSlashingFork(height) {
+		err = trieStore.addUnbondingCredit(toAddr, fromAddr, cancelBalance, height, changeInterval)
+	}
 
 	return &interestData, err
 }
@@ -437,8 +451,8 @@ func (trieStore *trieStakeStore) GetCreditDetails(addr *crypto.CommonAddress) ma
 	return m
 }
 
-func (trieStore *trieStakeStore) CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data []byte, height uint64) error {
-	if addresses == nil {
+func (trieStore *trieStakeStore) CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, candidateData *types.CandidateData, height uint64) error {
+	if addresses == nil || candidateData == nil {
 		return errors.New("candidate credit param err")
 	}
 	storage, _ := trieStore.getStakeStorage(addresses)
@@ -481,12 +495,7 @@ func (trieStore *trieStakeStore) CandidateCredit(addresses *crypto.CommonAddress
 			trieStore.AddCandidateAddr(addresses)
 		}
 	}
-	candidataDate := &types.CandidateData{}
-	err := candidataDate.Unmarshal(data)
-	if err != nil {
-		return err
-	}
-	data, err = binary.Marshal(candidataDate)
+	data, _ := binary.Marshal(candidateData)
 	if len(data) > 0 {
 		update = true
 		storage.CandidateData = data
@@ -511,3 +520,560 @@ func (trieStore *trieStakeStore) CancelCandidateCredit(fromAddr *crypto.CommonAd
 		return storage, nil
 	})
 }
+
+//addr投出的所有票，投给自己的抵押也包含在内，从voted和candidates的抵押中查找
+//链上不发放利息，Interest为0，ProjectedPayout为本金
+func (trieStore *trieStakeStore) GetVoteDetails(addr *crypto.CommonAddress, voted, candidates []crypto.CommonAddress, height uint64, changeInterval uint64) ([]*types.VoteDetail, error) {
+	details := make([]*types.VoteDetail, 0)
+	seen := make(map[crypto.CommonAddress]bool)
+	for _, candidate := range append(append([]crypto.CommonAddress{*addr}, voted...), candidates...) {
+		if seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		storage, _ := trieStore.getStakeStorage(&candidate)
+		if storage == nil {
+			continue
+		}
+		for _, rc := range storage.RC {
+			if rc.Addr != *addr {
+				continue
+			}
+			for _, hv := range rc.HeghtValues {
+				details = append(details, &types.VoteDetail{
+					Candidate:       candidate,
+					Amount:          hv.CreditValue,
+					Height:          hv.CreditHeight,
+					MaturityHeight:  height + changeInterval,
+					ProjectedPayout: hv.CreditValue,
+				})
+			}
+		}
+	}
+	return details, nil
+}
+
+//addr撤销后还没有退回余额的抵押，到期的判断与GetCancelCreditForBalance相同
+func (trieStore *trieStakeStore) GetUnbondingDetails(addr *crypto.CommonAddress, height uint64, changeInterval uint64) []*types.UnbondingDetail {
+	details := make([]*types.UnbondingDetail, 0)
+	storage, _ := trieStore.getStakeStorage(addr)
+	if storage == nil {
+		return details
+	}
+	for _, cc := range storage.CC {
+		for _, value := range cc.CancelCreditValue {
+			details = append(details, &types.UnbondingDetail{
+				Height:         cc.CancelCreditHeight,
+				Amount:         common.Big(value),
+				MaturityHeight: cc.CancelCreditHeight + changeInterval,
+				Matured:        height >= cc.CancelCreditHeight+changeInterval,
+			})
+		}
+	}
+	return details
+}
+
+//上次转投的高度，没有转投过返回false
+func (trieStore *trieStakeStore) getRedelegateHeight(addr *crypto.CommonAddress) (uint64, bool, error) {
+	key := sha3.Keccak256([]byte(RedelegateHeight + addr.Hex()))
+	value, err := trieStore.store.Get(key)
+	if err != nil || value == nil {
+		return 0, false, err
+	}
+	var height uint64
+	err = binary.Unmarshal(value, &height)
+	if err != nil {
+		return 0, false, err
+	}
+	return height, true, nil
+}
+
+func (trieStore *trieStakeStore) putRedelegateHeight(addr *crypto.CommonAddress, height uint64) error {
+	key := sha3.Keccak256([]byte(RedelegateHeight + addr.Hex()))
+	value, err := binary.Marshal(height)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put(key, value)
+}
+
+//把fromAddr投给oldAddr的票转投给newAddr，与撤销一样从最早的票开始转，转走的票保留原来的投票高度
+//两次转投之间至少间隔changeInterval，防止在候选人之间频繁转投
+func (trieStore *trieStakeStore) RedelegateCredit(fromAddr, oldAddr, newAddr *crypto.CommonAddress, amount *big.Int, height uint64, changeInterval uint64) ([]types.HeightValue, error) {
+	if fromAddr == nil || oldAddr == nil || newAddr == nil {
+		return nil, errors.New("addr cannot equal nil")
+	}
+	if *oldAddr == *newAddr || *fromAddr == *oldAddr || *fromAddr == *newAddr {
+		return nil, errors.New("redelegate between the same address")
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("redelegate credit value(%v) <= 0", amount)
+	}
+	last, found, err := trieStore.getRedelegateHeight(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	if found && height < last+changeInterval {
+		return nil, ErrRedelegateCooldown
+	}
+
+	oldStorage, _ := trieStore.getStakeStorage(oldAddr)
+	if oldStorage == nil {
+		return nil, fmt.Errorf("not exist vote credit")
+	}
+	index := -1
+	total := new(big.Int)
+	for i, rc := range oldStorage.RC {
+		if rc.Addr == *fromAddr {
+			index = i
+			for _, hv := range rc.HeghtValues {
+				total.Add(total, hv.CreditValue.ToInt())
+			}
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("not exist vote credit")
+	}
+	if total.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("vote credit not enough")
+	}
+
+	remain := new(big.Int).Set(amount)
+	moved := make([]types.HeightValue, 0, 1)
+	left := make([]types.HeightValue, 0)
+	for _, hv := range oldStorage.RC[index].HeghtValues {
+		value := hv.CreditValue.ToInt()
+		if remain.Sign() == 0 {
+			left = append(left, hv)
+		} else if remain.Cmp(value) >= 0 {
+			moved = append(moved, hv)
+			remain.Sub(remain, value)
+		} else {
+			moved = append(moved, types.HeightValue{hv.CreditHeight, common.Big(*new(big.Int).Set(remain))})
+			left = append(left, types.HeightValue{hv.CreditHeight, common.Big(*new(big.Int).Sub(value, remain))})
+			remain.SetUint64(0)
+		}
+	}
+	if len(left) == 0 {
+		oldStorage.RC = append(oldStorage.RC[0:index], oldStorage.RC[index+1:]...)
+	} else {
+		oldStorage.RC[index].HeghtValues = left
+	}
+	err = trieStore.putStakeStorage(oldAddr, oldStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	newStorage, _ := trieStore.getStakeStorage(newAddr)
+	if newStorage == nil {
+		newStorage = &types.StakeStorage{}
+	}
+	found = false
+	for i, rc := range newStorage.RC {
+		if rc.Addr == *fromAddr {
+			found = true
+			heightValues := append(rc.HeghtValues, moved...)
+			//按投票高度排序，撤销时仍然先撤销最早的票
+			sort.SliceStable(heightValues, func(i, j int) bool {
+				return heightValues[i].CreditHeight < heightValues[j].CreditHeight
+			})
+			newStorage.RC[i].HeghtValues = heightValues
+			break
+		}
+	}
+	if !found {
+		newStorage.RC = append(newStorage.RC, types.ReceivedCredit{Addr: *fromAddr, HeghtValues: moved})
+	}
+	err = trieStore.putStakeStorage(newAddr, newStorage)
+	if err != nil {
+		return nil, err
+	}
+	if params.IsSlashingFork(height) {
+		err = trieStore.addRedelegatedCredit(oldAddr, fromAddr, newAddr, amount, height, changeInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return moved, trieStore.putRedelegateHeight(fromAddr, height)
+}
+
+func (trieStore *trieStakeStore) GetSlashInfo(addr *crypto.CommonAddress) (*types.SlashInfo, error) {
+	info := &types.SlashInfo{}
+	key := sha3.Keccak256([]byte(SlashInfo + addr.Hex()))
+	value, err := trieStore.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return info, nil
+	}
+	err = binary.Unmarshal(value, info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func (trieStore *trieStakeStore) PutSlashInfo(addr *crypto.CommonAddress, info *types.SlashInfo) error {
+	key := sha3.Keccak256([]byte(SlashInfo + addr.Hex()))
+	value, err := binary.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put(key, value)
+}
+
+//按千分之rate罚没投给候选人addr的每一笔票，包括候选人自己的抵押和从候选人撤销、在height尚未到期的抵押，罚没的币直接销毁
+//候选人的抵押不足时被撤销候选人资格
+func (trieStore *trieStakeStore) SlashCredit(addr *crypto.CommonAddress, rate uint64, height uint64, changeInterval uint64) (*big.Int, error) {
+	if addr == nil {
+		return nil, errors.New("addr cannot equal nil")
+	}
+	if rate > slashRateBase {
+		return nil, fmt.Errorf("slash rate(%d) > %d", rate, slashRateBase)
+	}
+	if rate == 0 {
+		return new(big.Int), nil
+	}
+
+	//撤销和转投走的抵押不在候选人的stakeStorage中，候选人的抵押全部撤销后仍然要罚没
+	slashed, err := trieStore.slashUnbondingCredit(addr, rate, height, changeInterval)
+	if err != nil {
+		return nil, err
+	}
+	redelegated, err := trieStore.slashRedelegatedCredit(addr, rate, height, changeInterval)
+	if err != nil {
+		return nil, err
+	}
+	slashed.Add(slashed, redelegated)
+	storage, _ := trieStore.getStakeStorage(addr)
+	if storage == nil {
+		return slashed, nil
+	}
+
+	pledge := new(big.Int)
+	left := make([]types.ReceivedCredit, 0, len(storage.RC))
+	for _, rc := range storage.RC {
+		heightValues := make([]types.HeightValue, 0, len(rc.HeghtValues))
+		for _, hv := range rc.HeghtValues {
+			value := hv.CreditValue.ToInt()
+			cut := slashCut(value, rate)
+			slashed.Add(slashed, cut)
+			remain := new(big.Int).Sub(value, cut)
+			if remain.Sign() > 0 {
+				heightValues = append(heightValues, types.HeightValue{hv.CreditHeight, common.Big(*remain)})
+				if rc.Addr == *addr {
+					pledge.Add(pledge, remain)
+				}
+			}
+		}
+		if len(heightValues) == 0 {
+			continue
+		}
+		left = append(left, types.ReceivedCredit{Addr: rc.Addr, HeghtValues: heightValues})
+	}
+	storage.RC = left
+	err = trieStore.putStakeStorage(addr, storage)
+	if err != nil {
+		return nil, err
+	}
+
+	if pledge.Cmp(new(big.Int).Mul(new(big.Int).SetUint64(registerPledgeLimit), new(big.Int).SetUint64(params.Coin))) < 0 {
+		err = trieStore.DelCandidateAddr(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return slashed, nil
+}
+
+func slashCut(value *big.Int, rate uint64) *big.Int {
+	cut := new(big.Int).Mul(value, new(big.Int).SetUint64(rate))
+	return cut.Div(cut, new(big.Int).SetUint64(slashRateBase))
+}
+
+func (trieStore *trieStakeStore) getUnbondingCredits(addr *crypto.CommonAddress) ([]types.UnbondingCredit, error) {
+	key := sha3.Keccak256([]byte(UnbondingCredit + addr.Hex()))
+	value, err := trieStore.store.Get(key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	credits := []types.UnbondingCredit{}
+	err = binary.Unmarshal(value, &credits)
+	if err != nil {
+		return nil, err
+	}
+	return credits, nil
+}
+
+func (trieStore *trieStakeStore) putUnbondingCredits(addr *crypto.CommonAddress, credits []types.UnbondingCredit) error {
+	key := sha3.Keccak256([]byte(UnbondingCredit + addr.Hex()))
+	if len(credits) == 0 {
+		return trieStore.store.Delete(key)
+	}
+	value, err := binary.Marshal(credits)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put(key, value)
+}
+
+//记录fromAddr在height从候选人addr撤销的抵押，同时清理已经到期的记录
+func (trieStore *trieStakeStore) addUnbondingCredit(addr, fromAddr *crypto.CommonAddress, value *big.Int, height uint64, changeInterval uint64) error {
+	credits, err := trieStore.getUnbondingCredits(addr)
+	if err != nil {
+		return err
+	}
+	left := make([]types.UnbondingCredit, 0, len(credits)+1)
+	for _, credit := range credits {
+		if height < credit.Height+changeInterval {
+			left = append(left, credit)
+		}
+	}
+	left = append(left, types.UnbondingCredit{Addr: *fromAddr, Height: height, Value: common.Big(*value)})
+	return trieStore.putUnbondingCredits(addr, left)
+}
+
+//按比例罚没从候选人addr撤销、在height尚未到期的抵押，到期的抵押已经计入余额，不再罚没
+//同一地址同一高度撤销的抵押同时到期，按高度和金额找到CC中对应的一笔即可
+func (trieStore *trieStakeStore) slashUnbondingCredit(addr *crypto.CommonAddress, rate uint64, height uint64, changeInterval uint64) (*big.Int, error) {
+	slashed := new(big.Int)
+	credits, err := trieStore.getUnbondingCredits(addr)
+	if err != nil || len(credits) == 0 {
+		return slashed, err
+	}
+
+	left := make([]types.UnbondingCredit, 0, len(credits))
+	for _, credit := range credits {
+		if height >= credit.Height+changeInterval {
+			continue
+		}
+		storage, _ := trieStore.getStakeStorage(&credit.Addr)
+		if storage == nil {
+			continue
+		}
+		value := credit.Value.ToInt()
+		cut := slashCut(value, rate)
+		remain := new(big.Int).Sub(value, cut)
+		if !slashCancelCredit(storage, credit.Height, value, remain) {
+			continue
+		}
+		err = trieStore.putStakeStorage(&credit.Addr, storage)
+		if err != nil {
+			return nil, err
+		}
+		slashed.Add(slashed, cut)
+		if remain.Sign() > 0 {
+			credit.Value = common.Big(*remain)
+			left = append(left, credit)
+		}
+	}
+	return slashed, trieStore.putUnbondingCredits(addr, left)
+}
+
+func (trieStore *trieStakeStore) getRedelegatedCredits(addr *crypto.CommonAddress) ([]types.RedelegatedCredit, error) {
+	key := sha3.Keccak256([]byte(RedelegatedCredit + addr.Hex()))
+	value, err := trieStore.store.Get(key)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	credits := []types.RedelegatedCredit{}
+	err = binary.Unmarshal(value, &credits)
+	if err != nil {
+		return nil, err
+	}
+	return credits, nil
+}
+
+func (trieStore *trieStakeStore) putRedelegatedCredits(addr *crypto.CommonAddress, credits []types.RedelegatedCredit) error {
+	key := sha3.Keccak256([]byte(RedelegatedCredit + addr.Hex()))
+	if len(credits) == 0 {
+		return trieStore.store.Delete(key)
+	}
+	value, err := binary.Marshal(credits)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put(key, value)
+}
+
+//记录fromAddr在height从候选人addr转投给toAddr的票，同时清理已经过了惩罚期的记录
+func (trieStore *trieStakeStore) addRedelegatedCredit(addr, fromAddr, toAddr *crypto.CommonAddress, value *big.Int, height uint64, changeInterval uint64) error {
+	credits, err := trieStore.getRedelegatedCredits(addr)
+	if err != nil {
+		return err
+	}
+	left := make([]types.RedelegatedCredit, 0, len(credits)+1)
+	for _, credit := range credits {
+		if height < credit.Height+changeInterval {
+			left = append(left, credit)
+		}
+	}
+	left = append(left, types.RedelegatedCredit{Addr: *fromAddr, To: *toAddr, Height: height, Value: common.Big(*value)})
+	return trieStore.putRedelegatedCredits(addr, left)
+}
+
+//按比例罚没从候选人addr转投出去、在height仍在惩罚期内的票，从新候选人处投票地址的票中扣除
+//投票地址已经从新候选人撤销或再次转投的部分不再追溯，只罚没仍然留在新候选人处的票
+func (trieStore *trieStakeStore) slashRedelegatedCredit(addr *crypto.CommonAddress, rate uint64, height uint64, changeInterval uint64) (*big.Int, error) {
+	slashed := new(big.Int)
+	credits, err := trieStore.getRedelegatedCredits(addr)
+	if err != nil || len(credits) == 0 {
+		return slashed, err
+	}
+
+	left := make([]types.RedelegatedCredit, 0, len(credits))
+	for _, credit := range credits {
+		if height >= credit.Height+changeInterval {
+			continue
+		}
+		storage, _ := trieStore.getStakeStorage(&credit.To)
+		if storage == nil {
+			continue
+		}
+		value := credit.Value.ToInt()
+		cut := slashReceivedCredit(storage, &credit.Addr, slashCut(value, rate))
+		if cut.Sign() == 0 {
+			continue
+		}
+		err = trieStore.putStakeStorage(&credit.To, storage)
+		if err != nil {
+			return nil, err
+		}
+		slashed.Add(slashed, cut)
+		remain := new(big.Int).Sub(value, cut)
+		if remain.Sign() > 0 {
+			credit.Value = common.Big(*remain)
+			left = append(left, credit)
+		}
+	}
+	return slashed, trieStore.putRedelegatedCredits(addr, left)
+}
+
+//从storage中addr投的票里扣除cut，从最早的票开始扣，票不足时全部扣除，返回实际扣除的数量
+func slashReceivedCredit(storage *types.StakeStorage, addr *crypto.CommonAddress, cut *big.Int) *big.Int {
+	slashed := new(big.Int)
+	for i, rc := range storage.RC {
+		if rc.Addr != *addr {
+			continue
+		}
+		remain := new(big.Int).Set(cut)
+		heightValues := make([]types.HeightValue, 0, len(rc.HeghtValues))
+		for _, hv := range rc.HeghtValues {
+			value := hv.CreditValue.ToInt()
+			if remain.Sign() == 0 {
+				heightValues = append(heightValues, hv)
+			} else if remain.Cmp(value) >= 0 {
+				slashed.Add(slashed, value)
+				remain.Sub(remain, value)
+			} else {
+				slashed.Add(slashed, remain)
+				heightValues = append(heightValues, types.HeightValue{hv.CreditHeight, common.Big(*new(big.Int).Sub(value, remain))})
+				remain.SetUint64(0)
+			}
+		}
+		if len(heightValues) == 0 {
+			storage.RC = append(storage.RC[0:i], storage.RC[i+1:]...)
+		} else {
+			storage.RC[i].HeghtValues = heightValues
+		}
+		break
+	}
+	return slashed
+}
+
+//把storage中在height撤销、金额为value的一笔抵押改为remain，remain为0时删除，找不到时返回false
+func slashCancelCredit(storage *types.StakeStorage, height uint64, value, remain *big.Int) bool {
+	for i, cc := range storage.CC {
+		if cc.CancelCreditHeight != height {
+			continue
+		}
+		for j := range cc.CancelCreditValue {
+			if cc.CancelCreditValue[j].Cmp(value) != 0 {
+				continue
+			}
+			if remain.Sign() > 0 {
+				cc.CancelCreditValue[j] = *remain
+			} else if len(cc.CancelCreditValue) > 1 {
+				storage.CC[i].CancelCreditValue = append(cc.CancelCreditValue[:j], cc.CancelCreditValue[j+1:]...)
+			} else {
+				storage.CC = append(storage.CC[:i], storage.CC[i+1:]...)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (trieStore *trieStakeStore) GetJailedAddrs() ([]crypto.CommonAddress, error) {
+	value, err := trieStore.store.Get([]byte(JailedAddrs))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	addrs := []crypto.CommonAddress{}
+	err = binary.Unmarshal(value, &addrs)
+	if err != nil {
+		return nil, err
+	}
+	return addrs, nil
+}
+
+func (trieStore *trieStakeStore) putJailedAddrs(addrs []crypto.CommonAddress) error {
+	if len(addrs) == 0 {
+		return trieStore.store.Delete([]byte(JailedAddrs))
+	}
+	value, err := binary.Marshal(addrs)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put([]byte(JailedAddrs), value)
+}
+
+//暂停候选人addr的出块资格到until高度，已经被暂停的取较晚的解禁高度
+func (trieStore *trieStakeStore) JailCandidate(addr *crypto.CommonAddress, until uint64) error {
+	info, err := trieStore.GetSlashInfo(addr)
+	if err != nil {
+		return err
+	}
+	if until > info.JailedUntil {
+		info.JailedUntil = until
+		err = trieStore.PutSlashInfo(addr, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	addrs, err := trieStore.GetJailedAddrs()
+	if err != nil {
+		return err
+	}
+	for _, jailed := range addrs {
+		if jailed == *addr {
+			return nil
+		}
+	}
+	return trieStore.putJailedAddrs(append(addrs, *addr))
+}
+
+//解禁到达解禁高度的候选人
+func (trieStore *trieStakeStore) ReleaseJailed(height uint64) error {
+	addrs, err := trieStore.GetJailedAddrs()
+	if err != nil || len(addrs) == 0 {
+		return err
+	}
+	left := make([]crypto.CommonAddress, 0, len(addrs))
+	for _, addr := range addrs {
+		info, err := trieStore.GetSlashInfo(&addr)
+		if err != nil {
+			return err
+		}
+		if info.JailedUntil > height {
+			left = append(left, addr)
+		}
+	}
+	if len(left) == len(addrs) {
+		return nil
+	}
+	return trieStore.putJailedAddrs(left)
+}
@@ -28,6 +28,14 @@ type StoreInterface interface {
 	AliasGet(alias string) (*crypto.CommonAddress, error)
 	AliasExist(alias string) bool
 	AliasSet(addr *crypto.CommonAddress, alias string) (err error)
+	AliasDelete(alias string) error
+	AliasTransfer(from, to *crypto.CommonAddress, alias string) error
+	GetAliasLease(alias string) (*types.AliasLease, error)
+	PopExpiredAliasLeases(height uint64) ([]*types.AliasLease, error)
+	PutAliasLease(lease *types.AliasLease) error
+	DelAliasLease(alias string) error
+	GetAliasHistory(alias string) ([]*types.AliasRecord, error)
+	AddAliasHistory(record *types.AliasRecord) error
 
 	GetBalance(addr *crypto.CommonAddress, height uint64) *big.Int
 	PutBalance(addr *crypto.CommonAddress, height uint64, balance *big.Int) error
@@ -41,6 +49,12 @@ type StoreInterface interface {
 	GetCodeHash(addr *crypto.CommonAddress) crypto.Hash
 	PutByteCode(addr *crypto.CommonAddress, byteCode []byte) error
 
+	GetContractState(addr *crypto.CommonAddress, key []byte) ([]byte, error)
+	PutContractState(addr *crypto.CommonAddress, key []byte, value []byte) error
+
+	GetMultiSigAccount(addr *crypto.CommonAddress) *types.MultiSigAccount
+	PutMultiSigAccount(addr *crypto.CommonAddress, account *types.MultiSigAccount) error
+
 	GetReputation(addr *crypto.CommonAddress) *big.Int
 	GetStateRoot() []byte
 	RecoverTrie(root []byte) bool
@@ -61,11 +75,29 @@ type StoreInterface interface {
 	GetVoteCreditCount(addr *crypto.CommonAddress) *big.Int
 	CancelVoteCredit(fromAddr, toAddr *crypto.CommonAddress, cancelBalance *big.Int, height uint64) (*types.CancelCreditDetail, error)
 	VoteCredit(addresses *crypto.CommonAddress, to *crypto.CommonAddress, addBalance *big.Int, height uint64) error
-	CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data []byte, height uint64) error
+	CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data *types.CandidateData, height uint64) error
 	CancelCandidateCredit(fromAddr *crypto.CommonAddress, cancelBalance *big.Int, height uint64) (*types.CancelCreditDetail, error)
 	GetCandidateData(addr *crypto.CommonAddress) ([]byte, error)
 	AddCandidateAddr(addr *crypto.CommonAddress) error
 	GetCreditDetails(addr *crypto.CommonAddress) map[crypto.CommonAddress]big.Int
+	RedelegateCredit(fromAddr, oldAddr, newAddr *crypto.CommonAddress, amount *big.Int, height uint64) ([]types.HeightValue, error)
+	GetVoteDetails(addr *crypto.CommonAddress, voted []crypto.CommonAddress, height uint64) ([]*types.VoteDetail, error)
+	GetUnbondingDetails(addr *crypto.CommonAddress, height uint64) ([]*types.UnbondingDetail, error)
+
+	//slash
+	GetSlashInfo(addr *crypto.CommonAddress) (*types.SlashInfo, error)
+	PutSlashInfo(addr *crypto.CommonAddress, info *types.SlashInfo) error
+	SlashCredit(addr *crypto.CommonAddress, rate uint64, height uint64) (*big.Int, error)
+	JailCandidate(addr *crypto.CommonAddress, until uint64) error
+	GetJailedAddrs() ([]crypto.CommonAddress, error)
+	ReleaseJailed(height uint64) error
+
+	//time lock
+	GetTimeLock(id *crypto.Hash) (*types.TimeLock, error)
+	GetTimeLockIds(addr *crypto.CommonAddress) ([]crypto.Hash, error)
+	PutTimeLock(lock *types.TimeLock, height uint64) error
+	DelTimeLock(id *crypto.Hash) error
+	PopMaturedTimeLocks(height uint64, timestamp uint64) ([]*types.TimeLock, error)
 }
 
 type Store struct {
@@ -139,6 +171,26 @@ func (s Store) GetCandidateAddrs() ([]crypto.CommonAddress, error) {
 	return s.stake.GetCandidateAddrs()
 }
 
+func (s Store) GetTimeLock(id *crypto.Hash) (*types.TimeLock, error) {
+	return s.stake.GetTimeLock(id)
+}
+
+func (s Store) GetTimeLockIds(addr *crypto.CommonAddress) ([]crypto.Hash, error) {
+	return s.stake.GetTimeLockIds(addr)
+}
+
+func (s Store) PutTimeLock(lock *types.TimeLock, height uint64) error {
+	return s.stake.PutTimeLock(lock, height)
+}
+
+func (s Store) DelTimeLock(id *crypto.Hash) error {
+	return s.stake.DelTimeLock(id)
+}
+
+func (s Store) PopMaturedTimeLocks(height uint64, timestamp uint64) ([]*types.TimeLock, error) {
+	return s.stake.PopMaturedTimeLocks(height, timestamp)
+}
+
 func (s Store) GetVoteCreditCount(addr *crypto.CommonAddress) *big.Int {
 	return s.stake.GetCreditCount(addr)
 }
@@ -242,6 +294,22 @@ func (s Store) PutByteCode(addr *crypto.CommonAddress, byteCode []byte) error {
 	return s.account.PutByteCode(addr, byteCode)
 }
 
+func (s Store) GetContractState(addr *crypto.CommonAddress, key []byte) ([]byte, error) {
+	return s.account.GetContractState(addr, key)
+}
+
+func (s Store) PutContractState(addr *crypto.CommonAddress, key []byte, value []byte) error {
+	return s.account.PutContractState(addr, key, value)
+}
+
+func (s Store) GetMultiSigAccount(addr *crypto.CommonAddress) *types.MultiSigAccount {
+	return s.account.GetMultiSigAccount(addr)
+}
+
+func (s Store) PutMultiSigAccount(addr *crypto.CommonAddress, account *types.MultiSigAccount) error {
+	return s.account.PutMultiSigAccount(addr, account)
+}
+
 func (s Store) GetReputation(addr *crypto.CommonAddress) *big.Int {
 	return s.account.GetReputation(addr)
 }
@@ -250,6 +318,38 @@ func (s Store) AliasSet(addr *crypto.CommonAddress, alias string) (err error) {
 	return s.account.AliasSet(addr, alias)
 }
 
+func (s Store) AliasDelete(alias string) error {
+	return s.account.AliasDelete(alias)
+}
+
+func (s Store) AliasTransfer(from, to *crypto.CommonAddress, alias string) error {
+	return s.account.AliasTransfer(from, to, alias)
+}
+
+func (s Store) GetAliasLease(alias string) (*types.AliasLease, error) {
+	return s.account.GetAliasLease(alias)
+}
+
+func (s Store) PopExpiredAliasLeases(height uint64) ([]*types.AliasLease, error) {
+	return s.account.PopExpiredAliasLeases(height)
+}
+
+func (s Store) PutAliasLease(lease *types.AliasLease) error {
+	return s.account.PutAliasLease(lease)
+}
+
+func (s Store) DelAliasLease(alias string) error {
+	return s.account.DelAliasLease(alias)
+}
+
+func (s Store) GetAliasHistory(alias string) ([]*types.AliasRecord, error) {
+	return s.account.GetAliasHistory(alias)
+}
+
+func (s Store) AddAliasHistory(record *types.AliasRecord) error {
+	return s.account.AddAliasHistory(record)
+}
+
 func (s Store) CancelVoteCredit(fromAddr, toAddr *crypto.CommonAddress, cancelBalance *big.Int, height uint64) (*types.CancelCreditDetail, error) {
 	ci, err := s.GetChangeInterval()
 	if err != nil {
@@ -262,7 +362,7 @@ func (s Store) VoteCredit(fromAddr *crypto.CommonAddress, to *crypto.CommonAddre
 	return s.stake.VoteCredit(fromAddr, to, addBalance, height)
 }
 
-func (s Store) CandidateCredit(fromAddr *crypto.CommonAddress, addBalance *big.Int, data []byte, height uint64) error {
+func (s Store) CandidateCredit(fromAddr *crypto.CommonAddress, addBalance *big.Int, data *types.CandidateData, height uint64) error {
 	return s.stake.CandidateCredit(fromAddr, addBalance, data, height)
 }
 func (s Store) TrieDB() *trie.Database {
@@ -310,6 +410,36 @@ func (s Store) GetStateRoot() []byte {
 	return s.db.getStateRoot()
 }
 
+// AccessCount return how many times the state is read and written through the store
+func (s *Store) AccessCount() (reads uint64, writes uint64) {
+	return s.db.reads, s.db.writes
+}
+
+// Overlay return a store executing one transaction on top of s, nothing is written to s until
+// Merge, the overlays of different transactions can be executed concurrently
+func (s *Store) Overlay() (*Store, bool) {
+	if s.db.cache == nil {
+		return nil, false
+	}
+	db := NewStoreDB(s.db.store, database.NewOverlayStore(s.db.cache), s.db.trie, s.db.trieDb)
+	return &Store{
+		stake:   NewStakeStorage(db),
+		account: NewTrieAccoutStore(db),
+		db:      db,
+	}, true
+}
+
+// Conflict report whether the overlay read a key written by the transactions merged before it
+func (s *Store) Conflict(written map[string]struct{}) bool {
+	return s.db.cache.Conflict(written)
+}
+
+// Merge write the changes of the overlay to the store it is created from, the keys written
+// are added to written
+func (s *Store) Merge(written map[string]struct{}) {
+	s.db.cache.Merge(written)
+}
+
 func (s *Store) RecoverTrie(root []byte) bool {
 	return s.db.RecoverTrie(root)
 }
@@ -317,3 +447,62 @@ func (s *Store) RecoverTrie(root []byte) bool {
 func (s *Store) GetCreditDetails(addr *crypto.CommonAddress) map[crypto.CommonAddress]big.Int {
 	return s.stake.GetCreditDetails(addr)
 }
+
+func (s *Store) RedelegateCredit(fromAddr, oldAddr, newAddr *crypto.CommonAddress, amount *big.Int, height uint64) ([]types.HeightValue, error) {
+	ci, err := s.GetChangeInterval()
+	if err != nil {
+		return nil, err
+	}
+	return s.stake.RedelegateCredit(fromAddr, oldAddr, newAddr, amount, height, ci)
+}
+
+// GetVoteDetails return the votes of addr to itself, the candidates and the addresses in voted
+func (s *Store) GetVoteDetails(addr *crypto.CommonAddress, voted []crypto.CommonAddress, height uint64) ([]*types.VoteDetail, error) {
+	ci, err := s.GetChangeInterval()
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.stake.GetCandidateAddrs()
+	if err != nil {
+		return nil, err
+	}
+	return s.stake.GetVoteDetails(addr, voted, candidates, height, ci)
+}
+
+func (s *Store) GetUnbondingDetails(addr *crypto.CommonAddress, height uint64) ([]*types.UnbondingDetail, error) {
+	ci, err := s.GetChangeInterval()
+	if err != nil {
+		return nil, err
+	}
+	return s.stake.GetUnbondingDetails(addr, height, ci), nil
+}
+
+func (s *Store) GetSlashInfo(addr *crypto.CommonAddress) (*types.SlashInfo, error) {
+	return s.stake.GetSlashInfo(addr)
+}
+
+func (s *Store) PutSlashInfo(addr *crypto.CommonAddress, info *types.SlashInfo) error {
+	return s.stake.PutSlashInfo(addr, info)
+}
+
+// SlashCredit burn rate/1000 of the credit voted to addr, including the credit cancelled
+// from addr that is not matured at height
+func (s *Store) SlashCredit(addr *crypto.CommonAddress, rate uint64, height uint64) (*big.Int, error) {
+	ci, err := s.GetChangeInterval()
+	if err != nil {
+		return nil, err
+	}
+	return s.stake.SlashCredit(addr, rate, height, ci)
+}
+
+func (s *Store) JailCandidate(addr *crypto.CommonAddress, until uint64) error {
+	return s.stake.JailCandidate(addr, until)
+}
+
+func (s *Store) GetJailedAddrs() ([]crypto.CommonAddress, error) {
+	return s.stake.GetJailedAddrs()
+}
+
+func (s *Store) ReleaseJailed(height uint64) error {
+	return s.stake.ReleaseJailed(height)
+}
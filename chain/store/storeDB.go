@@ -12,6 +12,9 @@ type StoreDB struct {
 	cache  *database.TransactionStore //数据属于storage的缓存，调用flush才会把数据写入到diskDb中
 	trie   *trie.SecureTrie           //全局状态树  临时树（临时变量）
 	trieDb *trie.Database             //状态树存储到磁盘时，使用到的db
+
+	reads  uint64 //读取状态的次数，用于统计交易执行的开销
+	writes uint64 //写入或删除状态的次数
 }
 
 func NewStoreDB(store dbinterface.KeyValueStore, cache *database.TransactionStore, trie *trie.SecureTrie, trieDb *trie.Database) *StoreDB {
@@ -30,6 +33,7 @@ func (s *StoreDB) initState() error {
 }
 
 func (s *StoreDB) Get(key []byte) ([]byte, error) {
+	s.reads++
 	var value []byte
 	var err error
 	if s.cache != nil {
@@ -44,6 +48,7 @@ func (s *StoreDB) Get(key []byte) ([]byte, error) {
 }
 
 func (s *StoreDB) Put(key []byte, value []byte) error {
+	s.writes++
 	if s.cache != nil {
 		return s.cache.Put(key, value)
 	} else {
@@ -57,11 +62,17 @@ func (s *StoreDB) Put(key []byte, value []byte) error {
 }
 
 func (s *StoreDB) Delete(key []byte) error {
+	s.writes++
 	if s.cache != nil {
 		err := s.cache.Delete(key)
 		if err != nil {
 			return err
 		}
+		//overlay之间共享状态树，不能直接删除，Merge后由Flush从状态树删除。执行块时交易的修改
+		//只有在块无效时才会被撤销，所以与立即删除的结果相同
+		if s.cache.IsOverlay() {
+			return nil
+		}
 	}
 	s.trie.Delete(key)
 	_, err := s.trie.Commit(nil)
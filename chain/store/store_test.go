@@ -2,6 +2,7 @@ package store
 
 import (
 	"crypto/rand"
+	bin "encoding/binary"
 	"fmt"
 	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/types"
@@ -17,11 +18,31 @@ import (
 
 var ChangeCycle uint64 = 100
 
+//在./test创建空的状态，撤销的抵押ChangeCycle块后到期
+func newTestStore(t *testing.T) StoreInterface {
+	diskDB, err := leveldb.New("./test", 16, 512, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeInterval := make([]byte, 8)
+	bin.BigEndian.PutUint64(changeInterval, ChangeCycle)
+	diskDB.Put([]byte(ChangeInterval), changeInterval)
+	storeInterface, err := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storeInterface
+}
+
+//n个drep
+func coins(n uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(n), new(big.Int).SetUint64(params.Coin))
+}
+
 //添加 撤销
 func TestGetVoteCredit(t *testing.T) {
 	defer os.RemoveAll("./test")
-	diskDB, _ := leveldb.New("./test", 16, 512, "")
-	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	storeInterface := newTestStore(t)
 
 	store := storeInterface.(*Store)
 	b := store.RecoverTrie([]byte{})
@@ -36,8 +57,8 @@ func TestGetVoteCredit(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		pri, _ := crypto.GenerateKey(rand.Reader)
 		addr := crypto.PubkeyToAddress(pri.PubKey())
-		store.stake.VoteCredit(&addr, &backbone, new(big.Int).SetUint64(uint64(222+i)*drepUnit), 0)
-		total.Add(total, new(big.Int).SetUint64(uint64(222+i)*drepUnit))
+		store.stake.VoteCredit(&addr, &backbone, coins(uint64(222 + i)), 0)
+		total.Add(total, coins(uint64(222 + i)))
 	}
 
 	if total.Cmp(store.GetVoteCreditCount(&backbone)) != 0 {
@@ -52,9 +73,7 @@ func TestGetVoteCredit(t *testing.T) {
 
 func TestCandidateCredit(t *testing.T) {
 	defer os.RemoveAll("./test")
-	diskDB, _ := leveldb.New("./test", 16, 512, "")
-
-	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	storeInterface := newTestStore(t)
 
 	pri, _ := crypto.GenerateKey(rand.Reader)
 	backbone := crypto.PubkeyToAddress(pri.PubKey())
@@ -66,8 +85,7 @@ func TestCandidateCredit(t *testing.T) {
 		Pubkey: pk.PubKey(),
 		Node:   "127.0.0.1:55555",
 	}
-	data, _ := cd.Marshal()
-	store.stake.CandidateCredit(&backbone, new(big.Int).SetUint64(registerPledgeLimit*drepUnit), data, 0)
+	store.stake.CandidateCredit(&backbone, coins(registerPledgeLimit), cd, 0)
 
 	m, err := store.GetCandidateAddrs()
 	if err != nil {
@@ -103,8 +121,7 @@ func TestBigInt(t *testing.T) {
 
 func TestPutBalance(t *testing.T) {
 	defer os.RemoveAll("./test")
-	diskDB, _ := leveldb.New("./test", 16, 512, "")
-	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	storeInterface := newTestStore(t)
 
 	store := storeInterface.(*Store)
 	b := store.RecoverTrie([]byte{})
@@ -147,9 +164,8 @@ func TestPutBalance(t *testing.T) {
 
 func TestDatabase_UpdateCandidateAddr(t *testing.T) {
 	defer os.RemoveAll("./test")
-
-	diskDB, _ := leveldb.New("./test", 16, 512, "")
-	storeInterface, err := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	storeInterface := newTestStore(t)
+	var err error
 
 	store := storeInterface.(*Store)
 
@@ -210,9 +226,7 @@ func TestBinary(t *testing.T) {
 
 func TestVoteCredit(t *testing.T) {
 	defer os.RemoveAll("./test")
-
-	diskDB, _ := leveldb.New("./test", 16, 512, "")
-	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	storeInterface := newTestStore(t)
 
 	store := storeInterface.(*Store)
 
@@ -241,9 +255,8 @@ func TestVoteCredit(t *testing.T) {
 
 func TestCancelVoteCredit(t *testing.T) {
 	defer os.RemoveAll("./test")
-
-	diskDB, _ := leveldb.New("./test", 16, 512, "")
-	storeInterface, err := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	storeInterface := newTestStore(t)
+	var err error
 
 	store := storeInterface.(*Store)
 
@@ -269,7 +282,7 @@ func TestCancelVoteCredit(t *testing.T) {
 	fmt.Println(v)
 	for _, addr := range addrs {
 		voteValue := new(big.Int).SetInt64(50000)
-		_, err = store.CancelVoteCredit(&addr, &backbone, voteValue, 10)
+		_, err = store.CancelVoteCredit(&addr, &backbone, voteValue, 0)
 		if err != nil {
 			t.Fatal("cancel vote ok")
 		}
@@ -305,35 +318,46 @@ func TestCancelVoteCredit(t *testing.T) {
 	}
 }
 
-func Test_getInterset(t *testing.T) {
-	balance := new(big.Int).SetUint64(params.Coin)
-	balance.Mul(balance, new(big.Int).SetUint64(100))
-	//2个月内的
-	interest2 := getInterst(0, 1036800, balance)
-
-	fmt.Println("0-3  month:", interest2)
-	fmt.Println(interest2.Mul(interest2, new(big.Int).SetUint64(6*100)).Div(interest2, balance), "%")
-
-	//3个月利息
-	interest3 := getInterst(0, 1555200, balance)
-
-	fmt.Println("3-6  month:", interest3)
-	fmt.Println(interest3.Mul(interest3, new(big.Int).SetUint64(4*100)).Div(interest3, balance), "%")
-
-	//6个月利息
-	interest6 := getInterst(0, 3110400, balance)
-	fmt.Println("6-12  month:", getInterst(0, 3110400, balance))
-	fmt.Println(interest6.Mul(interest6, new(big.Int).SetUint64(2*100)).Div(interest6, balance), "%")
-	//12个月利息
-
-	interest12 := getInterst(0, 6220800, balance)
-	fmt.Println("12- month:")
-	fmt.Println(interest12)
-	fmt.Println(balance)
+//转投走的票在惩罚期内随原候选人一起被罚没
+func TestSlashRedelegatedCredit(t *testing.T) {
+	defer os.RemoveAll("./test")
+	defer func(height uint64) { params.SlashingForkHeight = height }(params.SlashingForkHeight)
+	params.SlashingForkHeight = 0
+	store := newTestStore(t).(*Store)
 
-	interest12.Mul(interest12, new(big.Int).SetUint64(100))
+	pri, _ := crypto.GenerateKey(rand.Reader)
+	voter := crypto.PubkeyToAddress(pri.PubKey())
+	candidateA, candidateB := crypto.CommonAddress{1}, crypto.CommonAddress{2}
+	if err := store.VoteCredit(&voter, &candidateA, coins(1000), 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.RedelegateCredit(&voter, &candidateA, &candidateB, coins(600), 1); err != nil {
+		t.Fatal(err)
+	}
 
-	fmt.Println(interest12.Div(interest12, balance).String(), "%")
+	//千分之100，A处400和转投到B的600各罚没十分之一
+	slashed, err := store.SlashCredit(&candidateA, 100, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slashed.Cmp(coins(100)) != 0 {
+		t.Fatalf("slashed %v, want %v", slashed, coins(100))
+	}
+	details := store.GetCreditDetails(&candidateB)
+	if value := details[voter]; value.Cmp(coins(540)) != 0 {
+		t.Fatalf("credit at new candidate %v, want %v", &value, coins(540))
+	}
 
-	//fmt.Println(getInterst(0, 1555200, balance))
+	//过了惩罚期，转投走的票不再罚没
+	slashed, err = store.SlashCredit(&candidateA, 100, 1+ChangeCycle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slashed.Cmp(coins(36)) != 0 {
+		t.Fatalf("slashed %v, want %v", slashed, coins(36))
+	}
+	details = store.GetCreditDetails(&candidateB)
+	if value := details[voter]; value.Cmp(coins(540)) != 0 {
+		t.Fatalf("credit at new candidate %v, want %v", &value, coins(540))
+	}
 }
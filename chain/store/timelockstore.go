@@ -0,0 +1,339 @@
+package store
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+const (
+	TimeLockAddr       = "TimeLockAddr"       //以地址作为KEY,存储该地址发出或接收的未到期锁定id
+	TimeLockStorage    = "TimeLockStorage"    //以锁定id作为KEY,存储锁定内容
+	TimeLockHeight     = "TimeLockHeight"     //以到期高度作为KEY,存储在该高度满足高度条件的锁定id
+	TimeLockTime       = "TimeLockTime"       //以UnlockTime所在的时间段作为KEY,存储已满足高度条件、等待时间条件的锁定，按UnlockTime排列
+	TimeLockTimeCursor = "TimeLockTimeCursor" //下一个要检查的时间段，之前的时间段都已处理完
+
+	timeLockTimeBucket = 60 //等待时间条件的锁定按UnlockTime每60秒分为一段
+)
+
+var (
+	ErrTimeLockExist = errors.New("time lock id already exist")
+)
+
+//满足了高度条件，等待区块时间到达UnlockTime的锁定
+type timeLockDue struct {
+	UnlockTime uint64
+	Id         crypto.Hash
+}
+
+func timeLockKey(id *crypto.Hash) []byte {
+	return sha3.Keccak256([]byte(TimeLockStorage + id.String()))
+}
+
+func timeLockHeightKey(height uint64) []byte {
+	return []byte(TimeLockHeight + strconv.FormatUint(height, 10))
+}
+
+func timeLockTimeKey(bucket uint64) []byte {
+	return []byte(TimeLockTime + strconv.FormatUint(bucket, 10))
+}
+
+//TimeLockAddrKey returns the trie key of the ids of the locks sent or received by addr
+func TimeLockAddrKey(addr *crypto.CommonAddress) []byte {
+	return sha3.Keccak256([]byte(TimeLockAddr + addr.Hex()))
+}
+
+func (trieStore *trieStakeStore) GetTimeLock(id *crypto.Hash) (*types.TimeLock, error) {
+	value, err := trieStore.store.Get(timeLockKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	lock := &types.TimeLock{}
+	err = binary.Unmarshal(value, lock)
+	if err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// GetTimeLockIds return the ids of the locks sent or received by addr and not released yet
+func (trieStore *trieStakeStore) GetTimeLockIds(addr *crypto.CommonAddress) ([]crypto.Hash, error) {
+	return trieStore.getHashes(TimeLockAddrKey(addr))
+}
+
+func (trieStore *trieStakeStore) getHashes(key []byte) ([]crypto.Hash, error) {
+	value, err := trieStore.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	ids := []crypto.Hash{}
+	if value == nil {
+		return ids, nil
+	}
+	err = binary.Unmarshal(value, &ids)
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (trieStore *trieStakeStore) putHashes(key []byte, ids []crypto.Hash) error {
+	if len(ids) == 0 {
+		return trieStore.store.Delete(key)
+	}
+	value, err := binary.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put(key, value)
+}
+
+func (trieStore *trieStakeStore) getTimeLockDues(bucket uint64) ([]timeLockDue, error) {
+	value, err := trieStore.store.Get(timeLockTimeKey(bucket))
+	if err != nil {
+		return nil, err
+	}
+	dues := []timeLockDue{}
+	if value == nil {
+		return dues, nil
+	}
+	err = binary.Unmarshal(value, &dues)
+	if err != nil {
+		return nil, err
+	}
+	return dues, nil
+}
+
+func (trieStore *trieStakeStore) putTimeLockDues(bucket uint64, dues []timeLockDue) error {
+	if len(dues) == 0 {
+		return trieStore.store.Delete(timeLockTimeKey(bucket))
+	}
+	value, err := binary.Marshal(dues)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put(timeLockTimeKey(bucket), value)
+}
+
+//下一个要检查的时间段，还没有等待时间条件的锁定时返回false
+func (trieStore *trieStakeStore) getTimeLockTimeCursor() (uint64, bool, error) {
+	value, err := trieStore.store.Get([]byte(TimeLockTimeCursor))
+	if err != nil || value == nil {
+		return 0, false, err
+	}
+	var bucket uint64
+	err = binary.Unmarshal(value, &bucket)
+	if err != nil {
+		return 0, false, err
+	}
+	return bucket, true, nil
+}
+
+func (trieStore *trieStakeStore) putTimeLockTimeCursor(bucket uint64) error {
+	value, err := binary.Marshal(bucket)
+	if err != nil {
+		return err
+	}
+	return trieStore.store.Put([]byte(TimeLockTimeCursor), value)
+}
+
+//addTimeLockDues 放入UnlockTime所在的时间段，段内按UnlockTime插入，UnlockTime相同的按加入的先后排列
+//加入的锁定都还没到UnlockTime，所在的时间段不早于游标，没有游标时从第一个加入的锁定所在的时间段开始
+func (trieStore *trieStakeStore) addTimeLockDues(locks ...*types.TimeLock) error {
+	if len(locks) == 0 {
+		return nil
+	}
+	cursor, found, err := trieStore.getTimeLockTimeCursor()
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		bucket := lock.UnlockTime / timeLockTimeBucket
+		if !found || bucket < cursor {
+			cursor, found = bucket, true
+			err = trieStore.putTimeLockTimeCursor(cursor)
+			if err != nil {
+				return err
+			}
+		}
+		dues, err := trieStore.getTimeLockDues(bucket)
+		if err != nil {
+			return err
+		}
+		index := sort.Search(len(dues), func(i int) bool { return dues[i].UnlockTime > lock.UnlockTime })
+		dues = append(dues, timeLockDue{})
+		copy(dues[index+1:], dues[index:])
+		dues[index] = timeLockDue{UnlockTime: lock.UnlockTime, Id: lock.Id}
+		err = trieStore.putTimeLockDues(bucket, dues)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (trieStore *trieStakeStore) addTimeLockAddr(addr *crypto.CommonAddress, id crypto.Hash) error {
+	key := TimeLockAddrKey(addr)
+	ids, err := trieStore.getHashes(key)
+	if err != nil {
+		return err
+	}
+	return trieStore.putHashes(key, append(ids, id))
+}
+
+func (trieStore *trieStakeStore) delTimeLockAddr(addr *crypto.CommonAddress, id crypto.Hash) error {
+	key := TimeLockAddrKey(addr)
+	ids, err := trieStore.getHashes(key)
+	if err != nil {
+		return err
+	}
+	for index, temId := range ids {
+		if temId == id {
+			ids = append(ids[0:index], ids[index+1:]...)
+			break
+		}
+	}
+	return trieStore.putHashes(key, ids)
+}
+
+// PutTimeLock store a new lock created at height, the lock is indexed by the height it matured,
+// or by its unlock time if the height is reached already
+func (trieStore *trieStakeStore) PutTimeLock(lock *types.TimeLock, height uint64) error {
+	exist, err := trieStore.GetTimeLock(&lock.Id)
+	if err != nil {
+		return err
+	}
+	if exist != nil {
+		return ErrTimeLockExist
+	}
+	value, err := binary.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	err = trieStore.store.Put(timeLockKey(&lock.Id), value)
+	if err != nil {
+		return err
+	}
+	err = trieStore.addTimeLockAddr(&lock.From, lock.Id)
+	if err != nil {
+		return err
+	}
+	if lock.To != lock.From {
+		err = trieStore.addTimeLockAddr(&lock.To, lock.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if lock.Lock.CreditHeight > height {
+		key := timeLockHeightKey(lock.Lock.CreditHeight)
+		ids, err := trieStore.getHashes(key)
+		if err != nil {
+			return err
+		}
+		return trieStore.putHashes(key, append(ids, lock.Id))
+	}
+	return trieStore.addTimeLockDues(lock)
+}
+
+// DelTimeLock delete the lock, the height and time index entries of it are dropped when they are visited
+func (trieStore *trieStakeStore) DelTimeLock(id *crypto.Hash) error {
+	lock, err := trieStore.GetTimeLock(id)
+	if err != nil || lock == nil {
+		return err
+	}
+	err = trieStore.store.Delete(timeLockKey(id))
+	if err != nil {
+		return err
+	}
+	err = trieStore.delTimeLockAddr(&lock.From, *id)
+	if err != nil {
+		return err
+	}
+	if lock.To != lock.From {
+		return trieStore.delTimeLockAddr(&lock.To, *id)
+	}
+	return nil
+}
+
+// PopMaturedTimeLocks remove the locks matured at the block of height and timestamp from the index
+// and return them, the locks reaching height but not timestamp are moved to wait for the time.
+// It must be called at every height so that no height index is skipped
+func (trieStore *trieStakeStore) PopMaturedTimeLocks(height uint64, timestamp uint64) ([]*types.TimeLock, error) {
+	matured := []*types.TimeLock{}
+	key := timeLockHeightKey(height)
+	ids, err := trieStore.getHashes(key)
+	if err != nil {
+		return nil, err
+	}
+	waiting := []*types.TimeLock{}
+	for _, id := range ids {
+		lock, err := trieStore.GetTimeLock(&id)
+		if err != nil {
+			return nil, err
+		}
+		//已撤销
+		if lock == nil {
+			continue
+		}
+		if lock.Matured(height, timestamp) {
+			matured = append(matured, lock)
+		} else {
+			waiting = append(waiting, lock)
+		}
+	}
+	if len(ids) > 0 {
+		err = trieStore.store.Delete(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = trieStore.addTimeLockDues(waiting...)
+	if err != nil {
+		return nil, err
+	}
+
+	//从游标到当前时间段逐段检查，当前时间段之前的段都会被取空
+	cursor, found, err := trieStore.getTimeLockTimeCursor()
+	if err != nil || !found {
+		return matured, err
+	}
+	current := timestamp / timeLockTimeBucket
+	for bucket := cursor; bucket <= current; bucket++ {
+		dues, err := trieStore.getTimeLockDues(bucket)
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for ; count < len(dues) && dues[count].UnlockTime <= timestamp; count++ {
+			lock, err := trieStore.GetTimeLock(&dues[count].Id)
+			if err != nil {
+				return nil, err
+			}
+			if lock != nil {
+				matured = append(matured, lock)
+			}
+		}
+		if count > 0 {
+			err = trieStore.putTimeLockDues(bucket, dues[count:])
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if current > cursor {
+		err = trieStore.putTimeLockTimeCursor(current)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return matured, nil
+}
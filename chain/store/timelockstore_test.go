@@ -0,0 +1,106 @@
+package store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/common/trie"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/database/leveldb"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+func TestTimeLockIndex(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+
+	store := storeInterface.(*Store)
+	if !store.RecoverTrie([]byte{}) {
+		t.Fatal("recover trie err")
+	}
+
+	from, to := crypto.CommonAddress{7}, crypto.CommonAddress{8}
+	ids := []crypto.Hash{{1}, {2}, {3}}
+	for _, id := range ids {
+		if err := store.PutTimeLock(&types.TimeLock{Id: id, From: from, To: to, Lock: types.HeightValue{CreditHeight: 10}}, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.DelTimeLock(&ids[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	//发送方和接收方各自索引未到期的锁定
+	for _, addr := range []crypto.CommonAddress{from, to} {
+		left, err := store.GetTimeLockIds(&addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(left) != 2 || left[0] != ids[0] || left[1] != ids[2] {
+			t.Fatalf("unexpected time lock ids of %s %v", addr.String(), left)
+		}
+	}
+	other := crypto.CommonAddress{9}
+	if left, _ := store.GetTimeLockIds(&other); len(left) != 0 {
+		t.Fatalf("unexpected time lock ids %v", left)
+	}
+	lock, err := store.GetTimeLock(&ids[1])
+	if err != nil || lock != nil {
+		t.Fatalf("deleted time lock still exist, %v %v", lock, err)
+	}
+	lock, err = store.GetTimeLock(&ids[2])
+	if err != nil || lock == nil || lock.Lock.CreditHeight != 10 {
+		t.Fatalf("time lock mismatch, %v %v", lock, err)
+	}
+}
+
+func TestTimeLockMaturity(t *testing.T) {
+	defer os.RemoveAll("./test")
+	diskDB, _ := leveldb.New("./test", 16, 512, "")
+	storeInterface, _ := TrieStoreFromStore(diskDB, trie.EmptyRoot[:])
+	store := storeInterface.(*Store)
+
+	byHeight := &types.TimeLock{Id: crypto.Hash{1}, Lock: types.HeightValue{CreditHeight: 10}}
+	byBoth := &types.TimeLock{Id: crypto.Hash{2}, Lock: types.HeightValue{CreditHeight: 10}, UnlockTime: 5000}
+	byTime := &types.TimeLock{Id: crypto.Hash{3}, UnlockTime: 3000}
+	sameBucket := &types.TimeLock{Id: crypto.Hash{4}, UnlockTime: 3010}
+	farAway := &types.TimeLock{Id: crypto.Hash{5}, UnlockTime: 100000}
+	for _, lock := range []*types.TimeLock{byHeight, byBoth, byTime, sameBucket, farAway} {
+		if err := store.PutTimeLock(lock, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.PutTimeLock(&types.TimeLock{Id: crypto.Hash{1}}, 1); err != ErrTimeLockExist {
+		t.Fatalf("expect %v, got %v", ErrTimeLockExist, err)
+	}
+
+	cases := []struct {
+		height    uint64
+		timestamp uint64
+		matured   []crypto.Hash
+	}{
+		{9, 2000, nil},
+		//同一时间段内还没到UnlockTime的锁定留在段中
+		{10, 3000, []crypto.Hash{byHeight.Id, byTime.Id}},
+		{11, 4999, []crypto.Hash{sameBucket.Id}},
+		{12, 5000, []crypto.Hash{byBoth.Id}},
+		{13, 6000, nil},
+		{14, 99999, nil},
+		{15, 100000, []crypto.Hash{farAway.Id}},
+	}
+	for _, c := range cases {
+		locks, err := store.PopMaturedTimeLocks(c.height, c.timestamp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(locks) != len(c.matured) {
+			t.Fatalf("height %d: have %d matured locks, want %d", c.height, len(locks), len(c.matured))
+		}
+		for i, lock := range locks {
+			if lock.Id != c.matured[i] {
+				t.Fatalf("height %d: have lock %s, want %s", c.height, lock.Id, c.matured[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package chain
+
+import (
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+	"math/big"
+)
+
+/**********************time lock********************/
+
+type TimeLockTxSelector struct {
+}
+
+func (timeLockTxSelector *TimeLockTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.TimeLockType
+}
+
+type CancelTimeLockTxSelector struct {
+}
+
+func (cancelTimeLockTxSelector *CancelTimeLockTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.CancelTimeLockType
+}
+
+var (
+	_ = (ITransactionSelector)((*TimeLockTxSelector)(nil))
+	_ = (ITransactionSelector)((*CancelTimeLockTxSelector)(nil))
+	_ = (ITransactionValidator)((*TimeLockTransactionProcessor)(nil))
+	_ = (ITransactionValidator)((*CancelTimeLockTransactionProcessor)(nil))
+)
+
+//TimeLockTransactionProcessor move the amount from sender to a time lock,
+//the lock is released to recipient by ReleaseTimeLocks at the first block it matured
+type TimeLockTransactionProcessor struct {
+}
+
+func (timeLockTransactionProcessor *TimeLockTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	lock := types.NewTimeLock(tx, context.OperationIndex(), from, payload.(*types.TimeLockData))
+	if lock.Matured(context.header.Height, context.header.Timestamp) {
+		etr.Txerror = ErrTimeLockMatured
+		return etr
+	}
+	if tx.Amount().Sign() <= 0 {
+		etr.Txerror = ErrTimeLockAmount
+		return etr
+	}
+
+	originBalance := store.GetBalance(from, context.header.Height)
+	leftBalance := originBalance.Sub(originBalance, tx.Amount())
+	if leftBalance.Sign() < 0 {
+		etr.Txerror = ErrBalance
+		return etr
+	}
+	err = store.PutBalance(from, context.header.Height, leftBalance)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.PutTimeLock(lock, context.header.Height)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
+
+//CancelTimeLockTransactionProcessor refund a revocable lock which not matured to its sender
+type CancelTimeLockTransactionProcessor struct {
+}
+
+func (cancelTimeLockTransactionProcessor *CancelTimeLockTransactionProcessor) ExecuteTransaction(context *ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	store := context.TrieStore()
+	tx := context.Tx()
+
+	if len(tx.GetData()) != crypto.HashLength {
+		etr.Txerror = ErrTimeLockNotFound
+		return etr
+	}
+	id := crypto.BytesToHash(tx.GetData())
+	lock, err := store.GetTimeLock(&id)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	// matured lock has been released at the beginning of block
+	if lock == nil {
+		etr.Txerror = ErrTimeLockNotFound
+		return etr
+	}
+	if lock.From != *from {
+		etr.Txerror = ErrTimeLockNotOwner
+		return etr
+	}
+	if !lock.Revocable {
+		etr.Txerror = ErrTimeLockIrrevocable
+		return etr
+	}
+
+	err = store.DelTimeLock(&id)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	err = store.AddBalance(from, context.header.Height, new(big.Int).Set(lock.Lock.CreditValue.ToInt()))
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	err = store.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
+
+// ReleaseTimeLocks transfer all locks matured at the header to their recipients,
+// it must be called before executing the transactions of block
+func ReleaseTimeLocks(trieStore store.StoreInterface, header *types.BlockHeader) error {
+	locks, err := trieStore.PopMaturedTimeLocks(header.Height, header.Timestamp)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		err = trieStore.DelTimeLock(&lock.Id)
+		if err != nil {
+			return err
+		}
+		err = trieStore.AddBalance(&lock.To, header.Height, new(big.Int).Set(lock.Lock.CreditValue.ToInt()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
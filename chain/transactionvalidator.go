@@ -3,6 +3,7 @@ package chain
 import (
 	"github.com/drep-project/DREP-Chain/chain/store"
 	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/types"
 	"math/big"
 )
@@ -22,9 +23,13 @@ type ExecuteTransactionContext struct {
 	gp          *GasPool
 	tx          *types.Transaction
 	from        *crypto.CommonAddress
+	gasPayer    *crypto.CommonAddress //sponsor of tx or from
 	gasPrice    *big.Int
 	value       *big.Int
 	data        []byte
+	payload     types.TxPayload //按交易类型和版本解码后的data
+	payloadErr  error
+	opIndex     int //批量交易中操作的序号，非批量交易为0
 	header      *types.BlockHeader
 	gasRemained uint64
 	initialGas  uint64
@@ -34,9 +39,14 @@ func NewExecuteTransactionContext(blockContext *BlockExecuteContext, chainstore
 	context := &ExecuteTransactionContext{trieStore: chainstore, gp: gasPool, tx: tx, from: from}
 	context.blockContext = blockContext
 	context.from = from
+	context.gasPayer = from
+	if sponsor, err := tx.Sponsor(); err == nil && sponsor != nil {
+		context.gasPayer = sponsor
+	}
 	context.gasPrice = tx.GasPrice()
 	context.value = tx.Amount()
 	context.data = tx.GetData()
+	context.payload, context.payloadErr = tx.DecodePayload()
 	context.header = blockContext.Block.Header
 	return context
 }
@@ -56,6 +66,17 @@ func (context *ExecuteTransactionContext) Data() []byte {
 	return context.data
 }
 
+// OperationIndex return the index of the operation executing in a batch tx, it is 0 for other txs
+func (context *ExecuteTransactionContext) OperationIndex() int {
+	return context.opIndex
+}
+
+// Payload return the data of tx decoded by the codec registered for its type and version,
+// it is nil for the tx types carrying raw data
+func (context *ExecuteTransactionContext) Payload() (types.TxPayload, error) {
+	return context.payload, context.payloadErr
+}
+
 func (context *ExecuteTransactionContext) Value() *big.Int {
 	return context.value
 }
@@ -72,6 +93,10 @@ func (context *ExecuteTransactionContext) From() *crypto.CommonAddress {
 	return context.from
 }
 
+func (context *ExecuteTransactionContext) GasPayer() *crypto.CommonAddress {
+	return context.gasPayer
+}
+
 func (context *ExecuteTransactionContext) Tx() *types.Transaction {
 	return context.tx
 }
@@ -87,7 +112,7 @@ func (context *ExecuteTransactionContext) TrieStore() store.StoreInterface {
 func (context *ExecuteTransactionContext) RefundCoin() error {
 	// Return DREP for remaining gasRemained, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(context.gasRemained), context.gasPrice)
-	err := context.trieStore.AddBalance(context.from, context.header.Height, remaining)
+	err := context.trieStore.AddBalance(context.gasPayer, context.header.Height, remaining)
 	if err != nil {
 		return nil
 	}
@@ -120,7 +145,7 @@ func (context *ExecuteTransactionContext) UseGas(amount uint64) error {
 
 func (context *ExecuteTransactionContext) buyGas() error {
 	mgval := new(big.Int).Mul(new(big.Int).SetUint64(context.tx.Gas()), context.gasPrice)
-	if context.trieStore.GetBalance(context.from, context.header.Height).Cmp(mgval) < 0 {
+	if context.trieStore.GetBalance(context.gasPayer, context.header.Height).Cmp(mgval) < 0 {
 		return ErrInsufficientBalanceForGas
 	}
 	if err := context.gp.SubGas(context.tx.Gas()); err != nil {
@@ -129,10 +154,37 @@ func (context *ExecuteTransactionContext) buyGas() error {
 	context.gasRemained += context.tx.Gas()
 
 	context.initialGas = context.tx.Gas()
-	return context.trieStore.SubBalance(context.from, context.header.Height, mgval)
+	return context.trieStore.SubBalance(context.gasPayer, context.header.Height, mgval)
 }
 
 func (context *ExecuteTransactionContext) PreCheck() error {
+	// Make sure this transaction is signed for this chain, reject replay from other chains.
+	if params.IsChainIdFork(context.header.Height) && context.tx.ChainId() != context.header.ChainId {
+		log.WithField("block chainId", context.header.ChainId).WithField("tx chainId", context.tx.ChainId()).WithField("from", context.from.String()).Info("state precheck chainId not matched")
+		return ErrTxChainId
+	}
+	// Make sure the version of this transaction is active at the height of block and its extension is well formed.
+	if err := context.tx.CheckVersion(context.header.Height); err != nil {
+		log.WithField("height", context.header.Height).WithField("version", context.tx.Data.Version).WithField("from", context.from.String()).WithField("err", err).Info("state precheck tx version fail")
+		return err
+	}
+	// Make sure this transaction is still valid at the height of block.
+	if context.tx.Expired(context.header.Height) {
+		log.WithField("height", context.header.Height).WithField("valid until", context.tx.ValidUntilHeight()).WithField("from", context.from.String()).Info("state precheck tx expired")
+		return ErrTxExpired
+	}
+	// Make sure the signature of sponsor is well formed, sponsor pay for the gas instead of sender.
+	if _, err := context.tx.Sponsor(); err != nil {
+		log.WithField("from", context.from.String()).WithField("err", err).Info("state precheck sponsor fail")
+		return err
+	}
+	// Make sure the signatures of multisig transaction reach the threshold of the sender account.
+	if context.tx.IsMultiSig() {
+		if err := VerifyMultiSig(context.trieStore.GetMultiSigAccount(context.from), context.tx); err != nil {
+			log.WithField("from", context.from.String()).WithField("err", err).Info("state precheck multisig fail")
+			return err
+		}
+	}
 	// Make sure this transaction's nonce is correct.
 	nonce := context.trieStore.GetNonce(context.from)
 	if nonce < context.tx.Nonce() {
@@ -144,3 +196,11 @@ func (context *ExecuteTransactionContext) PreCheck() error {
 	}
 	return context.buyGas()
 }
+
+// VerifyMultiSig check the multisig transaction against the account registered at its sender address
+func VerifyMultiSig(account *types.MultiSigAccount, tx *types.Transaction) error {
+	if account == nil {
+		return ErrNotMultiSigAccount
+	}
+	return account.VerifyTransaction(tx)
+}
@@ -0,0 +1,83 @@
+package chain
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/drep-project/DREP-Chain/blockmgr/txpool"
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/types"
+)
+
+//TestTxPoolReorg check the txs of detached blocks are back in the pool of a node following the chain
+func TestTxPoolReorg(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "drep-txpool")
+	defer os.RemoveAll(dir)
+
+	tester := newReorgTester(t)
+	chainService := tester.newChain()
+	genesis := chainService.genesisBlock.Header
+	poolStore, err := store.TrieStoreFromStore(chainService.DatabaseService.LevelDb(), genesis.StateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := txpool.NewTransactionPool(poolStore, filepath.Join(dir, "txs"))
+	pool.Start(chainService.NewBlockFeed(), chainService.DetachBlockFeed(), genesis.StateRoot)
+
+	sender := crypto.PubkeyToAddress(tester.priv.PubKey())
+	to := crypto.CommonAddress{1}
+	txs := []*types.Transaction{}
+	for nonce := uint64(0); nonce < 4; nonce++ {
+		txs = append(txs, tester.transfer(nonce, to))
+	}
+
+	a1 := tester.makeBlock(genesis, 1, txs[0])
+	a2 := tester.makeBlock(a1.Header, 1, txs[1], txs[2])
+	tester.process(chainService, a1, a2)
+	waitPool(t, pool, &sender, 3)
+	if err := pool.AddTransaction(txs[3], false); err != nil {
+		t.Fatal(err)
+	}
+
+	//a longer branch including the first two txs, the txs of a2 not in the branch go back to the pool
+	b1 := tester.makeBlock(genesis, 2, txs[0])
+	b2 := tester.makeBlock(b1.Header, 1, txs[1])
+	b3 := tester.makeBlock(b2.Header, 1)
+	tester.process(chainService, b1, b2, b3)
+	tester.checkState(chainService, []*types.Block{b1, b2, b3}, to)
+	waitPool(t, pool, &sender, 4)
+
+	pending := pool.GetPending(big.NewInt(0).SetUint64(^uint64(0) >> 1))
+	if len(pending) != 2 {
+		t.Fatalf("pending %d txs after reorg, want 2", len(pending))
+	}
+	for i, tx := range pending {
+		if *tx.TxHash() != *txs[i+2].TxHash() {
+			t.Fatalf("pending tx %d has nonce %d, want %d", i, tx.Nonce(), i+2)
+		}
+	}
+	for _, tx := range txs[:2] {
+		if _, err := pool.GetTxInPool(tx.TxHash().String()); err == nil {
+			t.Fatalf("tx with nonce %d in the new main chain added back to the pool", tx.Nonce())
+		}
+	}
+	if len(pool.GetQueue()) != 0 {
+		t.Fatalf("%d txs queued after reorg", len(pool.GetQueue()))
+	}
+}
+
+//waitPool wait the pool to handle the blocks sent to it, until the next nonce of addr is nonce
+func waitPool(t *testing.T, pool *txpool.TransactionPool, addr *crypto.CommonAddress, nonce uint64) {
+	for i := 0; i < 100; i++ {
+		if pool.GetTransactionCount(addr) == nonce {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("next nonce of pool %d, want %d", pool.GetTransactionCount(addr), nonce)
+}
@@ -2,10 +2,13 @@ package common
 
 import (
 	"bytes"
+	"errors"
 	"github.com/drep-project/DREP-Chain/crypto/sha3"
 	"math"
 )
 
+var ErrMerkleLeafIndex = errors.New("merkle leaf index out of range")
+
 type MerkleNode struct {
 	Parent     *MerkleNode
 	LeftChild  *MerkleNode
@@ -132,3 +135,56 @@ func (m *Merkle) IsOnTrie(hash []byte) bool {
 	}
 	return false
 }
+
+// MerkleProof is the authorization path of a leaf. Siblings[i] is the hash combined with
+// the node on layer i to get its parent, empty if the node has no neighbour and is hashed alone.
+type MerkleProof struct {
+	Index    uint64
+	Siblings []Bytes
+}
+
+// Proof build the authorization path of the leaf at index
+func (m *Merkle) Proof(index int) (*MerkleProof, error) {
+	if index < 0 || index >= len(m.Leaves) {
+		return nil, ErrMerkleLeafIndex
+	}
+	proof := &MerkleProof{
+		Index:    uint64(index),
+		Siblings: make([]Bytes, 0, m.Height-1),
+	}
+	node := m.Leaves[index]
+	for i := 0; i < m.Height-1; i++ {
+		if node.Neighbour != nil {
+			proof.Siblings = append(proof.Siblings, CopyBytes(node.Neighbour.Hash))
+		} else {
+			proof.Siblings = append(proof.Siblings, Bytes{})
+		}
+		node = node.Parent
+	}
+	return proof, nil
+}
+
+// VerifyMerkleProof check the leaf is at proof.Index of the merkle tree with the root
+func VerifyMerkleProof(root []byte, leaf []byte, proof *MerkleProof) bool {
+	if proof == nil || len(proof.Siblings) >= 64 {
+		return false
+	}
+	h := leaf
+	index := proof.Index
+	for _, sibling := range proof.Siblings {
+		switch {
+		case len(sibling) == 0:
+			//a single node is always the left child
+			if index%2 != 0 {
+				return false
+			}
+			h = sha3.HashS256(h)
+		case index%2 == 0:
+			h = sha3.HashS256(h, sibling)
+		default:
+			h = sha3.HashS256(sibling, h)
+		}
+		index /= 2
+	}
+	return index == 0 && bytes.Equal(h, root)
+}
@@ -0,0 +1,38 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for size := 1; size <= 9; size++ {
+		leaves := make([][]byte, size)
+		for i := range leaves {
+			leaves[i] = sha3.Keccak256([]byte{byte(i)})
+		}
+		merkle := NewMerkle(leaves)
+		for i := range leaves {
+			proof, err := merkle.Proof(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !VerifyMerkleProof(merkle.Root.Hash, leaves[i], proof) {
+				t.Fatalf("size %d index %d proof not verified", size, i)
+			}
+			if VerifyMerkleProof(merkle.Root.Hash, sha3.Keccak256([]byte("other")), proof) {
+				t.Fatalf("size %d index %d proof verify a wrong leaf", size, i)
+			}
+			if size > 1 {
+				proof.Index = uint64((i + 1) % size)
+				if VerifyMerkleProof(merkle.Root.Hash, leaves[i], proof) {
+					t.Fatalf("size %d index %d proof verify a wrong index", size, i)
+				}
+			}
+		}
+		if _, err := merkle.Proof(size); err != ErrMerkleLeafIndex {
+			t.Fatalf("expect ErrMerkleLeafIndex, got %v", err)
+		}
+	}
+}
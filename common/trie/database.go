@@ -91,6 +91,8 @@ type Database struct {
 	childrenSize  common.StorageSize // Storage size of the external children tracking
 	preimagesSize common.StorageSize // Storage size of the preimages cache
 
+	refcount bool // Whether references of persisted nodes are counted, see EnableRefCount
+
 	lock sync.RWMutex
 }
 
@@ -322,6 +324,7 @@ func NewDatabaseWithCache(diskdb dbinterface.KeyValueStore, cache int) *Database
 			children: make(map[crypto.Hash]uint16),
 		}},
 		preimages: make(map[crypto.Hash][]byte),
+		refcount:  RefCountEnabled(diskdb),
 	}
 }
 
@@ -700,6 +703,10 @@ func (db *Database) Commit(node crypto.Hash, report bool) error {
 // Note, this method is a non-synchronized mutator. It is unsafe to call this
 // concurrently with other mutators.
 func (db *Database) TrieDb(node crypto.Hash, report bool) error {
+	if db.refcount {
+		refLock.Lock()
+		defer refLock.Unlock()
+	}
 	// Create a database batch to flush persistent data out. It is important that
 	// outside code doesn't see an inconsistent state (referenced data removed from
 	// memory cache during commit but not yet in persistent storage). This is ensured
@@ -732,10 +739,20 @@ func (db *Database) TrieDb(node crypto.Hash, report bool) error {
 	nodes, storage := len(db.dirties), db.dirtiesSize
 
 	uncacher := &cleaner{db}
-	if err := db.commit(node, batch, uncacher); err != nil {
+	var counter *refCounter
+	if db.refcount {
+		counter = newRefCounter(db.diskdb)
+	}
+	if err := db.commit(node, batch, uncacher, counter); err != nil {
 		log.Error("Failed to commit trie from trie database", "err", err)
 		return err
 	}
+	if counter != nil {
+		if err := counter.flush(batch); err != nil {
+			log.Error("Failed to count trie references", "err", err)
+			return err
+		}
+	}
 	// Trie mostly committed to disk, flush any batch leftovers
 	if err := batch.Write(); err != nil {
 		log.Error("Failed to write trie to disk", "err", err)
@@ -767,17 +784,20 @@ func (db *Database) TrieDb(node crypto.Hash, report bool) error {
 }
 
 // commit is the private locked version of TrieDb.
-func (db *Database) commit(hash crypto.Hash, batch dbinterface.Batch, uncacher *cleaner) error {
+func (db *Database) commit(hash crypto.Hash, batch dbinterface.Batch, uncacher *cleaner, counter *refCounter) error {
 	// If the node does not exist, it's a previously committed node
 	node, ok := db.dirties[hash]
 	if !ok {
 		return nil
 	}
 	for _, child := range node.childs() {
-		if err := db.commit(child, batch, uncacher); err != nil {
+		if err := db.commit(child, batch, uncacher, counter); err != nil {
 			return err
 		}
 	}
+	if counter != nil {
+		counter.add(hash, node)
+	}
 
 	if err := batch.Put(hash[:], node.rlp()); err != nil {
 		return err
@@ -807,6 +827,10 @@ type cleaner struct {
 // the two-phase commit is to ensure ensure data availability while moving from
 // memory to disk.
 func (c *cleaner) Put(key []byte, rlp []byte) error {
+	// Reference counts are written in the same batch, they are not trie nodes
+	if len(key) != crypto.HashLength {
+		return nil
+	}
 	hash := crypto.BytesToHash(key)
 
 	// If the node does not exist, we're done on this path
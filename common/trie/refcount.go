@@ -0,0 +1,220 @@
+package trie
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/database/dbinterface"
+)
+
+// Persisted trie nodes are shared between the states of many blocks, so they can
+// only be deleted from disk once no retained state reaches them. The reference
+// count of a node on disk is the number of distinct persisted nodes pointing to it
+// plus the number of references taken on it as a state root. Counting must start
+// from an empty database, a node written before would be reached by uncounted
+// parents, so pruning is only possible if EnableRefCount was called before the
+// first commit.
+var (
+	refCountPrefix = []byte("trie-ref-")   // refCountPrefix + node hash -> uint64 reference count
+	refCountMarker = []byte("trie-ref-on") // existence means every persisted node is counted
+
+	// refLock serializes the commits with the reference changes of state roots. Many
+	// Database instances are opened on the same disk database, so the lock is not
+	// held by any single one of them.
+	refLock sync.Mutex
+)
+
+// EnableRefCount mark the disk database to count references of every trie node
+// committed afterwards. It must be called on an empty database.
+func EnableRefCount(diskdb dbinterface.KeyValueStore) error {
+	return diskdb.Put(refCountMarker, []byte{1})
+}
+
+// RefCountEnabled report whether the reference counts on disk cover every trie node
+func RefCountEnabled(diskdb dbinterface.KeyValueReader) bool {
+	ok, err := diskdb.Has(refCountMarker)
+	return err == nil && ok
+}
+
+func refCountKey(hash crypto.Hash) []byte {
+	return append(append([]byte{}, refCountPrefix...), hash[:]...)
+}
+
+// refCounter accumulates the reference changes of one commit, they are written
+// in the same batch as the last trie nodes.
+type refCounter struct {
+	diskdb  dbinterface.KeyValueReader
+	deltas  map[crypto.Hash]uint64
+	written map[crypto.Hash]struct{}
+}
+
+func newRefCounter(diskdb dbinterface.KeyValueReader) *refCounter {
+	return &refCounter{
+		diskdb:  diskdb,
+		deltas:  make(map[crypto.Hash]uint64),
+		written: make(map[crypto.Hash]struct{}),
+	}
+}
+
+// add count the children of a node about to be persisted, a node already on disk
+// has its children counted when it was first written.
+func (counter *refCounter) add(hash crypto.Hash, node *cachedNode) {
+	if counter.counted(hash) {
+		return
+	}
+	for _, child := range node.childs() {
+		counter.deltas[child]++
+	}
+}
+
+// addDecoded count the children of a node downloaded by state sync, like add.
+func (counter *refCounter) addDecoded(hash crypto.Hash, n node) {
+	if counter.counted(hash) {
+		return
+	}
+	var children []crypto.Hash
+	gatherDecodedChildren(n, &children)
+	for _, child := range children {
+		counter.deltas[child]++
+	}
+}
+
+func (counter *refCounter) counted(hash crypto.Hash) bool {
+	if _, ok := counter.written[hash]; ok {
+		return true
+	}
+	counter.written[hash] = struct{}{}
+	ok, _ := counter.diskdb.Has(hash[:])
+	return ok
+}
+
+func (counter *refCounter) flush(batch dbinterface.Batch) error {
+	for hash, delta := range counter.deltas {
+		count, _ := readRefCount(counter.diskdb, hash)
+		if err := batch.Put(refCountKey(hash), encodeRefCount(count+delta)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readRefCount(diskdb dbinterface.KeyValueReader, hash crypto.Hash) (uint64, bool) {
+	value, err := diskdb.Get(refCountKey(hash))
+	if err != nil || len(value) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(value), true
+}
+
+func encodeRefCount(count uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, count)
+	return buf
+}
+
+// ReferenceRoot take a reference on a committed state root, the state stays on
+// disk until every reference is released by DereferenceRoot.
+func (db *Database) ReferenceRoot(root crypto.Hash) error {
+	if !db.refcount || root == EmptyRoot {
+		return nil
+	}
+	refLock.Lock()
+	defer refLock.Unlock()
+
+	count, _ := readRefCount(db.diskdb, root)
+	return db.diskdb.Put(refCountKey(root), encodeRefCount(count+1))
+}
+
+// DereferenceRoot release a reference on a state root, nodes no longer reached by
+// any persisted node or referenced root are deleted from disk. It returns the
+// number of deleted nodes. It is serialized with commits by refLock, so a commit
+// never counts a node as already on disk while the node is being deleted.
+func (db *Database) DereferenceRoot(root crypto.Hash) (int, error) {
+	if !db.refcount || root == EmptyRoot {
+		return 0, nil
+	}
+	refLock.Lock()
+	defer refLock.Unlock()
+
+	batch := db.diskdb.NewBatch()
+	counts := make(map[crypto.Hash]uint64)
+	deleted := 0
+
+	pending := []crypto.Hash{root}
+	for len(pending) > 0 {
+		hash := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		count, ok := counts[hash]
+		if !ok {
+			// a node without count is written before counting started, keep it
+			if count, ok = readRefCount(db.diskdb, hash); !ok {
+				continue
+			}
+		}
+		if count > 1 {
+			counts[hash] = count - 1
+			continue
+		}
+		counts[hash] = 0
+		blob, err := db.diskdb.Get(hash[:])
+		if err != nil || len(blob) == 0 {
+			continue
+		}
+		n, err := decodeNode(hash[:], blob)
+		if err != nil {
+			return deleted, err
+		}
+		if err := batch.Delete(hash[:]); err != nil {
+			return deleted, err
+		}
+		deleted++
+		var children []crypto.Hash
+		gatherDecodedChildren(n, &children)
+		pending = append(pending, children...)
+
+		if batch.ValueSize() >= dbinterface.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return deleted, err
+			}
+			batch.Reset()
+		}
+	}
+	for hash, count := range counts {
+		var err error
+		if count == 0 {
+			err = batch.Delete(refCountKey(hash))
+		} else {
+			err = batch.Put(refCountKey(hash), encodeRefCount(count))
+		}
+		if err != nil {
+			return deleted, err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return deleted, err
+	}
+	if db.cleans != nil {
+		for hash, count := range counts {
+			if count == 0 {
+				db.cleans.Delete(string(hash[:]))
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// gatherDecodedChildren retrieves all the hashnode children of a node decoded from disk.
+func gatherDecodedChildren(n node, children *[]crypto.Hash) {
+	switch n := n.(type) {
+	case *shortNode:
+		gatherDecodedChildren(n.Val, children)
+	case *fullNode:
+		for i := 0; i < 16; i++ {
+			gatherDecodedChildren(n.Children[i], children)
+		}
+	case hashNode:
+		*children = append(*children, crypto.BytesToHash(n))
+	}
+}
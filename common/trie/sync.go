@@ -0,0 +1,264 @@
+// Copyright 2015 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package trie
+
+import (
+	"errors"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/database/dbinterface"
+	"github.com/ethereum/go-ethereum/common/prque"
+)
+
+// ErrNotRequested is returned by the trie sync when it's requested to process a
+// node it did not request.
+var ErrNotRequested = errors.New("not requested")
+
+// ErrAlreadyProcessed is returned by the trie sync when it's requested to process a
+// node it already processed previously.
+var ErrAlreadyProcessed = errors.New("already processed")
+
+// ErrSyncNodeHash is returned by the trie sync when the data delivered for a node
+// does not hash to the requested key.
+var ErrSyncNodeHash = errors.New("node data not matched hash")
+
+// request represents a scheduled or already in-flight state retrieval request.
+type request struct {
+	hash crypto.Hash // Hash of the node data content to retrieve
+	data []byte      // Data content of the node, cached until all subtrees complete
+
+	parents []*request // Parent state nodes referencing this entry (notify all upon completion)
+	depth   int        // Depth level within the trie the node is located to prioritise DFS
+	deps    int        // Number of dependencies before allowed to commit this node
+}
+
+// SyncResult is a simple list to return missing nodes along with their request
+// hashes.
+type SyncResult struct {
+	Hash crypto.Hash // Hash of the originally unknown trie node
+	Data []byte      // Data content of the retrieved node
+}
+
+// Sync is the main state trie synchronisation scheduler, which provides yet
+// unknown trie hashes to retrieve, accepts node data associated with said hashes
+// and reconstructs the trie step by step until all is done.
+//
+// A node is only written once all of its children are on disk, every node found
+// in the database is therefore the root of a complete subtrie and is not fetched
+// again, an interrupted sync resumes where it stopped.
+type Sync struct {
+	database dbinterface.KeyValueReader // Persistent database to check for existing entries
+	membatch map[crypto.Hash][]byte     // Memory buffer to avoid frequent database writes
+	requests map[crypto.Hash]*request   // Pending requests pertaining to a key hash
+	queue    *prque.Prque               // Priority queue with the pending requests
+}
+
+// NewSync creates a new trie data download scheduler.
+func NewSync(root crypto.Hash, database dbinterface.KeyValueReader) *Sync {
+	ts := &Sync{
+		database: database,
+		membatch: make(map[crypto.Hash][]byte),
+		requests: make(map[crypto.Hash]*request),
+		queue:    prque.New(nil),
+	}
+	if root != EmptyRoot && !ts.known(root) {
+		ts.schedule(&request{hash: root})
+	}
+	return ts
+}
+
+// Missing retrieves the known missing nodes from the trie for retrieval.
+func (s *Sync) Missing(max int) []crypto.Hash {
+	var requests []crypto.Hash
+	for !s.queue.Empty() && (max == 0 || len(requests) < max) {
+		requests = append(requests, s.queue.PopItem().(crypto.Hash))
+	}
+	return requests
+}
+
+// Retry schedules again the hashes returned by Missing whose data was not delivered.
+func (s *Sync) Retry(hashes []crypto.Hash) {
+	for _, hash := range hashes {
+		if req, ok := s.requests[hash]; ok && req.data == nil {
+			s.queue.Push(hash, int64(req.depth))
+		}
+	}
+}
+
+// Process injects a batch of retrieved trie nodes data, returning if something
+// was committed to the database and also the index of an entry if its processing
+// failed.
+func (s *Sync) Process(results []SyncResult) (bool, int, error) {
+	committed := false
+
+	for i, item := range results {
+		// If the item was not requested, bail out
+		request := s.requests[item.Hash]
+		if request == nil {
+			return committed, i, ErrNotRequested
+		}
+		if request.data != nil {
+			return committed, i, ErrAlreadyProcessed
+		}
+		if crypto.Keccak256Hash(item.Data) != item.Hash {
+			return committed, i, ErrSyncNodeHash
+		}
+		// Decode the node data content and update the request
+		node, err := decodeNode(item.Hash[:], item.Data)
+		if err != nil {
+			return committed, i, err
+		}
+		request.data = item.Data
+
+		// Create and schedule a request for all the children nodes
+		requests := s.children(request, node)
+		if len(requests) == 0 && request.deps == 0 {
+			s.commit(request)
+			committed = true
+			continue
+		}
+		request.deps += len(requests)
+		for _, child := range requests {
+			s.schedule(child)
+		}
+	}
+	return committed, 0, nil
+}
+
+// Commit flushes the data stored in the internal membatch out to persistent
+// storage, returning any occurred error. If the database counts node references
+// the children of the flushed nodes are counted in the same batch, the same way
+// as a commit of the trie database.
+func (s *Sync) Commit(dbw dbinterface.Batch) error {
+	var counter *refCounter
+	if RefCountEnabled(s.database) {
+		counter = newRefCounter(s.database)
+	}
+	for key, value := range s.membatch {
+		if counter != nil {
+			n, err := decodeNode(key[:], value)
+			if err != nil {
+				return err
+			}
+			counter.addDecoded(key, n)
+		}
+		if err := dbw.Put(key[:], value); err != nil {
+			return err
+		}
+	}
+	if counter != nil {
+		if err := counter.flush(dbw); err != nil {
+			return err
+		}
+	}
+	// Drop the membatch data and return
+	s.membatch = make(map[crypto.Hash][]byte)
+	return nil
+}
+
+// Pending returns the number of state entries currently pending for download.
+func (s *Sync) Pending() int {
+	return len(s.requests)
+}
+
+// known report whether a node is already written or waiting in the membatch
+func (s *Sync) known(hash crypto.Hash) bool {
+	if _, ok := s.membatch[hash]; ok {
+		return true
+	}
+	ok, _ := s.database.Has(hash[:])
+	return ok
+}
+
+// schedule inserts a new state retrieval request into the fetch queue. If there
+// is already a pending request for this node, the new request will be discarded
+// and only a parent reference added to the old one.
+func (s *Sync) schedule(req *request) {
+	// If we're already requesting this node, add a new reference and stop
+	if old, ok := s.requests[req.hash]; ok {
+		old.parents = append(old.parents, req.parents...)
+		return
+	}
+	// Schedule the request for future retrieval
+	s.queue.Push(req.hash, int64(req.depth))
+	s.requests[req.hash] = req
+}
+
+// children retrieves all the missing children of a state trie entry for future
+// retrieval scheduling.
+func (s *Sync) children(req *request, object node) []*request {
+	// Gather all the children of the node, irrelevant whether known or not
+	type child struct {
+		node  node
+		depth int
+	}
+	var children []child
+
+	switch node := (object).(type) {
+	case *shortNode:
+		children = []child{{
+			node:  node.Val,
+			depth: req.depth + len(node.Key),
+		}}
+	case *fullNode:
+		for i := 0; i < 17; i++ {
+			if node.Children[i] != nil {
+				children = append(children, child{
+					node:  node.Children[i],
+					depth: req.depth + 1,
+				})
+			}
+		}
+	}
+	// Iterate over the children, and request all unknown ones
+	requests := make([]*request, 0, len(children))
+	for _, child := range children {
+		// If the child references another node, resolve or schedule
+		if node, ok := (child.node).(hashNode); ok {
+			// Try to resolve the node from the local database
+			hash := crypto.BytesToHash(node)
+			if s.known(hash) {
+				continue
+			}
+			// Locally unknown node, schedule for retrieval
+			requests = append(requests, &request{
+				hash:    hash,
+				parents: []*request{req},
+				depth:   child.depth,
+			})
+		}
+	}
+	return requests
+}
+
+// commit finalizes a retrieval request and stores it into the membatch. If any
+// of the referencing parent requests complete due to this commit, they are also
+// committed themselves.
+func (s *Sync) commit(req *request) {
+	// Write the node content to the membatch
+	s.membatch[req.hash] = req.data
+
+	delete(s.requests, req.hash)
+
+	// Check all parents for completion
+	for _, parent := range req.parents {
+		parent.deps--
+		if parent.deps == 0 {
+			s.commit(parent)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2015 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/database/memorydb"
+)
+
+// makeSyncTestTrie create a secure trie committed to disk with some entries.
+func makeSyncTestTrie(t *testing.T) (*memorydb.Database, crypto.Hash, map[string][]byte) {
+	diskdb := memorydb.New()
+	triedb := NewDatabase(diskdb)
+	trie, _ := NewSecure(crypto.Hash{}, triedb)
+
+	content := make(map[string][]byte)
+	for i := byte(0); i < 255; i++ {
+		key, val := []byte(fmt.Sprintf("key-%d", i)), bytes.Repeat([]byte{i}, 40)
+		content[string(key)] = val
+		trie.Update(key, val)
+	}
+	root, err := trie.Commit(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := triedb.Commit(root, false); err != nil {
+		t.Fatal(err)
+	}
+	return diskdb, root, content
+}
+
+func checkSyncTestTrie(t *testing.T, diskdb *memorydb.Database, root crypto.Hash, content map[string][]byte) {
+	trie, err := NewSecure(root, NewDatabase(diskdb))
+	if err != nil {
+		t.Fatalf("synced trie not complete: %v", err)
+	}
+	for key, val := range content {
+		if have := trie.Get([]byte(key)); !bytes.Equal(have, val) {
+			t.Fatalf("entry %s mismatch: have %x, want %x", key, have, val)
+		}
+	}
+}
+
+func TestSyncTrie(t *testing.T) {
+	srcDb, root, content := makeSyncTestTrie(t)
+
+	diskdb := memorydb.New()
+	sched := NewSync(root, diskdb)
+	for rounds := 0; sched.Pending() > 0; rounds++ {
+		hashes := sched.Missing(16)
+		//only half of the nodes are delivered, the rest must be scheduled again
+		results := make([]SyncResult, 0, len(hashes))
+		for i, hash := range hashes {
+			if i%2 == 1 {
+				continue
+			}
+			data, err := srcDb.Get(hash[:])
+			if err != nil {
+				t.Fatalf("failed to retrieve node data for %x: %v", hash, err)
+			}
+			results = append(results, SyncResult{Hash: hash, Data: data})
+		}
+		if _, index, err := sched.Process(results); err != nil {
+			t.Fatalf("failed to process result #%d: %v", index, err)
+		}
+		sched.Retry(hashes)
+
+		batch := diskdb.NewBatch()
+		if err := sched.Commit(batch); err != nil {
+			t.Fatalf("failed to commit data: %v", err)
+		}
+		batch.Write()
+		if rounds > 1000 {
+			t.Fatal("sync not finished")
+		}
+	}
+	checkSyncTestTrie(t, diskdb, root, content)
+
+	//every node is known now, nothing to fetch again
+	if pending := NewSync(root, diskdb).Pending(); pending != 0 {
+		t.Fatalf("pending after sync: %d", pending)
+	}
+}
+
+func TestSyncTrieBadNode(t *testing.T) {
+	srcDb, root, _ := makeSyncTestTrie(t)
+
+	sched := NewSync(root, memorydb.New())
+	hashes := sched.Missing(1)
+	data, _ := srcDb.Get(hashes[0][:])
+	bad := append(append([]byte{}, data...), 0)
+	if _, _, err := sched.Process([]SyncResult{{Hash: hashes[0], Data: bad}}); err != ErrSyncNodeHash {
+		t.Fatalf("tampered node: have %v, want %v", err, ErrSyncNodeHash)
+	}
+	if _, _, err := sched.Process([]SyncResult{{Hash: crypto.Keccak256Hash(data[:1]), Data: data[:1]}}); err != ErrNotRequested {
+		t.Fatalf("unrequested node: have %v, want %v", err, ErrNotRequested)
+	}
+}
+
+func TestSyncTrieRefCount(t *testing.T) {
+	srcDb, root, content := makeSyncTestTrie(t)
+
+	diskdb := memorydb.New()
+	EnableRefCount(diskdb)
+	sched := NewSync(root, diskdb)
+	for sched.Pending() > 0 {
+		hashes := sched.Missing(0)
+		results := make([]SyncResult, len(hashes))
+		for i, hash := range hashes {
+			data, _ := srcDb.Get(hash[:])
+			results[i] = SyncResult{Hash: hash, Data: data}
+		}
+		if _, index, err := sched.Process(results); err != nil {
+			t.Fatalf("failed to process result #%d: %v", index, err)
+		}
+		batch := diskdb.NewBatch()
+		sched.Commit(batch)
+		batch.Write()
+	}
+	checkSyncTestTrie(t, diskdb, root, content)
+
+	//a synced state is released like a committed one
+	triedb := NewDatabaseWithCache(diskdb, 0)
+	triedb.ReferenceRoot(root)
+	deleted, err := triedb.DereferenceRoot(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := diskdb.Has(root[:]); ok || deleted == 0 {
+		t.Fatalf("synced state not released, deleted %d nodes", deleted)
+	}
+	it := diskdb.NewIterator()
+	defer it.Release()
+	for it.Next() {
+		if len(it.Key()) == crypto.HashLength {
+			t.Fatalf("node %x left after release", it.Key())
+		}
+	}
+}
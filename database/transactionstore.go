@@ -8,6 +8,10 @@ import (
 type TransactionStore struct {
 	dirties *sync.Map //数据属于storage的缓存
 	trie    *trie.SecureTrie
+	lock    sync.Mutex //状态树不支持并发读，多个overlay同时读取时需要加锁
+
+	parent *TransactionStore   //overlay的数据从parent读取，修改在Merge时写入parent
+	reads  map[string]struct{} //overlay从parent读取过的key
 }
 type SnapShot dirtiesKV
 type dirtiesKV struct {
@@ -22,6 +26,16 @@ func NewTransactionStore(trie *trie.SecureTrie) *TransactionStore {
 	}
 }
 
+// NewOverlayStore return a store buffering the changes of one transaction on top of parent,
+// the keys read from parent are recorded to detect the conflicts with other transactions
+func NewOverlayStore(parent *TransactionStore) *TransactionStore {
+	return &TransactionStore{
+		dirties: new(sync.Map),
+		parent:  parent,
+		reads:   make(map[string]struct{}),
+	}
+}
+
 func (tDb *TransactionStore) Get(key []byte) ([]byte, error) {
 	if val, ok := tDb.dirties.Load(string(key)); ok {
 		if val == nil {
@@ -29,6 +43,12 @@ func (tDb *TransactionStore) Get(key []byte) ([]byte, error) {
 		}
 		return val.([]byte), nil
 	}
+	if tDb.parent != nil {
+		tDb.reads[string(key)] = struct{}{}
+		return tDb.parent.Get(key)
+	}
+	tDb.lock.Lock()
+	defer tDb.lock.Unlock()
 	val, err := tDb.trie.TryGet(key)
 	if err != nil {
 		return nil, err
@@ -47,6 +67,11 @@ func (tDb *TransactionStore) Delete(key []byte) error {
 	return nil
 }
 
+// IsOverlay report whether the store is created by NewOverlayStore
+func (tDb *TransactionStore) IsOverlay() bool {
+	return tDb.parent != nil
+}
+
 func (tDb *TransactionStore) Flush() {
 	tDb.dirties.Range(func(key, value interface{}) bool {
 		bk := []byte(key.(string))
@@ -69,6 +94,26 @@ func (tDb *TransactionStore) Flush() {
 	})
 }
 
+// Conflict report whether the overlay read a key in written, the result of its transaction
+// may be different if it is executed after the transactions writing these keys
+func (tDb *TransactionStore) Conflict(written map[string]struct{}) bool {
+	for key := range tDb.reads {
+		if _, ok := written[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge write the changes of the overlay to its parent, the keys written are added to written
+func (tDb *TransactionStore) Merge(written map[string]struct{}) {
+	tDb.dirties.Range(func(key, value interface{}) bool {
+		tDb.parent.dirties.Store(key, value)
+		written[key.(string)] = struct{}{}
+		return true
+	})
+}
+
 func (tDb *TransactionStore) RevertState(snapShot *SnapShot) {
 	tDb.dirties = snapShot.storageDirties
 }
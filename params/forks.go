@@ -0,0 +1,64 @@
+package params
+
+import "math"
+
+// 共识规则升级的激活高度，低于激活高度的区块仍按原来的规则执行，保证重放历史区块得到相同的状态。
+// 升级高度由发布版本统一确定，未确定前为math.MaxUint64(不激活)，私有链和测试可以设置为0。
+var (
+	ContractStorageForkHeight uint64 = math.MaxUint64 //合约storage slot按合约地址隔离
+	ReceiptStatusForkHeight   uint64 = math.MaxUint64 //receipt区分reverted/out of gas状态并记录revert数据
+	EvmGasUsedForkHeight      uint64 = math.MaxUint64 //合约交易按虚拟机实际消耗的gas收费
+	RewardRemainderForkHeight uint64 = math.MaxUint64 //支持者奖励除不尽的部分和无人支持时的奖励发给leader，之前不发放
+	SlashingForkHeight        uint64 = math.MaxUint64 //惩罚双签和掉线的候选人，罚没包括撤销后尚未到期的抵押
+	TxExtensionForkHeight     uint64 = math.MaxUint64 //接受Data外层包裹扩展字段(有效高度、代付地址)的版本2交易
+	ChainIdForkHeight         uint64 = math.MaxUint64 //区块头和交易的chainId必须与本链一致
+	AliasForkHeight           uint64 = math.MaxUint64 //已有别名的地址不能再设置别名，设置别名记录变更历史
+)
+
+// IsContractStorageFork return whether the storage slots of contracts are namespaced by
+// contract address at height
+func IsContractStorageFork(height uint64) bool {
+	return height >= ContractStorageForkHeight
+}
+
+// IsReceiptStatusFork return whether the failure status and revert data of receipts are
+// hashed into ReceiptRoot at height
+func IsReceiptStatusFork(height uint64) bool {
+	return height >= ReceiptStatusForkHeight
+}
+
+// IsEvmGasUsedFork return whether contract txs are charged for the gas consumed by the vm
+// instead of the gas left over at height
+func IsEvmGasUsedFork(height uint64) bool {
+	return height >= EvmGasUsedForkHeight
+}
+
+// IsRewardRemainderFork return whether the part of the supporter reward left undistributed
+// is paid to the leader instead of burned at height
+func IsRewardRemainderFork(height uint64) bool {
+	return height >= RewardRemainderForkHeight
+}
+
+// IsSlashingFork return whether double signing and downtime of candidates are punished at
+// height, and whether cancelled credit is recorded to be slashed before it matures
+func IsSlashingFork(height uint64) bool {
+	return height >= SlashingForkHeight
+}
+
+// IsTxExtensionFork return whether txs of the version wrapping their data with extension
+// fields such as the valid until height are accepted at height
+func IsTxExtensionFork(height uint64) bool {
+	return height >= TxExtensionForkHeight
+}
+
+// IsChainIdFork return whether the chainId of block headers and txs must match the chainId
+// of this chain at height
+func IsChainIdFork(height uint64) bool {
+	return height >= ChainIdForkHeight
+}
+
+// IsAliasFork return whether an address holding an alias is refused to set another one and
+// the setting of alias is recorded in the alias history at height
+func IsAliasFork(height uint64) bool {
+	return height >= AliasForkHeight
+}
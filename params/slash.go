@@ -0,0 +1,9 @@
+package params
+
+// 候选人惩罚参数，影响状态，所有节点必须一致，不能由节点配置；比例的单位为千分之一，为0时不惩罚
+var (
+	DoubleSignSlashRate uint64 = 50       //双签罚没的抵押比例
+	DowntimeSlashRate   uint64 = 1        //掉线罚没的抵押比例
+	DowntimeBlocks      uint64 = 8640     //连续多少块没有参与签名算作掉线，为0时不检查掉线
+	JailPeriod          uint64 = 8640 * 2 //被惩罚后暂停出块资格的块数
+)
@@ -17,6 +17,7 @@ import (
 	"github.com/drep-project/DREP-Chain/pkgs/accounts/addrgenerator"
 	"github.com/drep-project/DREP-Chain/pkgs/evm"
 	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
 )
 
 /*
@@ -171,11 +172,10 @@ func (accountapi *AccountApi) Transfer(from crypto.CommonAddress, to crypto.Comm
 
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	tx := types.NewTransaction(to, (*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return "", err
 	}
-	tx.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
 	if err != nil {
 		return "", err
@@ -202,11 +202,45 @@ func (accountapi *AccountApi) Transfer(from crypto.CommonAddress, to crypto.Comm
 func (accountapi *AccountApi) TransferWithNonce(from crypto.CommonAddress, to crypto.CommonAddress, amount, gasprice, gaslimit *common.Big, data common.Bytes, nonce uint64) (string, error) {
 	//nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	tx := types.NewTransaction(to, (*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: transferWithExpiry
+ usage: 转账，超过指定高度后交易不会再被打包。交易使用带扩展的版本2格式，在升级高度之前不会被接受
+ params:
+	1. 发起转账的地址
+	2. 接受者的地址
+	3. 金额
+	4. gas价格
+	5. gas上限
+	6. 备注
+	7. 交易有效的最高高度
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_transferWithExpiry","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x111","0x110","0x30000","",10000],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) TransferWithExpiry(from crypto.CommonAddress, to crypto.CommonAddress, amount, gasprice, gaslimit *common.Big, data common.Bytes, validUntilHeight uint64) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx := types.NewTransaction(to, (*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	tx.Data.Data = data
+	err := tx.SetExtension(&types.TxExtension{ValidUntilHeight: validUntilHeight})
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return "", err
 	}
-	tx.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
 	if err != nil {
 		return "", err
@@ -231,11 +265,10 @@ response:
 func (accountapi *AccountApi) SetAlias(srcAddr crypto.CommonAddress, alias string, gasprice, gaslimit *common.Big) (string, error) {
 	nonce := accountapi.poolQuery.GetTransactionCount(&srcAddr)
 	t := types.NewAliasTransaction(alias, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&srcAddr, t.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&srcAddr, t)
 	if err != nil {
 		return "", err
 	}
-	t.Sig = sig
 	fmt.Println(hex.EncodeToString(t.AsPersistentMessage()))
 	fmt.Println(t.TxHash().String())
 	err = accountapi.messageBroadCastor.SendTransaction(t, true)
@@ -245,6 +278,90 @@ func (accountapi *AccountApi) SetAlias(srcAddr crypto.CommonAddress, alias strin
 	return t.TxHash().String(), nil
 }
 
+/*
+ name: transferAlias
+ usage: 把地址的别名转给另一个没有别名的地址，租用的别名到期高度不变
+ params:
+	1. 持有别名的地址
+	2. 接收别名的地址
+	3. gas价格
+	4. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_transferAlias","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x300fc5a14e578be28c64627c0e7e321771c58cd4","0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x1a3b2f8e4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7"}
+*/
+func (accountapi *AccountApi) TransferAlias(from crypto.CommonAddress, to crypto.CommonAddress, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx := types.NewTransferAliasTransaction(to, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	err := accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: releaseAlias
+ usage: 释放地址的别名，释放后任何地址都可以重新设置该别名
+ params:
+	1. 持有别名的地址
+	2. gas价格
+	3. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_releaseAlias","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) ReleaseAlias(from crypto.CommonAddress, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx := types.NewReleaseAliasTransaction((*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	err := accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: leaseAlias
+ usage: 租用别名若干高度，地址已经租用该别名时为续租，费用按永久设置的价格以一年为单位折算
+ params:
+	1. 租用别名的地址
+	2. 别名
+	3. 租用的高度数
+	4. gas价格
+	5. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_leaseAlias","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","tom123",6220800,"0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x5adb248f2943e12fb91c140bd3d0df6237712061e9abae97345b0869c3daa749"}
+*/
+func (accountapi *AccountApi) LeaseAlias(from crypto.CommonAddress, alias string, period uint64, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx, err := types.NewLeaseAliasTransaction(alias, period, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
 /*
  name: VoteCredit
  usage: 投票
@@ -263,11 +380,10 @@ func (accountapi *AccountApi) SetAlias(srcAddr crypto.CommonAddress, alias strin
 func (accountapi *AccountApi) VoteCredit(from crypto.CommonAddress, to crypto.CommonAddress, amount, gasprice, gaslimit *common.Big) (string, error) {
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	tx := types.NewVoteTransaction(to, (*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return "", err
 	}
-	tx.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
 	if err != nil {
 		return "", err
@@ -293,11 +409,76 @@ func (accountapi *AccountApi) VoteCredit(from crypto.CommonAddress, to crypto.Co
 func (accountapi *AccountApi) CancelVoteCredit(from crypto.CommonAddress, to crypto.CommonAddress, amount, gasprice, gaslimit *common.Big) (string, error) {
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	tx := types.NewCancelVoteTransaction(to, (*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: redelegateCredit
+ usage: 把投给一个候选人的票直接转投给另一个候选人，不需要等待撤销周期，票保留原来的投票高度，两次转投至少间隔一个换届周期
+ params:
+	1. 发起转投的地址
+	2. 原来投票的候选人地址
+	3. 转投的候选人地址
+	4. 金额
+	5. gas价格
+	6. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_redelegateCredit","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x300fc5a14e578be28c64627c0e7e321771c58cd4","0xd05d5f324ada3c418e14cd6b497f2f36d60ba607","0x111","0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) RedelegateCredit(from crypto.CommonAddress, oldCandidate, newCandidate crypto.CommonAddress, amount, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx, err := types.NewRedelegateTransaction(oldCandidate, newCandidate, (*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: submitEvidence
+ usage: 举证出块节点双签，两条消息必须是被举证的候选人在同一高度、同一轮次签名的冲突的共识消息，验证通过后罚没投给该候选人的抵押并暂停其出块资格
+ params:
+	1. 举证者的地址
+	2. 被举证的候选人地址
+	3. 共识消息类型，0为Setup，1为Commitment
+	4. 第一条签名的共识消息
+	5. 第二条签名的共识消息
+	6. gas价格
+	7. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_submitEvidence","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x300fc5a14e578be28c64627c0e7e321771c58cd4",1,"0x0000000000000004b0fdfbfefe00...","0x0000000000000004b0fdfbfefe00...","0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) SubmitEvidence(from crypto.CommonAddress, accused crypto.CommonAddress, msgType uint64, first, second common.Bytes, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	evidence := &types.EvidenceData{MsgType: msgType, First: first, Second: second}
+	tx, err := types.NewEvidenceTransaction(accused, evidence, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return "", err
 	}
-	tx.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
 	if err != nil {
 		return "", err
@@ -332,11 +513,10 @@ func (accountapi *AccountApi) CandidateCredit(from crypto.CommonAddress, amount,
 
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	tx := types.NewCandidateTransaction((*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce, []byte(data))
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err = accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return "", err
 	}
-	tx.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
 	if err != nil {
 		return "", err
@@ -362,11 +542,162 @@ func (accountapi *AccountApi) CandidateCredit(from crypto.CommonAddress, amount,
 func (accountapi *AccountApi) CancelCandidateCredit(from crypto.CommonAddress, amount, gasprice, gaslimit *common.Big, data common.Bytes) (string, error) {
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	tx := types.NewCancleCandidateTransaction((*big.Int)(amount), (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: batchTransaction
+ usage: 批量交易，多个操作原子执行，全部成功或全部失败
+ params:
+	1. 发起交易的地址
+	2. 操作列表
+	3. gas价格
+	4. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_batchTransaction","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5",[{"Type":0,"To":"0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","Amount":"0x111","Data":"0x"},{"Type":4,"To":"0x300fc5a14e578be28c64627c0e7e321771c58cd4","Amount":"0x111","Data":"0x"}],"0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) BatchTransaction(from crypto.CommonAddress, operations []types.BatchOperation, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx, err := types.NewBatchTransaction(operations, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: registerMultiSig
+ usage: 注册M-of-N多签账户，多签账户发出的交易需要至少threshold个持有人对交易hash签名(account_sign)
+ params:
+	1. 支付注册费用的地址
+	2. 签名阈值
+	3. 持有人公钥列表
+	4. gas价格
+	5. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_registerMultiSig","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5",2,["0x03177b8e4ef31f4f801ce00260db1b04cc501287e828692a404fdbc46c7ad6ff26","0x02e5a3b1f0f9d6e3e8b1f6e6c55b5d5c3b9dbe3c6ba4db3c4bfa7a2d0a2c8f3b1a","0x0373c1d2ab4f0b2c1e6e5d5f3e9d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d9c"],"0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) RegisterMultiSig(from crypto.CommonAddress, threshold uint64, pubkeys []*secp256k1.PublicKey, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	account := &types.MultiSigAccount{Threshold: threshold, PubKeys: pubkeys}
+	tx, err := types.NewRegisterMultiSigTransaction(account, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: timeLockTransfer
+ usage: 锁定金额，到达指定高度和时间后自动转给接收方
+ params:
+	1. 发起交易的地址
+	2. 接收者的地址
+	3. 金额
+	4. 到期高度，0表示不限制
+	5. 到期时间(unix秒)，0表示不限制
+	6. 到期前是否可以撤销
+	7. gas价格
+	8. gas上限
+ return: 交易地址，锁定id由交易hash计算(types.TimeLockId)，也可以通过chain_getTimeLocks查询
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_timeLockTransfer","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x300fc5a14e578be28c64627c0e7e321771c58cd4","0x111",10000,0,true,"0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9"}
+*/
+func (accountapi *AccountApi) TimeLockTransfer(from crypto.CommonAddress, to crypto.CommonAddress, amount *common.Big, unlockHeight, unlockTime uint64, revocable bool, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	lock := &types.TimeLockData{UnlockHeight: unlockHeight, UnlockTime: unlockTime, Revocable: revocable}
+	tx, err := types.NewTimeLockTransaction(to, (*big.Int)(amount), lock, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: cancelTimeLock
+ usage: 到期前撤销可撤销的锁定，金额退回发送方
+ params:
+	1. 创建锁定的地址
+	2. 锁定id
+	3. gas价格
+	4. gas上限
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_cancelTimeLock","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x7d9dd32ca192e765ff2abd7c5f8931cc3f77f8f47d2d52170c7804c2ca2c5dd9","0x110","0x30000"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0x3a3b59f90a21c2fd1b690aa3a2bc06dc2d40eb5bdc26fdd7ecb7e1105af2638e"}
+*/
+func (accountapi *AccountApi) CancelTimeLock(from crypto.CommonAddress, lockId crypto.Hash, gasprice, gaslimit *common.Big) (string, error) {
+	nonce := accountapi.poolQuery.GetTransactionCount(&from)
+	tx := types.NewCancelTimeLockTransaction(lockId, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
+	err := accountapi.Wallet.SignTransaction(&from, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
+	if err != nil {
+		return "", err
+	}
+	return tx.TxHash().String(), nil
+}
+
+/*
+ name: sponsorTransaction
+ usage: 为发送方已签名的交易代付gas，发送方只需支付金额。发送方须在交易扩展(版本2)中指定代付地址后再签名，分叉高度前不可用
+ params:
+	1. 代付gas的地址
+	2. 发送方已签名的交易
+ return: 交易地址
+ example:   curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_sponsorTransaction","params":["0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","0x40a287b6d30b05313131317a4120dd8c23c40910d038fa43b2f8932d3681cbe5ee3079b6e9de0bea6e8e6b2a867a561aa26e1cd6b62aa0422a043186b593b784bf80845c3fd5a7fbfe62e61d8564"],"id":1}' http://127.0.0.1:15645
+ response:
+	 {"jsonrpc":"2.0","id":1,"result":"0xf30e858667fa63bc57ae395c3f57ede9bb3ad4969d12f4bce51d900fb5931538"}
+*/
+func (accountapi *AccountApi) SponsorTransaction(sponsor crypto.CommonAddress, rawTx common.Bytes) (string, error) {
+	tx := &types.Transaction{}
+	err := binary.Unmarshal(rawTx, tx)
+	if err != nil {
+		return "", err
+	}
+	err = accountapi.Wallet.SponsorTransaction(&sponsor, tx)
 	if err != nil {
 		return "", err
 	}
-	tx.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(tx, true)
 	if err != nil {
 		return "", err
@@ -381,22 +712,25 @@ func (accountapi *AccountApi) CancelCandidateCredit(from crypto.CommonAddress, a
     1. 发交易的账户地址
 	2. 合约地址
 	3. 合约接口
- return: 查询结果
+	4. 区块高度或区块hash（可选，默认为最新区块）
+ return: 在该区块状态上的查询结果，区块状态已被裁剪时返回错误
  example:
 	curl -H "Content-Type: application/json" -X post --data '{"jsonrpc":"2.0","method":"account_readContract","params":["0xec61c03f719a5c214f60719c3f36bb362a202125","0xecfb51e10aa4c146bf6c12eee090339c99841efc","0x6d4ce63c"],"id":1}' http://127.0.0.1:15645
  response:
 	 {"jsonrpc":"2.0","id":1,"result":""}
 */
-func (accountapi *AccountApi) ReadContract(from, to crypto.CommonAddress, input common.Bytes) (common.Bytes, error) {
-	header := accountapi.EvmService.Chain.GetCurrentHeader()
+func (accountapi *AccountApi) ReadContract(from, to crypto.CommonAddress, input common.Bytes, blockNrOrHash *types.BlockNumberOrHash) (common.Bytes, error) {
+	header, err := accountapi.EvmService.Chain.GetStateHeader(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
 	tx := types.NewTransaction(to, new(big.Int).SetUint64(0), &big.Int{}, new(big.Int).SetUint64(params.MinGasLimit), 0)
 	tx.Data.Data = input
 
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err = accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return nil, err
 	}
-	tx.Sig = sig
 
 	trieStore, err := store.TrieStoreFromStore(accountapi.databaseService.LevelDb(), header.StateRoot)
 	if err != nil {
@@ -434,11 +768,10 @@ func (accountapi *AccountApi) EstimateGas(from crypto.CommonAddress, amount *com
 	tx := types.NewTransaction(*to, amount.ToInt(), new(big.Int).SetUint64(blockmgr.DefaultGasPrice), new(big.Int).SetUint64(params.MinGasLimit), 0)
 	tx.Data.Data = data
 
-	sig, err := accountapi.Wallet.Sign(&from, tx.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, tx)
 	if err != nil {
 		return 0, err
 	}
-	tx.Sig = sig
 
 	trieStore, err := store.TrieStoreFromStore(accountapi.databaseService.LevelDb(), header.StateRoot)
 	if err != nil {
@@ -449,16 +782,16 @@ func (accountapi *AccountApi) EstimateGas(from crypto.CommonAddress, amount *com
 
 	gl := new(big.Int).SetUint64(params.MinGasLimit)
 	var (
-		fail bool
+		vmerr error
 	)
 
 	for {
-		_, _, _, fail, err = accountapi.EvmService.Eval(state, tx, header, gl.Uint64(), amount.ToInt())
-		if err != nil || fail {
-			if err == vm.ErrCodeStoreOutOfGas || err == vm.ErrOutOfGas {
+		_, _, _, vmerr, err = accountapi.EvmService.Eval(state, tx, header, gl.Uint64(), amount.ToInt())
+		if err != nil || vmerr != nil {
+			if vmerr == vm.ErrCodeStoreOutOfGas || vmerr == vm.ErrOutOfGas {
 				gl = gl.Add(gl, new(big.Int).SetUint64(1))
 			} else {
-				return 0, fmt.Errorf("err:%v or fail:%v", err, fail)
+				return 0, fmt.Errorf("err:%v or fail:%v", err, vmerr)
 			}
 		} else {
 			tx.Data.GasLimit = *(*common.Big)(gl)
@@ -487,11 +820,10 @@ func (accountapi *AccountApi) EstimateGas(from crypto.CommonAddress, amount *com
 func (accountapi *AccountApi) ExecuteContract(from crypto.CommonAddress, to crypto.CommonAddress, input common.Bytes, gasprice, gaslimit *common.Big) (string, error) {
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	t := types.NewCallContractTransaction(to, input, &big.Int{}, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, t.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, t)
 	if err != nil {
 		return "", err
 	}
-	t.Sig = sig
 	accountapi.messageBroadCastor.SendTransaction(t, true)
 	return t.TxHash().String(), nil
 }
@@ -514,11 +846,10 @@ func (accountapi *AccountApi) ExecuteContract(from crypto.CommonAddress, to cryp
 func (accountapi *AccountApi) CreateCode(from crypto.CommonAddress, byteCode common.Bytes, amount, gasprice, gaslimit *common.Big) (string, error) {
 	nonce := accountapi.poolQuery.GetTransactionCount(&from)
 	t := types.NewContractTransaction(byteCode, (*big.Int)(gasprice), (*big.Int)(gaslimit), nonce)
-	sig, err := accountapi.Wallet.Sign(&from, t.TxHash().Bytes())
+	err := accountapi.Wallet.SignTransaction(&from, t)
 	if err != nil {
 		return "", err
 	}
-	t.Sig = sig
 	err = accountapi.messageBroadCastor.SendTransaction(t, true)
 	if err != nil {
 		return "", err
@@ -168,6 +168,34 @@ func (wallet *Wallet) Sign(addr *crypto.CommonAddress, msg []byte) ([]byte, erro
 	return sig, nil
 }
 
+// SignTransaction bind transaction to the chain of wallet and sign it using key in wallet
+func (wallet *Wallet) SignTransaction(addr *crypto.CommonAddress, tx *types.Transaction) error {
+	tx.Data.ChainId = wallet.chainId
+	sig, err := wallet.Sign(addr, tx.TxHash().Bytes())
+	if err != nil {
+		return err
+	}
+	tx.Sig = sig
+	return nil
+}
+
+// SponsorTransaction sign the transaction signed by sender as its sponsor, the sponsor pay for gas.
+// The sender must have committed addr as sponsor before signing
+func (wallet *Wallet) SponsorTransaction(addr *crypto.CommonAddress, tx *types.Transaction) error {
+	committed := tx.CommittedSponsor()
+	if committed == nil {
+		return types.ErrSponsorNotCommitted
+	}
+	if *committed != *addr {
+		return types.ErrSponsorMismatch
+	}
+	sig, err := wallet.Sign(addr, tx.SponsorHash())
+	if err != nil {
+		return err
+	}
+	return tx.SetSponsor(sig)
+}
+
 // IsLock query current lock state  0 is locked  1 is unlock
 func (wallet *Wallet) IsLock() bool {
 	//return atomic.LoadInt32(&wallet.isLock) == LOCKED
@@ -1,7 +1,11 @@
 package bft
 
 import (
+	"github.com/drep-project/DREP-Chain/chain"
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/types"
 	"time"
 )
 
@@ -52,3 +56,62 @@ func (consensusApi *ConsensusApi) GetMiners() []*secp256k1.PublicKey {
 
 	return pk
 }
+
+/*
+	 name: getBlockRewards
+	 usage: 查询主链上某个高度的区块奖励分配，包括leader奖励、交易手续费、每个支持者分得的奖励
+	 params:
+		1.区块高度
+	 return: 奖励分配记录，Remainder是支持者按比例分配后除不尽给leader的部分，Burned是RewardRemainderForkHeight之前未发放的这部分
+	 example:
+		curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"consensus_getBlockRewards","params":[100], "id": 3}' -H "Content-Type:application/json"
+
+	response:
+		 {"jsonrpc":"2.0","id":3,"result":{"Height":100,"Leader":"0xad3dc2d8aedef155eabaf1ed1c2e7a1a9c5a3b2d","LeaderReward":"0x6f05b59d3b200000","GasFee":"0x5208","Remainder":"0x2","Burned":"0x0","Supporters":[{"Addr":"0x3ebcbe7cb440dd8c52940a2963472380afbb56c5","Credit":"0x3635c9adc5dea00000","Bonus":"0x1bc16d674ec80000"}]}}
+*/
+func (consensusApi *ConsensusApi) GetBlockRewards(height uint64) (*types.BlockReward, error) {
+	header, err := consensusApi.consensusService.ChainService.GetBlockHeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	chainStore := &chain.ChainStore{KeyValueStore: consensusApi.consensusService.DatabaseService.LevelDb()}
+	reward := chainStore.GetBlockReward(*header.Hash())
+	if reward == nil {
+		return nil, ErrNoBlockReward
+	}
+	return reward, nil
+}
+
+/*
+	 name: getSlashInfo
+	 usage: 查询候选人的惩罚记录，包括连续缺席签名的块数、已受理双签证据的高度、解禁高度、累计罚没的抵押
+	 params:
+		1.候选人地址
+	 return: 惩罚记录，Jailed表示当前是否被暂停出块资格
+	 example:
+		curl http://localhost:15645 -X POST --data '{"jsonrpc":"2.0","method":"consensus_getSlashInfo","params":["0xad3dc2d8aedef155eabaf1ed1c2e7a1a9c5a3b2d"], "id": 3}' -H "Content-Type:application/json"
+
+	response:
+		 {"jsonrpc":"2.0","id":3,"result":{"Slashed":"0x3635c9adc5dea00000","MissedBlocks":0,"EvidenceHeight":1200,"JailedUntil":18481,"Jailed":true}}
+*/
+func (consensusApi *ConsensusApi) GetSlashInfo(addr crypto.CommonAddress) (*SlashStatus, error) {
+	trieStore, err := store.TrieStoreFromStore(consensusApi.consensusService.DatabaseService.LevelDb(), consensusApi.consensusService.ChainService.BestChain().Tip().StateRoot)
+	if err != nil {
+		return nil, err
+	}
+	info, err := trieStore.GetSlashInfo(&addr)
+	if err != nil {
+		return nil, err
+	}
+	jailedAddrs, err := trieStore.GetJailedAddrs()
+	if err != nil {
+		return nil, err
+	}
+	status := &SlashStatus{SlashInfo: *info}
+	for _, jailed := range jailedAddrs {
+		if jailed == addr {
+			status.Jailed = true
+		}
+	}
+	return status, nil
+}
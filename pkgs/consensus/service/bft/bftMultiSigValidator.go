@@ -7,10 +7,13 @@ import (
 	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
 	"github.com/drep-project/DREP-Chain/crypto/secp256k1/schnorr"
 	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/types"
 	"github.com/drep-project/binary"
 )
 
+var _ = chain.IFinalityValidator((*BlockMultiSigValidator)(nil)) //compile check
+
 type GetProducers func(uint64, int) ([]Producer, error)
 type GetBlock func(hash *crypto.Hash) (*types.Block, error)
 
@@ -20,8 +23,8 @@ type BlockMultiSigValidator struct {
 	producerNum  int
 }
 
-func NewBlockMultiSigValidator(getProducers GetProducers, getBlock GetBlock, producerNum int) *BlockMultiSigValidator {
-	return &BlockMultiSigValidator{getProducers, getBlock, producerNum}
+func NewBlockMultiSigValidator(getProducers GetProducers, getBlock GetBlock, config *BftConfig) *BlockMultiSigValidator {
+	return &BlockMultiSigValidator{getProducers, getBlock, config.ProducerNum}
 }
 
 func (blockMultiSigValidator *BlockMultiSigValidator) VerifyHeader(header, parent *types.BlockHeader) error {
@@ -65,6 +68,29 @@ func (blockMultiSigValidator *BlockMultiSigValidator) VerifyBody(block *types.Bl
 	return nil
 }
 
+// IsFinal report whether the block is signed by at least 2/3 of the producers, the
+// same quorum as a round of consensus, such a block is never reverted. The multisig is
+// verified again, a block of a trusted import is connected without VerifyBody
+func (blockMultiSigValidator *BlockMultiSigValidator) IsFinal(block *types.Block) bool {
+	multiSig := &MultiSignature{}
+	err := binary.Unmarshal(block.Proof.Evidence, multiSig)
+	if err != nil {
+		return false
+	}
+	err = blockMultiSigValidator.VerifyBody(block)
+	if err != nil {
+		log.WithField("Height", block.Header.Height).WithField("Reason", err).Warn("block not final, multisig invalid")
+		return false
+	}
+	signers := 0
+	for _, val := range multiSig.Bitmap {
+		if val == 1 {
+			signers++
+		}
+	}
+	return signers >= minProducers(blockMultiSigValidator.producerNum)
+}
+
 func (blockMultiSigValidator *BlockMultiSigValidator) ExecuteBlock(context *chain.BlockExecuteContext) error {
 	multiSig := &MultiSignature{}
 	parentBlock, err := blockMultiSigValidator.getBlock(&context.Block.Header.PreviousHash)
@@ -80,5 +106,60 @@ func (blockMultiSigValidator *BlockMultiSigValidator) ExecuteBlock(context *chai
 		return nil
 	}
 	calculator := NewRewardCalculator(context.TrieStore, multiSig, producers, context.GasFee, context.Block.Header.Height)
-	return calculator.AccumulateRewards(context.Block.Header.Height)
+	err = calculator.AccumulateRewards(context.Block.Header.Height)
+	if err != nil {
+		return err
+	}
+	//分叉高度之前不惩罚，重放历史区块得到相同的状态
+	if params.IsSlashingFork(context.Block.Header.Height) {
+		err = blockMultiSigValidator.punishDowntime(context, multiSig, producers)
+		if err != nil {
+			return err
+		}
+		err = context.TrieStore.ReleaseJailed(context.Block.Header.Height)
+		if err != nil {
+			return err
+		}
+	}
+	//块验证通过后由chain保存，块离开主链时与收据一起删除
+	context.Reward = calculator.Reward()
+	return nil
+}
+
+//根据多签的Bitmap统计出块节点连续没有参与签名的块数，达到DowntimeBlocks的视为掉线并惩罚
+func (blockMultiSigValidator *BlockMultiSigValidator) punishDowntime(context *chain.BlockExecuteContext, multiSig *MultiSignature, producers []Producer) error {
+	if params.DowntimeBlocks == 0 {
+		return nil
+	}
+	height := context.Block.Header.Height
+	for index, producer := range producers {
+		if index >= len(multiSig.Bitmap) {
+			break
+		}
+		addr := producer.Address()
+		info, err := context.TrieStore.GetSlashInfo(&addr)
+		if err != nil {
+			return err
+		}
+		if multiSig.Bitmap[index] == 1 {
+			if info.MissedBlocks == 0 {
+				continue
+			}
+			info.MissedBlocks = 0
+		} else {
+			info.MissedBlocks++
+			if info.MissedBlocks >= params.DowntimeBlocks {
+				err = punish(context.TrieStore, &addr, params.DowntimeSlashRate, height)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		err = context.TrieStore.PutSlashInfo(&addr, info)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,50 @@
+package bft
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1/schnorr"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	consensusTypes "github.com/drep-project/DREP-Chain/pkgs/consensus/types"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+func TestIsFinal(t *testing.T) {
+	prv, _ := crypto.GenerateKey(rand.Reader)
+	parent := &types.Block{Header: &types.BlockHeader{Height: 1}}
+	getProducers := func(uint64, int) ([]Producer, error) { return []Producer{{Pubkey: prv.PubKey()}}, nil }
+	getBlock := func(hash *crypto.Hash) (*types.Block, error) { return parent, nil }
+	validator := NewBlockMultiSigValidator(getProducers, getBlock, &BftConfig{ProducerNum: 1})
+
+	block := &types.Block{
+		Header: &types.BlockHeader{Height: 2, PreviousHash: *parent.Header.Hash(), GasLimit: *big.NewInt(0), GasUsed: *big.NewInt(0)},
+		Data:   &types.BlockData{},
+	}
+	r, s, err := schnorr.Sign(prv, sha3.Keccak256(block.AsSignMessage()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	setProof := func(sig secp256k1.Signature) {
+		evidence, _ := binary.Marshal(&MultiSignature{Sig: sig, Bitmap: []byte{1}})
+		block.Proof = types.Proof{Type: consensusTypes.Pbft, Evidence: evidence}
+	}
+
+	setProof(secp256k1.Signature{R: r, S: s})
+	if err := validator.VerifyBody(block); err != nil {
+		t.Fatal(err)
+	}
+	if !validator.IsFinal(block) {
+		t.Fatal("block signed by all producers not final")
+	}
+
+	//bitmap满足法定人数，但签名无效
+	setProof(secp256k1.Signature{R: r, S: new(big.Int).Add(s, big.NewInt(1))})
+	if validator.IsFinal(block) {
+		t.Fatal("block with invalid multisig final")
+	}
+}
@@ -139,10 +139,7 @@ func (bftConsensus *BftConsensus) Run(privKey *secp256k1.PrivateKey) (*types.Blo
 		return nil, ErrNotMyTurn
 	}
 
-	minMiners := int(len(producers) * 2 / 3)
-	if len(producers)*2%3 != 0 {
-		minMiners++
-	}
+	minMiners := minProducers(len(producers))
 	miners := bftConsensus.collectMemberStatus(producers)
 	//print miners status
 	str := "-----------------------------------\n"
@@ -406,7 +403,7 @@ func (bftConsensus *BftConsensus) verifyBlockContent(block *types.Block) error {
 	if err != nil {
 		return err
 	}
-	multiSigValidator := BlockMultiSigValidator{bftConsensus.GetProducers, bftConsensus.ChainService.GetBlockByHash, bftConsensus.config.ProducerNum}
+	multiSigValidator := NewBlockMultiSigValidator(bftConsensus.GetProducers, bftConsensus.ChainService.GetBlockByHash, bftConsensus.config)
 	if err := multiSigValidator.VerifyBody(block); err != nil {
 		return err
 	}
@@ -527,3 +524,12 @@ func (bftConsensus *BftConsensus) prepareForMining(p2p p2pService.P2P) {
 		}
 	}
 }
+
+//一轮共识至少需要2/3的出块节点签名
+func minProducers(producerNum int) int {
+	minMiners := producerNum * 2 / 3
+	if producerNum*2%3 != 0 {
+		minMiners++
+	}
+	return minMiners
+}
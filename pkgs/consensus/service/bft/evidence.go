@@ -0,0 +1,200 @@
+package bft
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/drep-project/DREP-Chain/chain"
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+/**********************evidence********************/
+
+type EvidenceTxSelector struct {
+}
+
+func (evidenceTxSelector *EvidenceTxSelector) Select(tx *types.Transaction) bool {
+	return tx.Type() == types.EvidenceType
+}
+
+var (
+	_ = (chain.ITransactionSelector)((*EvidenceTxSelector)(nil))
+	_ = (chain.ITransactionValidator)((*EvidenceTransactionProcessor)(nil))
+)
+
+// EvidenceTransactionProcessor 验证交易携带的两条冲突的共识消息确实由被举证的候选人(交易的To)签名，
+// 罚没投给该候选人的抵押并暂停其出块资格，同一候选人在同一高度或更早高度的双签只惩罚一次
+// 分叉高度之前举证交易无效
+type EvidenceTransactionProcessor struct {
+}
+
+func NewEvidenceTransactionProcessor() *EvidenceTransactionProcessor {
+	return &EvidenceTransactionProcessor{}
+}
+
+func (processor *EvidenceTransactionProcessor) ExecuteTransaction(context *chain.ExecuteTransactionContext) *types.ExecuteTransactionResult {
+	etr := &types.ExecuteTransactionResult{}
+	from := context.From()
+	trieStore := context.TrieStore()
+	tx := context.Tx()
+	height := context.Header().Height
+	if !params.IsSlashingFork(height) {
+		etr.Txerror = ErrSlashingNotActive
+		return etr
+	}
+
+	payload, err := context.Payload()
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	signer, msgHeight, err := VerifyEvidence(payload.(*types.EvidenceData))
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	accused := tx.To()
+	candidate, err := getCandidatePubkey(trieStore, accused)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	if !candidate.IsEqual(signer) {
+		etr.Txerror = ErrEvidenceSigner
+		return etr
+	}
+
+	info, err := trieStore.GetSlashInfo(accused)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	if msgHeight >= height || (info.EvidenceHeight != 0 && msgHeight <= info.EvidenceHeight) {
+		etr.Txerror = ErrEvidenceHeight
+		return etr
+	}
+	info.EvidenceHeight = msgHeight
+	err = trieStore.PutSlashInfo(accused, info)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	err = punish(trieStore, accused, params.DoubleSignSlashRate, height)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+
+	err = trieStore.PutNonce(from, tx.Nonce()+1)
+	if err != nil {
+		etr.Txerror = err
+		return etr
+	}
+	return etr
+}
+
+// VerifyEvidence 验证两条共识消息是同一签名者在同一高度、同一轮次签出的不同内容，返回签名者和消息高度
+// Setup是leader对两个不同块的提议；Commitment的随机数由块哈希确定性生成，Q不同即承诺了两个不同的块
+func VerifyEvidence(evidence *types.EvidenceData) (*secp256k1.PublicKey, uint64, error) {
+	var height uint64
+	var hash1, hash2, sig1, sig2 []byte
+	switch evidence.MsgType {
+	case MsgTypeSetUp:
+		first, second := &Setup{}, &Setup{}
+		if err := binary.Unmarshal(evidence.First, first); err != nil {
+			return nil, 0, err
+		}
+		if err := binary.Unmarshal(evidence.Second, second); err != nil {
+			return nil, 0, err
+		}
+		if first.Magic != SetupMagic || second.Magic != SetupMagic {
+			return nil, 0, ErrEvidenceMsgType
+		}
+		if first.Height != second.Height || first.Round != second.Round || bytes.Equal(first.Msg, second.Msg) {
+			return nil, 0, ErrEvidenceNotConflict
+		}
+		height = first.Height
+		hash1, sig1 = first.SignHash(), first.Sig
+		hash2, sig2 = second.SignHash(), second.Sig
+	case MsgTypeCommitment:
+		first, second := &Commitment{}, &Commitment{}
+		if err := binary.Unmarshal(evidence.First, first); err != nil {
+			return nil, 0, err
+		}
+		if err := binary.Unmarshal(evidence.Second, second); err != nil {
+			return nil, 0, err
+		}
+		if first.Magic != CommitMagic || second.Magic != CommitMagic {
+			return nil, 0, ErrEvidenceMsgType
+		}
+		if first.BpKey == nil || second.BpKey == nil || first.Q == nil || second.Q == nil {
+			return nil, 0, ErrEvidenceNotConflict
+		}
+		if first.Height != second.Height || first.Round != second.Round || !first.BpKey.IsEqual(second.BpKey) || first.Q.IsEqual(second.Q) {
+			return nil, 0, ErrEvidenceNotConflict
+		}
+		height = first.Height
+		hash1, sig1 = first.SignHash(), first.Sig
+		hash2, sig2 = second.SignHash(), second.Sig
+	default:
+		return nil, 0, ErrEvidenceMsgType
+	}
+
+	signer1, err := crypto.SigToPub(hash1, sig1)
+	if err != nil {
+		return nil, 0, ErrSignatureNotValid
+	}
+	signer2, err := crypto.SigToPub(hash2, sig2)
+	if err != nil {
+		return nil, 0, ErrSignatureNotValid
+	}
+	if !signer1.IsEqual(signer2) {
+		return nil, 0, ErrEvidenceSigner
+	}
+	return signer1, height, nil
+}
+
+func getCandidatePubkey(trieStore store.StoreInterface, addr *crypto.CommonAddress) (*secp256k1.PublicKey, error) {
+	data, err := trieStore.GetCandidateData(addr)
+	if err != nil {
+		return nil, err
+	}
+	cd := &types.CandidateData{}
+	err = binary.Unmarshal(data, cd)
+	if err != nil {
+		return nil, err
+	}
+	if cd.Pubkey == nil {
+		return nil, ErrEvidenceSigner
+	}
+	return cd.Pubkey, nil
+}
+
+// 在height按千分之rate罚没投给addr的抵押，暂停出块资格JailPeriod个块
+func punish(trieStore store.StoreInterface, addr *crypto.CommonAddress, rate uint64, height uint64) error {
+	jailUntil := height + params.JailPeriod
+	slashed, err := trieStore.SlashCredit(addr, rate, height)
+	if err != nil {
+		return err
+	}
+	info, err := trieStore.GetSlashInfo(addr)
+	if err != nil {
+		return err
+	}
+	info.MissedBlocks = 0
+	info.Slashed = common.Big(*new(big.Int).Add(info.Slashed.ToInt(), slashed))
+	err = trieStore.PutSlashInfo(addr, info)
+	if err != nil {
+		return err
+	}
+	log.WithField("addr", addr.String()).WithField("slashed", slashed).WithField("jailUntil", jailUntil).Info("punish candidate")
+	return trieStore.JailCandidate(addr, jailUntil)
+}
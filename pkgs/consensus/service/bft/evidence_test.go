@@ -0,0 +1,221 @@
+package bft
+
+import (
+	"crypto/rand"
+	bin "encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/chain"
+	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/database/memorydb"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
+	"github.com/drep-project/binary"
+)
+
+func coins(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), new(big.Int).SetUint64(params.Coin))
+}
+
+//一个抵押2000000、另有支持者投票1000000的候选人，撤销的抵押100块后到期
+func newSlashTestStore(t *testing.T, candidate *secp256k1.PrivateKey, supporter crypto.CommonAddress) store.StoreInterface {
+	db := memorydb.New()
+	changeInterval := make([]byte, 8)
+	bin.BigEndian.PutUint64(changeInterval, 100)
+	db.Put([]byte(store.ChangeInterval), changeInterval)
+	trieStore, err := store.TrieStoreFromStore(db, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := crypto.PubkeyToAddress(candidate.PubKey())
+	data := &types.CandidateData{
+		Pubkey: candidate.PubKey(),
+		Node:   "enode://e77d64fecbb1c7e78231507fdd58c963cdc1e0ed0bec29b5a65de32b992d596f@149.129.172.91:44444",
+	}
+	if err := trieStore.CandidateCredit(&addr, coins(2000000), data, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := trieStore.VoteCredit(&supporter, &addr, coins(1000000), 1); err != nil {
+		t.Fatal(err)
+	}
+	return trieStore
+}
+
+func signedCommitment(t *testing.T, prv *secp256k1.PrivateKey, height uint64) []byte {
+	nonce, _ := crypto.GenerateKey(rand.Reader)
+	commitment := &Commitment{Height: height, Magic: CommitMagic, Round: 1, BpKey: prv.PubKey(), Q: nonce.PubKey()}
+	if err := commitment.Sign(prv); err != nil {
+		t.Fatal(err)
+	}
+	bytes, _ := binary.Marshal(commitment)
+	return bytes
+}
+
+func signedSetup(t *testing.T, prv *secp256k1.PrivateKey, height uint64, msg []byte) []byte {
+	setup := &Setup{Height: height, Magic: SetupMagic, Round: 1, Msg: msg}
+	if err := setup.Sign(prv); err != nil {
+		t.Fatal(err)
+	}
+	bytes, _ := binary.Marshal(setup)
+	return bytes
+}
+
+func TestVerifyEvidence(t *testing.T) {
+	prv, _ := crypto.GenerateKey(rand.Reader)
+	other, _ := crypto.GenerateKey(rand.Reader)
+
+	cases := []struct {
+		name     string
+		evidence *types.EvidenceData
+		err      error
+	}{
+		{"conflicting setups", &types.EvidenceData{MsgType: MsgTypeSetUp, First: signedSetup(t, prv, 10, []byte{1}), Second: signedSetup(t, prv, 10, []byte{2})}, nil},
+		{"conflicting commitments", &types.EvidenceData{MsgType: MsgTypeCommitment, First: signedCommitment(t, prv, 10), Second: signedCommitment(t, prv, 10)}, nil},
+		{"same setup", &types.EvidenceData{MsgType: MsgTypeSetUp, First: signedSetup(t, prv, 10, []byte{1}), Second: signedSetup(t, prv, 10, []byte{1})}, ErrEvidenceNotConflict},
+		{"different heights", &types.EvidenceData{MsgType: MsgTypeCommitment, First: signedCommitment(t, prv, 10), Second: signedCommitment(t, prv, 11)}, ErrEvidenceNotConflict},
+		{"different signers", &types.EvidenceData{MsgType: MsgTypeSetUp, First: signedSetup(t, prv, 10, []byte{1}), Second: signedSetup(t, other, 10, []byte{2})}, ErrEvidenceSigner},
+		{"wrong message type", &types.EvidenceData{MsgType: MsgTypeChallenge, First: signedSetup(t, prv, 10, []byte{1}), Second: signedSetup(t, prv, 10, []byte{2})}, ErrEvidenceMsgType},
+	}
+	for _, c := range cases {
+		signer, height, err := VerifyEvidence(c.evidence)
+		if err != c.err {
+			t.Fatalf("%s: have error %v, want %v", c.name, err, c.err)
+		}
+		if err == nil && (!signer.IsEqual(prv.PubKey()) || height != 10) {
+			t.Fatalf("%s: unexpected signer or height %d", c.name, height)
+		}
+	}
+
+	//签名之后内容被篡改，恢复出的签名者不同
+	setup := &Setup{}
+	binary.Unmarshal(signedSetup(t, prv, 10, []byte{1}), setup)
+	setup.Msg = []byte{3}
+	tampered, _ := binary.Marshal(setup)
+	_, _, err := VerifyEvidence(&types.EvidenceData{MsgType: MsgTypeSetUp, First: tampered, Second: signedSetup(t, prv, 10, []byte{2})})
+	if err != ErrEvidenceSigner {
+		t.Fatalf("tampered setup: have error %v, want %v", err, ErrEvidenceSigner)
+	}
+}
+
+func TestEvidenceTransaction(t *testing.T) {
+	prv, _ := crypto.GenerateKey(rand.Reader)
+	accused := crypto.PubkeyToAddress(prv.PubKey())
+	supporter, reporter := crypto.CommonAddress{1}, crypto.CommonAddress{2}
+	trieStore := newSlashTestStore(t, prv, supporter)
+	processor := NewEvidenceTransactionProcessor()
+	defer func(height, rate, period uint64) {
+		params.SlashingForkHeight, params.DoubleSignSlashRate, params.JailPeriod = height, rate, period
+	}(params.SlashingForkHeight, params.DoubleSignSlashRate, params.JailPeriod)
+	params.SlashingForkHeight, params.DoubleSignSlashRate, params.JailPeriod = 10, 50, 100
+
+	execute := func(height uint64, evidence *types.EvidenceData) error {
+		tx, err := types.NewEvidenceTransaction(accused, evidence, big.NewInt(1), big.NewInt(30000), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gp := new(chain.GasPool).AddGas(30000)
+		block := &types.Block{Header: &types.BlockHeader{Height: height}}
+		context := chain.NewExecuteTransactionContext(chain.NewBlockExecuteContext(trieStore, gp, nil, block), trieStore, gp, &reporter, tx)
+		return processor.ExecuteTransaction(context).Txerror
+	}
+
+	//分叉高度之前不受理举证
+	evidence := &types.EvidenceData{MsgType: MsgTypeCommitment, First: signedCommitment(t, prv, 8), Second: signedCommitment(t, prv, 8)}
+	if err := execute(9, evidence); err != ErrSlashingNotActive {
+		t.Fatalf("evidence before fork: have error %v, want %v", err, ErrSlashingNotActive)
+	}
+
+	//支持者在分叉前后各撤销一笔，分叉后撤销、尚未到期的一笔一起罚没
+	if _, err := trieStore.CancelVoteCredit(&supporter, &accused, coins(100000), 5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := trieStore.CancelVoteCredit(&supporter, &accused, coins(400000), 10); err != nil {
+		t.Fatal(err)
+	}
+
+	//证据的高度必须低于当前块
+	evidence = &types.EvidenceData{MsgType: MsgTypeCommitment, First: signedCommitment(t, prv, 20), Second: signedCommitment(t, prv, 20)}
+	if err := execute(20, evidence); err != ErrEvidenceHeight {
+		t.Fatalf("evidence of current height: have error %v, want %v", err, ErrEvidenceHeight)
+	}
+	//签名者必须是被举证的候选人
+	other, _ := crypto.GenerateKey(rand.Reader)
+	if err := execute(21, &types.EvidenceData{MsgType: MsgTypeCommitment, First: signedCommitment(t, other, 20), Second: signedCommitment(t, other, 20)}); err != ErrEvidenceSigner {
+		t.Fatalf("evidence of other signer: have error %v, want %v", err, ErrEvidenceSigner)
+	}
+
+	if err := execute(21, evidence); err != nil {
+		t.Fatal(err)
+	}
+	details := trieStore.GetCreditDetails(&accused)
+	pledge, vote := details[accused], details[supporter]
+	if pledge.Cmp(coins(1900000)) != 0 || vote.Cmp(coins(475000)) != 0 {
+		t.Fatalf("slashed credit: have pledge %v vote %v", &pledge, &vote)
+	}
+	unbonding, _ := trieStore.GetUnbondingDetails(&supporter, 21)
+	if len(unbonding) != 2 || unbonding[0].Amount.ToInt().Cmp(coins(100000)) != 0 || unbonding[1].Amount.ToInt().Cmp(coins(380000)) != 0 {
+		t.Fatalf("slashed unbonding credit: have %v", unbonding)
+	}
+	info, _ := trieStore.GetSlashInfo(&accused)
+	if info.EvidenceHeight != 20 || info.JailedUntil != 121 || info.Slashed.ToInt().Cmp(coins(145000)) != 0 {
+		t.Fatalf("unexpected slash info %+v", info)
+	}
+	if producers := GetCandidates(trieStore, 7); len(producers) != 0 {
+		t.Fatalf("jailed candidate elected")
+	}
+
+	//同一高度或更早高度的双签只惩罚一次
+	if err := execute(22, &types.EvidenceData{MsgType: MsgTypeCommitment, First: signedCommitment(t, prv, 20), Second: signedCommitment(t, prv, 20)}); err != ErrEvidenceHeight {
+		t.Fatalf("punished evidence: have error %v, want %v", err, ErrEvidenceHeight)
+	}
+
+	trieStore.ReleaseJailed(120)
+	if producers := GetCandidates(trieStore, 7); len(producers) != 0 {
+		t.Fatalf("candidate released before jail period")
+	}
+	trieStore.ReleaseJailed(121)
+	if producers := GetCandidates(trieStore, 7); len(producers) != 1 || !producers[0].Pubkey.IsEqual(prv.PubKey()) {
+		t.Fatalf("candidate not released after jail period")
+	}
+}
+
+func TestPunishDowntime(t *testing.T) {
+	prv, _ := crypto.GenerateKey(rand.Reader)
+	addr := crypto.PubkeyToAddress(prv.PubKey())
+	trieStore := newSlashTestStore(t, prv, crypto.CommonAddress{1})
+	validator := NewBlockMultiSigValidator(nil, nil, &BftConfig{ProducerNum: 1})
+	defer func(rate, blocks, period uint64) {
+		params.DowntimeSlashRate, params.DowntimeBlocks, params.JailPeriod = rate, blocks, period
+	}(params.DowntimeSlashRate, params.DowntimeBlocks, params.JailPeriod)
+	params.DowntimeSlashRate, params.DowntimeBlocks, params.JailPeriod = 10, 3, 100
+	producers := []Producer{{Pubkey: prv.PubKey()}}
+
+	//bitmap依次为缺席、缺席、签名、缺席、缺席、缺席，第二次连续缺席3块时惩罚
+	for i, signed := range []byte{0, 0, 1, 0, 0, 0} {
+		height := uint64(i + 1)
+		context := chain.NewBlockExecuteContext(trieStore, nil, nil, &types.Block{Header: &types.BlockHeader{Height: height}})
+		if err := validator.punishDowntime(context, &MultiSignature{Bitmap: []byte{signed}}, producers); err != nil {
+			t.Fatal(err)
+		}
+		info, _ := trieStore.GetSlashInfo(&addr)
+		if height < 6 && info.JailedUntil != 0 {
+			t.Fatalf("punished at height %d", height)
+		}
+	}
+
+	info, _ := trieStore.GetSlashInfo(&addr)
+	if info.JailedUntil != 106 || info.MissedBlocks != 0 || info.Slashed.ToInt().Cmp(coins(30000)) != 0 {
+		t.Fatalf("unexpected slash info %+v", info)
+	}
+	if total := trieStore.GetVoteCreditCount(&addr); total.Cmp(coins(2970000)) != 0 {
+		t.Fatalf("credit after slash: have %v", total)
+	}
+	jailed, _ := trieStore.GetJailedAddrs()
+	if len(jailed) != 1 || jailed[0] != addr {
+		t.Fatalf("jailed addrs: have %v", jailed)
+	}
+}
+
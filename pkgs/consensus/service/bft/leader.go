@@ -199,6 +199,10 @@ func (leader *Leader) setUp(msg IConsenMsg, round int) {
 		return
 	}
 
+	if err := setup.Sign(leader.privakey); err != nil {
+		log.WithField("err", err).Error("sign setup message")
+	}
+
 	for _, member := range leader.liveMembers {
 		if member.Peer != nil && !member.IsMe {
 			log.WithField("Node", member.Peer.IP()).WithField("Height", setup.Height).WithField("size", len(setup.Msg)).Trace("leader sent setup message")
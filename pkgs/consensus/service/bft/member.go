@@ -296,6 +296,9 @@ func (member *Member) commit(round int) {
 		Q:     (*secp256k1.PublicKey)(nouncePk),
 	}
 	commitment.Height = member.currentHeight
+	if err := commitment.Sign(member.prvKey); err != nil {
+		log.WithField("err", err).Error("sign commitment message")
+	}
 	member.p2pServer.SendAsync(member.leader.Peer.GetMsgRW(), MsgTypeCommitment, commitment)
 }
 
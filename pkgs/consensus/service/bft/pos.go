@@ -41,9 +41,23 @@ func GetCandidates(store store.StoreInterface, topN int) []Producer {
 		return nil
 	}
 
+	//被惩罚暂停出块资格的候选人不参与选举
+	jailedAddrs, err := store.GetJailedAddrs()
+	if err != nil {
+		log.Errorf("get jailed candidates err:%v", err)
+		return nil
+	}
+	jailed := make(map[crypto.CommonAddress]struct{}, len(jailedAddrs))
+	for _, addr := range jailedAddrs {
+		jailed[addr] = struct{}{}
+	}
+
 	//key for credit; value for addrs slice
 	mapAddrs := make(map[string][]string)
 	for _, addr := range candidateAddrs {
+		if _, ok := jailed[addr]; ok {
+			continue
+		}
 		addrStr := addr.String()
 		totalCredit := store.GetVoteCreditCount(&addr).String()
 		if addrs, ok := mapAddrs[totalCredit]; ok {
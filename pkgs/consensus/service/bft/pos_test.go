@@ -23,6 +23,42 @@ func (s StoreFake) GetCreditDetails(addr *crypto.CommonAddress) map[crypto.Commo
 	panic("implement me")
 }
 
+func (s StoreFake) RedelegateCredit(fromAddr, oldAddr, newAddr *crypto.CommonAddress, amount *big.Int, height uint64) ([]types.HeightValue, error) {
+	panic("implement me")
+}
+
+func (s StoreFake) GetVoteDetails(addr *crypto.CommonAddress, voted []crypto.CommonAddress, height uint64) ([]*types.VoteDetail, error) {
+	panic("implement me")
+}
+
+func (s StoreFake) GetUnbondingDetails(addr *crypto.CommonAddress, height uint64) ([]*types.UnbondingDetail, error) {
+	panic("implement me")
+}
+
+func (s StoreFake) GetSlashInfo(addr *crypto.CommonAddress) (*types.SlashInfo, error) {
+	panic("implement me")
+}
+
+func (s StoreFake) PutSlashInfo(addr *crypto.CommonAddress, info *types.SlashInfo) error {
+	panic("implement me")
+}
+
+func (s StoreFake) SlashCredit(addr *crypto.CommonAddress, rate uint64, height uint64) (*big.Int, error) {
+	panic("implement me")
+}
+
+func (s StoreFake) JailCandidate(addr *crypto.CommonAddress, until uint64) error {
+	panic("implement me")
+}
+
+func (s StoreFake) GetJailedAddrs() ([]crypto.CommonAddress, error) {
+	return nil, nil
+}
+
+func (s StoreFake) ReleaseJailed(height uint64) error {
+	panic("implement me")
+}
+
 func (s StoreFake) Commit() {
 	panic("implement me")
 }
@@ -47,7 +83,7 @@ func (s StoreFake) VoteCredit(addresses *crypto.CommonAddress, to *crypto.Common
 	panic("implement me")
 }
 
-func (s StoreFake) CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data []byte, height uint64) error {
+func (s StoreFake) CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data *types.CandidateData, height uint64) error {
 	panic("implement me")
 }
 
@@ -121,6 +157,74 @@ func (StoreFake) PutByteCode(addr *crypto.CommonAddress, byteCode []byte) error
 	panic("implement me")
 }
 
+func (StoreFake) GetContractState(addr *crypto.CommonAddress, key []byte) ([]byte, error) {
+	panic("implement me")
+}
+
+func (StoreFake) PutContractState(addr *crypto.CommonAddress, key []byte, value []byte) error {
+	panic("implement me")
+}
+
+func (StoreFake) GetMultiSigAccount(addr *crypto.CommonAddress) *types.MultiSigAccount {
+	panic("implement me")
+}
+
+func (StoreFake) PutMultiSigAccount(addr *crypto.CommonAddress, account *types.MultiSigAccount) error {
+	panic("implement me")
+}
+
+func (StoreFake) GetTimeLock(id *crypto.Hash) (*types.TimeLock, error) {
+	panic("implement me")
+}
+
+func (StoreFake) GetTimeLockIds(addr *crypto.CommonAddress) ([]crypto.Hash, error) {
+	panic("implement me")
+}
+
+func (StoreFake) PutTimeLock(lock *types.TimeLock, height uint64) error {
+	panic("implement me")
+}
+
+func (StoreFake) DelTimeLock(id *crypto.Hash) error {
+	panic("implement me")
+}
+
+func (StoreFake) PopMaturedTimeLocks(height uint64, timestamp uint64) ([]*types.TimeLock, error) {
+	panic("implement me")
+}
+
+func (StoreFake) AliasDelete(alias string) error {
+	panic("implement me")
+}
+
+func (StoreFake) AliasTransfer(from, to *crypto.CommonAddress, alias string) error {
+	panic("implement me")
+}
+
+func (StoreFake) GetAliasLease(alias string) (*types.AliasLease, error) {
+	panic("implement me")
+}
+
+func (StoreFake) PopExpiredAliasLeases(height uint64) ([]*types.AliasLease, error) {
+	panic("implement me")
+}
+
+func (StoreFake) PutAliasLease(lease *types.AliasLease) error {
+	panic("implement me")
+}
+
+func (StoreFake) DelAliasLease(alias string) error {
+	panic("implement me")
+}
+
+func (StoreFake) GetAliasHistory(alias string) ([]*types.AliasRecord, error) {
+	panic("implement me")
+}
+
+func (StoreFake) AddAliasHistory(record *types.AliasRecord) error {
+	panic("implement me")
+}
+
 func (StoreFake) GetReputation(addr *crypto.CommonAddress) *big.Int {
 	panic("implement me")
 }
@@ -1,10 +1,15 @@
 package bft
 
 import (
+	"bytes"
 	"github.com/drep-project/DREP-Chain/chain/store"
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/DREP-Chain/types"
 	"math"
 	"math/big"
+	"sort"
 )
 
 type IRewardCalculator interface {
@@ -17,6 +22,7 @@ type RewardCalculator struct {
 	sig             *MultiSignature
 	producers       ProducerSet
 	totalGasBalance *big.Int
+	reward          *types.BlockReward
 }
 
 func NewRewardCalculator(trieStore store.StoreInterface, sig *MultiSignature, producers ProducerSet, totalGasBalance *big.Int, height uint64) *RewardCalculator {
@@ -29,7 +35,10 @@ func NewRewardCalculator(trieStore store.StoreInterface, sig *MultiSignature, pr
 	}
 }
 
-// AccumulateRewards credits,The leader gets half of the reward and other ,Other participants get the average of the other half
+// AccumulateRewards credits,The leader gets 80% of the reward and the gas fee, the supporters of the leader
+// share the other 20% in proportion to their credit. Supporters are paid in the order of their address. After
+// RewardRemainderForkHeight the remainder of the integer division, or the whole 20% without supporters, goes to
+// the leader, before it is burned
 func (calculator *RewardCalculator) AccumulateRewards(height uint64) error {
 	reward := big.NewInt(params.Rewards)
 	reward.Mul(reward, new(big.Int).SetUint64(params.Coin))
@@ -43,12 +52,7 @@ func (calculator *RewardCalculator) AccumulateRewards(height uint64) error {
 	leaderReward := new(big.Int)
 	leaderReward = leaderReward.Mul(reward, new(big.Int).SetInt64(selfProportion))
 	leaderReward = leaderReward.Div(leaderReward, new(big.Int).SetInt64(100))
-	leaderReward.Add(leaderReward, calculator.totalGasBalance)
 	leaderAddr := calculator.producers[calculator.sig.Leader].Address()
-	err := calculator.trieStore.AddBalance(&leaderAddr, calculator.height, leaderReward)
-	if err != nil {
-		return err
-	}
 
 	//Reward supporters in proportion
 	//Distribute Bonus
@@ -56,39 +60,63 @@ func (calculator *RewardCalculator) AccumulateRewards(height uint64) error {
 	otherReward = otherReward.Mul(reward, new(big.Int).SetInt64(100-selfProportion))
 	otherReward = otherReward.Div(otherReward, new(big.Int).SetInt64(100))
 
-	//fmt.Println(leaderReward.String(), otherReward.String())
+	record := &types.BlockReward{
+		Height:       calculator.height,
+		Leader:       leaderAddr,
+		LeaderReward: common.Big(*leaderReward),
+		GasFee:       common.Big(*calculator.totalGasBalance),
+	}
 	total := new(big.Int)
 	supporters := calculator.trieStore.GetCreditDetails(&leaderAddr)
-
-	for _, v := range supporters {
+	addrs := make([]crypto.CommonAddress, 0, len(supporters))
+	for addr, v := range supporters {
+		v := v
 		total = total.Add(total, &v)
+		addrs = append(addrs, addr)
 	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
 
-	for spporterAddr, supportCredit := range supporters {
-		bonus := new(big.Int).Set(otherReward)
-		bonus = bonus.Mul(bonus, &supportCredit)
-		bonus = bonus.Div(bonus, total)
-
-		//fmt.Println(bonus, supportCredit.String())
+	remainder := new(big.Int).Set(otherReward)
+	if total.Sign() > 0 {
+		for _, spporterAddr := range addrs {
+			spporterAddr := spporterAddr
+			supportCredit := supporters[spporterAddr]
+			bonus := new(big.Int).Set(otherReward)
+			bonus = bonus.Mul(bonus, &supportCredit)
+			bonus = bonus.Div(bonus, total)
 
-		err := calculator.trieStore.AddBalance(&spporterAddr, calculator.height, bonus)
-		if err != nil {
-			return err
+			err := calculator.trieStore.AddBalance(&spporterAddr, calculator.height, bonus)
+			if err != nil {
+				return err
+			}
+			remainder.Sub(remainder, bonus)
+			record.Supporters = append(record.Supporters, types.SupporterReward{
+				Addr:   spporterAddr,
+				Credit: common.Big(supportCredit),
+				Bonus:  common.Big(*bonus),
+			})
 		}
 	}
 
-	//num := calculator.sig.Num() - 1
-	//for index, isCommit := range calculator.sig.Bitmap {
-	//	if isCommit == 1 {
-	//		addr := calculator.producers[index].Address()
-	//		if addr != leaderAddr {
-	//			r.Div(reward, new(big.Int).SetInt64(int64(num*2)))
-	//			err = calculator.trieStore.AddBalance(&addr, calculator.height, r)
-	//			if err != nil {
-	//				return err
-	//			}
-	//		}
-	//	}
-	//}
+	leaderTotal := new(big.Int).Add(leaderReward, calculator.totalGasBalance)
+	//分叉前除不尽的部分和没有支持者时的2成不发放
+	if params.IsRewardRemainderFork(calculator.height) {
+		leaderTotal.Add(leaderTotal, remainder)
+		record.Remainder = common.Big(*remainder)
+	} else {
+		record.Burned = common.Big(*remainder)
+	}
+	err := calculator.trieStore.AddBalance(&leaderAddr, calculator.height, leaderTotal)
+	if err != nil {
+		return err
+	}
+	calculator.reward = record
 	return nil
 }
+
+// Reward return how the reward of the block is distributed, it is nil before AccumulateRewards
+func (calculator *RewardCalculator) Reward() *types.BlockReward {
+	return calculator.reward
+}
@@ -1,12 +1,15 @@
 package bft
 
 import (
+	"bytes"
 	"crypto/rand"
 	"github.com/drep-project/DREP-Chain/common/trie"
 	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/database"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/types"
 	"math/big"
+	"reflect"
 	"testing"
 )
 
@@ -70,6 +73,74 @@ func (fakeStore) PutByteCode(addr *crypto.CommonAddress, byteCode []byte) error
 	panic("implement me")
 }
 
+func (fakeStore) GetContractState(addr *crypto.CommonAddress, key []byte) ([]byte, error) {
+	panic("implement me")
+}
+
+func (fakeStore) PutContractState(addr *crypto.CommonAddress, key []byte, value []byte) error {
+	panic("implement me")
+}
+
+func (fakeStore) GetMultiSigAccount(addr *crypto.CommonAddress) *types.MultiSigAccount {
+	panic("implement me")
+}
+
+func (fakeStore) PutMultiSigAccount(addr *crypto.CommonAddress, account *types.MultiSigAccount) error {
+	panic("implement me")
+}
+
+func (fakeStore) GetTimeLock(id *crypto.Hash) (*types.TimeLock, error) {
+	panic("implement me")
+}
+
+func (fakeStore) GetTimeLockIds(addr *crypto.CommonAddress) ([]crypto.Hash, error) {
+	panic("implement me")
+}
+
+func (fakeStore) PutTimeLock(lock *types.TimeLock, height uint64) error {
+	panic("implement me")
+}
+
+func (fakeStore) DelTimeLock(id *crypto.Hash) error {
+	panic("implement me")
+}
+
+func (fakeStore) PopMaturedTimeLocks(height uint64, timestamp uint64) ([]*types.TimeLock, error) {
+	panic("implement me")
+}
+
+func (fakeStore) AliasDelete(alias string) error {
+	panic("implement me")
+}
+
+func (fakeStore) AliasTransfer(from, to *crypto.CommonAddress, alias string) error {
+	panic("implement me")
+}
+
+func (fakeStore) GetAliasLease(alias string) (*types.AliasLease, error) {
+	panic("implement me")
+}
+
+func (fakeStore) PopExpiredAliasLeases(height uint64) ([]*types.AliasLease, error) {
+	panic("implement me")
+}
+
+func (fakeStore) PutAliasLease(lease *types.AliasLease) error {
+	panic("implement me")
+}
+
+func (fakeStore) DelAliasLease(alias string) error {
+	panic("implement me")
+}
+
+func (fakeStore) GetAliasHistory(alias string) ([]*types.AliasRecord, error) {
+	panic("implement me")
+}
+
+func (fakeStore) AddAliasHistory(record *types.AliasRecord) error {
+	panic("implement me")
+}
+
 func (fakeStore) GetReputation(addr *crypto.CommonAddress) *big.Int {
 	panic("implement me")
 }
@@ -130,7 +201,7 @@ func (fakeStore) VoteCredit(addresses *crypto.CommonAddress, to *crypto.CommonAd
 	panic("implement me")
 }
 
-func (fakeStore) CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data []byte, height uint64) error {
+func (fakeStore) CandidateCredit(addresses *crypto.CommonAddress, addBalance *big.Int, data *types.CandidateData, height uint64) error {
 	panic("implement me")
 }
 
@@ -146,6 +217,42 @@ func (fakeStore) AddCandidateAddr(addr *crypto.CommonAddress) error {
 	panic("implement me")
 }
 
+func (fakeStore) RedelegateCredit(fromAddr, oldAddr, newAddr *crypto.CommonAddress, amount *big.Int, height uint64) ([]types.HeightValue, error) {
+	panic("implement me")
+}
+
+func (fakeStore) GetVoteDetails(addr *crypto.CommonAddress, voted []crypto.CommonAddress, height uint64) ([]*types.VoteDetail, error) {
+	panic("implement me")
+}
+
+func (fakeStore) GetUnbondingDetails(addr *crypto.CommonAddress, height uint64) ([]*types.UnbondingDetail, error) {
+	panic("implement me")
+}
+
+func (fakeStore) GetSlashInfo(addr *crypto.CommonAddress) (*types.SlashInfo, error) {
+	panic("implement me")
+}
+
+func (fakeStore) PutSlashInfo(addr *crypto.CommonAddress, info *types.SlashInfo) error {
+	panic("implement me")
+}
+
+func (fakeStore) SlashCredit(addr *crypto.CommonAddress, rate uint64, height uint64) (*big.Int, error) {
+	panic("implement me")
+}
+
+func (fakeStore) JailCandidate(addr *crypto.CommonAddress, until uint64) error {
+	panic("implement me")
+}
+
+func (fakeStore) GetJailedAddrs() ([]crypto.CommonAddress, error) {
+	panic("implement me")
+}
+
+func (fakeStore) ReleaseJailed(height uint64) error {
+	panic("implement me")
+}
+
 func (fakeStore) GetCreditDetails(addr *crypto.CommonAddress) map[crypto.CommonAddress]big.Int {
 	m := make(map[crypto.CommonAddress]big.Int)
 
@@ -173,3 +280,97 @@ func TestRewardCalculator_AccumulateRewards(t *testing.T) {
 		panic("reward errrrrrrrrr")
 	}
 }
+
+//rewardStore record the balances added by the reward calculator
+type rewardStore struct {
+	fakeStore
+	credits  map[crypto.CommonAddress]big.Int
+	balances map[crypto.CommonAddress]*big.Int
+	order    []crypto.CommonAddress
+}
+
+func (s *rewardStore) AddBalance(addr *crypto.CommonAddress, height uint64, amount *big.Int) error {
+	if _, ok := s.balances[*addr]; !ok {
+		s.balances[*addr] = new(big.Int)
+	}
+	s.balances[*addr].Add(s.balances[*addr], amount)
+	s.order = append(s.order, *addr)
+	return nil
+}
+
+func (s *rewardStore) GetCreditDetails(addr *crypto.CommonAddress) map[crypto.CommonAddress]big.Int {
+	return s.credits
+}
+
+func TestRewardCalculator_Distribution(t *testing.T) {
+	pk, _ := crypto.GenerateKey(rand.Reader)
+	ps := ProducerSet{Producer{Pubkey: pk.PubKey()}}
+	credits := make(map[crypto.CommonAddress]big.Int)
+	for i := 1; i < 8; i++ {
+		credits[crypto.CommonAddress{byte(i * 37)}] = *new(big.Int).SetInt64(int64(i*10 + 3))
+	}
+	gasFee := big.NewInt(21000)
+	reward := new(big.Int).Mul(big.NewInt(params.Rewards), new(big.Int).SetUint64(params.Coin))
+	defer func(height uint64) { params.RewardRemainderForkHeight = height }(params.RewardRemainderForkHeight)
+
+	distribute := func(credits map[crypto.CommonAddress]big.Int) (*rewardStore, *types.BlockReward) {
+		s := &rewardStore{credits: credits, balances: make(map[crypto.CommonAddress]*big.Int)}
+		calculator := NewRewardCalculator(s, &MultiSignature{}, ps, gasFee, 100)
+		if err := calculator.AccumulateRewards(100); err != nil {
+			t.Fatal(err)
+		}
+		record := calculator.Reward()
+		if len(record.Supporters) != len(credits) {
+			t.Fatalf("have %d supporters, want %d", len(record.Supporters), len(credits))
+		}
+
+		//the supporters get their bonus, the leader the rest except the part burned
+		paid := new(big.Int)
+		for j, supporter := range record.Supporters {
+			if j > 0 && bytes.Compare(record.Supporters[j-1].Addr[:], supporter.Addr[:]) >= 0 {
+				t.Fatal("supporters not sorted by address")
+			}
+			if s.balances[supporter.Addr].Cmp(supporter.Bonus.ToInt()) != 0 {
+				t.Fatalf("supporter %s paid %v, recorded %v", supporter.Addr.String(), s.balances[supporter.Addr], supporter.Bonus.ToInt())
+			}
+			paid.Add(paid, supporter.Bonus.ToInt())
+		}
+		leader := new(big.Int).Add(record.LeaderReward.ToInt(), record.GasFee.ToInt())
+		leader.Add(leader, record.Remainder.ToInt())
+		if s.balances[record.Leader].Cmp(leader) != 0 {
+			t.Fatalf("leader paid %v, want %v", s.balances[record.Leader], leader)
+		}
+		paid.Add(paid, leader)
+		paid.Add(paid, record.Burned.ToInt())
+		if want := new(big.Int).Add(reward, gasFee); paid.Cmp(want) != 0 {
+			t.Fatalf("distributed %v, want %v", paid, want)
+		}
+		return s, record
+	}
+
+	//before the fork the part not distributed to supporters is burned
+	params.RewardRemainderForkHeight = 101
+	if _, record := distribute(credits); record.Remainder.ToInt().Sign() != 0 || record.Burned.ToInt().Sign() <= 0 {
+		t.Fatalf("remainder %v, burned %v before the fork", record.Remainder.ToInt(), record.Burned.ToInt())
+	}
+	if _, record := distribute(nil); record.Burned.ToInt().Cmp(new(big.Int).Div(reward, big.NewInt(5))) != 0 {
+		t.Fatalf("burned %v without supporters before the fork", record.Burned.ToInt())
+	}
+
+	params.RewardRemainderForkHeight = 100
+	var first *rewardStore
+	for i := 0; i < 5; i++ {
+		s, record := distribute(credits)
+		if record.Remainder.ToInt().Sign() <= 0 || record.Burned.ToInt().Sign() != 0 {
+			t.Fatalf("remainder %v, burned %v after the fork", record.Remainder.ToInt(), record.Burned.ToInt())
+		}
+		if first == nil {
+			first = s
+		} else if !reflect.DeepEqual(first.order, s.order) {
+			t.Fatal("rewards paid in a different order")
+		}
+	}
+	if _, record := distribute(nil); record.Remainder.ToInt().Cmp(new(big.Int).Div(reward, big.NewInt(5))) != 0 {
+		t.Fatalf("leader paid remainder %v without supporters after the fork", record.Remainder.ToInt())
+	}
+}
@@ -80,7 +80,8 @@ func (bftConsensusService *BftConsensusService) Init(executeContext *app.Execute
 		&removePeerFeed,
 	)
 
-	bftConsensusService.ChainService.AddBlockValidator(&BlockMultiSigValidator{bftConsensusService.BftConsensus.GetProducers, bftConsensusService.ChainService.GetBlockByHash, bftConsensusService.Config.ProducerNum})
+	bftConsensusService.ChainService.AddBlockValidator(NewBlockMultiSigValidator(bftConsensusService.BftConsensus.GetProducers, bftConsensusService.ChainService.GetBlockByHash, bftConsensusService.Config))
+	bftConsensusService.ChainService.AddTransactionValidator(&EvidenceTxSelector{}, NewEvidenceTransactionProcessor())
 	bftConsensusService.ChainService.AddGenesisProcess(NewMinerGenesisProcessor())
 	//if !bftConsensusService.Config.StartMiner {
 	//	return nil
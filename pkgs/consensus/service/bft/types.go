@@ -4,6 +4,7 @@ import (
 	"github.com/drep-project/binary"
 	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
 	"github.com/drep-project/DREP-Chain/network/p2p"
+	"github.com/drep-project/DREP-Chain/types"
 )
 
 type Sender interface {
@@ -38,3 +39,9 @@ func (multiSignature *MultiSignature) Num() int {
 	}
 	return num
 }
+
+//候选人的惩罚记录及当前是否被暂停出块资格
+type SlashStatus struct {
+	types.SlashInfo
+	Jailed bool
+}
@@ -3,7 +3,9 @@ package bft
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
 	"github.com/drep-project/DREP-Chain/pkgs/consensus/types"
 	"github.com/drep-project/binary"
 )
@@ -44,6 +46,7 @@ type Setup struct {
 	Round  int
 
 	Msg []byte
+	Sig []byte //leader对消息的签名，可作为双签的证据
 }
 
 func (setup *Setup) String() string {
@@ -57,6 +60,20 @@ type Commitment struct {
 	Round  int
 	BpKey  *secp256k1.PublicKey
 	Q      *secp256k1.PublicKey
+	Sig    []byte //member对消息的签名，可作为双签的证据
+}
+
+//签名的内容不包括Sig
+func (setup *Setup) SignHash() []byte {
+	unsigned := *setup
+	unsigned.Sig = nil
+	bytes, _ := binary.Marshal(&unsigned)
+	return sha3.Keccak256(bytes)
+}
+
+func (setup *Setup) Sign(prv *secp256k1.PrivateKey) (err error) {
+	setup.Sig, err = crypto.Sign(setup.SignHash(), prv)
+	return err
 }
 
 func (commitment *Commitment) String() string {
@@ -64,6 +81,19 @@ func (commitment *Commitment) String() string {
 	return string(bytes)
 }
 
+//签名的内容不包括Sig
+func (commitment *Commitment) SignHash() []byte {
+	unsigned := *commitment
+	unsigned.Sig = nil
+	bytes, _ := binary.Marshal(&unsigned)
+	return sha3.Keccak256(bytes)
+}
+
+func (commitment *Commitment) Sign(prv *secp256k1.PrivateKey) (err error) {
+	commitment.Sig, err = crypto.Sign(commitment.SignHash(), prv)
+	return err
+}
+
 type Challenge struct {
 	Height      uint64
 	Magic       uint32
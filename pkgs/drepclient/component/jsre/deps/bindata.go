@@ -98,7 +98,7 @@ func bignumberJs() (*asset, error) {
 	return a, nil
 }
 
-var _drepJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x7d\x77\xdb\x36\xb2\x38\xfc\xf7\xd3\x4f\x81\xf8\x77\x6f\x24\x37\x8c\x24\xcb\x49\x9a\x2a\xd5\xf6\x3a\x8e\x93\x78\x9b\xc4\x3e\xb6\xb3\xed\x5e\xaf\x9b\x03\x91\x90\x84\x9a\x22\x75\x49\xca\x2f\x1b\xfb\xbb\x3f\x07\xaf\x04\x08\x80\x84\x64\x39\x6d\xb7\xf1\x9e\x6d\x44\x72\x30\x00\x06\x83\xc1\xcc\x60\x30\xc8\xd0\xff\x2d\x70\x86\x86\xed\xf1\x22\x09\x0b\x9c\x26\xed\xcd\xcf\xe2\x27\xc8\xda\x28\x48\x82\x42\x79\x93\xb6\x71\x30\xde\xfc\x8c\xc7\xed\x07\xc9\x29\x3e\x63\xbf\x10\xfd\x75\x01\x33\x10\x0e\x37\x04\xe8\xc6\x70\x58\x5c\xcf\x51\x3a\x06\xbc\x8a\x87\x0f\xf9\x8f\x17\xa4\xcc\xf8\xe1\xc3\x70\x33\x43\xc5\x22\x4b\x40\xd8\xc6\xc1\x83\xde\x26\x79\xbf\x10\xef\x16\xfc\x1d\xc1\x0a\x87\x09\xba\x04\x7b\x59\x96\x66\xed\x8d\x5d\x98\x24\x69\x01\xc6\x38\x89\xc0\x2c\x8d\x16\x31\x02\xad\x8d\x47\xf8\xd1\x46\x6b\x63\xf3\x45\x31\xcd\xd2\x4b\x00\x3b\x61\x1a\xa1\xe1\xc6\xfb\x83\x57\x1f\xdf\xed\x7d\xfa\x70\x70\xf2\xe9\xf5\xc1\xc7\x0f\xaf\x36\x02\x78\x4b\xf0\xcd\x87\xa4\xed\xc3\xcf\xe8\x6a\x9e\x66\x45\x3e\xf8\x7c\x7b\xfb\x82\xf4\xe1\xb4\x77\xd6\x09\x61\x1c\xb7\xe7\x1d\xfe\x29\x90\x54\xc9\x58\x07\x93\x21\x05\xdc\x3a\x3b\xcd\xce\x5e\xf0\xa6\xa6\xed\xe4\xe6\x26\xdb\xbc\x0d\xe6\x41\x59\x30\x0b\x18\xe9\x6e\x39\x10\xa9\x51\x7c\xbc\x1d\xa7\x59\x9b\x60\x5b\xf8\x90\x2b\xc0\xc3\xde\x0b\xfc\x43\xd1\x89\x51\x32\x29\xa6\x2f\xf0\xa3\x47\x9b\x69\xbb\x20\x34\x97\x2d\x10\xb5\x64\xb7\x9b\xed\xcd\xf6\xe7\xad\xc1\x69\xd9\x70\x8e\x85\xd1\x2a\xe0\x4d\xd8\xfc\xfc\x0d\xe0\x7f\xdd\x6f\xe9\xcf\x93\x29\xce\xc1\x18\xc7\x08\xe0\x1c\xcc\x61\x56\x80\x74\x0c\xa2\x0c\xcd\x3b\xbf\xe5\x9d\x6f\x28\x08\x7f\x22\x00\xe3\x0c\x21\x90\xa7\xe3\xe2\x12\x66\x68\x00\xae\xd3\x05\x08\x61\x02\x32\x14\xe1\xbc\xc8\xf0\x68\x51\x20\x80\x0b\x00\x93\xa8\x9b\x66\x64\x9c\xf0\xf8\x9a\xe2\xc0\x05\x58\x24\x11\xca\x40\x31\x45\xa0\x40\xd9\x2c\x27\xf5\x90\x87\x37\x1f\x3e\x82\x77\x28\xcf\x51\x06\xde\xa0\x04\x65\x30\x06\x87\x8b\x51\x8c\x43\xf0\x0e\x87\x28\xc9\x11\x80\x39\x98\x93\x37\xf9\x14\x45\x60\xc4\xf0\x91\x92\xaf\x49\x63\x8e\x79\x63\xc0\xeb\x74\x91\x44\x90\xf4\x3d\x00\x08\x17\x53\x94\x81\x0b\x94\xe5\x84\x7b\xb7\x45\x5d\x1c\x63\x00\xd2\x8c\x62\x69\xc3\x82\xf4\x21\x03\xe9\x9c\x14\xdc\x04\x30\xb9\x06\x31\x2c\xca\xb2\x26\x09\xca\x9e\x46\x00\x27\x14\xed\x34\x9d\x23\x50\x4c\x61\x41\xba\x79\x89\xe3\x18\x8c\x10\x58\xe4\x68\xbc\x88\x03\x5a\x7c\xb4\x28\xc0\xcf\xfb\x27\x6f\x0f\x3e\x9e\x80\x9d\x0f\xff\x04\x3f\xef\x1c\x1d\xed\x7c\x38\xf9\xe7\x0b\x70\x89\x8b\x69\xba\x28\x00\xba\x40\x0c\x17\x9e\xcd\x63\x8c\x22\x70\x09\xb3\x0c\x26\xc5\x35\x48\xc7\x14\xc5\xfb\xbd\xa3\xdd\xb7\x3b\x1f\x4e\x76\x5e\xee\xbf\xdb\x3f\xf9\x27\x48\x33\xf0\x7a\xff\xe4\xc3\xde\xf1\x31\x78\x7d\x70\x04\x76\xc0\xe1\xce\xd1\xc9\xfe\xee\xc7\x77\x3b\x47\xe0\xf0\xe3\xd1\xe1\xc1\xf1\x5e\x07\x80\x63\x44\x1a\x86\x28\x86\x66\x42\x8f\xe9\x98\x65\x08\x44\xa8\x80\x38\x16\xe3\xff\xcf\x74\x01\xf2\x69\xba\x88\x23\x30\x85\x17\x08\x64\x28\x44\xf8\x02\x45\x00\x82\x30\x9d\x5f\x7b\x0f\x24\x45\x06\xe3\x34\x99\xd0\x6e\x4b\x2e\x03\x60\x7f\x0c\x92\xb4\x08\x40\x8e\x10\xf8\x61\x5a\x14\xf3\x41\xb7\x7b\x79\x79\xd9\x99\x24\x8b\x4e\x9a\x4d\xba\x31\x43\x90\x77\xff\xd6\xf9\xe6\xdb\xae\xc2\xc2\xdf\xca\xdf\xe0\x5b\xf0\x3f\x94\x8d\x39\x56\xed\x03\x5c\x14\xd3\x34\xcb\x07\xea\x4b\x00\xfe\x8e\xc6\xe3\x0c\x5d\x83\x9f\x71\x1c\x9e\x23\xf0\xc3\x6f\x68\x3c\xfe\x1f\x54\x4c\x23\x74\xd1\x09\xd3\xd9\xdf\x74\xe8\xf7\x30\x43\xe7\xe0\xa7\xb4\x40\x97\x38\xfc\x37\xf8\x61\x46\x9e\x6b\xc1\x31\x4c\xc0\x01\x4c\x42\x04\x29\x34\x86\x89\x1b\xfc\x35\x1c\x11\xf0\x7f\xa4\x13\x14\xe7\x05\x8a\x63\x94\x81\x1f\xc6\xf4\xa5\xbb\xd0\x1b\x78\x01\x7e\x4e\xd3\x08\xfc\x30\x71\x01\xfd\x4f\x04\x0b\x04\xfa\xbd\xad\x27\xca\xdb\xee\x37\xf2\x81\x88\xa3\x23\xf4\x7f\x0b\x94\x17\xef\x61\x02\x27\x28\x03\x43\x21\x8a\xda\xad\x4e\x97\x50\xb3\x9b\x31\x80\x19\x03\x68\x6d\xbe\xd0\x8a\xef\x8f\x60\x62\x29\x84\x47\x30\xa9\x82\xee\xbe\xdd\xd9\xff\x60\x81\x9d\xa1\x62\x9a\x46\x79\x37\x9c\x42\x6c\x14\x7a\x77\xf0\xa6\xa6\x48\x9c\x4e\xaa\x05\x76\x76\x77\x0f\x3e\x7e\x38\xa9\x29\x04\xc3\x30\x5d\x24\x85\xd1\xbc\x83\x0f\xc7\x7b\x1f\x8e\x3f\x1e\xd7\x35\x31\x4d\x72\x94\xe4\x8b\xbc\x5a\xf8\xe4\x68\x67\x77\xaf\xa6\x60\x91\xc1\x10\x55\x0b\xbd\x7c\x77\xb0\xfb\xd3\xfb\x37\x47\x35\xe5\x46\x71\x1a\x9e\xcf\x26\x06\xd9\x0f\xfb\x87\x35\xa5\xe6\xfd\x79\xb5\xc0\x31\x2a\x0a\x9c\x4c\x72\x4b\xa9\x9c\x7f\xaa\x16\x11\x02\x54\x2b\x21\x24\xe3\x6f\x79\x6a\x8c\xd5\xa2\xc0\x71\xa5\x02\xfa\x8a\xfd\xb7\x0a\x9d\x4f\xe1\xb6\x0d\x98\xbc\xaf\xc2\xa2\xab\x02\x25\x91\xa5\xed\xec\x83\x41\x58\x58\x84\x53\x0b\xf4\x88\xbc\x37\x48\x99\xa5\x73\x94\x15\xd7\x16\xf8\x39\xff\x54\x2d\xf2\xb6\x28\xe6\x87\x59\x7a\x81\x23\xeb\x8c\x21\x32\x6c\xce\x3f\x1b\xf3\x65\x1e\xd6\x94\xc4\xf3\xd0\x55\xf0\x25\x9e\x7c\x58\xcc\x46\x7a\xb1\x11\x9e\x24\xf4\x65\xe7\x37\x4a\xdf\x6f\xca\xb9\x2d\xb5\xb7\x57\x19\x9a\x83\xb6\x40\xbb\x09\x4a\x25\x00\xd0\xa5\x14\xe7\x9d\x4f\x59\x55\x08\x10\xdd\x4b\x97\x0c\x25\x86\x17\x26\x82\x70\x91\x65\x28\x29\x94\x9e\x09\xe8\x17\xdf\x98\xd0\xf3\xfe\x9c\x57\x71\xd8\x3f\x6c\x93\x57\x36\x9c\x71\x3a\xe1\x50\xef\x0e\xde\x38\xa1\xe4\x8c\xe4\xb0\x72\x16\x3b\x4b\xd0\xa9\xc8\xa1\xe9\xb4\x75\x42\x72\x41\xc1\x61\xb9\x60\x71\xb7\x84\x88\x2f\xd1\x0a\x22\xea\x9c\x90\x62\x52\x73\x60\x21\x06\x04\xbc\x59\x20\x2f\x27\x2f\x29\x20\xe6\x72\xdb\x86\xbc\x9c\xb5\xfa\x40\x93\x3f\x38\xc7\x03\xa9\xdb\xf0\x7f\x35\xa0\x5b\x0b\x42\x31\x92\xb9\x15\xa5\x3a\x15\x06\xda\x53\x60\xc0\x2a\xbc\x3f\x50\x1f\x1a\x9b\xf0\x49\xce\x7f\xf6\xc3\x49\x59\x0e\xd8\x36\xdb\xc9\xa7\x32\x46\xf9\x40\xf9\xdd\xde\xd4\xeb\x56\x70\xde\x7e\xf3\x4d\xb7\x0b\x88\xe6\x9c\x23\x50\xd2\x20\x65\x8a\x5a\x18\xc3\xbc\xd4\x32\xc8\x14\xab\xa1\x93\x2f\x8d\x1a\xe9\x73\xab\x30\x87\xa8\xb3\x48\x89\x09\x41\x58\x44\x99\x7d\x72\xf2\x2f\x35\xef\x55\x24\x2b\x4f\x77\x8f\xb6\x66\x28\x47\x85\xd6\xca\x73\x84\xe6\xfb\xf9\xf1\x75\x12\xe2\x64\xe2\xd5\x54\x8a\xa3\x52\xce\xd2\x52\x8f\x99\x53\xd3\x50\x55\xe6\xca\xdf\x2f\x5c\xd0\x45\xfa\x16\x5d\x81\x21\x5b\x06\xd9\x53\x0d\xec\x4e\x1e\x62\xac\x40\xd3\xe7\x1a\xf8\x8f\xc5\xf8\xb9\x02\x4e\x1e\x9d\xd0\xe3\x2c\x9d\xe9\xf8\xe5\x9b\xda\x32\x5a\x1d\xe2\x45\x4d\x9b\x5e\xa1\x10\xcf\x60\xac\x34\x8b\xbf\xa9\xad\xa5\x5a\x4a\x79\x57\x53\x97\x3a\x16\xa2\x36\x9f\x31\xf9\x19\xa9\x54\xfe\x19\xd5\x53\x40\x85\xe6\xcf\x4e\x78\x9c\xef\x44\x51\x86\xf2\x5c\x96\x90\x6f\x6a\xca\xec\x4e\x51\x78\x9e\x2f\x66\x66\xd9\xca\x97\x9a\x3e\xb9\x70\x18\x5f\x6a\xda\xb1\xff\x72\xe7\x83\x52\x39\x79\x74\x42\xcf\x61\xf4\x0e\x8d\x0b\x09\xce\x9f\xeb\xe0\x8f\xf0\x64\xaa\x15\xa0\x2f\x5e\x7c\xe3\x16\x60\x4c\x1d\x94\x2e\x0c\x62\x67\x27\x93\x80\x1b\xe7\x79\x55\x28\x70\xdf\x47\xab\x77\xd5\x02\x8f\xa8\x2e\x69\x94\xb0\x4f\xf1\x8a\xd9\x78\x92\xc1\x24\x1f\xa7\xd9\x8c\xd8\xf6\x19\x0a\x0b\x80\x43\x38\x07\x45\x0a\x20\x23\xa1\x66\x39\xd5\x70\xce\xfe\xee\xce\xa1\x26\xd3\x08\x9e\x6a\xab\x89\x22\x87\x99\xc5\x44\xc4\x11\x31\x9e\x18\xdc\x0b\x5b\xe7\x08\x64\x87\x37\xc3\x25\xb1\xa8\x6b\x4b\xae\x67\x5a\x03\x1c\x24\x3b\x35\xd6\x46\xaa\x89\x71\x55\xd7\xb2\x74\x52\x10\x38\x43\x03\xd0\x12\xba\x43\x92\x46\xa8\x65\x2e\xf0\xe4\x6f\x82\x8a\x82\xac\x5f\xad\x4b\x34\xda\xfe\x14\xc6\x18\x25\xc5\x3f\x58\xb1\x96\x51\xe0\x56\x5f\x7f\xcf\xbc\xa4\x32\xce\x77\xd3\x24\x41\x61\x81\x22\x95\x61\x36\xad\xbd\x6d\x5b\x17\xac\x87\x0f\xad\x0b\x99\x8a\xba\xbd\xe9\xb7\x46\x84\x19\x82\x05\x12\x16\x47\x23\xf5\xa9\xc2\x47\x80\xab\x3a\x8c\x5a\x05\x73\xdb\x09\xcf\x21\x18\xd2\x3a\xc5\xdc\xb9\x0d\x3e\x6f\xa8\xf6\xcc\xc6\xa0\x1f\x6c\x68\xe6\xd0\xc6\xe0\x89\x7c\xa3\xda\x22\x1b\x83\x67\xf2\x3d\xe1\xad\x8d\xc1\x77\xe5\x73\x69\x79\x6c\x0c\x9e\xcb\xd7\x15\x9b\x79\x63\xb0\xb5\x65\x7c\x13\xea\xec\xc6\x60\xab\x6f\x7c\xa4\x5a\xf1\xc6\x60\x6b\xdb\xfc\x22\x34\xf7\x8d\xc1\xd6\x13\xe3\x6b\x9c\x4e\x36\x06\x5b\x4f\x8d\xf7\xf3\xfe\x7c\x63\xb0\xf5\xcc\x78\x4f\xb5\xfa\x8d\xc1\x56\xd9\x23\x61\xbc\x6d\x0c\xb6\xca\xfe\xe8\xce\x8c\x8d\xc1\xd6\xf7\xf2\x93\x50\x15\x36\x06\xfd\x1e\x79\x59\x5a\xa3\x1b\x83\xfe\x76\xf9\x86\xfe\x77\x63\xd0\xa7\x6d\x56\xcd\xe1\x8d\x41\xff\x69\xb0\xa1\x9a\x64\x1b\x03\xfd\xf1\xf6\x2c\xe8\x7f\xf5\xd2\x7e\xf5\xd2\xfe\xd5\xbd\xb4\x54\x6e\xd9\xdd\xb4\xcb\x79\x5a\xa5\x83\xf3\xa9\xdb\xc1\xf9\xf7\x3c\x4d\xb2\x79\xa8\x7b\x5b\x7e\x63\x2f\x0d\x3f\x53\x96\xa5\x59\xc5\x85\xc5\xde\xb5\x36\x2b\x8b\xae\x29\xf1\x09\xad\xac\xb6\x8b\xe1\x5d\xa1\x54\xad\x58\x34\x16\xe3\x85\x03\x90\xf6\x9c\x3a\x96\xc6\x0a\x79\x8f\x19\x0b\x8c\x10\x08\x61\x1c\xa3\x88\xeb\x31\x80\x2d\x52\x74\xf1\xe1\x48\xc9\x17\x3a\x0c\xe2\x85\x82\x46\xa3\x30\x13\xb0\x04\x8b\xf6\x7a\x0e\x33\x38\x03\x9f\x0f\x46\xbf\xa1\xb0\xb8\x05\x9c\x9e\x0c\x59\x01\x52\xfa\xda\xaa\x3a\x51\xba\x29\xeb\x27\x69\x9e\x4a\x45\xde\x9c\x3a\x42\xe6\x9d\xf9\x22\x9f\x4a\xc8\x95\x29\x83\xae\x50\x48\xa4\xe1\x32\x74\xe0\x65\xbc\xba\x26\xf0\xd7\xa9\x05\x84\x95\x94\x71\xd6\xba\xe9\x66\x89\xd2\x60\x4f\x22\xa6\x4d\x88\x32\x81\x52\x17\xca\xb2\x00\x64\x28\x5f\xc4\x85\xa1\x3f\x03\xaa\x92\xd0\x4f\x94\xdd\xd9\xaf\x9b\x1b\x8d\xd3\x4a\x40\x4e\xf7\x19\x9c\xb7\x8d\xa1\x0a\x00\x4e\x22\x74\x65\xab\x01\x94\x8a\x0f\xaf\xe2\x94\xc2\x9e\x91\x9a\x3e\xdf\x9a\x35\xdd\x6e\x76\xc6\x69\xb6\x07\xc3\xa9\x56\x0f\x29\xda\x50\x0d\x1e\xcb\x06\x89\x4a\xe8\xbe\xee\x08\x86\xe7\xa4\x8c\xb5\x90\x28\xf8\x80\x0b\x89\x0e\xce\xff\x01\x63\x1c\x1d\xa1\x7c\x4e\xd4\x13\x5e\xf5\xa6\xab\x52\xa3\x8f\xf6\xfa\xdb\x4c\x86\x74\xf6\x93\x0b\x2b\x76\x93\x0e\x92\x1e\xee\x76\x3b\x2b\x4b\x16\x71\x1c\x98\xc4\x20\x46\x0e\x2c\xc0\x8f\x46\x41\xf6\x81\x37\xa6\xc3\xdb\x04\x06\x40\x7f\xe1\x68\xe4\xad\x65\x08\x5f\xb8\x9d\x6a\x75\x4a\x2e\x65\x64\x5d\xcb\x65\x74\xdb\x18\x50\xf5\x8b\xcb\x98\x8d\xc1\xf7\xb7\x67\xc1\xf6\x57\x3d\xea\xab\x1e\xf5\x57\xd7\xa3\xb8\x58\xf9\x02\x8a\x94\x31\x59\x75\x79\xc8\x05\x1b\x73\xc6\x1d\x8c\x8f\xd3\x18\x47\xb8\xb8\xde\xc9\x26\xf9\xa0\x66\xf1\x03\xba\x5d\xcc\x82\x7d\x5a\x1c\x19\x60\x76\x13\x21\x39\xcc\x26\x8b\x19\x4a\x8a\x9c\xac\xda\x02\xb9\xc4\xdb\xaa\x0a\x9c\xa0\xae\x6d\x47\x87\xbb\x87\x44\x77\x59\x4b\xc3\x70\x32\x5f\x14\x80\xea\x42\xa8\x40\x19\x6d\xdf\xd1\xe1\x2e\x60\xca\x82\x5f\xc3\xb8\xc7\x01\xa7\x89\xda\xa2\x69\x9a\x17\x9b\x3e\x8d\xda\x3d\xf8\xf0\x61\x6f\xf7\x64\xff\xe0\x03\xd8\x3b\x3a\x3a\x38\x1a\x80\x5d\xc2\xbe\xc9\xbf\x5a\x05\x08\x19\x6a\xd2\xaa\x24\x8d\x10\x68\x3d\x02\x04\x2f\x78\xd4\xea\xf8\xb5\xad\xdc\x8e\x58\x96\x56\xd2\xd5\x92\xa4\x05\xc8\x89\x4a\x98\x01\xcc\x90\xfa\x55\x2d\x16\xc9\x01\xa8\x2a\x02\x16\xb2\x10\x2d\x6a\x86\xf2\x1c\x4e\x88\xb6\xf5\xe0\x01\x03\x04\x0f\x1f\xca\xdf\x1d\x3a\x57\xcc\x37\x1d\x51\xec\x47\x60\x7d\x3d\x00\x72\xd8\xff\x7e\x7c\xf0\x81\x8e\x6e\x26\x9b\xd6\x02\x8f\xe8\xeb\x0e\xf3\x3d\xe2\xf1\xb5\x68\xa3\x4d\x89\xaa\xd0\x88\x57\x51\x4f\x8d\x92\x3b\x4e\xf0\x0c\xa5\x8b\x42\xa5\xc7\x2c\x5f\x96\x45\x4e\xf6\xdf\xef\x1d\x7c\x3c\x19\x80\x82\x61\x23\x4c\x4c\x3a\x31\xcb\xc1\x23\xd0\x22\xff\xc0\x70\x4a\x04\x9e\x31\x48\xc6\x1a\x7e\x1b\x7c\xbe\x3d\x0b\x9e\x2c\xb3\x0e\x93\x61\x62\xda\x46\x81\xaa\x66\x56\xf9\xde\x23\xac\xa0\x53\x1b\x59\xf0\x9e\xa9\xea\x5a\x09\x73\x46\xba\xf7\xfe\xd5\x6d\xff\x6f\xba\x5d\x70\x72\xf0\xea\x80\xa8\x43\x63\x18\x16\x69\x16\x80\x3c\x65\xcb\x57\x39\xf9\x73\x40\x96\x65\xc2\xea\x30\x2e\x50\x86\xa2\x0e\x29\x87\x8b\x56\x0e\x12\x14\x92\x71\xce\xae\xc9\x2c\x9c\xc1\x73\x04\x72\x38\x43\xa0\x45\x5d\x75\xd4\x2b\x0a\x2e\xd3\xec\x9c\x2d\x13\xb3\x45\x5c\xe0\x79\xac\x6c\x38\xea\xe6\xa9\xd8\x06\xad\x37\x3a\xbb\xdf\x82\xdf\xf2\x29\x4e\x0a\x30\x83\x57\x61\x3a\x9b\xc7\xe8\x0a\x17\xd7\x83\xa7\xaa\xb1\x52\xe2\xd4\xf0\xc9\x66\x59\x75\xe6\xb2\x11\x28\x62\xa6\x9f\xc9\xe7\x44\x9f\x96\x58\x3a\x82\x96\x75\x6a\xfb\x03\xd2\x89\x53\xb3\xcc\x59\x9d\xda\xed\x2a\x43\xd6\x26\x8b\x61\x01\xac\xba\x2a\xf9\xd3\xfb\xc3\xad\x73\x1b\x66\x8b\xb5\x02\x50\x9c\x23\x47\x23\xad\x78\x2d\x38\x4c\x2a\xeb\x14\xe4\x1e\x4e\x17\x2d\x0c\x40\x8b\x01\xc5\xbe\xd4\x51\x93\x41\x74\x60\x51\xc0\x70\x7a\x92\xb2\x36\xb7\xf5\x2e\xd4\x98\x29\xbc\x78\x8e\x8a\x4a\xd0\x88\xcd\xd3\xe1\xb2\x24\x2c\xef\x1b\x89\x53\x6e\x7d\x34\xd3\xa7\x84\xb5\x90\xa8\x89\x4f\x41\x19\x43\x70\xbd\x32\x99\x24\x82\x7b\x20\x94\xf6\x54\x09\x22\x41\x57\x1d\x4d\xec\x96\x0f\x2f\xaa\x70\x42\xd8\xd2\x7f\x8d\xaf\x52\xb2\xb2\x1f\xc6\x77\x45\x9e\x8a\x9f\x95\xa6\xf0\xd5\x49\xdd\x1a\xbf\xd5\xe2\x96\x0c\x4d\x93\x51\x57\xb7\x0b\x3b\x56\x67\x7c\xd9\xad\x8d\x01\xdd\x39\x60\x6c\xb9\x31\xd8\xa2\x0e\x7d\x75\x43\xe0\xf6\x2c\x78\xfa\xd5\x82\xfc\x6a\x41\xfe\xd5\x2d\xc8\x72\xca\xac\xc5\x8a\xe4\x85\x56\x88\x67\x6e\x32\x40\x5b\x8b\x1c\x01\xc2\x4b\x61\xd1\x52\xa3\x08\xec\x0a\x62\xad\x7a\x18\xa6\xc9\x18\x4f\xac\xf0\xec\x53\x73\x8c\xb3\x08\x6f\x6e\xf0\x36\x93\x81\xe6\x6e\xb7\x74\x51\x10\x6d\xb1\x48\x01\x04\x23\x3c\xe1\x36\x64\x83\xb3\x99\x15\x92\x51\x2e\xaf\xc5\x60\x69\xb0\xdc\x13\x7f\x4c\x2d\x90\x1b\x06\x79\x23\xcb\xdc\x6a\xb0\x4c\xfa\xe6\xe0\x73\xf9\xbd\xce\x57\x4f\x3a\xef\x6a\x83\xa6\x35\xb2\xde\x38\xb6\xbc\x8d\x68\x1d\x01\xee\x8e\x68\xc0\xf9\x61\x86\x22\x34\xc6\x09\x8a\x5e\xc6\x69\x78\x2e\x43\x7f\xca\x3a\x47\xe5\x7b\x47\xc5\x23\xb5\xe4\x70\x08\x5a\x44\x58\xe5\x45\x0b\xdc\xdc\x98\xdf\xe6\x28\x89\x70\x32\xb1\x7f\x44\x30\x8b\x31\x29\x5a\xd3\x64\x62\x0d\xbc\x42\x63\xb8\x88\x0b\xa5\xc9\x76\x7a\xd5\xb4\x9d\xe8\x37\xd5\xfa\xc9\x62\x40\x69\x51\x63\x7b\x33\xd6\xed\x44\x4a\x0b\x5c\x16\x9c\x52\x8a\xb6\xda\xd6\x5c\xad\x89\x0d\xbd\xfe\x3d\xba\x2b\x61\x2a\x9d\x64\x1a\x39\xc1\xea\xe0\x21\xad\x31\x35\x15\x28\x60\x1e\x74\x54\x62\xfe\x3c\x28\x57\x91\x16\x8c\x68\xb9\x62\x54\xa6\x63\x00\x41\x91\xc1\x24\x87\x8c\x84\x30\x89\xc8\x08\x5f\x20\xa2\x13\xc1\x38\x06\x17\x30\x5e\x20\xea\x6f\x7a\xbb\xf7\x4b\x83\x18\xa1\x38\x77\x61\x1c\xd7\x0a\x10\xb1\x95\xa7\x56\xcb\x43\xa9\xac\x32\xa4\x41\x6e\x98\x95\x6a\x2c\x21\x82\xb4\x2a\x46\x26\x7f\x4d\x23\xaa\xc0\x50\x7f\xbc\xb9\xa9\x30\xf9\x0e\x0b\x4a\xa9\x68\x98\x64\xec\xd5\x72\xb6\x31\xae\x54\x43\xdb\xca\x43\xe6\xca\x19\xa0\x21\xa9\xf2\x80\xb3\xca\x22\xdd\x04\x9f\x01\xb5\xfe\xc1\x8c\x06\xc1\x8d\x10\x69\x77\x91\xc1\xb0\x60\x1b\xb1\xd5\xa8\x6b\xb5\x45\x45\xda\xd8\x9e\x22\xad\x6f\xcd\x69\x6b\x02\xf3\xc3\x0c\x87\xa8\x15\x00\xf2\x9b\xfc\x43\xf9\x85\xfc\x48\xd2\x24\x44\xad\xb3\xce\x18\xc7\x04\xa9\x1a\x83\x6b\x35\x7e\xc4\x69\x43\x56\xf9\xe9\x39\xba\x3e\x03\x0f\xd4\x49\x5a\xdd\xed\x31\x8c\x2b\x8a\xd9\x39\x06\x0c\xa3\x25\x16\xb4\xad\x02\x58\xf6\x94\x6c\xd3\x90\x17\xf9\xe3\xcc\xba\x93\x12\xdb\x17\x9f\x7c\xb6\xba\xef\x6f\x0e\xd6\x60\xf0\x9a\x5e\x5f\x27\xd4\x7f\xd2\x84\xe2\xea\xae\x31\xa3\x8a\x14\xe0\x22\xe7\x8e\x10\x3e\x9d\xbc\x94\xe0\xa5\x27\xd2\x95\x5d\xf1\xe5\xdf\x1b\xd4\xdd\xc6\xa9\x53\x5c\x6d\x56\xf5\x98\x76\x71\xd5\x51\x35\x19\x32\xa6\xc9\x22\x8e\x37\x8d\x81\xaa\x00\x1a\xd1\xf3\x15\x4c\x95\xa1\x62\x35\x29\x44\xdd\x4f\x22\x74\x55\x5f\x9d\x01\x6d\xad\xb3\x0a\x55\x3d\x50\x71\xd5\xa1\xbc\x6e\x2f\x4c\x3f\x99\x25\x26\x30\xb7\xc3\x4f\xa0\x71\x82\x87\xbe\xa4\xf3\xcc\x16\xe3\xdf\x56\xbe\x9b\x25\x29\x2b\xb9\x8a\xd1\x8f\xf6\x18\xeb\xe2\x6a\x6d\xbc\x4d\x2d\xfd\x79\xb1\x16\x1e\x3f\x62\xb8\xbc\x58\x9d\xd7\xbb\x26\x7e\xaf\xd6\x5c\x09\xce\xa2\x1f\x4d\xde\xe7\x1f\x3c\x27\x80\x0d\xda\x64\x12\x0b\x94\x39\x15\x04\xd0\x12\xf3\xc1\x59\xc4\xdd\x84\x86\x99\x21\xc0\xc2\xc5\x6c\x11\xc3\x02\x5f\xa0\x37\x30\xff\x98\xd3\xf8\x74\x17\x4a\x03\xd6\x81\x73\xd2\x88\x69\x22\xcb\x57\x89\x23\x4f\xa5\x64\x19\xbc\x96\xf0\x71\x3a\xc9\x1d\x96\x4f\x09\x40\xfd\x1d\xe5\xa3\x16\xf9\xd5\x8e\xd3\x89\x65\xfd\x01\xca\xb2\x41\xb9\xea\x5d\x3a\x29\x17\x5b\x52\xc6\x16\xef\x55\xbb\xf2\xca\xb8\x2a\xda\x92\x3b\xcd\x54\xca\x45\xab\xcf\x4d\x6a\x41\x7a\x4d\x47\x5a\xd1\x5d\x26\xa3\x5e\x95\x7a\xc4\x81\xa2\x36\x36\xe6\xba\x5d\x26\x86\xc6\x69\x36\xa3\x5b\xfd\x15\xd7\x12\x00\xac\x4d\x84\x51\xde\xe1\x19\x2e\x2c\xbc\xa4\x03\x54\x06\x45\x7e\x74\x30\xa2\xf6\xdd\x5a\x36\xc7\xff\xb6\x2d\x1b\xe5\x47\x6b\xa9\x02\xcf\x50\x5e\xc0\xd9\xdc\x59\x54\x42\x98\x82\x81\x01\x24\x4d\xa2\x48\x03\x73\x55\x23\xfd\x55\x96\x46\x46\x78\x3c\xc6\xe1\x22\xa6\x9b\x1e\xe6\xea\x53\x05\xb2\x77\x34\x2d\x60\xfc\xca\x07\x51\x05\xd2\xa6\x37\xeb\x93\x9e\x17\x2b\xe5\x97\x7d\xea\x9b\x60\xa6\xd6\x89\x0b\x34\x73\x4c\x7b\x3c\x6e\x3f\x10\xf5\x32\x47\x24\x83\x36\xe9\x2d\xfe\x34\x41\x61\x53\xb7\x18\x82\x55\x25\xc6\x48\xf7\x7f\xad\x20\x2f\xe2\x74\xe2\x25\x18\x54\x21\x57\x27\x16\xe2\x74\x62\x5a\x6d\xa6\x6c\xa8\xd4\x6b\x93\x0f\x6a\x8d\xaa\x74\x20\x12\xd6\xf0\xae\x91\xb7\xda\x0a\x6a\x10\xb4\x02\x60\x99\x04\x55\x14\xc6\x5c\x23\x00\xc6\x12\x69\xad\xc8\x63\xcd\xb5\x22\xb3\x56\x19\xa7\x93\x9a\xaa\xc4\x57\x47\x15\xb2\xb0\xdd\xf0\x89\xd3\xc9\x5d\xbc\x08\x97\x53\x9c\x93\x15\x66\x9e\xe6\xc5\x1a\xdc\x08\x87\x69\xee\xa7\x0b\x6a\xfe\x83\x66\x6e\xab\x77\x20\x68\xb5\xaa\x9c\x46\x7a\x65\x5b\x86\xc8\xfb\xce\x1c\x5e\xc7\x29\x8c\xf4\x83\xd0\x6d\xf5\x53\x65\x34\xe9\xa7\xa2\xb0\x1e\x08\x6e\x8b\x8f\xb6\x32\x97\x69\x76\x7e\x92\x1e\x66\xe9\x05\x72\x97\x55\x80\x6c\x38\xe6\x19\x4e\x33\xac\xc8\x5c\x03\x81\x80\xa8\x32\x4a\xb7\x0b\xc6\x3c\xe4\xda\x90\xc1\x0f\x74\x21\xcc\x3a\x91\xce\x71\x68\x97\xbe\xca\x77\x30\xd4\x9e\x7e\x04\xa7\xca\xe3\x19\x18\x18\xf1\xf9\xb7\x66\xab\xd8\x8e\x13\xdb\x7c\x8a\xe3\xf4\x12\x27\x13\xd3\x99\x54\x57\xad\xae\xed\xd1\x77\x16\xc1\xdf\xed\x0a\xae\x06\x69\x12\x5f\x93\x8e\x27\x69\x01\xa6\xe8\xca\xe5\xf3\x60\xa8\x3a\x34\xee\xfc\x60\xdc\x6e\xf5\xae\x5a\x9b\xd4\xe3\xdf\xdb\x04\x3f\x02\xfa\x11\x0c\x2a\xc7\xcf\x79\xf5\x7e\xee\x0a\xd2\x87\x3b\x49\x7d\xb9\x5b\x4b\x27\x2e\x8f\xd0\xf3\x5a\x07\x7c\xa7\xe8\x5d\x34\xc3\xfa\x09\x59\x99\x8f\x74\x3c\xd1\xd5\x1c\x67\xd7\x16\x11\xa8\x7c\xb5\x4d\x8b\x1c\x25\x36\x35\x51\x7e\x6b\x9a\xc2\x95\x22\x35\x13\x98\xce\x4c\x9b\x56\x59\x81\xa8\x94\xaf\x48\x9b\x23\x78\x29\x58\x98\xbf\xa9\x05\xaf\xe6\x5d\xa8\x8a\xa7\x6a\x59\x55\xaf\xfa\x7b\xce\x09\x2e\xe1\x8d\xf8\xbb\x6e\xd9\xc5\xb2\x42\x1a\x27\x3a\x87\x59\x8e\xea\x84\x61\xb7\x7b\x97\x19\x4d\x65\x8f\x2a\x6d\x1a\x85\x8d\x29\x4e\xdc\xb0\x4b\x4b\x88\xca\xe6\x18\x23\xf5\x7a\xe6\xb3\x5c\xa4\xaa\x5e\x5d\xcd\x5f\xc1\x0f\xcf\xfb\x1c\xc3\x17\xa0\x55\x4d\x03\xb4\xe9\x31\x7c\x7e\x30\xa8\xbd\x09\x1e\x3e\x04\xfc\xcd\x2b\x9a\x30\xa0\x5d\xb7\x8b\xc8\xf3\x13\xb8\x4e\xf2\x03\x7d\xcb\x52\x55\xa1\x43\xd1\x33\xd9\xb2\x9a\x6a\x60\x35\xdd\x83\x03\xf1\x12\x28\x79\xcb\xed\x98\x75\xef\x1b\xcd\xbf\xa9\xc4\x1b\xf3\x38\x6f\x51\xb6\x55\xd9\x05\xb5\x08\x36\x9e\xc1\xc5\x2a\xdb\xc4\x81\x24\x73\xdf\xf8\x81\xfd\x13\xa8\x1e\x3a\x6b\x30\x18\x68\xd4\x77\x5e\xc0\xac\xc0\xc9\x84\x1a\xdf\x56\x67\x8b\x09\x66\x78\x6c\x28\x08\xcf\x29\xd0\x80\x48\x85\xb2\xe3\x99\xe2\xc9\x14\xe5\x4d\x78\x54\x28\x0b\xef\x72\xa8\xf3\x24\xbd\x4c\x8e\x0b\x58\xd8\xe3\x24\x4d\x28\x77\x85\x2a\x2a\x5b\x90\x3b\x05\x9a\x2f\xe2\x18\x45\x4d\xa8\x54\x28\x4f\x3f\x90\x3e\x9c\xbf\xe3\xee\x1e\xf3\xb8\x34\xc4\xe4\xac\x79\x57\xcf\xa8\xf3\xeb\x6e\xde\x4a\xbb\x79\x70\x46\xf3\x3f\xfe\x15\xb7\xec\x1a\xce\x71\x35\x05\x31\x0d\x1a\x21\x02\x13\x5f\x0d\x22\x4f\x0c\x5a\x18\xc9\xc0\xf2\xce\x52\xc6\xe6\x53\x1a\xb8\x3f\x59\x30\x54\x39\x6c\x60\x7f\x6d\x29\xa9\xe9\xe9\x03\xcb\x3b\xbd\x8c\x2b\xc4\x6e\xe0\xfc\x62\x2b\x6f\xef\xb0\xfb\x5b\x03\x8e\xea\x3e\x90\x05\x55\x15\xc4\xda\x2b\xcd\x99\x3d\xb0\xbe\xb5\x95\x53\x9d\x5c\x03\xcb\x3b\x5b\x99\x0a\xd9\x2d\x2f\x6d\xa5\xaa\xba\xc7\xc0\xf1\x3e\x30\x95\x18\x1a\x8c\xae\xc7\x8e\x6e\x0c\xfa\xfd\x60\xc3\x1e\x9f\xce\x73\xee\xdc\x9e\x05\xcf\xbe\xc6\x9e\x7f\x8d\x3d\xff\x6b\xc5\x9e\xf3\x80\x73\x35\x1f\x95\x5f\xa2\xee\x3f\x5c\xea\x6d\x9f\xa4\x33\x0d\x89\x64\xba\x5d\x7a\xe8\x0f\x66\x64\x2a\x10\x85\x73\x91\xeb\xa4\x21\x0c\x1b\xe1\xf1\x18\x11\x23\x01\xa0\xe4\x22\xa7\x85\x46\x59\x7a\x99\x2b\xba\x26\xd1\xac\xf8\x0d\x02\x97\x38\x89\xd2\x4b\xaa\x96\xb4\xa4\x5e\xd2\x22\xd6\x2a\xfb\xd2\xf9\xe5\xfd\xbb\xb7\x45\x31\x3f\xb2\xe7\x57\xd1\xbf\x82\xa1\xbd\xd4\x0b\xa2\xb9\xf1\xb3\x85\x78\x92\xa4\x44\xb4\xc4\x38\x41\xa4\x6d\x49\x1a\x95\x9e\x32\xeb\xc9\x38\xa3\x0e\x49\x9c\xab\x59\x4c\x7a\xcf\x8f\x3f\xb5\x36\x7d\xab\x95\xd5\xe9\xd4\xff\xe5\xed\x51\x5f\x43\x3f\xcd\xfa\xad\x4d\x27\x16\x97\x0d\xa1\xa5\x79\xce\x65\x02\x99\x45\xce\xd2\xc7\xe4\x28\x89\x40\x36\x0f\x69\x46\x99\x1c\xa4\x17\x28\xa3\x63\xe8\xd4\xdf\x2b\x69\xa3\xf5\xc3\xde\x81\x38\x92\x1b\x90\x0a\xb2\x00\xcc\x61\x9e\x5f\xa6\x59\x14\x80\x29\x82\x11\xca\x0c\xcb\x8d\x26\x84\xa1\xe7\xb9\x87\xec\x58\xf7\xcd\x0d\x68\xf1\x39\x19\xa7\x21\x8c\xc9\xcb\xc1\xf3\xa7\x4f\x9e\xb6\x2c\x99\x64\xc4\x01\xe0\xa1\x3c\x0a\x7c\x73\x03\x7a\x16\x40\xd2\x1a\xa2\x9b\xe6\xd6\x1c\x45\xa2\x95\x60\x28\x1b\x6c\x81\xe2\x3d\x20\x0d\x65\xbf\x56\xce\xd9\x33\xcf\xd0\x9c\x9e\xb5\x45\x97\x15\x7e\x6a\x30\xd8\x78\x41\x13\x58\x5a\x6b\x2f\xd3\x34\x46\x30\x21\xe6\xda\x82\xba\x4d\x44\xaa\xa4\x72\xe8\x61\x7e\x9d\x84\x16\xd3\x0d\x7c\xd6\xdb\x52\x7b\xaa\x42\x65\x03\x35\x69\xa6\xd6\x40\xdd\x8d\x45\xaa\xad\xc9\x1c\x64\xb1\xb7\xac\x45\x40\x99\x36\x86\xbb\xbe\xc8\x4c\xa9\x3a\xa4\x14\x28\x95\x4b\x14\xa6\xb1\xfa\x99\x1a\xab\xd2\x28\x64\x78\xc1\xaa\xb6\x0c\xab\x3f\x9d\xa3\xa4\xdd\x3a\x3c\x38\x3e\x69\x05\x25\xc3\x07\x6c\x20\x2c\xfe\x8e\x92\x63\x45\x8a\x47\xc1\x95\x36\x5a\xd0\x7b\x66\x16\xc5\x14\x0c\x41\xeb\x25\xcc\x71\x48\xcf\xc2\xd3\x14\x8d\x0b\x22\x7b\x15\x74\x8f\x40\x6b\x40\x3e\xea\x38\x3b\x45\xca\xb7\xbb\x5b\x23\x98\xa3\x67\x4f\xaa\xc7\xe6\xd5\xbe\x94\x87\x3e\xdf\xd2\x49\xd0\x6e\xed\xd0\x05\x0f\xff\x9b\x2a\x43\xad\x80\xb6\xc5\xf0\x0e\xba\x8b\xef\xa6\x49\x81\x92\xe2\xf1\xc9\xf5\x9c\x46\xa2\xc2\xf9\x3c\xc6\x21\x45\xd6\xad\xe6\xe7\x07\x3c\x72\x50\x99\x8d\x36\x8a\xa8\xdf\x4d\x23\x96\x7d\x70\x27\x7a\x72\x34\x94\x15\xeb\x24\x70\x86\x84\x30\xb3\x86\xe4\x01\xeb\xf6\xbe\x5e\x85\x9a\x67\x69\x65\x21\xc2\x8e\xe1\x5f\x27\xa1\x67\xd2\x2f\x22\xe9\xeb\xfc\x3b\xdc\x95\x6f\x15\x0c\x65\x94\x5e\xbe\x88\x97\x12\x08\x79\xf5\xac\xbf\xd8\x31\x70\x0b\x02\x31\x4f\x75\x59\xd2\x1e\xc3\x38\x47\x46\x66\xf9\xec\xda\x3d\x67\x69\xe5\xed\x4a\x6a\x0b\xb9\xdf\x51\x65\xd1\x90\x26\x50\x63\x59\xae\xec\x5c\x95\xa5\x97\x40\x4f\x82\x55\x26\xb6\x68\xcb\x79\x5d\x2f\x13\x58\x3f\x69\x62\x8f\xa1\x6c\xa7\xc8\xc5\x71\x82\xae\xaa\x62\xd0\xd5\x41\x8e\x41\xd9\x8f\xb1\xe6\xec\x28\xbb\xe5\xdb\x25\x25\xaf\x97\xd9\xb8\x75\x3b\x38\x8f\x0d\x7d\x84\x72\x35\x5c\x92\xad\x77\x8c\x15\xcd\x83\xb7\x39\xc8\x6b\xce\x99\xb7\x40\xe4\x1e\x03\x45\x86\x27\x13\x94\xa1\x08\xa4\x09\x20\xfc\x4b\xed\x07\x35\x13\xdd\xe6\xb2\x53\x80\xb6\xd0\x36\x0f\x02\xa0\xe6\x77\x33\x39\xa5\x6e\x46\x90\x25\xde\xf4\x9f\xf1\x35\x27\xc9\x10\x8c\xae\xf3\x02\x16\x28\x9c\xc2\x64\x52\x9f\xc1\x0f\xe8\xc9\xe7\x3a\xb4\x30\x75\x6a\xd3\xad\xed\x27\x64\x25\xaa\x2e\xa7\x44\x4b\xdf\x72\xc9\x51\x0f\x3e\x77\x15\x63\x49\x6e\x58\xac\xdb\x0b\x7b\xea\x38\xfb\xac\x28\x69\xe0\x39\x3b\xc4\x5f\xfd\x2c\x11\x7f\xa2\x5d\xab\xcf\x17\x59\x9f\xbd\x57\x7a\xa6\x3d\xc9\x6b\xcb\x26\x4a\x28\x59\xa0\x54\x7c\xea\x47\xbe\x9a\xe2\xcf\xc8\xd7\xd3\x56\x15\x27\x43\x74\xfe\x7e\x32\xd9\x91\x9b\xd0\x2a\x96\x1b\x73\x01\x01\x8b\x7c\xba\x4e\xc2\x69\x96\x26\xe9\x22\x8f\xaf\x89\x5c\x60\x3b\x2b\x54\x48\x91\xd9\xee\x29\xa3\x94\x8c\xdc\xd6\xe5\x55\x2a\xed\x62\x0f\xa5\xaa\xbc\x4f\xe1\x05\x4a\x5a\x05\x18\x43\x1c\xa3\xa8\x03\x0e\x8a\x29\xca\x2e\x71\x8e\x00\x5d\x15\x97\x91\x46\xf6\xc4\xe3\x26\x5f\xd8\x47\x91\xdf\x47\x61\xbd\x9d\x84\xfc\xe1\x68\x00\xbe\x97\x7f\xf6\xe4\xea\x3c\x05\xe2\x00\xb4\xfa\x9d\x9e\x23\x01\x3b\x23\xdc\x00\xb4\x12\x54\x7c\x8a\x71\x5e\xa0\x04\x27\x13\x07\xf0\x9c\xa7\x42\x3b\x3d\x6b\xd4\xc4\x80\x72\x68\x22\x5b\xa0\x65\x16\x4b\x5e\x8c\x12\xdc\x87\x95\x8c\x5d\x0a\x75\x40\x5e\x38\x12\x44\x12\x23\x7f\x63\xf0\xac\x1f\x6c\xe8\xde\x84\x8d\x41\x7f\xeb\xf6\x2c\xf8\xee\xab\xcb\xf5\xab\xcb\xf5\xaf\xe5\x72\xd5\x04\x26\x63\xe3\x11\x4c\xbe\x54\xde\x6d\xbf\x0b\xcb\xd4\x12\xe5\x35\x23\xa5\x6c\x15\x97\x7a\x8c\xae\x2d\xb1\x0b\x9a\xa6\xc4\x20\x75\xf1\x72\x39\x25\xbd\x16\xf1\x06\xec\x2e\x55\xf0\x03\x43\x06\xbe\x05\x7d\x77\x30\x04\x18\x82\x56\x8f\x18\xfc\xf6\x10\x12\x8b\x74\x5b\x42\x77\x3f\xe4\x4e\x2c\x98\x00\x7a\x0d\x0b\xe3\xad\x08\x7c\xff\x1d\x08\xd3\xd9\x7c\x51\xd0\xa9\x0a\x46\xd7\x60\x96\x5e\xe0\x64\xc2\x22\xbf\x70\x96\x17\xe0\x09\x08\xa7\x90\x25\x9a\x24\x2f\x89\x8a\x0d\x93\xa8\x3c\x00\x22\xa0\x63\x54\xf0\x84\x94\x6a\xc5\x8c\xf6\x39\x68\xef\x80\x21\xd8\xea\x05\xe0\x25\xf9\x77\x2b\x00\x9d\x4e\x27\x00\xff\x0b\x86\x60\xfb\xe9\x66\x40\x84\x4a\x3e\x47\x21\x1e\x63\x36\x8f\xf7\x8f\x0f\xb6\xb6\x9f\x6d\x3d\xeb\x34\x2e\xd6\x29\x85\xe3\xfd\xb3\xd9\x0c\xcc\x5d\x72\xcb\xc2\xaf\x48\x43\x09\x01\x2c\x2b\x7b\x5e\x82\x12\x28\xae\xbe\x47\x55\xf0\x4a\xd4\xb2\x5e\xbf\x7e\x1d\xcb\x08\x26\x36\xfe\x21\x94\x68\xed\xb4\x3a\x84\xac\xbb\x69\x84\x76\x8a\x76\xaf\xb2\xf2\x11\x30\x42\x9b\xd6\xff\x1a\x60\xba\x7f\x85\x85\x94\xd1\x49\x56\xa4\x1f\xe7\x73\x94\xed\xc2\x1c\x55\x5d\x5d\x2a\x58\xbe\x18\xe5\x45\xd6\x7e\xb2\x29\x82\xc4\xf8\x8b\x5e\xf0\xc4\xb1\xbd\xcf\xa0\xe6\x31\x2e\xda\xad\xd6\xa6\x1e\x87\x97\x38\x52\x63\x86\x69\x44\x6f\xbc\xab\xeb\x24\xe0\x66\x0c\x85\xfd\xdb\x10\xec\x10\xbb\x85\x3e\xfc\x30\x04\xff\xeb\x38\xf7\xd1\xed\x02\x0b\x27\x71\x46\x72\x38\x89\x78\xb6\x92\x08\x81\xc7\x60\x07\x3c\x02\x5b\xbd\x25\x13\xeb\x89\x04\x97\x8d\xca\xfd\x66\xe7\xb7\x14\x27\x84\x4c\x5e\xb3\x72\x17\xc6\xe1\x82\xa6\x88\xa1\x2c\xf7\xfe\xe0\x15\x99\x90\x5b\x3d\x21\xc3\xe7\x30\xcf\x39\x07\xda\x66\xc8\x77\xbd\x67\x4f\x9a\x26\xc8\x2c\x8d\xbe\xff\x6e\xab\xd7\x34\x31\xec\xf3\xc1\x4c\xe8\xa3\x73\x3f\x47\xee\xc5\xf5\x19\x9a\x41\x9c\xb0\x7d\x08\x02\x63\x6a\x87\xfc\x04\x8d\x5d\x9c\xf2\xc2\x42\xa2\xfe\x0d\xf4\x2d\x0c\x32\xe2\x91\x6b\x25\x78\x4e\xd6\xaa\x76\x2f\x00\xdf\x5b\x75\xcb\xb2\x49\xd4\xf0\xdc\x4f\x0a\x76\x7c\x29\x00\x5b\xbd\x4d\xf0\xdf\x64\x34\x1e\x19\xd8\xd8\xc9\x25\xd6\x10\x2f\x1f\x8b\xc4\x2d\x31\x95\xf8\xbd\xf8\x84\x6a\x85\xd2\x2a\xb0\x6c\x0c\xf1\xdb\x16\xe8\x3c\x67\xfb\x00\x80\xc6\x4e\xd1\x17\x61\x9a\xd1\x6b\xa6\xd8\x72\xe5\xe2\x97\x7a\xa6\xd0\xc7\x9d\xa7\xa7\xaa\x1f\x74\x76\x89\x9e\x22\x7a\xfc\xbb\x2a\xbc\x45\xde\xfd\x44\x44\xc1\x44\x8b\x99\xe5\x0a\x2d\xdb\xa4\xa0\x77\xd3\x19\x90\x06\x0d\x4c\x64\xa5\x15\x48\x48\x70\x2b\x97\x93\xba\xcd\x17\x02\xd9\x51\x6a\xf4\x0d\x17\x86\xf9\x4b\x11\x2e\x5c\x9e\xc9\xe3\xf0\x01\xd8\x7a\x66\x59\x32\x46\x30\x47\xdb\xcf\xc0\x90\x96\x2d\x77\x0a\xb6\x6d\xb0\x73\x18\x45\x88\x6d\xa2\x51\x1d\xa8\xcd\x0a\x07\x60\xeb\xa9\xfd\xdc\xba\xec\xc7\xcb\x11\x4c\xda\xac\xb8\xbe\xe8\x78\x0a\x3d\x7e\x82\x43\x11\x70\x2f\x09\x09\x8b\x54\xd3\x4e\x08\xfb\x00\x1a\x1b\x98\x5d\x0b\xc9\xc7\x76\x1a\x3a\x9a\x62\x13\x23\x98\xd3\x44\xb8\x5c\xe7\xff\x01\xff\x6d\x63\x42\x15\x5e\x16\xfc\x38\x2e\x07\x29\x9f\x42\x66\x0c\x50\x3d\xe6\x2a\x8c\x17\x39\xa6\x0a\x34\xf5\x01\xe1\x11\x8e\x71\x21\xf5\xe7\x11\x4c\xce\xbb\xa3\x0c\x26\xe1\x14\xe4\x28\xbb\xc0\xa1\x50\x74\xc4\xcd\x54\x3f\x74\xf1\xdf\x3a\x2e\xde\x25\x86\x03\xe2\x19\xc4\x99\x36\x31\x46\x19\x51\x27\x60\x3c\x49\x33\x5c\x4c\x67\x20\x42\x79\x98\xe1\x11\x13\xe7\x5c\xcd\x46\x49\xe7\x12\x9f\xe3\x39\x8a\x30\xa4\xba\x36\x79\xea\xee\x27\x05\xca\x12\xda\x21\x18\x7f\x7a\x09\x93\xf3\x4f\x3c\x6e\xf2\x13\xe3\x8b\xff\xf7\x86\xf7\x38\x99\x7c\x22\x5d\xfd\x14\x4e\x51\x78\xfe\x29\xc2\x13\x5c\xf8\x4c\x86\x97\xd5\x25\xa0\x32\x13\x46\x42\x77\x12\x23\x25\x8e\xe1\x14\x69\x8d\x3a\xb5\xc2\x1c\x79\x59\x95\x2c\x23\xc7\x72\xc2\x39\x63\x97\xe9\x19\xad\x5f\xf6\x5a\x2f\x6c\xbe\xfb\x52\xc0\xf3\xb5\xaa\x5d\xd1\xd8\xda\x2a\xa2\x47\xa0\xd5\xa3\x2a\x38\xad\xd5\x32\x69\x28\x59\x5f\x11\xaa\x82\x21\x68\x33\x75\xbd\xfd\xfd\x73\xf0\xb8\xac\x6a\x73\x93\x2f\x13\x8f\xfb\x0e\x7d\x4a\x1e\x00\xd0\xab\x56\x70\xf3\x06\xac\xe8\x95\xe7\x42\x52\xa1\x39\x9d\x50\x13\x4c\x8c\x5a\x9c\xe4\x05\x2e\x16\x32\x1c\x19\x47\x28\x29\x88\x52\xd1\x94\x19\x90\x61\xdd\x4f\xd8\x9d\x85\x36\x66\x11\xfe\x7b\x1e\x0e\x1a\x08\x1b\x2c\x12\x6f\x68\xca\xea\x0d\xa5\x05\x1b\xb4\x09\x1b\x65\x1b\x36\xd6\x21\x6b\xf5\x96\x5a\x23\x96\xed\xd9\xfb\x74\x09\xd7\xda\x3b\x79\x4b\xc6\x57\x44\xf9\xaa\x94\x53\xde\xca\xb6\x7b\xde\xfe\x38\x4d\xeb\xd6\x37\xc2\x03\x44\x29\x66\x83\xc5\x56\x6c\x80\x73\xc0\x8e\x37\x28\xeb\x5e\xa3\x5d\x44\x0f\x8f\x34\xda\x43\x86\x4e\x60\xf1\x73\x0a\xff\x26\x2e\xca\x96\x38\x8d\xa2\x7d\xe5\xe8\x8a\x97\x5e\x88\xb5\x9b\x29\x75\xb6\x57\xc6\x06\x97\xe7\x61\x56\xde\x84\x95\xd4\xa5\x5d\xa7\xcb\x4b\x96\xad\x46\xcb\x32\xad\xa5\x95\x4a\x01\x48\x3d\x9c\xbe\x94\x56\xaa\xb3\xd7\xa4\x9a\x83\x53\xbb\xbf\xfe\xb2\x77\xda\x7b\xfc\xfd\xd9\xe7\xfe\x6d\x7b\xef\xe4\x2d\xf9\xbd\xf3\xf8\x7f\xcf\x3e\x6f\x6d\xdf\xde\xc8\x87\xed\x5e\xb0\xbd\x75\xbb\xf9\x5f\xdd\x4e\x41\x77\xa0\xa4\x42\xb6\x09\x1e\x3e\x34\xb4\x61\x97\x80\x54\x8a\xb1\xa3\x93\x5b\x6b\x1a\x00\x9e\x02\x01\x8b\x7b\x50\x1b\x87\xe1\x95\x29\x7a\xee\x67\x1c\x5e\x99\x92\xc3\x31\x10\x25\x71\x84\x65\x42\x28\xb4\xfd\x04\xdc\xdc\xb8\xbe\x3d\x5d\x33\xf9\xc6\x00\x9b\x42\xd9\x4e\x40\xab\xf4\xbe\x1f\x12\x5a\xc5\xef\x52\x44\xec\xf7\x56\x26\xd4\x04\x15\xdc\xf4\xe1\x97\x13\xab\x05\x3e\xe6\xdc\xdc\x9e\xa5\xd1\xe3\xef\xbf\x7b\xbc\xd5\x93\x60\xd4\x97\x45\x7b\x11\xa6\x31\x68\xef\x1f\x1f\x74\xf7\xf7\x76\x01\xb1\xb3\x07\xfd\x5e\x6f\x7b\xb3\x69\x8d\xb4\x54\x67\x7a\x97\x2c\x50\x4e\x42\x0a\xd8\x25\xc9\xc8\x7d\x3a\xfd\x00\xf4\x57\x17\x97\x94\x8a\xca\xa2\x67\x55\x15\x00\x9a\x74\xc0\x2f\x47\x7b\x6f\x9a\x98\xaf\x44\x54\x4f\x9d\xc6\x1a\xdd\x4c\xa7\x94\xf4\x25\x57\xc9\xa8\x6d\x7a\x20\xdc\x20\xe1\x77\x01\x78\xb2\x09\x06\xa0\xe5\x48\xc4\xeb\x49\x47\x76\x2b\xb1\xd2\x21\xea\x73\xc7\x89\x8b\x2e\x84\xaa\x6f\x76\xfe\x71\xf0\xfa\x9f\x07\x47\x3f\x35\x71\x1d\xc5\xdd\xc0\x73\xd5\xfa\xbd\x98\x8f\x15\x5a\x1b\x2d\xb7\xb6\xd6\x47\x49\x2e\x5d\xfc\xec\x7d\xb7\x21\x6f\x92\xc8\x89\xd8\x49\x26\x68\xb1\xeb\x6d\x87\x47\x39\x91\xea\xce\xf0\x6a\x46\xbc\x49\xc0\x27\x16\x27\x96\xd3\x55\x20\xec\x79\xc3\xfa\x07\xaa\x67\x8a\xd9\xfe\xba\xbf\x60\xeb\xd9\x66\x00\xfa\x55\x77\xad\xdd\xb7\xe5\x1a\xcd\x0a\x8d\x04\xee\xe5\xe4\x98\xe7\x89\xb2\x7d\x0a\x5a\x6e\xd2\x36\x5c\x88\xfc\xfc\xeb\xbe\xec\xd7\x7d\xd9\xbf\xd6\xbe\xac\xd8\x8c\x2d\xaf\x5c\xf7\x3b\x09\x73\x0f\x67\x55\x36\xca\x6b\x15\x36\x5e\xdc\xe9\x56\x85\x86\x13\x2f\x1a\xec\xfe\x3c\xb4\x9e\x83\x98\xc3\x62\x1a\x80\x04\x19\xa7\x53\x48\xa1\x4f\xd4\x07\xc9\x04\xb1\xe5\x88\x81\x88\x1d\xdb\xe5\x71\x4d\xb9\xe5\x22\x28\x1e\xbc\x4d\x03\xbe\xc9\x3f\xd5\xe8\x2b\xf2\x39\x94\x01\x50\x54\x86\x17\xe2\x45\xfb\x33\x29\x31\x28\x71\x18\xe7\x6f\x2b\xc5\x3b\x69\xd2\x6e\x51\x0a\xb4\xca\x4b\x79\xdb\xc8\xb2\x51\x12\xa6\x49\x9e\x12\x19\xca\xaf\x19\x3c\xdc\x55\xae\x7d\x63\x49\x15\x5a\x01\xb0\x45\x4b\x7f\x62\xd2\x99\x47\x97\x19\x31\xf5\x5e\x2d\x4c\x22\xb5\x7d\x96\xe6\x79\x54\xa2\xbd\xe9\x76\xc1\xbb\xfd\xe3\x93\xbd\x0f\x74\x92\x2b\x97\xcf\x1d\xed\x1d\x1f\x1e\x7c\x38\xde\x3b\x6e\x6c\x54\x04\x0b\xa8\xb6\x8a\x3c\xdb\x56\xe8\xee\xb7\xd6\x8b\xce\xc0\x33\x8d\xc9\x2b\x1d\xa1\x1b\x40\x32\xf6\x90\xa0\x2e\x17\xdb\x4d\xcb\xd1\x6d\x79\xeb\xa0\x81\x10\x08\xaf\x49\x54\x1f\x73\xd9\xed\x52\x65\x89\x8a\xca\x08\x5c\x4e\x71\x38\x05\x33\x58\x84\x53\x6e\xfb\xb0\xa5\x96\xc8\xe6\xc8\x5a\xde\x92\xca\xb3\xf1\x5a\x62\x1e\xf3\x60\xf4\x86\x46\x0b\xd6\xdf\x66\x8c\xc7\xed\x4f\xf6\x39\x75\x4a\x4a\x77\x70\x74\xe6\x4e\xec\x27\x91\x10\x9a\x70\x70\x7b\x28\x27\x70\x04\x99\x81\xa6\x0d\x60\x89\x9e\xf7\xd1\x55\x81\x23\x56\xb4\xdb\x05\x63\x9c\xb1\x7d\x87\xd0\x96\xc1\x4b\xd6\xe1\xa6\x03\x21\x41\x4d\xeb\x6a\x8a\xf1\xeb\x99\x6b\x83\x6a\xc9\x5f\x84\x62\x54\xa0\x3a\x4c\xae\x4e\x1b\x6f\x3c\x2f\x64\xd6\x54\xfe\x9f\x89\x46\x40\x67\x0a\xe7\x50\xd6\x00\xea\x22\x66\x81\xe6\x09\x20\xab\xfd\x35\xe0\xb7\x48\xe2\xa2\xa3\x90\x5b\xe8\xfa\xfa\x64\x53\xbe\x57\xdc\x9f\x64\x16\xda\xb5\xfb\x72\xa9\x50\x14\x58\x1d\xad\x9a\x2d\xc6\x26\x29\xaa\xab\x87\xb9\xd9\xcd\x26\xe0\x3f\x58\xfe\x0f\x96\x14\xa9\x2a\xd2\x5e\xed\x3d\xde\x7d\xfb\xf1\xc3\x4f\x7b\x47\x06\xee\x08\x85\xd3\x45\x72\x8e\xa2\x57\xb0\x80\x60\x58\xe9\x0c\xff\xeb\x64\x68\x1e\xc3\x10\xb5\xbb\xff\xba\x3d\xfd\x57\xf2\xaf\xec\xec\xc7\x7f\x7d\xee\x4e\x82\xd6\xed\xcd\xe3\xc7\x37\x9f\x5b\x9b\x34\xf5\x93\xc9\x52\x6a\xb9\x7f\x9d\x89\x92\xa7\xbc\xec\x19\x29\x7c\x2a\x4a\x9f\x9d\xd6\x97\xaf\x96\xd6\x0a\x37\x94\x2d\xeb\x56\x6a\x96\x15\x5b\xca\xf2\x18\x15\x02\xd6\xaa\x2e\x44\x1a\xc5\x4c\x19\x45\x07\xd1\x22\x6f\xbb\x5d\x1a\x08\x84\x12\x76\xb5\x52\x0c\xf3\x02\x50\x44\x06\xa4\x9c\xba\x04\x66\x97\x80\xd8\x25\x56\xc4\x46\xac\x02\x0b\x1e\xd1\x0f\x16\x69\xae\x45\x9c\x39\x24\xbe\x3b\xc2\xde\x16\x5d\x4f\xfb\x6a\xc1\xc2\x23\x6a\x59\x40\xad\x15\x5b\xb5\xd1\x43\x57\xa3\x39\xe9\x68\xee\x22\x79\xda\xb3\x48\x89\x15\x14\xa2\x98\xa6\xbe\x11\x77\xc2\x5b\xcb\x86\x31\x82\x99\x08\x67\xaf\xd4\xca\x5f\x3b\x04\x99\x1d\x18\x0c\x41\x8e\x0a\x81\xb0\x4e\xf5\xd0\xf1\xb8\x54\x10\xf5\xaf\xf6\x5c\x0e\xa7\xb5\xad\xdc\x6d\x00\xb6\x7a\xbd\x1e\xf8\x96\xed\xb8\x3b\x86\x8f\x48\x09\x5b\xd8\x91\x8d\x53\x39\x75\x05\xbd\x89\xe0\xcc\x11\xe7\x57\x96\xa6\x39\x8e\x4d\x55\x70\x25\x52\x9b\x9c\xe0\x60\x4d\x9a\x30\xbd\x72\x0a\xc7\xe8\x20\x13\x83\x9d\xf9\x22\x9f\x3a\x4e\x46\x39\xb6\x52\xd5\xe2\x8e\x94\x5f\xda\x1a\xf3\x86\xeb\x44\x30\x8a\x72\x62\x97\xc9\x43\x44\xa9\xb6\xe6\x94\x1a\x3d\xdb\x6c\x53\xc4\x37\x53\xa5\x84\xf5\xca\x5d\x53\x98\x25\x72\xe4\x6b\x03\xd5\xb3\x44\xb0\x80\x7c\xbb\x1f\xb1\x52\x30\xcb\xf0\x05\xb2\xae\x5c\x30\x92\x8c\xb3\x6b\x68\x09\xcd\x2b\x94\xa5\xb8\x96\xb1\xd1\xe7\x0c\x13\x66\x11\x21\xd7\x5c\x8d\x02\x37\x37\xe2\xe9\xb4\x77\x66\xa8\x3d\xec\x12\x6c\x7e\x41\xa7\x28\xc5\x5f\xe8\x25\x67\xfc\xf6\x4e\x0f\x2b\x8a\x28\x1a\x60\x28\x5b\xe9\x63\x78\x91\x22\x1d\xd9\x90\x59\xe5\xa6\x50\xa7\xc6\x21\x84\x83\x2a\x8e\xc0\xe5\x94\xbb\x18\x50\x12\x31\x73\x92\x7a\x1d\xe8\xde\x27\x51\xe2\x22\xdb\xc8\xf1\x29\xb7\xcc\x68\x99\xc7\x7d\x0c\x7f\xd8\x38\xcd\xda\x84\xc4\xe7\xe8\x9a\xf9\x50\x6c\x7d\xb7\x1f\x0e\x6b\xdb\x81\x3b\x53\x98\x1f\x5c\x26\xe2\xa6\x54\x9a\x23\xca\x21\x04\x1d\x94\x3e\x47\xd7\x67\xee\x03\x3c\xad\x34\x01\xfb\x87\xbb\xad\x6a\xec\x84\xf8\xe3\x2a\x66\x0d\xee\xc6\x10\x4b\x8f\x59\xbe\x2b\xf6\xc9\xa8\xbe\xcd\xd2\xde\x01\xc5\xca\xc6\x39\xc8\x0b\xcc\xd2\xaf\xe1\xc8\x36\x15\xed\x67\x80\x9a\xc6\xd4\x7e\x5a\xc7\x18\x56\xd3\xb5\x50\xd5\xfc\xc8\x7a\x9e\x21\xde\xe2\x80\xb1\xa4\xde\xfe\x49\xaa\xe4\xa2\xe0\x43\xfe\xe0\x53\xd5\xb0\xbd\xcc\x70\x01\x47\x31\x32\x65\xaf\x01\x5a\xf1\x39\x7c\x72\x3b\x1d\xb8\xe8\x7d\xf0\xc0\x55\x9b\xc3\x21\x6c\x25\x59\xdd\x89\xe3\x6a\xff\x6c\xf5\xa1\x63\x47\xd2\x81\x52\x6d\xb2\x2c\x4a\xab\x91\x58\x90\xd9\x9f\xca\xc0\xe2\x6c\xf0\x76\xee\x88\x5e\x70\x85\xd1\xd9\xfb\x9a\xf3\x79\xeb\x51\x14\xab\xa0\x9a\x9e\x68\xef\xf2\xf2\x1a\x91\x45\xa3\xa9\x9c\x72\xd0\xc5\x80\xcb\x5a\x37\x52\x83\xfe\x33\x5d\xd0\xb3\x80\x65\x02\x95\x8d\xd6\xa3\xea\x62\xf5\xa8\xb5\x41\x4f\xe7\xcb\xe3\x83\x1d\xf5\x34\x61\xb9\x38\x88\x3b\xf7\xf3\xc5\x7c\x9e\x66\x05\x75\xb4\xb3\xc0\xa4\xc3\x5d\x79\x83\x7e\xa7\x9a\x05\xc1\x7e\xe4\xcc\x3d\x21\x56\x38\x7f\xbc\xba\xd0\xf0\xe7\xe6\xa5\x38\xb9\xd6\xe1\x46\x79\xd7\xf3\x5c\x29\xd3\xc3\x2d\xba\x8d\x85\x28\xbe\xfb\x45\x25\xe5\xad\x79\xd5\xb4\x14\x6a\xf2\xbc\xdf\xed\x59\xf0\xfd\xd7\x9d\xa3\xaf\x3b\x47\x7f\xc9\x9d\x23\x7e\x0c\xf8\x5e\xf2\xa7\xed\xc0\x2c\x4d\xc0\x4f\x8b\x19\xbc\xc0\x39\xf8\x01\x92\xc7\xff\x39\x67\x8f\x9d\x19\xf2\xda\x5d\xea\x76\xc1\x7e\x82\x0b\x0c\x63\xfc\x6f\x04\xfe\xce\x5a\x4b\x18\x1b\x82\x9c\x86\x62\x8b\x48\x58\x4a\x18\x32\xc1\x71\x71\x2d\x05\x6c\x5e\x86\x71\x93\x15\x57\x14\xaf\xe6\x2a\xe5\xc9\xf9\xf7\xa3\x01\xe8\x35\x5b\x17\xf6\x10\x06\x16\x3e\x49\xc8\x29\xe2\x74\x45\xbe\x76\xaf\xb8\xce\x22\x3d\xf4\xc9\x8e\x21\xee\x09\x18\x8b\x81\xa3\x2d\x28\x23\x72\x6d\xa5\xa9\x9f\xff\x96\x9f\xcf\x0e\x14\x6f\xeb\x58\xa0\x13\x9f\xd8\x64\x86\xb1\x86\xc5\xb8\x56\xa6\xec\x29\xa9\xdf\xd9\xcb\x92\xdb\x38\xd9\x3b\xb2\x8f\xda\x12\xc8\xda\x10\xf0\x46\x58\x93\x73\x33\x10\x73\xe1\xaa\xec\x76\x89\x26\x19\x51\xb8\xf2\x7c\xd5\x03\xc3\x7d\xd8\xed\x02\x18\x5d\xc0\x24\x44\x82\x0b\xc0\xfe\x2b\x0d\x42\xb4\x5e\x32\xc9\xa3\x47\x76\xc5\xd9\xd4\x59\x9a\xce\xd8\xe3\x68\x60\xa2\x37\xc1\xc4\x31\x7c\x4e\x29\xe3\xbb\x38\x79\xcf\xfe\x25\x06\x7a\xe5\x0c\xfe\xed\xdd\x23\x13\x05\x6d\xa5\xdb\x43\x44\x0c\x37\xf0\x35\x8f\x7d\x35\xdd\xf6\x9e\xb7\x59\x58\x32\xa9\x55\x1a\xe0\x17\xc7\x28\xc8\x5c\x69\x4f\xe5\x4a\x42\xf6\xd2\x11\xbe\x42\xe7\x91\xba\x6f\xc6\x81\x7f\x94\x6d\xea\xa0\x0b\x94\x5d\xb7\x69\xb3\x60\x81\x8e\x71\x32\x89\xd1\x7b\x36\xb0\x9b\x60\x00\xac\x1f\x4a\x4c\x15\xb6\x92\x0d\xb3\x17\xe3\x0c\xe3\xbe\x2b\xe1\xc1\x03\xc1\xd1\x96\xa0\x60\xf2\x27\xbe\xb3\xf9\xe3\x82\x12\x40\x82\x03\xe8\x2d\xf6\x84\x9f\x5d\x05\x78\x16\x49\x51\x0e\x47\xac\x08\x0b\xd5\x71\x96\x12\xe0\xdc\x5e\xd1\x73\x62\x93\x69\x4a\x2f\x86\x91\xaf\xc8\xf0\x13\x85\x5d\x11\xbb\x55\x09\xe4\x9d\x5e\xc4\xc6\xf7\x4c\x84\x8f\x68\x26\x8a\x25\x05\xf9\x4b\x52\xa8\x46\x9a\x73\x79\xcc\x3b\x5c\x91\xc8\xac\x8e\x9c\x2d\x67\x1c\xa5\x50\x49\xa3\x4d\xea\xfa\xe5\xd3\xbc\x2d\xa4\xf5\x26\x18\x63\x14\x47\x8e\x28\x38\x5e\x9b\xd6\x93\x06\x29\xad\x76\xa0\x22\xaa\x59\x93\x1d\x13\x44\x7c\xd6\x4e\x12\xcb\x52\x35\xd9\x3c\x8c\x05\x42\x94\xe9\x88\xc5\x41\x3c\xf3\x45\xc2\x6f\x17\xd2\xb0\x0f\x78\x3d\x8a\x6d\x70\x7b\x16\x6c\xf5\xbe\x2a\xff\x5f\x95\xff\xbf\x96\xf2\xaf\x89\x0a\xca\xc6\x6c\xa2\x7d\xa9\x84\x1e\xeb\x0d\x08\x53\x41\xdf\x8b\xcd\x82\xc6\xb3\x61\xd4\xff\x90\xc0\x19\x52\xae\xb2\x20\x8f\x16\x2f\x05\x59\x17\x14\x28\xf2\x68\x8d\x06\xa3\x72\xb9\x84\x2b\xf5\x31\x5b\x32\x5c\x9a\xca\x5f\xbd\x7f\xa3\x3c\x8f\xa6\x7e\xb0\x94\x64\x49\xe5\x6d\x45\x2b\x5f\xac\x5b\x2c\xd4\xe3\xf5\x1e\x26\x70\x82\xca\xfc\x6e\x36\xf1\xc9\x48\xa9\xb9\xb0\x44\x36\xce\xb2\xb8\xa2\x3d\xcd\xac\x14\x36\x2a\xcc\x66\x2b\x1e\x7f\x8c\x10\x91\x80\x38\x41\x80\x8e\x1a\x9f\x3f\x15\xa5\x7f\x04\x73\x96\x31\x10\x66\x93\x05\x3d\xa6\xdb\xb0\x5e\x4f\x10\xbd\xff\xa1\x66\xa1\xb6\x61\x2a\x4f\xd3\x89\xb0\x13\xd1\x26\xbd\x3d\xd6\x95\xd6\x20\x2c\x6f\x83\x7e\x80\x3c\x9b\xb8\xd6\x59\x11\xc0\xf5\x5a\x5e\x9a\x25\x98\x54\x86\xe7\x93\x07\x8e\x62\x50\xbe\x59\x91\xf0\xe8\x8a\x5f\xa9\x22\x36\x1f\xe9\xd1\x7c\xa9\xb2\x48\xfa\x74\xc0\x7b\xb2\x74\x61\x94\xf3\x7b\x72\x28\x1d\x1b\xe8\xcf\x91\x5b\xf6\x45\x97\x1b\x07\x41\x8c\x9b\x0f\x8b\x38\x2e\xd3\x48\x06\xc4\x66\x40\x57\x58\x0b\x48\xa8\x1b\x8b\x4a\x7b\x1a\xc7\x44\xbd\x13\x4b\x0e\x08\x01\x3c\x25\xff\x11\xe7\x9e\x1e\x83\xad\xb3\xda\x5b\xb7\x08\xec\x3c\x9d\xb7\x59\x6a\x71\xa6\x02\xb0\x1d\xed\x6c\x92\x33\x5a\x3f\x58\x93\x6a\xab\x6e\xa2\xf1\xb3\x66\xea\x28\xfa\x9f\x9f\x14\x76\xc9\x4e\x36\xb1\xa6\x5b\xa8\x1d\x37\xea\xcf\xcf\xc1\x67\xea\xcd\xbf\x2d\x8f\xa1\x26\xa9\xdd\x89\x60\x0c\x94\x5a\xbb\xd7\x28\xa9\xe3\x41\xcc\x0b\x45\x68\xfb\xa6\x65\x65\x27\x30\x0e\xc6\x47\x87\xbb\x87\xb4\xa0\x99\x7b\x7a\xc5\x51\xe1\xf7\xf2\xb1\x79\x43\xc7\x5c\xba\x67\x1a\x46\x81\x95\xdc\x27\x05\xdd\x83\x60\x9d\x32\xc6\xa7\x1a\x6a\x2b\xb5\x78\x11\xfb\x81\x65\x8d\x6b\xe0\x7f\x9f\x53\x29\x16\xac\x15\x6b\x63\x2c\x2f\x59\x01\xf4\x72\xd0\xba\x24\x82\x72\x05\xfd\xb1\xfc\xcd\xe6\x2e\x2d\x7a\x46\x84\xa7\xf2\xb8\x42\xec\x63\xed\x60\xb3\xd5\x5a\xde\x4a\xb7\xdc\x80\x1f\xd0\xc2\xcb\xf9\x53\xea\x2f\x07\x75\x8c\x39\xab\xa8\xea\x28\xb1\x5c\x96\xa7\x0e\x51\x55\x45\xa1\x89\x6b\xa9\x61\xff\xa3\x15\x40\xa2\x1c\xac\x90\xb8\xb8\xe2\x6f\xa5\xab\x93\x48\x8a\x20\xa6\x53\xa3\xd5\xde\x6c\xb0\xc3\xaa\x98\x5b\x89\xa8\x76\x27\xa8\x6d\x1a\xd1\xbc\x14\x4c\x2d\xa0\x24\xe3\x5a\x02\x83\xb5\xa4\xb0\x28\x57\x2c\x0a\x5e\x59\xc8\x5c\xc5\xa4\xd6\x4a\x0b\x29\xd3\xdc\x56\x80\xc2\xa8\x82\xb7\x2d\x0d\x72\x1b\x2b\x98\x33\x4f\xb8\x32\xa9\xd7\xda\xf8\xaa\x3b\x32\xcd\xef\xa2\x8b\x03\x7b\xb0\xb5\xc3\xcd\x69\x0c\x01\x2c\x0a\x18\x4e\x4f\x52\x36\x6e\xba\xad\x30\xfa\xcd\x36\x0c\x04\x40\x90\x68\xb4\xc0\x71\x44\x07\xa2\x42\x1c\x02\xd4\x51\x07\x8c\xaa\x5c\x64\x39\x3f\x39\x78\x75\xf0\xe0\xc1\x03\x6a\xec\xb6\x72\xb0\x98\xc4\xd7\x1d\xc0\x6e\x8c\x23\xe6\xf1\x22\x27\x1a\x53\x61\xd4\xca\x8d\x13\x69\xa8\x88\x38\x58\x63\x1f\x9a\x08\x5c\x0a\x20\x93\x68\x59\x33\x42\xa7\xa3\xdf\x4e\x09\xd8\x69\xef\xec\x8c\x18\x0d\xea\xe3\xcd\x8d\x71\xc4\xa5\x5a\x84\xfd\xd8\xa2\x65\x49\x5f\x3d\xaf\x6e\xa8\xd4\x6a\x29\xe9\x37\x6a\x92\xee\x4d\x21\x38\x32\x5e\xcc\x3c\xde\x43\x3e\x1b\x91\x29\xae\x28\x93\xf2\xbe\x5d\x6e\x1c\x97\xee\x29\xe6\x0a\x56\x2c\x23\x62\x6a\x4b\xa5\x9b\x69\x3b\xb6\x50\x29\x32\x50\x22\x42\xc1\xb5\xe3\x2f\xfe\xa4\x53\x8d\xd6\xad\x9b\x51\x65\x34\x41\xb9\x59\x5e\x76\x48\x4d\x91\x5e\x73\xb2\xa0\xda\x10\x56\x8e\xd7\xa7\xca\x7e\x79\x46\xc4\x11\xef\xda\x98\x1b\xdb\xec\x8e\x86\xde\xdd\x45\x67\x4e\x6a\xfd\x91\x80\x76\x2a\x59\xda\x45\xa6\xeb\x11\x4e\x22\x6a\x20\xd9\xd3\x8f\x90\xa2\xab\xef\x8e\xf0\x85\x67\x91\x21\x1a\x5e\x73\x74\xb8\x2b\x93\x45\xb3\x78\xd2\x10\x26\xd2\x92\xc2\x09\xf7\xc2\xfa\xa5\xab\x36\xa1\xe4\x82\xd4\xe9\x74\xc4\x7e\x5e\xdd\x82\xa4\x6c\xda\x54\x51\xd5\x2c\x50\x99\xe5\xd6\x18\xdb\x34\x2b\xa7\x07\x4b\x4b\x7e\xa7\xc9\x21\x78\x91\x6b\x46\xda\x6a\xc4\xb8\xa4\x69\x20\x8d\xbb\xba\x6b\x3d\xc1\xef\xd5\xb0\x55\xbf\x18\x91\xad\x2d\x1f\x3f\xb1\xdd\x19\xa5\xfa\xb9\xd8\xf0\x56\x5d\x5c\x52\xff\xac\xba\xc5\xca\x0f\xd5\x22\x36\x27\x9a\xe9\x47\xd3\x8a\xec\xec\xee\x1e\x7c\xfc\x70\xa2\x8d\x6d\x94\xa1\xb9\x3d\xc5\x9f\xe1\xba\xa1\xae\xc7\xca\x6b\x4b\x76\xae\x1c\xc5\x63\x7b\x08\x24\xeb\x7b\xde\xb6\x1c\x8d\xe3\x7b\xbc\x4e\x9d\xa1\xb2\x6a\xb7\x49\x25\x16\xc1\xc3\x81\x0d\x57\x55\xdb\xd6\x76\xcf\x7d\x84\x72\x51\x31\x73\x01\xb8\x34\xb6\x04\x5d\xf2\xe1\xb7\xe4\x68\x27\xcb\xe1\x00\xb4\x08\xa8\x65\x57\x98\xbc\x1e\x80\x16\xcf\x46\xf7\xc9\x01\x25\x74\xa5\x67\x96\x6d\x65\xcd\x3e\x1a\x80\xd3\x92\x87\x3a\xf6\x7b\x47\x41\x33\x04\x3b\x6b\x66\x5c\x2b\xeb\xf7\xea\xcc\xa0\xb3\x49\xb7\x38\xcd\xd1\xcf\x44\xb4\x16\xbe\xe4\x2b\x4b\x78\x50\xb1\x16\x58\x10\xb3\xe7\x41\x4c\x9f\xbe\xd0\x75\x81\x67\xf2\xf3\xed\x8d\x5a\xc6\xa3\x3f\x0d\xe0\xf7\xd2\x23\x9e\x94\xcf\xbf\x3b\xa4\x80\x6f\x5f\x1c\xb0\xa2\x23\x4f\xd7\xc2\xe7\xf7\xce\xc5\xb4\x2b\xcb\xb1\xb1\x52\xc4\x97\x56\x8d\x8c\xbc\xe5\x41\x2d\x42\x0b\x8f\x2e\x45\x8b\xd9\xfc\x30\xc3\x17\xe7\xe8\xda\xb3\x47\x4a\x89\xe6\x0e\xd5\x03\x2f\xd3\x9f\xa6\xd1\xf7\xe8\xeb\x04\xe6\x87\x19\x0e\x7d\x99\x5c\x80\x37\xf7\xb2\x06\x72\xcd\x33\x75\x82\x8a\x25\xa6\x29\x87\xf6\xe8\x80\x13\xf0\x0b\x0f\x51\x8c\xf3\xa2\x4c\xb8\xeb\xd3\x47\xa5\x44\x73\x3f\xeb\x81\xd7\x3c\x56\x71\x1a\x9e\x2f\x25\x2b\xca\x02\x1e\x3d\xa9\x83\x5d\x73\x47\xd2\x39\x4a\x96\xea\x48\x59\xa0\xb9\x23\xb5\xb0\xf7\x20\xf0\x72\x54\xec\xc4\x18\xfa\xb2\x97\x00\x6f\xee\x48\x0d\xa4\xe8\xc6\x93\x2f\xbc\xca\xf9\x90\x03\x4f\x12\x5f\x52\xe0\x49\xe2\x41\x06\x3b\x94\x20\x41\x7f\x6d\x24\xf0\xe8\x1c\xbb\xec\x82\x6d\x84\x7b\x74\x50\x80\x37\x77\xb2\x06\xf2\x4b\x6b\xee\x2b\x6a\x3b\x4e\x1a\x1a\x44\xe4\x47\xfb\x4f\xae\x3c\xa9\x28\xe1\x9b\xc9\x58\x07\x2a\xe8\xf8\xdd\x9f\x81\x8e\xab\xcd\xbd\x09\x2a\x4e\xae\xf6\x93\xc3\x34\xf5\xb5\x29\x95\x12\x5e\x8b\x7a\x0d\xf0\x3d\x88\xd6\x45\xf2\x6e\xd9\x25\x4f\x2d\xd2\xdc\xa3\x06\xe8\x7b\xe8\x12\x9e\xcd\xd3\xac\xf8\x09\x5d\x1f\x17\x69\xe6\xab\x76\xe9\x85\x9a\xbb\xd5\x08\xbf\x8c\xf0\x64\xec\xb8\x54\xf7\x96\xd3\xff\xb5\x32\xbe\x9d\x5b\x8f\x0d\xe0\xd9\x2b\x7e\x8f\x00\xe2\x93\x1c\xf9\xae\xf5\x46\x39\x9f\x39\xd6\x5c\xe4\x7e\x55\x68\xf5\x99\x3b\x4b\x4f\x95\x99\x1f\x94\xf2\x9b\x9d\xb8\x0b\x14\x5f\x49\xa0\xf9\x19\x82\xd2\x52\x0f\x54\x43\x34\x50\x8c\xb8\x40\x98\x3a\x01\x37\x19\x02\x45\xa5\x0e\x4a\xa5\x34\x28\xd5\xba\x40\x28\x46\x01\x51\x0d\x02\xb1\x74\x06\xea\x64\x0e\xf4\x29\x10\x68\x4c\x13\x18\x44\x2e\xa9\x70\x5b\xe3\x05\xe6\xee\x50\xdd\x0d\x6c\xf5\x04\xab\x7e\xd8\x8d\xc1\x53\xfa\x86\xb9\x04\x37\x06\x5b\xbd\xdb\xb3\x60\xab\xff\xe7\xf7\x0e\xbf\x7c\x77\xb0\xfb\xd3\xfb\x37\x47\x5f\xdd\xc3\xf7\xe7\x1e\x5e\xbb\x87\x81\x5e\x5a\x34\x9b\x64\x5f\xd4\xc5\xc0\x66\xdb\x09\x9f\xa8\xf4\x5f\x58\x26\x3a\xf4\x97\xa3\x16\x0c\x3e\x3d\x5d\xaa\xf4\x7a\x1d\x88\x2b\x2b\x81\x2b\xaa\x7f\x44\x42\xbf\xc7\x09\x3e\x44\x49\x84\x93\xc9\x87\x34\xf1\xe7\x1d\x7b\x69\x2f\x02\xfb\x96\xfc\xd2\xfe\x39\xd6\x30\x65\xc0\xfd\x57\x6e\xa3\xa4\x3f\x21\x1a\x4a\x7d\x61\x22\xe4\x28\x89\x8e\xe0\xe5\xf2\xb3\xce\x2c\xe8\x41\x02\xaf\x42\x6b\x56\xd1\xa4\x96\x52\xaa\x12\xce\x19\x1f\x38\x98\x35\xb0\x8c\x5d\x60\x76\xc6\x4f\x51\x10\x2b\xe3\x5a\x34\x85\xed\x3f\xbf\xa6\xb0\xfb\x76\x67\xff\xc3\x57\x35\xe1\x1e\xd5\x04\x24\x24\xc3\xcb\xeb\x65\x9c\x91\x46\x39\xf7\x0c\x0f\xa7\x10\x27\x9f\x7c\x0a\xdc\x8b\x01\xc6\x34\xfe\x97\xd7\xcb\xf9\xf2\x8d\x72\x3e\xfd\x6b\x2a\xf0\xe5\x17\xb1\x97\x30\x86\x4b\x2d\xe4\xbc\x80\x47\x6f\xdd\x90\xbf\x43\x37\xf9\xb5\x9d\xbe\x9d\x24\xe0\x3e\x5d\x74\xc0\x2d\xd3\xc1\x15\xd5\xb1\xf7\xf0\xea\x2d\xc2\x93\xa9\xaf\xf7\x4a\x2d\xe2\xd1\xb3\x3a\xd8\xf5\xef\x13\x2e\xa9\x4c\x36\x68\x8f\xb2\x13\x7f\x20\x5d\x31\x43\x17\x38\x5d\xe4\x94\x61\xde\xc2\x7c\xba\x84\xb2\x58\x2d\xea\xd1\x71\x9f\x32\xeb\x1f\xc5\x23\x24\x6f\xf9\xf6\xee\x5d\x59\xc6\xa3\x5b\xb5\xc0\x5f\x7e\x50\x15\xe5\xed\xe5\x35\x23\x35\x9d\x32\x3b\x49\xb4\x9f\x44\xc8\x77\x13\xc2\x03\x93\x07\x6d\x56\x40\xb1\x8c\xab\xd6\x7f\x53\xa6\x9e\x50\xbb\xe9\x22\x29\xb4\x06\xae\x44\x25\x13\xcd\x72\x24\xf2\x2a\xff\x05\xa4\xb8\x6a\x13\xbc\xce\xd2\xd9\x92\xeb\x94\xb5\xf8\x72\x94\xa8\x2d\x77\x9f\x14\x50\x1c\xc0\xba\xde\x17\x18\x9a\x52\x50\x6a\x13\x81\x58\x75\x03\x75\x91\x0a\x84\xb0\x0f\x6c\xc2\x2f\xd0\x44\x47\xe0\x31\x59\x82\x26\x6e\x09\x5c\x44\xf4\xb3\xe2\xa8\xd5\xb2\x16\x13\xee\xc9\x7f\x80\x09\x77\xf0\xe1\x78\xef\xc3\xf1\xc7\xe3\xaf\x66\xdc\xfd\x99\x71\x33\x9c\x24\xec\x72\x2c\x1f\xd1\xc2\xa1\x6b\x24\x49\x9a\xe4\x28\xc9\x17\xf9\x27\x37\xe8\x9a\x15\x8c\x99\xbf\x64\x9c\xd5\x4b\xc1\xb2\xed\xf5\x52\x6f\x0d\x0d\x17\x42\x8e\x53\x29\x98\xf9\x8b\x08\x31\x2b\xd6\x22\x26\x9e\xfe\xf9\xc5\xc4\xbb\x83\x37\x5f\x05\xc4\xfd\x09\x88\x9c\x2c\xb1\xe1\xf9\x49\x06\x43\xb4\xe3\xab\x94\xe9\x85\xdc\x53\x2e\x4e\x27\x9f\x1a\x61\xef\x27\x80\xee\x1d\xba\x40\xbe\x21\x2b\x02\xbc\xb1\x23\x2e\xa8\xfb\xe9\xc2\x3f\xd8\x4c\xf5\xef\x04\x2f\xd0\xd8\x0d\x37\xdc\x3d\xb9\xd1\x75\x1e\x08\x04\x25\x83\xb2\x2d\x7e\xc2\xf1\xdd\xc1\x9b\xb5\x88\xc5\x67\x7f\x7e\xb1\x78\xd8\x3f\xfc\x2a\x16\xef\x4f\x2c\xc2\x28\x3a\x44\x6c\x08\x7d\x26\x9f\x00\x77\x4f\xbd\x79\x7f\xfe\xa9\x06\xea\x7e\x3c\xdc\xcb\x74\x41\x80\xd7\x77\xa1\x06\x6a\xcd\x7a\x5f\x86\x66\xe9\x05\x5a\xa6\x07\x4a\x89\xfa\x4e\xd4\x03\xde\x93\x0c\x14\x83\x1f\x08\x12\x06\x4a\x33\xfc\xc4\xdf\x61\xff\x70\x2d\xe2\xef\xbb\x3f\xbf\xf8\x3b\x39\xda\xd9\xdd\xfb\x2a\x00\xef\x4f\x00\x46\x28\x4c\x23\x74\x92\xc1\x3c\x59\x6a\x8b\xdf\x28\xe7\x9e\x8a\x05\x51\x07\x3e\xf9\x14\xb8\x1f\xe9\xb8\x52\x08\x83\x51\xae\xa9\x7f\x3e\x05\xee\xa9\x7f\x2c\xdf\xa3\xe6\xf4\xda\x89\x22\xdf\xa3\x06\x35\x18\x7c\xfa\xec\x5f\x54\xf4\x7e\x7b\x8d\x47\x4f\xfc\xa9\x74\x8c\x92\xe8\x0e\x24\xb2\x16\xf7\xa0\x8f\x6f\xb9\xdf\x97\x38\x2b\xcd\x8f\xe5\x26\xc7\x7d\xcf\x8c\x6a\xaf\x32\x44\xf3\xb8\x78\xeb\x14\x14\xba\xa9\x1f\x6e\x30\xb9\xd9\x52\xdb\x30\xa1\x24\x18\xc2\x30\x30\xc4\x47\x50\x33\xb9\x02\x17\x63\x55\xfc\xd6\x81\xa0\x82\x9f\xde\x41\x17\xdb\xb5\x68\x1e\x5f\x2f\xc8\xff\x9a\xe9\xf8\x2f\x9f\xe9\x78\xce\xef\xc8\x73\xe4\x3a\xae\xbb\x19\x7f\x9c\xa5\xff\x46\x33\x98\x74\xa2\xca\xe5\x25\xbf\x63\x9e\x64\xdd\x2d\xc1\xfb\xb6\xe6\xfc\xc7\x13\x54\xc9\x33\xcc\x5e\x58\x20\xf3\x2a\x64\xee\x82\xbc\x4b\x12\xe3\xd5\x53\x27\x2f\x91\xfe\x58\xd0\xf2\xf7\x4f\x80\xfc\x87\xcd\x12\x6a\x21\x51\x4d\x9e\xd0\xba\x7c\x91\x95\x11\xfd\xd1\xf6\x96\xe1\xa0\x19\x39\xff\xc3\x32\x6f\x3a\xe9\xb8\xe6\xdc\x9b\x0f\x86\x8c\xe1\x2b\xaf\xca\x4b\x35\xee\x21\x39\xe7\x5f\x24\x8f\xb4\x65\x08\xff\x9c\x99\xa4\x1b\x86\x93\xf9\x4f\xca\x9e\x14\xa9\xdf\xd4\xd1\xfd\x2e\xbe\x93\xa7\x7a\xff\x95\x2f\xe9\x97\xcf\xf0\x49\xcb\x1a\x17\x83\x91\xbf\x09\x2a\x06\x4a\xe6\xcf\x37\xa8\x68\x6f\x9a\x86\x06\x4a\x16\x33\x94\xc1\x51\x8c\x06\xf4\xaa\x22\x9d\xc2\x16\xf3\x8e\x2c\xb5\xb9\x57\x76\x4f\x25\x15\x28\x2d\x74\xda\x3b\xb3\xa7\xff\xcc\x3d\xf3\x7f\xe6\x95\x04\xa0\x79\x43\x06\xd0\x2a\xa8\x09\xa4\x35\x6f\xab\x9a\x28\x59\xef\x3c\x1b\x93\x0e\x13\x39\xf2\xc2\xe2\x74\xf4\x5b\x40\xcb\x07\x6c\x28\x2a\x34\x20\xf5\xc3\xfc\x3a\x09\xdf\x50\x7d\xe2\x03\x9c\x21\xda\xe5\xcd\x33\x2d\x2d\xeb\x0e\x07\x69\xd7\xf8\xe0\x2a\x68\x34\xe6\xa0\x28\xed\xa2\x95\x98\xd6\x2d\xf0\x88\xb6\xb1\x13\x4e\x61\xb6\x53\xb4\x7b\x9b\x9d\x22\xfd\x38\x9f\xa3\x6c\x17\xe6\xa8\xbd\x29\x3e\xd3\x8c\x87\xed\x2d\x47\x23\x2c\xfc\x2a\x38\xab\x39\xe9\x62\xa9\xe0\x99\xd9\x4d\x45\x5a\x6b\x81\x61\x42\x08\xe1\x96\x14\x52\x11\xd6\x92\x71\xca\xb7\xb6\x74\x9c\xf6\x64\xa2\x22\xa9\xaf\x2c\xca\xd4\x43\x03\xf6\xb6\x26\x8d\xa7\x0f\x81\xc4\xe8\xde\x8d\x4a\xdc\xb7\xa2\x21\xa9\xcb\xc5\x5a\x47\x10\x96\x82\xf5\x8e\x54\x59\x32\x75\xab\x9e\xae\xd5\x3e\x88\xee\x84\xad\x55\xd7\x47\x65\xc6\x4f\x50\xb1\x62\x22\xd5\x09\x5a\x56\x2d\xf8\xcf\xca\xa3\x6a\x61\xda\xe5\x33\xa9\xba\x13\x66\x2b\x76\x98\xdb\xcb\x75\x7a\x66\x77\x94\xb1\x9c\xd9\xd6\xac\xe0\x7e\xc9\x5a\xeb\x58\xe6\x4b\xa7\x6e\x15\x84\xae\xbf\xe1\xf7\xf6\x2c\xd8\xfa\x7a\xab\xef\x57\x77\xd7\x5f\xde\xdd\xc5\x85\xd0\x8c\xaf\x5a\x76\xa7\xd7\xdf\xd1\x78\x9c\xa1\x6b\xf0\x33\x8e\xc3\x73\x04\x7e\xf8\x0d\x8d\xc7\x4e\xbf\xd5\x4a\xce\xae\xb2\x10\x86\x09\x38\x80\x49\x88\x20\x2d\x83\x61\xd2\x54\xa8\xce\x25\xd7\x50\xf4\x0d\xbc\x00\x3f\xa7\x69\x04\x7e\x98\x34\xfa\xe1\x9e\xb8\xfd\x70\xe5\xc5\xc1\xca\x0d\x64\x7c\x55\xf0\xd9\x4e\xaf\xb9\xdb\x2c\xb4\x82\x86\x69\x32\xc6\x93\x15\xef\x41\xab\xf0\xc1\x7e\xd1\xca\xc5\x15\xa5\x78\x14\x33\xbe\x9e\xc3\x3c\xc7\xc9\x44\xde\xd9\x48\x96\x61\x71\xcf\x7d\x6e\x94\x86\x71\x9e\x9a\x28\xd2\x38\x26\x28\x08\xb3\x23\x22\x60\xd0\x62\x06\x92\x34\x62\x5f\x71\x12\xa6\x33\xb5\x06\x15\xe9\x2b\x34\x86\x8b\xb8\xa0\x28\x40\x81\x67\x88\xcc\x79\x9c\x83\x2d\x90\xa3\x30\x4d\xb4\x5b\x38\xd8\xad\xa7\x45\x9a\x58\x97\x5d\x42\x96\x1a\x9f\x9c\xe8\x92\xd5\x33\x27\x3e\x82\xa1\xec\xba\xed\x5a\xb7\x34\xa6\xe3\x59\x35\x8e\x58\xd6\x73\xde\xf6\x3a\x47\x62\xa3\x5f\x84\x28\x91\xd3\x2c\x4d\xd2\x45\x1e\x5f\xb3\xfb\x09\xfc\x54\x1b\x02\x5a\x63\x4a\x83\x08\x16\x70\x69\x67\x94\x4e\x4d\xcd\xfb\x59\xb9\x38\x81\xa0\x77\xdf\xfd\xe3\x22\x3d\x30\x2e\x6e\xd6\xef\x58\x12\x37\xf8\xb7\x6d\xea\x2b\xef\x80\x4e\x6c\x60\x1a\x99\xba\x5a\x65\xde\x2f\x4a\x9a\x2e\x2f\x17\xa5\x0f\xd6\x9b\x45\xd9\x8e\x25\x75\x94\x0d\x75\x9e\xd1\xaf\x27\xa8\xd8\xf7\x94\x08\x8e\x1b\x87\xa5\x6e\xee\x79\xe5\x54\xb5\x9c\xcf\x2d\x49\x0c\xb4\x73\x27\x67\x1d\xbc\x13\x57\x52\xc3\x68\x29\xd6\x34\xae\x3a\x37\xaf\x78\xf1\x65\x52\x5a\xb9\xc1\xa9\x01\x70\x59\x79\x7e\x3c\xcb\x69\xab\x9a\x5f\x3e\x5c\x7b\x3f\x8c\x69\xf2\xe2\xaa\xb7\x81\x90\xce\xa3\xcc\xda\x63\x47\xaf\xad\xd7\x7d\x58\xf1\xae\x32\x07\x9a\x49\x6d\x60\xf1\x6a\x8f\x44\xc6\x82\x30\xb4\x29\xe2\x19\xc1\xe5\x61\xc9\xda\x66\xcd\x32\xc6\x2a\x29\x40\xaf\x5f\xb6\xcd\x0d\xed\x2a\xe7\xfb\x9c\x40\xb4\x05\x7f\xb2\x09\xa4\x5e\x5a\xcd\x56\xa6\x8a\x50\x5e\x6d\xca\x58\xaf\x06\xbc\xd7\x39\x23\xb6\x00\xe4\x35\xf3\xb4\x15\x6b\x99\x2a\xd6\x3b\x89\xea\xe6\x8a\x46\x88\x3b\x4e\x12\xa9\xed\xa0\x42\xaa\x5b\xc4\x56\x12\x5e\x12\x6e\xa1\x34\x4e\x90\x42\x70\x4a\xcd\xf2\xb2\x2c\xcb\x4b\x9c\xba\xf2\xd8\xa8\x35\xae\x4a\x82\x02\x66\x85\xd0\x9f\x9b\x3a\x4c\x60\x0f\x0d\x50\xef\x05\x95\x5d\x59\x79\x4b\x6b\xdb\xb7\x6a\x8b\xb5\x12\xc3\x02\xb5\x48\x70\x92\x17\xfa\x9d\xb9\x5a\x89\x22\x8d\x52\x10\xc6\x08\x26\x8b\xb9\x72\xc3\xa8\xe1\x68\xf3\x19\x1a\xa5\xf7\x16\x81\xc4\x3a\x15\x28\xbb\x74\xb2\x71\xf6\xb1\x23\xba\xfc\x29\x2b\x75\x46\x74\x7a\x82\x66\x00\x18\x32\x1c\x0d\x0c\x84\x03\x1b\xea\x41\xf9\xf3\xb6\x9a\x0f\xb9\xdb\x75\x8d\xae\x26\x27\xb9\xdd\x60\x57\x02\x79\x43\xef\x78\xb7\x68\xc9\x6c\xe9\x5c\xda\x6a\xc4\x32\x63\x17\xcf\x31\x9f\x03\xbf\x26\xb1\x69\xf7\x8e\xe0\xa8\xe1\x41\x27\x8f\x79\x8d\xb0\x44\xad\x4f\x3e\x8a\xab\x4a\xa0\x08\xc5\xa8\x40\x96\xc1\x7c\x61\x19\x86\xb2\xdf\x95\x51\x68\xf3\x3d\xa8\x73\x74\x9d\xb7\x4b\x5c\x9b\x62\xff\x6c\x38\x1c\x82\x1e\x78\xf8\x10\x34\x8d\x15\x61\xf2\xec\x84\x7d\x6f\x6b\xc0\xa6\x9c\xad\xb5\x17\x57\x18\xdf\x52\xe9\xc8\x10\x91\xa9\xc4\x0c\x17\xc3\x3c\x43\xe1\x14\x26\x38\x9f\x09\x67\xd4\x72\x42\x96\x22\x5c\x6e\x18\x59\x1b\xd4\x01\x3c\x47\x68\xbe\x9f\x1f\x5f\x27\x21\x4e\x8c\xd8\x8f\xee\xb7\xbf\xe5\x53\x9c\x90\x06\x5d\x85\xe9\x6c\x1e\xa3\x2b\x5c\x5c\x0f\x9e\x6a\x9e\x17\xf2\x47\x18\xb6\x4d\xd6\xfc\x73\x74\xcd\xfc\x8b\x72\xb4\x2c\xe3\xd1\xed\xf2\x83\x2b\x00\xc6\x31\xa5\x46\x1e\x00\x74\x15\xa2\x79\x41\xad\x6c\xfe\xca\x56\xae\x98\xa2\x6b\x90\x20\x46\xd1\x11\xe2\x78\x22\x42\xb1\x05\x8c\xe3\x6b\x30\xba\xa6\x24\x27\xe4\xcd\x59\xa7\x28\xef\xfe\x4c\xf4\x0e\x9c\x4c\xda\x9b\x96\xe5\xbc\xfd\x40\xa3\x02\xb8\xb9\x21\xfd\xe8\xd0\xeb\x64\x0f\xc6\xed\x16\x41\x44\x98\xff\x53\x6b\x93\x32\xdd\x63\xeb\xbe\x2d\xd0\xc5\xd7\x39\xba\x3e\xeb\x48\x09\x54\x95\x12\xe2\xcf\x9c\x29\xa4\x5c\xd3\x4d\x7d\xb7\x7f\xe1\x69\x44\x9d\x51\x84\xdf\xc8\xdc\x99\xa0\x1c\xa4\xc9\x2a\xb2\x92\xa0\x59\x6e\xee\xd0\x8a\xeb\x36\x89\x1c\xb3\x05\x3c\x53\xd1\x5b\x88\x93\xa3\x42\xa3\x2a\xa9\x48\xd9\x8c\x09\x40\xd8\xd9\x3b\x79\xfb\xe9\xf0\xe0\xdd\xbb\xfd\x0f\x6f\x3e\x9d\xec\xbf\xdf\x3b\xf8\x78\x62\xf3\x66\xf8\x8c\xb4\x5b\xe7\xaf\x55\xe8\xbf\x88\xc7\xa8\xd9\xa4\x20\xfd\x79\x05\x0b\x08\x86\xe0\xf4\xcc\x72\x4d\x2e\xf9\xbe\x4f\x8f\xee\x54\x3f\x2f\x27\xa2\x64\x45\x9d\xf9\x22\x9f\xb6\xab\xf3\x9a\x5b\x2e\xd6\x52\xfb\x51\xce\x0a\x9d\xa3\xeb\x7a\x23\x89\xde\x33\x2a\x2b\x5a\xc3\x20\x79\x59\x5d\xb2\xc6\x2a\x07\x75\xbb\x60\x06\xe7\xda\x42\x84\xe9\x94\xa3\x42\x97\xb2\xbf\x3a\xad\x74\x92\xbf\x87\x73\x8b\xf7\x55\xd9\x75\xd4\x4f\x80\x31\x5b\x8e\x5d\xfd\xed\xa0\x3e\xc5\x79\x4a\x8b\x63\xaa\x06\x0a\xea\x3a\xef\xf9\x6e\x38\x9d\xa6\x7e\x5c\xce\xcc\x4c\x75\x43\xd3\x34\xc5\xf8\xed\xc1\x03\x31\x01\x40\x9c\x4e\x7e\x74\x19\xa4\x69\x83\x49\x7a\x5f\x46\xa8\x87\xdf\xb2\x6a\x3e\x02\x63\xe9\x61\xcd\xa4\xb0\x95\x2b\xdd\xeb\xe3\x21\xe8\x2d\x05\x51\x39\x8a\x64\x6c\xb9\x77\x07\x1b\x7a\xa1\xf8\xa3\x1c\x79\x8e\x40\xbe\xc8\xe8\x8e\xa0\x10\xf4\x38\x07\x79\x81\x09\x3f\xb2\xd5\x15\x45\x00\x8e\xc9\x07\x98\x65\xf8\x02\xc6\x15\x7d\xca\x8a\x9b\x10\x90\xb0\x07\x9f\xd6\x38\x3a\xab\xbb\x85\x97\x37\x55\xb9\xbd\x5b\x2f\x2b\xbf\xd8\x97\x79\x50\xf5\xfa\x3a\xee\xe8\xa5\xb7\x33\x37\x61\x18\xc3\x38\x47\xb6\x98\x91\x0e\x23\x8f\xf7\x98\x70\x7c\x0f\x1e\xb8\xda\xb4\x3c\x4a\x32\xcc\x94\xb1\x14\xf9\xe3\x70\x2b\xda\x49\x40\xf9\x9a\x62\x58\x62\x38\x96\x77\x3d\x02\x2b\x63\x2b\x44\xa1\x88\xec\x14\xa9\xc8\xb2\x66\x2a\xeb\x6d\x6d\xf6\x6c\x02\x5b\x04\x90\xc3\x91\x63\x04\x5e\x1c\x55\x4f\xec\x52\x50\x2d\xfc\x82\x6d\x5b\x6e\x0c\xfa\xfd\xa0\xe9\x46\x5d\xf2\xc0\x77\x50\x37\x06\xdf\xdf\x9e\x05\xfd\x9e\xd7\xb9\x68\xd9\x3e\xc2\x10\xc7\xa8\x28\x70\x32\x31\x0f\xd4\xaa\x1d\xa4\x12\x39\x62\x3b\x8e\x22\xd3\x5b\x2b\x86\x05\xca\x8b\x96\x45\x76\x73\xc8\xf2\xf6\x4e\x19\x1e\xed\x49\x27\xd1\x28\x85\x42\xa4\x7b\x5e\xd7\x07\x0b\xa4\xad\x45\x8e\x40\x5e\x64\x38\x24\x6d\xfc\xa6\xdb\x05\x93\x14\xa0\xe4\x02\x44\x29\xca\x93\x56\xc1\x22\x0f\x60\x12\x31\x93\xe5\x97\xf7\xef\xde\x16\xc5\xfc\xa8\x22\x95\x08\xcb\x13\x0d\x33\x1d\x57\x20\xa8\x2e\xd0\x92\x1d\x6b\x55\x49\xc6\x1b\xd4\xa9\x96\xd2\x96\x61\xeb\x8d\xef\xce\x92\xfa\x0b\x1a\x9b\xcc\x95\x5a\x3c\x49\xd2\x0c\x0d\x62\x9c\x94\x02\xea\x56\xa7\x9d\xd7\xe5\x1a\xa2\xec\xd7\x48\x9e\xaf\x91\x3c\x7f\xfe\x48\x1e\x1e\xbe\xc3\x24\xaa\x3d\x6c\x27\x1f\xa8\x2f\xc1\x7a\x8f\x9f\x55\x0c\xe6\x8f\x44\x88\x3b\x2d\x5f\x96\x29\x69\x51\x81\xa9\xc7\x86\x8b\x6b\x29\xb4\x9d\x98\xc3\x18\xe6\x39\xcf\x72\x79\xc6\x89\xa1\x03\xa4\x49\x5e\x64\x8b\xb0\x48\x33\xbd\xe6\x6f\xba\xd4\x19\x44\x65\x05\xb5\x35\x98\xac\x03\xc4\xe0\x7d\xb9\xff\xe6\xc3\xc7\xf7\x2f\xf7\x8e\x3e\x1d\x1d\x7c\xfc\xf0\x8a\xd8\xbe\xef\x0f\x5e\xed\x69\x4b\xcb\x4b\x3c\x61\x6e\x4d\x35\xdc\x66\x84\x27\xcc\x9f\xdd\xf9\xcd\x3c\x7c\x47\x30\x7f\xfc\xb0\x7f\x72\x4c\x6c\x44\x4d\x28\xb6\x2e\x11\xae\x1c\x4e\x6e\x9d\x5b\xde\xbd\xb7\xbc\x7b\x63\x79\x97\xff\x1b\x8e\xd2\xea\xcb\x31\x4e\x12\xe3\xfe\xac\xd6\x18\xcd\x8a\x94\x46\xe7\x54\xbf\xcc\x71\x68\xff\x90\xc0\xc4\xfe\x61\x86\xc3\xcc\xf5\x25\x8e\xb1\x13\x99\x15\x8f\x15\x45\xf5\xa5\x15\xe5\x24\x83\x49\xf5\xac\x77\xeb\xbd\x15\xf4\x8d\xf5\xed\x89\xf5\xed\xa1\xf5\xed\x9e\xf5\xed\xff\x5a\xdf\xfe\xd3\xfa\xf6\x83\xf5\xed\x2b\xeb\xdb\x7f\x58\xdf\x7e\x64\x6f\xe5\xcb\xb3\x3a\xed\x43\x5f\x8e\xa9\x7b\x67\xe7\x15\x61\xf1\x01\xd8\xae\xa4\x1c\x26\x1f\x8f\xf7\xdf\x7c\xd8\x39\xf9\x78\xb4\xf7\xe9\xdd\xde\x87\x37\x27\x6f\x8d\x2b\x48\x25\x57\x0f\xca\x9f\x26\x84\x63\x46\x0d\xc0\x67\x50\x79\x21\xe7\x55\x87\x7e\xf8\xf4\xea\xe0\xe7\x0f\xe0\xd6\xc4\x58\x71\x4a\x0d\xc0\x56\xaf\xd7\xeb\x56\x7a\xa0\x6a\x75\x03\xa9\xd4\x59\x61\xb8\x3e\x37\x28\xd5\x39\x4d\x9b\x93\xba\xac\x3a\xc5\x37\x06\xfa\x23\x51\x45\xbc\x6e\xef\x10\x98\xbf\xaa\x22\x5f\x55\x91\x3f\xbf\x2a\xa2\x2d\xb8\x94\x8d\xf3\x29\xdc\x76\x84\x12\xaf\xfd\x0c\xfc\x6e\x76\x3d\x2f\xd2\xbf\x1f\xab\xeb\x70\x48\xdf\x3d\xfe\xcd\x88\xa6\x25\x0d\xb3\x02\x76\xc9\x17\x6d\xc9\x36\x24\x67\x69\x41\x5e\xc0\x78\x41\x18\xd8\x7e\x70\x9e\x58\x55\xfc\x13\x78\xf8\x50\x9e\x3d\x47\x49\x98\x46\x74\x17\x93\xd8\x56\x53\x74\x65\x58\x55\xa2\x30\x45\x5f\x9e\xd5\xeb\x13\x2c\xec\x5d\xbe\x18\xe5\x45\xd6\xee\x05\xa0\xcf\x36\x84\x5a\x3d\x3b\x1a\xd6\xdf\x78\x81\xc0\x50\x2f\xda\xf7\xf2\xb3\x89\xa2\x82\xb6\xa4\xf1\x9d\xb7\xe8\xaa\x33\x87\x59\x8e\x58\x03\xbd\x22\x0d\x09\x55\x05\xb9\x2c\x07\x0e\xe9\xf1\x8f\x77\xb4\xa3\x03\xd0\x7f\xfa\xac\xe2\x78\xe8\x14\xe9\x71\x91\xd1\xcd\xb3\x17\x76\x69\x2c\xc7\x6f\x63\xb0\xfd\x34\xd8\xd0\x87\x73\x63\xf0\xf4\x19\x11\xc9\x5e\xd9\xb8\x05\xfa\xaf\x22\xf9\xab\x48\xfe\x4f\x14\xc9\xcc\x63\xff\x05\x64\xf2\x1f\xd1\x30\xac\x56\x50\x67\x17\x8a\x07\x6f\x23\x4f\x2d\x50\x5d\x5f\x3a\x5d\xbe\x14\x9a\xa7\x40\xc6\xcf\x55\x40\xf2\x6c\x58\x8c\x8b\x04\x17\x7c\x7b\xab\x62\x35\x71\x33\x6b\xc0\x9f\x7b\x55\x93\x80\x18\x85\x03\xe5\x79\xcb\x6a\x5e\x0e\x14\x80\x5e\xcf\x40\xf2\x93\x07\xcc\x08\x8e\x46\x70\x82\x64\x4b\x6c\x30\x8a\x8d\x39\x70\xc1\xcc\x2c\x75\x59\xc0\xde\xfb\x81\xc5\xe9\x05\x8a\x61\x28\x9a\xe5\x02\x2b\x4d\xdc\x41\x1d\xd8\xc4\x5e\xa9\x05\xf2\x8d\x37\x64\x3e\x85\x49\x92\x26\x1a\xdd\xec\x90\xa5\xb9\x3d\xf0\x80\xf4\xac\x9d\x3a\x08\x06\x16\x48\xdb\xd0\x94\x66\xfd\xc0\x0f\xd8\x17\x33\x77\x49\x0c\xac\xc0\x56\xe4\xd2\x8d\x60\xb6\xc4\x05\xef\x6a\x8c\x05\x5e\x9b\x54\x16\x78\x4b\x91\x73\xbd\x8c\xad\x88\x8d\xa3\xa8\x8b\xa2\xb6\x22\x5b\x77\xbc\xea\xb2\x55\xe7\x5b\xd0\x52\xb6\x58\xa2\x2c\x29\xae\xe9\x69\xe2\xb7\x4f\x74\x0d\x8c\xe8\x86\x46\x32\x61\xa9\x60\xe6\x28\x24\xaa\x04\xd3\x82\x9d\xeb\x07\x0f\xa9\x81\xd1\x3b\x34\xb6\x1e\xe1\x66\xfa\xe3\xad\x82\x7a\x84\x08\x7c\x84\xac\xb1\xae\x22\x52\x31\x9c\xc2\x0c\x86\x34\x4d\x2e\x55\x60\xf8\x21\x1f\x8e\x45\x59\xf5\x6b\xeb\xc4\x93\x24\x00\xa3\x6b\xe1\x62\x00\x3d\x0d\x9a\xa9\xc8\x79\x09\x9f\xd1\x8b\x97\x60\x8c\x27\x09\x12\xc4\xd0\x16\x27\xf1\x93\x45\x5b\xd0\x3e\x6b\x76\x09\x2b\x12\xd0\xd6\xe7\x01\xad\xdf\x91\xad\x22\x41\x97\x80\xed\xdd\x53\x58\xf0\x98\x57\x27\xac\x8e\x47\x60\x6b\xb3\xf3\x5b\x8a\x93\x36\x41\x02\x7e\xa4\xb8\xc0\x00\x6c\xf4\x36\x36\xc1\x23\x0e\xbc\xf2\x29\x88\x3b\x8e\xf5\x11\xa1\xd3\x5f\x6d\xb0\x8f\xf8\xad\x5c\xab\x8c\x36\xef\xc9\x23\xd0\xbe\xcb\xb8\xaf\x7e\xea\x65\x82\x0a\xa6\xf0\xd0\x84\x4e\xb8\x68\xe5\x60\x8a\xae\x40\x86\xe6\x19\xca\x51\xc2\x6e\x80\x6a\x18\xf7\x22\xfd\x58\x8c\x9f\x7b\x8c\x3a\x4e\x08\xf2\x7a\xe2\xc3\x3c\xc4\x58\x14\xd0\x9b\x41\xd4\x76\xd2\x38\x6a\xb4\x3a\x07\x84\xb5\x46\x19\x8e\xf6\x14\x5d\x11\xea\x77\xbb\xe0\x35\x4e\x22\x6a\xcb\xe1\xa4\xd2\x31\xa1\x24\x16\x44\x9f\xdc\xd8\x30\xa3\xc8\x30\x18\x82\x5e\x00\x62\x30\x24\x6d\xe0\x83\x62\x26\xf3\x21\xdf\x98\x3d\x4f\x6c\xe3\x66\x6f\x00\x41\xdb\x77\x45\x41\x02\x11\xa2\xf6\x02\x60\xf0\x03\x88\x5f\x00\xfc\x68\xd8\xb7\xa1\xa1\xc7\x91\xd3\x08\x81\x21\xa0\x7e\x80\xfd\xa4\x50\x9a\xd2\xc6\xa4\x1d\x01\xd8\x7a\x66\x71\x31\x90\x56\xb3\xa2\x34\xc8\xcc\xea\xaf\x18\x65\x08\x5a\xa2\x58\x08\xb5\x1e\x0d\x01\x1b\x3a\x7a\x93\xd9\xee\x14\x66\xbb\x69\x84\x28\x46\x2f\x37\x04\x61\xbe\x0e\xcb\xde\x4a\xa6\xcd\xdd\x38\x99\x31\xcf\x9d\x58\x79\x87\xa0\xf8\xe3\xf0\x32\x6d\xce\x57\x66\xf6\x65\xe6\x75\x70\x64\x5e\x64\x77\x62\x42\x93\xe9\x40\x7b\x9e\xa1\x31\xbe\xa2\xce\x20\xd0\xbb\xa2\x69\x0b\xa9\xd4\x35\x16\x17\x1b\x53\x92\x6e\xf8\x49\x58\x1b\xc4\xcb\x34\x8d\x11\x4c\x6e\x01\x8c\xe3\xf4\xf2\x7f\x51\x96\xd2\xbc\x3d\x69\x72\x81\xb2\x82\xd1\x79\x9e\xe2\xa4\x00\xff\xe6\x9f\x7a\x3d\x1a\x42\x87\x60\x44\x9a\x89\x12\xfa\x8f\x05\xbf\xc1\xee\x96\x8e\xa7\x63\x9e\x07\xb0\x61\x15\x15\x5d\x54\x39\x3d\x2f\xb2\xa0\x6c\x74\x95\x51\x18\x73\x53\xe9\x41\xdd\xb7\x55\xe9\x21\x30\x4f\xe9\xb5\xa2\xd5\x49\x30\x4e\xb3\xb6\x9c\x08\x8c\x21\xf3\x22\x13\x13\x01\xe0\x47\x8f\x1a\x18\x93\x40\x87\x9c\xb5\x76\x8a\x36\x6e\x16\xac\x0e\x57\x30\x81\x72\x76\x52\xfd\x23\x1d\x79\x44\xe6\x5e\xaf\x55\x17\x28\x58\x83\xc1\x21\xc5\x81\xd5\xcf\x5c\x8b\x8d\xe6\xb5\x03\x43\x4a\x8c\xd2\x13\x6c\x9d\x91\x4a\xd3\x13\xa1\xca\xfc\x00\xfa\xe0\x47\xd0\xea\xd1\xa4\x6c\x60\x00\xac\xd1\xad\xfa\x93\x6d\xa2\x6e\xf4\xae\x36\xc0\x23\x82\xfd\x4b\xcc\x57\x55\x98\x7b\x4c\x58\xcf\x75\xa4\x4e\xf9\x65\x2e\x5e\x18\x53\x3d\xf9\xbe\x67\x9f\xb1\xd0\x90\xe9\x64\xc9\x78\xf5\xfb\xcd\xa7\x25\x98\x6e\x49\x86\x5b\x33\x7b\x89\xe3\x7b\x3c\x37\xda\x78\x11\xc7\x92\xac\x5d\x74\x81\x92\x82\x65\x5d\xa4\x7a\xca\x6f\x79\x9a\x00\x38\xc2\x4d\x8a\x49\x06\x93\x7c\x9c\x66\xb3\x93\xf4\xf5\x22\x8e\x3f\xc0\x99\xd5\xe4\x51\xd3\x9e\x3d\xd6\xb1\x96\x29\x44\x04\xc7\xb0\x86\x19\xed\x72\x6b\x23\x66\x1b\x34\xab\x87\x54\x6a\xdb\x71\x23\xef\x59\x9e\x4c\x79\x7a\xaa\xdd\xda\xa4\xf9\x6b\xed\xa7\xa6\x78\x43\x65\xb9\xc6\xf3\x0d\xc5\xf5\x1c\xf1\xe6\xd0\x42\x94\xeb\xf5\x58\xf4\x36\xde\xfc\xcc\xf1\xe2\x0e\x81\x7f\x01\x6e\xb9\x3d\x65\x4f\x6d\x26\x6b\x07\x8f\x40\xab\x4d\x38\x47\xd6\xf2\x08\xb4\x36\x5b\x77\x92\x39\x11\xce\xe7\x31\xbc\x66\x7c\x90\x8e\xc9\x72\xcc\x12\xec\x8a\xe6\x36\xb0\x03\xcf\x05\xf7\x8a\xa1\x71\x71\x83\x18\x68\x51\x8b\xce\x84\xf5\xe2\x44\x6b\x21\x3d\x7b\xaa\x73\x30\x9a\x74\xc0\x6c\x11\x17\x78\x1e\x5f\xb7\x17\x38\x29\xfa\x4f\x9f\x6d\x82\xc7\x7f\x93\x2f\x9d\x6c\x64\xb6\xbd\x31\x91\x27\xd3\x66\x5f\x66\x30\x3c\xa7\x47\x15\x0d\x66\x32\xd7\x7d\x94\x44\x2e\xf8\xcd\x2a\x3c\x1f\xf1\x76\x59\x05\xe3\x4d\xf0\xf0\xa1\x8a\x47\x30\xec\x8f\x3c\x55\xa8\xdc\xf1\x95\xe5\x36\x89\x6c\xd1\x18\x76\x05\xde\x20\x6c\x76\x57\xc6\x38\xe1\xac\xba\x6e\xae\x28\xdb\xb6\x0c\x4b\xf0\xdf\x4d\x1c\x71\x52\xce\xe2\x3f\x2f\x3b\x94\x85\x1e\x81\xad\x40\x85\x7f\xac\xb4\xf9\x31\xd8\xda\xec\x64\x68\x1e\xc3\x10\xb5\x5b\xa0\x15\x80\x56\x8b\x30\x8f\xba\xae\xd6\xb0\xce\x2e\xd3\xde\x73\x1e\x0d\x50\xa4\xcc\xdc\x8d\xd8\x3d\xe2\x55\x35\x00\x27\x7e\x6a\x4b\x91\xf2\x8b\xc8\xdd\x5c\x73\xc3\x74\x93\x1b\xb9\xfb\x66\xcf\x98\xce\xd9\xa5\xc6\xae\xe5\x35\x99\xb1\x1b\xae\xf4\xe8\xa9\xac\x92\xc3\x75\x8a\x94\x3f\xfb\xf9\x0c\x1c\x34\x5b\xda\x45\xa0\x5c\xd8\x7e\xef\x74\x52\xea\x6a\xa6\x14\xd5\x8f\xc4\x96\xa8\x49\xaf\x9a\xf4\x58\x7c\xcf\x54\xd7\xa9\x6c\xa3\xc0\x01\x63\x94\xe7\x27\x53\x98\xb4\x7b\x84\xfb\x5b\x8f\x7b\x57\x64\x79\xe4\xa7\x57\xf8\x3c\xd8\x22\xec\xdc\x52\xbf\x78\x8d\xd2\xce\xa2\x34\x4e\x73\x1a\xdc\xc0\xce\xee\xb2\x41\xc3\x89\x7b\xd8\x3a\x8e\x71\xdb\x49\x22\x16\xab\xc0\xe6\x00\x1e\x5f\xe7\x20\xa5\x6a\x52\x0e\x46\x68\x9c\x66\xc8\x55\xb2\x9c\x17\x6f\x2b\x9e\x9e\xfa\xb1\xbe\xa9\xbb\x4c\xa0\x79\x6a\xbc\xa5\x1a\xb6\x36\xd8\xbe\xa7\x8a\x9f\x1b\x87\xf0\x89\xfe\x85\x73\xee\x03\xa0\xa8\x4c\x0f\x9f\x38\x42\x56\x32\x5b\xfb\x11\x81\xb4\x1c\x26\xc6\xb9\xc6\x56\x7e\xc8\x1c\xb8\xf8\xf1\x96\x0b\xc2\xdb\xc3\x21\x68\xb1\x41\x69\xd5\xa2\xfc\x58\x8c\x9f\xb7\xff\x7e\x7c\xf0\xa1\x23\x47\x93\xb5\xc3\x3c\xb6\x8a\xc7\x00\x13\x16\x02\x09\x9a\xc0\x02\x5f\x20\xce\xbd\x01\x4d\xa0\x08\x70\x41\xcf\x3e\x2e\x26\x53\xfb\x8c\x96\x1d\xe6\x73\x82\xd6\xe2\x8e\x0a\x2b\x97\x93\xc7\xd4\x39\x56\xe3\x4c\x75\x52\xa8\x0a\x48\x0d\x70\x3c\xd6\xd1\x7b\x62\xbf\x80\xb1\x13\x21\x68\x3f\xc0\xf9\x6b\x9c\xe0\x02\x39\x06\xd1\x46\xf5\x0b\x18\x07\x5b\x5e\x0e\x34\x77\xcf\x6a\x26\xfe\x11\x57\x35\xd8\x44\x4f\xc7\x34\xc2\x82\x2c\x5d\x3f\xa3\x26\xdb\x68\x82\x8a\x7f\x90\x52\x07\xe3\x8f\x09\xae\xdd\x7d\xa2\x38\x8b\x29\xe2\x3f\x4a\x37\x58\x91\x06\x72\x77\x88\xee\xaa\xda\xd5\xa0\x52\x9c\x97\xed\x94\xe8\xda\xac\xb1\x9b\x5a\x51\x7a\xbe\x36\x67\x07\x6c\x09\xe9\x25\x34\xce\x41\x92\x16\x20\x4c\xb3\x0c\x85\xc5\xe0\xd2\x29\x10\xf4\xde\x69\x92\x81\x20\xaa\xf2\xe4\x82\x01\xd1\x7f\x7e\xa4\xff\x74\x8a\xf4\x5d\x7a\x29\x13\xdd\x0f\xc4\x66\xba\xb9\x20\x10\xe8\x7f\xf0\xf8\x42\x1e\xe0\x72\x4a\xfe\xb5\x5c\x5a\xa0\x80\xaa\xd7\x90\xb8\x53\x23\x26\xe8\x12\xec\xd1\x0c\x00\x2d\x1a\xc0\x47\x1b\x48\x8f\xc4\xfd\xab\x55\xf0\xeb\x39\x02\x30\x8f\x11\xcc\xa9\x05\x4d\x29\x95\x26\x92\xc4\xe3\x34\x8e\xd3\x4b\x9c\x4c\x68\xc9\x9c\x2c\x2b\x15\x39\xc0\x5b\x2c\xee\xea\xeb\x9b\xd9\xc8\x2c\xbc\x4a\x9a\x55\x8a\x34\xd9\xab\x00\x6c\xf5\xfc\xf8\xf6\x04\x9e\x23\x2a\x65\x64\xb2\xa4\x4b\x84\xe9\xf1\x3e\xb9\x8a\x31\x56\x23\x6b\x59\x4a\x83\xfc\x28\xf9\xd9\xd0\x38\x38\xfb\x30\xcd\x59\xb2\x56\xda\x57\x00\x33\x54\x39\x2f\x74\xbc\x4f\xcc\x87\xac\x60\x3f\x89\x35\x2e\xbe\xee\x8d\xc7\x78\x72\xcd\x1f\x0e\xaa\xac\xfc\x18\x9c\x93\xe6\xb1\xbf\x32\xf8\x87\x3e\xf2\x78\x21\x1d\x7c\x56\x82\xcb\x90\x1c\xf6\x28\x02\x79\x74\xf8\x49\x09\x2f\xa3\x63\xd8\x23\x0f\xab\x29\xbf\xe9\x05\x1f\x3f\x96\x8f\x65\x54\x0b\x2b\xf8\x6f\x38\x4a\xb5\x6f\x35\x25\x45\x14\x0a\xeb\x20\x0d\x62\x51\xbf\xe9\x25\x95\xd6\x29\x48\xd8\x63\x85\x6a\x0a\x28\xa8\x40\x2a\x0f\x34\x78\xa4\x42\x40\x43\x9c\x3c\x06\x13\xcb\xbb\xa2\xfa\xce\xa5\x7b\xea\xf2\xb0\xe2\x34\xbc\x91\xc6\x1d\x63\x47\x9e\x7c\x1f\xca\xb5\x8f\xbf\xe7\x7b\x52\x69\x06\x20\x78\xbb\xf7\x0b\xf5\x6f\x0a\xf3\xe1\xde\x04\xa8\x54\x98\x84\x97\xea\xe7\x29\x4a\xb8\x7e\x07\x95\x80\x3e\xa6\x08\x90\x69\x23\x04\x2f\x91\x03\x30\x07\x97\x88\x4c\x6c\x3a\x89\x2e\x71\x5e\x76\xab\x56\x7f\xfe\x19\x69\x6e\x4d\x41\x08\x9b\xec\x64\x4a\x31\xa9\x52\x48\x41\x55\x8d\x66\x25\x37\x3b\x11\xbe\xc0\x11\x8a\x5e\x5e\xb7\x75\xd1\xcc\xc4\xb1\x43\x73\x56\x15\x27\x8e\x08\xfc\xa8\x55\x36\x50\x9f\x14\x5d\x7c\x65\x39\x04\xd9\x20\x59\x44\xd1\x25\xc2\x5f\x25\x8f\x2c\xf8\x55\xf2\x78\x48\x9e\x22\xf5\x91\x3b\x8a\xbd\xfb\xc5\x25\x10\x99\xeb\x7f\x44\x19\x44\x29\xb7\x5e\x09\x54\xe0\x19\xca\xff\x88\xd2\x27\x11\xf7\x1b\x26\xca\x8e\x05\x95\x3a\xd4\x72\x27\xac\x20\x22\xb4\x1b\xf9\x4d\xb6\x78\x29\xae\x2b\xf9\x4c\xc4\x60\x11\xe6\x2a\x99\xcd\x8e\x55\xf0\x86\x82\xc8\x06\x58\x1d\x58\x35\x06\xbd\x32\xbc\xcb\x64\xcd\x53\xe1\xa4\x03\x87\xff\xb8\xb9\x01\x3d\x53\xfb\xb6\x8c\xa7\xd3\x6c\x66\xdf\xad\xb6\x3c\x1f\x64\xd1\xe2\x87\x0f\x01\xff\x6d\x33\x37\x49\x53\xaa\x5f\x55\x5b\xb7\x66\x23\x47\x57\xb2\x39\x12\xe9\xfd\xec\x5d\xb5\x82\x56\xcb\x16\xd1\x61\x37\x2f\xad\xd8\x54\x8e\x5d\x5a\x79\x4f\x22\xce\xb7\x16\x9e\x95\x0c\xcb\xc0\xc6\x80\xdb\x6e\xc2\xa9\xc0\x4f\x50\x51\xd8\xe2\x32\x6d\xe5\x80\x0d\xef\x4c\xf7\xa5\xdb\x79\xfc\xe4\x32\xcd\x77\x6d\xe0\x8d\x8c\xde\xc0\xbe\x35\x4c\xab\xd7\xa9\xfb\xda\xad\xac\x4b\x8a\x8d\x14\x4e\xb7\x49\xa4\x2c\x5d\x24\x51\x75\x23\x8d\x30\x99\x2c\xa8\x3a\x0d\xbd\x59\x65\x63\x7c\xc7\xbf\x0d\xca\x55\x9d\x79\xbc\xc8\xcb\xa6\xf0\x67\xd3\x99\x61\x69\x8f\x2c\xe4\xe7\x63\x9e\xa2\xf0\x3c\x17\x76\x3e\x5b\x55\x44\xbc\x58\xce\x93\xc2\xc4\xd7\x44\x0e\x42\x76\xc3\x7c\x03\x87\xb0\x29\x1a\x8a\xfb\xe8\xeb\xd6\x45\x8e\x50\xa9\x98\x08\x3e\x68\x96\x93\xcc\xc2\xe3\x82\x9c\xac\x52\xa9\x5c\xbf\x04\x93\xbd\x73\x38\xeb\xbb\xbf\xf6\xae\x4e\x7b\x8f\xbf\x87\x8f\xc7\x67\x9f\x9f\xf4\x6e\xff\xab\x8b\x3b\x05\xca\x0b\x59\x6c\x0d\xc4\x5c\x82\x86\xbf\x13\xf5\x96\xa0\x1b\xf5\xc9\x75\x7f\x6d\xf7\xae\x36\x7f\xac\x25\x9c\x23\x73\x6a\x48\x48\xc5\x85\xd3\x14\xb2\x6e\x8c\x60\x8e\x43\x71\x8a\x89\x5e\x06\x46\x75\x2c\x0b\xd9\xf4\xc1\xb3\x64\x12\xbb\x2d\x3d\x87\xd6\x46\xea\x6d\x24\x0b\x85\x02\xb6\xf3\xf8\xb5\x15\xcc\xd1\x95\xfd\x31\x73\xf0\xc3\x38\x06\xf9\x8c\xfc\x37\x84\xf3\x9c\xea\x89\x71\x0c\xc4\x73\x20\xf7\x85\xaa\x17\x76\x2a\x3d\x21\xdf\xac\x1d\xb1\x56\x7c\x20\x55\x3a\x46\x4d\x04\xe9\xe5\x75\x96\xd4\x6b\x52\xa9\x62\x1c\xba\x98\xf1\x91\xb6\xb0\x37\xf0\xcd\x6a\xda\xc0\xec\xac\x4d\xf9\x62\x36\x43\x91\x37\xdf\x57\x9a\xf7\xa5\xf9\xbf\x52\xbd\xcf\x3c\xe8\x76\x19\x21\x1c\xc4\x87\x12\x13\xff\x65\x28\x11\xa6\x4f\x93\x43\xbe\x85\xf9\x14\x0c\xd9\xa1\x67\x51\x58\xf3\x8b\x56\x35\x67\x99\x1a\x54\x09\x69\x7a\xd2\xa3\xa1\x4c\xc0\xc1\xba\x84\x78\x49\x31\x05\x31\xbb\x49\x3d\x2f\x03\x82\xe6\x73\x94\x91\xde\x88\xf1\x25\x50\x11\x9e\x60\x7a\x74\x99\x7c\x98\xc1\x39\xbd\x44\xc9\x40\x4b\xe6\x5c\x5b\x46\xc1\x2a\x9d\x39\xc5\x67\x74\x6d\x03\x7f\x03\xdf\x11\xcd\x8d\x7f\x3a\xc5\x67\x95\x8b\x4d\x1f\x0c\x87\xca\x47\x3a\x3b\xeb\x11\xfe\x30\xb4\x60\x54\x3d\xc8\x15\x8c\x0d\x99\x04\x5d\x99\x09\x9b\xd7\x5e\x7d\xfe\xd2\xc3\xe5\xae\xe9\xf3\x9e\x7b\x3f\xc4\x34\xf1\x9c\x23\x45\x7a\x9f\x73\xa4\x79\xab\x6f\x85\x29\xa2\xec\x9c\xc9\xe9\x50\xcd\x08\x27\xee\xbd\x6d\x55\x78\xda\x9c\x3f\xda\xc0\xde\x69\x36\x59\x80\x43\xa3\x77\x04\xb1\xc7\x3c\x13\xad\x53\xc2\x07\x5d\x73\x8e\x2e\x17\xd3\xf2\x9c\x10\x99\x45\xdf\xd3\xeb\xe2\xc9\x38\x25\x2c\x55\x28\x2e\xca\x29\x68\x9d\x61\x4d\x13\xcc\x79\xa1\x6b\xa5\x87\x8f\x86\xce\x79\x68\x99\x01\x75\x11\xb6\xb5\x98\x57\x9a\x4d\x15\x8c\x7e\xf6\x51\x69\x0f\x69\x4b\x52\x91\xf2\x84\x9e\xfd\x1e\x18\x5d\x17\x28\x7f\xcc\x03\x2d\x59\x1b\xd9\x61\xf9\xde\x15\x60\x61\xb4\x8d\x93\xd0\x7f\xf2\xd5\xcd\x33\x7e\xc7\x7f\x61\x5d\x21\x8d\x73\x15\xcb\xcd\xb8\x8a\x2e\x5c\xab\xc4\x88\xdb\xdc\xab\x74\x06\xf6\x64\xcf\xdd\x5f\xeb\x94\xa9\x9a\x0a\x78\x70\x85\x4f\x3d\x7a\x09\x7e\x3e\xb1\x4d\x43\x0d\x64\x4d\x65\x1a\x92\x00\x3c\xf1\xb4\x9f\xc5\xa6\x2d\x91\xd4\xa4\x33\xc2\x6b\x96\x97\x36\x9c\xea\x2b\xa3\x4b\x41\xa3\xda\x52\xeb\xf8\xa9\x0b\xad\xf0\xd0\x49\x6c\x1e\x1b\x7a\xb1\x3c\x0a\x0d\x5f\x1c\x47\x2b\xfa\x94\xe4\x05\x4c\x42\x22\x77\x4b\x24\x37\x37\xc6\xe0\x70\x64\x34\xbd\x0c\xcb\xbc\xae\xe4\x33\xb0\xbf\x65\x51\xa6\x54\x8a\x4b\xd4\xad\xbb\x0e\x80\x70\x7f\x2d\x4b\xfd\x63\x67\x58\xfa\x1d\x49\xcf\x10\xfb\xd3\x9d\x2f\x72\xbc\x47\x94\x3a\xac\x4f\xad\xf5\x93\x9d\xb5\xed\xce\x34\x17\x3d\x5b\x9e\xea\xaf\xcd\xe8\xce\x75\xd1\x5d\xa0\xbe\x13\xe5\x45\x49\xbf\xa8\x67\x37\x85\x48\x5f\x8a\xe5\xc9\xc3\x48\x70\x0f\xc4\x39\xe0\x7d\x5c\x52\x18\x10\x0d\x38\x59\xc4\x31\x61\xac\x07\xfc\x22\x6e\x91\x71\x9d\x97\xa7\xee\x54\x0b\x25\x79\x94\xd3\x1d\xe9\x38\x62\x5d\x5b\x41\xb8\xb2\x82\xf7\x21\x5a\x19\xc8\x9d\xd8\x8c\x77\xeb\xae\xd4\x81\x64\x20\x96\xa7\x0d\x1d\xbf\x7b\xa0\x0c\xc5\xeb\x4f\x17\x2b\x3b\xad\x44\x91\xaa\x1b\x81\xe9\x6c\xf4\xc4\x49\x5a\x9d\x51\x76\x92\xfc\x3d\xb7\xcb\x24\xd3\xb4\x59\x8a\x22\x04\x6d\xf5\xc0\xbc\x91\xfb\x3b\xbb\x76\xeb\x3e\x0f\x1e\xd0\xe0\x1f\x96\xf3\xcc\x3c\xf8\x77\x0b\x42\x7a\x0f\x62\xdb\x08\x92\x05\xf5\x8e\xae\x75\x50\x19\x72\x3a\xef\x89\x8b\x95\x47\x34\x73\xf9\x14\xc1\x08\x65\xe4\x21\x9d\x35\x45\x9c\xe2\xfc\x25\x01\xab\x53\x88\xa7\xe8\x0a\xb0\x83\x8f\x11\xc3\xc9\xef\x41\x58\x79\xfa\x52\x1c\xea\x98\x50\xac\x5e\x9e\xca\xa7\x5b\xfd\xd2\x55\xc9\x8a\x2d\x49\x77\xb7\x83\x91\xe1\x56\x51\xdb\xfc\x8b\x26\x54\x4d\x03\x2c\x7e\x41\xdb\x3c\xac\xb4\x73\x0d\x1c\x11\xa7\xc4\x74\x9a\xe3\xb0\x79\xfc\x4f\x08\x98\xef\xf8\x17\x06\xf0\x12\x03\x4f\x6b\xd2\x06\x9e\xa2\xf3\x1a\xf8\x67\x4f\xca\x71\x67\xa5\xd6\x36\xee\x14\xb5\x8a\xd9\x36\xec\x06\xd0\xbd\x8e\x7a\x43\xc2\x5e\x6e\x56\x0d\xc4\x8f\xa0\xfa\x95\xe6\x09\x19\xc8\x5f\xfa\x77\x6a\x8c\x0d\xd8\x3f\xd5\x2f\x3c\x98\x76\x50\xfe\xd4\x21\x94\x80\xdb\x81\xfa\x50\xc5\xf3\xb1\x18\x3f\x1f\xf0\x7f\xab\xdf\xe8\xf9\xcf\x81\xf8\x61\xe2\x67\x65\xc5\x2f\xf3\x3b\x2f\x2f\x7f\x56\xf0\x9b\x47\x07\x07\xb6\x97\x7a\x29\xf3\xa4\xd8\xc0\xf2\xce\x5a\x46\x9c\x25\x1a\x54\x5f\x54\xfb\xfd\x33\xa2\xbd\xfe\x19\x59\xfa\x4c\xbf\xf1\x1f\xd5\x72\xd2\x52\x1b\xa8\x0f\x55\x28\x7d\xb3\x75\x60\xbc\x31\x46\x81\x19\xe3\x83\xf2\xa7\x0e\xa1\x58\xb0\x03\xf5\xa1\x0a\xa5\x79\x2b\x06\xd5\x17\x55\x68\x05\xce\x01\x51\x71\x54\x0e\xcc\x57\xd5\x9e\x18\x25\x8c\x57\xd5\x3a\x84\xa1\x32\x50\x7e\xdb\xfa\x95\x4c\x06\xf2\x57\xf5\x3b\xd3\xd4\x06\xf2\x97\x41\x3d\x26\x0f\x07\xe5\x4f\x83\x16\x44\xeb\x1a\x88\x1f\xd5\xaf\x44\x71\x19\xf0\x7f\x0d\xdc\x64\xdd\x19\x88\x1f\xd5\xaf\x54\xcc\x0e\xc4\x8f\xc0\x10\x30\xf4\x32\x16\x91\x92\x70\x63\xd0\xdf\x0e\x6a\xf3\x59\x07\x1b\x8b\x62\xfc\x7c\x63\xf0\x6c\xeb\xf6\x2c\xe8\x3f\x5d\x26\x8d\xaa\x2e\xc6\x86\xba\x10\xdb\xe0\x59\x44\x37\x06\x60\xa3\xd7\xe9\xf7\x3a\xcf\x36\xca\xa6\x6a\x17\x7b\x3c\xf3\xba\xf3\x45\x29\xf0\xdd\x32\x8d\x7c\xa1\x5e\xa5\x93\xa6\x45\x00\xc6\x90\x18\xed\xd7\x01\x0b\x36\xaf\x71\x8b\x4b\xf9\x3c\x1c\x82\x0d\xa6\xe7\x6e\x38\xbc\xc7\xbb\xe9\x6c\x96\x26\x7f\x3f\x36\xbe\x19\xa2\x5e\xc9\x35\xcc\xda\x21\xba\xd0\xde\xe8\x74\xc3\x34\x43\x1b\x9b\x01\x50\x5e\xa1\x24\x7c\x3c\x82\x39\x7a\xf6\xa4\xf2\x61\x16\x3d\xad\x82\x5e\xcc\xcf\xa3\x71\xe5\x65\x88\xe7\x53\x94\x3d\x66\x98\x6b\x03\x15\xe4\x0a\xca\xbb\xcf\x2f\x4b\xa0\xbd\x17\x34\xdc\x20\xc6\x28\xfb\xd0\x81\x33\x6b\x94\x7e\xb7\x0b\x76\xde\xbf\x32\x5e\xb3\x42\xed\x53\xd1\xcb\x00\xe8\x9d\xa3\xcf\xa4\x4f\xec\x03\xeb\x0a\xfd\xad\xf6\xe0\x4c\x0e\x5f\x73\x57\xac\x4d\x7b\x13\xa7\x23\x18\x83\xf6\x28\x4b\x2f\x73\x94\x99\xc1\x4e\x72\x54\xd2\xb4\xe8\x88\xdc\xc8\xee\x8d\x58\x7a\x79\x9f\x7a\xa1\x9b\x2c\x02\x3e\xeb\xae\xd3\xb6\xfb\x76\x22\xde\x36\x1a\x0e\x1b\x2e\x0a\x73\x47\x9d\xe6\xc0\x56\x72\x35\xdb\x73\x1a\xec\x7e\x8a\xf1\x88\x40\x75\x62\x3c\xb2\x83\xd0\x2c\xf9\xbb\x94\xa0\x04\x90\x14\xe8\x28\xef\x5c\x78\x61\x3c\x49\x29\x62\xf2\xc3\x72\x9d\x5a\xb7\x0b\xde\xa5\xe9\xf9\x62\x0e\x0a\x38\x8a\x91\xbd\x03\xc7\x2f\x0f\x7e\xb1\x5c\x9d\x28\x3e\xef\x7f\xf8\xc7\xa7\x06\x90\xe3\x8f\x2f\x3f\xbd\xdf\xff\xe5\x53\xcf\x03\x66\xcb\x03\xa6\xef\x01\xb3\xdd\xd4\x64\x8f\x36\xa9\x70\x75\xed\x52\xe1\xea\xda\xa6\xc2\x89\xf6\x39\xa4\xd2\x7c\x51\x20\x10\xd7\x8e\x4d\x03\x63\x56\x70\x45\xe9\x62\x14\x23\x86\xcb\x0a\x4b\x1a\x18\x39\x1a\x0f\x1c\xfb\x74\xfd\xa7\xcf\x9c\xb9\x3d\xc4\x1f\xdd\x3d\x01\x3f\x80\xad\xfe\xf3\x3a\x30\xf2\x17\x9d\xe2\x33\x30\x24\x98\x7f\x00\x5b\xee\x3b\xf3\x1a\xf3\xdc\x28\xb8\xda\x0c\xd9\x26\xf8\x15\xf4\xae\xb6\xb6\x2c\xd3\x4b\x62\xb5\x7e\xb1\x5c\xb2\x08\x18\x61\x7f\x86\xf1\x39\x78\xf3\xba\xdd\xff\xf5\xb9\xfd\xfc\x1c\xa1\xd5\x15\xa5\x95\xfb\x33\x76\x7f\x5f\x95\xde\xca\x98\xe7\xa3\xf4\xca\x09\x47\xcf\xd0\x93\xf6\x5d\x61\xf0\x2b\x68\x5f\x95\x84\xe2\xbf\xfb\xca\xef\x6d\xe5\xf7\x13\x47\x92\x1f\xf2\x47\x11\xb6\xf3\x2b\xf0\xb7\xbf\xfd\x0d\x3c\xa7\x85\xf2\x2b\xf0\x10\xf4\xae\xc6\x63\x36\x06\xcf\xb6\xdd\xa5\x89\x18\x39\xbd\x22\xc3\x96\x5f\xb9\xa1\x84\xc0\x39\xcd\x29\xe8\x95\xe3\x9a\xc8\x0a\x2d\x78\x5e\x02\x1c\x9a\xa9\xd2\xaa\x54\xb9\x22\x73\x38\x3a\xad\x5e\x26\x6a\x80\x3d\x61\x60\xfd\x26\xb8\xe7\x0c\xee\x89\xeb\x42\xcb\x4a\x4b\xf3\xc5\x88\xed\xa9\x06\x60\x86\xaf\x40\x98\xc6\x8b\x59\x92\xbb\xa4\x80\x5a\x53\x41\x88\x1f\x51\xba\x7c\x4b\xd8\xbd\xb7\x25\x46\x80\x3f\xf6\xb6\x7a\xbd\x9a\xd1\x93\x32\x91\x0d\x42\xbb\xa0\x6c\xf0\x64\x13\xdc\x90\xdf\x6c\x48\x9b\x4b\x6f\xa9\xa5\xb7\x9e\x29\xa5\x9d\xf9\xa1\xd4\xe2\x7d\xb5\xf8\xf3\x4d\x50\x16\xef\xd7\x71\x9e\x94\xab\xac\x78\xe1\x47\x6b\x9c\x5c\xa8\xf4\x26\x8f\xab\xd0\xfc\xea\xb9\x42\x61\x3e\x87\x9e\xf0\x57\x3d\xf1\xa2\xaf\x0d\x8a\xef\x98\x68\x6b\x15\x67\xf9\x25\x07\x46\x5b\xc6\x34\x14\xfe\xa3\xa3\x2d\x71\x1a\x0e\xff\x21\xd2\x96\x3f\x8e\xc3\x73\x9c\x12\x74\x55\x00\x7a\x09\x90\xea\xe0\xac\xfc\x51\x0f\xd5\x55\xd3\x22\xc3\x45\xde\xf0\xce\x2b\x0c\x45\xd4\x07\xbf\x82\xe8\x94\xfc\xef\xea\x39\xf8\x15\x5c\xf5\xcf\xce\x6a\xc4\x01\x2d\x86\xc1\xaf\x43\x5a\xe6\x0a\xd7\xc1\xba\xd6\x23\xe3\x8d\x19\xba\x06\x18\xf1\x0e\x33\x14\x32\xfa\x45\xe0\x28\x4c\x13\xae\x4f\x58\x55\x93\xa3\xdd\x83\x0f\x64\xf1\xef\x5d\xf5\x7a\x01\xe8\x5d\xf5\xb6\xe8\x7f\xfb\xf4\xbf\x4f\xe8\x7f\x9f\x07\x94\x61\xc9\x7f\xfb\xf4\xbf\x4f\xe8\x7f\x9f\xd3\xff\x6e\x8d\xc8\x7f\xb7\x9f\x59\x35\x1a\xd5\x59\x2a\xfe\xbe\x05\x3b\x7b\xc7\xdc\x33\xce\x2c\x05\x40\x94\xd4\x0c\x17\x53\xd5\x3b\x2e\xc1\xbb\xd6\x66\x13\x1c\x43\xae\xe8\x76\xd8\x83\xa2\x16\x77\xd0\x55\x81\x92\xa8\x6d\x1f\xc7\x4f\x51\x7a\x84\x72\x54\x0c\x6a\xee\x1f\xad\x50\xf4\xf8\x1c\xcf\x41\x46\x0a\x81\x74\x0c\x92\xa3\x74\x91\x44\x39\x0d\xb8\x1d\x21\x94\x00\xf2\x9e\x65\x94\xa0\x67\x05\xce\xd1\x35\x88\x70\xc4\x8e\x79\xd3\x7b\xe9\x6b\x59\x97\xde\x60\xfa\x49\x20\x15\x57\xef\x7f\x3a\x47\xd7\x87\x19\x4e\x33\xda\x56\x6a\xd5\xc9\xf7\x4d\xac\xee\xba\x39\x5a\xfc\x39\x94\x1b\xd0\x64\xd6\x88\x3f\x71\x71\xfa\xd0\xde\xd4\xf2\x6d\xfd\x02\x79\x8e\xae\x7f\x4e\x33\x7a\x3f\xfb\x39\xba\xee\x5c\x92\xdf\x8d\x25\x8e\xf1\xbf\x11\x2f\x90\xe3\xc9\x4b\x22\xbf\x41\x17\x3c\xf1\x14\x27\xf2\x8c\x24\x3d\xb7\x50\xdf\x43\x31\x22\xa2\x3f\xe5\xb3\x68\xc6\x23\xf0\x6c\xd9\x7a\x09\xd9\xf2\x70\x8a\xe8\x1d\x1b\xc4\xa6\xad\xef\x6f\x7e\x94\x5e\x92\x1a\xdb\xa2\xf2\x47\x44\x55\xfb\xd6\xb7\xbf\x6a\x6d\x8d\x84\x15\xad\x52\xc6\x4f\x79\xe7\x32\x0f\x80\x76\x9b\x3e\x69\x2f\x53\x5b\xd9\xcf\x1f\x78\x17\xf8\x73\xbd\x06\x0b\xf8\x84\x90\x45\x19\x99\x9b\x8a\x90\x3f\xa5\xad\xa7\xb4\xf8\x19\x1b\x26\xca\x5f\xfc\x4d\xbd\x78\xf6\x12\xfd\x40\x59\xfc\x8d\x2a\xc1\x63\xb0\x55\xa7\xea\xa9\x5d\x7c\xc0\x3b\xf9\xdf\xb2\x93\x3e\xbd\x04\x6c\x74\x8f\xd2\x02\x90\xc9\xe2\x05\x5f\xa8\x0b\xb6\xbe\x5e\xfb\xd6\x77\xbc\x18\x2d\x59\x1f\x55\xd3\x45\x4d\x67\x8a\xd2\x42\x3f\x94\x7a\x07\xb7\x10\xce\x14\x9d\x44\x85\x78\xae\x01\xd0\xf6\x73\xcc\xfc\xb5\x7f\x1f\xde\xe3\x2b\xba\x16\x7a\xf6\xe1\xd7\x21\x5d\x19\x4f\xf9\x30\x75\x4b\x5e\xbc\x01\x3d\xde\xa1\x7a\x7e\x02\xfa\x2e\x97\x10\x19\x7f\x03\xcf\x88\x88\xaf\x8c\x3e\x18\x0e\xc1\x93\x25\x38\xe0\x8f\x3c\x22\x8d\x44\x69\x1e\x33\xd7\x6c\x36\xe6\x1b\x27\xdf\x19\xf8\x15\x38\x2e\xd1\x2f\x6b\x5d\xa1\x3d\x15\x83\xc1\x5b\x9a\xe2\xe4\xe2\x27\x8b\x40\x35\x5e\x7b\xc9\x54\x52\xaa\x14\xab\xf2\xa9\x94\xac\xe2\x55\xb3\x70\x55\x45\x34\x5f\x5a\x1e\xcb\xe2\x0d\x73\x89\xba\x73\x44\xe5\xff\xed\xc7\xad\x4e\x51\xf9\x25\x64\xf1\x93\xa6\x4a\x96\xe8\xef\x0f\xe0\x09\xb8\xb9\x11\x6b\x9a\xe7\x5c\xd5\x87\xfb\x54\x20\xe3\xd6\x4f\x6d\xd3\x7c\xfb\x5f\x53\x85\x6e\x3f\xea\xd3\x9f\xcc\x17\xdd\x38\x74\x48\x81\x33\xf0\xab\x97\x88\xd1\xad\x44\xbb\xc0\xa8\x56\xba\x7d\xaa\x4b\x8e\xc6\xe1\x5a\xee\xcb\x6d\x60\x1f\x5f\x94\xd0\x0b\x09\xf9\xf5\xb3\xa5\x05\xf0\x3e\x00\xe9\x78\x9c\x23\x23\x38\x4b\xfd\x63\xf3\x38\x4a\x77\x25\x8a\xb2\x5c\x60\xaa\x4d\x41\xe9\x59\x29\x7f\x6e\x95\x3f\xfb\xe5\xcf\xed\x80\x0a\x52\x87\x15\xed\xea\x4c\x84\xee\xd0\x19\xb2\x8e\x5c\xc2\x39\xe8\x27\x11\x35\x5b\x9e\x14\xd3\x66\x8d\x94\xcc\xb6\xf7\xa7\x0c\x39\x51\x46\x6b\x46\x4d\x03\xd3\x8b\x6d\xfb\x15\xdb\x6e\xf2\x14\xf8\x8c\x87\x3e\x47\x02\x7d\x62\xe8\x8f\x5b\xfa\x63\x5f\x7f\xdc\x0e\xa4\x0b\xb2\x4e\x79\xea\x76\xc1\x7e\x72\x01\xf2\x3f\x07\x69\x6d\x60\x2e\x6e\xd3\x08\x6d\x65\xb7\x00\xdc\x8d\xfb\x1b\xb8\x95\x1b\xa6\xab\x58\x6d\xf5\x03\xf6\x06\x15\x2c\x11\x40\x00\x60\x14\x31\xe3\x90\x74\xa5\xde\x89\xde\x53\x28\x7f\x46\x5f\xff\xaa\xad\x44\xbd\x06\x07\x71\xbe\x55\x1d\xf1\x0a\x82\x3a\x16\xa0\x08\xfa\x3a\x82\x7e\x15\x41\x93\x8b\x3a\xdf\xae\x32\x4f\x05\xc1\x76\x83\xef\xfa\xa7\x8a\x39\xdb\xe8\xab\x53\x55\x90\x06\x23\xf6\xa8\xde\x44\x97\x0a\x12\x1b\xad\x21\xd8\x7a\xc1\x7f\xfe\x20\x98\x80\xbf\x68\x56\x8c\x28\x73\xe1\x71\x41\x27\x69\xe0\xf0\xc4\xfb\xf2\x86\xe8\x66\x41\xf8\x43\x71\xe1\xf6\xa4\xf6\xfd\xab\xe2\x33\x6f\xe7\x5b\x86\xee\xfd\xab\xe2\x14\x6f\xe7\xfd\xaa\xe6\xfd\xab\xe2\xf4\xce\xb7\x95\xd7\x86\x1a\xf4\xe8\x51\xc3\xaa\x4a\xdb\xb9\xa5\xb7\x73\xcb\xd1\xce\x7e\x43\x3b\xb7\x6b\xdb\xd9\xbb\x7b\x3b\xfb\x7a\x3b\xfb\x8e\x76\x6e\x37\xb4\xb3\x57\xdb\xce\xad\xbb\xb7\x73\x5b\x6f\xe7\xb6\xa3\x9d\xbd\x86\x76\x6e\xd5\xb6\xb3\xdf\xd8\xce\x26\x8e\xff\x38\xa7\xb7\xc6\xe6\x05\x2c\xdc\xe6\x0c\xf9\xa3\x82\xae\x70\x6c\x57\x4a\x20\xc2\x45\x45\x8d\x6f\x9d\x02\x91\x21\x2c\xfa\x0d\x40\x84\x7e\x45\xcd\x76\x61\x93\xfb\xd2\x3a\x91\xfd\xe5\x3a\x9d\xb7\x6d\x66\xf6\x2a\x93\xb6\x6a\x32\x5b\xe6\x6d\xd5\x66\x36\xa7\xae\x66\x34\x97\x73\x77\x73\x69\x66\x93\x13\x57\x34\x74\xcb\xdd\x50\x73\xe2\x1a\x0d\x35\xe6\xae\xde\xd0\xde\x1d\x1b\xda\x57\x1a\xda\x77\x37\xd4\x9c\xb9\x46\x43\x8d\xc9\xab\x37\x74\xeb\x8e\x0d\xdd\x56\x1a\xba\xed\x6e\xa8\x39\x75\x8d\x86\x1a\xb3\x57\x6f\x68\xbf\xb9\xa1\xb5\x4c\x8e\x0a\x7e\x53\x7b\xa3\xb2\xc7\x34\x93\xfa\x09\x5c\xd5\x27\xeb\xe6\xb1\xae\x6f\xd4\x4f\x67\x43\xe1\x74\xcc\x6a\x97\xc6\xc9\xfd\x3b\xf4\x1e\xfa\xee\x76\xdf\xdc\x03\xb3\xee\x80\xd9\xb7\x9d\x84\xf6\x58\xde\x53\x0d\x8a\x94\x9e\xe9\x67\xdb\x50\xad\xbc\x3c\xf1\x59\xa0\x6c\x0c\x43\x64\xdb\x8f\xb2\xa1\xfe\x1f\x74\x05\x67\x73\x9b\x53\xc8\x0a\x0e\xc4\x31\x79\x5a\x6f\x81\xae\x0a\xf5\x5a\xff\x9d\x3d\x7a\xb5\x3f\x79\x6c\xcf\x50\x9e\xc3\x09\xa2\x6a\x75\x00\xc2\xf1\xc4\x66\x1f\x96\x08\xe7\x31\x24\x6d\xbf\x2a\x40\x15\x21\x37\x14\xdb\x65\x95\x0d\x38\xcd\x6d\xb7\x5d\xcb\x3e\xdb\x27\x76\xd3\xcd\x5b\x14\xcf\x51\xd6\xde\xd9\x33\x02\xef\xd8\x1e\xa5\xf6\x8e\x87\xdb\xab\xcd\x53\x06\xf1\x56\xc4\x3c\xb2\xb0\x58\x35\x96\x70\xd0\x7f\x1e\x88\x68\xc4\x41\xff\xfb\x40\x8f\x47\x1c\x6c\xf7\x82\x32\x10\x71\xb0\xdd\x0f\x78\x7c\xe2\x60\xfb\xbb\xdb\xb3\xa0\xff\x7c\x2d\x41\xa8\x7f\xc0\xe8\xd3\x3f\x50\x8c\xe8\x9f\x38\xda\xd3\x10\x1a\xdf\x02\x1e\x76\x49\x7a\x46\x53\xbb\xa5\x09\x4a\x8a\xbc\x22\x13\x2a\x13\x45\x72\x75\x8c\x47\x1d\x8e\xe0\xe6\x46\x0d\xd9\xab\xcd\x98\xfd\x85\x82\x4a\x61\x8e\xca\x68\x52\x68\x4b\xd2\x42\xc0\x7e\x4e\xb3\x48\x9c\x60\x64\xb0\xf2\x8d\x03\xef\x62\x3c\x46\x19\x8a\xa8\x84\xd8\x11\x9b\xfa\x65\x4d\xd6\xcf\xae\x5e\xa0\x24\xa4\xbd\x40\x49\x68\x07\xe1\xb7\x06\x52\xc8\x0e\x79\x70\x77\xf6\xd9\x13\x09\xc8\x1e\x3d\xe3\x66\x6d\x40\x7b\x17\xf3\x9f\x5e\xbd\x2e\x83\x0f\xd8\xf3\x12\xd1\x0f\xa3\x9c\xdd\xeb\x43\xe4\x96\x88\x80\x28\xd0\x6c\x1e\xc3\xc2\x7f\xc1\x99\x67\xe9\x1c\x65\xc5\x35\xf8\x9c\xf0\xb4\x95\x62\x27\x89\xe6\x58\x97\x2b\x1a\xdd\xb2\xc0\xff\x46\x1d\xf0\x8a\xe5\x47\x1d\x80\x27\xa0\xbd\xd5\x7f\x0e\x46\xb8\xc8\x2d\xf1\x8b\x56\xdc\xf8\xc2\x82\x7a\xff\x1f\x6b\xc0\xfc\x69\xef\xc3\xee\xa7\x5f\x5e\x1f\x1c\xbd\xff\xf4\xfe\xe0\xd5\x1e\xd8\x01\xf4\x9c\x3e\x4c\x8a\xf2\x92\x0f\x9c\x4c\x84\xd7\x96\xcc\xa0\x59\x1a\x59\xe9\x64\x45\xff\x6a\xcf\x0b\x3d\x5f\x1e\xeb\xd0\xdb\xe3\x50\x2a\xa1\xd9\xf2\xd1\xce\xeb\xf5\x71\x29\x56\x86\x61\x5d\xdb\x4d\x93\x31\x9e\x2c\x32\x7e\xbb\x1f\x6d\x69\x55\x12\x49\x68\x17\x12\x85\x3e\x72\x22\x93\xb1\x05\x27\x53\x44\x46\xb3\x48\x69\xfa\xfd\x71\x9a\x51\xd7\x19\x20\xc0\xd5\x0b\x56\x1a\x48\x42\xfe\xc2\xf1\x64\x40\x67\x9d\xe8\xed\xa6\x43\xb9\xab\xeb\x2f\x55\x2b\x72\xd6\x0e\x3e\x47\x70\xe2\xc1\x05\x0d\x04\x60\x27\x29\x95\xde\x93\xf9\x41\xba\x7f\x8e\xae\x5d\xd8\x8c\x2b\xf6\xc2\xf1\x04\xb4\x0f\xf8\x15\x8d\x9b\xb4\x78\x68\x1b\x9f\xe5\x09\xea\x6c\x81\x38\xd5\xc9\xf8\xeb\x96\xf0\xb1\x20\x0a\x4b\x4e\xb2\x34\x46\x62\xf5\xab\x47\x47\xfd\x4a\x39\xf5\xdc\xda\x62\x40\xd3\x77\x55\xd5\x54\x84\x6f\x75\x98\x1e\xb9\xc7\xc6\x37\xcd\xda\x3c\x74\x83\x1d\xc5\x02\x9f\x01\xbe\x18\x00\x7c\x51\xae\x48\xb7\xae\x70\x43\x17\x53\xea\xf8\x55\xe7\xb5\x54\x83\x6b\x7c\x84\xe2\x24\xe9\x14\xe7\xbc\xa9\x3c\x6a\x4b\x17\x5f\xf5\x3a\x75\x8d\x9d\xb3\xfc\x54\x68\x96\x58\xe0\xeb\x54\xf0\xc3\xf8\x87\x9c\x0a\xaf\xd0\xfd\x4e\x05\x89\x7f\x6d\x53\x41\x5f\x6a\xef\x63\x2a\xec\x27\xb8\xc0\x30\xc6\xff\x66\xf7\x1d\xa0\xcb\xf8\x9a\xf7\x26\xe2\x04\x5d\x75\x1a\x08\x7d\xe1\x6a\x9c\x66\xb3\xf7\x69\x84\xc0\x1e\xa6\xd9\xf7\x8b\x29\x52\x57\x9d\x34\x53\x27\x1e\x3d\xc6\x4c\x53\x8f\x89\x69\x28\xd5\x8b\xc6\x19\xf4\xe7\x9b\x78\xf7\xcc\xf0\x6d\xf3\x83\x4d\xba\xae\x75\x2a\xe0\x04\x6b\x51\xc0\x72\xf8\x15\xee\xad\xdf\x92\xdc\x99\xcf\x09\x13\x52\xd2\x8b\x2b\x08\xdc\x5b\x56\x6c\xd2\x8c\x27\x62\x73\x32\x1c\x4f\x84\x92\xc4\x26\x4a\xad\xcb\xaf\x20\x86\xa8\x3c\x3a\xcf\xf8\x0d\x36\xf8\xb3\xd9\xd4\x2c\xd9\x7a\x58\xb2\xb8\xdb\x6b\x27\xe3\x18\x58\x64\x4d\xb3\x27\x12\xb3\x89\xc9\x52\xa2\x37\x74\x9f\x46\x51\xdb\xb2\x22\x82\xd5\x84\x02\x8d\x39\xd6\x97\x47\x7a\x55\x60\x2e\x5b\x45\x37\x38\xbe\x20\xbb\x73\x51\x54\xdb\x51\x17\x47\x66\x4b\x06\xa6\xb3\x88\xeb\x08\x16\x10\x8c\xa8\xc9\xe1\x04\x76\x58\x24\xb4\xc2\x4e\x08\xe3\x98\x8a\xf1\x06\x1e\x3c\x44\x19\xe5\xbd\x30\x4d\xc2\x0c\x15\xe8\x31\xa7\x78\x9c\x4e\x5c\x2b\xa8\x12\xa5\x71\xb4\xe6\xa1\xdf\x89\xa2\x9c\xf5\xbd\x48\xc1\x48\x0a\x6a\x14\x29\x72\x1a\x45\x77\x56\x8d\x6e\x72\x71\x33\x32\x2c\x20\xdf\x34\x23\x02\x57\xd4\xcc\xab\x55\x2a\x5d\x59\xa9\x51\x56\x05\x59\x03\x1c\x17\x28\x03\xf3\x2c\x0d\x51\x9e\xe3\x64\xf2\x85\x05\x77\x49\xd4\xa1\xe0\x6c\xde\x96\x76\x8b\xb4\xaf\x9a\x2c\x76\x39\x14\x97\xa2\xc3\x4b\xce\x13\x5e\x5e\x9d\x29\xe5\xe8\x34\xcb\x6c\x94\xb8\x43\x67\x19\xb7\x42\x0a\xa4\x22\x6d\x98\x19\xac\x41\x00\x5e\x40\x1c\xc3\x51\x8c\xd8\x11\x17\xb7\x30\x54\xf5\xa8\x4f\x82\x1c\xeb\x9b\x1b\xaf\x71\xc2\x35\xa5\x5a\x15\x86\x57\xec\xd4\x3b\x3e\xa4\x05\x02\xc5\x14\xb2\x9b\x77\xc6\x1c\x69\xa9\x45\x00\x9c\x03\x34\x1e\xa3\xb0\xc0\x17\x28\xbe\xa6\x37\xf9\xb0\x34\x8b\xf8\x02\x05\x20\x43\x30\x7a\x9c\x26\x21\xba\x83\xde\xe1\x3b\x1d\x69\xe3\xbe\xec\xa4\x64\x55\xfe\x91\xa6\xa6\x18\x21\xf7\xba\xe3\x55\xfa\x0e\x33\x5b\xe2\x58\x75\x6a\x0b\x04\x2b\xcd\xed\xd7\x25\x13\x2c\x28\xbc\x13\x18\x8f\x7d\xd1\x82\x5a\xa1\xe0\x2a\x51\x1f\xa4\xb0\xda\x42\x4a\x6f\xb6\x23\x3d\xe4\xd2\x06\x45\xaf\x48\x4f\x87\x72\x85\x7d\xad\x0c\x7f\x93\xe4\x31\x11\xad\xb8\x37\xbb\xd5\x7f\xde\xdd\xee\x3b\xa0\x98\xeb\xb8\x01\xa8\xa2\xe8\x0f\xc0\x96\x0b\x50\x37\x32\x07\xc0\x85\xd1\xc3\xa1\x92\x5b\xf7\x83\xe1\x52\xbb\xc1\xc0\x47\x72\x09\x4f\x05\x1f\xe2\x93\x29\x52\x14\x55\x66\xfc\x00\x08\xa6\x74\x0f\x95\x18\x6a\x2b\x0b\x2a\x61\x1c\xee\xc8\x74\xa5\x34\x1d\xb7\x10\x85\xc4\x56\xe0\xb2\xd0\xd2\xf9\x3f\x85\xdb\x84\x1f\xfb\x34\x37\xd9\x2a\x3b\xd1\x86\x2d\xb9\xa4\x0c\xd2\xb0\x0d\x7c\x32\x6e\x90\x3f\x09\x95\xa3\x18\x85\x05\x6b\xd9\x71\x91\xc1\x02\x4d\xae\xdb\x1e\xe7\x36\x95\x8d\x5a\x6a\x7d\x95\x99\x8f\x7d\xce\x1b\x70\x36\x38\x84\x79\x4e\x64\xef\x4b\x98\xa3\xc8\x95\x26\x46\xfd\xf3\x3e\x6d\xc0\x2b\x38\x46\x19\xf5\xc2\x10\x2d\xc7\x0b\xff\x2a\x32\x52\x08\x29\x49\x78\x36\x65\xfc\x4e\xbe\x7a\xf4\x85\x4f\x0a\x75\x91\x31\x83\x2d\x3c\x0f\x64\xf1\x4a\x9d\xa3\x2e\xc3\x39\x58\x1f\x02\xe0\x15\xd6\x51\xf9\x73\x89\x61\xf5\x8f\xcf\xee\x81\x41\xb6\x4a\xc0\xc7\xba\xfa\xa5\x47\x95\x04\xc0\x37\xba\xa4\xda\xb5\x7a\xf6\xa9\x59\x63\x1d\x2b\x56\xdb\x72\x29\xde\x32\xa1\x42\xfa\x1e\x6d\x5e\x64\x08\xce\xd6\xb8\x55\x4b\x2d\x03\xbe\xa3\xaa\x9e\x0d\xde\xee\x3f\x1e\x61\x76\xb4\x53\x77\x68\x30\xe5\x19\xe5\x20\x4d\x94\xed\xd6\x2d\xd0\xde\xee\x3b\x77\x5b\xed\xfb\x95\xc7\xb4\x2f\x95\x5d\xcb\xea\x4b\xbf\x33\xf4\xaf\x2d\x7a\x5a\x83\x76\x26\x8c\xa4\x39\xcc\xa8\x67\xa6\xc1\x44\x32\xd5\x1d\xea\xbc\x28\x63\xfb\x85\xd9\xf4\xe0\x41\x6b\x1c\x2f\xf2\x69\x6b\x69\xb5\x87\x21\x5c\x4e\xf1\x91\xc3\x37\xb0\x5c\xdb\xb3\x0c\x9b\x51\x8f\x5c\x02\x67\x28\x9f\x3b\x82\xcd\x1c\x9b\xce\x9f\x66\xcc\x97\xb7\xdb\xe1\x3f\x3e\xdf\xae\xca\xdc\x5a\x22\x06\x8a\xad\x8e\xc1\xed\xed\x51\x22\xc2\xb8\x93\xd1\x48\x54\xc6\xdf\xab\xdb\xc2\xcb\x6f\x82\xab\x3b\x61\xb4\xa9\xe3\x34\x53\x4c\xdb\x35\xe9\x67\x3b\x3a\x4d\x9a\xb6\x95\x24\x1a\x63\x3f\x9d\xce\xe3\x3f\x85\x5a\x25\xd8\x49\x68\x4d\x74\x47\x71\xf7\xe5\xae\xb1\x2d\x2b\x04\x3d\xbe\x60\xd9\x1a\xd6\xb7\x0b\x5b\x62\xf6\xdd\x7c\x92\x68\xc4\x06\x82\x82\xe2\x7e\x76\x5f\x25\xcf\x95\x0e\x94\xaf\x3c\x77\x4f\x3c\x57\xee\x7f\xae\x85\xe7\xac\xdb\x9d\xcb\xf3\x9c\x44\x73\x9f\x3c\x57\xb7\xcd\x79\x97\xbd\xfe\x3f\x16\xcf\xdd\x13\xf7\xd4\x48\x85\x95\xd8\xa7\xba\x45\xe8\xc7\x33\x4c\x3d\x91\x7b\x9e\x61\x83\x95\xc4\x0f\xaa\x5e\x80\x21\xc0\x17\x0e\x3e\xba\x93\xa6\xc1\x75\x3b\xba\x20\x83\xdd\x29\xc4\x09\x4e\x26\xcb\x86\xba\xbd\xa4\xc1\xa7\x9f\x04\xa9\xc1\xd0\x2b\x13\x65\xcd\xf6\x8d\xa6\x88\x10\x8c\x75\xdc\x6d\x1f\x9e\xb2\x61\x15\x65\x43\x06\x9f\x39\x34\xc2\x3a\x99\xff\x72\x57\x68\x15\x6e\x67\x8c\xbd\x39\x1a\x07\x12\x6a\xbd\xdc\xad\x57\x77\x6a\x9b\xc2\x9a\x23\x74\x55\xe6\xc9\x67\x5b\x7d\x74\x1c\x61\xc1\x4f\xdd\x3a\xda\x08\xdc\x13\x09\xd8\x66\x34\xb3\x3b\xa4\x8b\x9b\x9b\x21\xa9\xb0\x3e\x80\x73\xb5\x03\xd6\x58\x0a\x7e\xf2\x83\xe0\xe3\x3f\x2f\xa7\x28\x43\xec\x8a\x5a\xda\x81\xbc\x80\x99\x11\x50\xed\xdf\xfa\x5a\x09\xd2\x58\x1c\xb0\x70\x7b\x24\xb6\xa2\xd8\xe9\x71\xd2\x73\x26\x27\xe4\x09\xfa\x1a\xfb\xd5\xc1\x05\xa0\xdc\xa0\x32\x8e\x4a\xeb\xb8\x7d\x8e\xa5\x36\x25\xe3\x02\xd5\x08\x0b\x55\xf8\x34\x9f\x56\x2c\x8d\x51\xe9\xc2\x97\xaf\x9a\xcf\x10\xfe\x72\x70\x44\xfd\x8a\x7c\xbe\xd4\x82\x5f\xa5\x19\x25\x47\xb9\xdb\x1c\x00\x8d\x1c\x41\xd9\x98\x06\xaf\x01\x6f\xa8\x9a\xcb\xa1\x5d\x1d\xb5\xa6\xa6\x1f\xa1\x19\xa2\x96\x37\xd5\xed\x18\x47\xf2\x68\x19\xea\x36\xa5\xa9\x07\xe9\xeb\x5a\x4c\xc2\x12\x45\x17\x4c\xba\x0e\xf9\x7a\x98\xc7\x38\x44\x6d\xd1\x31\x79\x0c\xca\xa7\x87\x8e\x84\x16\x2b\x8a\xb2\x08\xad\x2c\xca\xa4\xba\xf3\x55\x94\x7d\x15\x65\xff\xe9\xa2\x6c\x4d\xf2\x80\x1e\x23\x9d\xe2\x7c\x69\x69\xd0\xd4\x3e\x3e\x19\xa9\xb8\xfd\xe5\xe0\xc8\x47\x3e\xaa\xe9\x61\x0c\xf9\x58\x57\x7e\x49\x51\xdd\xd4\xf4\x93\x92\xa2\x23\x14\xa6\x33\x2e\x00\x88\xd0\xc4\xe9\x22\x5f\x49\xca\x4a\x1a\xaf\x4d\x8e\x4a\xce\x16\xbd\x6f\x3b\xbb\xbc\x86\x3c\x25\x54\xdb\x17\x8a\x7f\x43\x36\xc7\x69\x9a\xe6\x08\xcc\xf0\x15\xd1\xda\xeb\x89\x45\xd3\x56\xd5\xda\x26\x40\x9d\x2d\xdc\xe2\xf0\x98\x1b\xe9\x05\x35\xf6\xc6\x69\x06\xf2\xc5\x28\x47\xff\xb7\x40\x49\xd1\xe4\xc1\x05\xba\x71\x23\xcf\xbe\xdd\x31\x03\xaf\xda\xfc\x0a\x67\xac\xba\x21\x4f\x14\x99\x86\xce\xd8\x12\xb3\x97\xf2\xa5\x29\x3d\x3b\xf9\xa3\xfc\x54\x1e\x89\xc6\x67\xe0\xd7\x21\x43\x61\xbd\xdb\xb9\x6c\xf9\x12\xfd\x11\xc7\x6b\x5f\xee\x9a\x08\x5d\x29\x83\xed\x46\xe3\x21\x8c\x22\xc2\x70\x2b\x79\xa8\xe7\x30\xa2\x0e\x6a\xf6\xef\x32\xfe\xe9\xc3\x9f\x76\x8f\xc1\xff\x7b\xda\xfd\x0e\xcc\x79\x03\x72\xbe\xe9\xe4\x5f\xff\xe1\x79\x98\x7f\x47\xad\xd5\x39\x8c\x3a\xe2\x69\x69\x0b\xf5\x10\x8a\x00\xc3\x45\x4e\x6f\xbf\x9e\xa2\x32\x59\x31\x3f\xfd\x1a\x01\x9c\x94\x6d\x5e\xc3\x39\x0c\x5a\x9f\x1a\x5f\x48\xba\xd0\x54\xde\xbe\xb5\xc4\x73\xdf\x2b\x11\x5c\x14\x67\x3e\x4d\x17\x71\x04\x46\x88\x52\x98\x5e\x23\xf7\x87\x77\x19\x4a\x4f\x8f\x1c\x50\xf2\xab\x0c\x32\x0a\xdc\xd7\x13\xb8\x42\x08\x61\x54\x0d\x31\x5a\xb3\x88\x97\xc8\x58\x96\xe2\xa1\x32\x3a\x1e\x19\x7c\x17\x49\x21\x67\x00\xcd\x61\x52\x5b\x57\xc2\x67\xab\x51\x15\x7b\xf1\x98\x8e\x7c\x99\x31\xf9\xbf\x2b\x00\x0d\x8d\x61\x41\x2a\xa2\x35\xb5\x99\x47\x69\x1e\x02\x06\x48\x98\x9a\xe6\x2e\xd6\xda\x56\x26\xd3\x30\xde\xf3\x0c\x1a\xc6\x7b\x9a\x37\x43\x7b\xbb\x4c\x7b\x7d\x9b\x9a\x7b\x67\xe4\x2c\xe5\xbf\xde\x28\x80\xc1\x23\x8f\xe4\x90\x6a\x9d\x9d\xf9\x22\x9f\xb6\x95\x37\x4b\xdb\x85\x95\x9e\x80\x61\x79\x70\x5b\xf8\x40\xd5\x0a\x03\xbd\xcd\x0d\x61\xad\x3b\x51\xd4\x48\x46\xca\x59\x61\x9a\x84\xb0\x10\x35\xad\xcf\x19\xfe\x31\x99\x4b\x21\x4c\xa5\xd8\x14\x46\x2c\xe9\x3a\x97\x5d\xbf\xa7\x64\x5e\x24\x35\xb2\xf9\x8f\x2c\x3a\x69\xc3\x57\x8e\xd0\xa4\xa5\xab\xb2\xb3\x41\x60\xbe\x41\x85\x12\xe9\xa0\xc9\x35\x7a\xd1\x22\x88\x61\x5e\xd0\xe7\xa5\xc4\x5c\x69\xe4\x9e\xb6\x75\x09\xf7\x18\x6c\x6d\xb2\x04\x3e\x67\x3c\xd5\x4e\x43\xb6\x39\xa6\xe2\x7a\x31\x7b\x59\xc9\xb0\x2a\x96\x6c\x65\x2c\xbe\xfb\xb5\x6c\xd7\xff\x71\x42\x51\x1a\x4e\xfe\x37\x86\x0d\xd8\x42\x06\x3c\x23\x51\xbe\xe0\xa9\xf9\xf7\x69\x84\x6e\xd9\xd6\xd3\x89\xf4\xfc\xb0\x98\x09\xea\x27\x50\x08\xb2\xfb\x72\xd7\x03\x21\x67\x9d\x5b\x39\x1f\x08\xda\xaa\xd6\x6b\x22\xa7\x73\x78\xa9\x19\x4b\xcf\xe4\x73\x6a\x2a\x87\xce\xdc\x33\x96\xf4\x8a\xf6\x22\x70\xbb\x85\x58\x3b\xeb\x9a\x73\xeb\x3a\xfa\xbf\xda\x31\x27\xc6\x82\x4e\xb8\x5d\xe5\xdc\x95\xf7\x89\x26\xbf\xcb\x2e\xf4\x33\x7b\xf5\x39\xc4\xa8\xa5\x4b\x88\xec\xda\xca\x03\xfa\x1e\x26\x01\x9d\xd1\xf3\x78\xcd\x14\x28\x59\xae\xd6\x01\x60\x9c\xfa\x13\x66\xb2\x1e\x67\xed\xe3\x25\x20\x75\x51\x75\x8a\x3a\x81\xa9\x97\xb1\x12\xc5\xd1\x68\xcb\x77\xbf\xad\x6f\x94\x1e\xd3\xbd\x09\xbe\xed\xde\xa5\x5d\xd2\x67\xdd\xec\xd5\xf8\x09\xa1\x39\x80\x05\x88\x11\x59\x7b\xd2\x44\x4c\x69\x9c\x30\xcf\x2e\x3d\x31\x47\xd5\x3e\xba\xe2\xd5\xae\x0b\x40\x7a\x3a\x66\x38\x61\x67\xed\xb8\x27\xb2\xee\xba\x21\xe7\x17\x8e\x8a\x71\x88\xd2\x57\xc6\xd7\x33\x7a\x44\x95\xb9\xe5\xf0\x05\x78\xf8\xb0\x69\x77\xbb\x26\xa3\xef\xe1\x5d\xbd\xbb\x65\x53\x75\xff\xb3\x8f\xbf\xb1\xa6\x5d\x2b\x84\x17\x7a\x19\x65\xa5\x9a\x2c\x8f\xe0\xf2\x57\xf5\x13\x50\xb4\xe7\x4b\x4c\xbc\x6e\x17\x1c\xc2\x88\xea\x1a\x3e\x86\x04\xb5\x84\xf9\x11\x10\x6a\xc5\xd2\xdf\xcb\x38\x68\x45\x60\x26\x3b\xd0\xe4\xe1\xd4\x5b\x29\x34\xd3\x85\xec\xbe\xe4\xc4\x17\xeb\x58\x53\x2b\xa8\x11\xe3\x3f\x9c\x4c\x3b\xb7\xb5\x62\xb5\xf3\x46\xf7\x12\xf9\x4a\xcf\xf3\x98\x8a\xed\x32\x51\xd6\x20\x4c\xe3\x18\xb1\xb9\x9d\x8e\x85\x86\x49\xed\x2e\x54\xa0\xcc\xa6\xaa\x35\xe9\xb5\x8a\xb5\xa6\x64\x57\x24\x6a\x55\x06\x2f\x95\x57\x4d\x09\xa4\xec\xe9\x19\x58\xc2\x48\xa9\x0c\x2f\x89\xa8\x8c\x98\x5a\xe4\xcc\x38\x2d\x93\x4f\x12\x89\x54\x77\x44\xd2\x85\x33\x87\x31\xeb\x1e\xfd\x41\xf1\xd2\xcd\x2a\xc8\x2e\x21\x43\x19\xbe\x60\xda\xaf\x10\xa2\x4d\xb8\x45\x90\x58\x69\x4c\x2b\xe7\x95\x6a\x2f\x6a\xf3\xd3\x94\xef\xd8\xf5\x7a\x8d\x39\x9c\xa2\xd9\x9d\xab\xb0\x9b\x46\x7c\x9b\x97\x1e\x7e\x1d\x2b\xa8\x9b\xb0\xbd\xa6\xf9\x41\x6e\x89\x16\x31\x83\x45\xc1\xcf\x7f\xf1\x4c\x11\xe2\x6d\x55\xdd\x0f\xd3\xe4\x02\x65\x85\x66\x78\xd1\x69\x21\x0f\xa5\xd1\x93\x6a\xec\x6c\xd0\xb2\xc9\xca\x0e\x19\x22\x3d\x65\x99\x7c\x79\xa7\x08\xed\xe6\x04\x2d\x7a\x37\x56\xf5\xc9\xc8\x2c\x28\x6a\xdb\x2b\xa7\xde\x60\x72\x2d\x46\x6a\x9e\xe6\x39\x1e\xc5\xc8\x4b\xc2\xd4\x37\x60\x1d\x49\x50\xca\x01\x30\xcf\xb1\xb1\x6f\xc2\x6f\xe7\x58\xde\xbe\x95\xbf\x4a\x39\x34\x50\x7e\x97\x39\x52\x9a\xca\x9f\xa3\xeb\x81\x9e\x55\xa5\xa9\x44\x25\xe5\x4a\x23\x3c\x91\x4b\x03\xfa\x5f\xff\x32\x52\xcc\x0c\xcc\x6c\x31\x8d\x85\xb9\xe5\x5a\x0d\x0c\x6d\x2c\x27\xcd\x59\xdd\x5d\xf6\xd3\xee\xf1\x77\x8d\x65\x95\x75\xf1\x49\x23\xb0\x94\x04\x4a\x55\xec\x5d\xe7\x60\x8e\x92\xe3\xe3\x77\x75\x18\xee\x9a\xe2\x46\x65\xc1\x46\xad\x7e\x86\xaf\xf6\x13\xbd\xc8\xfa\x22\xeb\x99\x8c\xcb\x57\x16\x72\xc0\x47\x52\x98\xe2\xb7\x92\x35\xc9\x21\x81\x17\xf9\xf2\x61\xd6\xe2\xec\xa1\xc8\x4f\x40\x97\x65\xfa\x1b\x8f\x71\x55\x02\x2e\x8d\xbd\x98\x66\xe9\x65\x0e\xf6\xb2\x2c\xcd\xc0\xfe\x18\x24\xa8\xcc\x50\x55\xf6\x2e\x49\xd9\x9b\xca\x02\x83\x32\x80\x73\xe0\x8e\xaf\xba\x2f\x81\xc7\xba\x2f\xc3\x71\xb8\xe4\x7b\x04\x5a\xad\xc6\xd4\x02\xd6\xa2\x9d\x22\x3d\xa6\xef\x3d\xf2\x1b\x34\x20\x70\xcc\xbd\x25\xa7\x97\x40\xa7\x4e\x31\x49\x73\x8f\x53\x05\x25\x30\xb8\xb9\x61\x93\xae\x2c\xde\x11\xdc\x73\x2d\x3c\x59\x36\x4c\x77\x8b\x0c\x67\x13\x64\xb5\x3d\x7e\xd6\x52\xba\xcd\x2f\x7f\x2e\xb3\xd3\xcf\x49\x6e\x9b\x83\xfe\xcd\xe0\x48\x5e\x4b\x3a\x0e\x65\xcb\xc4\x98\xae\xb2\xf5\x2f\xc5\x13\x74\xcb\xa6\x44\x54\xfe\x38\x4c\x67\x73\x58\x50\x1d\xe3\x6e\xf2\x4a\xd5\x02\x2a\xfa\x8d\xa2\x87\xdf\x4d\x89\xb2\xc9\xa9\xf5\x75\xe4\xf7\x38\x4b\x94\xb2\xd6\x1f\x8b\x19\xef\x98\xdb\xca\x7c\xf2\x58\xd3\x5c\x53\x5e\x22\x59\x65\x59\xf5\xf7\x38\xab\x77\x03\x68\x12\xac\xfc\xd2\x70\xb7\x13\xb1\x08\x2b\x65\xc9\xbb\x06\x37\x17\xa5\x58\xad\x93\x8b\x20\xf1\x71\x1d\x5f\x2a\xf9\xc3\x8d\x2d\xe9\xd3\xde\xd5\xd3\xed\x67\x5b\xcf\xc2\xef\xe8\x25\xe3\xcf\x9e\x3e\x7b\xf2\x74\xfc\x74\x7c\xb6\x29\x76\x92\x69\x2d\xe2\xa1\xec\x73\xb3\x1b\x69\xb9\x86\xf9\x50\xb3\xd9\xb5\x22\x31\x96\xeb\x0b\x4b\x34\x7e\x0f\xfa\x52\x9d\xd8\x51\x33\x98\xac\xc7\xd4\x12\x42\xa2\x9c\x62\x6b\x96\x17\x7a\x3e\x57\x21\xf9\xd6\x26\xec\x7e\xbf\xe4\xae\xa6\x99\x57\x91\x46\x73\x98\xe5\xa8\xad\xc9\xae\xa5\x43\x98\xb2\x5c\x73\x90\x97\xc8\x9a\x4e\xe2\x93\x92\x80\x5d\x9d\xe1\x2f\x87\x18\x4f\x1b\x0d\xf7\xdc\x62\xf3\xac\x47\x84\xe2\x28\x3e\x36\x7e\x33\x7c\x5d\x25\x27\x28\x2f\x58\x70\x2a\x8c\xeb\xc5\x57\xa5\xa6\xd3\xde\x19\x18\x0e\x41\x29\x8e\xc0\xc3\x87\xd5\xd6\x9c\x6e\x71\x18\x21\xa6\x3c\x1c\xf8\x7b\x57\x6c\xdb\xbe\xb6\x3d\x40\x97\xd5\x86\x94\xac\xb4\x83\x87\x91\xf7\x03\xf0\xc4\x1a\xc8\x59\x69\x02\x0f\x69\xa0\xc8\x69\x8c\x45\x89\xae\xb6\xa8\x51\xeb\x9c\x56\xdb\xab\x89\xb1\x33\x4a\x6a\x31\x12\x5b\xcf\xee\x22\x5c\xad\x9e\x11\x87\xeb\x23\x50\x1c\x0e\x4e\x5b\xf9\x6e\xf1\x18\x42\x2e\x5d\x66\x70\x3e\xa7\x06\x18\x2c\x78\x53\x55\xc7\x30\x80\x44\x77\xcc\x95\x5c\x39\xbe\x02\xcd\x95\xba\xc3\x48\xbb\x53\x26\xf0\xb0\x7d\xba\x5b\xae\x85\x35\x87\x4e\x48\xf5\x5c\x98\xe2\x75\xc6\xb7\x70\x7f\xaa\xf4\xe2\xe4\x62\x39\xba\x92\x88\xf1\xb3\xf4\x0e\x94\xb1\x11\xf5\x2e\x14\xcf\x1b\x0b\x6a\x52\x3c\xd1\x16\x0f\x0c\xab\xc3\xce\x67\x2b\xdc\x7c\xc0\xb7\xd5\x09\xef\xf0\x2c\x41\xeb\x4f\x3b\x56\xfa\xf6\xeb\x3d\x1d\x35\xb9\x17\x79\xdb\x58\x94\x31\xff\x5d\x66\x5d\xfc\x0f\xcc\x7e\x6d\x57\x53\x76\xfe\xec\x4a\x0a\x91\x54\x76\x7f\xb4\x29\x53\x64\x1a\x2b\xd3\x29\xab\x65\xb4\x6a\x76\xcd\xdc\x5f\xdd\x65\x56\xf0\x1a\x4f\xe9\x17\x6d\x47\x20\x65\x86\x43\x0f\x5c\xda\xa5\x6b\x49\x5d\xe6\x4e\x2c\xb6\xce\xf4\xe5\xab\x66\x2e\xdf\x6b\x38\x1c\xac\x64\x12\x4d\xcb\x74\x09\xb6\x3b\x41\x9a\x32\x8a\x19\x1a\x6b\x79\x8e\x5f\xe6\x25\xe5\x34\x5a\x9f\xd2\xba\x4b\xc9\x52\xd6\x44\x23\xc4\xea\x70\xf3\xf0\x74\xb2\x8c\xc9\x14\x6b\x2e\x35\x61\x25\xe5\xc8\x53\x47\xd3\x14\xa7\xda\x32\x62\x9f\xa8\x1e\x8a\x30\xbc\x24\x49\x07\x5f\xd4\x43\x2b\xbb\x3c\x9c\x7d\x6b\xc1\xd9\xbe\x4e\x89\x9e\xc6\x40\xd5\x96\x90\x3b\x3a\x65\x21\xfe\xaa\xbe\x9c\xb2\x9b\x53\x3d\x45\x5a\x5f\x50\xd9\xd9\x21\xf3\x63\x5c\xef\x4a\x71\x6a\xa7\xcb\x2b\x0d\x3c\xe6\x2d\x97\x7c\x24\x77\x1b\x1c\x21\x0a\xe0\x0b\x2b\x10\x2a\x97\x4a\x1d\xa2\x12\xa0\xa1\x3c\x16\x69\x53\xd2\xe6\xff\x00\x4d\xa2\x92\x56\x5a\xde\xdd\xf9\xa7\xd0\x1e\xca\x9b\x46\x9b\x97\x4c\x91\x25\xd2\xb2\x64\x8a\x19\xc3\xf3\x94\x2a\x99\x23\xd7\xb1\x7c\xae\xbb\xb5\x55\x75\xe1\xde\x57\x7a\x67\x3e\x4f\x47\xaa\xcd\x3f\xc2\x62\xcf\xfd\x94\x8a\x43\x52\x9d\xfc\xee\xea\xd4\x55\x9b\x47\xda\x51\x37\x93\xda\xd1\x52\xaa\x36\x34\x82\x0b\xc4\xda\xc5\x5b\x65\x0a\x4d\xe3\xd0\xae\x5e\x62\x84\x2d\xb5\x07\x45\xac\x6a\x4e\xe9\xa6\x75\x5a\xd6\xb6\x7e\x87\xb0\x55\xe8\x57\x09\xef\x0c\x4b\xa0\x3e\x73\x98\xe7\x8b\x19\x8a\xf4\x08\x21\x18\x67\x08\x46\xd7\x8a\x9e\xa2\xf9\x37\x16\x49\x38\x85\xc9\x64\xf5\x0b\x36\x96\x5b\x14\x1a\x45\xfa\x52\x2e\x06\x22\xcb\xe9\xd1\xda\x2c\x47\x6b\x5a\x35\xdd\x8e\xec\x45\xb2\x9e\x1a\xfe\x24\x66\xa2\x31\x71\x99\x6f\xfb\x40\x70\x22\x90\x53\xd8\x5e\xb7\x23\x25\xb7\xe1\xec\x56\x25\x03\x47\x59\x23\x00\x95\xdc\xda\xaa\xac\xf1\x4f\xb1\x2d\xa2\x68\x99\x48\x37\x85\x53\xcd\x16\x3d\xf0\xdd\x9d\xe2\x75\xf8\x6d\x4a\xf9\xbd\x5d\x26\x18\xe0\x27\x7b\xcc\xe8\x6a\xd1\x01\xe7\xd1\x98\x86\x06\xb0\x7f\x57\x89\x0b\x58\x22\x84\xb5\x36\x36\xe0\x27\xd6\x12\xd2\xa2\xbb\x84\x04\xbc\x22\x4d\xa1\x91\x8d\xa4\x61\x44\x2a\xee\xff\x43\xf8\x21\xe7\x3c\xe9\xfa\x5d\xb7\xd9\x04\x1e\x1e\xd7\xca\x1f\xa8\x3e\x4c\x2a\xa7\xd5\x79\x27\x03\x28\xaf\x04\x46\x2c\x72\x15\x27\xfc\x44\x1f\x0f\x8c\xe4\x21\xcd\x13\x94\xd0\x43\x7d\xde\x88\xe5\x7d\xc0\x0e\xbc\xec\x32\x59\x6f\xb4\xa6\x7b\x91\xba\xef\x15\xbd\x7c\x07\x3c\x7b\x42\x0f\x24\xd2\x0f\xe2\x40\xdc\xfe\x18\xa4\x33\x4c\xd4\xc7\x00\x40\xf6\xe9\x12\xc7\x31\x18\x21\x59\x77\x04\x32\x98\x44\xe9\x2c\x76\x9a\x04\x6b\xf1\xf8\xb1\x90\x53\x4e\xd2\x00\xec\xff\x23\xa0\xfc\x41\x9a\xf4\xa7\x90\xf2\x8c\xbb\x22\x53\xc4\x2b\x53\xa6\x83\xae\x50\xb8\x28\x50\xbb\x25\x6e\x18\x68\x05\xa0\xff\xf4\x59\x77\xbb\x1f\xf0\xe3\x00\xcd\x9a\xf8\x9a\x2a\x0a\x40\x8b\xd0\x96\xfc\xdf\x7d\x4b\x8d\xc3\x7b\xc6\x90\xab\x0b\x89\x98\x67\x81\x98\x31\x01\xe7\x70\xb6\x9b\xd4\x78\xb2\x99\xb1\x1a\xe7\xb4\xe6\x0d\xc8\x07\x3e\x01\x14\xc6\x8e\x20\xc3\xde\x7e\xf6\xa4\xfb\x7c\xe5\x5b\x67\x98\x04\x53\xc4\x57\xad\x82\xcc\x2e\x00\x64\xf7\x9b\x97\xfb\x6e\xf2\xda\x17\x21\x5d\x1e\x09\x71\x70\xbb\xd9\x09\xd3\xd9\x7c\x41\x13\x0e\x08\x8a\xd2\xbe\x36\xdd\x20\x48\xe6\x52\xe1\xdd\x2e\x7a\xc0\xd2\xd8\x2a\x25\x56\xbf\x9a\x6b\x8b\x37\x6f\x53\x8c\x25\xf8\xb6\x76\xf7\x92\x14\x97\x5b\x96\x43\xd9\xb9\xc6\x3c\x1d\x47\x5c\xbd\x5f\xdd\x59\x57\x3a\xd7\xa4\x1d\x79\xff\x7b\x98\x36\x87\xa3\xb6\xa1\x19\xf1\xc5\x4e\x2c\x13\x95\x75\xce\x62\x4e\x7c\xeb\x32\x14\xd6\xbd\x11\x6a\xb9\xe0\x44\xee\x84\xda\xbf\xd9\xfc\xf8\x7f\x90\x8d\xd1\x9f\x5e\xbd\xbe\x05\x44\x45\x12\x47\x8c\x6c\xfa\x57\x69\xb0\x88\x75\xad\x5e\x09\x59\xc3\x76\xa8\x85\x64\x5e\x07\xc7\xcf\xa3\xf1\x40\xd1\xbb\xec\xbc\xbb\x96\x7d\x51\x9e\xf9\xe3\xee\xba\xd7\x1f\x79\xa3\xb4\x56\x2f\xfc\x33\x78\x39\xff\x32\xfb\xa5\x16\xc9\xe3\xb5\x59\xd8\x12\xa3\xe9\x71\xdd\xde\xfd\xb7\x21\x00\x9f\x7f\x97\xfd\xca\x52\x61\xf8\xa3\xf8\x31\x2b\xba\x52\x5a\x78\xec\xc9\xd9\x34\x5c\x52\x29\x51\x6e\x85\x52\xab\xf4\x94\x0d\x90\xd4\x39\xf9\x33\x53\x57\x3c\xf2\x32\x31\x2b\x8b\xd1\xa1\xae\xfb\x1d\xaa\x30\x69\xed\xea\x34\xa5\x9c\xf4\xd9\xb2\xd5\x5c\xb6\xee\xad\x72\x35\x87\xa8\x31\xee\x7a\xab\x94\x1d\xde\xba\xc6\xbd\xc7\x57\xc4\xe4\xe4\x65\x9b\x06\x45\x71\xb8\xb1\xf3\x4c\x5a\x9d\x1e\xae\xdb\x26\x6f\xcc\xda\x37\xec\xfe\x88\x2b\xdb\xfd\xed\xe0\xfd\xe9\x97\xb8\xff\xcc\x8d\x3c\xdb\x5a\xb2\xec\x4e\xde\xba\xd6\x95\xb5\xb7\xd6\xdc\xc9\xbb\xdf\x25\xd0\x73\x23\xef\x8f\xb7\x0a\xfe\x41\x76\xf3\x7e\xd7\xa5\x38\xd0\xc2\x9a\x9b\xfd\x19\xf7\xbd\x36\x2f\xbb\xb9\xe9\xde\xe4\xb6\x2d\xcd\xea\x08\x2d\xbf\x3a\x7b\xee\x76\x5a\x77\x29\xb4\x67\x96\xa9\xf8\x9b\xf2\x3b\x07\xb8\x0d\x3e\x6f\x74\xba\x61\x9a\xa1\x8d\x41\xff\xfb\xdb\xb3\xa0\xff\xfd\xe0\x54\xcc\xaa\x76\x86\xfe\x6f\x81\x33\x14\xcc\xd2\x68\x11\xa3\x00\x5d\xcd\xd3\xac\xc8\x37\xcb\x89\xf4\x42\xb9\xb2\x26\x4b\xd3\x22\x00\x63\x18\x16\x69\x66\x5c\xcd\xaa\x6c\x17\x71\x2c\x60\x38\x1c\x82\x0d\x66\xb1\x6c\xd8\x26\x27\x9d\x2e\xb3\x59\x9a\xfc\xfd\xd8\xf8\xc6\x1a\xd4\x91\xa8\x4a\xa4\xa2\x01\xd5\x13\xad\x3a\x8d\xe8\xde\x91\xd2\x26\x96\xe1\x92\x35\x49\xf4\x68\x03\x3c\x7c\xc8\x3f\x74\xe0\x2c\x72\x34\x71\xe7\xfd\x2b\xe3\x35\x2b\xd4\x3e\x3d\x2b\xc9\xd1\xd8\x18\x2b\xf2\x37\x71\x3a\x82\x31\x68\x8f\xb2\xf4\x32\x47\x99\x99\x0b\x90\xd0\xbc\x23\xa4\xaa\x4f\xe7\x6f\x39\x7b\xea\x49\xa0\x34\x60\x43\xcf\xfa\x56\xca\x6d\x40\x18\x05\x84\xe9\x6c\x9e\x26\x28\x31\x2e\x68\xa8\x48\x6b\xba\x6f\x55\xb6\x4d\xfe\xbc\xb9\x51\x6f\x3a\x7a\x0f\x8b\x69\x50\xa6\x32\xb7\x92\xf9\xdb\x6f\xfe\x3f\xde\x92\x77\x69\x08\x63\x30\x4f\xe3\xeb\x31\x8e\x41\x3a\x06\x4c\x61\xe1\xde\x3f\x01\x66\x77\x74\xf1\xbb\xa1\x87\x7a\x99\x4a\x73\x5c\xcb\x84\x84\x78\x4d\x40\x6c\xce\x41\x60\xbb\x66\x37\x1d\xfd\x56\xb7\xf0\xd0\xc3\x2a\x8b\x11\xe1\xc2\x1a\x29\xf0\xba\x33\xcf\xd2\x22\x25\x50\x60\x48\x8c\xfc\x1a\x58\x8e\x0d\x0c\x41\x82\x2e\x49\x63\xbd\xf1\x26\x8b\x38\x6e\x16\x45\xb2\xb5\x36\x18\xcb\x05\xb2\xf4\xf2\x58\x5f\x47\xaa\x64\x91\x55\x76\x48\x97\xdc\x15\x7d\x87\x47\x19\xcc\xae\x57\xdb\x8c\x8d\xf1\x88\x6e\xc6\xb2\x7f\x97\xa9\x96\x68\x54\x62\x97\x8b\x28\xcd\x62\x04\x60\x0c\x70\x32\x45\x19\x76\xdd\x1a\xe7\xca\xfa\x49\xf0\xc9\x74\x9f\xec\xa1\xc2\xce\xb5\xac\xba\xf2\xe5\x33\xe2\x2a\x47\x9a\x98\x46\x1e\xe3\x9e\xc2\x42\xf4\x83\x27\xa2\x65\x56\x41\x9d\x63\x0a\xb8\x15\x6b\x60\x35\x4e\xd2\x0b\x94\x65\x38\x42\x39\x38\x64\x8e\x5f\x8c\x72\xa6\x1f\xcc\xaf\x01\x4e\x68\x56\x29\xa4\x34\x6b\xe5\x7a\xab\x37\xc1\x9f\xac\x07\x6d\x9d\x61\xd2\x5c\xfa\xee\x97\xdb\x10\xbe\x79\x7f\x7d\xc2\xa6\xbd\xa6\xf7\xfb\x9d\xda\x51\xb3\xaf\x60\x14\x47\x03\xd0\xba\x80\xf1\x02\xb5\x6a\xe2\x79\x1b\x1b\x45\xfe\x66\xa8\x98\xa6\x91\x6f\x8a\x42\xa5\x60\xcd\x95\xd7\x0d\x79\x5e\x80\xdb\xca\x00\x74\x7b\x93\xd0\x42\x3b\x13\x2a\x98\xcf\xe7\x8a\x9e\x39\xbc\x4c\x6a\x81\x94\x05\x80\x68\xd4\x6c\x0f\xab\x29\xcf\x2a\xc7\xbe\xb3\x98\xcc\x50\x52\x7f\xf6\x90\xe8\x38\xde\x2d\x06\xe5\xfa\xc1\x7d\x3b\x65\xd1\xa6\x3b\xe9\x9b\x1a\xcb\x83\xf4\x45\x22\x15\x2c\x53\x5b\xb9\x13\xd0\x02\xb9\xc1\xcb\xdb\x34\x85\xf9\xc1\x65\xc2\xe7\xfc\x75\xbb\x45\x90\xb4\x36\x65\xa6\x0f\xf2\x48\x55\x38\x01\x4f\x5e\x2c\xd3\x67\x86\xc0\x97\xfb\x2c\x08\xfe\x2b\x5f\xcc\x89\x9d\x93\xe0\xa2\x03\x89\x41\xc9\xb5\x2d\x98\x4d\x16\x64\xa8\x9a\xc8\x08\xea\xef\x67\x07\x5e\x94\x2e\xb3\x86\x65\xad\x1c\x94\x2b\x3c\x66\xbb\x8f\x82\xdb\x98\x0c\xab\x45\xa6\xd2\x45\x53\x15\x9a\x55\x16\x20\xb6\x73\xc7\x28\x43\x49\x48\xaa\xa5\x83\x36\xaf\x91\x5b\x06\x21\xb9\xb1\xdb\x50\x8d\x8f\x62\x02\x1a\x6e\xfc\x6f\x58\xeb\xf6\xa8\x14\xc8\xd5\xb5\x8c\xed\xce\x2e\x12\x46\x54\xca\x39\x4c\x7a\xd5\xde\x84\xb6\x23\x18\x81\x2e\x57\x6c\xb6\x6f\xca\x28\x1b\x62\x40\xd3\xeb\x43\x28\xbe\xf6\xe6\xd7\x75\xab\x2c\x0e\x44\xb4\x02\xbf\x57\x17\x0c\xf9\x12\x26\x36\xfe\x57\x14\xf0\xac\xb4\xf7\x92\x63\xb6\x82\x8a\x9e\xf2\x76\xd4\xba\x92\xf2\x4e\x60\x45\x40\x88\x77\xba\x90\xf0\x61\x78\x51\xf2\x5e\x38\xbe\x2e\xf5\x60\x33\x17\x81\x03\xbe\x6e\xf0\x8b\xbe\xe9\xc4\xa0\x47\x8d\xa3\x08\xe4\xe9\x0c\x81\x38\x9d\xe0\x10\x5c\x4e\x51\x02\xae\xd3\x45\x26\x8f\x23\x43\x62\x5f\xb2\x7a\x7e\xc7\xdb\xff\xd6\xa8\x20\x55\x13\xc6\x79\x2a\x34\x80\xc9\xcf\x4e\xa7\x96\xcc\xe2\xef\xde\xd4\x9f\x65\x5a\x7f\x07\x6e\xdb\x4d\xe7\x44\x85\x9f\x97\xda\x3c\xd7\xe2\xd7\x6e\x3a\x28\x55\xd0\xcd\x05\xcd\x7e\x98\xd1\x8d\xb0\xdf\x91\xef\xb8\x44\x63\xba\xd7\x0a\xfa\xf7\x7d\xb1\x01\x6d\x90\x16\xe2\x27\xe9\xd6\x24\x2d\xe5\x2d\x3f\x22\x46\xe7\x03\x9c\xd1\x08\x57\x7f\x14\x80\xeb\x81\x65\x89\xaa\x26\xa8\xe2\xde\xf4\x55\xda\x08\x77\x9d\xaa\x25\xcf\xc0\x50\x69\x95\xfe\xc9\x43\x6f\xbb\xab\xda\xb6\x07\x2e\xd3\xa4\x55\x30\x2b\x56\xe4\x3d\x52\xee\xe3\x89\xd3\x74\x0e\xe0\x28\xbd\xa8\x57\xa1\xea\x29\xd5\x12\x88\x5b\x5e\x74\xa2\x8b\x9b\x6c\x8b\x4a\x1e\xf9\xb6\x49\x5d\x75\x7e\xb9\x83\xb8\x90\xae\x07\x4a\x2b\x1a\x25\x7d\x77\x49\x61\x53\x9a\xc2\x38\x4d\x5c\x9b\xb9\xcd\x18\xd7\xb3\x10\xd1\x36\x80\x61\xa9\x3d\xd0\x17\x2b\xeb\x3b\xa4\xf0\x32\xea\x0e\x27\x8b\xb4\xb0\x4a\x43\x40\xac\x83\x4d\x27\x35\x96\x71\x15\x2e\x11\x74\x99\x00\x48\x93\x8e\x55\xae\xd7\x59\x21\xcb\xb9\x71\xcb\xb1\x37\x62\x5b\xa2\x6b\x19\xf6\xaa\x5f\x01\x94\xe3\x49\x82\xc7\x38\x84\x49\xc1\xef\x69\xc2\x8c\xaa\x14\x3b\xab\xd2\xdf\xed\xf7\xb3\x92\x73\x8d\xf9\xfe\xd4\x37\xf7\x96\x75\xba\xb6\xa9\x0e\x52\x03\x6d\x21\xd6\x68\xad\x1e\x49\xf0\x1f\x4e\x60\x3d\x44\x21\xc9\x5e\x09\x5b\x68\x1c\x01\x54\x5f\x91\xb3\xfe\xbb\x05\x0a\xa8\x49\xf3\x34\x0d\xd3\x88\xc4\x6e\xde\xc6\xf7\xc7\x75\xda\xbb\xea\xf5\x7a\x5b\xbd\x7e\x6f\x3b\x00\xbd\xab\xde\x93\xde\xd3\xde\xb3\xde\x77\x67\x5f\xa2\x8e\x00\x3c\xbb\x63\xb2\x65\x7e\x05\x8a\x18\xe9\x3a\xc9\x75\xc9\xb3\x9e\x51\xb1\x25\x1e\xd8\xbf\x37\x37\xf4\x5a\x42\xb7\xce\x3b\x06\x6d\xc9\x4c\x0f\x86\xf5\x5b\x53\xea\x1f\xad\x4b\x09\x7a\x17\x3f\xef\x78\x88\xad\x8a\x96\x71\x6b\x8c\x92\x49\x31\x65\x01\xf5\x4e\xf4\x76\xc9\x7b\xd7\xe4\xd2\xa5\x10\x58\x47\x4a\xe9\xbd\x24\x4c\x23\x32\x81\x11\xfb\x51\x9d\xbf\xf4\x75\xfd\x05\x5e\x92\x1f\x51\x12\x76\xde\xa2\xab\x65\x5b\xd2\x94\x69\xfa\x0e\x52\x6f\x4d\xf9\x9e\xcb\xd9\xb7\x5c\xb2\x67\x4b\x22\xcd\x25\x32\x3d\x5b\x4a\x6b\xa4\xfe\x58\x8c\x9d\x87\x67\x96\x48\xf0\xcc\x07\xde\x27\xbd\xb3\xe0\x91\x9b\x1b\xf0\x16\x5d\xf9\x66\x74\x5e\x89\xe1\x43\x58\xa0\x84\xab\x99\x3a\xc7\x37\x2f\xda\xc0\x87\xf1\x95\x38\xb7\x72\x78\x4f\xf8\x72\xa4\xcc\xaf\xf9\x1c\x25\xcb\x87\x2c\x5a\xa3\xe9\xee\xd6\xee\xd5\x79\x59\xf6\x6f\x4b\x64\xa0\x95\x6f\xdc\x87\xdc\x1c\xa7\x29\x68\xf9\xea\xaa\xc0\xae\xdd\x5c\x47\xa2\x60\x32\xb8\x3f\x1b\x8b\x47\x7d\x8a\xa4\x62\x0a\x65\x9a\xcd\x72\xce\x78\x15\xc4\xf9\x71\x29\xd9\x35\x49\xdf\x5c\xe5\xb1\xbe\x26\xb0\x5a\xcb\xe2\x75\xb4\xd8\x8d\xe1\x6c\x0e\xd0\x15\xbd\xcc\x69\x84\x6b\x68\xc2\x62\xdc\x08\x78\x6d\x28\x01\x8b\x6d\x0b\x1b\x52\x1d\x6b\xfd\xfd\xef\xe6\x9b\x7d\x29\x56\x62\xe1\x25\x88\x6a\x6c\x00\x16\x00\x82\x02\xcf\xea\x4d\x60\xdb\xcd\xc2\x2a\xc1\xbc\x2e\x97\x07\x0a\xa5\x49\x29\x30\x24\xed\xe7\x03\x7d\x8a\xc5\x5d\xa8\xe4\x9f\x76\xff\x09\x78\x0c\xda\x98\x75\xe9\x5b\xf0\x7c\x73\x53\xde\x91\xda\x54\x85\xe4\xb7\x53\x9d\x3a\x8f\x00\x96\xf7\xad\xde\x0c\xcb\x56\xfc\xf0\x83\xa8\xce\x02\x2f\xeb\x5f\xd9\x28\xf7\x51\x48\xd4\x51\x61\xe2\x64\x5d\xa3\xe2\x75\xdd\xb3\x2f\xd5\x86\xc0\x1c\xae\x95\xe9\x52\x3f\x3f\xa4\x76\xf6\x68\xa8\x77\xa9\x7e\xc2\x4c\x21\x4e\xe0\xa8\xc6\x41\xa0\xd8\xde\x6b\x5b\xda\x58\x16\x5b\x62\xfb\x68\xf6\x10\x36\xa2\xb9\x64\x91\xfb\x5c\x10\x4a\xd1\x62\x87\x76\x2c\x02\xa4\xd0\x0a\x37\x19\xd6\x8b\x7e\xd3\x66\x68\xc8\x08\xef\x94\xdc\x8d\xa2\xb7\xde\x6e\x39\x95\x98\xc5\x85\xcb\x43\x2a\x4d\xd8\x1f\x15\x01\xdb\x7d\x22\x02\x72\x4d\x96\xd6\x4f\x7c\xcd\x6c\x18\x82\xf7\xb0\x98\x76\x42\x84\xe3\x12\x49\xd7\x79\xa2\x77\x15\x0d\xca\xee\xa3\xbb\x83\xfa\xe1\x38\x1f\x50\xe7\xa2\xbb\xb7\xc3\x6b\xdc\x25\xa7\xb2\x71\x9d\x4f\xce\xc9\xc6\x4b\xf8\xe2\xd4\x7a\xa9\x7f\x87\x75\x5c\xbd\x98\xf7\xff\x67\xef\x6f\xb8\xdb\xb6\x91\x46\x71\xfc\xab\x60\xef\x39\xdb\xca\x35\x1d\x13\x7c\x97\x37\xee\xbd\x8e\xed\xd4\xbe\x71\xe2\x5c\xdb\xd9\x76\x9f\x9c\x34\x87\x2f\xa0\xc5\x46\x96\xfc\x48\x74\x62\xf7\x69\xf7\xfb\xfc\x3f\xc7\xff\x8b\xfd\x0e\x06\x20\x09\x52\x24\x00\x4a\x72\x5e\xb6\x52\x77\x63\x91\x02\x06\x83\xc1\x60\x30\x18\x0c\x66\xba\xaa\xb2\x92\x8b\xec\xcd\xaf\x76\x9b\x3a\x97\x76\x28\x88\x47\x60\x0e\x41\xd7\x4d\xb3\xf1\xb8\xc8\xb7\xc7\xef\xfe\x83\x95\x66\x59\xfd\xba\x30\x0c\x4d\xda\xac\x71\x62\x03\x3a\xc1\x35\xfa\x72\x25\x87\xbf\x02\xbb\x7f\x89\xdb\x2b\x1a\x46\x1e\x1e\x37\x01\xf7\xb5\xe5\xb0\x7a\x22\xb3\x4f\x94\x46\x1c\x51\x28\x4b\x4d\x36\xb4\xe0\xac\xee\xf8\x78\xf3\xfe\x93\x56\x3e\xe6\xf7\x9f\xd0\x3e\xfd\x57\xae\x19\xb0\xa2\xbf\x53\xc5\xe5\xde\x0e\x13\xe2\x05\x91\x24\x0f\x71\x59\x23\x9c\x7f\x40\xa2\xe8\xd6\x3b\xd8\xef\xe7\x7f\xc4\xd0\x1a\x98\xf7\x43\xd3\x1b\xa2\x1f\x68\xcf\x7f\x07\xed\xf3\xf9\xf3\xe7\xcf\xb7\xd0\x36\x7b\xf1\xe3\x8f\xc8\xbc\xc7\x26\x28\xa6\x14\x2d\xb5\x62\xca\x28\x33\x30\xef\x1d\xcf\x35\x19\xdc\x4f\x4d\xb8\x9f\x96\x80\x0b\x63\x45\xe6\x77\x10\x8f\x63\x00\xc8\x3d\x7d\xca\x80\xa0\x6d\x04\xc3\xa6\x0b\x8a\x83\xd9\xdd\x87\xea\xfc\xa3\x5d\x6d\x7b\x1f\x99\x4f\x5c\x9d\xe2\x30\x2a\xbc\xd6\x0f\xcc\x63\xbd\x98\x07\x5b\xe8\x47\xf4\xc4\x45\xff\x1b\x61\xb4\x87\x76\xf0\xf2\x8a\xb7\x4c\x06\xd7\x35\x67\x03\xcd\xe2\x30\x1e\x11\xa6\x41\x4f\x7a\xe9\xce\x14\xc8\x7b\x3a\x53\x66\x83\x01\x83\x82\xfe\xf8\x03\xd5\x3a\x44\xf5\x08\x81\x9c\x4a\x67\x14\x06\x65\x1f\xbd\x9f\x0d\x58\x55\x98\x1f\x7e\xe4\xcb\x49\xc1\x16\xa0\xdb\xbb\xf9\x68\x30\x28\xab\x56\xad\xa2\x3f\x90\xb9\x52\x76\xde\x09\xf9\x24\x04\x35\x01\x7f\x2a\x6e\x3e\xe6\x72\xe7\x31\xb2\x7f\x71\x5b\xe6\x72\x3e\xe5\x64\x12\x83\x4f\x39\xfb\xdb\xc7\xa7\xfc\x84\xdc\x2f\x9a\x49\xf5\x9b\xa6\xd5\xf7\x19\x02\x4f\xd8\xf7\x55\xd2\x7b\x35\xad\xc3\x23\x72\xbf\xa2\x85\x58\xc3\x50\xb6\x96\xa4\x5d\x2b\x60\xfa\x25\x16\xeb\x11\xb9\x5f\xcc\xd0\xc5\xc7\x50\x30\x8c\x56\xe6\xb1\x35\xe4\xe5\x5a\xaf\xad\xed\xd3\x32\x36\xb3\xf9\x0a\x56\x2f\xc6\xa5\x52\xf0\x23\x72\x7f\x38\x0a\x67\x85\xca\xd1\x55\xb4\xcd\x98\x31\xef\x63\x5e\xa2\x75\xa3\x8c\x99\x95\x3e\xad\xcf\xa4\x54\xa0\xcf\x25\x2b\xb4\x40\xe1\x39\x5b\x95\x0d\x1f\x7b\x5b\x8a\x75\xaa\x0d\x0a\x6d\xdd\x4c\xb5\xc1\xa8\x25\x74\xd9\xc8\x6f\xd3\x6c\x32\xf8\xbe\x2b\x5e\xc7\x4a\xb9\xbd\x84\x39\xcd\xa4\xd1\xea\x66\xfb\x42\x62\xb0\xe9\xb7\xaa\xe0\xe8\xd8\x3e\xfc\x27\xec\x1b\x0a\x51\xc4\x6e\x2a\x97\xd2\x6a\xd5\x9c\x5c\x0c\x90\xa6\xfc\x51\x4d\xf5\xcb\x7c\x76\x56\x58\x44\xd8\x23\xb7\x90\xac\x2e\x48\xc4\x8d\x4b\x57\xb9\x36\x29\x22\x62\xc5\xd5\x3a\x4b\x25\x4c\x44\x01\x62\x83\xbd\x18\xc8\x76\x3a\xc9\x39\xb9\x9e\xcc\xef\xa2\x79\x3e\x1b\x64\x06\xb2\xb6\x0c\x84\xbd\x2d\xc1\x90\x4c\x85\x4c\xb0\xa5\x08\xe8\xb6\x8a\xc6\x55\x23\xf4\x2e\xea\x3a\xf2\x59\x29\x06\xdb\x59\x98\x67\x13\xbc\x8a\x1a\xc4\x21\x14\x9a\x50\xf9\xb8\x4e\x65\x88\x03\xfd\x36\xf4\xa1\xd5\x90\xfd\x12\x72\x68\x0c\x18\xb7\x6b\x45\xac\x37\x1b\xc5\xa8\x0d\x2b\x1d\x79\xc6\x68\xfb\x2d\xeb\x46\x42\x0f\x98\x62\xc3\x18\xe5\x49\x3a\x9b\xde\xd0\xb7\x87\xd3\x84\x80\xae\xb3\x9a\x5e\x23\x36\xf3\x98\xaa\x4d\x6d\x7a\xae\x5b\xbb\x29\x67\xd2\x1a\x24\xc1\x7f\xb8\x8e\xc3\x05\x0b\x53\x73\x44\x09\xb4\xaa\xa6\x53\xc2\x5a\x87\xb2\x53\x02\x2b\xf5\x9d\xf2\xcd\x17\x57\x79\x1a\xb8\x69\xc9\x88\x86\x5c\xf8\x63\x5f\xa0\xd7\x93\x98\xcf\xe6\x83\x7c\x90\x15\xb2\xa1\xa9\xf1\x28\x0f\xcc\x56\xd1\x78\x1a\x3d\x7a\x0c\x85\xe7\xcd\xd5\xf3\x9d\x60\x15\x7d\xe7\x4d\x9e\x06\xa5\xb6\xc3\x1f\xd6\xa9\xeb\x30\x04\xbf\x0d\x55\x67\x25\x5c\xbf\x84\x34\xba\xcb\xd3\xa0\x5d\xcf\xa1\x23\xf9\x45\xb5\x9c\x7c\xf6\xa0\x77\xa5\x20\x21\xf1\x34\x21\x6f\x2e\x4e\x0f\x8b\x80\x32\x03\x32\x8f\xc3\x5b\x32\x90\xe8\x6a\xd2\xb5\x19\xc5\x61\x1e\x8f\xd0\x80\xa8\x5d\x75\x67\xd3\x4f\x30\x7f\x8f\x67\xb3\xe9\x6c\xf0\xfd\xcb\x70\x9c\x4e\x67\x37\x24\xe1\xac\x90\x84\x79\xd8\x19\x41\xf4\x51\x5c\x6b\xeb\x13\x66\xdd\xeb\x39\xe7\x97\xd5\x99\xfd\x3f\x7c\x31\x87\xd9\xc3\x96\xf2\x6a\x8a\xad\xba\x90\x73\x48\x1a\x0e\xae\x35\x5d\xe2\x6e\xc2\xa7\x03\xf3\x7a\xad\x4d\x94\x02\x64\xd7\x74\x58\x2d\x9e\x79\x34\x67\xc9\xb6\xa3\xbb\x34\x25\x33\x92\xb0\x1c\x7e\x62\x94\x4b\x72\x73\x3b\x6e\x3f\x2e\x6f\x85\x28\xdc\xb5\x7c\xa8\x12\x02\xa2\x9b\xbb\x79\x8e\x22\x82\x32\x3a\x94\x37\x64\x92\x93\x04\x65\x13\xf0\x19\x99\xc4\x33\x92\x97\xd1\x0a\x96\xb8\xd4\x53\x1c\xff\xbf\xbf\xc9\x26\xcf\xa0\x1f\x65\x92\x8d\xca\x0d\x00\x50\x99\xb3\xb0\x35\xf3\xd1\xf4\x6e\x9c\x50\x74\x3e\x90\xdb\x1c\xdd\x4d\x6e\x67\xd3\x98\xc0\x8d\x7c\x7e\x4f\x84\x91\x43\x70\x3c\x37\xb5\x17\xdb\x67\x9c\x92\xcf\x68\x83\x07\x25\x1d\xcb\xc0\x3d\x5d\x3f\xaf\x74\x93\xe7\x82\xcc\x49\xe1\xb4\xdf\x18\xc1\xef\xe7\x20\xe2\x78\x97\x20\xe4\x40\x3e\x2f\xa2\x72\x20\x3a\x25\x3f\xa7\x4f\x4d\xd4\xda\xfd\x27\x33\x8a\x7f\x5f\xa7\x1a\xa8\xd4\xc3\x37\x8c\x5f\x77\x42\x70\x83\x56\xe1\x07\xfb\x1e\x68\xb6\xdf\xa6\xf8\x49\xd6\x0a\x56\x75\x72\x14\xe6\x61\xb1\x41\xef\x38\xa5\x5e\x62\xfd\x38\x48\x92\x39\xe0\x03\xa8\x15\xde\xe9\x8b\xa3\xcd\x79\x77\x55\x3d\xac\x8c\x55\x0b\xcd\xd1\xc9\x54\xb4\xcb\xfd\xd4\x11\x13\x99\xfc\x26\x3f\x5b\xdc\x58\x4c\x8b\x50\xb8\xb4\xc6\x6e\xb5\xc2\x42\xf4\xe5\xd9\xec\x3d\x43\x7d\xf0\xbd\x74\xd1\xd7\x02\xb1\xac\xb6\xc5\xeb\x8b\x6c\x4b\x91\x51\xb0\xee\x62\x34\xd1\x92\xc2\x86\x98\x88\x4e\x20\x3c\x4f\x42\xd7\x09\x55\x8c\x89\x08\xbc\xae\x9f\xd0\x8b\xcf\x0d\x61\x05\x85\x2e\x2c\x9b\x5c\xe5\x00\x28\xa2\x31\x1f\x8b\x2b\x05\x8a\xd6\x16\x66\xe1\xf6\x3e\xa0\xac\x70\xb8\x5f\x62\x4a\xbe\xe6\xeb\xc6\x1c\x85\x1f\xc3\x6c\x0c\x29\x3a\x98\xb0\x85\xd5\xa6\x2f\xc3\x5f\x09\x21\x34\xb2\xc9\xc7\xe9\x07\x32\x47\xef\x93\x29\x6f\x05\x18\x71\x30\x4d\xd3\x39\xc9\xb7\x0c\xf4\x69\x94\xc5\xa3\xd6\x75\x35\x7a\xd0\x5b\x57\xa5\xb8\x14\xf2\x20\x9a\x4e\xc7\x24\x9c\xfc\x89\x92\xe9\xf3\xf1\xdd\x7c\x84\x7e\x1e\x91\x9c\x05\xc2\x1e\x17\x8b\x6a\x38\x81\x58\xe2\x20\x5c\xf9\xab\x6a\x89\x2d\xd7\xd6\xb5\x85\x89\x2e\x17\x6b\x18\xd4\xcf\xab\x4e\x96\x8d\x1f\xb1\x39\xd0\x25\x25\x78\x39\x8d\x3b\x58\x7d\x21\xfe\xed\x6f\xdf\xa7\x74\x20\xfa\xa6\x6f\x2a\x00\xd4\x24\x0f\x1b\xd3\xb5\x58\xb2\xb9\x50\xa8\x66\xab\xdc\x86\x4d\x4b\x14\x37\x69\x60\x1c\x35\xec\xde\xb4\x9c\x70\x15\x46\x63\x4e\x17\x35\x2b\x45\x94\x63\x58\xbe\xd0\xac\x57\xb4\x59\x01\x52\x26\x38\x3a\x9c\xde\x4d\xf2\x62\x3e\xc8\x65\x31\x6d\x6a\x02\x03\x3e\xbf\x80\xcc\xa1\xfb\xf5\xbe\xee\x36\x10\xe9\x46\x9a\x4a\x75\x8d\x51\xe5\x28\x5e\x4c\xef\x26\x09\xba\xbb\x65\x81\xa8\xe2\xf1\x5d\x42\x1a\x13\x59\x0a\xa1\x81\x72\xe5\x68\x2e\xfe\xb0\x6a\x66\xc7\x12\xcd\x64\xfa\x69\x22\x22\x3a\x9d\x8c\x1f\x50\x7a\x57\x8a\x21\x79\x8a\xf1\xdd\x5d\x34\x26\x73\x16\xc3\xab\x7d\x57\x00\xa2\x74\x46\x6e\xc2\x6c\x52\xdf\x06\xf4\xa6\xc1\x4d\x78\x3f\xa8\xd1\x00\x7c\xdc\xd0\x0e\x9f\x1c\xb5\x6d\x8a\xb1\xac\xf7\x5b\xc9\x61\xcc\x16\xab\xc1\x60\x30\xdd\x0a\x44\x6b\xe8\xfd\x80\x84\xf9\xa0\xc1\xd3\xc0\x94\x3a\x2c\x4d\x0b\xd6\x49\x93\x4d\x06\x22\x22\x3f\x20\xc7\xa8\xf1\xba\xe2\xea\x19\x5f\x0b\x55\xdc\x49\xa7\x81\xd0\x8c\x76\xe8\x1a\xb6\xba\x32\x7b\x35\xff\xfe\x54\x24\x5c\xf9\x76\x5b\x10\x05\x3a\xbe\xbb\x14\x75\x32\x4b\xa7\xb3\x9b\x72\x65\xde\xa9\x36\xd9\x10\x23\x4b\x09\x83\x8b\xd6\xba\x3e\x50\xca\x51\x83\xa3\xb6\x72\xfc\x46\x76\x6d\x48\x58\x66\x81\xc1\xa4\xb5\x6a\xeb\x98\x28\xd5\x7f\x66\x97\x0f\x6e\xd9\xed\x03\x43\x24\xa5\x02\xcf\x9a\x70\x47\x3b\xfb\x22\x33\x2d\x3b\x5f\x8a\x94\x73\x9a\x3d\xeb\xb6\xfd\xd7\xfb\x6a\x88\xb8\x3d\xfa\xbd\x9a\x25\x33\x3f\xf5\x8d\x79\xf3\xd8\x17\x6a\x3a\xd4\x9c\x6f\xe0\x76\xcd\xfb\x05\x4d\xa7\xc2\x5a\xc5\x4b\x4b\x5c\xae\xa9\x2f\x17\x6d\x96\xa8\x3e\x7e\xcb\xa5\xc1\x6f\x14\xce\xa9\x16\xdf\xd7\xbc\xd7\x62\x76\xab\x54\xa2\xba\xc5\x4d\x8c\xed\xc2\x58\x97\xb7\xc9\x12\xc7\x90\x39\x9a\x4e\x04\x0b\x1b\xf6\xd0\xc0\xc5\x16\xdc\x4f\x5c\x0c\x7f\xdf\xe9\xd1\xcc\x40\x16\xd6\xb5\xf2\xb1\xdd\xcc\xf6\x79\x92\x17\x4a\x92\x03\x8a\x46\xbe\x25\x72\xf9\xc9\x02\xf6\x30\xe2\x2e\xbb\xcd\x5b\x39\x1b\x10\x6d\x1e\xb1\x2c\xa6\xcb\x25\x05\x5a\xd1\x45\xba\xe4\x83\x5a\xce\x9a\xcb\x93\x03\xcb\xf5\x94\xf1\x75\xf4\x82\xd2\xac\x3b\xb5\x4c\x91\x54\x66\xa9\xfc\x32\x97\xa4\x0c\xad\xac\x65\xd0\x94\x5a\x58\x97\xba\x5c\x5c\x59\x9c\x0b\x59\xf2\x65\x4d\xcb\x7c\x02\x7c\x16\x53\x32\xf4\x5e\xb4\xab\x77\x16\xee\x10\x44\xd0\x60\x6d\xd1\x91\x6a\xbd\x4d\xd5\x91\x53\x5c\xae\x37\x16\xfa\xe2\xc5\x9a\x87\xfe\xcd\x6d\x02\xe2\x5b\x1c\x7b\xb8\xcb\x59\x66\x18\x5d\x9f\xf1\x99\x03\x64\x4d\x36\x73\x81\xae\x18\x30\x85\x2d\x16\x3c\x5a\xca\x92\xe2\x73\x65\x76\xbd\x83\x9e\x0d\xbe\xe7\xdd\x52\x18\xa5\xeb\x95\x96\xb5\x41\xb3\xea\x22\xbb\xd7\xc8\xac\x16\x72\x6a\x73\x2d\xb7\x92\xd7\xe1\xca\x99\x9c\x8f\x31\xdd\x77\xd3\x6e\x2a\x1a\x10\x0c\x6c\xd2\x0d\xeb\x17\x89\xbc\xf0\x3c\x9b\xf0\x55\xba\xe8\x8d\xce\xd2\x88\x5e\x4d\xa1\xff\x61\x0e\xd5\x52\x0e\xa4\x4a\xb4\x87\xb2\x39\x22\x69\x4a\xe2\x3c\xfb\x48\xc6\x0f\x28\x44\x09\x99\xe7\xb3\x3b\x78\x36\x60\x43\xbe\x33\x9d\xc4\x64\x85\xd4\x7c\x9a\x13\x51\x0c\xe7\xc7\x10\xad\x92\x0b\x43\x89\x75\x19\x7b\x29\xed\xbe\x80\x2a\x81\xf6\x8b\xa9\x56\x8c\x82\x86\x25\xb7\xa3\xa2\xe6\xd4\x96\x40\x58\x76\x9e\x17\x00\x96\x9d\xe9\xcf\x5b\x06\x56\x6a\x72\xd1\x06\x8e\x54\x82\xa2\xab\x92\x7c\x83\xbf\xdc\x3a\x29\x50\xbe\x58\x32\x9f\x0b\xa3\xae\x92\x1c\xb4\x6a\x3f\xc9\x51\xee\x98\xf6\x90\x8b\xad\x5d\xdb\x5a\xc5\x38\x30\xe7\xd6\xf9\x5a\xd2\xf3\x90\xf3\xd0\xf7\x65\x12\x83\x6c\x92\x93\x59\xda\x7e\x51\x15\xe9\x88\x84\x62\xb5\xe4\x24\x2d\x26\x27\xd3\xfb\x78\x82\xad\x10\x8d\xc8\xf8\x96\xcc\xe8\xb6\x60\x69\x09\xf0\x9c\xf7\x83\x47\xe7\x6b\x76\xef\x9b\xf0\x3a\x62\xfb\x8e\x66\x6c\x05\x46\xc2\x27\xef\x19\xb5\x4e\x80\x54\x83\xb6\x0d\x4b\xdf\xa3\x1d\x11\x60\xed\x06\x15\x34\xa8\xe1\x8d\xb4\x20\x1f\x94\x89\x34\x51\xdd\x40\xc6\x45\x16\x58\xc7\x68\xcd\x4a\x78\x71\x80\xb2\x39\xfd\x08\x26\xb3\xd6\x59\x41\x57\xd5\x93\x97\x07\x87\x9f\x6b\x56\xd0\x0d\x72\x11\xfa\x97\xb6\xcb\x67\xc7\x5f\x7a\x66\x9c\xdc\x84\xb1\xd6\xec\xb8\x09\xe3\xf5\xcf\x90\x12\xe8\xba\x66\xc9\x07\xa2\x3c\x5c\x10\x66\xc9\xe1\x7b\xe8\x02\xe5\x05\x36\x55\x58\xd3\x0c\xcc\x3a\xa6\xcc\x6a\xb6\xc1\xf2\x3c\x62\xa9\xa8\x06\xb4\x6b\x10\xd6\x80\x7f\x69\x8d\x6b\xc0\x89\x71\xd8\x4c\x5a\xfa\x32\xcc\x47\xf5\xc4\xa5\x62\x69\xce\x00\xff\x68\x4f\x6b\xfa\xe7\x3b\xc3\x36\xff\x43\x72\x99\x72\xdc\x07\x45\xaa\xd6\xa6\xd7\xe7\x97\x48\x6e\x5a\xe0\xf2\xf8\x49\x4e\x4b\x2a\x88\xc9\x4e\x7b\x64\x38\x2d\xab\x34\x33\x9d\x2a\xd2\x7e\x4a\x9d\x1c\x8a\x94\x93\x15\x17\xb6\x96\x10\x33\x45\xb6\x17\x91\x44\x72\xef\x82\x29\x44\x0a\xe9\x97\x78\xd2\x73\x56\xb9\x30\xc2\x21\x14\x57\x46\xca\xc7\x75\x5e\x1a\xe1\x40\xbf\x8d\x5b\x23\xab\x21\xfb\x25\xd6\xd9\x08\x30\x6e\xbf\x38\xc2\x7a\xf3\xd7\xbd\x20\x2b\xab\x7a\x13\xde\x96\xfb\xc0\x9b\xf0\x76\x5d\xc1\x75\x5b\xc2\x60\x4a\x01\x6b\xdc\xc1\x63\x23\xbc\x86\x6b\xba\x68\x7b\x1f\xd9\x5a\x37\x75\x1f\x72\x82\x5b\xae\xea\xb2\x4f\xd7\x85\x5d\xf6\xd1\xb9\xb6\x5b\xb4\x61\x55\x6d\x0c\x32\xb4\x8d\xf0\x56\xcb\xa5\xe0\xe2\x97\x9e\x57\x83\x8b\x36\xec\x46\x1b\x56\x67\x1b\x56\x6b\x1b\xca\x46\xf2\x59\x76\x3b\x06\x5f\x92\x01\xa3\xdb\xd3\xa7\x10\x8d\xe1\x0f\xf6\x6c\xd1\xe7\x80\x3e\x02\x36\x0a\x80\xe5\x08\xfe\xc6\x46\x70\xf0\x1b\x7a\x4a\x71\xfa\xee\x3b\x04\x38\xfe\x86\x7e\x40\xe6\x13\xdf\x15\x06\x76\xeb\x1f\xe8\x37\xbd\xd8\xc7\x02\x23\xb1\xdb\xd2\x37\xe1\x2d\x5c\xac\x3c\xc8\x07\x83\xa2\x1b\x40\x15\x0f\xfd\x80\x06\x36\xda\x41\xbf\x6d\x71\x52\xd8\xa9\x2a\xc2\x8c\x2c\xd4\xae\x6c\x0a\x1c\x24\x09\xba\x0d\x13\xba\x82\x49\xa7\x01\x2f\x43\xd1\x47\xfb\x48\x40\xdd\x93\x05\xb9\x80\xdc\x49\x55\x4d\xe5\x1d\xd4\x51\x36\x26\x68\x20\x52\x8a\xc7\x57\xd5\x88\x6d\x8d\xda\x68\x2c\x36\xfe\x08\x04\xe4\x8b\x98\xd8\xec\x63\xde\x50\xaf\xad\x8f\xeb\xbe\xd1\x56\x2e\x65\x6b\x58\x8a\xff\xc3\x2f\xb5\xf1\x95\x9d\x39\xe5\x8b\x2a\xc0\xaa\x17\xdb\x4a\x58\x6b\x59\xd4\x4b\x68\xe5\x15\xf5\xf2\x4d\x79\x45\x5d\x56\x7f\x61\x91\x96\x15\x9e\x91\x8f\x64\x36\x27\x2f\x85\x3a\xd5\x2b\x45\x76\x98\xbf\x55\x25\xd5\xdb\x7c\x49\x33\x8a\x05\x1a\xb5\x88\x78\x2a\xe1\xa9\x34\xe3\xf4\xd0\x15\xe6\x55\x9b\x6f\x0b\x59\xc8\xef\xc7\xff\xb6\xf5\x0e\xed\xa3\xdf\x1e\x47\x5a\x9f\x5e\x4f\xa6\x33\xf2\xf5\x08\x6c\xa1\xa1\xd3\x49\x02\xa1\x0e\x2b\x16\xcb\xe8\x9b\xf3\xb4\x87\x0c\x16\x5a\x67\xe0\xfe\xb6\xbf\x8f\x76\xb0\xbe\xe4\x17\x79\x5d\x04\xf4\x38\xa3\xa1\x52\x1f\xb9\x14\x84\x59\x7e\x36\x9d\xde\x56\xd3\xdb\x68\xe2\x6b\x08\x1c\xd5\xa5\xb4\x76\xbb\xae\x85\xb7\x7b\xe8\xfb\x83\x67\x87\x47\xc7\xcf\x7f\x3a\x39\xfd\xbf\x2f\xce\x5e\xbe\x3a\x7f\xfd\xff\x2e\x2e\xaf\xde\xfc\xf3\xe7\x5f\xfe\xf5\x5f\x61\x14\x27\x24\xbd\x1e\x65\xbf\x7d\x18\xdf\x4c\xa6\xb7\xff\x3d\x9b\xe7\x77\x1f\x3f\xdd\x3f\xfc\x6e\x62\xcb\x76\x5c\xcf\x0f\x86\xdb\xbb\xfb\x8b\xd9\x2e\xdb\x4c\x4b\xa5\xb0\xea\xdd\xad\x8e\x51\xd4\x09\x74\x51\xb9\x39\x77\xdf\xf5\x6b\x53\xbf\x1b\xe8\x28\x03\x61\x50\x06\xcc\x74\x94\x0d\x1e\x51\x67\x4e\xf5\x74\x41\x16\x54\xcc\x2f\x46\xcc\x40\x3b\x08\x6f\xbd\x83\x70\x19\x95\xce\xde\x99\xa7\xa5\xd1\x82\xa5\xd3\xc2\xd6\xbb\x42\x73\x14\xb7\x05\xaa\x26\x98\x5e\x3e\xa9\x45\xc6\xff\x83\x2a\xd2\xd0\xb1\x3f\x58\xf3\x62\x98\x8f\x89\x76\x70\x7c\x04\x3e\xfb\xb4\xf4\xf6\x76\xef\x4b\xff\xad\x6f\xf9\x64\x5a\xc8\x8e\xa6\x0a\x42\xfb\x67\xc3\x04\xaa\x34\x7f\x0a\x2b\x7c\x87\x25\xb4\xb0\xd4\xed\x59\xc3\x3f\xdf\x19\x36\xde\x18\x45\x37\x46\xd1\x8d\x51\x14\x15\x41\x74\xb0\x87\x9e\x1d\xaf\x18\x48\x07\x7b\xcf\x8e\xc5\x58\x3a\xd8\xab\x3f\xc1\xaf\xeb\x0e\xae\xc3\x10\xff\x36\x4c\xa5\x2b\xe3\xfb\x85\x82\xec\x60\xaf\x33\xca\x0e\xf6\xfe\xba\xb6\xd2\x55\xad\x94\x40\xd9\x35\x19\x29\x95\xd1\x51\xe1\x6e\xc7\x34\x21\xaf\xa7\xd9\x24\xef\x8a\x29\x88\xbd\x8e\x98\x82\x2a\xd3\x61\xd5\x95\xee\xa0\x82\x65\xeb\xab\x45\x16\x14\x9a\x7a\x4c\xb3\x4d\x73\xae\x3e\x42\x2c\x22\x98\x56\xeb\x11\x0c\xff\xe1\xb6\x1b\x26\x68\xca\x88\x44\x85\x3c\x5a\x43\x48\x22\x00\xb5\x8e\xd0\x82\x05\xac\x72\x2b\x5b\xbc\xf8\xe2\x81\x05\xeb\x98\xf5\x8e\x2b\x88\x61\x7f\x51\xf6\xa6\x1e\x55\x90\x6e\x33\x2a\xa1\x61\x51\xa1\xd1\x9d\x14\x47\x6b\x7a\x77\xed\x15\x1a\xf4\xed\xde\x28\xad\x1c\x4f\x10\x7b\xe8\x6c\x69\x55\x48\x50\x77\xce\x1e\x49\xdd\x39\xfb\xc6\xd4\x9d\xe5\xf1\xfd\x92\xea\x4e\xab\x08\x3a\x3b\xde\x68\x3b\x6d\x58\x7d\xf5\xda\xce\xfc\x53\x78\x7b\x3c\x49\xb2\x70\x32\xe8\xab\xf8\x28\x0c\x26\xff\xb1\x9a\xcf\xd9\xe7\xd4\x7c\x96\x97\x11\x7f\x05\xcd\xe7\xec\xb8\xa1\xfb\x6c\x14\x9f\xe6\xe7\xd1\x14\x1f\x41\x72\xf4\xd2\x81\xbe\x05\x25\xa8\x64\x08\xa1\x93\xb4\xa5\x2e\x2a\x15\x09\xe8\xa1\x10\x77\xd2\x60\x62\xd2\x34\xe9\xff\xc1\x83\x83\xfd\x48\x09\xc8\x7f\x65\xbf\xb5\x0a\xd2\xe5\xec\xbc\x30\x27\xf4\xcc\xbc\xd6\x5a\xcc\xbc\x06\xba\x9b\x24\x24\xfd\x0a\xad\xbd\x06\x12\x5e\xcd\x47\x21\x6e\xbc\x1a\xdd\x84\xf1\x57\x65\x13\x36\x50\x81\x28\x7c\x03\xfc\x36\x76\xe2\xd5\xec\xc4\xcf\xc2\x39\xa9\x82\xa8\x86\xf3\x96\x28\x1a\x4b\x9a\x93\x6b\x7e\xeb\xed\x85\x5e\x1e\xb9\x00\x0f\xbc\xf7\x5f\x1e\xb9\xfa\x7b\x2d\xb8\x5b\xfc\x81\x3c\xa0\x84\xcc\xb2\x8f\xec\x16\x67\x49\x4e\x88\xf6\x17\x4e\x72\xb8\x41\x35\x9d\xc0\xd5\x35\xb8\x48\x7d\xfc\xcf\xd7\xef\x41\x15\xbc\x9a\xbe\x20\xad\x9b\x32\xf4\xe9\xd3\xa7\x27\xd3\x5b\x32\x99\xcf\xc7\x4f\xa6\xb3\xeb\xdd\x64\x1a\xcf\x77\x63\xa0\xf2\x6e\xa3\xfa\x28\xbf\x19\x6b\xed\xeb\x68\x57\x8f\x3f\xde\xbe\x38\x7a\x5e\xf5\xb6\x7c\x5e\x29\x3e\x6d\xaf\xf8\x19\xdd\x3a\xd6\x42\xec\x91\x0f\xe4\xa1\x8c\x3c\x42\xa9\x3c\xa7\x0f\xd9\xa4\x08\x3a\x22\x64\xfb\xad\x02\x8d\x38\x68\x80\xad\xa0\x2b\xce\xc8\x42\x53\x1d\x37\x7c\xc4\x10\xc9\x70\xd3\x47\x68\xe1\xe5\x91\xdb\xa3\x0f\x59\xce\x2f\xeb\x36\x33\x17\x0b\x3f\xe4\x53\x74\xcb\xee\x36\x8a\x11\x53\x7a\xe9\x47\x0b\xf1\x47\xba\x95\x04\x4e\xd5\x3d\x84\xad\x00\x6e\x27\x76\x15\x64\x34\x81\x0e\x77\x17\xaa\xba\xd1\x85\xf2\x9f\x6b\x0e\x85\xd2\x31\xdf\xbe\x4c\x6c\x14\x22\xa0\xf2\x99\x6f\x32\x7f\x48\xd2\x85\x88\x28\x6c\x3e\x2b\x23\xa2\xf4\x80\xf1\x3f\x15\xbf\x04\x70\xdf\x68\xad\x10\x8d\x3a\xff\x98\xa6\x29\x69\x63\x2d\x71\x5c\x94\x61\x59\xda\x2a\x2d\xb1\x1b\x3d\xa2\x6c\x01\xdc\x4b\xf9\x95\xee\xa5\x51\x88\x6e\xc3\xf9\x9c\x0a\xaf\xf5\x5d\xdc\x2f\x20\xb2\xd8\xa9\x0a\xf0\x12\x38\xf3\x70\x9c\xa3\x03\xf8\xb3\xae\xfd\x2b\x4c\x0c\x36\x5f\x3f\xf7\xcc\x20\x54\x41\xf8\x90\xa4\x4f\x58\x5c\x06\x32\x28\x28\x63\x40\x0f\xfb\x06\x20\x63\x40\x44\x26\x6b\xc0\x5b\xc3\x1e\xb4\xce\x92\xf2\x1d\x27\x95\x8e\x5c\x3a\x4b\x61\x96\x61\x93\x28\x93\xf3\x3b\xbc\x95\x68\x50\xb5\xa0\x11\x7b\x08\xc2\xb6\xb2\x61\x7e\x01\x34\x5f\xd8\xf5\xa9\xe2\x1c\xe9\x05\x9f\x2d\x9b\x28\xa3\x0f\x96\x6f\x74\xcc\x82\x85\x1e\xc1\x08\xc1\x9f\xe4\x55\x84\xd5\x99\xd5\xaa\x5e\xc8\x7b\xf4\x13\x57\x49\x68\xa3\x9d\xe5\xb8\xc3\x79\xa3\x5f\x85\xd3\xf9\xd3\x02\x61\xd5\x56\x9f\x6e\x79\x20\xda\x80\x8e\xa7\x62\x3d\xb8\x0c\xab\xb6\xac\x7f\x22\x12\x03\xe8\x56\x21\x2d\x38\xec\x62\x72\x08\x77\x5e\x65\xb3\xae\x8e\x5f\x19\xe0\x49\x5a\x98\xb2\x68\x39\x20\xd2\x92\x35\xab\x0a\x66\x56\x15\x61\x2c\x75\x2c\x2a\xa8\x08\xea\xd0\x12\xbc\x43\x87\x8e\x6d\xbd\x93\x95\x55\x04\xf1\x14\x78\x9f\x47\x4c\x57\x21\xd1\x3d\x90\x02\x2c\xc1\x76\x5e\xcc\x17\x79\x10\xe4\x32\xfb\x4f\x01\xe2\x31\x6e\x2d\xf7\x5d\x46\x3b\xc2\x1a\xae\xba\x7c\x2e\xbf\x74\xae\x31\x08\x9f\x3c\xc8\x50\x7b\xcf\x97\x5a\x9b\xdb\x41\x75\x19\x95\xdb\x4b\x77\xae\xe0\xad\xc5\x51\x6d\xe5\x2e\xb5\x47\xa6\x38\x6a\xac\xde\xfd\x20\x18\x48\xad\xd5\xae\x02\x51\x57\xab\x6d\x3b\x7c\xad\xb6\xe4\x5d\xda\x86\x34\x78\x07\x1f\xef\xba\xc6\x0d\x21\x3b\xb4\x55\xa1\x3f\x9b\xb7\xf7\xd5\x26\x4d\xd6\x9c\xda\x9e\x69\x14\xf6\xb2\x3d\xdb\x31\x0a\x2b\xda\x9e\x6b\xff\xf9\xce\xb0\xed\xbf\x92\x9d\x33\xce\x6e\x47\x64\xb6\xf3\xd5\xf9\xbb\x82\x45\x53\x44\xee\x3f\xc3\xb0\x09\x3c\x91\x4d\x48\x17\x69\x3e\x83\x85\xf3\x10\x88\xfa\x9a\x2e\x05\xf3\xd2\x7a\x29\xbe\xd4\xf2\x87\x6d\x2b\x72\x02\xf7\x5f\x98\xd7\xc6\x49\xdb\x9d\x13\x06\x27\x9d\xce\x6e\xc2\x1c\x40\xb1\xaf\x2d\x8b\x30\x07\xf7\x1c\x7e\xcf\x79\xe4\x5d\x56\xfa\x09\x6b\x66\x79\x67\x90\x9c\xae\x73\xd0\xdf\x9c\xdc\xe7\x68\x9a\xa2\x90\x3f\xa3\x5b\x46\x15\x1e\xb5\x87\x05\xb8\x22\xf7\x61\x42\xe2\xec\x26\x1c\x8f\x1f\x98\x27\x0b\x49\x56\x74\x1a\x11\xa9\xfd\x27\x6f\x9b\x0f\xc8\x55\x89\x5c\x1d\x99\xb5\x38\x91\xac\xb5\x2f\x5f\xe2\x68\xb7\xcc\xf9\x2f\xae\x83\x15\x5b\x08\x0e\x25\x22\x51\xd7\xe1\x53\x52\x83\xa7\x0e\xe1\x23\x16\x7f\x52\x31\xdb\x93\x7c\xca\xf0\x1f\x9c\x90\xfb\x47\x71\x38\x68\x1f\x62\x81\xdd\x45\x3f\x84\xb5\x30\x5a\xc3\x23\x21\x9b\xdc\xde\xe5\xeb\xe7\xb6\x82\x9d\xeb\x53\x67\x6d\xb3\xe5\x4b\x30\x73\xdc\x10\xc4\x2d\xfc\xcc\x3c\x15\x4a\xae\x5f\xd5\x55\x01\xc6\x46\x19\xb4\xbe\xe2\x95\x7d\x54\x21\xc1\xea\x76\x6f\xed\x0a\x4d\xac\xc6\xf8\x85\x9d\xb5\x82\xb9\x27\xc2\xef\xb2\xb0\xb6\x9e\xb8\xd7\x1e\x35\x54\xc1\x8a\x8c\x12\x75\x50\x50\x30\xf6\xac\xc0\x68\x9c\x78\x3b\x9b\x8b\x4d\x9b\x8b\x4d\xff\x19\x07\xd6\x1a\xea\xdb\x42\xfa\x6e\xcd\x93\x6c\x5d\x0b\x0a\x84\x26\x2c\x8f\x33\xf5\x6f\x43\x41\xbd\x7d\x31\xa8\xdd\xaa\xc7\xc4\xb2\x43\x3d\x08\x9a\xb7\xe4\x1a\xa8\x7d\x86\xab\x02\xb4\x68\xdb\xa1\x7b\x7f\x88\xc6\x48\xe2\x19\xc9\xbf\xc0\x51\x06\xdd\x2d\x9f\xb4\x67\x3f\x00\x92\x71\x59\xdf\x16\x44\x91\xc5\x1d\x5c\xed\x30\x4d\x8c\x60\xa8\x4e\xbf\xaa\x3a\x93\x28\xcf\x23\x58\x00\x85\xf2\xb1\x19\x62\x54\x2f\x7c\xd2\x23\xa5\xcb\x04\x73\x8f\x7e\xb6\x4c\x66\x1d\x12\x92\x65\x4a\x88\xae\xbc\xd9\xaf\x77\x1c\xc2\x48\xf5\x4c\x48\x7a\xc7\x89\xa7\x99\xf6\xae\x51\xbf\xb0\xfa\x36\xc1\x2a\x53\xe0\x1d\x8c\xc7\xd3\x4f\x28\x9c\x45\x59\x3e\x0b\x67\x0f\x88\x1f\x5f\x7c\x20\x0f\xf2\xd4\x5d\x1f\x44\x6b\xf3\x8f\xad\xe8\xe8\x51\xbd\x69\x8c\x5f\x85\xf4\xfa\xc1\xbe\x28\xfa\x7a\x61\xbe\x20\x0f\x11\xd8\xad\xa7\x33\x94\x4d\x26\x64\x06\xc9\x34\xa7\x77\x39\x68\xce\x92\xe4\x54\x90\xad\x8c\x1d\xa9\xb1\xb9\xc2\x1f\x58\xdb\xb2\x24\x4a\xc5\xf9\x95\x58\x39\x6b\xa9\xbc\x3a\x1b\x4e\x85\xf3\xb8\xa9\xe6\x49\x5c\x26\xd4\xc9\x84\x3a\x32\x6c\x7e\x39\xbf\x00\x9e\x62\xce\x53\xb7\x61\x82\xe2\xe9\x64\x9e\x87\x13\x09\x7e\x6d\xbe\xb4\x0d\x3e\xd3\x3a\xfa\x29\xfb\xf8\x36\x7b\x87\x7e\xdd\x47\xe6\xbd\x1b\xb3\xff\x14\x11\x45\x16\xea\xd9\x1e\xfb\x6f\x99\x53\x9a\x69\xe3\x7c\x26\x6b\x3c\xb7\xcd\xa0\x6f\x27\xbf\x0c\xa8\x16\x5f\x3a\xbb\x4c\xb9\xcc\x7e\x9e\x0c\x33\x5a\x2e\x01\xad\xcb\xa5\x7c\x5c\x81\xae\x8a\xf5\x57\x7d\x08\x59\x3f\xce\xad\x64\xc8\x23\x25\x99\x01\x06\xf8\x26\x53\xcc\xbc\x3c\x38\xe4\x09\x66\xa0\x0f\x19\x88\xa5\x25\xc2\x8d\xaf\x85\x71\x7b\xe6\x9a\x59\xa8\xf8\x05\xf2\xcd\x88\x8c\x5d\xa0\xd1\x27\xa9\xcc\xd7\x90\xff\x05\x46\x7e\x93\xff\x45\xde\x70\xc7\x31\xf4\x52\xbb\xbe\xd5\x37\x54\x74\xc9\xac\xd8\xbf\x4f\x0e\x98\xee\xca\x7d\xf2\xc0\x74\x43\xf9\x52\xb9\x60\x1e\x6b\x3d\x3a\x64\x87\xce\x30\xd0\x72\xc5\x90\x6a\xc7\x27\xed\x09\x72\x74\xb3\xc7\xe8\x2e\x6f\xe2\x18\x34\x9a\xaa\x94\xed\x42\x53\x2e\x7c\x6c\x4a\x04\xb7\x74\x92\xc6\xdc\x84\x1d\x0a\x62\xbb\x37\x4c\xed\xb9\x61\x64\x95\x5c\x0d\x72\xff\x4a\x47\xe6\xf7\x9e\xb3\xd3\xf2\x7a\x9c\x45\x3b\x14\xcd\x04\x6e\x4a\xce\x1b\xbf\x92\x49\xbc\x03\x37\xce\x5a\xde\xb3\x90\x66\x8d\x1f\x6e\x12\x57\x7d\x25\x69\x3e\x0a\x2d\xb7\x09\x92\xbe\xb4\x9a\xe0\xe6\xa3\xd0\xc5\xd6\xe2\x4b\x3b\x68\x29\x69\x37\x5e\xcd\xb2\x5b\x72\x93\x60\xcf\x6c\xbd\x11\x55\x7b\x75\x1b\x7d\x48\xd2\x66\x3b\xe4\xe3\xed\x87\x24\x95\x79\x1e\xd4\xbb\x3e\x4d\xc8\x4e\x9c\x46\xad\xaf\xf3\x59\xc7\xeb\x9d\xeb\x71\x98\xdc\x84\x93\xb6\x9f\xa7\xed\xc0\x48\xdc\x7c\x7d\x1b\x26\x3b\xe1\x64\x9e\xdd\x0f\xad\x26\x11\xe8\x4f\xd9\x7c\x8a\x4d\x6c\x35\x29\xce\x7f\x1a\xfa\x43\xbf\x39\x42\xf4\xa7\xdf\xc9\x6c\xca\x83\x31\xb6\xfc\x3a\xe9\xf8\x8d\x9d\x5f\xec\x8c\xc8\x7d\xe3\x87\x90\x34\x99\x8b\x05\x4f\x4e\x16\xde\xcf\xe2\xe6\xe0\xce\xc2\x28\xca\xf2\xd6\x97\x3b\x63\x72\x1d\xc6\x0f\x5f\xd5\xe1\x00\x78\x81\x94\xf3\x0d\x9e\x9a\xd3\x0c\x5e\x56\xb3\xab\x7c\xe4\x93\x0a\x9e\xe9\x5c\x6a\x5c\x96\xe3\x33\xa7\xfc\x6e\x39\xe5\x77\x3a\x4f\x8a\xef\x74\x7a\x94\xdf\xd9\xb7\x6a\x32\x54\xb7\xee\xe0\x1b\x67\x7d\x86\x01\xe3\xf8\x05\x2f\x16\x86\x4e\xc1\xdf\xc2\x53\x3e\xab\x3f\x95\xdc\x5c\xbd\x9d\xd6\x6a\x50\xde\x65\xcd\x8a\x2c\x5b\xbe\x29\x39\x55\x7c\xc3\x18\xb4\x7c\x23\xf2\x65\xf9\x72\x52\x7f\x25\x70\x21\x3c\x53\xe6\x83\x2f\x15\xcf\x31\xaa\xc4\x9c\x50\x9c\xc3\x84\xef\x05\x63\x3d\xfe\x49\x4f\xed\x84\x47\x94\xe7\xeb\x3e\xf9\xd1\x4b\x18\xc3\xc9\xb5\x67\xf9\x86\xfc\xac\xd1\xa8\xf3\xec\x9e\x6d\x1a\x35\xa6\xde\xb3\xb1\x51\x31\xd5\x9e\x6d\x19\xf5\x91\xd9\xb3\xed\xa6\x43\x5b\x73\x9a\xec\xd9\x9e\xc1\x67\xc2\x9e\x0d\xf8\x94\x5c\xb8\x67\x07\x86\xc8\x87\x7b\x8e\x69\xb4\x71\xe2\x9e\x3d\x34\x44\xee\xdb\x73\xb0\x21\xf2\xe6\x9e\x03\x78\xd5\xf8\x71\xcf\xb1\x8d\x26\x47\xee\x39\x8e\xd1\xe4\xc9\x3d\xc7\x35\x16\x18\x70\xcf\xf1\x8c\x16\x56\xdd\x73\x00\x7f\x3e\xdd\xf6\x1c\xc0\x9e\xb3\xdd\x9e\x6b\x1a\x0b\x8c\xb7\xe7\x00\xe2\x94\x45\xf7\x5c\xc0\xb9\x9a\xc3\x7b\xae\x25\xfa\xff\x19\x95\x38\xd8\x73\x0b\xcf\x40\x2a\x28\xf6\x5c\xd7\x28\xc4\xc0\x9e\xeb\x19\x95\x78\xd8\x73\x7d\xa3\x12\x1c\x7b\x2e\xa0\x53\xcd\x8e\x3d\x17\x1a\x2f\x85\xd8\x9e\x67\x52\x8d\xc9\xdb\x1c\x2d\x6f\x8e\x96\x57\x39\x5a\x3e\x1c\x91\xf8\x03\xa5\x25\xcc\x72\x16\x9e\x63\x8e\xc2\x19\x41\xf3\xbb\x5b\x3a\x4a\x64\x31\x77\xae\x40\x79\xd8\xdb\xb1\xa4\xd1\xe8\x6f\xfb\xe8\xfb\xa2\xbd\xce\x63\x26\x26\xf2\xda\xc2\x0e\xb4\x61\xf7\xa5\x23\x7a\xb6\xe1\x74\x41\x52\x32\x23\x60\xb7\x98\x65\xd7\x60\x4c\xc8\x26\xd9\xe2\x4e\x13\xe2\xf7\xdc\xdd\x92\x19\x9c\x24\x8a\x57\x89\x68\xf1\x76\xd8\x07\x77\xd7\x37\x64\x92\x37\xca\xa2\x7c\x8a\x46\xe1\x24\x19\x93\xda\x28\x75\xb4\x18\xb5\xb6\x57\xf3\xbf\x06\x28\xd2\xd0\x46\xc2\xa9\x24\xcb\x18\xce\xbc\xf7\xb3\x49\x1e\xb4\x96\x2f\x58\x82\x93\xb2\x30\x1c\xd6\x39\x44\x6a\x39\xab\x2a\xb3\xf3\xd3\x37\xb4\x2d\x78\x21\xa2\xdb\xb5\x09\x55\x75\x62\x9a\xd3\x9d\x3e\x0b\xdc\xf5\x31\x23\x9f\x34\xba\xa3\x83\xaa\xd0\xcf\xd3\x02\x5d\xf4\xc7\x1f\x9d\x55\x8b\x69\x03\x9d\x83\x13\xba\x02\xd2\xdf\xa8\xec\x2a\xdd\x7a\x41\x78\xb5\xb7\xb3\x50\x75\x4b\xd6\x60\x27\xae\xd8\x53\x22\xdb\xdd\xfe\xd2\x95\x4f\x27\xb9\x6d\xad\xd0\xf0\xd2\x95\x9f\x8f\xa7\xe1\x8a\xb5\x3d\x07\xde\xb7\x56\x5d\x9d\xb3\x9f\xb0\x69\x66\x20\xf1\xd5\x43\x4e\xce\xd3\x74\x4e\xf2\x85\xd7\x2c\xda\x4c\xef\xe9\x70\xc2\xa4\x48\x85\x41\xcf\xc9\x5c\x55\x5c\x87\xfd\xad\x6a\xe3\x59\xd9\x25\xb4\xdf\xde\x55\xb9\x59\xee\xf8\x3e\x9f\x85\x71\x0e\xc9\x89\xf4\xc2\xb0\xf5\x0d\x5c\xd4\x86\x6b\xef\xf0\x45\x2c\x2e\x7c\x05\xea\x6d\xf6\x4e\x88\x0a\x9f\xe9\x04\x84\x57\xe4\xb5\x28\x3d\x92\xd8\x49\x54\x15\x72\xab\xb3\x56\xb9\x44\x3d\x89\xc3\xf1\x98\xab\x16\x3c\xb6\x51\x5b\xa7\xbb\x78\xae\x4b\xff\x11\xb0\x3b\xa6\x25\x68\x2b\x68\x42\x37\x1d\x1d\xeb\xe6\x22\x62\xe1\xed\xed\xf8\x81\x63\x16\xce\xae\xef\xe8\x12\xd9\xe5\x8d\xad\x17\x65\x89\xaf\x92\x4f\x6e\x67\xd3\x7c\x4a\x7b\x29\xae\x97\xfd\x7d\x44\xbb\xb4\x06\x89\xa9\xd3\xdf\x28\xee\x1b\xc5\x5d\x4b\x71\x87\x5c\xb3\xdf\x4e\xc0\xfb\xca\xb9\xef\x7d\x95\x26\x79\x69\x17\x50\xd0\xe0\x98\x6f\x0c\xca\x5b\x0f\x46\x29\xa0\x2b\x2e\xd0\x3b\x98\x1b\x4e\x6c\xba\x7d\x6c\x14\x9b\x24\xd4\xb1\x22\x78\x8e\x52\xfe\x5f\x51\x01\xbf\xcf\x86\xf0\x49\x18\xcd\xd9\x97\x79\x36\xe1\xd9\x00\xa9\xb0\x37\xef\xb1\xc9\x3f\x5b\xe8\x8f\xae\xa4\x2c\x2d\x62\x6d\xd0\x7a\x70\xd3\xe1\x34\xfb\xf2\xc8\x6d\xf8\x90\xea\x3b\xce\x36\xc3\x49\xa1\x7d\x3e\xc8\x72\xc7\xd9\xf7\xc9\xf4\xa2\x8f\x17\x49\x75\x08\xc7\xf5\xa4\xfa\x06\x66\xf0\x56\xba\xcc\x9a\xf7\x9e\xef\xb8\x96\x6d\x62\x03\x99\xf7\x24\x8d\x93\x30\x0a\x86\xdd\x61\x7e\x58\x9d\x61\x10\x85\x49\x9c\x12\x03\x86\xc1\xb6\x5c\xc7\xf7\x3a\xab\xbc\xeb\xe9\x2e\xf2\x3e\x99\xbe\x9e\x4d\x63\x32\x9f\x83\x27\x93\x48\x87\x97\x06\x9a\x82\x62\xa7\x52\xa3\x3e\x85\xb7\x88\x40\x14\xc0\x5e\xda\x0a\xf6\xb4\x74\x13\x2d\xd7\x38\x54\xb8\xc7\x01\xc2\xef\x69\x2b\xec\x2b\xda\x46\x99\xdc\x6b\x0c\xb8\xe7\xbd\x50\xf1\xe5\xdb\xe2\xa1\x6d\xb6\x8a\x1f\xa1\x24\x9d\x41\xd2\xb2\xf4\x33\x18\x0c\x84\x96\x58\x1c\x44\xf4\x07\x12\x5f\x82\x06\xe6\x6c\x35\x22\x20\xa2\xee\xcd\x40\x27\x6c\xcb\xd9\x6a\x81\x0d\xd9\x43\x6b\xb1\x17\xa5\x90\x1f\xd9\xa9\xf6\xa4\x76\xae\xad\xf4\x4b\xac\x0d\x95\x89\x84\xb1\x42\xdb\xc8\x94\x28\xcb\xb5\x8a\xb8\x51\x11\xeb\x56\xb4\x1a\x15\x2d\xdd\x8a\x76\xa3\xa2\xad\x5b\xd1\x69\x54\x74\x74\x2b\xba\x8d\x8a\xae\x6e\x45\xaf\x51\xd1\xd3\xad\xe8\x37\x2a\xfa\xba\x15\x83\x46\xc5\x40\xb7\xe2\xb0\x51\x71\xa8\xcd\x00\x66\x83\x01\xf4\x59\x07\x37\x6a\x6a\xf3\x0e\xb6\x1a\x35\xb5\x99\x07\xdb\x8d\x9a\xda\xdc\x83\x9d\x46\x4d\x6d\xf6\xc1\x6e\xa3\xa6\x2b\x13\x85\xbb\xbb\x74\x19\xfc\x90\x4d\xae\x29\x94\x2c\x1c\x47\x8a\xfd\x6d\x48\x57\xe7\xb7\x2a\xaa\x47\x50\x4a\x45\xe1\x18\x4a\xa9\xa8\x99\x40\x29\x5b\xd1\x8b\xc3\xca\xa1\xac\xb3\x18\xc5\xfd\xf9\xf3\x41\x68\xa0\xc8\x40\xb1\x81\x12\x43\x10\x49\x06\x42\xbe\x41\x55\x2a\xb3\x6b\x1d\xa6\x9f\x84\x81\x48\x0c\x54\x42\xa9\x28\x6f\x20\x84\x2d\x03\x5d\xbd\xc5\x32\x10\x31\x03\xc1\xda\x67\x50\x2a\x31\x45\x41\xf8\x14\x84\x25\x03\x11\x31\x10\x65\x2f\x42\x01\x84\x6d\x20\x64\x01\x16\xb6\x0c\x84\x8c\x16\x4e\x49\x0b\x67\x59\x5a\xb8\x25\x2d\xdc\x65\x69\xe1\x95\xb4\xf0\x96\xa5\x85\x5f\xd2\xc2\x5f\x96\x16\x41\x49\x8b\x60\x59\x5a\x0c\x4b\x5a\x0c\x97\xa5\x05\x36\x0d\x4e\x0b\x2c\x65\x4f\x19\x31\x30\x36\x38\x31\xb0\x94\x3f\x65\xd4\xa0\xdd\x60\xd4\xc0\x52\x06\x95\x4e\x13\xdb\x28\xa6\x89\x94\x43\xa5\xf4\x70\x4a\x7a\x48\x59\x54\x4a\x0f\xb7\xa4\x07\xf0\xa8\x94\x20\x3f\xfd\xd4\x41\x10\x03\x21\x97\x11\x44\xca\xa5\x09\x83\xd1\x4a\x10\xca\xe8\x43\x06\x43\xca\xa6\x31\x83\xd1\x4e\x10\x6c\x20\x4a\x94\xab\xb7\x58\xca\xa7\x11\x83\xd1\x4a\x10\x2a\x02\x2d\x13\x60\x48\x19\x55\x46\x0f\xb7\xa4\x87\xa5\x94\xa3\x5d\xf4\xa0\xcc\xce\xe8\x61\x29\x05\x69\x27\x3d\xdc\x82\x1e\x96\x52\x92\x76\xd1\xc3\x29\xe9\x61\x29\x45\x69\x17\x3d\x86\x15\x3d\x94\xb2\xb4\x93\x1e\x4e\x49\x0f\xa5\x30\xed\xa2\x07\x5d\x15\x38\x3d\x94\xd2\xb4\x8b\x1e\x41\x45\x0f\xa5\x38\xed\x9c\x2f\xb6\x51\xd0\x43\x29\x4f\xbb\xe8\x61\x95\xf3\xc5\x52\x0a\xd4\x2e\x7a\xf8\x25\x3d\x6c\xa5\x40\xed\xa2\x07\x15\x62\x8c\x1e\x36\x56\x0a\x90\x93\x93\xee\x09\xe3\x40\x67\x6c\xa5\x44\x3d\x39\x69\x27\x08\x1d\x18\x2a\x00\xae\xde\xda\x4a\x89\x7a\x72\x22\x11\x20\x1e\xc0\x50\x4a\xd4\x93\x93\x0e\x82\x38\x06\xb2\x6c\x80\x21\x65\x54\x19\x3d\x70\x45\x0f\xa5\x40\xed\xa2\x87\x53\xd1\x43\x29\x50\xbb\xe8\x01\x0c\xc2\xe8\xa1\x14\xa8\x9d\xf4\x30\x4b\x7a\x28\x05\x6a\x27\x3d\x6c\x83\xd3\xc3\x51\x0a\xd4\x2e\x7a\x98\x25\x3d\x1c\xa5\x40\xed\xa2\x87\x5d\xd2\xc3\x51\x0a\xd4\x2e\x7a\xd0\x85\x8e\xd1\xc3\x51\x0a\xd4\x2e\x7a\x0c\x4b\xfe\x70\x94\x02\xb5\x8b\x1e\x74\xe2\x72\x7a\x28\x05\x6a\xe7\x7c\x71\x8b\xf9\xe2\x28\x05\x6a\x17\x3d\xac\x8a\x1e\xbe\x52\x80\x9c\x9e\x76\x6f\x5c\x3c\x46\x10\xa5\x44\x3d\x3d\x6d\x27\x08\x30\x3b\x48\x32\x47\x29\x51\x4f\x4f\x25\x2a\x99\x0b\x1a\xbf\x52\xa2\x9e\x9e\xb6\x13\x84\x0a\x43\x0b\x06\xc6\x55\xaa\xa8\x5d\xf4\xa0\x83\xcb\xe8\xe1\x2a\x05\x6a\x17\x3d\xec\x92\x1e\xae\x52\xa0\x76\xd2\xc3\x2c\xe9\xa1\x14\xa8\x5d\xf4\xc0\x15\x3d\x94\x02\xb5\x8b\x1e\x41\xc9\x1f\xae\x52\xa0\x76\xd1\x03\x98\x9d\xd1\x43\x29\x50\xbb\xe8\x01\xdb\x39\x46\x0f\xa5\x40\xed\xa4\x87\x6d\x14\xf4\x50\x0a\xd4\x2e\x7a\x38\x25\x3d\x3c\xa5\x40\xed\xa4\x07\x2e\xe8\xe1\x29\x05\x6a\x17\x3d\xac\x92\x1e\x9e\x52\xa0\x76\xd1\x63\x58\xf2\x87\x67\x4b\x05\x08\x1c\xdd\xe7\x64\x76\x43\x92\x2c\xcc\x79\xb8\x0f\xb8\xc7\xdc\x59\xe5\xe4\xad\x09\x96\x71\xf8\xbb\x8d\x42\xc9\xe1\x11\x2b\x8e\x79\x71\x4c\x8b\x47\xca\xe2\x16\x2f\x6e\xd1\xe2\xb1\xb2\xb8\xcd\x8b\xdb\xb4\x78\x22\x3b\xc9\xea\x3e\x2d\x79\xde\x72\xe9\x6b\x2d\xb9\xf7\x92\x30\x0f\x4b\x73\x38\x7d\x50\x58\xb4\xc2\x3c\x2c\x03\x73\x87\x79\xa8\x65\x3c\x9f\x3c\xcb\xf2\xf9\xd5\x34\x0f\xc7\x65\x4b\x93\xa3\x30\x0f\xd9\x9d\xf6\x1f\x50\x4b\x18\x9c\x85\xea\x67\x24\xcd\x8b\x36\xcb\xeb\xf0\x50\x55\x46\x83\x83\x24\x41\xdc\xa9\xb9\x7b\xb6\x14\x5d\x7a\x5b\x35\xf4\xe3\x8f\x3f\x22\x17\x3c\x52\xcc\xfb\xc0\xac\x1c\x51\xaa\x12\x7f\x47\xb6\x25\x63\xdb\x7a\xbf\x4f\xd0\x3e\x82\x03\xce\x74\x3c\x9d\xce\x06\x02\x41\x76\x6b\xa7\x9c\x1a\x84\x80\x6a\x67\x68\x5f\x78\x92\x48\x82\xb2\x6f\x83\xc1\xa0\x42\x7e\x1b\x79\xce\x16\x74\x72\x08\x09\xc1\x9c\x2d\x66\x6d\x55\x1e\x27\x15\x50\x78\xa7\xaa\xb3\x24\xf1\xed\x52\x87\x49\x8b\x90\xf9\x49\x52\x13\x72\x8f\xa3\x24\x99\x88\xec\x43\x18\xa7\x27\x61\xce\x5a\x09\x73\xb6\x06\xc2\x9c\xb5\x12\xe6\x6c\x09\xc2\x48\x29\x23\x06\x9c\x18\x94\xa4\x2a\x62\xd6\x6f\x23\xbc\xa5\x8e\x18\x03\x17\x3c\xd9\x85\x62\x88\x55\xa3\x88\x3a\xf1\xfe\x96\x9d\x07\xaf\x27\x5e\x09\x3f\x2a\xaf\x0e\xf9\xe4\x13\xeb\x84\x5f\x0f\xd5\x09\x51\xb2\xec\xb9\xb3\xda\x25\x02\x69\xfa\x07\x96\x58\xc3\xb9\xf1\xc9\x5b\xe5\x81\xf1\x09\xf7\xb5\x18\x0c\x4e\x6a\x07\xc0\x27\x2b\x9e\xfc\x9e\xd4\x8e\x7c\x4f\x3a\xcf\x7a\x97\x3d\xcd\xbd\x60\xbe\x64\x8c\x89\x78\x4c\xeb\x04\x06\xaa\xb3\x56\x71\x29\xb7\x73\xc8\xbb\xd6\x58\xb8\x02\xac\xbb\xba\xc2\x09\x10\x44\xfc\x29\xfd\x3d\xe0\xb1\xf2\x13\x94\x69\x56\x50\xf2\xfd\x02\x8b\x6a\xc4\xeb\x29\x42\x9f\xd2\x82\x7d\xee\x1c\x2f\xbc\x2c\xbb\xd9\xb0\x96\xdf\x1b\x68\x6e\xa0\x4e\xd7\x0b\x58\x84\xd0\x3e\x0a\xd1\x36\x1a\x0c\x22\xf4\x1d\xd3\x7e\x06\xff\xa6\x5f\x93\x2d\x2a\x2c\xef\xd1\x36\xca\xdb\x71\x2b\xf3\x31\x4e\x28\xd7\xcc\x99\x10\x63\x09\x6d\x6d\x0b\xed\xa0\xf9\x16\x40\x68\xf1\xb6\x6a\x61\x93\xb2\x07\x0d\x73\x5d\xef\x1e\x80\x42\x36\x88\xd1\x77\xe8\xdf\x5f\xa8\x07\x0d\x7b\x41\x9f\x1e\x44\xe8\x57\x14\xa3\x5f\x69\x27\xbe\x00\xe2\x8d\x7d\x4a\x1f\xc4\x29\xd2\x83\x08\xfd\xf1\xb9\x89\xde\xe1\x03\x56\xc8\xdc\xaa\x6f\xf9\x14\x42\x77\xb0\xa0\x01\xdf\x97\x01\xab\x33\xba\x15\x49\xc3\xd6\xb8\x2f\x3d\xf3\x51\x14\x61\x36\x1a\x71\x6a\x40\x14\xe8\x02\xef\x88\xb5\xd1\x0b\xc6\xe7\xc8\xf5\xc0\x85\x5d\xe9\x15\xfc\xf2\xc8\x95\x47\xd1\x90\x57\x95\x86\xce\x68\xcd\xb4\x50\xf3\xcd\x7b\xcf\x22\x29\x9e\x90\xf1\x2d\x99\x0d\x5e\x1e\xb9\x3d\xbc\x05\x3b\x39\xe5\xe4\xe5\xc1\xe1\xd7\xc0\x27\xab\x46\xb8\xec\xc5\x68\x1d\x41\x5d\xbe\x20\xa3\xb1\x58\x1f\x25\xb7\x9c\xdc\x84\x31\xe5\x18\x4e\xb8\xce\x38\x99\xad\x4c\xc3\x2b\x2f\x32\xce\x4d\x18\xd7\x98\x47\xac\xf6\x27\xf3\x4a\x56\x3a\xc6\xd7\x93\x8f\x4a\xdc\xe1\x83\xbf\x52\xe4\x8f\x4d\xb2\x8c\xf2\xf3\x68\x0e\xf4\x0b\x92\xed\x07\x1e\x53\x1c\x3d\x27\x24\x89\xc2\xf8\x03\x4f\x81\x75\x33\x4d\x9a\xf2\xab\x31\x4f\x4a\x6e\x86\xa2\x87\xcf\x9f\xd1\x6d\x86\x5c\x87\x06\xef\x76\x28\x58\xbb\x23\x02\x7e\xc0\x0c\x8d\x97\x14\x16\xf7\x9f\x6e\x93\xcc\x87\xcf\x9f\x3d\x39\x9e\xb0\x0c\xb9\xe0\x4d\xff\xfc\x99\xdc\xdd\xfa\xb6\xc3\xd5\x98\x5f\xe5\xd1\x73\x37\xd6\xda\x77\xf2\x48\xf9\x85\x5a\xcf\x1e\x15\xfe\x66\x42\x6c\x57\x56\x5e\x8c\xed\xda\x59\xb5\x48\x87\xfb\x82\x3c\xcc\xf3\x19\x09\x6f\x0e\x26\x09\x27\x4a\xcb\x6d\xa5\x29\xbf\x2a\x57\x42\x36\x78\x5b\x8a\xdd\xf6\x05\xb9\x21\x90\xc4\x16\xae\x4c\x31\xae\xe0\xa9\xaa\x20\x88\xdf\x84\xdc\xe7\xec\xb5\x72\x77\x4f\x3e\x3e\xe3\x79\xd5\x00\xa7\x27\xf3\x71\x16\x93\x41\x81\x58\xe9\xf3\x57\x62\xa8\x7f\x89\xa9\x8b\x47\x8e\xc8\x5f\x9c\x47\xd6\x33\x80\x70\x1d\x71\x94\xcd\x7b\x0f\xdf\x17\xe6\xdd\xab\xaa\xc7\x11\x89\xa7\x37\x3c\x86\x1f\xe5\xc4\x6c\x7a\x37\xef\xcd\xb6\x25\x0d\xd6\xb3\xf3\x96\x10\x61\xa0\xec\x79\xf7\xd5\x74\xa9\xf5\x08\x4c\x52\x1f\xab\x58\xc1\x1f\x3b\x28\xd8\xc8\x6d\xc9\xf0\x6b\x2d\x49\x17\xe0\xec\xa3\xca\x56\x52\xc2\x40\xfb\x28\xfb\xc8\x79\xc7\x54\xcb\x9e\xe9\x47\x82\x4e\xff\x09\xe6\xb4\xf9\x5d\x34\x27\xff\x7d\x47\x26\xb9\x9e\x39\x11\xba\x59\x5e\x1a\x5f\xf2\x62\x66\x13\xf7\x06\x53\xe8\x32\x02\xfd\xf0\x69\x4b\xd8\x08\x43\xf5\x41\x09\xda\x40\x9d\xc4\xd8\xdd\x45\x9c\x2b\x5a\x7f\x6e\xb3\x34\x46\xda\x61\x8f\xd9\x05\xdc\xea\xca\x0a\xc4\x2f\x2e\xb1\x02\xc3\xa2\x5e\x0f\x5b\x76\xda\x85\xbe\xfb\xfc\x59\xff\x4b\xa3\x85\x36\xb1\x74\x5a\x91\xe1\x46\x67\x6e\x1b\xaa\x8d\xce\xbc\x82\xce\x8c\xfe\xcf\xed\x8c\xcc\xc9\xec\x23\x59\xd0\x9e\xa7\x77\x74\xbb\x2f\x68\xcd\x60\xad\x0e\xf3\x2c\x1a\xf3\x75\x16\x1d\xcd\xd0\xb3\x59\x16\x4e\xd0\x4f\x2c\xd6\x13\x4a\xb3\x31\x21\x93\xf8\x49\xdc\x84\x56\xe4\xeb\x84\x04\xa8\x8d\x29\x71\x75\xd1\x2c\xfd\x7f\xc3\x09\x3a\x99\xdd\x45\x0f\xe8\xb7\x11\xfd\xf3\xe4\x13\x89\xfe\xcf\xf5\x4d\x98\x8d\x9f\xc4\xd3\x9b\x3e\xca\xfb\xd5\x45\x81\x9a\x9e\x0e\x2f\x96\x5f\x56\x95\x2f\x9b\xc9\x26\xf1\xcf\xd3\x59\x02\x2b\xdf\xe2\xb0\xb6\x4b\x30\xca\xc8\x03\xa8\x81\xd8\x21\x06\x3f\x75\xd8\x02\x86\x66\xdf\xfe\x07\xed\xee\x4e\x3f\x92\x59\x3a\x9e\x7e\x92\xab\x58\x98\x76\xbb\x00\x86\xbd\xad\xef\x28\x00\x85\x5a\x66\x89\x75\x02\xad\x2a\x36\xd7\xa0\x38\xae\x92\x25\x10\x32\x3e\x63\xa1\x2f\xbb\xbb\xa8\xe8\x0a\x8a\x70\x67\x3d\xf9\x01\x13\xf4\x53\xe2\xae\x50\xb6\x6c\x55\x2d\x4b\x0b\x6b\xe4\x53\xb6\xd4\x4d\x96\xcd\xda\x9a\xcd\xea\x35\x0d\xcd\xdb\x7a\xcd\xcb\x53\x60\x23\x2e\x98\xd6\x84\xd4\xf6\x76\x64\xaf\x8a\x92\xfc\x57\x25\xb2\x6a\x44\xb7\xb7\x23\x4b\x8e\x64\x37\x0a\xdd\xbf\x48\x11\x93\x23\xb5\xbd\x1d\xe1\xa5\x0e\x13\x61\xc6\x49\xb9\x00\x4a\x6c\xef\xc3\x94\x7b\xfa\x94\x0a\x00\x9d\xb2\x16\x3b\x4d\x55\x17\xed\x1a\xed\x76\x32\x75\x92\x48\xae\xca\x01\x4e\xe6\xbd\x89\xf9\xa9\x6c\x9f\x26\xb9\x0a\x46\xc1\xf4\x3b\x04\xca\x26\x31\x5f\xff\x06\x31\xfb\xdb\x4b\x7e\xf3\x3a\xcc\x63\xab\x58\x05\xaa\x97\x5b\x5c\x98\xb7\x0b\x03\xe9\x6e\x9c\xaa\xda\xe0\xd1\x84\x32\x61\xb5\x65\xcb\xea\xc2\x72\xfc\xfd\x1c\x41\xa3\x73\x34\x9d\x8c\x1f\xd8\x9a\x7d\xf4\xf3\xf9\xc5\x11\xfa\x0d\x3d\x45\xed\x91\xb0\xe8\xa7\xc0\x14\xb7\xa1\xdf\xe9\x60\x27\x1d\x02\x5e\x5b\x6b\x14\xe8\x92\x52\x33\x85\x95\x8b\xf2\x93\x8e\xd7\x5f\xb3\x11\x64\x05\x1b\x88\xac\x66\x63\xc7\x2b\x45\x8b\x6b\x72\x25\x5e\xc5\x58\xc8\x7a\xac\xb9\x55\x46\x5a\xdb\x65\x54\x31\x55\x13\x0b\xed\xcd\x33\x5a\x6d\x03\x8d\x7a\x6d\xa2\x91\x5c\xf2\xb6\x88\x07\x85\xd7\x9c\xb8\xe1\xe6\x55\x84\x4e\x77\xd5\x5c\x76\x7f\x8d\xd4\x7b\x6c\xb4\xf2\x3e\x1b\x2d\xbf\xd7\x46\x9d\xe2\x42\xd7\x12\x5a\xcd\x7d\xd1\x20\x5a\x8a\x87\x96\x2a\xc5\x76\xbc\xac\xd9\xbe\x83\xd7\xd9\xc3\x0b\x20\x96\xdb\xca\x3b\xe6\x66\x2b\xdf\x36\xde\x9b\xad\xfc\x3a\x8f\xbf\x16\x36\xf0\xbd\x8e\xbd\xae\x2e\xb4\xb7\xcc\x2b\xec\x95\x5b\xd6\xfa\xe6\x22\xbf\x59\xdd\x37\xab\xfb\x63\xac\xee\x5d\xbf\x7c\xb1\xe5\xfa\x74\x12\xcf\x08\x84\x03\xe6\x4d\x2a\x15\xf4\x8a\xc1\x76\x10\xbb\xee\xd1\xf1\x0b\x78\x37\xff\x81\xcc\xbf\xae\xba\xb0\x84\x9e\xb0\xac\x89\x9f\xd6\x5c\x52\x2f\xc0\x1b\xbd\xa0\x6d\x60\x37\x7a\xc1\x1a\xf5\x82\xe3\x31\x89\xf3\xd9\x74\x92\xc5\xe8\x70\x9a\x90\x68\x3a\x5d\xd6\x35\xe6\xf8\x50\xd3\x35\x86\x15\x5c\x56\x47\x38\x3e\xac\xbb\xc6\xd0\xe7\xc7\xd5\x09\xc4\x15\xbe\x2e\xce\xeb\x20\x56\x3a\x34\xa7\xdd\xa8\x09\xa5\xcf\xdb\xad\x84\x3c\x4e\xb7\xb8\x4c\x3c\x3e\x5c\xf6\x88\x14\x6a\x2e\x29\x3f\xad\x8d\xfc\x6c\x1b\xac\x8d\xfc\x5c\xa3\xfc\x3c\xbf\xcb\x6f\xef\xf2\x55\xdd\x0a\xcf\x75\xdd\x0a\xcf\x57\x72\x2b\x6c\xee\xaf\xce\x1b\x6e\x86\xe7\x5f\xb9\x0b\xd9\x97\xdf\x5f\x2d\xba\xca\x94\x6f\x3e\xf3\x1e\x4b\x82\xc9\xb7\xb8\xcf\xda\x18\x37\x3b\x56\xd0\xf3\x86\x9b\xa7\xc6\x6e\xe5\x7c\x69\x87\xa4\xf3\xe5\x1d\x92\x1c\x7b\xb3\xda\xb6\x0d\xec\x66\xb5\x5d\xe3\x6a\x7b\xf0\xea\xf2\x14\xfd\xf2\x64\x68\xd9\x45\xcc\x03\x34\xcf\xa9\x58\xbd\x6e\xde\xf3\xe9\x5a\x6e\x6f\xc3\xe4\xc9\xc1\x64\x9e\xfd\x42\x61\xec\xb7\x74\xf9\x36\x4c\xc4\x95\x2d\x09\xf3\x50\x70\x4f\xd5\x70\x4b\x6d\x97\x97\x45\x64\x89\xcb\xea\xda\x79\xed\x1a\x7a\xbb\xf4\xa8\xad\x6b\x45\xbd\x6a\xa1\xeb\xbe\xa1\x0e\xcc\x7f\x37\xc9\x4b\x32\x75\xe7\x46\x81\x4b\x8b\xaf\x59\xb1\x85\x26\xd8\x8b\x9d\x3a\xea\x7f\x6f\xfc\x2e\xc3\x81\x85\xdf\x1f\x87\x73\x96\x9e\x05\xdd\x4e\xe7\x59\x67\x18\x58\x8a\x0a\x2d\x4a\xa1\xbe\x9e\x16\x34\x2a\xdb\xdd\x6e\xe0\xb9\x83\x70\x77\xd3\xaf\xc3\xc5\xd4\x65\xa8\xb8\xfc\x1f\x8f\xc3\x9b\xdb\xae\xa4\xca\x55\xd0\x8f\xb7\x22\x32\x55\x0a\x97\x3a\x16\x55\x0a\x17\xb1\xb4\x22\x99\x4b\x3d\x04\xc1\x76\x03\x66\xcb\xc1\x78\xcb\x9d\xee\xbb\x49\x0b\xaf\x4a\x18\xf4\x27\x92\xa3\xc9\x1d\xb8\xca\x4f\xd3\x3a\x63\x30\x97\x81\x72\x94\xb4\xf9\x44\x20\xd5\xa0\xde\xa5\x1d\x84\xb7\x0a\x92\x49\xfd\xc0\x2a\xfd\x43\x16\xc5\xa4\x01\x5c\x4d\xaf\xba\xf8\x51\x2d\x7e\x85\x54\xb8\x1f\x5a\xf6\xd2\x0b\xa0\xb3\x59\x00\xdb\x88\xbf\x59\x00\xd7\xb8\x00\x9e\x5e\x9e\x23\x48\x80\xba\xca\xfa\x77\xca\x93\xa8\x3e\xce\xfa\xf7\xf5\x2f\x64\x95\x20\xd1\x5f\xc9\xe4\xcb\x09\x4b\xaa\xdf\x9e\x11\xea\xc9\x2c\x9c\x24\xd3\x9b\xc1\xc2\xda\xb5\xb5\xd5\x72\xaf\x18\xce\x73\x64\xd0\xd8\xfd\xdc\xc1\xdb\x85\x45\xc8\x72\xde\x19\x14\xe8\x66\xf1\x68\x1d\xa3\xc7\x5d\x3c\x8a\x29\xb5\xf4\xe2\xe1\x6e\x16\x8f\x36\xe2\x6f\x16\x8f\xf5\x2e\x1e\xbb\xa7\xc7\x87\x68\xe8\x0f\xfd\x1d\x8c\xf8\x24\x40\x2f\x49\x3e\x9a\x26\xc8\xea\xb7\x82\x40\xca\xed\xf5\xae\x20\x07\x49\xc2\x82\xd1\x75\x8a\x11\x0d\x69\x5b\xc8\x47\x0a\x88\x7d\x0a\xb1\xd8\xd5\xec\x7f\x91\xd9\x94\x22\x0d\x77\x27\x67\x64\xde\xbe\x82\xd5\xfa\x4f\xab\x70\xf2\xd1\xe7\x85\x3e\x3e\x8e\x0c\xe6\xa2\xee\x77\x8e\x6f\xa7\xbc\xeb\x44\x15\x1a\x64\xad\x28\x05\xea\x74\x42\xd0\xcd\x74\x46\xd8\xb6\x6d\x67\x07\xa8\xa3\x31\x3a\x85\x9c\xdd\xd9\x59\x97\x60\x05\x4e\x5b\x5a\xb0\xae\x27\x47\xfa\x46\xb0\x6e\x04\x6b\xd1\x78\x8b\x59\x0a\x4d\xa6\xd3\xdb\x55\x54\xf2\x57\xc5\x1c\xd5\x91\xa8\x6d\x03\xa1\x25\x5f\x5a\x2b\xf6\x9f\x93\x25\xb2\x4b\x4f\xca\xf5\xe4\x3f\xdd\x4c\xca\xcd\xa4\x2c\x1a\x5f\x9c\x94\xff\x25\x2c\x93\xcb\x4c\x49\x61\xe1\xfc\x46\x36\xca\x2b\x9a\x3c\x45\x73\xe4\xc2\xbe\x79\x30\x90\xef\x9c\xb7\xd0\x1f\x7f\x34\x5f\x3d\x8e\x16\xa4\x24\x5f\xaf\x00\xce\xbb\xbb\xe8\x0d\x45\xa0\x13\x5a\xb6\x10\x92\x19\xcc\xce\x6d\xc5\x3f\x8d\xb2\x31\x41\x83\xbf\x0d\xaa\x80\xb2\x55\xea\x6f\x88\xe6\xb7\x90\xea\xbb\xbc\xd0\x2b\x3b\x7e\xcc\xda\x74\x29\xd4\x79\xb0\xd8\x0c\x6f\x0b\xd9\x66\xd7\xa2\x8c\x09\x53\x62\x69\xd1\xff\x97\x8a\xf5\x35\x1f\x85\xb8\xf1\x6a\x74\x13\xc6\x5f\xdf\x32\x00\x88\xc2\x37\xc0\xef\x1b\x5e\x09\x14\x4e\x34\x9f\x27\x6f\xf6\xb3\x70\x4e\xca\x24\xd8\xf4\xa1\xbd\x58\xef\xf4\xda\x0b\x39\xb3\xdb\x0a\x5d\x9e\x1c\xe0\x2a\x65\x33\x7d\x6a\x2f\x77\xf2\xf2\xe0\xb0\x2a\x47\x9f\xf4\x63\x44\xbe\x0e\xe7\x73\x2a\x56\x77\x68\xe7\x12\xf4\x82\x3c\xa0\x23\x32\xcb\x3e\x86\x2c\xe4\x6e\x31\x04\xd6\x32\x19\xa7\x5f\x3f\x7b\x71\xf4\xdc\xaa\x30\x2b\x9f\x69\x5b\x72\xff\xa4\x56\x6c\x11\xbf\x00\x33\x49\xb3\xeb\xbb\x19\xc3\x70\x7a\x4b\xff\xcc\xdb\x0d\xb0\x6d\xf1\x17\x11\x0f\x3c\x39\x9b\xde\x92\x59\xfe\x80\xfe\x87\x19\x43\x21\xe2\x24\xac\xcc\x57\x23\xf0\xed\x41\x73\xfa\x90\xb1\x6b\xc6\x73\x94\x4f\xcb\x28\x48\x4f\xd0\x11\x49\xc3\xbb\x71\xbe\x87\x1c\x34\xc0\x56\x80\xa2\x2c\x9f\x77\x84\x00\xa8\x35\xc5\x42\x33\xfe\xc9\x23\xb5\x96\xe1\x4f\xc9\x8c\xc7\xb9\x12\x20\xd3\xd1\xee\x81\x7c\x96\x13\x46\x90\x39\x40\xad\x0c\xbc\xc2\x0f\xf9\x14\xdd\x92\x59\x3a\x9d\xdd\x08\xed\x74\x35\xb2\x38\xa2\xf4\x13\xa7\xd7\x7b\x1a\xa3\x87\x98\x5b\xd3\x25\x24\x81\xc0\x56\xb0\x6b\x5b\xdd\x29\xbc\x19\x05\x58\x8f\xbb\x4b\x55\xfd\xe8\xc2\xf9\xcf\xad\x8e\x70\xd9\x12\x5e\x3a\x9d\x64\x79\x06\xc9\x2a\xe6\x28\x44\x13\xf2\x69\xfc\x80\x98\xdd\x29\x01\x1e\x48\xaa\xa9\x50\x48\xa3\xfe\x9c\xc6\x42\x9c\x9e\xc3\xca\xf5\x27\x25\x21\x1a\x9c\x03\xd7\x86\xe3\x2d\x18\xad\xb8\x8d\xa3\x8b\xd0\x67\xe9\x74\x06\x16\x9b\x0a\x95\xde\x18\x74\x86\x27\x95\x56\x43\x85\xa7\x5c\x92\x8a\x9e\x8a\xc2\x4c\x2e\x4c\x74\x5d\x77\x67\xfa\xc0\xf8\x9f\x8a\x61\x02\xf0\x9f\x5a\x2b\x44\xa3\xce\x3f\xa6\x69\x4a\xda\x68\x67\xfd\x6c\x92\xd5\x72\xe0\xc7\xe9\xb5\xd2\x29\x9b\x8e\xf5\x7e\xf9\xb5\x98\x33\xb4\x66\xbf\x80\xef\x52\x69\x08\x8e\x19\x2c\x5e\x9c\xae\x30\x5f\x96\x87\x17\xc3\xf4\xde\xf2\x16\x81\x8f\x8b\x87\x2e\xf0\x12\x38\xf3\x70\x9c\xa3\x03\xf8\xd3\x1b\xb9\x8e\x78\xbf\x45\x6c\xa2\xf6\xd0\xc1\x72\x90\x2b\xce\x18\x42\xf5\x80\x0f\x49\xfa\x84\x27\x02\x18\x14\x94\x31\xa0\x87\x3d\x39\x8f\x03\x11\x99\xaf\x01\x4f\xcf\x4f\xb7\xb3\x0c\xb8\xe9\xd6\x58\x55\x75\xa3\x2d\xcb\x41\xe9\x90\x42\xe4\xe1\x8d\x21\xec\x32\x9f\x92\x74\x12\x30\x71\x6f\x94\xcc\xa2\xbc\x3d\x07\x12\x9a\x65\x51\x52\xa4\x07\x62\x03\xfe\x02\xa8\xbf\x70\x8e\xa0\xc8\x57\x03\x3b\xde\xd3\x49\x42\xee\xdb\x2a\xbf\x35\xef\xf9\x19\x84\x3c\x05\xa5\x7e\x2a\xa3\x12\xd7\x72\x83\x5b\xbe\x29\xb6\xb9\x7a\xe8\x16\xf5\xab\x37\x3a\xf5\x0b\x65\x67\x9f\x0e\xfc\x13\xfe\xa4\x70\xae\xae\x34\x09\x56\xab\x7a\xa1\xef\x31\xdd\x59\x8e\x6f\xb9\x1b\x74\x29\xd2\xb8\x3c\x2d\x10\x56\x39\xf7\x96\xb4\x41\xfb\xc0\x80\x4f\xee\x6e\x13\x3a\x80\x30\x4d\x9e\xa4\x3c\x33\xd5\xa0\x22\x96\x84\x2d\xe8\x07\x60\xcc\xc8\x9c\xe4\xd2\x64\x17\x48\x77\xec\x6b\x38\xd6\x86\x4e\x35\x6a\x8b\x35\xcf\x18\x6d\xf6\x85\x57\x9c\x5e\x6a\x4c\x4f\xcb\xb1\x53\x36\x98\x89\x19\xcd\x78\x63\x72\x34\x6b\x3e\xda\x98\xf9\x68\x0b\xcc\xa2\xe3\xa4\x8d\x60\xc5\xad\x35\x0c\x43\x51\x8e\xa0\xf8\xa3\x62\x0c\x51\xbf\x71\x44\x3d\x52\xdb\xa0\x16\x1a\x15\xa3\x2a\xbe\x53\x26\xeb\x11\x1a\xfe\xe5\xfc\xa2\xde\x75\x08\xf0\x23\x8f\x00\x5b\x7c\x4a\xca\xff\xc6\xbc\xe3\x7f\x2b\xbc\xe3\x05\x86\xf9\x07\xfa\x4d\x8f\xfe\xf4\x53\x55\x7e\xfb\x1b\x38\xca\x2f\xf4\xf4\xed\x6f\x12\x87\xf9\xe2\xa3\x0a\x0e\x26\xfd\x59\x90\x8c\xfc\xc4\x18\xb0\x52\x8c\x7a\x43\x42\xbe\x35\xdf\x6d\x6f\x2f\x73\x9f\x41\x68\x5d\x30\xc5\x15\xf2\x53\x9e\x5a\x8a\x6b\x26\x15\x88\x95\xae\xe0\x75\xec\xe0\xd7\xa5\x01\xf6\x4c\xf5\xa0\xab\xf9\x2d\xaf\xf5\xad\x6b\xef\x94\xcd\xb9\x0a\xd5\xb5\x7b\xea\x95\x46\x42\xae\x56\x7e\xb9\x6c\x12\x4c\xe9\x2c\x37\x44\x6c\x2f\xa4\xa1\x78\xf6\x83\x60\x20\xf5\x46\x6d\x15\x88\xba\x1b\xb5\xd6\x1c\x18\xa5\x7d\xa9\x4b\x51\x36\x90\x64\xd7\xc6\xc7\xbb\xbe\x89\xa4\xe5\xf5\xb5\xf8\x3f\xfb\xdf\xd6\x61\xcd\xa9\xb3\x6b\x18\x85\x45\x77\xcf\x76\x8c\xc2\xce\xbb\xe7\xda\x7f\xbe\x33\x9c\xbf\x54\x04\x61\x32\x89\x77\xa2\x70\x4e\x3c\xa7\xf1\xc3\x4d\xe2\x36\x8b\x7e\xbc\xfd\x90\xa4\xdf\xd2\x21\xae\xd0\x39\x78\xa6\x7d\x62\x3f\xb0\xae\xfc\x27\x9d\xf4\x7e\x15\xf6\xfd\x4b\xb8\xf4\x77\x58\xdc\x51\x65\xb6\x7b\xf1\xa5\xa6\xf9\xbe\x0d\xfd\x0b\x72\x37\x0f\xa3\x31\xe1\x79\x96\xda\x7b\x71\x89\xd0\x3e\x7a\xdb\xa2\x42\xb1\x66\x24\x3f\xfe\x54\xd4\xd4\x55\x14\x2e\xc2\x28\xca\x72\xc4\xaf\x96\xf2\x7b\xb9\x52\xb3\x7e\x1b\x14\x48\x55\x90\xcd\x51\x88\xc6\xe4\x3a\x8c\x1f\xd0\x47\x32\x9b\x43\x6e\xa9\x51\x98\xa3\x09\xb9\x1e\x93\x38\x27\x09\x5d\x82\xe3\xe9\xe4\x23\x99\xe5\xa0\x98\xd0\xa5\x20\x9f\xa2\x71\x96\xe7\x63\xb2\xc3\x72\x52\xb6\xae\xf9\x00\x9f\xcc\x66\xd3\x19\x4a\xa6\x64\x3e\xf9\x3e\x47\x61\x9a\x92\x98\x81\x61\x58\x7f\x3f\x47\x73\x12\xdf\xcd\xb2\xfc\xa1\xc5\x62\xfc\x03\x8a\xee\x72\x94\xe5\x50\xbf\xa8\x9c\xe5\xf3\x32\xd0\x75\x36\xce\x72\x1e\x37\x73\x9a\x53\x22\x64\x74\xbd\xbd\x21\x13\xa6\x21\xb4\x1d\x26\x74\x9c\x70\x30\x92\x9e\x31\x42\x94\xe7\x1c\x8d\xb7\x22\x3b\xc9\xed\xe6\xef\x93\xe9\x05\xdd\xa3\xac\x3d\x73\xf2\x0b\xf1\x6e\xb1\x8e\x7d\xa0\xba\x49\x0d\x3b\xfd\xae\xe4\x0b\xa8\xb1\xc3\xcf\xb8\xb5\x86\x2a\x3c\x44\xc7\x66\xf3\x4b\x89\x18\xfd\xf6\x56\xaa\xd1\xbf\x78\x6b\xbe\x33\xd0\xe0\xc5\x5b\xfb\x1d\x0f\x7b\x8b\xfe\xa0\x8f\xfc\x4c\x1e\x7b\x5b\xdd\xc7\x07\xac\x3e\x66\xf5\xcd\x7a\x7d\x5b\xbb\xbe\xc5\xea\xe3\x7a\x7d\x53\xbb\xbe\xcd\xea\x5b\xf5\xfa\xb8\xac\xdf\x59\x5d\x96\x35\xb5\x8d\xfe\x45\x9c\x2e\x8d\x11\x38\x2c\x47\xe0\x50\x39\x02\x8b\xb8\x0b\xb4\xe7\xa4\x60\x6e\x10\x69\x0a\x39\xa2\xcb\x0e\xb2\x8c\xad\xa6\x49\x7f\x51\x90\x69\x71\x80\xed\x7a\x23\xb8\xb5\x11\xab\x6f\x23\x66\xe7\x28\x0a\xe0\x1a\x8d\xd8\x7d\x1b\xc1\x5d\x43\x6d\x08\xe0\x1a\x8d\x98\x8d\x46\x96\xe5\x8a\xc3\x70\x36\x7b\x40\x51\xd6\x6d\xc2\x60\xe3\x1e\xb1\x68\xd3\x52\x2b\x6c\xce\xf8\x8b\x8a\xe0\xf9\xc3\x3c\x27\x37\x28\x9d\xde\xcd\x50\x9e\xdd\x48\xd8\x6b\xf9\xd4\xc2\x13\x72\x9f\x5f\x52\x19\xa2\x95\xa5\x56\x9e\x99\xf7\xe5\x34\xc9\xd2\x07\xb6\x7a\xb0\x69\xd1\x0f\xe3\x40\x0b\xe3\xc3\xb7\x2c\x90\xc0\x2f\x6f\x07\x19\xda\x46\x10\xe0\xdf\x97\x86\x12\x90\xd2\xfb\x9f\x68\x4e\xf2\xbb\xdb\xce\x32\x7a\xa1\x25\x7a\x99\x24\x4f\xff\xc9\xc2\x4d\x68\xda\x21\x4f\xff\xf9\xde\x44\xfb\xe8\xf4\x9f\x6f\x4d\x85\x01\x88\x97\xc6\xac\x34\x56\x65\x80\x16\xe5\x19\x30\xd9\xfc\x2e\xfa\x48\xa8\x12\xaa\x61\xab\x34\x59\xee\x68\x40\x8e\x25\x8f\xfe\x03\xb1\xa7\xae\xe4\xd1\x62\x71\x9e\x1d\xba\x2c\x5f\xb9\x75\x29\xb3\x43\x97\x18\x58\x25\x06\xb8\x86\x01\x56\x61\x80\xeb\x18\xe0\xa5\x31\x80\xec\x10\x99\x59\x48\x1a\x0a\x2f\xb3\xea\x82\x46\x03\x8a\x0d\x50\xac\x42\x7c\x01\x14\xb3\x2e\x98\xd4\x03\xc9\xe7\x9e\xe6\x72\x84\x60\x1e\x99\xcc\xce\xa8\xc8\x82\x70\x48\x65\x29\x2d\x27\x09\xb2\xcf\xca\x59\xac\x9c\x22\x3b\xc0\x21\x15\xc6\xb4\x9c\x22\xd5\xc1\xe1\x5b\x47\x13\x3f\x57\x13\x3f\x4f\x13\x3f\xbf\xc4\x4f\xcf\xb8\xdf\x57\x5a\xa3\x95\x24\x36\xea\x2d\xb5\xd1\x12\x39\x19\xba\x0e\xc8\xdf\x27\xd3\xd7\x1d\x11\x8d\x5e\xf6\x8c\x66\xa4\xab\xae\xf6\x5d\x30\x3b\x4b\xb7\x53\x4d\x4b\xdd\x83\x51\xad\xa2\x05\x81\xe4\xee\xac\x78\xc9\xf2\x16\xfc\x02\x13\x0c\x0d\x7e\xa1\x0c\x5a\x88\x08\x78\x2e\xb5\xae\xee\x31\xbb\x64\xc9\x03\x7e\x81\x49\x45\xeb\xf8\x0d\x18\xae\x0e\x0c\x8b\xc1\x70\x38\x0c\xdc\x80\xe1\xeb\xc0\xb0\x19\x0c\xef\x5d\x89\x7b\x0d\x06\xae\x60\x3c\x82\x7a\x42\xd9\xe5\x53\x78\x8b\xd8\x36\x56\x5a\xf4\x92\xea\x05\xb0\x22\xc0\x37\xb6\x22\x50\x61\x0a\x8f\x5d\x4b\x82\x72\xaa\x09\xf0\xf8\x92\x51\xc2\xa3\x4b\x46\x73\xcd\x50\xf5\x46\x15\x75\x89\x7e\x5e\x36\x63\x26\x5d\x2e\x13\x2b\xa9\x63\x02\x97\x0e\xe7\x95\xbf\x58\x6b\xb9\xec\x23\x2b\xe4\x39\xbb\xb6\xb5\x68\x7f\x95\xe6\x6d\x2a\x27\x5a\xe7\x9e\x5a\x2b\xf8\x4b\x4d\x06\x74\x15\x12\x76\x55\xdd\xfe\xea\x97\xe1\x47\x82\xa6\xe3\x44\x67\x7d\x5c\x4e\x33\x3d\x7c\xcf\xd8\xef\x50\x92\x1f\xaf\x0b\xbb\xc3\x70\x1c\xdf\x8d\xa9\x98\x99\x90\x4f\x3a\x28\x1e\x32\x01\x33\x80\xbf\xdb\xc8\xbc\x77\x12\x1b\xfe\x87\xb6\x8b\x9d\x06\x44\x2b\x6e\x47\xe4\x90\x89\x96\x01\xfc\xa5\xb5\x79\x65\x1b\x6d\xa3\x01\x83\x49\xb9\xdb\xdc\x42\x4f\xd1\xe0\xf0\xbd\xf0\xfc\xbf\x11\x46\x7b\xc8\xdc\x92\x02\xb7\x38\x70\x8b\x01\xe7\xb0\x1d\x0e\x1c\x37\x80\xe3\x7e\xc0\x6d\x0e\xdc\x5e\xe8\xf7\x80\x35\x59\x03\x6e\xf5\x03\xee\x70\xe0\x4e\x2b\x59\xec\x06\x70\xbb\x1f\x70\x97\x03\x77\x5b\xc9\xe2\x34\x80\x3b\xfd\x80\x7b\x1c\xb8\xd7\x4a\x16\xb7\x01\xdc\xed\x07\xdc\xe7\xc0\xfd\x56\xb2\x78\x0d\xe0\x5e\x1f\xe0\xd5\xb6\x98\xc1\xaf\x41\xf2\x9b\x90\x24\xf1\x2e\xca\x19\x44\xd5\x80\xeb\x9d\xb5\x4f\x6f\x5a\xe1\xfa\x1e\x16\xc2\x8c\x92\x81\x4d\x73\xa9\x4d\x60\x3a\x99\xe7\xb3\xbb\x38\x47\xa3\xec\x7a\x84\xc2\x49\x82\xc6\xd3\x4f\x28\x9c\x5d\xdf\x41\x88\x72\x88\x1b\xf8\xdf\x77\xe1\xac\xeb\x4a\x72\xd9\x6c\x88\xf6\x69\xdb\xc5\xb6\x42\x6e\x4e\xbc\x8e\x58\x69\xb6\x46\xab\xcc\x16\x05\xd5\x6a\x28\xce\xc8\xfc\x6e\x9c\xa3\x69\xaa\x89\xdf\x88\xad\xba\x83\xeb\x10\xfd\x80\xae\x43\x16\x86\x02\xfb\x5b\x68\x1b\xb1\x57\x11\x7f\xe5\xc2\xab\x08\x5e\x29\x7a\x31\x66\x30\x85\x6e\x33\xb3\xcd\x0f\xe8\xfa\x1e\xf8\x69\x0b\xd8\xaf\x28\x50\x6e\x94\x84\x02\xf2\xbe\x9f\xd0\x1e\xff\x72\x7e\x81\x64\x29\x00\x7f\x62\x32\xfd\x7a\x84\x7e\x45\xd7\xe3\xd5\xc4\xba\xd2\x50\xfb\x0b\x17\xea\x3f\x31\xa1\x3e\x18\xfc\x54\xe9\x67\x54\xed\xf8\x49\x50\x01\xb7\x78\x01\xaf\x5e\xc0\xab\x0a\x48\x26\xdd\x2f\x5c\xfe\xff\xc4\xe4\xff\x80\xb5\x58\xe9\x4b\x3f\x15\x12\x1f\xf4\xa5\x6d\x44\x1b\x96\x82\xb3\x38\x38\xab\x00\x87\xeb\x68\xe1\x05\xbc\xcd\x7a\x01\x53\x13\x6f\x9b\x37\x64\x17\x0d\x59\x75\xbc\xad\x06\xde\x58\x8e\xb7\xc3\xc1\x39\x05\x38\xbb\x8e\x96\xbd\x80\xb7\x55\x2f\x60\x69\xe2\xed\xf2\x86\xdc\xa2\x21\xa7\x8e\xb7\xd3\xc0\xdb\x96\xe3\xed\x71\x70\x5e\x01\xce\xad\xa3\xe5\x2e\xe0\xed\xd4\x0b\x38\x9a\x78\xfb\xbc\x21\xff\x5d\x8d\xdf\x4a\xbc\xbd\x06\xde\x6e\x17\xde\x2d\x93\xa4\xe3\xe8\xac\xd0\x0a\x4b\x65\x12\x5c\x4c\x6a\x07\x52\xec\x90\x8f\xf9\x47\xa5\x61\xdc\x8c\x1a\x8c\xd6\xec\xee\xc1\xda\xcd\xc9\x7d\x2e\x7a\x58\x88\x27\x4f\x45\x08\xd6\xc1\x0d\x99\xcf\xc3\x6b\x62\xd0\x9d\x22\xf3\x80\x90\x3a\x6d\xdc\x8e\x43\xda\x89\xfb\x1c\x75\x42\xe6\x41\xc6\x07\x15\x12\x0a\xe0\x6d\xde\x1a\xb2\x53\xb2\xf7\xcc\xfd\xe2\x84\x8c\x6f\xc9\x6c\x20\x96\x6c\x9e\x3c\x6b\xb8\x58\x88\xd5\x97\xba\xed\x68\xd4\x4f\xe6\xf7\x6c\xd3\xa8\x8e\xe4\xf7\x6c\xcb\xe0\x27\xf5\x7b\xb6\xff\xe7\x3b\xc3\xfd\x4b\x65\x81\xda\xb8\x63\x6c\xdc\x31\x36\xee\x18\xeb\x77\xc7\xd0\x12\xa2\x95\x0b\x42\xd3\xf9\xe0\x2f\xe1\x76\xa0\x63\x83\x5b\xde\xc8\xf7\xa2\xb4\xdc\xbd\xa8\x5b\xee\x5e\xac\x6a\xb9\x7b\x51\xb7\xdc\xbd\x90\x59\xee\xba\xc0\xc8\xcf\x0f\x37\x0e\x19\x1b\x87\x8c\x8d\x43\x46\x5b\x23\x1b\x87\x8c\x8d\x43\xc6\x12\x38\x6f\x1c\x32\x36\x0e\x19\x1b\x87\x0c\x79\xb9\x8d\x43\x46\xeb\x67\xe3\x90\xb1\x71\xc8\x68\xfd\x6c\x1c\x32\x1a\x9f\x8d\x43\x46\xd9\x9b\x8d\x43\x06\x7c\x36\x0e\x19\x02\x76\x1b\x87\x8c\x8d\x43\xc6\xc6\x21\x63\xe3\x90\xd1\xd1\xec\xc6\x21\x63\xe3\x90\xb1\x71\xc8\xd8\x38\x64\x94\xe0\x36\x0e\x19\x5f\x8b\x43\xc6\x63\xb8\x62\xac\xd3\x09\x43\xc7\xfd\x62\x69\xc7\x8b\xcf\xe1\x72\x81\x37\x2e\x17\x1b\x97\x8b\x8d\xcb\xc5\x62\x89\x2f\xe9\x72\xd1\xe1\xf6\x70\xe8\xf4\x09\x41\xd1\xe1\xf4\x70\xe8\x08\x1e\x0f\xf0\xf0\x15\xb8\x3b\xb0\x70\x47\xa5\xc3\x83\x5c\x77\xfd\x50\x85\x7e\xd4\x74\x8e\x80\x28\x49\x62\x04\x32\x21\xe7\xb0\xd4\xf4\x39\xc9\x72\x34\x8f\xa6\xf7\x52\xe8\x97\x25\xea\x97\x1d\x5e\x2e\xc5\xa7\x6d\x6b\x64\xb9\x9e\x96\xa1\x8e\x5b\xdf\xb2\x65\xcf\xe1\x5e\x90\x07\xc5\x41\x5c\x1d\x3b\xa3\x88\x84\xd7\x07\x49\x4e\x6c\x4a\xda\x22\x22\x68\x86\xfe\x2e\xd2\x5f\x7d\x5c\xc3\xeb\x53\xc5\xa8\x18\xe9\xb7\x35\x98\x8b\xd9\x24\x6a\x3f\x2f\x24\x96\x50\xd8\x0c\x69\x37\x07\xbf\xa1\x6d\x46\xe1\xed\xa2\xfd\x2d\xf4\x77\xe8\xb6\x3a\x74\xe5\xa7\xb0\x9b\xa6\x45\xa7\x40\x51\x90\x1a\x19\x51\x35\xc6\x97\xca\xc8\x80\xb4\x04\xe5\xba\x65\x99\xe1\x50\x75\x7a\xcc\xf8\x39\x2b\x39\x9b\xf1\xc2\x67\x3d\xc8\x28\x8c\xb4\x60\xa0\x2d\x22\xc8\xbf\x28\x4e\x08\x28\x5f\x28\xcf\x66\xba\x50\x2b\xa3\xa6\x59\xae\xa7\x36\xd0\x9a\x3d\x8d\xb3\xad\xb8\xae\x66\xa8\x15\x44\x4c\xb7\xa1\xb6\x1a\xad\x0e\x21\x51\x84\xb7\xe4\x43\xda\x6d\xed\x11\xe3\xce\x0a\x07\x32\x9d\x40\x3f\x88\x3d\xed\xe6\x94\x52\xc0\x4c\x98\x64\x99\xb0\x53\x8a\x89\x5c\xae\x64\x70\x7a\x8b\xb6\x11\x2e\xa7\x64\x57\xd1\xda\x54\x56\x4f\x60\xd5\xe4\xd5\x9b\xb8\x5a\x93\xb6\x9a\xb0\x9d\x45\xea\x44\xfc\x83\x42\x1c\x70\x91\x44\x6b\xf3\xee\xbc\xab\x92\xad\x4f\x24\xc9\xd5\xbb\x8d\x26\x6f\x20\xac\xaf\xdc\x7f\xa4\x9a\xfd\x1d\x9c\x54\x09\x85\x2e\x2e\xe2\x3b\x9a\x5a\xa7\xfe\x12\xdb\xd6\x43\x67\xcd\x7b\xd6\x43\x67\x4d\x1b\xd6\x45\x65\xaf\xb1\x5b\x3d\x74\x7a\x04\x4f\x05\x7f\x88\x8c\x24\x6b\x53\x4b\x8f\x66\xd3\xdb\x9a\x6a\xca\x5f\x00\x45\xbf\xb2\x8c\x27\x09\x45\xad\x9e\x2e\xa4\x71\x76\x4d\xb9\x93\x96\x2a\x13\x86\x20\x3c\x5c\x3c\xe5\xeb\x22\x09\x2a\x32\x86\xd0\xce\x0b\x09\x10\xba\xa5\x24\x6d\x6b\xaf\xb3\x8d\xce\x0c\x1f\x7d\x35\x7a\x8a\x4f\x51\x47\xfb\x90\x9f\x0e\xa4\x9e\xd6\x59\x3a\x30\xd3\xee\x50\xd5\xf3\x47\xd0\x40\x77\x76\x54\x8a\xe7\x72\xfa\x01\xea\x3e\xdc\xd5\x5b\xf3\xbf\x7d\x61\x45\xc7\x66\xed\x02\x0b\x80\xae\x4b\x68\x71\x31\x20\x17\x5c\xb4\xd0\x32\x76\xb6\x43\xe7\x73\x18\xd9\xac\xb5\x18\xd9\xbe\x42\xeb\xda\x57\x64\x03\xfb\x86\xad\x59\xbb\x3f\xc0\x22\x43\xe6\x64\xf6\xb1\x31\xb1\x07\xf1\x16\xb2\x4c\x6c\xa1\xe8\x01\x1d\xfe\xff\xff\x7f\xc9\x2c\x8b\xd1\x4b\x32\x9f\x64\xe3\x27\xe8\x60\x3c\x46\xb3\xec\x7a\x94\xcf\x11\xaf\x9b\x3c\x69\x88\xa8\x0b\x92\x64\xf3\x7c\x96\x45\x77\x80\x42\x38\x49\x20\xba\x77\x36\x41\xf3\xe9\xdd\x2c\x26\xf0\x26\xca\x26\xe1\xec\x81\x0a\xe2\x9b\xb9\xc1\x83\x7b\xce\xe0\xef\xf4\x2e\xa7\x6c\x91\xa5\x59\x0c\x2b\xa8\x81\xc2\x19\x41\xb7\x64\x76\x93\xe5\x39\x49\xd0\xed\x6c\xfa\x31\x4b\x48\xc2\x22\x98\x52\xf9\x96\x4e\xc7\xe3\xe9\xa7\x6c\x72\x8d\xe2\xe9\x24\xc9\x98\xec\xa3\x95\x6e\x48\xbe\xd7\x40\x6e\xa7\x81\xde\x1c\x4e\x44\x19\x5e\xf1\x34\x21\xe8\xe6\x6e\x9e\xd3\x29\x1b\x66\x13\x00\x1e\x46\xd3\x8f\xf4\xa7\xdb\x07\xe8\x36\x9a\x4c\xf3\x2c\x26\x06\x0b\x54\x3e\xce\xe6\x70\xa4\x2a\xb6\x3b\x49\x1a\x48\x25\xd9\x3c\x1e\x87\xd9\x0d\x99\x3d\x51\xe1\x92\x4d\x44\xc2\x14\xb8\xdc\xce\xa6\xc9\x5d\x4c\xd6\x8e\x0e\xe2\x5d\x4c\xa6\xf1\x5d\x19\x4e\x95\xd6\xd8\x9d\xce\x78\xa8\xd5\x9b\x30\x27\xb3\x2c\x1c\xcf\x2b\xb2\xc3\x58\x41\x35\x01\xf5\x26\x0f\x5c\x9d\x9c\x5e\xa2\xcb\xf3\xe7\x57\x3f\x1f\x5c\x1c\xa3\xd3\x4b\xf4\xfa\xe2\xfc\x9f\xa7\x47\xc7\x47\xe8\xd9\xbf\xd0\xd5\xc9\x31\x3a\x3c\x7f\xfd\xaf\x8b\xd3\x9f\x4e\xae\xd0\xc9\xf9\xd9\xd1\xf1\xc5\x25\x3a\x78\x75\x84\x0e\xcf\x5f\x5d\x5d\x9c\x3e\x7b\x73\x75\x7e\x71\x89\xfe\xd7\xc1\x25\x3a\xbd\xfc\x5f\xf0\xc3\xc1\xab\x7f\xa1\xe3\x5f\x5e\x5f\x1c\x5f\x5e\xa2\xf3\x0b\x74\xfa\xf2\xf5\xd9\xe9\xf1\x11\xfa\xf9\xe0\xe2\xe2\xe0\xd5\xd5\xe9\xf1\xa5\x81\x4e\x5f\x1d\x9e\xbd\x39\x3a\x7d\xf5\x93\x81\x9e\xbd\xb9\x42\xaf\xce\xaf\xd0\xd9\xe9\xcb\xd3\xab\xe3\x23\x74\x75\x6e\x40\xa3\x8b\xd5\xd0\xf9\x73\xf4\xf2\xf8\xe2\xf0\xe4\xe0\xd5\xd5\xc1\xb3\xd3\xb3\xd3\xab\x7f\x41\x7b\xcf\x4f\xaf\x5e\xd1\xb6\x9e\x9f\x5f\xa0\x03\xf4\xfa\xe0\xe2\xea\xf4\xf0\xcd\xd9\xc1\x05\x7a\xfd\xe6\xe2\xf5\xf9\xe5\x31\xa2\xdd\x3a\x3a\xbd\x3c\x3c\x3b\x38\x7d\x79\x7c\xf4\x04\x9d\xbe\x42\xaf\xce\xd1\xf1\x3f\x8f\x5f\x5d\xa1\xcb\x93\x83\xb3\xb3\xd6\x5e\x52\xdc\x6b\x7d\x7c\x76\x8c\xce\x4e\x0f\x9e\x9d\x1d\xb3\x96\x5e\xfd\x0b\x1d\x9d\x5e\x1c\x1f\x5e\xd1\xee\x54\xdf\x0e\x4f\x8f\x8e\x5f\x5d\x1d\x9c\x19\xe8\xf2\xf5\xf1\xe1\x29\xfd\x72\xfc\xcb\xf1\xcb\xd7\x67\x07\x17\xff\x32\x38\xcc\xcb\xe3\xff\xf7\xe6\xf8\xd5\xd5\xe9\xc1\x19\x3a\x3a\x78\x79\xf0\xd3\xf1\x25\x1a\x28\x48\xf2\xfa\xe2\xfc\xf0\xcd\xc5\xf1\x4b\x8a\xf3\xf9\x73\x74\xf9\xe6\xd9\xe5\xd5\xe9\xd5\x9b\xab\x63\xf4\xd3\xf9\xf9\x11\x10\xfa\xf2\xf8\xe2\x9f\xa7\x87\xc7\x97\xff\x40\x67\xe7\x97\x40\xad\x37\x97\xc7\x06\x3a\x3a\xb8\x3a\x80\x86\x5f\x5f\x9c\x3f\x3f\xbd\xba\xfc\x07\xfd\xfe\xec\xcd\xe5\x29\x10\xed\xf4\xd5\xd5\xf1\xc5\xc5\x9b\xd7\x57\xa7\xe7\xaf\xb6\xd0\xc9\xf9\xcf\xc7\xff\x3c\xbe\x40\x87\x07\x6f\x2e\x8f\x8f\x80\xba\xe7\xaf\xa0\xab\x57\x27\xc7\xe7\x17\xff\xa2\x40\x29\x0d\x80\xf8\x06\xfa\xf9\xe4\xf8\xea\xe4\xf8\x82\x12\x14\x28\x75\x40\x49\x70\x79\x75\x71\x7a\x78\x25\x16\x3b\xbf\x40\x57\xe7\x17\x57\x42\x1f\xd1\xab\xe3\x9f\xce\x4e\x7f\x3a\x7e\x75\x78\x4c\x7f\x3d\xa7\x50\x7e\x3e\xbd\x3c\xde\x42\x07\x17\xa7\x97\xb4\xc0\x29\x6b\xf6\xe7\x83\x7f\xa1\xf3\x37\xd0\x65\x3a\x46\x6f\x2e\x8f\xd9\x57\x81\x63\x0d\x18\x49\x74\xfa\x1c\x1d\x1c\xfd\xf3\x94\xa2\xcd\x0b\xbf\x3e\xbf\xbc\x3c\xe5\x7c\x02\x24\x3b\x3c\xe1\xe4\x5e\xcc\x70\xdc\x75\x78\xf0\x32\xcc\x47\x5f\xee\x00\xa1\x77\xee\x4b\x96\x05\xb1\x2c\xcd\x1e\x57\xb8\xd8\x09\x2e\x34\xe1\x24\x9f\xa3\x3c\x8c\x5a\x74\x4a\x0a\xe8\xfd\xef\xe3\xd6\xbc\x4e\xad\x6a\xb3\x69\x20\x84\x0d\x84\x2c\x03\x21\xdb\x40\xc8\x31\x10\x72\x0d\x84\x3c\x03\x21\xdf\x40\x28\x30\x10\x1a\x1a\x08\x9b\x06\xc2\xd8\x40\xd8\x32\x10\xb6\x0d\x84\x1d\x03\x61\xb7\xfd\x06\x92\xcf\xc0\xd0\x62\x14\x34\xad\x4a\xc1\x61\x97\x35\x41\x41\x40\xb3\x43\xde\x94\xc5\xc1\x61\x68\xae\x15\xa4\xcd\x31\x70\x38\x86\x43\x0e\x2e\x10\xb0\xf7\x39\x58\x8f\x63\x88\x19\x78\xdc\x91\x8e\x11\x73\x30\x05\x86\x26\x03\x07\xd8\x15\xd8\xdb\x0c\x2c\x6d\x0a\x8b\x84\xe9\x00\xe9\x70\x30\x2e\xef\x9c\xcf\xc1\x59\x02\xf6\x98\x83\x0d\x38\x86\x9c\x30\xd8\x7e\xd7\xa2\x61\xb3\xd1\x9c\xe9\x8f\x66\x81\xa8\x2f\x90\xd8\xe2\x2d\x15\x9d\xf2\xea\xb4\x83\xce\xdb\xdd\x74\xf2\x78\x75\xbb\x02\x0b\x60\xdc\xaa\x53\x05\xb8\x82\x76\xd0\x2c\xee\xa6\x13\x94\x77\x05\x62\xf8\x1c\x6d\xaf\x1a\x93\x12\x9e\x25\x8c\x0f\xeb\x42\x2b\xcc\x80\x57\x17\x68\x0c\x43\xeb\x56\x63\x52\x82\xb3\x85\xf1\x61\x5d\x68\x47\xd3\xe2\x63\x63\x0a\x70\x0b\xca\xf9\x25\x2b\x94\xf3\x01\x71\x4a\xb2\x2e\x74\x8e\xe7\xbc\xc7\xec\x84\x2e\x70\x0a\x03\xfa\x62\xf3\x05\xf7\xda\xc2\x28\x78\xd5\x6f\x5d\x73\xc9\x37\xa0\x14\x10\xb8\x98\x27\xc3\x8a\xd1\x0b\xae\xc5\xae\x30\x18\x3e\x2f\xdb\x35\xa0\xb8\xe2\xad\x72\x30\x87\xbc\x4e\xc1\x93\x81\x50\xa6\xe8\x8e\x0f\xdf\xbb\x61\x5a\x42\xf7\x9d\x0a\xa7\x52\x2a\x89\x93\x32\xa8\xc1\x6e\x85\x59\x88\x0d\xb7\x9a\x7c\x25\x19\xac\x0a\xad\x92\xa2\x9c\x0f\x59\x13\xa8\x7b\x44\x7b\xcc\x50\x8e\x79\x6d\xf0\xdc\x0a\x2d\xa0\x88\x5f\xc9\x07\x40\xc3\xe1\xe8\x79\xdd\xbd\xb2\xeb\x83\x57\x12\x08\x57\x20\x4b\x82\x97\x22\x07\x7e\xef\x02\x59\x0a\x3e\x2c\xd0\xd6\xab\xb0\x29\x85\x00\x97\x2b\xd8\x56\x8c\xa7\x5b\xb1\xaf\xd8\xb3\x02\x66\x39\xf9\x2d\xe1\xaf\x5b\x0a\x97\x4e\x62\xba\x2d\x55\x9c\xfa\xd8\x96\x6c\x51\xf5\x1a\x61\x8c\x5a\xb3\x1b\xc2\x80\x8e\xe8\x14\x6d\x19\x51\x54\xa6\x46\x34\x0d\x64\xde\xbb\x07\x81\xe5\x0f\x87\x43\xfa\xdd\x3b\x3e\x1a\x1e\x3f\x3b\xc0\xf4\x7b\xf0\x1c\x3f\x7b\x76\x78\x74\x48\xbf\x1f\x0c\x5d\xfb\xf9\x91\x73\xdc\xc9\x3c\xa3\x59\x67\x5b\xae\x79\x60\x05\xcf\x8e\x3d\x68\xeb\xd0\x39\x3a\xc2\x96\x03\x6d\x1d\xf9\xa6\x7d\xfc\xdc\xa6\xdf\xfd\x03\xef\xc8\xf7\x8e\x01\x87\x02\xb7\xd6\x8e\x75\xb9\x28\x9c\xbe\x3e\x7e\x79\x84\x3d\x13\x72\x13\x2f\x65\x08\x2e\x21\x54\xa6\x60\xe1\x15\x53\x79\xd6\xeb\xa5\xc0\x0e\x33\x00\xe1\x8e\x0c\x96\x9e\xef\xb8\x96\x6d\xc2\x70\x1c\x3f\x3f\x3c\x3a\x78\x16\x00\x89\x86\xc1\xb3\x83\xa3\xc3\xe7\xc7\xf4\x3b\x36\x6d\xcb\x75\x7c\x20\xef\xa1\x7d\x64\x1d\xe3\xe7\x66\xdb\x30\xa1\x35\x1c\x99\xaa\x4e\xb4\x96\x09\xed\x80\xf5\x4e\xf8\x7b\xdd\xc3\x65\x18\xc3\x41\x52\x75\xe1\x46\xed\x01\xf0\xf2\xbd\x50\xb1\x38\x06\x7e\x2f\xf5\x07\x47\x9a\x67\xf1\x02\x34\xb4\x8f\x06\xd2\xb2\x88\x5d\x4e\x12\xb0\xa9\x5c\x33\x85\x97\xab\x5c\x7c\xaa\xc3\xe6\xd7\x9f\x1a\xb0\x17\x2f\x41\x49\x21\xf7\x36\x75\xa3\x1e\x97\x06\x4f\x50\x79\x7c\x4c\xe7\x8b\x8e\xef\x0d\x48\xbf\xf7\xa3\xb1\x56\xd9\x19\x94\x9d\xe9\x94\x85\x6d\xc9\xfb\xdf\xb5\xe0\x82\xd2\xfb\xfe\x77\x2d\xb8\xa0\x50\xbd\x9f\x6b\xc1\x85\xa5\xfa\xfd\x7c\xa6\x4c\x52\xb9\xbb\x4b\xe5\xca\x87\x6c\x72\x4d\xeb\x65\x74\xb3\x25\x77\x88\x0a\xc7\x06\x8a\xc6\x06\x8a\xc7\x06\x4a\xc6\x06\x22\x1d\xce\xf1\x65\xf9\x99\x81\xa2\x99\x81\xe2\x99\x81\x92\x99\x81\xe8\xb6\xb0\xb3\x42\x48\xd1\x0e\x69\x3f\x4f\xa4\x37\xde\xa3\x19\x64\x2d\x85\x72\x58\x52\x2e\xa6\xe5\x62\x56\xce\x92\x94\x4b\x68\xb9\x84\x95\xb3\x25\xe5\x60\x8b\x4b\x58\x39\x47\x52\x8e\x59\xb3\x8b\xf4\x84\x52\xfa\x48\x3c\x65\x5a\xef\xab\xc0\xdf\xed\x7d\x84\x55\xf2\x30\xa7\x62\x24\x1c\xa3\xed\x4a\xb6\x6c\xff\x3e\x7e\x9b\xbd\x7b\xb7\xf5\x87\xe2\x5e\x35\x04\x3e\x78\x8a\xbd\x2d\xf5\x75\xe4\x9c\xe2\x82\x52\x3c\x88\xc6\x46\x3c\x36\x92\xf1\x16\xda\x46\xa3\xb1\x32\x5e\xc1\x9f\x95\x11\x3e\x7b\x6a\x5b\x3a\x37\x9f\x79\x53\x56\xb3\x29\x19\x03\x2c\x34\xe5\x04\x3d\x9a\xb2\x9b\x4d\xc9\x78\x68\xa1\x29\xcf\xe9\xd1\x94\xd3\x6c\x4a\xc6\x86\x42\x53\xff\xb3\xbb\xcb\x9b\x0b\xcc\x1e\xcd\xb9\xcd\xe6\x64\xdc\x8c\x94\xd9\x5e\x29\xaf\xe5\x2a\xae\xa2\x85\xd0\x6c\x9a\x8f\x07\xb9\x31\xa7\x8c\xa8\xb8\xb7\x0e\x0c\x9c\x6f\x93\xb1\x92\x5f\x41\x62\xc8\x04\x11\x82\xf3\x15\x98\xe3\xf2\x42\x20\x03\x00\x47\x2a\xdf\xb0\x2a\x88\x03\x88\x96\x48\x01\x13\xe4\x94\xcc\xbf\x06\x95\xb3\x75\x86\xb6\x85\xc9\x3a\x7b\xa4\xc9\xea\x0e\xa2\x99\x11\xcf\x8c\x64\x06\x83\x3f\x7b\xc4\xc9\xea\x34\x9b\x7a\xd4\xc9\x5a\x6f\xea\xf1\x26\xab\xd5\x6c\xea\x71\x27\x2b\x6e\x36\xf7\xb9\x27\xeb\x0c\x9c\xd6\xf4\x66\xeb\x4c\x3d\x5b\x61\x11\x6d\xb1\x0e\x8b\x1f\x58\x69\x13\x45\x21\x58\xb6\xd9\x6c\x9d\xe9\xcc\x56\xd0\x1a\x14\x30\x41\xb5\x90\xba\xaf\x76\xfd\x02\x6e\xd9\x42\x8e\x6f\xd8\xb7\xc1\xad\xbf\xee\x0d\x1e\xfd\x67\x1f\x0d\x4e\xd8\x7d\xbc\x98\xae\xd6\x89\x9c\x82\x27\xfc\x0a\xdf\x09\xbb\x73\x97\xd0\x2a\x0a\xa2\x9f\xf0\x6b\x7a\x27\xec\xf6\x1c\xa1\x55\x42\x55\x15\x9b\x57\x81\x1b\x72\xa0\x46\x44\xaa\x2a\x0e\xaf\x02\x97\x18\x23\x5a\x25\x56\x55\x81\x7b\x8f\x5d\xe4\x96\x6c\x46\x9f\xf3\x9c\xf1\x6b\xf7\xf7\x4f\xc2\x3c\x2c\x77\x12\xf4\x41\xae\xdd\xd2\x12\x85\xcb\x3f\xfd\xae\xd4\xb7\xc1\xc9\xf4\x59\x96\xcf\xaf\xa6\x39\x2c\x5b\xac\xa5\xc9\x51\x98\x87\xec\x1a\xc0\x0f\x28\x90\xb7\x09\xd5\xcf\x48\x9a\x17\x6d\x96\x39\xcc\xa1\xaa\x8c\x06\x07\x49\x82\x6e\xc3\x24\x91\x5d\xec\x2d\xbb\xf4\xb6\x6a\x88\xee\xf6\xdc\x77\xe8\x8f\x7d\x64\xde\x07\xa6\xe0\xe8\x59\x96\xf8\x3b\xb2\x2d\xc9\xf4\xab\x80\x0e\x06\x83\xaa\xd6\x36\xa2\x72\x97\x42\x1f\x6e\x51\xa8\x0e\x15\x70\xd8\x51\xef\x80\x0b\x28\x8c\x8a\xd5\xee\x57\x78\xb9\xd4\xee\x77\x01\x2e\xdf\xf9\x36\xe0\xf6\xd8\xf9\x2a\x88\x22\x26\xa0\x1f\x94\x54\x7a\x32\x86\xb4\xfe\xcc\xb5\x58\x9e\x8f\x7e\x77\x17\x4c\x4f\x28\xa5\x33\x82\x85\xf8\x50\x39\xcf\xdf\x32\x4b\xce\x40\xe1\x1c\xa7\x37\x61\x40\xca\x89\x5b\x6f\x39\xf3\x9e\xa0\x7d\x24\x6e\xd0\x1f\xc3\x60\xe4\xf6\xb5\x17\x29\xed\x3e\x27\x60\xf0\x39\x91\xdf\xfc\x47\x9a\x96\x9e\x93\x32\x5a\xcd\x49\xcd\x72\x73\xb2\xa2\xc9\xe6\xa4\x66\xab\x39\xe9\x34\xd2\x2c\x7b\x35\xe3\x82\xb9\xa8\x31\x46\xe3\xf9\xd3\x13\x18\xcc\xce\x5a\xdc\xab\xad\x9b\x2d\xba\x44\x7c\x3c\x9e\x4e\xb4\x85\x3b\xb8\x11\xd2\x0a\x95\x19\x16\x1e\xb5\xbc\x2c\x59\xc9\xf7\x0b\x6c\xcc\x40\x48\xe7\x08\xef\x1d\x14\xec\x72\x76\x5f\x78\xb3\xe0\xf9\x87\xc4\x1b\x1a\x29\x1e\xdc\x1b\xe8\xc1\x40\xbf\x2b\x52\xda\x0f\x06\xf7\x10\x85\xe9\x01\xfe\xfd\x7d\xab\x0d\xcf\x96\xe1\xac\x1a\xb2\xb4\x1b\x1a\xdc\x6f\x7d\x37\x78\xd8\x62\x41\xf4\xfe\x4d\x1f\x7e\xdf\x6a\xfa\xd7\x29\x9b\xb3\xfb\x34\x47\x5b\xfa\x37\x6d\xb2\xea\x5c\xaf\xc6\x9c\x5e\x8d\x7d\x07\x4d\x40\xef\x1e\xb6\xbe\x1b\xfc\x1b\xba\xd7\xb7\x49\xb7\xe7\xb8\xd1\x81\xfb\xa3\x6a\xab\x57\x63\xa0\xf1\xde\x1b\x13\x55\x53\xf7\x4f\x9f\x4e\xa0\x5f\xf7\x3f\xfe\xf8\xe3\xc0\xb6\x76\x26\x1d\xdd\x5a\x78\xa7\xeb\x3d\x5c\x38\x0f\x8f\x60\xd6\xad\xe2\x3c\x7c\x1b\xce\xc2\x1b\xf4\x3f\xe5\x19\xc7\x1f\xf3\x7c\x96\x4d\xae\xff\x44\xdc\xf3\x17\x7c\xda\x8b\xef\xf9\x94\xad\x21\xba\xc0\x39\x41\x2a\xe8\x7f\x02\xb8\x5e\x30\xe6\x79\x98\x67\xb1\x6e\xe9\xe5\x7c\xa1\xb9\x10\xaa\x9c\x80\x8b\xf3\xa5\xc1\xf7\xbc\xeb\xdf\xcb\xfd\x9e\xbb\x01\x7c\x2a\xba\xae\xef\xe0\xbc\x78\xb8\xd5\x70\x6e\x2e\x0a\xac\xe2\x7f\x5e\x70\xd0\xc9\xcb\x83\xc3\xaf\x81\x7f\x24\x90\x3e\x90\x07\x80\x32\x27\xf1\x8c\xe4\x70\x59\x77\x45\x06\xa4\x9d\xfe\xba\x18\xf0\x26\x8c\x45\xfe\x39\xb9\x09\xe3\x8a\x87\x44\x27\x7c\x6d\x36\xaa\x81\x58\x64\xa5\x9b\x30\x6e\x61\x27\x11\xc4\x9f\xcc\x17\x4e\xed\x31\x5f\xd4\x97\xf8\xcd\x73\x27\xf9\x3f\xdf\x19\xae\xbd\xf1\x7c\xdf\x78\xbe\x7f\xe5\x71\x1c\xbe\xb8\x1b\x66\x23\xbf\x46\x3b\x92\x3d\x73\x64\x5c\x9e\x1c\xec\xe0\xa5\xbd\x30\x2e\x4f\x0e\x70\xe5\x80\xc1\x9f\x1e\xd9\xf7\x62\x1f\xa2\x78\x55\xee\x17\xd9\x24\xcb\x3b\xdc\x9e\x8a\x4f\xdd\x33\x83\xa4\x71\x12\x46\xc1\x50\x1e\xc9\xde\xbc\x1f\x06\x51\x98\xc4\x29\xa9\x79\x6d\x28\xea\xc4\x76\x62\x11\x9c\x2e\x5e\x05\x2f\x3e\x8f\xe5\xea\xd1\xd5\x9e\xfe\x89\x7d\xdb\x81\xbd\x0c\x6c\xcf\x83\x6a\xe5\x29\x32\x2d\x15\x29\xcf\x90\x61\x77\xa9\x3c\x41\x06\x63\xa0\xf2\xfc\x98\x96\x22\xe5\xe9\xb1\xac\xaf\x3a\xc7\xc7\x9d\xe7\xc3\x6a\xe3\x07\x9c\x42\x20\x16\x17\x4c\x7d\x0e\xf1\x33\xb3\x58\xd4\x83\xd3\x76\x06\x0f\x2b\x3e\x7f\x76\x09\xf6\xe6\xa7\xb8\x78\xff\xf3\xdb\x0c\xed\x20\xfb\x1d\xfa\x95\x7f\x0d\xaa\xaf\xd8\x11\xbe\x7b\x8a\xf3\x0f\x01\xe7\xc1\x04\xc2\x9f\x31\x23\x1e\x18\x44\x6c\xac\x8c\x97\xad\xb4\x07\xc1\x61\xc7\x20\xa4\xa0\x5d\x00\x1d\x32\xcb\x8d\x0f\xd1\xd0\x08\xda\x86\xe6\x35\xce\xed\xd0\x53\x64\xe9\x1f\x05\x0d\x06\x11\xfa\x0e\xc5\x6c\x7f\x4c\xbf\x26\xd0\x9e\x79\xef\x86\xcc\x43\x4f\xff\xc0\x0b\x3d\x45\x4e\x8f\x86\x23\xf4\x2b\x8a\xd1\xaf\x28\x61\xed\x79\x24\x19\x92\x28\x54\x84\x5d\x6f\xb4\xe7\x2d\xd7\x51\xd6\x4f\xfa\x2d\xe6\x3d\xde\x41\xe6\xbd\x6f\x12\xc7\xb1\x2d\x47\x0b\x83\xdd\x1f\x4a\x24\x02\x73\x0b\xfd\xb0\xbb\x4c\xbf\x69\xab\xb6\x3b\x4c\x6c\x62\x49\x0e\x05\x90\x9a\x81\xa8\x00\x68\x89\x38\x20\x7e\xa8\x24\x89\x15\x87\x59\x08\xd0\x7b\xfa\x14\xd9\x26\xa7\x13\xb0\xa0\x82\xb9\xa9\xbc\x53\xa0\x1f\xae\x12\xbf\xa5\xef\x09\x18\x3f\x02\x2a\x4e\x8d\x42\x49\x50\x42\x54\x3b\xfd\x82\x03\x33\x59\xac\x63\x54\x3b\xf9\x82\xc3\xb2\x58\x59\xdc\x16\x0f\xca\x12\x65\x71\x47\x3c\x24\x23\x92\xe2\x9b\xa3\xac\xee\xea\xff\xf1\x47\x59\x74\xff\xfa\x24\x1d\x4f\xa7\x33\xf1\x1c\x69\x17\x94\x3c\xfe\x59\x57\x7b\x10\x6e\xb4\x6a\x44\xff\xec\x69\xe1\xe8\xe9\x4b\x9d\x3a\x2d\x7f\xca\xa0\x3a\x82\xfa\x6b\x9e\x35\x34\x5f\x6e\x4c\xbb\x0b\x30\xbe\x84\x69\x97\xee\x5c\x97\xb5\xea\x42\xdd\xfe\x06\xdd\xfa\x66\xb9\x6e\xcb\xa5\xbf\x6d\xcc\xb8\x2a\xec\xbe\x65\x33\x2e\x30\xcd\xd2\x16\xdc\x76\xde\xa9\x8c\xb7\x9c\x7f\xc4\x8a\x3a\x51\x4e\x68\x35\x3d\x73\xad\xf3\x57\x8a\x06\x3c\x1f\x85\x96\xeb\x7d\x55\xa6\x5c\x88\xd1\xcb\xf1\xda\x98\x75\x3f\xb3\x59\x77\xc1\x56\xdb\x56\xe8\xf2\xe4\xc0\x72\xbd\x9a\x6d\xb4\x3d\xc8\xa0\xc4\x2e\x6b\x59\xce\x2a\x96\x59\x5a\xbd\xd6\x3e\x3c\x33\x44\xbe\x2e\xeb\x6c\x8c\x4d\x77\x48\x92\xc0\xa0\x9b\x7a\xcf\x8f\x13\xd7\xf4\xe1\xbb\xe9\x9b\x49\x82\xe1\x7b\xea\x9b\xc4\x1d\xda\x4a\xab\x6d\x9a\xc6\xa6\x19\xd9\x60\xe9\xf5\x02\x37\xc0\x2e\x66\xdf\x9d\x74\x18\xa4\x21\xc0\x8a\x48\x1a\x3a\x69\xe8\xac\xd3\x4a\xdb\x77\xe3\x28\xa8\x12\x7c\x48\x04\x20\x7a\x21\xd3\x60\xb9\x2d\x77\x0d\x3b\xfb\xd2\x3d\x82\xda\xf9\x67\xa3\xb1\x7e\x5b\x1a\xab\x65\x39\x2b\xe8\xac\xb4\xf6\x52\x5a\x6b\x4d\x8c\x2c\xe8\xad\x96\xd5\x27\x38\xe4\x46\x73\xfd\x16\x35\x57\xca\x3a\xab\xe8\xae\xad\x1c\x54\xd3\x5e\x19\x17\x89\x95\x35\xf5\x57\xcb\x92\x05\xea\x13\xa2\xf2\x71\xe5\x69\xcf\x75\xa9\x3e\xeb\x6e\xdc\x0f\x36\xee\x07\x5a\x7a\xea\x5f\x3c\x12\xd4\x29\xcb\xb6\x9f\xfd\x5e\x06\x9e\x43\xb3\xe9\xdd\x24\x41\x71\x3d\x44\x54\x3b\x0d\x4e\x3a\xf2\x1e\xd0\xdf\x5e\x74\x3a\x2e\x14\xc7\xaf\xa4\x6a\x64\xa1\x90\x62\x27\x81\x44\x87\xcd\x6c\xfe\x7a\x96\xdd\x90\x41\xa7\xc7\x66\x81\xd2\xfc\xbf\x67\xf9\xab\xc2\x62\x4c\x1f\x06\x13\x89\x39\xb1\x3c\xff\x65\x6c\x88\xf6\x91\xf5\x8f\xe2\xfb\xd3\x7d\x06\xac\x78\xa1\x77\x24\xfc\xb7\xc1\x04\xfd\x9d\xd7\xd8\xd2\x39\xad\xe3\x02\x31\x0d\xc7\xf3\x0e\xb3\x64\xf1\x91\x65\x21\x56\xa9\x90\xf9\xec\xae\xd3\xe8\x29\xa7\xfc\x35\xc9\x9f\xcf\x42\xf8\x1e\x8e\x9f\x65\xf9\x5c\x3e\x06\xa5\x93\xee\x04\xed\xa0\xc1\x04\x92\xc9\x6d\xa1\x1f\x6a\x96\x7a\xc9\x21\x4f\x3b\x32\xc5\xf1\x76\x47\xf2\x69\xf8\x19\xf8\xa3\x3b\x3e\xfd\xa7\x51\x36\x26\x68\xc0\x8b\x3d\x45\xf2\xdb\x8a\x70\xd6\x59\xb2\x9c\xce\xb8\x97\x80\xb5\x2e\x5c\x9e\xbc\x65\xe5\x21\x41\xde\x02\x81\x81\x77\x6f\xa7\x9f\x06\x13\x03\x61\xb4\x8b\xac\x36\xa7\x63\xf1\x23\xbf\xad\xf8\xa2\x57\x63\x76\xab\x33\xb5\xf8\x61\xd0\xb6\xb7\x97\x3a\xe0\x9c\x74\xd5\x6b\xd9\xca\x0c\x5a\x51\x79\x44\xa7\x2a\xba\xfb\x5f\x65\xf3\xbe\x68\x3c\xf8\x42\xae\x55\x27\x4f\xe6\xe3\x2c\x26\x03\xb3\x8b\x73\x36\xae\x4b\x5f\xda\x75\x49\x56\x2a\x85\x52\xae\xa2\xd4\x35\x94\x92\x79\xf2\xc0\x7e\x00\x4a\xf9\x8f\xe4\x2c\xe5\xe9\x65\x22\xff\xea\x9c\xa5\xae\xc3\x9b\x9b\xd0\xbc\x2f\x5d\xa6\xb0\x8c\xd8\x8b\x15\xe1\xca\xf1\xa0\x00\xf2\xf4\x29\xb2\x98\x13\x53\xf1\xe6\xc7\x1f\x7f\x44\xfe\xd6\x16\x42\xbf\x2a\x81\x22\xb8\x7a\x26\x80\xc2\xce\x02\x28\x1c\x6c\x6d\xe9\x82\x12\xeb\xd9\x2a\x51\x5e\xeb\x15\xae\xc8\xa1\xba\xf8\x5f\xaf\x27\x50\x03\xb3\x2e\x08\xd4\xc0\xf7\x45\x4a\x5b\xed\x2e\xd4\x40\xd9\x8b\xa0\x86\x3d\xa9\x51\xd4\x53\xa6\x98\x47\x15\xf3\xf1\x71\xde\xe6\x04\x81\x24\x9e\xbc\xb7\xdb\xba\x4e\x74\x1a\x6e\x70\x31\xc4\x25\x1b\x10\xf4\x1d\x4a\xe1\x56\xd3\xbf\xe9\xd7\xd6\x18\xfa\x8d\x9a\x37\x21\x64\xc4\x09\xd1\x77\x28\x82\x9a\x21\xf3\xf7\xfa\x15\x71\xcf\x2f\x45\x5f\x41\x59\xce\xae\x69\x27\x4b\x4f\x3c\xee\x07\xc5\x5d\xf1\xd8\xdd\x31\xf6\x0b\x1e\x0a\xbf\x60\xbb\xf6\x53\xad\x92\x52\x57\x29\xdb\xc5\xd0\x2e\x81\xd9\xc3\x72\xab\x12\x00\xe1\x71\xe0\xec\x17\x2c\xfc\x82\xb1\xf8\x93\xcf\xee\x7c\xb2\x9f\x2c\x57\xa9\xb6\x80\xd7\x21\x6d\x74\x84\xb6\x0b\x0c\xb6\xe9\x00\x6c\xa3\x17\x2c\x15\x8f\xda\xe3\x10\x60\x58\x68\xbf\xa0\xdc\x36\x1d\x06\x45\xbb\x54\x00\x5f\xcb\xc1\x52\x49\x2e\x49\x4d\x4d\x3f\x74\x49\x50\xec\x0f\x20\xad\x1a\x64\xb1\xc7\x0a\xb7\x2b\xa4\xef\x20\x27\xc9\x35\x8d\xf4\x9d\xe1\x06\x39\xa5\x75\x6e\x29\xf0\xda\x38\xc6\x55\xc5\xb5\x1d\xe3\x58\x71\x97\x17\x87\x2c\xc9\xa9\xb2\xb8\xc7\x8b\x43\x16\xe4\x6b\x65\x71\x9f\x17\x07\x31\x38\xda\x78\xe9\x6d\xbc\xf4\x36\x5e\x7a\x8b\x9f\x8d\x97\x9e\xd8\xd2\xc6\x4b\x6f\x73\xe6\x79\xc9\xcd\x20\xab\x9c\x79\xba\xde\x92\x67\x9e\xa2\xf5\x65\xf1\xcc\xd3\xf5\x36\x67\x9e\x2a\xec\xbe\xf5\x33\x4f\xd7\x5b\xed\xcc\xb3\x8d\x83\xea\x67\x9e\xc0\x45\x62\x65\xdd\xbb\xd6\x0b\xbe\x45\x12\xcf\x3d\xef\xaf\xe4\xb9\x77\xef\x39\x5f\x69\x12\xfe\x12\xb3\xcd\xa9\xe8\xd7\x7e\x2a\x7a\xef\x81\x1b\xdd\x93\x7b\xaf\xe5\x7a\x1b\x2d\xf2\x8b\xe7\xf0\x7c\xc6\x50\xf8\x49\x7b\x0a\xdb\x65\x93\xed\xb4\xd3\xed\xe2\xe4\xfc\xfd\xf9\xf3\xe7\x97\xc7\x57\x5d\xb9\xe4\x69\xa9\xd7\xa7\xef\x4f\x5f\x1d\x1d\xff\x72\x7c\x89\x24\xa5\x2e\xce\xdf\xbc\x3a\x7a\x7f\x78\xfe\xea\xf2\xea\xe0\x55\x09\xaf\x63\xa6\xc2\x39\xec\xe1\x2a\xe7\xb0\x02\x9c\xd9\x68\x5a\x04\x7c\xef\x3e\xda\x2d\xd0\xbc\x47\xfb\x08\x1b\xe8\x41\x23\x6f\x74\xce\x8c\xca\x39\x7a\x8a\x2c\xe7\x1f\x28\x97\x1b\x95\x05\x5a\xbe\xbd\x47\xdb\xc8\x45\x3f\xa0\x07\x16\x13\x2c\x2f\x62\xbe\xc1\x37\x6b\x0b\x0e\xd0\xd0\xdf\x91\x27\xd3\xf3\x61\x93\x46\x3e\xfd\x82\xf6\xd1\x03\xfa\x3b\x72\x15\xdb\x39\xf2\xe9\x5f\xb4\x2d\x0b\xfd\x80\x68\xeb\x36\x6d\x7d\x4b\x5e\xef\x9e\x9d\xd4\xfc\xd2\x5d\xe2\x81\x95\xf8\x57\xaf\xa3\x51\x61\x64\x6e\x33\x94\x41\x96\x7c\xf9\xb8\x94\x14\xbf\x67\x14\xbf\x67\xf1\xde\xee\xe5\x04\x2f\x6b\xb1\xb1\x44\x0f\xac\xd6\x83\xda\xf6\x5f\x31\x74\x7d\xa4\x1e\xd0\x36\x1a\xb4\x90\x90\x0e\x9d\x84\x8e\x5d\x39\x5d\x95\x7c\x5b\x77\x79\xe8\x64\xd9\xb3\xe7\x97\x17\xb4\x8b\xf7\x66\xc7\xdd\xdc\xb6\x63\x10\x4b\x7d\x0c\x42\x6b\x00\x0e\xc5\x44\x7c\x39\xff\xd4\x3d\x2d\x5a\x6b\x9c\x15\x35\xd4\xa3\xc4\x72\xfa\xa3\xdf\xd0\x53\xe4\xff\x03\xfd\xa6\x77\x42\x03\x5d\x87\xb0\x79\xca\xd0\xe8\x05\x7e\x51\x96\xbf\x9e\xce\x21\x09\x22\x9d\x10\x98\xee\xd0\x7f\xdb\x42\x3b\x48\x71\xb1\xb9\x68\x52\xac\xff\x14\xe9\x05\x46\xa6\x9f\x05\xba\xfc\xba\x8f\xa0\x71\x01\xa0\x1a\x83\xfa\xe5\x66\x11\x97\x1f\xf7\x01\x19\xad\x7b\xce\x0b\xf8\xbc\x14\xf0\xa9\x41\xdd\x91\x5b\x5c\x4a\xb4\xa4\x25\x14\xa7\x0a\x02\xcb\x4f\xc8\x7d\x0e\xfc\xac\x3f\xf2\x7a\x51\x95\x77\x77\xd1\xeb\x59\x76\x93\xe5\xd9\x47\x82\x6e\xa7\xe3\x87\xc9\xf4\x26\x0b\xc7\x68\xfa\x91\xcc\xd0\x4f\xcf\x07\xd6\xd6\x1e\xba\xff\x35\x40\xdb\xe8\xfe\x57\x0f\xfe\x75\xe1\x5f\x87\x8a\x66\x25\x74\x3e\x03\x19\x52\x2c\xf4\xc0\xaf\xc8\xbc\xf7\xf5\xee\xca\xab\xb1\xe7\x70\xf7\x55\x3c\xba\x94\xbf\x4d\x63\x61\x66\x87\x49\x5c\xdb\x28\xb2\xce\x34\x99\xc5\x58\x60\xe7\xce\xcc\xff\x0b\x6f\x74\x7d\x24\xe6\x20\x1b\x72\x72\x73\x3b\x9d\x85\xb3\x07\x66\x3a\x6f\xd7\x53\xae\x3a\xf4\x0e\x1d\x7f\xad\x36\xd9\xa8\x0e\x26\x7a\xd5\x4a\xa6\x95\x89\xd0\xed\xe8\x61\xaf\xe2\xe6\x61\xd7\x9c\x3c\x6c\x4d\x17\x8f\x2f\x90\xc9\x7e\x7a\x97\xdf\xde\xe5\x67\x60\xe7\xec\xaa\x86\x60\x47\x9f\x90\x79\x36\x23\x89\x90\xf2\x3e\xca\xf2\x79\x91\xb8\x96\xc1\xe9\xb2\x3d\x14\x70\xce\x27\xe3\x82\xad\x84\x34\xc2\xe1\x8c\xec\x21\xcb\x72\x0c\x64\xb9\x9e\x81\xec\xc0\x31\x90\x8b\x2d\x09\x1c\x9e\x53\x7f\x8f\x16\xeb\x28\x25\x49\xaa\x5f\xd8\x2a\x75\xf2\xea\x8b\xf4\xe9\x6e\x6e\x6d\xf9\xf5\xe1\xe4\x33\x0f\x73\x52\xda\x44\x8b\xa7\xb7\xef\xd4\x2b\x7a\xbf\x19\x45\x3f\x00\x9d\xcd\xac\x09\xf9\x54\xce\x2e\x70\x10\x5a\x2e\x84\x2d\x60\x0d\x46\xf0\xcb\xec\x77\x38\x6e\xc4\x9e\x69\xa2\x1d\x44\xb5\xb8\x32\xbf\xbf\x48\x56\xaa\x7d\xdb\x1d\x6e\x7b\x9f\xc1\x07\x49\x7e\x70\xd4\x32\x18\xaa\x63\x9c\xa2\xef\x67\xe1\x04\x8e\x13\x1a\x14\xd9\x45\x96\xe2\x34\x27\x9a\x4f\x67\x51\xaf\xd1\x6e\x34\xba\xfe\x4c\x5e\x2f\xad\x0c\xd5\xdd\x6d\xe8\x70\xea\x1c\x85\xbf\xb4\x32\xdc\x52\x93\xae\xf2\x9a\x61\x9e\x55\xc1\xa9\xe9\x87\xe2\xa7\x9b\xcf\xcb\xaa\x25\xf2\xb2\x56\xcc\xe0\x65\xd5\x52\x77\x59\xeb\xce\xd9\xc5\x3b\x87\x7b\xf4\x0e\xd7\xbb\x87\x57\xed\x1f\xae\x77\x10\x2f\xd9\x43\xd5\x50\x33\xb6\x2f\x0d\xd9\xd9\x24\x9f\xb2\xd9\xa7\x64\xb1\x71\x08\x47\x50\x85\x2c\x93\x13\x93\x16\x7e\x32\xca\xae\x47\x54\xfd\xa6\xdd\xd1\x28\x3e\x9e\x7e\x42\xbc\xf8\xb2\x8e\x09\x17\x54\x7b\xeb\x9e\x67\xe5\xa4\x66\x5b\x50\x98\xd8\xec\x2b\xdb\x38\xc2\x77\xad\x39\x7d\x35\x22\x79\x28\x2d\xb4\xdc\xe6\x5e\x68\xe2\x65\x76\x8f\xe2\xe9\xf8\xee\x66\x02\xe4\x91\x4b\x0f\x54\xb8\xc4\xf0\xbd\xac\x81\xaa\x3d\xaa\xaa\xde\xf2\x06\x05\xb1\xe5\x1a\x7b\x54\xe6\x05\x75\xf3\xf4\x53\x6c\xd4\x4a\xb6\xd1\xac\x76\x26\x54\x1b\x4f\x3f\xe9\x6c\xe8\x74\x48\x7f\xa5\x52\xce\x9b\x1f\x50\xd6\xe9\x40\x5f\xbd\xbd\xd7\xe8\xf2\xd5\x3d\x9b\x1b\xfb\xd0\x71\xad\xf2\x30\x39\xf6\xa1\xc7\xab\x78\xa0\xaf\xcc\x95\x7a\x8b\x19\x2a\x69\xe2\x00\x51\x06\x94\x21\x1c\x30\x2a\x69\xfa\x33\x5e\xdd\xe3\xaa\x26\xee\x59\x93\x4d\x82\xab\x7b\xac\xc9\x4b\xbc\xda\x59\x59\x0d\x78\x49\x77\x92\xce\xef\x66\x20\x38\xb2\xc9\x35\x9f\xb0\xbd\xe6\xea\xd5\xbd\xc3\x25\x25\x1a\x0c\x38\xf2\x65\x98\x3f\x8e\x15\x8f\xf5\xa7\x61\xb1\x00\xc0\x67\x25\x60\x26\x53\x19\xe0\xb3\x06\xe0\x97\xbd\x00\x7f\x05\x52\xa2\xb6\xa8\xe8\xcd\x1c\xd4\x5c\x5c\xd4\x13\x08\xad\xc1\xee\x73\x31\x9a\xa2\xd7\x99\xde\x4c\xa4\xe8\x9d\x82\xb5\x78\x1f\xe1\x7f\x08\x8f\x6c\x7b\x51\x3e\x3f\xd2\x14\xad\x8d\x48\xd9\x98\xe6\x5c\xa3\xe5\x19\x17\xf7\x90\xdc\x45\xc5\xb3\xaa\xa2\x96\xec\x86\x85\x7b\x34\x3d\x67\x1a\xee\x7e\xed\xe4\x41\x44\x5c\x87\x46\x17\x53\xd8\x71\xe8\x2d\xac\x59\x8a\x06\x55\xc3\x7d\x8c\xa3\xc2\x34\x1f\x14\xb4\x7a\xfa\xb4\xea\x05\x4c\xc5\x82\x16\x74\x2e\x0e\x6c\x0b\xed\x08\xbf\x6b\x4c\x4c\x54\x9f\xf5\x25\xb8\xd6\x76\x5e\x2e\xdf\x4e\xdd\x44\x5b\xd1\xa3\xaf\x81\xb6\x49\x13\x8e\xab\x00\x11\x8c\xb3\x8b\x28\x7b\xce\x3a\x48\xf3\x52\xd1\xdc\xd9\xf2\xcd\x69\x2a\x17\xb3\x70\x32\xbf\x9d\xce\x75\xb9\x0f\x16\xa7\xd7\xd9\x19\x9b\xa7\x57\x6f\x85\x53\x9c\x8a\xeb\x75\x94\x0e\x06\xa3\xa7\xe6\xc1\x2b\xf5\x50\x3f\x74\x64\xe3\x6d\x86\xc2\x1c\x74\x10\x58\x4d\x94\x7b\x8f\x2b\x13\xba\xae\xca\xed\x58\x9a\x12\xcc\x52\xa2\xa9\xaa\x5c\x99\x05\x41\x58\x45\x0d\x19\x76\x65\x16\xe4\xe0\x55\xd4\xda\xc2\xee\x2e\x3a\x1c\x69\xae\x07\x4b\x69\x66\xab\xaf\xce\xbd\x16\x0e\x24\x88\xf1\x62\xed\x2a\x57\x73\xfd\x79\xb9\xf4\xda\x53\x00\xb8\x2a\x67\xc5\x52\xb5\xef\x71\x59\x7f\x20\x28\x9a\x5b\x3d\xf5\x12\x06\xcb\x6a\xc0\xb2\x16\x60\xe9\x8e\x03\x55\x2a\x67\xd3\x4f\x7a\xc3\x30\x16\xe6\xf4\x55\x35\xc1\x7f\x45\x83\x7f\xf3\xfe\xb1\x17\xdf\x15\x28\xc2\xa3\xa6\xf0\x1c\x0b\x73\xff\xaa\x12\x04\x22\x70\x78\x51\x01\x1f\x4f\xbb\x8e\x4c\xc4\xcf\xaa\xfa\xd5\xe9\x54\xb1\xfb\x5e\xe0\x2e\x1d\xd1\x51\x3b\xfb\xa1\x0a\x46\xe3\x04\x09\x7e\xef\x63\xf8\xa8\x01\xd4\x90\x2c\x35\x4d\xb5\x5e\x99\xca\x98\xde\x47\xf1\xdf\xee\x15\x00\x59\x9d\xc7\xbd\x01\x20\xab\x5a\x9a\x97\x29\x88\x45\x9b\xf3\x0f\x60\x60\x97\x1a\x9d\xd7\x70\x85\x00\xaf\x78\x83\x00\xee\x06\xc4\x24\x1b\xd7\x7d\xfb\xf1\x16\xda\xad\x77\x70\x0b\xfd\xd0\x7c\x01\x78\x80\x53\x41\x79\x9f\xe1\x1b\x74\xf8\x7f\xa4\x33\x09\xf1\xb4\xa5\xe8\x73\xeb\x51\x0c\xda\x55\x31\x9a\x58\xba\x38\xe0\x58\x04\xbf\xab\xba\xb1\x72\xf9\xdf\x77\x84\xfc\xde\x6d\xda\x2d\x1c\xbd\x8b\x19\xd8\x76\xd6\x5c\x7c\xda\x0e\x44\x16\xd0\x5c\xff\x91\x48\x2f\xab\xf3\x52\x5b\xd2\xae\xed\xe8\xba\x0e\x4d\x2a\x84\xb4\x8e\x16\x84\x3d\x0a\x3f\x5d\x10\x37\x41\xcb\x1e\x30\x08\x50\xf9\x19\x83\x08\x75\xbd\x07\x29\x15\x31\xb5\x3b\x7c\xb6\xd0\xe1\xb3\x35\x74\xf8\x6c\xa1\xc3\x67\x4b\x77\x58\xc9\x0d\x6c\xaa\x71\xa9\x91\x4f\xd1\x8c\xe4\xb3\x8c\x7c\x24\xf2\x8b\x44\x88\x07\x22\x64\x72\xf1\xf6\x6e\x3e\x2a\xf0\x54\x10\xb9\xa5\xd2\x4b\x69\xa5\xc7\xca\x87\xda\x12\xf3\xa2\xc4\xcd\x58\x14\x5a\x3d\xa3\x60\xac\xff\xa6\x94\x96\xcc\xe7\xd7\xa6\x5a\x96\x80\x32\x9c\x47\x3f\x49\xd9\xdf\x51\xa0\xf8\x5a\xdd\xd4\xea\xaa\xa4\x8e\x39\xb4\xb9\xc3\xd5\x00\xfe\x0d\xdf\xe1\xb2\x57\xb8\xc1\x65\x2f\x77\x7f\xcb\x96\xdc\xde\x6a\x0d\x6c\xb1\xb9\xbb\x25\xc2\xfc\xc6\xef\x6e\xd9\x2b\xdd\xdc\x6a\xe1\x9d\xda\xbd\x2d\x7b\xf9\x5b\x5b\xb6\x5e\xac\xca\xf2\xb2\xd0\x9e\x67\xfe\xf9\xce\x70\xfd\xbf\xe6\x1d\xae\x66\x50\x76\x17\x5b\x5f\xf1\xc5\xae\x22\x44\x3b\xc5\xf2\x3f\xe3\x92\xd7\xa3\x5d\xf0\x5a\xe3\x0d\x2b\x5e\xb2\xba\x0d\x56\x14\x5f\x43\x3c\x77\x17\x5b\x35\x6f\x5d\x17\xb7\xd9\x4a\x64\x9e\xc2\xc1\x4a\xf1\xdc\x69\xf5\x9a\xb7\x70\xc0\xc3\xe8\xba\xd8\x7a\xe4\x90\x70\x22\x59\x75\x42\xba\x2f\xf8\x88\x9a\xf7\x71\x14\x45\xc3\xc4\x4d\x0c\x21\xde\xfb\x96\xd1\x56\xd2\xb3\x86\xa1\x35\xb4\x42\x31\x1a\xfc\x96\x3c\xec\x7b\x0b\x94\x21\x76\x87\x26\x76\x43\x31\x8e\x7c\x7b\x7b\xd8\xb5\x52\x12\xb3\xe8\xf3\x45\x94\xf9\xfe\xed\x79\xbe\x6d\x5b\x9e\xc7\x62\xd5\xf3\x18\xf4\xed\xed\x05\x24\x72\x9c\x30\xf0\xc5\x08\xf5\xfd\xdb\x4b\x22\x33\xb6\x88\x99\x88\xb1\xed\xdb\xdb\x73\xfc\xc8\x75\x02\x9c\x88\x91\xef\xbb\x77\x8b\x9f\x3d\xf4\x3d\xe5\xde\xd5\x43\xdf\xe3\xb6\xdc\x0a\xc5\x67\x13\xfb\xfe\x3f\x6e\x0f\x11\xac\x12\xfb\x9e\xd6\x5e\x6e\x1f\x21\x8a\xdc\xc5\x9d\x44\xb0\x89\x7d\xaf\xc4\xee\x5b\xdf\x4b\x04\xab\xc5\xbe\x6f\xe5\xa0\xfa\x7e\x22\x58\x32\xf6\xbd\x1d\xe8\xc7\xbe\xa7\x5a\xe9\x9e\x1b\xb4\xed\x2e\x82\xbf\xe6\xee\xe2\xab\xdd\x48\x6c\x36\x0f\xab\x45\x87\xf8\x52\xc1\x1e\xd6\xb2\x15\x59\x1c\xab\x82\xa2\x1c\xfc\xfb\xe2\x82\x63\x87\xbe\xc5\xe5\x44\xfd\x3a\xe4\x93\xf0\xf6\x76\xfc\x30\xe0\x2f\x0d\x14\xce\xae\xef\x6e\xc8\x24\x6f\xb3\x6d\xb7\xd8\x66\xc5\x20\x15\xad\xdd\x80\x58\xfe\xad\xe8\x34\xb0\x36\xef\x1d\x2b\x08\xad\x74\x08\xca\x77\xe2\x5b\x41\x48\x2c\x6b\xcb\x58\x2c\xe7\x63\xdb\x77\x9c\x21\xa4\x72\xb2\x6c\x92\x7a\x6e\x9c\x74\x28\xcd\x0b\x75\x23\x37\x36\xd3\x28\x4e\x21\xf9\x7f\xec\x24\x76\x64\xa5\x6d\x6d\x90\x61\xe4\x26\x51\xe8\xd2\x72\x01\x0e\x86\x49\x14\xc5\xba\x6d\xd8\x43\xd7\x8b\x2d\x37\x02\xf5\xdf\x76\x82\xc8\xb5\x83\xb6\x36\xdc\x61\x8a\x31\x4e\xa1\x1f\x91\x67\xba\x89\x89\xbb\x36\x1b\x0b\x75\x87\x96\x9d\x06\x56\xe8\xd0\xba\x61\x8a\x87\x4e\x3a\x8c\xda\xda\x08\x23\x1c\xbb\x24\x81\x7e\x24\xa1\x97\x04\x18\x07\xba\x6d\x24\x81\xe9\x87\x21\x1b\x8f\xd0\x36\x6d\xd3\x72\x5a\xc7\x03\x5b\x81\xed\x46\x26\xf4\xc3\x71\x7d\xd3\x4b\x23\xa2\xdb\x86\xe5\xd8\x38\x70\x23\x02\x75\x09\x71\x22\x2b\x88\x5b\x69\xe5\x9a\xb1\x9f\xc4\x36\xf4\xc3\x4d\xd3\xc8\x21\x96\x6e\x1b\xbe\x15\x11\x37\xf1\x81\x56\xa9\xe5\x47\xc1\xd0\x6b\x1d\xf3\xc0\x4c\x48\x84\x53\xc0\xc5\x8e\xb0\x37\xf4\xa2\xae\xcd\xd8\xe2\x78\x44\x49\x6c\x7a\x2c\xa5\x98\xe5\xc6\x3e\xb6\x6c\xb7\xad\x8d\x18\x0f\xa3\x14\x33\x5c\xe2\xd4\x1b\x5a\xde\xd0\xd1\x6d\x83\x38\xc3\xc8\x1b\xc6\x40\xe7\x21\x49\xb1\x13\x26\xad\xe3\x41\xd2\x88\x38\x7e\xe0\x41\x3f\x02\x27\xb5\x5c\x62\xeb\xb6\x61\xa6\x31\x1e\x26\x31\xd4\x0d\xa2\x20\x4e\xdc\xa8\xb5\x1f\x96\x63\xc6\x21\x8e\x63\x5a\xce\xf7\xc3\x78\x18\x7b\xae\xf6\x98\x27\x64\x68\xc5\x1e\xcc\x41\x77\x68\x45\xa6\xe5\xb7\xb6\xe1\x84\xbe\x13\x38\x21\x6c\xdb\x3d\x12\x7a\xc4\x09\xb4\xfb\xe1\xc6\x91\x19\x0e\x13\xc0\x2f\x4a\x1c\x9c\x46\x89\xd3\x2a\x4b\xbc\x74\x18\x04\x09\xb4\x11\xd8\x18\xbb\x76\xa4\xdd\x8f\x61\x60\x13\x17\xbb\x16\xc8\x12\xe2\x79\x49\x1a\xb6\xcf\xc1\xc0\xc6\xb1\xe7\xc1\xa6\xdb\x4a\x22\xc7\xb6\xb0\xa9\x2d\xaf\x4c\xd3\xb6\xfc\x18\xe6\xe0\x30\x48\x23\x0b\xdb\xad\xbc\x1b\xa5\xee\xd0\x4f\x63\x9e\xd2\x8e\xa4\x26\x21\xda\x7c\x15\x7b\xc4\x34\xa3\x14\xe6\x96\x9d\x84\x41\x90\xc6\xad\x7c\x95\xb8\xa1\x3f\xc4\x0e\xb4\x31\xb4\xcd\x30\xf4\x2d\x6d\x5a\x99\x5e\x1c\x7a\xb6\x0b\xbc\x4b\x4c\xd3\x0e\xac\xf6\x39\x88\x1d\x6b\x68\x0d\x99\xe9\xc4\x0c\x4d\xe2\x11\x5f\x9b\x56\x96\x1f\xf9\x66\x18\x80\xac\x73\xbc\xc4\xb2\xd2\xb4\x55\x96\x58\x04\x53\x3a\x02\x4d\xdd\xd8\xf2\xe2\xa1\xe5\xe9\xb6\xe1\x24\x56\xec\x25\x29\xf0\x95\x1b\xc6\x8e\x15\x92\xa4\x55\x5e\xd9\x76\x60\x26\x18\x68\x3a\x4c\x86\x6e\x64\x27\xa9\x6e\x1b\x9e\x6b\x86\xbe\xed\x3a\x6c\x0e\x86\xa9\x67\x27\xa4\x9d\x77\xbd\xd0\x0c\x23\x58\x67\xec\xd8\xf7\x23\x2b\xd4\x96\xed\x01\x8e\xad\x78\x68\x31\xb9\xeb\x93\x24\x24\xc4\x6b\x6b\x63\x68\xf9\x96\x15\x33\x9a\x62\x27\xb0\x6c\xd7\x8e\x74\xdb\x08\xad\x28\x25\x41\xc8\xd6\x85\x38\xc5\xa6\xed\xb5\xce\xc1\x30\xc0\xa1\xe7\x39\xd0\x8f\x28\x76\x2c\xdb\x34\xb5\xe5\x6e\x6c\x39\x51\x10\xf9\x26\xac\x0b\x66\x1a\x0c\xfd\x21\x6e\x95\xbb\xbe\x17\xbb\x38\x84\xf1\x30\x3d\xd7\x89\x88\xad\xcd\x57\x09\x1e\x5a\x24\xc0\x43\x68\xc3\x23\xa9\x6b\xe1\xd6\xf5\x3c\xf1\x86\x43\xd3\xb3\x60\xdc\x5c\xd7\x73\xc3\xa1\xfe\x3c\x4f\x1d\x93\xd8\x2e\xa3\xb3\xeb\xfb\xd8\x32\xad\xb0\x75\x7e\x98\x5e\x18\x9a\xac\xbf\xb6\x15\x45\x09\x8e\xb4\xc7\x1c\x0f\x43\x27\xc6\x18\x64\x7b\x14\x24\x56\x62\xc6\xad\xfd\xc0\xc4\xf6\xbd\xd8\x64\xf3\x03\x3b\x38\x8c\x5c\x6d\xb9\x6b\xf9\x4e\xe0\xfb\x0e\xcc\x8f\x24\x0d\x08\x89\x86\xc3\xb6\x36\x6c\x27\x32\xa3\x38\x82\xfe\x12\x3c\x8c\x9c\x40\x9f\x77\xed\x21\x8e\xcd\x38\x82\xb1\x8c\xdd\x78\xe8\x86\x9e\xdd\xba\x7e\x90\x24\x08\x43\x07\x64\x3b\xb1\x1d\x1c\x84\xb1\x36\xef\xba\xd1\x30\x8e\x43\x27\x65\xeb\x9b\x67\x13\xdb\x6f\x6d\xc3\x0b\x2c\xe2\xa5\x4c\x76\x26\x5e\x64\x45\x41\xa8\x4d\x2b\xdf\x09\xd2\xc0\x22\x30\x07\xdd\x84\xa4\x91\xd5\x2e\xaf\xfc\x20\x74\x3d\x9b\xad\x97\x8e\x8d\x7d\x2b\xf5\xb4\xf9\x2a\x70\xe2\xc0\x0f\x30\xd3\x13\x71\x6a\x86\x91\xdf\x2a\xdb\x83\x38\xf6\x4d\x8b\x8d\x39\x0e\x3d\xc7\x1e\x12\x6d\x7d\x77\x68\x46\x24\x4d\xd3\x90\xe9\xe3\x9e\x8d\x89\xd5\xca\x57\xa1\xe3\x9a\x5e\x4c\x60\x9e\x27\x24\xb0\xa2\x84\x68\xeb\xbb\x11\x49\x87\xa1\x9d\xb2\xf5\xcd\x8a\x3d\x7f\x88\xdb\xf5\x2b\xcf\xc7\x7e\x90\xb2\x35\xd9\xf6\x2d\xd7\xb6\xb4\xc7\x3c\x0e\x2d\xdf\x26\x31\x8c\x07\x09\x2d\xcf\xc3\xc3\xd6\xf1\x48\x70\xe0\x45\x01\x5b\x6b\x2d\xca\x8a\x56\xe7\xa1\xc4\xa2\x6e\x16\x26\xa1\x9f\x24\x30\x07\xe3\x84\x98\x24\xc2\xad\xb2\x3d\x75\xfd\xc4\x49\xfd\x94\xeb\x16\x24\xc1\xbe\xf6\xfc\x30\xbd\xd4\xf4\x7c\xa6\x37\xf9\x16\xf6\xbd\x34\x6a\x95\x25\x66\xe8\xd9\x7e\x12\xc3\x1c\x0c\xad\x38\x18\x06\xa1\xf6\x3a\x88\xb1\x9d\x0e\x03\xd3\xe1\x07\x09\x43\x33\x09\x5b\xfb\x81\x23\x1f\x9b\x91\xcd\xd6\x0f\x1b\xc7\x8e\x8f\xb5\xc7\xc3\x0a\x92\xc8\xf7\x53\x97\xf1\x95\xe9\xf8\x49\xd0\xba\x7e\xd8\x56\x1c\x86\x91\x0f\x7c\xe5\x98\xb1\x6f\x39\x43\xed\x39\x68\xc7\x43\x12\x11\x13\x68\x85\xdd\x78\x18\x91\xa8\x75\xcc\x1d\x1b\x27\x9e\x1f\x43\x7f\x87\x31\x36\xcd\xc4\xd1\x9e\x1f\x4e\x1c\xbb\x89\xc3\xf6\x38\x71\x64\x13\xc7\x8a\x5a\xd7\x5a\xaa\xc1\x59\xc3\x21\xc8\xce\x34\xf6\x5c\x9f\xd0\x35\x40\x53\x5e\xa5\x71\xe4\xa5\x21\xd3\x05\xc2\xc4\x4b\x43\xd2\xda\x0f\x2f\x76\x1c\x3c\x0c\xa0\x0d\x27\x74\x7c\x37\xc0\xfe\xa2\x15\xa8\x23\xe6\x63\x33\xf6\x53\xeb\x86\xff\xe7\xf5\x86\x79\x0a\x4c\xa5\xaf\xd9\xcf\xb5\x30\x4f\xef\x1f\x3f\xcc\x93\x8b\xad\x55\x0e\x6f\x5b\x0e\x8f\x3f\x57\x3e\xaf\x35\x1c\xde\x7a\xa1\x39\x24\xc5\xe1\xa6\x1d\xc5\xf1\xd0\xec\x38\xbc\x8d\x22\xcf\x0f\x09\xd3\x69\x02\x27\x0e\x43\xbf\x53\x77\x94\xb4\x67\xc7\x1e\x49\x6d\x1f\x24\x7a\x4a\x86\x4e\x1a\x50\x89\xde\x56\x32\x74\x9d\x34\x75\x6d\x98\xcb\x6e\x8a\x13\xdb\x4b\x97\x38\x4c\x75\xb1\x49\x5c\x8b\x49\xde\x30\x21\x5e\x60\x25\x1d\x87\xb7\xc3\xc8\x74\xbd\x80\xcd\x25\x2b\xb2\x89\x17\xe3\x2e\x1d\x5f\xd2\x1e\x4e\x03\x3b\x19\xb2\x99\x9b\x46\x0e\x8e\x12\xaf\xa3\x7f\x6e\x44\xcc\x38\x61\x7a\x28\xb6\x7d\x62\x61\x7f\xb8\xd6\xc3\xdb\xcf\x13\x1e\xa9\x67\x8e\x36\xa8\x62\x6a\xe5\x5d\x3b\xc1\x5a\x89\xd7\x4e\x2c\xad\xcc\x6b\x27\xb6\x56\xea\xb5\x13\x47\x2b\xf7\xda\x89\xab\x95\x7c\xed\xc4\xd3\xca\xbe\x76\xe2\xab\xd3\xaf\x31\xc2\x41\x9e\x36\xd5\x45\x50\x56\x74\xcc\x8a\x4a\xaf\xaf\x33\x32\x03\x50\x55\x3c\x0a\x56\x74\xcc\x8a\xaa\x81\x5a\x00\xd4\xd2\x01\x6a\x8d\x59\x51\x35\x50\x1b\x80\xda\x3a\x40\xed\x31\x2b\xaa\x06\xea\x00\x50\x47\x07\xa8\x33\x66\x45\xd5\x40\x5d\x00\xea\xea\x00\x75\xc7\xac\xa8\x1a\xa8\x07\x40\x3d\x1d\xa0\xde\x98\x15\x55\x03\xf5\x01\xa8\xaf\x03\xd4\x1f\xb3\xa2\xf2\x2b\x28\xfd\xb3\x30\x32\xa6\x56\xb4\x1e\x32\x7e\x96\x24\x71\x81\x5b\x70\x8c\x97\x15\xb0\x22\xc6\xc6\x0a\x58\x31\x63\x61\x05\xac\x98\x71\xaf\x02\x56\xc2\x38\x57\x01\x2b\x61\x4c\xab\x80\x45\x18\xc3\x2a\x60\x11\xc6\xab\x0a\x58\x29\xe3\x53\x05\xac\x94\xb1\xa8\x02\xd6\x35\x63\x4f\x05\xac\x6b\xc6\x99\x0a\x58\x23\xc6\x95\x0a\x58\x23\xc6\x90\x63\x45\x0e\x1d\xcd\xc0\x5b\xfd\xd4\x54\xa4\x99\xc7\xb4\xc0\xf5\xe7\x8c\xe5\x4d\xd4\x08\x79\x77\x0c\x4a\x63\xe1\xc8\x21\x2d\xdd\x2f\x65\x26\xc3\x83\x92\xf6\xe7\xac\xb8\x97\x2d\xe6\xcf\x44\x3f\x20\xeb\x1d\x94\x54\x26\x86\xab\xc0\x8d\x19\x38\x7e\x13\xbb\x09\x0e\xa2\xfc\xad\x31\x2d\xe7\xee\x2e\xfa\x09\x92\x2e\x6a\x61\x57\xa4\xb9\x5c\x36\x83\xe7\xfd\xa8\x4c\xf2\x78\xdf\x23\x82\x0c\xaf\x31\x16\x2a\x6b\x47\x91\x61\x15\x46\xb5\xdc\xa1\x23\x96\xd8\x50\xcc\xf8\x39\x86\x4c\x8c\x45\xaa\xc3\x5a\xb9\x60\xa1\x1c\x5c\x54\xfb\x15\xd5\x8b\xf9\x9a\x11\x9b\x58\xa5\x71\x0d\xa1\xf1\x22\x42\xa3\x36\x84\xc6\x8b\x08\x8d\x44\x84\xea\xe5\xfc\xc5\x72\xea\x84\x8d\x48\x60\x0a\x75\xd4\xe8\x8f\x42\x1a\xd3\x25\xf3\x98\x56\x3c\x81\x7b\xf3\x04\xae\x78\x02\xf7\xe5\x09\x3c\xaa\x65\x50\x1d\x15\x59\x4e\x85\xc4\xa7\x63\x9e\x0b\x55\xa0\x2d\xe6\x03\x53\x2f\x06\xb7\x17\x87\x02\x53\x14\xf0\x9a\x89\x6a\xe4\x18\x8d\x6b\x18\x8d\x5b\x30\x1a\xb5\x61\x34\x5e\xc0\x68\x54\xc3\xa8\x0e\xd0\x5b\x80\x67\x79\xba\x5c\xd1\x2b\x4d\xab\xa6\xbc\xf3\x2b\xc6\xf1\x35\x19\xe7\xe7\xcc\x67\x42\x57\xa5\x66\x35\x2a\x8d\x79\x25\xbd\x90\x6f\xac\x12\xf6\x04\x69\xa7\xca\x3d\x2b\x56\x63\x18\x62\x95\x7a\xd9\xac\x36\x2e\xaa\xf5\x41\xb2\x92\x8c\x63\x3a\x02\x99\x2f\x51\x07\xea\x35\x2b\x81\x3c\x62\x35\x47\x90\x20\x82\x82\xa4\xcc\x62\x6e\xa1\xa7\x85\x08\x29\xdf\xfc\x6f\x84\xd1\x1e\x92\x5d\x8b\x5c\xc4\x8e\xfe\x5b\x30\x49\x2f\xe4\xe8\xbf\xdb\xe5\x8c\xed\xc0\x0d\xaf\x01\x37\x20\x7e\x7f\xcc\xd8\x50\x2f\xe2\xc5\xc6\x72\x01\x2b\x25\xfc\x4a\x93\xf8\x39\xd3\x60\x9b\x4a\x53\xf8\x39\x53\x60\xaf\x95\x18\x99\x67\x46\x1e\xa1\xef\x50\x3a\xe2\xb9\x91\xe9\x83\x32\x8c\x0c\xab\xce\x24\x18\x19\xd3\xea\x63\x5e\x9d\x3e\x5c\x8f\xf5\x92\x2b\x8f\x20\xbb\x32\x6d\x30\x62\xad\xc3\xf7\x98\x7d\x8f\xf8\x77\x2d\x48\x63\x80\x44\xdb\x8e\x18\x22\xf0\x3d\x66\xdf\x23\xfe\x5d\x3b\x5b\xf3\x88\xa5\x6b\xe6\x72\x95\x2d\xc0\xe1\x98\x65\xc6\xdc\x62\x51\x17\xc3\x51\x91\xcc\x99\xff\x58\x4b\xe7\x3c\x12\xf2\x95\x87\xc5\xf2\xac\x9b\xb4\x19\x6c\x0f\x83\x12\x26\x6f\x7e\x54\x6f\x7e\x5c\x6b\x7e\x54\x6f\x7e\x2c\x36\x3f\xea\xd9\x3c\x66\xbd\x27\x7c\x89\x64\x57\xec\x09\x5b\x1c\x83\x22\x39\xf4\xa8\xc8\x99\x2e\xfc\xe8\x54\x3f\xd2\xe6\xed\xe2\x37\x96\x57\x54\xbb\x79\xd6\x7b\x5e\xaf\x68\x7e\x54\x6f\x7e\x5c\x6b\x7e\x54\x6f\x7e\x2c\x36\x3f\xaa\x9a\x57\x6d\x1c\xd4\x89\xab\x95\x3d\x78\x01\x51\xbe\x5f\x68\x85\xb3\x78\x01\x62\xe5\x45\xa6\x19\xc8\xe2\x05\x08\xaf\x17\x99\xc6\x4a\xf1\x11\xb2\x70\xd3\xe2\xa3\x71\xd9\x1d\x85\xb8\x60\x75\x28\x46\xa3\x8a\x04\x4c\xd2\xe5\x58\x94\x74\xa3\x9e\xc2\xb7\x42\x86\xfe\x4b\x69\xaa\x8d\x49\x0e\x08\xc4\xa3\x36\x34\xe2\x55\xf1\x78\xa1\x12\xa0\x4d\x3c\x5e\x64\x6d\x78\xbc\xc8\x56\xc4\x43\x29\xc8\x9b\x78\xfc\xdc\x8a\xc7\xcf\x6d\x78\x28\x39\xbe\x99\x66\x5d\x8d\x08\x98\x1b\x0b\x31\x05\x75\x74\xd0\x07\x7b\x66\x21\x5b\xb7\x99\xe8\x87\x3e\x58\x62\x1f\x0a\xb0\xbd\xfb\xf1\xe6\x36\x09\x73\x82\x3e\x69\x1b\xce\xe8\x07\x8c\x24\xaa\x99\x07\x36\x92\x6b\x45\x17\x61\xfd\x4e\x15\x90\xc0\x72\x93\x2a\x20\x81\x41\x89\x28\x20\x81\x3d\x89\x28\x20\x81\x01\x6b\x90\x8c\x21\x55\xfd\x58\x23\x57\x3d\xd8\xc5\x06\xc9\x08\x2a\xb0\xd1\x21\xe2\xe0\x24\x0b\xe3\xa2\x93\x00\x9f\x02\x8d\x15\xfd\x01\xbb\x5d\xac\xe8\x0f\x98\x13\x23\x05\x24\xb0\x26\x46\x0a\x48\x60\xe4\x0c\x15\x90\xc0\xc6\x29\xcb\x79\x4d\x3f\x60\x54\x1d\xb0\x69\x9c\x5b\x3a\x44\x06\x63\xed\x80\xd1\x97\x4e\x8a\xed\x6a\x9d\x67\x54\xce\x17\x75\xdb\xcf\x9b\xcf\x5f\x38\xf8\x00\xe5\xee\x04\xa6\x79\x28\x53\xe8\x4e\xca\x40\x9b\x83\x13\x98\xde\x21\xeb\xd8\x89\x29\xf6\x2c\x5c\xec\x98\x04\xa4\x70\x54\xc2\xd0\x00\x22\x47\x52\x34\x70\x85\x06\x10\x38\xe2\x68\xd4\xe4\x64\xd4\x0b\x0d\xe1\x70\x85\xa1\x61\xc1\x12\x26\x45\xc3\xaa\xd0\xb0\x46\x85\x12\x31\x80\xaa\xc2\xf2\xd5\x0b\x0d\xe1\x38\x86\xa1\x61\x53\x34\x12\x29\x1a\x76\x85\x86\x4d\x31\x48\x38\x1a\xb6\x62\x52\x4b\x40\x0a\x07\x38\x0c\x0d\x87\xa2\x41\xa4\x68\x38\x15\x1a\x0e\xc5\x80\x70\x34\x1c\x11\x0d\xd2\x0b\x0d\xe1\xc8\x87\xa1\xe1\x52\x34\x52\x29\x1a\x6e\x85\x86\x4b\x31\x48\x39\x1a\xae\x88\x46\xda\x0b\x0d\xe1\x90\x88\xa1\xe1\xc1\x9e\x57\x8a\x86\x57\xa1\x01\xdb\xc8\x6b\x8e\x86\x57\xdb\xe3\xf6\x42\x43\x38\x56\x62\x68\xf8\x14\x8d\x91\x14\x0d\xbf\x42\x03\x2c\x00\x5c\xc3\xa2\x55\x65\x8a\xde\xe3\xdf\x80\xfe\xdc\xc1\x28\xa5\x95\x1e\x37\x1a\xa5\x8c\x06\xeb\x08\x26\x19\x98\x2b\x46\x93\xac\xc7\x90\xa4\xdb\x50\xd8\x73\x51\xe6\x78\xca\xa2\xdf\xda\xe6\x3b\xb4\x8f\x20\xea\x64\x3a\x9e\x4e\x67\x03\x81\x62\xbb\xc8\xbc\xc7\x26\xff\xac\xaf\x45\x0c\x99\xb6\xca\x66\xe4\x70\xbf\xca\x10\x95\x87\xd3\xc9\x47\x32\x63\x99\xde\x50\x3e\x45\xb6\xb5\x13\x65\x39\xd5\x5d\x13\x14\xc2\x9d\xb8\x88\xa4\xd3\x19\xe1\x37\xd4\x64\x2c\x20\xdc\xe2\x16\x7c\x48\xf2\xe9\x2f\xb6\xa5\xc2\x62\xf9\xa8\x70\x92\x60\x01\x9f\x2d\xd2\x5b\x57\x65\x1e\xdc\x6d\x91\x26\x65\xa4\x35\x55\xef\x64\xf1\xd4\x3a\xba\x57\x06\x52\xdd\x43\xd8\xb4\x9c\x5d\x7b\x31\xc5\xdd\x26\x68\x82\x1e\x8c\x2f\x14\x34\xc1\xc5\xd6\x0a\x41\x13\x68\xed\xa5\x82\x26\xd4\x5c\x1d\x17\x82\x26\xb8\xd8\xda\x04\x4d\x50\x61\xf7\x8d\x07\x4d\xa0\xac\xb3\x4a\xd0\x84\x56\x0e\xaa\x05\x4d\x60\x5c\x24\x56\xd6\x0c\x9a\x50\x0f\xe2\xd4\x23\x08\xdb\xf0\xaf\x14\x26\x81\x4c\xe2\x9d\x28\x9c\x13\xcf\x69\xfc\x70\x93\xb8\xcd\xa2\x1f\x6f\x3f\x24\x69\xe3\x65\x9c\xdd\x8e\xc8\xec\x2b\x8d\xb4\x20\x74\x0e\x9e\x69\x9f\xd8\x0f\xac\x2b\xf0\x5d\xec\xc1\x26\x22\xc3\x6a\x11\x19\xea\x61\x11\xc6\x59\xa4\x0a\x8b\x00\xce\xc9\x87\x30\x02\x65\x15\xe1\x5d\x17\x22\xaa\x58\x0a\xbb\xbb\xe8\x35\x99\xdd\x80\x4a\x78\x38\x9a\x66\x31\x41\x58\x92\xd5\x9f\x02\x7d\x7d\x88\x3b\x63\x1b\xb8\xbe\x81\x9c\xa1\x81\x1c\x6c\x20\xdb\x36\x90\xe5\x1a\x08\xfb\x06\x1a\x1a\x08\xe1\x76\x17\x71\x37\x30\x90\x6b\x1a\xc8\xb1\x0c\x64\x3b\x06\xb2\x3c\x03\xe1\xc0\x40\xd8\x34\x90\xd5\x51\x65\x68\x20\x17\x1b\xc8\xb1\x0d\x64\xbb\x06\xb2\x7c\x03\xe1\xa1\x81\x30\x6d\xb5\xbd\x8a\x67\x1a\xc8\xb5\x0c\xe4\x38\x06\xb2\x3d\x03\x79\xb6\x81\x5c\xd7\x40\x8e\x6f\x20\x7b\xd8\x5e\xc7\xc6\x06\xb2\x6c\x03\x61\xd7\x40\xbe\x81\x90\x67\x19\xc8\x75\x0c\xe4\x40\xe2\xe5\x8e\x3a\x14\x6b\xcb\x40\xd8\x31\x90\x47\xeb\x60\x03\xb9\xb6\x81\x1c\xd7\x40\xb6\xdf\x5e\xc7\x1a\x1a\xc8\xc2\x06\xc2\x14\x27\x03\x21\x2b\x30\x90\x65\x1a\x08\x53\x7c\x17\x6a\x74\xdc\x51\x69\x8e\xa2\xa5\x1c\x45\xab\x73\x14\x29\xf2\x74\xd4\x28\x3d\x2d\xfa\xdd\x40\xc8\xed\xe8\x2f\xc7\x97\xd2\x88\xf6\x17\xfa\x61\x76\xf4\xd3\xe6\xc3\x44\xfb\x45\xcb\x7a\x06\xea\x20\x23\xf6\x18\xc9\xe9\xc8\x02\x29\xec\x4e\x66\xa0\xac\x46\x47\x96\x8e\x96\xed\xb3\x11\x75\xbb\xb0\xa5\x7c\x66\x72\xe6\x71\x19\x8b\x3a\x1d\x28\x50\x4e\xa1\xac\x6c\x53\x66\xf3\x18\x6f\xba\x1d\xdc\x45\x39\x82\xf2\x2f\xe5\x63\xca\x5d\x74\x44\x5b\xf6\x06\x1d\x43\x77\x78\x77\x73\x37\x0e\x21\xf9\x3f\xdd\x1e\xce\x47\x59\x9a\x2b\x46\xef\xd9\xe9\xd5\xfb\xcb\x93\xd3\xe7\x57\x97\x74\x10\xe9\xf8\x58\x06\x02\xa2\xd2\x41\x08\xe8\x6c\xe3\x0c\x04\x63\xc9\x67\x21\xe6\x8c\x66\xf1\x99\x09\xd4\x0d\x3a\xb0\xba\x7c\x76\xfe\x0b\x99\xa3\x70\x92\xf0\xec\xaa\xb7\xc0\x61\x2c\xa9\xba\x1c\x3b\x5a\xf5\xfd\xeb\x4e\xf6\xea\xde\x11\x9a\xf7\xe6\x1e\xd8\x33\x02\xcb\xec\x60\x22\x56\xac\x30\x37\xb0\xd2\xf2\xb2\x56\xad\x6c\x60\x9a\x1d\x8c\xc4\x4a\xdb\x42\x69\x69\x41\x47\x2c\x28\xc7\xc0\xad\x63\x60\xc9\x31\xf0\xea\x7d\x53\x94\xf6\x1b\x94\x90\x63\x12\xd4\x90\x96\x02\x1e\x36\xd1\x90\x02\x0e\xc5\xd2\x8a\xb2\x51\x73\x40\xa4\xa5\xe3\x06\x1e\xb2\xb2\x49\x93\x18\xd2\xd2\x44\x28\x2d\x45\x21\xad\x77\x4e\x0a\x34\xd0\x84\x89\x83\x3e\x3c\x61\x05\xfa\x18\xd8\x81\xfe\xdc\x70\x9a\x58\xc8\xf9\x38\xd0\x66\x79\x2f\xe8\x33\xe9\xfc\x40\x73\xd2\x05\x41\x1f\xa6\x1c\x06\xfa\x4c\x19\x36\x11\x96\xb3\x70\x13\x0f\x29\xd6\x71\xd0\x87\x2d\x93\x40\x9f\xe1\x49\xa0\x3d\x9f\xd3\x26\x12\x72\xf6\xd4\xe1\x1e\x2e\x85\xb1\x72\xd8\x2c\xa1\xa0\x9a\xcd\xec\x5a\x69\x15\xa6\x4e\x1d\xb6\x82\x60\xae\x58\x5a\xc5\xc3\x62\xf7\xe4\x45\xfd\x3a\xca\x7a\x32\x02\xeb\xcd\xfd\x61\xb3\x83\x3a\x82\x18\xeb\xf1\x5a\x54\x27\x87\x9c\x8b\x6b\xe4\xd0\x91\xc3\x58\x6f\x9a\x92\x46\x69\x0d\x51\x8c\x95\xf2\x35\xe8\x43\x62\x1c\xf4\x21\xb1\x15\xf4\xe1\x4f\x3b\xd0\xe6\x22\xa7\x01\x58\x43\x16\x6b\x8e\xb3\x17\x68\xce\x54\x3f\xd0\x67\x88\x20\xd0\x1f\xb7\x61\xd0\x67\x82\x84\x81\x36\xab\x45\xcd\x81\x96\x2b\x13\x4d\x34\xa4\xa5\x93\x40\x93\xd9\x48\xa0\x2f\x55\xd2\x26\xaf\x2d\xee\x13\x10\x3b\x82\x68\x7b\xad\xd4\x9d\x1d\xd3\x0c\x1c\xb3\x6b\x0b\x86\x44\xb9\xcd\x8b\xeb\x28\xcf\x25\x97\x69\xc8\xed\x02\x0b\x53\x81\x85\x53\xc7\x42\x55\xdc\xad\x43\x57\x21\xee\xd5\xa1\xab\x8a\xfb\x55\x71\x39\x1a\xe2\x2a\xaa\x02\x3a\x6c\xe2\x20\x07\x1d\x36\x09\xa2\xa5\x46\x73\x4c\xe4\xa0\xe3\xda\x30\xca\xcb\x26\x55\x59\x1d\xb1\xcd\xd0\x95\x83\x4c\x9b\x9c\xa1\xb3\x4b\xd1\x1d\x3a\x1c\xe8\xd3\xc1\x0a\x34\x87\xd9\x6e\xe2\xa0\xe0\xe5\xa0\xc1\x9c\x0a\x5e\x0e\x7a\x8d\xb4\x17\xe8\x4f\x42\x3f\xe8\x35\x09\x83\x40\x9f\x31\x86\x81\x1e\x63\x84\x81\xb6\x78\x89\x9a\xd8\x6a\x89\x6f\x5d\x91\x91\x04\xfa\x73\x95\x34\x47\x50\x4b\x8a\xeb\x30\xbf\x68\xab\x90\x17\xc4\xfd\x04\x28\xb6\xfa\xc9\x17\x6c\xf7\x13\xa1\xd8\xe9\x37\x6d\xb1\xab\x2b\x44\xb1\xd7\x43\x76\x61\x5f\x7f\xb9\x12\x77\xd5\x4a\xb8\xc3\x1e\x6b\x1b\x0e\x7b\x48\x50\x1c\xf5\x5b\xda\x70\xdc\x63\x51\xc1\x49\xbf\x95\x0d\x93\x7e\xcb\x0a\x4e\x9b\x5c\xa2\x62\xef\x1e\xf2\x17\xe3\x1e\xa2\x0c\x5b\xfd\xa4\x03\xb6\xf5\x05\x0f\x76\x7a\x2e\x32\x6e\x3f\x09\x8f\x3d\xdd\x95\x06\xfb\xfd\x16\x03\x1c\xf4\x93\x54\x78\xd8\x4f\x68\xe2\xb0\xdf\xd2\x87\xa3\x1e\x4b\x08\x8e\x35\xd7\x10\x9c\xf4\x10\xb0\xa4\xdf\x9a\x87\xd3\xc6\xba\xb0\x56\x05\x1c\x9b\x8e\x7a\x35\x50\xf6\xdf\xaa\x8d\x01\xd6\x51\xbc\x59\xe3\x8a\xe6\x1d\xa1\xa8\x29\x2f\xea\x36\xd8\x46\x5a\xd8\xab\x8d\x96\x02\x09\xbf\x56\xb8\x97\x32\xa8\xa5\x71\x57\x68\x68\xe8\xdb\x05\xd9\x34\x74\xed\x0a\x63\x69\xf7\xe2\xc6\xd8\x49\x0b\x27\xb5\x01\xd1\x50\xb8\x01\xae\x5a\xd9\xe6\x10\x95\x94\xd5\xa4\x15\x0e\x7a\x10\xc0\x0a\xb4\x78\xdc\x0e\x7a\xf0\xb8\x13\xf4\xe0\x46\x37\xd0\x26\xaa\x17\x68\x4f\x08\x5f\x28\x2a\x2d\x18\x04\x5a\x03\x35\x0c\x34\x07\x2a\x0c\x7a\xcc\x97\x28\xd0\x16\x06\x71\xa0\x3d\x01\x92\xa0\x07\x4f\x93\xa0\x87\x30\x48\x83\x1e\xf3\xbb\xa6\x21\xab\x99\x16\xeb\x4b\x3b\x6c\xe9\x4f\x44\x6c\xf7\x91\xce\xd8\xd1\x9d\x90\xd8\xed\x23\x45\xb1\xd7\x47\x40\x63\x5f\x73\xe1\x0d\xfa\x48\x2f\x3c\xec\x23\x9d\x71\xa8\x27\xc1\x70\xa4\x2f\x99\x71\xdc\x67\x31\xc1\xa2\xb8\x95\xe3\x40\xf4\x17\x55\x9c\xf6\x59\x1e\xb8\xc2\xac\x4b\x33\xac\x2b\x75\xb0\xd5\x4b\xa0\xdb\x7a\x42\x1a\x3b\xfa\x02\x12\xbb\x7d\x24\x3a\xf6\x74\xe5\x1f\xf6\xfb\xac\x3e\x38\xd0\x97\xfe\x78\xd8\x47\x54\xe1\x50\x5f\xb6\xe2\xa8\xcf\x82\x85\xe3\x3e\xf2\x15\x27\x7d\x16\x04\x4c\xf4\x96\x23\x9c\xd6\x97\x83\xb5\x6a\xc5\x81\xc9\x30\x55\x0a\xf7\x62\x67\x88\x4d\x47\xad\x20\x17\x14\x90\x17\xb5\x2b\xb8\x8e\x72\xbe\x39\x5a\xb3\xc2\xad\xd3\x5f\xa9\x19\x97\xfb\x5d\x05\xaa\xbe\x59\xdb\x79\x69\x69\x6f\x85\x4f\x87\x52\x2f\xae\x78\x51\xa9\x15\xb3\xa2\x4a\x8d\xb8\x1a\x2c\x0d\x1f\x8e\x6a\x04\xe4\x90\x13\x41\xde\xa9\xf5\xe1\x62\x9a\x2b\x35\xe2\x8a\x0b\xd5\x74\xd5\x65\x42\x1c\x68\xd1\xca\x0a\x7a\x74\xdf\x0e\x7a\x10\xd6\x09\xb4\xf9\xd0\x0d\x7a\x10\xc1\x0b\x74\x66\x81\x1f\xf4\x98\x58\x41\xa0\x39\x5a\xc3\x3a\xa2\x1a\xaa\xb1\x1e\x63\x47\x81\x26\x5f\xc5\x41\x8f\x99\x95\x04\xda\x33\x96\x04\xda\x22\x2b\x0d\x7a\x08\x0d\x6c\xea\x4b\x0d\x8c\xf5\x25\x17\xb6\xfa\x88\x2e\x6c\x6b\xea\x13\xba\xd3\x1b\xbb\x7d\xa4\x06\xf6\xcc\x1e\x6c\x83\xfd\x3e\x6b\x0d\x0e\xfa\x08\x10\x3c\xec\x23\x99\x71\xd8\x67\x75\xc2\x91\xfe\xb2\x87\xe3\x3e\x22\x1a\x27\xfa\x4b\x04\x26\x7a\x6b\x04\x4e\x75\x25\x34\xd3\x89\xb5\xa9\x80\xfb\xc8\x32\x6c\x69\x09\x33\x6c\xeb\xcb\x52\xec\xe8\xcf\x7a\xec\xf6\x11\x27\xd8\xeb\xb5\xfe\xf8\x7a\x0b\x10\x0e\x7a\xc9\x93\xa1\xae\xa8\xc6\x61\x9f\xc5\x0a\x47\x7d\xd6\x41\x1c\xeb\xca\x6b\x9c\xf4\x59\x31\x30\xd1\x5f\x32\x70\x5a\x17\xd9\x6b\x56\x89\x55\x42\xbd\xe8\xbc\x86\x8f\x46\xd9\x1f\x95\x22\xbc\x27\x78\x44\xab\xf4\x60\x5e\x16\x6c\xea\x2a\x6d\xb8\xdc\x6e\xc9\x8b\x7a\x05\x58\x8d\xb2\x7e\xd5\x2f\x79\x41\x91\x5d\x35\x14\xe1\xaa\x5b\x6a\x4d\xb8\x22\x97\x1c\x85\xa8\xea\x97\x12\xdd\xb8\x56\x56\xa1\x5e\xe8\x88\x2e\x22\xf2\x8a\xbc\xed\xb4\x46\x7f\xa5\x7d\x58\x13\x2a\x0e\xf4\x47\xd5\x0a\x4c\x0d\xf6\xb7\x03\x6d\xf6\x77\x02\x4d\xf6\x77\x03\x7d\x96\xf6\x02\xfd\x31\xf2\x03\xfd\x69\x15\x04\xfa\xfc\x37\x0c\x34\xc6\x3e\x0c\xf4\x19\x2f\x0a\xb4\xe7\x69\x1c\xe8\xf3\x7e\x12\xe8\xf3\x14\x09\xb4\xa7\x6a\x1a\x68\x4e\x7f\x6c\xea\x0a\x40\x8c\x4d\x1d\xe6\xc3\x56\x0f\x41\x89\xed\x1e\x22\x0d\x3b\xfa\xa2\x12\xbb\x3d\xe4\x04\xf6\x7a\xc8\x6b\xec\xeb\x0b\xcc\x52\xd5\xd2\x61\x30\x3c\xec\x21\x5e\x70\xa8\xbf\xc4\xe1\xa8\x87\xdc\xc6\xb1\xee\xc2\x81\x93\x1e\x22\x1e\x13\x2d\x35\x32\xd5\x17\xc6\xa0\xf5\x6a\xb3\x0e\xd6\x9f\x3a\xd8\xd2\x17\x9f\xd8\xee\x21\x16\xb1\xd3\x43\xd6\x61\xb7\xc7\x0a\xe2\x69\x2d\x0b\xd8\xef\x21\x97\x71\xa0\x2d\x41\x86\x3d\xa4\x28\x0e\x75\xe4\x32\x8e\x7a\x48\x45\x1c\xeb\x4b\x66\x9c\xe8\xae\x77\x98\xf4\x58\x6c\x70\xda\x90\xf8\x6b\xd5\x75\x39\x0d\x3a\xae\x5a\xa2\x42\x8c\x17\xed\x2b\xb4\x5d\x06\xd1\x52\x92\xd5\xae\x8a\x5a\x8a\xc6\x9d\x8a\xfe\x2a\x04\x5c\x91\x4e\x0a\x5d\xb7\x2c\x28\x6d\xdc\xaf\x4d\x6c\x1d\x9d\x4c\xa5\xe3\x0a\x7d\x91\xb6\x1c\x16\x28\xaa\x3a\x1d\xf1\xd9\xa9\xd0\x6b\x85\x91\x91\x16\x4d\x84\x76\xa5\x05\x49\x49\x43\x69\xb1\xb4\x36\xd2\x0a\x0a\xea\xb2\x0f\x0e\xb4\xd9\xc7\x0a\xd4\xe4\xb1\x03\x4d\xbe\x71\x02\x4d\xe2\xb8\x81\xf6\xe4\xf2\x02\x6d\xfe\xf6\x03\x2d\x92\x07\x81\x26\x77\x0f\x03\xad\x79\x1d\x06\xda\x5c\x1b\x05\xda\x8c\x16\x07\xea\xf9\x92\x04\xda\xcc\x43\x02\xcd\xf9\x92\x06\xda\x53\x1a\x9b\xfa\xb2\x07\x63\xfd\x39\x86\x2d\x7d\xf1\x87\x6d\xbd\x79\x86\x1d\x5d\xe1\x87\x5d\x7d\xa1\x86\x3d\x53\x7f\x95\xf0\x75\x45\x56\xb9\x29\x55\xf7\x7e\xa8\xb3\xa0\x87\x1a\x22\x10\x47\xfa\xab\x13\x8e\xf5\x16\x3c\x9c\xe8\xcb\x73\x4c\xf4\x05\x21\x4e\x75\xd7\x27\xaa\xa7\xaa\xbb\x8e\x75\xe7\x06\xb6\x74\x25\x21\xb6\xf5\x25\x1c\x76\xf4\x45\x1c\x76\xf5\x64\x1c\xf6\xf4\x97\x00\xec\xf7\x59\x59\xf4\x45\x0e\x1e\xea\x4b\x3b\x1c\xea\xc9\x5a\x1c\xe9\x2e\x31\x38\xd6\x15\xf3\x38\xd1\x10\xb5\x98\xf4\x90\x8b\x69\x7d\x45\x78\x04\x85\x54\xce\xf4\x45\xbf\x1d\xb3\x23\x74\x0d\xe2\x0a\x29\xd7\xc6\xe5\xaa\x28\x6f\x55\x01\xcc\x29\xf7\x0c\x8a\x82\xae\x9a\xd8\x1e\x6b\x53\xae\x79\x56\x5b\x14\x69\xc1\x40\xa0\x86\x42\xf9\x2c\xc9\x2b\x05\x18\x0a\x22\x40\x5a\x30\x12\x28\xa2\x30\xab\x0a\xdb\x2d\xb9\xf2\xc9\x06\x4c\xda\x2c\xa9\x06\x4c\x61\x50\x15\xb6\xc3\x52\x0a\x6a\x52\x1a\x07\xca\x81\xb5\x02\x4d\xd6\xb4\x03\x4d\xda\x39\x81\xde\xa4\x70\x03\xcd\x51\xf3\x02\x4d\xb2\xf8\x81\xc6\x60\x04\x81\xde\xec\x19\x06\x9a\x5c\x1a\x06\x9a\xbc\x12\x05\x7a\xec\x1c\x07\x1a\x42\x20\x09\x34\x27\x37\x09\x94\x33\x37\x0d\xf4\xf8\x13\x9b\xba\x0c\x8a\xb1\xae\x64\xb4\x74\x45\x23\xb6\x75\xe5\x0b\x76\x34\xe5\x06\x76\x75\x25\x11\xf6\x74\x25\x07\xf6\x35\xa5\x33\x57\x71\x54\xa5\x86\xba\x42\x1c\x87\x9a\x52\x06\x47\x6a\x59\x8e\x63\x8d\xf5\x37\xd1\x15\x92\x98\xe8\x0a\x68\x9c\xea\x2c\x80\xd8\xd4\x65\x58\xac\x2b\xdd\xb0\xa5\x2d\x53\x6d\xf5\x84\xc2\x8e\xae\xc8\xc2\xae\xa6\xb4\xc4\x9e\x8e\x70\xc3\xbe\xae\x94\xc6\x81\xae\xf8\xc5\x43\x5d\x01\x87\x43\xf5\x7a\x83\x23\x5d\xc1\x85\x63\x4d\x49\x8d\x13\x1d\x81\x89\x89\xae\x40\xc7\xa9\x20\xa8\xd7\xaa\x29\x06\x54\xf1\xb5\xe4\x8d\x97\xa3\x22\x5d\xb5\xf7\x2a\x1f\x10\xe9\xa2\xcd\xa0\x49\x97\x6b\xde\xa0\xbc\x94\xcb\x1a\xb4\x54\xe8\x7b\x65\x39\xc5\x52\xcd\x3b\xa0\xd4\x72\x02\x53\x49\x8c\x61\x49\x5a\xc5\x42\xcd\x7a\xaa\x28\x15\x95\xad\xca\x7b\x1a\xef\xb1\x00\x1d\xd2\x45\x5a\x39\x1d\x48\x39\x90\x72\x48\x29\xc7\x5d\x5e\xaa\xf0\x0a\xd2\x21\x2e\x2b\x89\x35\xc7\x95\x17\xb7\x34\xb9\x98\x17\xb7\x35\xd9\x94\x17\x71\x34\x07\x9c\x17\x77\xb5\xc6\x93\x17\xf6\xfa\x10\xd0\x57\x4f\x1b\x5e\x32\xd0\x1c\x3f\x5e\x7c\xa8\xc1\x34\xbc\x68\xa8\x35\x2f\x79\xe1\x48\x73\xda\xf1\xe2\xb1\x92\x2f\x79\xc1\x44\x73\x32\xf0\xe2\x44\x73\x26\xf2\xe2\xa9\x96\xac\x2b\x36\xcf\x2a\xc1\x89\x35\x69\x80\x2d\xf5\xe8\x62\x5b\x63\x0a\x61\x47\xb3\xbb\xd8\xd5\xe4\x12\xec\x69\xd2\x1b\xfb\x7a\xa4\xd3\x58\x8f\x87\x5a\x7c\x86\x43\xcd\xb9\x89\x23\xdd\x85\x2e\xd6\x14\x3d\x38\xd1\x9a\xe7\x98\x68\xcc\x2d\x9c\xf6\x92\x48\x95\xdd\x46\xa7\x30\xd6\x16\xbb\xd8\xea\x35\xaf\xb0\xdd\x43\x78\x61\x47\x57\x78\x61\xb7\x97\xbc\x2d\x98\x53\x97\x76\x7e\xaf\xb5\x02\x07\xbd\x56\x22\x3c\xec\x25\x78\x0b\xf6\xd5\x94\x4c\x8c\x89\x75\x0a\xc6\x3d\xd6\x20\xc6\xc8\x7a\xdd\x23\x3d\xa4\x7f\xc1\xd4\x80\x46\xbb\xbe\xba\xf0\xb6\x23\x50\xef\xcb\x70\xfe\x61\x8e\xf2\x51\x98\xa3\x39\x19\x93\x38\x87\x64\x10\x97\xcf\xce\x7f\x41\xd9\xe4\xf6\x2e\x5f\xa8\x53\x86\xe8\x7d\x79\x70\xf9\xa2\x33\x4a\x6f\x15\xa9\xcc\x40\x55\xcc\x04\xfa\xdd\xe4\x0f\xf0\x9d\x3f\xb4\xf7\x98\xfe\xce\x0a\xb0\xb2\xec\xa1\xf8\x4e\x1f\x0c\x81\x22\x7a\x1d\x6e\x4f\x8a\x71\x74\x7c\xc9\xb2\xb3\x20\x16\xa5\x1e\x85\xe3\xeb\xe9\x2c\xcb\x47\x37\x6d\xc9\x21\x16\xf3\x2c\x50\x9a\x50\x18\xfb\x3c\x66\xfa\x13\xf6\x20\x44\x58\x7f\x42\xee\x73\x32\x49\x06\xed\x1b\x86\xf7\xc9\xf4\x82\xcc\x49\xbe\xf6\xec\x57\x1f\xc8\x43\x99\xdf\xe6\x03\x69\x09\x0e\xdf\x28\x5c\xa4\xbe\xfa\x40\x1e\x94\x99\xaf\x28\x12\x8c\x63\x5c\x0f\x45\x59\x3e\x47\x61\x1c\x4f\x67\x49\x36\xb9\x46\xf9\x14\xbd\x3e\x6c\xdf\x49\x09\xad\x3d\xcb\x20\x83\xc2\x5b\x49\x0e\xdc\x74\x3a\x43\x03\x5a\x3c\x43\xfb\xc8\xfc\x07\xca\xd0\x53\xe4\x7a\xff\x40\xd9\xf6\xb6\x8c\x40\xf5\x46\x5e\x4f\x69\x33\xaf\x0f\xf1\xdb\xec\x1d\xda\x41\x58\x9e\x7a\x91\x23\xc6\x52\xda\x0f\x0a\xa2\xbc\xad\x40\xf1\xe4\x59\xf4\xcf\xc0\xc6\x68\x47\x68\x05\xb2\x66\x6d\xa1\xef\x64\x6d\xc8\xf3\x30\x1e\xcc\xe7\xe4\x26\x1a\x13\x84\x3d\x34\xbf\x8b\x3e\x90\x07\xf9\x08\xcf\xef\xa2\x17\xe4\x61\x5e\x8e\x72\xf5\xac\x45\xd6\xc9\x25\x94\x67\xc4\x2d\x1e\x9e\x22\xec\x95\x4f\x6a\x42\xef\xee\xa2\x43\xc8\x28\xc2\x11\x56\x8e\xca\xbc\x68\x93\x23\xfb\x96\x37\xf5\x8e\x63\xad\x6a\xad\xe0\x7d\x65\x43\x51\x96\x5f\x42\x3c\xf4\x7d\x21\xe6\x79\xd9\x9a\x46\x43\x8c\xbf\x9d\xa0\x95\xbf\xdb\x43\x2f\x16\x9f\x36\xce\xb5\x1c\x2d\xce\xad\xb7\x9e\xce\xa6\x37\x20\x94\xc7\x24\xcd\x91\x15\xc0\xa4\xa6\xf8\x28\x61\x30\xf2\xbe\x1d\x64\x68\x17\x79\x90\x1d\x14\x52\xbe\x15\x0c\x3e\x18\xbc\x3e\xb4\xf8\x94\xd8\x42\xdb\x25\xb5\xb6\xd0\xdf\x91\x15\xbc\x83\xac\x70\xc0\xdf\x19\xfa\x3b\xf2\x74\x92\xd4\xb7\x20\x3d\xcb\xae\x47\x4b\x61\xed\x40\xa6\xc1\x0a\xf5\xad\x1a\xee\x56\x00\x3f\xb3\x1e\xa0\x6d\x64\x39\x1d\xdd\xd8\x6a\xe9\x87\xac\x75\x45\x36\x7c\xda\xc3\x6c\x12\x13\x44\xc2\x78\xc4\xf9\x1d\x65\x73\x14\xde\xde\x8e\x33\x92\x50\xc6\x08\x27\x88\xdc\xdf\x86\x93\x84\x24\x45\xce\x36\x58\x49\xbb\x97\x75\x0e\x98\x92\x8b\x43\x8c\xc3\x09\x8a\x08\x8a\x66\xd3\x0f\x64\x82\xb2\x49\x3e\x45\x01\x4b\xc8\x3a\x47\xf3\x38\x1c\xb3\x96\x18\xf4\xb9\x12\xf0\xa7\x51\x16\x8f\x50\x38\x1e\x4f\x3f\xcd\xa1\x15\xda\x44\x3e\xa5\x2d\xdc\xcd\x49\x82\x3e\x65\xf9\x68\x7a\x97\x33\xb4\xe7\xd9\x74\x22\x05\xc8\xc7\x07\x52\xfa\x0d\xaa\x87\xa7\x4f\x29\xf9\xff\x10\x5f\x51\x09\x69\x63\x05\xc1\x6b\xf3\x04\xb3\x79\xe2\x6b\x4f\x13\xde\x18\xc8\xea\xea\x3b\x88\xe6\x41\xc6\x38\xe2\x07\x44\x59\xc9\x56\x8e\xbb\x46\x33\xbe\xd8\x67\xff\x1d\xcf\x36\xf8\x87\xf8\x0a\xd2\xeb\xfb\x92\xc6\xe4\xe2\xff\x90\x65\xd8\x43\xd9\xe4\x23\x99\xcd\x89\xd6\x12\x90\x4d\x3e\x5e\x36\x56\x81\xda\xab\xbe\xeb\x2b\xd6\x5b\x5f\xab\x36\x44\xf2\xcf\xdf\x62\x97\x4e\x35\x49\x9b\xed\xa4\xee\xca\x9a\x47\x26\xf1\xec\xe1\x36\x07\x5d\x4a\xd4\x8f\x5e\x1a\x68\x9a\xa6\x73\x92\xcb\xf0\xe4\x69\x3f\xa7\x87\x25\x88\xaa\x9e\x51\x5f\x31\x7b\xe6\x2c\x4d\xc8\x23\xa3\x55\x91\xb7\x7f\x36\xd5\x43\x39\x6a\x46\x31\x56\x0a\x1d\xf3\x27\x92\x77\x6c\x02\xea\x1d\x19\x43\x53\x68\x1f\xbd\x7c\xcb\x1a\x90\x0c\x3e\xab\x31\x6b\xd6\x40\xdb\x08\xcb\x16\x64\xc8\x50\x9d\xe5\x59\x38\x16\x33\x89\x74\x16\x27\xf7\xf1\x28\x9c\x5c\x93\xb3\x8b\x2a\x1d\x24\x4b\x1b\x63\xde\x9b\x29\xfb\x4f\x32\x47\xdb\xab\x63\xaf\xd8\x7b\xa4\x69\xaa\x53\xfd\xe2\x4c\xac\x6e\x41\xeb\x36\xff\xf4\xae\x1e\x30\xe4\xcd\x34\xa5\xff\xef\x8f\x3c\x54\x77\xf9\x47\x95\xf4\x73\x7a\x37\x49\xba\x85\x4e\x29\x34\x58\x6a\x17\x10\x1c\xec\x2b\x13\x1e\xf0\x5d\x4b\x6f\x54\xef\x62\x50\x97\xce\x08\x8d\x48\x18\xad\xa8\x58\x32\xa7\xc8\xab\xea\x6a\xb3\x7a\xb5\x19\xaf\xa6\xea\xd0\x73\x92\xcd\x73\x32\x2e\x67\x9d\xb2\x9d\x14\xc8\xd7\x5b\x93\x0c\xb4\x57\xc8\x94\xaa\x4d\x2c\xa7\xce\xdb\xec\xdd\xdb\xc1\x80\xf7\xec\xd7\x6a\xbd\xa4\x1b\x96\x72\x4b\x0f\xcf\x90\xcf\x59\x41\x5c\xf9\x8a\xd9\x90\x0c\x33\x0d\xb2\x37\x44\xc3\xb8\xc0\x33\x5d\x76\x31\x7d\x33\x49\xa6\x68\xfe\x29\xbc\x65\xea\xe8\x38\x9c\xe7\x8c\x37\xa5\xcb\x69\xae\xcd\x2b\x8d\x3e\xd6\x39\x45\xb3\x97\xb9\x7c\x26\x42\x5e\xec\x15\x44\xde\xc2\xb4\x57\x55\x5f\x51\xe8\xac\x28\xf2\x56\x14\xb8\xff\x1f\x7b\xef\xbf\xdd\x36\x8e\x24\x8c\xfe\x7d\xbf\xa7\xa8\xec\x99\x8e\xa4\x88\x92\x28\xd9\x4e\x1c\x27\x8a\xd7\x49\x9c\x1e\xef\x75\x6c\xaf\xe3\x9e\xe9\x5d\xb7\xbb\x87\x12\x21\x89\xb6\x44\x2a\x24\x65\x4b\x69\x67\xce\x7d\x8d\xfb\x18\xfb\x0a\xfb\x28\xf7\x49\xee\x41\x01\x20\x41\x12\x00\x29\xd9\x49\xcf\x7c\xdf\xf2\x9c\x38\x12\x05\x14\x0a\x40\xa1\x50\x28\xd4\x8f\x12\x76\x6f\x56\x65\xc4\x10\x2c\x62\xd3\x66\x97\xec\x6e\x95\x09\x24\xb3\xbb\x55\xa2\x10\xdd\xa6\x7e\x43\x56\x2c\x73\xf0\xf3\xed\xce\x56\x4f\x53\xc8\xbb\x2d\x2f\x23\x25\x21\x7e\xbe\x46\x0a\xe2\x0e\x7c\xa2\xeb\x88\x1f\x83\xc3\x20\x8a\xd2\x23\x29\x26\xf9\xc2\x63\x1e\xaa\x8c\x0a\x95\x13\x09\x24\x9d\xa6\xba\x10\x44\x66\x4e\x74\xa3\xe3\x62\x62\x31\xd6\xeb\x99\x85\x46\x79\x93\x10\xb1\x7e\xcd\x0c\x29\xe5\x63\x14\xa0\x7a\x68\x33\x0b\xef\x57\x5c\x79\xfa\x62\xd3\xb4\x18\x95\xf2\x59\x7b\xc5\x0a\x0a\xfe\x53\xe8\xed\xf9\xf1\x46\xbd\x0d\xf5\xbd\x9d\x56\xee\xed\xb4\x5a\x6f\xc3\x8d\x7b\x5b\x35\xcb\x70\x24\xd2\x0c\x33\x05\xeb\x43\x12\x0d\x6f\x96\x58\x97\xb5\x1b\x93\x65\x2c\xa7\xd7\x7d\x7f\xf8\xa9\xcd\x0f\x18\x99\xfc\xba\x16\x0c\x47\x63\x73\x82\xe7\xf9\xd4\xa1\xb8\x2f\x63\xc8\x03\xe4\x47\x83\x7a\xda\x64\x09\x4c\x55\xe2\xde\xa2\x06\x39\x9b\xf8\xf9\xfd\xe1\xa7\x35\xb2\x3e\x5f\x84\xde\x7c\x4a\x5a\x8f\xa1\xe7\x66\xa0\x32\xda\x6e\xf9\xd5\xff\xf6\x3a\x6f\xae\xea\xa4\xbd\xf5\x30\x8d\xe1\x90\x94\x25\xfc\x77\x49\xd4\x85\x3e\xad\xd2\x66\x73\x78\xc8\x48\x2e\x08\xeb\x49\xde\x56\xfe\x4b\xa2\x6f\x6e\x47\x53\x6f\x48\xea\xb6\x05\xbd\x46\x3e\xe1\xaf\xb2\x85\xde\x86\x2d\xf4\x2c\xd8\xae\xd6\xc2\xd6\x86\x2d\x6c\x5b\xf0\x5c\xdb\xc2\xb7\x3c\xf6\x93\xa8\xdb\x96\xe1\x48\x95\xab\x0c\x68\x5b\x3e\xe3\xaf\x57\x77\x6b\xbd\x76\xbf\xa5\x92\x81\x22\xb3\x79\x47\x7a\x0f\x18\xc0\xee\x7a\xed\x96\x8a\x3e\xdd\x97\xbd\x3f\x44\xf6\xf9\xe7\xde\xe2\x12\xe6\xfc\xb8\x1b\x5d\x0a\xf6\x51\xb6\x3b\xdd\x16\x92\xdd\xf4\x92\x52\x1b\xa4\xaa\x4f\xea\x1a\xb2\xd5\x4b\x29\xca\xf7\x7a\xbb\x56\x2e\x81\xbd\x94\xe6\x7c\x6f\xcb\xb6\xd2\xfc\xe6\x7b\x5b\x3d\x8b\xa7\x3d\xdf\xdb\x7a\xf1\xf5\xca\x7a\x6e\x3f\x4a\x6e\xfb\x7f\xc0\xa4\xf6\xff\x40\xa9\xe7\xff\xf7\x48\x22\xbf\xf0\x59\xbf\x74\x23\xf1\x1d\xb2\xc9\xbf\x75\x22\x92\x66\x85\x77\x22\xa2\x2e\xf6\xf3\x56\xcf\x98\x77\xbe\x2a\xe3\x5c\x3e\xdf\x06\xdf\x99\x91\x68\xae\x61\x89\x6a\xb9\xf3\xdd\x6f\xb4\x22\xed\x08\xfb\xff\xf7\xaf\xd5\x9b\x3c\x80\xe7\xdb\x78\x11\x47\xe5\xbb\xea\x4d\xfe\xfc\x7c\x9b\xf6\x0f\x3b\xbb\x7c\xbe\xdd\xe6\x5f\xe8\x10\x99\x65\x5b\x25\x1a\x0c\x15\xae\xc5\xf6\xbe\x90\x08\x1c\xf0\xc9\xdd\x74\x05\x8c\xc7\xb9\x25\x48\x82\x9a\xff\x33\xb0\xff\x3a\x77\x42\x67\x06\xbf\xfb\x8b\xd9\x80\x84\x5f\x61\xe2\x8d\x27\x70\x31\x21\xec\xc3\x56\x0f\x4f\xee\x3a\xa0\x85\xda\xd3\xe0\x0e\x2b\xd3\xff\x4b\xea\x6a\x41\x6a\xf7\x30\x63\x35\xe0\x43\xbf\x4c\x87\x5e\x30\x70\x31\x03\x42\xca\x64\xe6\x41\x76\xcf\xde\x42\x2d\xd1\xb6\xbd\x63\x3f\xb7\xb5\x37\x6f\x8a\x29\x06\xbc\xc4\xf2\x32\x27\x10\x3a\x5c\x16\xed\x77\xa9\x64\x85\x03\xdb\xc7\xf1\x2d\x91\x85\xe8\x28\xf6\x29\x4c\x8d\xdc\xa3\x16\x56\x0c\x34\xf4\xd6\x8b\xef\xbc\x88\xc0\xc9\xe9\x45\x84\x4d\x6c\x46\x32\x7c\x87\xfc\x9d\x93\xf9\x57\x38\xa0\xf4\x48\x87\xbe\x85\x63\xcf\xc5\x17\x67\x14\x93\x10\x7c\x32\x76\x62\xcf\x1f\x7f\x67\x3a\xc0\x66\x09\xa5\x03\x4e\x11\x6d\x3f\x88\xeb\x6b\x4e\x72\xa7\x03\x7e\x50\xe9\xa0\xd9\xe9\x60\xab\x7c\x6e\xff\x9e\x4c\xb4\xba\x3d\x5e\x9a\xcd\xef\xdf\xc5\x5c\x6b\x8e\x8f\x9d\x8e\x10\x49\xf8\x78\x0b\x3a\x4e\x49\x4e\xdb\x8a\x4e\x34\xae\x40\x23\x07\x27\xef\x25\x1a\xc1\xab\x7e\x14\x52\xe7\x4e\x84\x57\xff\x0f\xe0\x35\x09\xdd\x20\x64\xca\x2f\x12\xd2\x89\x03\xda\xb0\x68\xed\xbb\x10\xe8\xc1\xc9\xfb\xef\x4f\x9e\xcc\xca\x23\x25\x4e\xc7\x77\xeb\x8e\x1f\xc4\x13\x12\x72\xa4\xd7\x27\x55\xc7\x77\x65\x52\xa5\x23\x57\x8d\x5c\x53\xb6\xf4\x94\x8d\x77\x45\xca\x4d\x98\x14\xaf\xf6\x0f\x47\xc3\xa7\xe7\x7f\x0c\x09\x9f\x9e\x7f\x57\x0a\x3e\x3d\xff\xfe\x04\x1c\x84\x19\xfa\x0d\xc2\x07\x93\x6f\x10\x3e\x94\x7a\xef\x37\xa3\xde\xfb\x7f\x50\xea\xfd\xf9\x8f\x22\xdf\x9f\xbf\x33\xfd\xfe\xfc\x47\x10\xf0\x32\x47\xc1\xcb\x47\x20\xe1\xe5\xc3\x69\xf8\xd7\xcd\x68\xf8\xd7\x7f\x30\x1a\x46\x43\x4a\x99\x7a\x7d\x76\x05\xc8\x35\x5d\x53\x32\x8a\x1f\x7a\x52\xf1\x91\x6e\xd9\x37\x08\x46\x09\xfc\x88\x36\xfd\x6d\x09\x16\x9b\xf8\xfe\x24\x8b\xcd\x66\x88\x16\xdf\x1c\xd7\x7b\xda\x0b\x7a\x3d\xad\xb2\xaa\x32\xb9\xfa\x06\x5a\xf5\x46\x50\xf7\xe1\x35\x6c\xf5\xaa\x11\x74\x3d\xa5\xe8\xd7\xaf\xc1\x47\x5b\xc7\x84\x58\x99\x01\x7c\x0f\x5a\xe0\xeb\xb4\xfb\x6a\x2a\xa7\xa0\xf4\x54\xaa\xd3\xd8\x14\xb1\x93\x01\xd6\x7d\x68\xd1\x6e\x55\xc0\x43\x63\x6e\x43\xdb\xfe\x67\x58\x74\x78\xd5\xfe\x3f\xab\xae\x6a\x35\x30\xaf\xba\xf3\xfa\xba\x2a\x03\xb1\xe8\xce\x1f\x7d\xd1\x31\xf2\xcc\x2e\x30\x69\xcd\x89\x65\x58\x6d\xcd\x15\xb6\x24\x84\xf6\xa0\x65\x27\x2d\xe3\x04\x62\xa5\x75\xc7\x71\xf9\x47\x5f\x78\xe7\x41\xec\xc4\xe4\x8f\xd9\xee\x42\x6c\xfb\xdb\xae\x3c\x6c\xe3\xfb\xaf\x3c\xd6\x35\x79\xe5\x85\xc1\x86\xbb\x1d\xad\x58\x75\xd9\xf1\xa1\x41\x72\xe5\x1b\xac\xdf\xa0\x27\x9c\xf4\xcd\x79\xfd\xf9\x76\xc9\x52\xfa\x06\x64\xf4\x4d\x19\xf8\xff\x69\x74\xb4\x11\xff\xa6\xf5\x36\x26\xa3\xf3\x02\x19\x1d\x7f\x13\x32\x3a\x70\xdd\x3f\xe6\xdc\xe8\xb8\xee\x77\x3d\x37\x3a\xae\xfb\x07\x68\xee\xdc\x9c\xe6\xce\x7d\x04\xcd\x9d\xbb\xae\xe6\x2e\xbf\xe3\x37\x93\xf3\x1f\xfa\xbc\x19\x37\xd5\xa1\x13\x86\x2b\x5a\x5b\x88\x0a\x76\x03\x5e\xe7\xa4\x07\xbb\x01\xfb\xd0\x85\xbd\x12\x50\x45\x69\xbf\x99\x9e\x5f\xa1\xc9\x9a\xe2\x18\x3d\xfe\x46\x5d\xf8\x61\x1d\x33\x8c\x03\x1f\x1c\xbc\x91\x0c\x46\xf2\xfd\x99\xea\xbe\x4a\x6d\x6b\x31\x0f\x83\x39\x09\xe3\x15\xfc\x8e\xf7\x98\x6c\x4d\x44\xb8\x28\x12\xc0\x85\x3b\x28\x4e\xc0\xca\x56\x64\x90\x82\x5b\x47\xde\xf8\xed\x8a\xee\x09\x59\xa6\x1d\x79\x63\xdf\x1b\x79\x43\xc7\x8f\x61\x80\xbf\x7b\xbe\xb4\xe4\xb1\xfd\xb5\xaf\x2b\xd3\x0b\x5a\x81\xad\x78\xf3\xcd\x2e\x2e\x8d\xd8\x6a\x86\x1e\x32\x1c\x29\x33\xf6\xf5\xd3\x39\x5d\x41\xce\xb4\x91\x99\xde\x75\x66\x01\x94\x9b\x66\x32\x0d\x52\x0b\x95\x66\x84\x68\x89\xca\xdc\xbb\x87\xf1\xa8\x3b\x79\x36\xf3\x9d\xcf\x58\xdc\x69\x79\xd5\xe3\x35\xa1\x8e\xf3\xc0\x41\xd1\x67\xdd\x8b\x5a\x8d\xdb\x6a\x25\x80\xdd\xdd\xee\xcb\xae\xd3\x1d\x60\x70\x89\x61\xd7\xed\x92\xee\xa8\x68\xbf\x21\xc1\xbb\xfa\x9f\x11\x2a\x1b\x21\x0b\xba\xf6\x03\x6f\xcf\x71\x91\x58\xc9\x32\x33\xdd\xa1\xdf\x71\xab\x5b\xdc\x72\xc4\x17\xf6\xff\xfd\xbd\x39\x0a\x00\x3d\x4f\x27\x2b\xf9\x49\x1f\x8c\x26\x34\xf2\xc3\x24\x36\x51\xb3\x9f\xe0\x69\xf0\x2f\x32\x9d\x8a\x75\x60\x19\xab\x98\x12\x7f\x1c\x4f\xe0\x19\xec\x3e\x96\x27\xac\x81\x39\xbf\x0b\xfc\x5b\x12\x0a\xa5\x91\xb4\x0f\x72\xee\x49\xa5\x3a\xe1\x8c\xfe\x00\x66\x2d\xc4\xba\x84\xee\x32\x26\x40\x5f\xe1\x22\xbb\x75\xd5\x22\x70\x9d\xd8\x01\x27\x7a\x94\xd6\x1f\x78\x51\x90\x35\x5f\x5a\x4a\x9b\x65\x3b\x0e\x7e\xde\xea\xad\x6b\x55\x80\x95\x1e\xdd\x7a\x9d\xe3\x95\x5d\x1a\x66\x1b\x76\x51\xe5\x98\x51\x5c\xd2\x37\x41\x83\x25\xf6\xec\x8c\x74\xcc\x2d\xb0\xb1\xdb\xc4\x99\x3c\x8b\x5c\xe5\xc0\x2d\xa9\xfd\x8f\x00\x70\x69\x72\x29\xa7\x8f\x40\xb2\x3d\x5f\x44\x93\xba\x90\xeb\xa9\x00\x5a\xe2\xfa\xaf\xae\xa8\x97\x59\xc1\xec\x78\x28\x04\x61\x89\xde\x04\x5b\x16\x2d\x59\x59\x9e\xb1\xa6\x41\xb4\x89\x0f\x60\x3b\x74\xb9\x0d\x83\x39\x0a\x4d\xe5\xd2\x24\xac\x73\x92\x4b\x16\x3a\x81\xe1\x34\xf0\xd7\x57\x34\x3c\x6c\x11\x63\x9b\xf9\xd5\x8b\x2f\xd7\x5d\xbd\x58\xa9\xea\xea\x95\x9b\x46\x21\x9a\xf5\x3d\xf1\x35\x2c\xf1\x2d\x7c\x87\x55\xff\x05\x97\xf2\xbf\xb0\x79\x30\x36\x25\xb6\x43\xd6\x4a\x71\xa7\x14\xbe\x21\x95\x9a\xc5\x40\x25\xc2\x92\x91\x09\xcc\xe5\x8d\x27\xac\xe4\x2e\xc3\x47\x74\xf5\x54\x0b\xff\x6e\xcd\x55\x7f\xc7\x17\xb9\x68\xf4\xd2\xbb\x2a\x99\xd9\x4a\xcb\x10\x41\xe8\x0c\xef\x0a\x6f\xd6\xb7\x2b\x37\x58\x93\x73\xd3\xf1\xaf\x57\xd6\xf3\xee\x3a\xf6\xe0\x9d\x67\x4f\x60\x12\xc7\xf3\x68\xaf\xd3\x99\xc5\x93\xa8\x3d\x20\x9d\x45\x3c\xda\xbd\x8e\xe0\xb6\xd7\xee\xb6\x7b\x30\x58\xc1\xbf\xce\x9c\x78\xe2\x39\x91\x4c\xdf\xa9\x21\x39\x9a\x2c\x17\xcc\x8e\x3b\x1d\x78\x4f\x62\x16\x9e\x87\x10\x3a\xdb\x9e\x33\x98\x92\x08\xfe\xc6\x91\xf8\x5b\xa6\x38\xba\xa7\x87\x84\x1c\x26\x66\xe1\x05\xe3\x73\xa8\x31\x8a\xaa\xc1\xd3\xa7\xe2\xf5\xab\x4a\x8d\xc2\xdf\x58\xff\xd5\x4d\x7e\xc4\xdf\xd2\x16\x67\xfc\x7b\xb6\x41\xfe\xf6\xe9\xd3\xc2\x44\xe6\x4d\xdb\xfb\x99\x8e\x24\x55\xab\xa2\x3a\x46\x4b\xf1\xbf\x59\xcc\x8f\xfc\x24\x70\x49\xfb\x3a\x82\x20\x84\xb7\xcc\x74\xdc\x1b\x79\xc4\x85\x61\xe0\x12\x2b\x0f\xd0\xf1\x5d\x58\x44\x04\xbc\x98\x8a\x41\x7f\xa3\xf3\xa2\xee\x32\xb7\x46\x4f\xba\x3c\xe6\xdf\xb3\x5d\x66\x6f\xb3\x54\x4a\xe5\xe1\x14\x42\x3b\xa9\xd8\x97\xe1\xde\xdf\x4b\xdf\xda\x77\x9e\xef\x06\x77\xb9\x32\xaa\x15\x4a\xf1\x05\xb9\x50\xde\xfa\x3d\xdb\xdf\x67\xad\x47\x7b\x9e\x75\xfe\x57\x61\x9c\xa2\x38\xf4\xfc\xf1\x87\x30\x98\xbd\x9b\x38\xe1\xbb\xc0\xa5\x24\xf2\x09\x5f\xb6\x47\xd2\xdb\xe2\xbc\x5e\x38\x37\xc4\x67\xd3\x97\x5f\x5a\xf3\x85\xbf\xa2\x53\x97\xa9\x92\xec\x0b\x8b\x61\xd4\x73\x09\xfd\xbd\xce\x5a\x57\x8d\x12\x5a\x52\xa1\xf3\xb8\x46\x42\xc2\x0d\x24\x58\xf8\x31\x09\xd5\xf7\x60\xa8\x0c\x14\x4c\x97\x35\xa4\xe5\xba\xb4\x2c\xc6\x82\x52\xff\x44\x96\x71\xe8\x14\x7f\xba\x9b\x78\x53\x02\x75\x81\xc5\x6b\xde\x9c\xde\x1d\x79\xba\x20\x29\x2e\x43\x3e\xb2\x07\xb1\x80\xd0\x6c\x6a\xf8\x32\x25\x47\x56\xfb\x4d\x1f\xec\xe5\xfb\x5d\xdb\xa6\x94\xcb\x5e\xbd\xc6\x57\x6f\x3f\x7c\xa0\xaf\xaa\xe2\x02\x6c\x0e\x51\x19\x19\x2d\xc2\x30\x18\x3b\x31\xb1\x70\x69\xc5\x13\x12\x12\x0c\xc0\x05\x3e\x59\xc6\x40\xf1\x74\x86\x31\x09\xb5\x80\x70\x78\x36\xea\x98\xe8\x5c\x9d\x81\x78\x0a\xf6\xf2\xc3\x3b\xdb\x6e\xd0\x35\x6a\x2f\xdf\xe3\xc7\xdf\x29\xa2\xd3\xe0\x2e\xc5\xd3\xb8\xe9\x31\xa2\x61\x82\x67\x9d\x8f\x1a\x85\xbb\xf5\xe1\x43\x03\x23\x6a\xd9\x0d\x68\x82\xd4\x20\xfe\xd0\x14\xb1\xea\x4d\x3b\x63\x95\x93\x6b\xa7\x03\x0b\x7f\xe6\xc4\xc3\x09\x71\x53\x8c\x5f\x41\xe0\x4f\x57\xe0\xcc\xe7\x04\x47\xd8\x8b\x90\xb5\xc1\xc2\xf7\x62\x0b\x3c\x1f\x86\x4e\x44\x50\x29\x46\x87\xbc\xac\x81\xa4\x2a\x9d\xa5\x58\x78\x24\x24\x8d\x51\x19\xd5\x91\xbe\xce\x1d\x4f\x3f\x77\xf9\x21\xc3\x01\x2b\x91\xee\xf9\xac\xb6\x5a\x6b\x1f\xc3\xcb\x46\xb0\x2a\x26\x0a\x39\xa3\xc8\x67\x99\x54\xc1\x40\x9a\x79\xec\x03\x99\x19\xf1\x91\x99\xa1\x14\xaa\xe3\x65\x09\x27\x62\x67\x06\x13\x23\xf2\x7c\x97\x2c\xa1\x0f\x2d\x45\xc4\xcb\x12\x46\x95\x70\xcc\x5a\x4d\xcb\xae\x9a\x4d\xd6\x40\x55\x76\x85\x08\x5f\x62\x1d\xcd\x71\x51\x62\x4f\x74\x01\x7f\xa0\x0b\xca\x24\xf9\xd3\x92\xad\xbe\x58\x71\x7a\x22\xe2\xbd\x69\xf6\x15\xbb\x94\x68\xf0\xcd\x1b\xe8\xda\x62\x1d\xc3\x3d\xe7\x8e\x06\x02\x16\xdd\x62\xec\x05\xee\x21\xc3\x22\xaa\x92\x5b\x35\xf4\x14\x68\x3c\x84\x4e\x13\xa2\x1b\x4e\xc8\xf0\xe6\xd3\xd0\x99\x3a\xe1\x5f\x68\x3b\x75\x4a\x7f\x67\x81\xe7\x2b\xbd\x97\xe9\xf4\x24\x05\xb2\x3b\x48\xfa\x9a\xed\x22\x86\xa9\x8b\x27\x61\x70\x07\x87\x61\x18\x84\x75\xed\xe0\xd6\x8e\xe9\xf9\x28\x65\x3d\x3f\x35\x6b\xd0\x4c\x5b\x69\xc7\x01\x13\x2d\xea\xdd\xe7\x8d\x76\x1c\xfc\x34\x9f\x93\xf0\x9d\x13\x91\x7a\x03\x9a\x7a\xa0\x94\xcd\xf9\x41\x4c\x99\x1a\xf6\x99\x4d\x59\x4d\x59\xa1\x74\xc8\xbf\x7e\x37\xf1\x2a\x9d\x2e\x54\x21\xbc\x5d\xc5\xd2\x44\x59\xcc\xe2\x49\x29\x20\x32\x82\x50\x10\x55\x5d\x9e\xc7\x37\x02\x02\x23\x5d\xbc\x4b\x5c\xee\xe6\x49\x5f\x47\x42\x8c\x67\xbd\x13\xe0\xca\x29\x48\x6a\xfa\x29\x5f\xe5\x1f\x3e\xec\xf2\xad\x9a\xef\xd2\xdd\xd6\x60\x15\x13\x88\xc8\xe7\x05\xf1\x87\xea\x7d\x5a\xdf\xbb\x14\x85\x2a\xcb\x06\x85\xd7\xd5\x6c\x10\x4c\x35\xec\x4e\x87\xf4\xae\x9d\x45\xba\x57\x01\xe9\xa4\xa1\xf2\x49\x79\xce\x26\xa4\xcb\x27\xe4\x9d\x8a\x17\x15\x7b\x93\xf8\xf9\x2a\x30\x46\xb1\x44\xc6\x78\xab\x02\xc6\x06\x16\xa1\xe6\x71\x6b\x74\xb1\xdb\x63\x7d\xb4\x79\x1f\x0f\x75\xfc\x96\xc3\x6c\xf6\x35\x4b\x40\x15\x39\x76\x9d\xb1\x39\xb4\x0b\x63\xb3\xfd\xb8\xb3\xd9\xdd\xe5\x5d\x7d\xc1\xba\xfa\x61\xd3\xae\x76\x75\xf6\x7c\x8f\x33\x46\x29\x14\x55\x9f\xb2\xe3\xa6\xe5\x15\x20\x2d\x4f\x84\x57\x8d\x95\x2c\xe2\xd1\x2e\x17\x81\xcc\xe7\xb9\x04\x8d\x08\xfa\x8a\x53\x60\xc9\x11\x2e\xad\xfd\x20\xe9\x29\x01\xa3\xfe\x99\x52\x0f\xdb\xa3\x1e\x47\x8a\x4a\x87\x5e\xee\x81\x51\x9c\x92\x50\x68\xf6\x0d\x8c\x7a\x0d\xe1\x22\x05\xf9\x87\x29\x1b\x12\x72\x09\x89\xe3\xbe\x0b\xfc\xd8\xf3\x17\x18\x7e\x0f\x09\x5e\xb7\xeb\x50\xc4\x8f\x70\xa0\xdf\xf4\xb1\x17\xef\xe8\xd9\xa3\x8a\x8c\x52\x3b\xf2\x6f\x9d\xa9\xe7\x62\x2d\x46\x15\x35\xe5\x90\x69\xa8\x24\x8b\x20\xb0\xd6\x51\x3b\x7e\x99\x20\x75\xc5\xf9\x50\x11\x6c\x52\xa4\xd9\x54\x68\x94\x39\x33\xcb\x35\xf1\x94\x6d\x17\xec\xec\x4b\x77\x56\x75\x27\x85\x16\x56\x55\x7d\x4b\x81\x8a\x2a\xc4\x58\x07\x8e\x46\x70\x47\x80\x9e\x46\x17\x73\xa0\x27\x7e\x0b\xbc\xf8\xff\xfb\x7f\xfe\x5f\x21\x69\xc9\xe0\xb1\x37\x05\x20\xca\xc1\x2e\x54\xcb\x8f\xb9\x8e\x89\x30\x56\xf0\x09\xd9\x8e\x92\x1a\xc4\xe2\xd4\x2c\x6b\xfa\x53\x4f\xff\xd3\x96\xfe\xa7\xed\x52\x3e\x51\x46\x99\xdf\x8b\x30\xb3\xcd\xf6\x2b\x2c\x08\x4e\x2b\x23\x67\xaa\x0a\x41\xa1\xa6\x8c\x73\xe2\xb8\x30\xf2\xc2\x28\x56\xcf\x3b\x4e\xc2\xe3\xae\x87\xa2\xe8\x08\x75\x3f\x28\x12\x53\x54\x34\xb7\xc0\xa5\xc4\x50\x7a\xca\x97\x0d\x97\x06\x4c\x03\xa2\x21\x24\xf5\x80\x94\x09\x88\x39\x14\x0e\xc5\x12\x7e\xa7\xc5\x02\x89\x15\xfa\x1a\x56\xa8\xde\x15\xe4\x8d\x44\x6e\xae\xcb\xd4\x5a\x18\xd1\x5f\xb3\x08\x40\x7d\x08\xd4\xb3\x18\x90\xd9\x8c\x76\xbb\x84\x2a\x1a\x9d\xcd\xb8\x44\x02\xbe\xe2\x14\x6d\xe5\xa9\x67\xe6\xac\xc0\xf3\x87\xd3\x05\x6a\xca\xe6\x8e\x17\xca\xea\xb8\x72\x3a\xfa\x20\x26\xf1\xf0\x51\x27\x11\x59\xd1\x23\x4d\xbc\xcd\xf5\x99\x3d\x74\x67\x61\xd8\xc8\x84\xa0\x60\x79\xa0\x26\x04\x1b\x8f\x44\xfa\x39\x5c\xfb\x2c\x01\xff\x6c\xf4\x53\x76\x6a\xc8\x53\xc7\x2e\xa7\x8e\x0f\x7f\x38\x75\xe0\x0e\xf6\x58\x14\xf5\x02\x29\xca\x5e\xe6\x69\xca\x5e\xda\xef\x1a\x70\xaf\x9d\xa4\x3a\xc3\x9c\x95\x4c\x08\x50\xb1\xb1\x82\x86\x00\x51\x11\xa8\x50\x48\x75\xed\x32\x6d\x62\x45\x32\xab\x42\x07\x4a\x52\xfb\xe9\xe2\x43\x6b\x17\x5c\xbc\xc3\x24\x6e\x89\x34\x23\x44\x0a\x1e\xbe\x49\xf5\x13\x6e\xd7\xea\x9f\x70\x7f\x7c\xa5\x3f\x63\xf1\xd3\x52\x2a\xcb\xab\xc6\x25\x69\x3e\x7b\xc4\x92\x2a\xa9\x37\x67\xc4\x4b\xde\xd5\xb5\x87\x2c\x49\x02\xd1\x5d\xbb\x65\x0e\x7a\xba\xcb\xbb\x78\x36\xd7\x9e\xae\xea\xf1\x6c\x0e\xfd\x9c\x50\xd8\x80\x27\xfd\x3e\x93\x65\x4a\x4f\x5b\xdc\xf2\x28\x9e\xcd\xd7\x39\x26\x49\xca\xfc\x14\x92\x79\xca\xbf\xf5\xfd\x2c\x9d\x78\xe8\x2b\xba\x5b\xbb\x25\x61\xe4\x05\x7e\x6d\x0f\x6a\x68\xc2\x50\x2b\xda\xb6\xd6\x58\x67\x6a\x7b\xd2\x19\x5d\x51\x8a\x0d\x33\x2f\xc5\xbe\x64\xbb\x5c\xbc\xfc\xfd\x14\xcc\x08\x1c\x7c\x7c\x0f\x83\x85\x37\x75\x21\x98\xc7\xde\xcc\xfb\x42\xc2\xc8\x82\xa9\x77\x43\x20\x6c\x5f\x47\x16\xdb\x38\xd0\x94\x25\x9a\x93\xa1\x37\xf2\x86\x94\x75\xbb\x1e\x92\xf4\xdc\x89\x63\x12\xfa\x51\x1e\x34\xd6\x8f\x27\x04\x46\xc1\x74\x1a\xdc\x79\xfe\x78\x2f\x53\x84\xf2\x8f\xe2\xda\xcd\x85\xc7\x83\x9a\x58\x3a\x35\x95\x31\x43\xa6\x78\xdb\x99\xb9\x39\xa3\x80\x42\x85\xb4\x64\xe6\x27\x15\x1d\xf2\xa0\x7a\x89\xdd\x48\x89\x28\x4a\xc7\x5c\x41\xa2\x79\xaa\xfb\xbf\x12\x2d\x58\xce\xec\xe2\x89\xf4\xbd\xed\x07\x2e\xb9\x58\xcd\x95\xeb\x43\xd4\x65\x86\x20\x5c\x5d\xe6\xf9\xb2\xed\xc5\xb9\xe7\x8f\x83\x7f\xfb\x04\xb7\x76\x7b\xb7\x6d\xab\x35\xf2\x29\x08\x29\xcc\xa1\xa6\x13\x7c\x73\x17\x0d\x39\xe1\xdd\xc4\x99\xe6\x1a\x7a\xd1\xb6\x5b\xca\x86\xf0\x3e\x8b\x39\x35\xb1\xd0\x74\xba\x42\x13\x27\x3a\xbd\xf3\xcf\x84\x87\x48\x9f\xd7\x6a\x67\xdf\xab\xeb\x27\x66\x56\x98\x74\x8a\xcd\x86\x69\xbb\xc9\xc2\x64\xe6\x6a\xb4\x0e\xc6\xe7\x6c\xd0\xf9\x90\xe7\xe7\xf2\x86\x65\x60\xa3\x25\xf0\xf3\x86\xd7\x99\xb9\x81\x3c\x9f\x78\x7e\x40\x87\xd1\x81\x3b\x32\x00\x1e\x6c\xb1\xc8\xd4\x82\x20\x6e\x73\xfe\x51\x9c\x20\x89\x8f\xb1\x30\x8b\x0d\x61\xfb\xf6\xd5\xfa\xfd\xeb\x95\xf5\xbc\xb7\x8e\x81\x55\x21\xf0\xe5\xcf\x1f\x8f\xff\x1c\xc7\xf3\x73\x2a\x51\x45\x71\x06\xf2\xbf\x0c\xbc\x31\x73\x0f\x69\x5f\x47\xff\xb2\xae\x19\x97\x5c\x19\x6e\x7b\x6d\xbb\xfd\x22\xb9\xb2\x1d\x7b\xf1\x64\x31\x68\x0f\x83\x59\xe7\xa3\x77\x43\x3e\x0e\xa7\x1d\xb9\x78\xe7\xf8\xe8\xdd\xe1\xc9\xbb\x43\x90\x19\xad\x1c\x2a\x74\xac\x34\xda\xa9\x2d\x22\x82\x6a\xd5\x61\x5c\xcb\x73\x42\x61\x5e\xa9\xc0\x8a\xff\x72\x00\xff\xe6\xdc\x3a\x9f\x86\xa1\x37\x8f\x61\xea\x0d\x42\x27\x5c\x21\xd9\x39\xe1\xc0\x8b\xe9\xb7\xd6\x3c\x24\x43\x8f\xf2\x72\x70\x30\x72\x38\x89\xbd\xa1\xb0\x02\xad\xd8\x35\x5e\xfa\x5d\x30\x5f\xb1\xbc\x01\xf5\x61\x03\x7a\x76\x77\x07\x3e\x7a\xc3\x89\x43\xa6\xf0\x71\x38\x75\x16\xe3\xc9\xd4\xf3\xe1\xf5\xc7\xdd\xe1\x64\x77\x77\xfa\xaf\xe3\x99\xe3\x4d\x29\xcc\x37\xbc\xfe\xc7\xa3\x0b\x38\x5c\xce\x9d\x18\x8e\xbd\x61\x22\x09\xd3\xe1\x2a\x6c\x4c\x6f\xbd\xf1\x09\xb6\x6e\x01\x8b\x5a\x6d\xc1\xdc\x09\x23\x72\xb2\x98\x91\xd0\x1b\x16\xb7\x19\x2f\xe2\x3f\x41\x1f\x3a\xbf\xb6\xf6\xeb\xbf\xb8\xcd\xfa\x2f\xed\x5f\xdc\x67\x8d\xfd\x7b\xfa\x7f\xb3\x51\x27\x97\xcd\xd6\xd5\x3e\xfd\xb8\xff\xa7\x8e\x57\x84\x31\x73\xe2\xc9\x90\x78\x53\xe8\xc3\x47\x27\x9e\xb4\xe9\x67\x75\xa9\xd1\x34\x08\x42\x51\x0c\xbf\x14\xcb\xf9\x41\xfc\x36\x60\xf7\x47\x5c\xeb\x35\x08\x82\x29\x71\x7c\xba\xb6\x06\x9e\x4f\x67\xca\xf5\xc6\x5e\xac\xd8\x5a\x31\xe9\x89\xe7\x8f\x3f\x32\x8b\xa9\x9a\xf8\x4e\x57\x02\x51\x94\x8f\x83\xe0\xa3\xe3\xaf\xde\x53\x70\x74\x7d\xd4\xb8\x83\x14\xdd\x89\x28\x57\x81\x59\x10\xd2\x6d\xcf\xf1\xa1\xbb\x93\xf1\x99\x42\x0c\x22\x05\xc8\x83\xe3\xb3\x3f\x1f\xbc\x3d\xbc\xa0\xd0\xec\x6e\x6f\x6b\x7b\xe7\xf9\x8b\xdd\x97\xce\x60\xe8\x92\xd1\x78\xe2\x5d\xdf\x4c\x67\x7e\x30\xff\x1c\x46\xf1\xe2\xf6\x6e\xb9\xfa\x72\xf0\xf6\xdd\xfb\xc3\x0f\x3f\xfe\xf9\xe8\xdf\xfe\xef\xe3\x8f\x27\xa7\x67\xff\x7e\xfe\xe9\xe2\xa7\xbf\xfc\xf5\xe7\xff\xf8\xcf\x3f\xfd\xa6\x00\xff\xf6\xe0\xd3\x21\xf4\xa1\x4b\xba\xdb\xc5\x1f\x8f\x4f\x7f\xfc\x4d\x14\x50\xfc\xfc\xf1\xe0\xe7\xdf\x3e\x1d\x7c\x38\xfc\xed\xe8\xe4\xe2\xf0\xc7\xc3\x73\xbc\x6f\xef\x8e\xe4\xc7\x4a\x0a\x77\x3a\xd0\xfb\x75\x67\x0b\x5a\x50\x4c\x6f\xda\xe9\x20\xac\xa3\x93\x8b\xad\x1e\x02\x79\x51\xa8\x0e\x59\x40\x98\x96\xb0\x08\xe8\xec\xf4\xaf\x9f\x7e\xbb\x38\x3c\xa1\x02\x69\xd7\x82\xae\x4d\xff\xd1\x3f\x64\x8b\xfe\xd9\xa6\x7f\x76\xe8\x9f\xe7\xf4\xcf\x0b\xfa\x67\x97\xfe\x79\x49\xff\x60\x69\xd2\xed\xe2\xdf\x1e\xfe\xdd\xba\x2a\xf6\xfa\xd3\xbf\x9f\x5f\x24\xa3\x42\x5e\x28\x6c\xcd\x3b\x92\x39\xf6\x33\x16\x0f\xd4\x9b\x79\x31\x04\xcc\x23\x8e\x99\x22\x04\x23\x78\x7f\xf8\xee\xe8\xe3\xc1\xf1\x6f\x67\xc7\x07\xef\x0e\x3f\x59\x70\x71\xfa\xdb\xe1\xcf\x67\xbf\x9d\x1c\xfe\x98\x7c\x3e\x3b\xfd\x64\xc1\xc7\xa3\x13\xfa\xc5\xc2\x41\xc2\x0f\x8e\x9c\x8a\xe7\x19\x42\x75\xc2\xf1\x62\x46\x7c\xee\x2d\x1f\xd0\xbd\xc9\x27\x7e\xec\x39\x53\x0b\xe2\xe0\x83\xb7\x24\x2e\x7e\x08\xc2\x99\x13\x73\x1b\xb0\xe0\x4c\xf0\x24\x0b\x06\x64\x15\xf8\xae\x48\x79\xe8\xcb\xe0\xc9\x72\x48\xd0\xed\x8f\x59\x22\x85\xc1\x9d\x0f\x75\x6f\x04\x87\xe7\xe7\xa7\xe7\x9f\xf0\x65\xb8\x20\x0d\xc9\xa4\x5d\x61\x3b\xfe\xf1\xe0\x67\x3a\x60\x87\x2f\x5f\x29\xe6\x54\x31\xc7\x36\xed\x47\x42\x15\xff\x4b\xcd\x91\x85\xe1\x3e\x4b\xa5\xc2\x44\x2d\x27\x65\x5a\x54\x0e\x8d\xe2\x70\x31\x8c\x83\x90\x63\x97\xc3\x2c\xd9\x15\xb8\x0b\x73\x7d\x18\xf8\x23\x6f\x7c\x3a\xb8\xd6\x69\xa7\x5d\xef\x56\xe7\x5e\xd0\x01\xcf\x85\x38\x74\x86\x37\xcc\x60\x8b\xca\x0d\x24\x4c\xda\xb0\x20\x0a\x80\xf2\x05\xdf\x99\x11\x91\xa9\x92\xab\xaf\x5c\xba\xe3\x13\x7a\x28\x05\x1e\x65\x5c\xe3\x33\xe9\x61\xda\x30\xb5\x83\xdb\x19\xf4\xd3\xbe\xb7\xe7\x61\x10\x07\x94\xf1\xa8\x0b\x9f\x9e\x50\x02\xf6\xc9\x5d\x5a\xa5\xde\x6d\x58\xff\x4b\xeb\x3a\x51\xf2\xc0\xe1\xfb\xa3\x8b\x83\xb7\xc7\x87\xf0\xfe\xf0\xc3\xc1\x4f\xc7\x17\x9f\xa0\xb4\x4e\x61\xcb\xc9\xcf\xaf\x78\xd8\x2a\x72\xc9\xc8\x59\x4c\x63\x91\xcf\x73\x40\xa6\xc1\x1d\xcc\x16\x51\xcc\x06\x32\x26\x63\x12\x46\xe8\x81\xcf\x7d\x4f\x71\x70\x23\xef\x96\x40\xe8\xf8\x63\x12\x41\x84\x81\x10\xda\x2a\xe0\x1c\x28\x9d\x16\x67\x1a\x61\x8e\x4f\x96\x86\xc6\x05\x27\x86\x70\xe1\xb7\x62\x6f\x46\x60\x11\x51\xf6\x9f\x8e\x32\xa3\x97\x3c\xc4\x8e\x96\x42\x68\x53\x33\x67\xe9\xcd\x16\x33\xc9\x7b\xd6\x25\x43\x6f\xe6\x4c\x61\x3e\x75\x86\x24\x42\xc1\x81\xca\x9e\x0e\x0b\x8f\xef\xf9\xb7\xc1\xf4\x96\xb6\xeb\x7a\xb7\xb8\x5c\xd5\xb4\x91\xe5\x27\xd0\x87\x9e\xad\x62\xa0\x99\xe5\xb5\xce\xf8\x67\x76\x3f\x9e\x01\x75\x42\xfc\xf4\x3d\x8f\xd3\xe1\x0c\x82\x5b\x92\xeb\x13\xe3\x38\x58\x1c\x87\x30\x0f\xbf\x8c\x63\x15\x19\x16\x2e\x79\x4c\x9e\x57\xcf\x10\x46\xa3\x30\xbd\x3f\x9d\x25\x5f\x6c\x38\xb8\x73\x56\xcc\x04\xf0\x0b\x09\x83\x42\xd9\xf7\xa7\x7f\x3d\xe1\x5f\xba\x70\x11\xdc\x39\xa1\x1b\xa9\x4b\xbe\x3b\x3c\x3a\xe6\x5f\x7a\x49\xc9\xe6\x91\x3f\xf2\x7c\x2f\xce\xfb\x0b\x3d\x83\x0f\xc7\xa7\xa7\xe7\xec\xcb\x56\x52\xbc\xa5\x2d\xfe\xe7\x83\xe3\x0f\xbf\x31\xc4\xb7\x93\xe2\x3e\x71\x42\x12\xc5\xe0\x13\x6f\x3c\x19\x04\x8b\xb0\x0d\x47\x23\xa0\xf2\xb4\xeb\x45\xb1\xe3\xc7\x16\x2c\xe6\x6a\x50\xac\x5f\x3b\xeb\x80\x72\x83\xbb\x3c\xa1\x71\x60\x87\x7f\x39\x3c\x01\x78\xbe\x0e\xb0\x98\x17\x25\xb7\xc4\x97\xca\x29\xc1\xb3\x91\x7d\xb1\x09\x78\xfd\xf8\x23\x64\x36\x09\xbb\x9b\x40\xd6\x4e\x95\xda\x4d\xea\xfc\xf4\xa7\x93\xf7\x47\x27\x3f\xfe\xf6\xf1\xf4\x3d\x65\xb4\xdb\xea\x95\x98\xae\xc5\x5d\x2d\xcb\x38\xfc\xf9\xec\xf4\xe4\xf0\xe4\xe2\xe8\xe0\xf8\xb7\x83\x0b\xd8\x83\xcb\x54\x50\x00\x59\x52\xb8\x32\x72\x1d\xc2\xd7\x17\x97\x3f\xf8\x92\x1a\x10\x9f\x38\xf1\x84\xef\xfb\xc2\x72\x8f\xef\xa5\x51\x52\xc9\x73\xa6\x54\x78\x46\x86\xa4\x66\x3e\x9d\x0e\x9c\xa4\xc2\xee\x1e\xb4\x5e\x28\x8b\x49\xa8\xf7\xa1\xf5\x42\x37\x2a\xe9\xb8\xb4\xf4\x4c\xca\xd4\x2f\xc6\x83\x1e\xbd\x57\x3d\x75\x6e\xfe\x74\x0e\x28\xd7\xed\x96\xf7\xca\xd4\xa9\xf3\x83\x93\x1f\x0f\xe9\x34\xe7\xe5\x3e\xf3\xfc\xce\x3c\x1f\x77\x95\xec\x78\x58\xb9\x19\x5e\xf8\x2e\x09\x47\x74\xe3\x8c\x03\xe4\x6b\x10\x0c\x87\x8b\x50\x23\x6d\x14\x26\x75\xab\xb7\x0d\x50\xdf\x21\xf4\x83\xda\x2b\x9e\x23\x8d\xb6\x39\xc4\x30\xbf\x14\x76\xab\x5b\x69\x86\xc5\x7e\x99\xef\x99\x3c\xc7\xc1\x6d\xda\x2d\xb1\x52\xd7\xea\x1a\x6c\xd9\xbb\x00\xf5\x6e\xfb\xc5\xcb\x17\xcf\x5f\x6e\x75\xb7\xb6\x77\x9f\xf7\xb6\xba\x3b\x2f\x48\x73\xcb\xde\x55\xf7\xb5\xd3\x81\x0f\x41\x28\xa6\x07\xde\xb0\x33\x05\x69\x8f\xdb\x39\xc1\xaa\x46\x4f\x17\xfc\xa9\x35\xda\xf3\xe9\x22\xaa\x77\x1b\x30\x73\x56\x54\xce\x88\xa6\xc1\x9d\x1a\x49\x01\x99\x9d\x34\xf4\x63\x89\x77\xeb\x65\x74\xf5\xd7\x09\xa1\x22\xae\x24\x1e\xe3\x2d\x48\x04\x4e\x48\x28\x5f\x0e\xb9\x6c\xaf\x46\x85\x0b\xfb\x7d\x94\xf5\x4b\x50\xa1\x45\xe8\xe9\x1a\xb5\xf8\x5a\x7c\xde\xa1\x78\x45\xb1\xf6\xfc\xf8\x2f\xce\xd4\x73\x9d\x38\x08\x4f\x02\x8e\x55\xe6\x80\x81\x90\x34\x12\x71\x84\x75\x8f\xf0\x62\x43\x86\xf4\x57\x2f\x9e\x30\x58\x96\x68\x52\xfd\x73\x47\xd5\x7e\xe9\x30\xc6\x01\xfa\xa7\x31\xb5\xc8\x38\x74\xe6\x13\x8f\x0a\xfc\xab\x56\x44\x86\x8b\x10\x45\x4e\x37\x48\x84\xbc\x31\xf1\xb9\x3c\x67\xd1\x9e\x39\xb7\x8e\x37\x75\x06\x53\x4d\x9f\xde\x9d\xff\xc7\xd9\xc5\x29\xf0\x7b\x10\xf3\x22\xaa\x32\xd8\x4a\x71\x0e\x15\x70\x41\x5e\x98\x1b\x3a\xd3\xe1\x62\x8a\x61\xca\x50\x98\xc3\x52\x8b\x68\x0f\x1c\xfa\x11\x8a\x12\x01\x05\xf5\x79\x11\xc4\x1e\x5d\x99\xf5\xcf\xd0\x07\x07\x3a\xe0\x37\xe8\xb4\x09\x60\x54\x88\x1e\x0e\x83\x50\x96\x12\x87\x41\x18\x92\x68\x1e\x30\xd1\x31\x23\x5b\x2a\xdb\x08\xc9\xcc\xf1\x28\xf7\x82\x7a\x58\x00\x1e\xed\x41\x88\x2d\xb7\xc0\x87\x67\xf0\x39\x0f\x41\x2f\x12\xda\x39\xe0\x5e\x04\xf3\x20\xf2\x62\x7a\x6a\xf0\x46\x88\x29\x95\xba\x5d\xe2\xbb\x68\xa1\x8e\xe9\x1c\x6e\x89\xc5\x0d\x56\xd3\x37\x06\x51\xb2\x9b\x6b\x63\xe2\xb0\x03\x62\x44\x0f\x82\x91\x37\xf6\x81\xbf\x10\x2d\x15\x60\xc9\x0f\x06\x8b\x90\x27\x0f\xfd\x8c\x66\x33\x74\x3e\xba\xf1\xe9\xe9\xdc\x89\xa0\x16\x87\x0b\x7f\x88\xa3\x23\x4e\x0d\x35\xdc\x19\xbd\xc8\x04\x9c\xca\x3e\xb7\xce\x94\xce\x65\x1c\x40\xdd\x81\x1f\x70\x2a\x7d\x49\xbf\x69\x10\x6d\xb7\xd6\xe9\x68\x14\x84\x50\x3f\x5b\xc5\x93\xc0\x87\x1f\x8a\x52\x7b\x2a\x69\x3e\x57\x74\x79\x36\x9f\x12\xae\xee\x98\x10\x38\x3a\x3c\x3c\x84\x17\x3b\xdb\x52\xd3\xe2\xd4\x5d\x80\x7b\xf8\xd3\xbb\xe3\xa3\xf7\xf4\xf3\x4b\x38\x5c\x0c\xa7\x9e\xeb\x39\x7e\x7a\xb2\x82\xcf\x2c\xc0\x89\x5f\xf7\x1b\xf0\x0c\x50\xa9\x58\xa7\x14\xed\x0c\xa2\xba\xdf\x28\xe2\x99\x9d\x9a\x1c\x29\x39\xd3\x3b\x67\x95\x52\x54\x19\x59\xd2\xfa\xc5\x79\xb3\x18\x16\x99\x37\x45\xcc\x71\x72\x8b\x03\x91\x6f\x82\x0e\x1f\x63\xf9\x09\xcd\xe0\xe2\xa7\x67\x4e\x69\xbd\xa7\xe7\xcf\x42\x87\x0f\xa6\xf1\x24\x58\x8c\x59\x50\x3d\xd4\x9a\x64\x57\x6f\xf6\x10\x4d\x81\x5b\xb4\xe8\x0a\xb7\x3b\x3f\x88\x61\x4c\xd7\xd6\x22\x22\xa3\xc5\x14\x42\x12\x2d\xa6\x85\x48\x54\x1a\xa1\xfa\xe3\xe9\xfb\x9f\x8e\x4f\x85\x48\x5d\x41\xcc\x7a\xb9\xe6\x39\xbc\xa8\x91\x65\xa1\x20\x08\xc7\x53\x7c\x8b\x83\xb3\xe0\x8e\x84\xda\x41\x4a\xdb\x39\x1a\xc1\xd9\xe9\x5f\x7f\x3b\x3b\x3f\x7c\x77\xf4\xe9\xe8\xf4\x84\x12\x85\x6d\x71\xf7\xcb\x3b\x6f\x3a\xc5\x31\xf2\x51\x41\x48\x5c\x05\x02\x6a\xc8\x59\x98\x7d\xa6\xe5\x34\x8d\x44\x99\x8c\x35\x62\xa7\x6c\x71\x83\xcb\x8c\x6e\x90\x30\x06\x2b\xec\xb2\x42\xb1\xd4\x4e\x0e\xe7\x33\x12\x4f\x02\x4d\xb4\xc3\x0f\xa7\xe7\x1f\x0f\x2e\x94\xb7\xdb\xe2\xe1\xaa\x82\x4f\x64\xee\x84\x74\x0f\xde\x83\x5a\x5b\xa1\xab\x16\xcf\x38\x0c\x16\x73\xb9\xb0\x55\x5a\x18\x73\x17\x6e\xe9\x4b\x45\x64\x18\xf8\xae\x13\xae\x7e\x4c\x8b\x6b\xb4\x6c\x80\xd7\xa3\x0e\x32\x97\x1f\xf3\x98\xfc\xb2\x3c\xb0\x6b\x56\x32\xb2\x7e\xe0\xb7\x06\x21\x71\x6e\xe8\xda\xc0\xbc\x5d\x15\x41\x32\x04\xd4\xd7\x88\xf9\xc0\x0a\x50\x49\x3f\xb7\xf1\xa3\xd2\xd1\x51\xe9\xed\xf4\xe4\xd3\xc5\xf9\x4f\xef\x2e\x4e\xcf\x95\xf8\xe4\x55\xe0\x4a\xa5\x2c\xf2\x2c\x76\x03\x48\x59\x50\x91\x65\xab\x15\xbc\x54\xb8\x16\x39\x64\x99\x1f\x6c\x0a\x9d\x5f\x0b\x4b\x20\x64\x68\xbe\x88\x69\x77\xcf\xdc\x11\xee\x93\x8a\x18\x6a\x93\x5f\x5d\xe1\xf9\x22\x83\xc5\xe5\xe0\x2a\x8d\x86\x47\xbb\x33\x70\x22\x6c\xda\x6f\xc3\x11\xd3\x3c\x5a\xd0\xa3\x0b\xed\xf9\x76\xaa\x76\x34\x6b\xc4\x13\xdd\x73\x7a\x4e\x00\xdf\x82\x81\xd2\xde\x00\x84\xd1\x8d\x05\xc4\x02\xcf\xa2\xb8\x5a\x30\x25\xbe\x05\x51\xac\xb8\xf5\x12\xcf\x92\x07\x40\xd1\xc7\x9f\x3c\xf4\x31\x56\x83\x3c\x2b\x8b\xc8\x19\x13\x54\xa4\x06\x8b\x98\x8e\xb6\x46\xf2\x1e\x41\x1d\x9e\xd4\x61\x99\x4c\x46\x30\x92\x66\xa2\x01\x85\x40\x1a\xb9\xa6\x6b\x69\xcf\x1b\x19\x04\xa8\x20\x2d\xb7\xbf\x07\xbf\xfb\x5f\xd5\xae\x80\x02\x11\x76\x56\x68\x40\xe8\x78\x11\xa9\x43\xef\xb9\x05\x35\x13\xc8\x9a\x05\xbe\xca\x8f\x50\x3c\x9c\xd8\xb2\xe7\x38\x3e\x3f\xba\xfb\x7d\xdd\x10\xd7\xb2\x50\x1a\x8c\x7a\xf0\x32\xd2\x17\x8a\xeb\x3d\xf8\x7d\xa0\xe9\xa2\x16\x02\xed\x4a\x30\x62\xca\x6d\x43\x7d\x9c\xa7\x01\xf4\xfb\xe0\x2f\xa6\x18\xd1\xe2\x49\x7a\x68\xaa\xc3\xc0\x82\x9e\x05\xcf\xb7\x2d\xf0\x5c\x0b\x6a\x14\x72\xad\xc2\xe4\xbd\x5f\xcc\xa7\x74\xa3\xd0\x85\x32\x12\x0d\xfb\x3a\xea\x30\xbb\xd8\x2f\xdb\x11\xf4\xc1\x6f\x1b\x62\x78\xb1\x62\x04\x8b\x69\x42\xd5\xa4\xc5\x86\xd0\x47\x64\x68\xe1\x21\x34\x60\x9f\xc2\xc6\x70\x40\x0d\xd8\xd3\xc5\x77\x4f\x3a\xe2\xea\xa3\xb0\x8b\x87\x11\xcc\x46\x21\x77\x70\x9c\xea\x74\x49\xa7\x61\x4c\x7c\x34\x56\x62\x2c\x87\x4e\xc7\xd3\xa7\x78\xb2\xb1\xd1\x24\xb5\xe2\xe8\x75\xe9\x41\x0c\x5e\x83\x0d\xfb\xbc\xef\x2d\xdf\x82\x56\x17\x68\x97\xbb\x86\x20\x48\xc0\x95\x1b\x4e\x14\xc3\xdc\x89\x27\x28\x1d\x8a\x1b\x16\xfd\x7c\x43\x3a\xe7\xfd\x7e\x1f\xfe\xfe\x77\xbf\x14\x51\x10\xe6\x39\x80\xee\xda\x16\x46\x42\xf2\x5f\x81\x07\x6f\xa8\x54\x43\x3f\x74\xfa\x78\x91\x4b\x9a\x4d\xd3\x7a\x4d\x7b\x4e\x01\x95\x90\x03\x24\x24\x71\xe9\x97\x44\x47\x83\x8a\xd3\x0f\x15\x48\x00\xcc\x64\x40\x9f\x28\xa6\xe7\x58\x1f\x9a\x4a\xb7\xb4\x04\x48\x95\x20\x15\x8c\x3d\x27\x06\x19\xed\x98\x44\x71\x3d\xd3\x00\xae\x72\xce\xe8\x64\xb3\x8e\x3a\x2c\xd9\xb6\x82\x24\x59\x16\x05\x0e\x49\x2d\x8a\x43\x39\x24\x08\x1a\xd6\xf7\x61\x7b\x07\x29\x8f\xb5\x49\x8b\xb0\x15\xd7\x6d\xc8\x54\xa8\x1f\xa9\x8d\xba\x3e\x80\x3e\x0c\xf4\x41\xa0\xa1\x30\xc8\x26\x26\x77\xe8\x47\xa8\xcb\x61\x63\xc4\x34\xcc\x5e\xc4\xce\x3b\x04\x83\x7e\xe7\x2e\xdd\x1c\x76\x01\xc9\xcf\x45\x94\x97\x1a\xd6\x4b\xa7\x03\x07\xd3\x69\x70\xa7\x54\x48\x53\xe0\x03\xa1\x99\xa1\x20\x91\xe5\x77\xed\xe4\xa6\xbf\x84\xef\x22\xc3\xef\x56\x60\x15\x85\x6b\x60\x1d\xcb\xde\x07\x1f\xf6\x70\xf0\x4a\x48\x82\x0f\x17\x8e\x12\xd2\x52\x6e\x90\x9a\xb8\x4a\x9b\xf4\x04\x97\xbd\x27\xd9\x2c\x62\x19\x1d\xc6\xdb\xc0\x73\x61\x1e\xc4\x7c\x10\x31\x75\xf8\x3c\x24\x7c\x28\x83\x51\xaa\x14\xa6\x92\xe4\x89\x73\x42\x67\x0a\x47\x74\x7b\xbb\xc9\x2f\x7f\xcd\x13\xe5\xaf\xc4\x41\xd1\xf3\x33\x13\x46\x8f\x4e\xec\x28\x37\x72\xbc\x29\xb8\x0b\x22\x54\x5c\x97\x87\xe4\xea\xb2\xd9\xba\x2a\x99\xa9\xaa\x9c\xff\x09\xe5\xfc\xf7\x7a\x03\x7d\xfa\x3c\xa9\xe3\x64\x9e\x93\xf1\xe1\x72\x5e\x87\xda\xaf\xad\xfd\x1a\x34\xa1\x0e\x94\xd9\xd5\x2e\xe9\x67\x61\x51\xc4\x17\x23\x65\xba\x54\xe6\x6c\x42\xed\xaa\x49\xdb\xd3\xc7\x68\x10\x4f\xad\xbe\xbf\xf7\xcb\x2f\x6d\x8c\xfa\x40\xeb\x35\xf6\xff\x54\xb3\x06\xf0\x1a\xb6\x5e\xc0\x3e\xd4\xbc\x1a\xec\x71\xee\xc2\x98\x4e\x14\x87\x8d\x52\x3a\x2c\xe3\x43\x06\xc9\x0b\xca\xb7\x57\x7f\x31\xdb\x64\xcf\x34\x73\xae\x72\xf6\xcb\x35\xd8\x4f\x9f\x22\x8c\x90\xe0\x65\x78\x1d\x3a\xbf\xda\xbf\xb4\xed\x67\xf7\xbf\xb4\x3b\x16\x8e\x94\x88\x7a\xfb\x06\xba\x3b\x66\xc9\x4b\x3c\x2a\xa1\x70\x4d\x6b\xb3\x12\xa9\x5a\x3c\x5c\xa2\xf6\xf0\x36\x5e\xb2\x6f\x2b\x11\xa0\xa1\x7c\xb3\xeb\x74\xe0\x2c\x24\xb7\xc4\x8f\x61\xea\xc4\xf4\x68\x98\x98\x92\xf3\xf1\x08\xd0\x05\xf6\x96\xe0\xf9\x90\xdb\x41\x1a\x41\xb2\x95\xa4\x71\x85\x4c\xd0\xaa\xb2\x7d\x7e\xc3\x7d\xad\x64\x63\xe2\x3d\x7e\xeb\xd0\x61\x47\xf2\xa7\xf2\xcf\xc0\x42\x94\xd6\x3e\x7c\xbc\x17\x76\x18\x81\xe7\xc7\xfb\xfa\x03\x02\x93\xc0\x68\x67\xd0\x5b\xf5\x74\x54\xaf\xb5\x6b\x74\xd9\xbe\x61\x7d\x4a\x3b\x9b\x90\x71\xad\x5d\x63\xe4\x6b\x38\x5d\xe6\x18\xa5\x11\x01\x4f\x8c\x26\x71\xc2\xe1\xa4\x0e\x1d\xd2\xf1\x80\xa1\x60\x97\x9f\x47\x48\x4c\xc2\x99\xe7\xa7\x37\xc2\x25\x6c\x97\xe0\x2a\x6f\x60\xb7\x3d\xfd\x74\x11\x68\xf6\xa1\x99\x4e\x32\x78\x74\xe7\x32\x51\xbe\x44\x16\x8b\x01\xd3\x33\xd4\x99\x68\xab\x9b\xbc\x34\xfa\x45\x82\x95\xb9\xb3\x5c\xe7\xa0\xef\xa1\x98\x4b\x53\x04\x52\x13\xcd\x88\xb1\x9c\x12\x07\x15\xbb\x5f\x48\x18\x68\x36\x48\x26\xbe\xf3\x08\xa6\xb9\xf5\xe2\xf1\xf5\xb2\x8b\xa1\x4c\x4d\x84\x92\x36\x19\x87\x8e\x37\xad\xd4\xe6\x94\xf8\xd9\x6e\xe6\x9b\x6f\xb5\xa6\xc4\x4f\x51\xd0\x05\xe7\xc8\x2d\x63\xf0\x50\xb3\x22\xa6\x59\x4b\xb1\xb8\xad\xe9\x99\x48\x01\x3b\x33\xf9\x7a\x91\x83\xb2\x20\xe3\x72\x42\x8c\xbc\x25\xa1\x9a\x79\x53\x0c\x24\x7e\x6f\x94\x5d\xbe\xf1\x46\xc1\x8e\x7c\x8b\x19\xdd\xe6\xd2\x0d\x8f\x76\x9f\x6f\x67\xfa\x1d\x84\x72\xb4\x67\x6c\x1f\x31\x92\x32\x81\x16\x78\xd0\x32\x6e\xba\x9d\x0e\x9c\x72\x2b\x00\x35\x97\x81\x74\xe5\xbf\x49\xae\xd6\x4b\x37\x5b\x5c\x6d\x4a\xd3\x9f\xdc\xc3\x0e\x94\x5c\x21\xb1\x98\x4e\xcb\xcf\xd7\x3f\x09\x63\x0c\x3d\xbe\x79\xd6\x20\xcc\x2b\xaa\xa0\xfd\x9f\x45\xb3\x35\x25\xca\x97\x1c\x69\x1b\x0c\x67\xe1\x8a\x7b\x66\x85\xc3\x37\x6f\xd4\x94\xa9\x80\x77\xe0\x22\x74\xfc\x08\xc5\x6b\x2a\xa9\x97\x16\x27\x22\x52\xa2\x38\x29\x95\x6f\x04\xbc\xa6\x47\x6b\xde\xe1\x9d\x4c\x1c\x00\x72\x01\xe4\x0a\x71\x00\x63\x12\x33\x3f\x35\x8c\x95\x40\xd8\xad\xa3\xb8\x07\x1a\x06\x64\x34\xf2\x86\x78\xf5\x6d\x0a\x13\xce\x1f\x0f\x75\x50\x84\x31\x16\xf8\x21\xb1\xaf\x2f\x51\x3e\xc9\xdb\x82\x47\x77\xa3\xb4\x5e\x79\x45\x8f\x05\xaf\xa9\xa4\x88\xa1\x15\xbc\x06\x9d\x20\xe6\x58\x99\xd9\xf6\xd8\x06\x66\x5c\xa9\xe2\x49\xb9\x73\x4b\x42\x56\xa0\xf2\xaa\x12\x2e\xc0\x28\x45\x81\x88\x67\x65\x47\x81\x21\x55\x06\xab\x44\x14\x05\xc5\x3e\xe0\x55\x00\x4b\xa7\x34\x41\xa4\x55\xba\xe5\x26\xd8\x54\x59\x4d\x0c\x7e\xab\x8f\x83\xf6\x10\x39\x9b\xcd\xc7\x2b\xf0\x5a\x2d\xdc\x22\xe9\xe8\xd5\xec\x5a\xb9\x66\x47\x1e\x7e\xf3\xe1\x4b\xf9\x56\x74\xd2\xc4\xb2\xcd\x4c\xaa\x22\x83\xd2\xf4\x5f\xa7\xb7\xfb\x5a\x7a\xa5\x05\x67\xe7\xa7\x67\x87\xe7\x17\x47\x87\x9f\x14\x65\xd3\xbb\x50\xee\x36\x00\x7d\xe1\x40\xa0\x58\x1d\x69\x69\x54\x76\xfc\xf6\xd3\x99\x1a\xab\x7c\x39\xb4\x1f\xe9\xab\x4e\x12\xf9\x92\x68\x30\xdb\x07\x45\x50\x90\x7c\x49\x66\xaa\xd1\x07\x45\xd8\x88\x7c\x51\x61\x81\xdc\x07\x85\x8b\xbf\xb2\x30\x47\x78\xa7\x62\x71\xb4\xed\xe8\xc3\xf3\x8a\xc5\x79\x27\x5f\x54\x2c\x2e\x7a\xaa\xf0\x58\x4d\xcb\x73\x5b\x90\x3e\xbc\x54\x5f\xaa\xa6\xaf\x30\x55\xcc\xc8\x1b\x2f\x42\x02\x9e\x3f\x0a\x31\x86\x44\x3c\x5d\xb5\xd0\x49\x80\x4a\xab\xdc\xeb\xaf\x75\xe7\xb9\x04\x22\x12\xc7\x9e\x3f\x8e\x74\xf7\x90\x07\xc3\x21\x99\xe3\xf5\x0f\x77\x7e\xc5\xbb\xd0\x44\xb5\x07\x53\x2f\x8a\x2d\x2e\x02\xfa\x68\xde\x35\x73\xfc\x95\xd8\x76\x12\x9f\x69\xe0\x19\xd3\x3c\x12\x41\x10\xca\x0d\x60\x02\x2f\x2a\x52\x47\x10\x92\x68\x4e\x86\xb1\x77\x4b\xa6\xab\x3d\x0d\x3e\x05\xe7\x81\xf4\xb6\x33\xbd\xdf\x14\x86\x04\xe9\x05\x67\x16\x44\x56\x97\xa7\x07\xb1\xab\x03\x90\xb3\x74\x4e\xee\x6a\xd9\x7f\x97\x57\x32\xa4\x16\x45\x24\x8f\x4f\x6e\x10\x2a\x3d\x97\xfc\x5e\x23\x81\x68\x23\xbc\x76\xae\xbf\xed\xab\x5c\x67\xd1\x4a\x37\x7d\x14\xb8\x9e\x04\x7e\x0b\x4d\x6c\xbd\x6f\x8f\x74\xab\x2b\xb0\x16\xbf\x74\x4d\xd8\x73\x31\x3d\xc5\x9e\xfb\x44\xde\x27\x53\xc6\xed\x3c\xb9\x01\x62\x97\x92\xa0\x9d\x85\xc1\x8d\x14\x1f\x04\x43\xb6\xe8\x81\x0c\xc9\x24\x0f\x33\xe4\xd1\x91\x4c\xd6\x10\x46\x0f\xc0\x40\xb6\xdc\x40\x25\xed\x07\x5b\x92\x32\x88\x4f\xc4\x6c\x00\x53\x98\xbf\xbc\x45\x8b\x00\xcd\xb4\x01\x5f\x0b\xe5\xb3\x26\x2d\x29\x2a\xe6\xf2\xde\x17\x92\x25\x0b\xd1\xfb\x42\xf9\xa2\x7d\x8b\xb9\xbc\xda\xc0\x45\x8f\x4f\xc1\x7a\x45\x8b\x8f\x5c\xb5\x2e\x39\x5e\x39\x11\x3d\x77\xb2\x3b\x95\xd4\x89\x88\xcf\x0d\x67\x92\x12\xb7\x73\x42\x76\x81\x8e\x1a\x43\x6e\xbe\x86\xb1\x5c\xe8\x31\xad\xa1\x69\xef\xb0\x3d\xce\x58\x75\xe4\xdd\xb8\xea\x3d\xdb\x82\x6d\x34\x2e\xcd\xd8\x41\x1a\xeb\xfc\x9e\x67\x9d\x7b\xe8\x77\x95\x65\x86\x7b\xb0\x0d\x5f\x75\x68\x1d\x8d\x7d\x7a\xfe\x4e\x7b\xd7\x91\xb8\x77\x44\xd0\x12\x13\x6f\xee\x83\x30\xcd\xd9\x96\xe9\xc8\x39\xb7\x8c\x49\xb6\x93\x34\xe9\x6d\x3a\x64\xc3\x45\x18\x26\xd6\xf3\x91\xd9\x36\x25\xdf\x4b\xe8\x57\xc8\xf8\x83\x61\xcb\x2d\x98\xeb\xad\x51\x3c\xbd\xf3\x22\x7d\x42\x8c\x01\xa1\xff\xdd\xc1\x40\xe5\xdc\xcf\x55\x5f\x2c\xa0\xc5\x2e\x6d\x85\xef\xae\x78\x26\x4e\x04\x7d\x08\xe0\xe9\x53\x71\xe9\x12\xc8\xb1\x41\x8c\x52\xf1\x7e\x76\x24\xd8\x29\x2b\xc8\xc5\xa1\xa8\xcf\xa5\xab\xd5\x3a\xdc\xd2\xd6\x2e\xe7\x57\xd0\x80\x27\x5c\x39\xa0\x91\x99\xc5\xb3\xa7\x6a\xc5\x49\xaf\x09\xbc\x3c\x78\xe7\xd2\x6b\x36\xb3\x0d\x18\x94\x6d\x59\x8a\x4d\x98\xa6\x61\xab\xd7\x5a\x38\xd6\xf8\x3a\x68\xe4\xa1\x16\xec\x5b\x6e\x0d\xf6\x2d\x3a\x20\x39\x13\x17\x1d\x08\x1c\x9e\x89\x13\xd5\x61\x0e\x7d\xa8\x65\x81\xf0\x4b\x34\xd9\xe6\xe5\xd6\xa2\xe7\xd9\x8f\x07\x3f\xa3\xed\xcb\x1c\xcc\xd7\x53\x05\xc7\xca\x5b\xfd\xfd\xb2\x7a\x5a\x43\x3a\xf9\xfd\x1c\x20\xfd\xfc\x64\x99\x87\x66\x7a\x76\xd7\x9a\x9c\x2c\xc8\xcd\xe6\x26\x0b\x63\xb3\xa9\xc9\xc0\xd0\xce\xcc\x6e\xa5\x79\xc9\x3b\xd9\x6d\x3a\x2d\x19\x38\x86\xbb\x8c\xac\x78\x5a\x90\xf8\x74\xf5\x4a\x65\x56\x9d\x0c\x8a\xbf\xab\x16\xa3\xe6\x46\x39\x33\x59\x39\x6c\x37\x9b\xf1\x1c\x90\xcd\xa6\x3c\x0b\xa4\xd4\xb6\x8c\xa9\xad\x22\x8c\x4f\x56\xbf\x2d\xbf\x38\xe6\xe5\x25\x12\xa2\xbc\x1f\x87\x12\x69\x89\x13\x52\x9e\xce\x2e\xbb\x57\x6b\x31\x01\xf1\x64\x3c\x18\x69\x53\x66\x5b\x93\x5c\x35\xe6\x22\x48\x1b\x2f\xaf\xa6\xdf\x21\x64\xad\x70\x6e\xf1\xb0\x6e\xaf\xd3\xa9\xac\x4b\x66\x3d\x8b\x29\xdd\x5c\xd8\x65\x78\xeb\x16\xf6\xe0\x16\x30\xaa\xf8\x06\xba\x20\xd3\xfa\xbb\xd4\x84\xbe\x00\x9d\x8e\x38\xf1\x92\x2c\x9e\xba\x36\x39\x74\x65\x21\xeb\xce\x56\x7c\x35\x2a\xcf\x57\x95\x57\x25\x43\x7b\x43\xf6\x8b\x75\x87\x8e\x4f\xab\x0f\x08\xde\x8f\xad\x57\x77\x43\x96\x4d\xeb\x7e\xdf\x65\xdb\xea\x9a\xd7\x6d\x77\xfd\x75\x9b\x3a\xa6\x56\x5f\xb4\xa9\xff\xe5\x3f\xd4\x8a\x45\x28\xb7\x6c\x21\xca\x0e\xb7\x75\x09\xe1\xf5\x17\x2e\xc8\x59\x18\xf2\xe6\xd4\x14\xb1\x26\xd4\x72\xd4\x57\xb3\x28\xe0\x47\xe6\x05\x79\xb7\x67\x13\x17\xe0\x7a\x8c\x82\xea\x41\xa1\x78\xa8\xb2\x65\x32\x68\xc6\x28\x51\x6b\x6c\x7b\x08\xad\xda\xba\xb9\xc5\x3b\xea\x27\x4f\x6e\xe1\xfe\x9e\x7f\xe9\xa6\x1f\xcb\x8d\xf8\xaa\x58\xa8\x66\x1c\x65\xeb\xa9\x43\x2f\x6d\xb5\x01\xfb\x1a\xe7\x58\xd8\x53\xba\xe7\x96\x5e\x14\xca\x44\x54\x62\xf8\x95\x21\x31\x1e\xae\xeb\xf1\x09\x8b\x21\xa3\x27\x25\xae\xce\x7a\x24\x52\xe2\xd0\x36\x25\xa5\x0c\x2c\xe6\x62\x0c\x0b\x3f\xf1\x1a\xde\x83\xdf\xd9\xcb\x6a\x94\xc8\x90\xf9\x3e\x94\x98\xb8\x2e\x3f\x79\x42\x81\x3d\x7d\x2a\xd0\x4f\xcf\xda\xfc\x85\x1c\x8c\xb3\x84\x2d\x71\xcc\x9e\x3e\x85\x27\x1c\x7e\x6a\x6a\x20\xf3\xa8\x5a\x71\xa8\x6a\x22\x72\x9d\x91\x9c\xfe\x41\x69\x96\x75\x56\x4f\xb3\xb2\xfa\x54\x73\x4a\x7c\xb9\xd6\x29\x51\x06\xb8\x99\x90\x22\x43\xd8\x4c\xdc\x90\x20\x68\xcf\x87\x2f\x2b\xed\x95\x59\x7f\xd1\x4d\x4f\x87\x12\x14\xfd\x4c\x64\xf5\xd0\x8f\xa4\x50\xc9\x02\xdd\x6c\x3e\xb2\x30\x36\x9b\x91\x0c\x8c\x07\x6b\x53\xf2\xbe\xab\x9b\xce\x4b\x06\x8e\x7e\x66\xb8\x0a\x59\x68\xf5\xcb\x47\x8c\x57\xe0\xc3\xcd\xaa\x55\x1f\x2b\x56\xbb\x1a\xaf\xe5\xfc\xf0\x36\xcb\x0a\x4b\x58\x4f\xe2\x4f\x7b\xfb\xcd\xf8\x59\x2d\xdb\xf9\x6f\x20\xe5\xb1\x4e\x68\x26\x4d\xb8\x0f\x28\x2e\xee\xcb\xaf\x69\xcf\x65\x27\xd1\xd4\x77\xe1\x6e\x12\x44\x24\x75\xe0\x88\x25\xf7\x6f\x7e\xa9\x9a\x28\x96\x75\x77\xb6\x69\x84\x45\xad\x0f\x69\x45\x9d\xfa\xcc\x59\xe6\x15\xea\xa2\xd3\x33\x67\x79\x1a\x7e\xf4\xfc\xba\xa4\xe8\x86\xb3\xf6\x34\x86\xc6\xab\x47\xee\x3e\x8f\x17\xf4\x07\x74\xdf\xf3\xd7\xec\xfe\xf8\xa1\xdd\xf7\xe2\x09\x38\x22\x24\x0b\x1b\x06\xf2\x79\xe1\x4c\x29\x57\x0e\x42\x18\xa3\x93\x71\xc8\x4c\x20\x6d\x74\x11\x99\x92\x28\xe2\x26\x91\x56\x66\x18\x7c\xee\x8e\xe3\xce\x2d\x5a\x35\xa7\x1b\xf6\x46\xe0\xce\xe9\x10\x07\x33\x2f\x8e\x89\x6b\xe5\x63\xfb\xd5\xd1\xb8\x3d\x42\xfb\x4d\x61\xe9\x2a\xc3\x47\xa3\x57\xbc\xde\x9a\x87\x81\xbb\x18\x12\xb7\xa1\x9b\x91\x4b\x77\x2e\x79\x25\xbf\xcf\xb4\xd3\xae\xb8\xf5\x64\x00\xd6\xd8\x00\xd5\x1b\x79\xa4\x0b\x5b\x8f\x3b\x97\xb9\xa1\xa1\x66\x6e\xc3\xd1\xd7\xab\x28\xe4\x1a\x09\x8b\xcf\x6f\x1f\xea\xd5\x2e\xab\xe6\xc1\x5d\xef\xb7\x9d\x2d\x0c\x39\xdb\xb3\xe5\xc7\xa0\x77\x12\x14\xb6\xb3\x05\x03\x2f\x4e\xd4\x41\xbe\xc5\x0d\x08\x6d\x78\xdd\x47\x0f\x92\x97\xb6\xfd\xa2\xfb\xf2\x65\x6f\x67\xfb\xc5\xb6\xfd\xf2\x65\x4f\xbb\xe1\xbf\x43\xaf\x07\x6f\xc4\xc2\x18\x27\x23\xc2\xe7\x5f\xb6\xce\xdd\xea\xd1\x36\x23\x3e\xa2\x6e\x30\xf3\x49\xa4\x8f\x6f\x75\x34\x02\x2f\x06\x37\xc0\xe8\x8b\x51\xb4\x98\x61\x74\xb4\x29\x71\xa2\x98\x63\x9f\x25\x34\x8b\x79\xad\xdd\x79\x11\x29\x94\xdf\xb2\xb1\xbc\xb6\x2d\x7b\xb9\xcd\x87\x8e\x12\x7f\xef\xd7\x2d\xdb\xc2\xe4\x43\xe9\x9b\xde\x96\x95\x84\x07\xe6\x6f\x30\x88\xaf\x1a\x24\x9d\x1e\xd6\xc5\x9d\xad\x81\x17\xf3\x43\x6b\x76\x80\x9e\x89\x09\xe4\xc9\x38\x11\xb4\x76\x97\xca\xdf\xdb\x25\x1c\x87\x87\x91\xae\x83\x06\x3a\xb2\x1e\x2d\xd8\xfc\x45\x9d\xb8\x94\x2b\xe0\x2a\x8d\xd0\x3d\xd8\xec\xcd\x2e\x4f\x6d\x69\x76\xb9\x52\x80\xda\x4d\x01\xe9\x2f\xfa\x44\x5e\xb0\x04\x3f\x77\x6e\x4e\xd9\x1c\x82\x83\x7e\x26\xc4\x82\x1b\x0b\x6e\xf5\xf7\xa7\x50\x7e\x97\x4b\x1f\x66\xe7\x6b\x2e\x43\xbb\x55\xf0\x40\x3c\x3d\x39\x34\xd9\x99\xba\x98\x36\x64\xae\xf5\x68\xa7\xac\x59\x08\xa4\xdd\x6d\x54\x6e\x14\xee\xe8\xdd\xb9\x59\x9d\x77\x03\xfd\x24\x10\x39\x85\x08\x1d\xc9\xe0\xd4\x80\x1b\x95\xb9\xd8\xb1\xad\x8a\x89\xf6\x5b\x16\xd5\x3f\x82\x68\x31\x9f\x07\x21\x46\xdd\x62\x4c\xaf\x3d\x26\xf1\x39\x4e\xf9\x5f\x4a\x9c\x13\x45\xab\xd2\x41\x5b\x0d\xa2\x9a\x63\x99\x03\x7d\x4d\x7d\xe6\x5a\xf8\x93\xe7\xc7\x5b\x3d\xa6\xe6\x85\x1b\x78\xd6\x87\xde\x5a\x66\xc1\xcc\x0e\xf8\xe6\x55\x35\x6c\xf8\x38\x71\x76\xb5\x57\xb5\x3c\x5f\x7e\xf3\xe0\xae\xde\xb3\x60\xab\xd7\xa0\x9c\x86\x2e\x1d\xf9\x75\xaf\xdb\x68\xa4\xc9\xaa\x7b\xea\x18\x7f\x0a\xe0\x5d\xfa\xb0\xbf\xda\x0f\x6c\x7d\x8a\xed\x24\xf9\xf0\x40\xfc\x31\x11\x7a\x77\x23\xa4\xab\x3c\x9a\xfe\x54\x6d\x82\x6f\xa2\x82\xb9\x9b\x29\x56\x3c\xdc\x76\xe1\x0a\xd9\x1a\xab\xdf\x84\xba\x73\x89\x5e\x56\x57\xa2\xc7\x15\xa8\x0b\xc4\xfe\x4c\x8f\x2c\x94\xdd\x45\xce\x6c\x3e\x2d\x24\x96\x31\xe1\x4f\x77\xee\x5b\xc5\xce\x5d\x15\xc0\x59\x18\x0c\x9c\x81\x37\xf5\xe2\x15\xdd\xaf\x63\xb8\x85\x37\x7d\x78\x49\xba\x3b\x56\x21\xec\x9a\x1e\x4a\xa6\x69\xe8\x14\xb0\x81\xbf\xf7\xc1\x6e\xdb\xb6\xbd\x6b\x81\xd7\x26\x6d\xe8\x82\xe7\x43\xb7\xb7\x53\x6d\xa2\xb8\x2e\x8d\x23\x56\xd9\x16\x1f\xb8\x6b\x7d\x55\xd6\xd0\xab\x64\x95\x2f\x1e\xa4\x80\x3e\x0c\x2e\xed\x0a\xe1\x18\xa4\x4a\x8c\x48\x68\xc5\x6e\xc5\x8a\xe5\x46\xe9\xf9\x47\xa2\x8c\x3e\xec\xbe\xcc\x3e\x6b\x43\xa9\xdf\xc2\x0f\x98\x6e\xa1\x41\xbf\x6d\x08\x4c\xd8\xe5\x0b\x58\xeb\x0c\x35\xfa\x4e\x68\xf2\x47\xe6\x1f\xb3\x8d\x52\xb5\x12\x54\x4e\xb8\x81\x0e\x6d\xb2\xb4\x6c\xa7\x93\x24\x2a\x2a\x6e\x88\x4c\x00\x7a\xbb\x8a\xcb\x36\x43\xf9\xae\xad\xb0\x27\x4a\x50\x2a\x3b\x5a\x0f\x16\xa3\x91\x22\xf5\x4e\xfe\x91\x76\x4e\xa9\x15\xbe\x49\xbe\xf8\xd6\x5b\xa4\xbd\xec\xca\x27\x18\xc6\x85\xb7\x77\xad\xec\x0f\xfc\x75\xe5\x8d\x48\xaa\xcc\x05\xfb\x9e\x04\x91\xb3\xfa\xed\xc7\xdc\x39\x1f\xba\x09\x3d\x88\x89\xdf\xe2\xd5\x58\x9d\xf1\xa3\xa7\xb0\xd5\x05\x26\x6f\x67\x87\xb6\x81\x11\x15\x12\xf6\x93\x2b\x50\x29\x84\x82\x78\x04\x98\x5e\x0e\x4c\xa6\x8d\xad\xcc\x8f\x1b\xc1\xdf\xbe\xc2\x34\xa2\xcf\x33\x70\x77\xf0\xe5\x2e\xbe\x63\x6f\x9e\x97\x79\xe5\x89\xe7\x01\xbb\x88\x62\x89\xbc\x68\xb4\x87\xc1\x7c\x55\xa7\xe7\x0f\xad\x97\x74\xfe\xd9\x9c\x93\xff\x63\xf0\x60\x85\x27\x8b\xea\x79\x4c\x1e\x5c\xd2\xe4\xda\x6a\x63\x48\x55\xc7\xdd\xed\xcd\xaf\xdf\xcc\x9d\x30\x47\x7e\xf9\x29\x22\xf2\xc1\x7d\x4f\x5c\x40\x8a\xa0\xd1\x10\x84\x02\x03\x2f\x92\x31\x43\x4d\x5e\xa5\x08\xd3\xc0\xe9\xfd\x89\x57\xca\x8f\x15\xfc\xdb\x54\x1c\x38\xcb\xc9\x6a\x3a\x74\x19\x5d\xf3\xf8\x30\x36\xc7\x96\xdf\x90\x99\xff\xf6\x13\x72\xfc\x06\xa3\x4d\x8f\xc4\xc3\xcb\x56\xcb\x33\x88\x5a\xee\x1c\x7e\xa8\xe4\xa0\xda\xe9\xc0\x3b\x16\xe7\x22\x75\xf8\xe7\x9e\xed\x3c\x32\x7d\x94\x8d\x19\xed\xe6\x33\x5b\x48\x0f\x8e\xc6\x0d\xdd\xe5\xdd\x79\xe9\xa0\xdf\xb2\xbb\x23\x4c\x14\x75\x29\xf9\x6e\xba\xf3\x12\x11\x72\xc8\x84\x54\x49\x63\x44\xd7\xd4\x2d\x6e\x11\xa6\xcb\x18\xe3\x20\x9c\x93\x59\x70\x2b\x05\x3d\x20\x22\xae\x31\xcf\xc4\x14\x12\x55\xfa\x11\xe9\x11\x24\xc7\xb0\xeb\x63\x14\x86\x61\x7b\x1e\xcc\xeb\x0d\x0b\xbc\x56\xcb\x28\x77\x70\x07\xcc\x12\x7f\x79\x2f\x89\x49\xa1\x1f\x1c\xae\xed\x81\xea\x8e\xe4\x65\xba\x11\x3e\x32\x22\x02\x85\x66\x60\x58\xb6\x07\xf7\x7a\x11\xc5\x69\x82\x80\x84\x70\xa6\x25\x1e\xd9\x69\xe8\xb9\x56\x17\xc7\xd0\x96\xc6\x30\x9a\x78\xa3\x98\x8e\x22\x91\xfd\x98\xcb\xc4\x38\x24\xec\x85\x1f\xf3\x38\xd7\x72\x10\xe1\x82\x0f\xf9\x10\x09\x5b\x1d\x6c\x03\x33\xdc\xb4\xdb\x55\xf0\xf7\x58\x34\xe4\x5b\x5c\x9f\xf6\xd5\x2b\xb6\x31\x77\x6d\xfa\x81\x47\xcf\xf3\x9a\xcd\x0a\x88\xf3\x71\x8c\xe5\xb4\x1a\x95\xc7\x32\x21\x15\xc9\x31\x3b\x33\x72\xd0\x32\xc5\x59\x31\x2c\x13\xca\x1e\xcb\x22\x0b\x60\x19\x4a\x82\xc3\xd2\x98\x9e\xb4\xa8\xe6\xbe\x59\x95\xa3\x95\xb2\x63\xa5\xbf\xf0\xd9\xf9\xd1\x5f\x0e\x2e\x0e\xe1\xc3\x4f\x27\xef\x2e\x8e\x4e\x4f\x54\xae\xc2\x12\x9b\x73\x92\xf8\xb2\xec\xe2\x8c\x12\xc0\xc0\x89\xc8\x11\x86\x7a\xd3\xfd\x7a\xba\x50\x84\x2f\x48\x54\xba\xc5\x50\x41\xbc\x8e\xc5\x41\x5b\x2c\xec\xba\xe9\x16\xc4\x15\x0a\xdf\xd0\x82\xa5\x05\xcb\xa1\x05\x2b\xb3\x0b\x8f\x1c\x24\x08\x6a\xed\x1a\x34\xf4\xe5\x51\x57\x9b\xcb\x74\xa7\x9f\xa0\x59\x45\x6b\x7c\x16\xe1\x8e\x0d\x1e\x86\x1d\x93\xe3\x13\xc5\xc1\x71\x70\x47\xc2\x77\x74\x50\x0c\x11\x67\x4e\x02\xbf\xe5\x99\xe2\xe8\x70\x82\x7e\x53\x66\xf6\x74\x53\xd1\x18\x01\x44\xd4\x0f\x11\xed\x3b\x49\x09\xaa\x5f\x58\x79\x63\x89\xd2\xa8\x86\xba\x00\x4d\xba\x5a\xab\x82\xf2\x9d\x0d\xab\xa1\xca\x12\xfa\xb0\x42\xdd\xa4\x14\xef\x00\x03\xb4\x18\x2a\xe5\x3b\x72\x53\x4d\x36\xa0\x9d\x72\xf0\xb2\x34\xbd\x7f\xc4\xf0\xe9\x3e\x84\x24\x8a\xd9\xcd\x18\x49\xfc\x0f\x61\xee\x84\x31\x0c\x56\x2c\x93\x02\xcf\x61\x61\x6a\x87\xc7\x75\x1f\xac\x30\x33\x1f\x06\x0e\x41\x81\xd6\x65\x6b\x72\x4e\x09\x49\x3f\x3b\x2b\xe4\x38\x71\xf0\x96\x2d\xba\xba\xc8\x5b\x86\x39\xc5\xeb\x2c\x3c\xc8\x85\xd0\xd5\x62\x30\x83\x86\x85\xb1\x0c\x1a\x3c\xa6\x17\xab\x67\x9c\x1f\x64\x7c\xab\xf6\x70\xc3\x50\x4e\x89\x90\x35\x21\x22\xec\x0f\x1d\x4f\x13\xdd\x2f\x73\x9d\x4a\xf8\x0a\x65\x27\x25\x28\x13\x3c\x69\xf4\x61\x39\x34\x47\x3d\x52\x48\x3e\xa5\xe1\x9e\x5e\xc1\x92\x8a\x9f\x37\x57\xc0\x76\xe8\x25\x17\x73\x74\xb8\xb0\x00\xa8\x4b\xdc\xd4\x13\x6f\xb9\x9a\xad\x0b\xf9\x59\x51\xd4\x69\xb5\x34\xbb\x50\x69\x4c\x0f\x1e\x20\xc8\xb0\x41\x25\xf1\x73\x4c\x24\x8b\x0c\x1d\x13\x8f\x10\x97\x7b\xbe\x62\x2e\x95\x61\x9c\xe4\x61\x30\x05\xd4\x88\x78\x7e\x0b\xf3\xfa\x76\xbd\x5b\x8c\xbe\xb8\xb2\xf0\x36\x2d\x9c\x55\x22\xd6\x25\x8b\x80\x64\xda\x82\xb1\x80\xc2\xb4\x47\x3c\x84\xc5\x50\x5a\x8b\xcc\x5d\x8c\x10\xd5\xa4\x7b\x4d\x53\x1b\x22\x8a\x27\x3a\x48\x52\x55\xa0\x68\xc6\x35\xe8\x89\xa8\x26\xbc\x8f\x59\x7a\xe5\x60\x24\xff\x32\x71\x62\x91\xb1\x49\xc4\x7f\x2d\x64\xdb\xe3\x8f\x87\xf3\x7c\xe9\x6a\xc4\x60\xba\x40\xc4\x68\x76\x74\xba\x58\x3a\x52\x21\xdc\xdf\x83\x8b\x24\x79\x7f\x8f\x10\x99\xc6\xe9\x89\x31\xc2\x14\xd6\x9c\xc1\x6b\xd8\xc6\x28\x85\x9e\x28\x4e\x61\x84\xcc\x7a\xaf\x8e\x5b\x2d\x8b\x27\xca\x3f\xd6\x91\x36\x98\xcb\xc0\x16\xec\xb1\x5b\x3d\xed\x34\xed\xd1\x8d\x11\x6e\x68\x75\x8f\xd6\xa6\x87\x2f\x01\x74\x9b\x35\x94\x40\x7e\x4e\x5b\x44\xe4\x5b\x14\xf9\xa7\x68\x50\x6c\x94\x01\xb2\xd8\xec\xc2\x1e\xbc\x30\xdc\x31\xe2\xc2\xa5\xa3\x84\x86\xca\xc9\x82\x37\xc7\x9d\xe9\xfe\xda\x72\xe7\x06\x6b\x6e\x48\x76\xd4\x10\xf6\x73\x7c\xbd\xd6\xad\x59\xd0\xc2\xa3\xe6\x1e\x63\x28\xaa\xda\xe5\xfc\x40\xf0\x48\xba\xde\x4a\xae\x94\xc3\x2a\xb7\xc9\xe7\x82\xb6\x17\x73\xa4\xd4\x19\x71\x7c\xee\x05\x4e\x6e\xbd\x60\x11\x71\x52\xc6\xb4\x3c\x41\x96\x90\xf1\x1c\x15\x05\x60\x12\x46\x20\x61\xc5\xad\x16\xa7\xdf\x57\xd0\x6c\x22\x57\x76\xaf\xe0\x0d\x24\x2f\xab\x28\x3e\x70\x81\x30\x91\xa6\x9a\xba\xe3\x89\x5b\x59\xcf\xd8\x6c\x56\x88\x00\xce\x90\x68\x2f\x7c\x76\xd0\xeb\x56\x8a\x22\xb5\xd9\xaf\x66\x1d\xc0\x7a\xb1\x0f\x21\x99\x85\xec\x46\x8b\x94\x4f\xb7\xc7\x57\x65\x47\xfe\xc3\xf6\xb8\x0d\x97\xdb\x16\x60\xb2\xeb\x9d\x2b\x18\x90\x61\x30\x23\x11\x6c\x0f\x46\x65\x6d\x32\xb3\x0f\xbe\x34\x6a\x35\x54\x75\x51\x69\x4e\xc4\x95\x4a\xa2\x0b\x0f\x27\x4e\x78\x10\xd7\xe9\x34\x73\xcf\xf4\xd2\x90\x99\xd9\x55\x86\x62\x07\x59\x3f\xe0\xe9\x45\x9a\x7a\x19\xa3\x32\x3b\xae\xcb\x92\x50\x79\x63\x0d\x6d\x73\xd1\x20\x8a\x55\x26\xa7\x9a\xb3\x1f\x09\x31\x30\x5d\x92\x7d\x89\xa7\x1c\xe6\x09\x74\x88\x8b\x6b\xa1\x0d\xef\x28\x22\x2e\x97\x48\x71\x8d\x49\x47\xb6\x22\x36\xb4\x50\xc1\x7c\x4d\xd7\xd1\x03\x66\xa3\xe5\x0b\x8f\xc7\x25\xc2\xbf\xd1\x88\x50\x02\xe4\x6c\x31\x8d\xbd\xf9\x74\x85\xdb\xfb\x0d\xdb\xd6\x8d\xeb\x8a\x9e\x11\x67\x16\xc4\x64\x36\xb7\x60\x39\x0d\x2c\x58\x4e\x14\x59\xfb\xe5\x67\xe8\x84\xe1\xaa\xdc\x3e\x08\xb7\x49\x4e\xbe\xe6\x92\x37\xd3\x00\x05\xcb\x1f\xd2\xf4\xeb\x25\x15\x26\x42\xe7\x9d\xe6\x6b\xbf\x37\xb2\x1a\x46\xde\x4b\x44\x89\x27\x86\xe0\x11\xd3\x4a\x03\x47\x23\x72\xcb\x4b\xef\x4a\xc6\xaf\x24\xb6\x1a\xe2\x87\x75\x0a\x28\x9a\xea\xd1\x03\x32\xed\xdb\x33\x6c\xb5\x89\x70\x9e\xd1\xe1\x31\x57\xa3\x93\x47\x6b\x4e\x83\xa4\x66\x1d\xea\x30\x93\x11\x46\x3d\xa6\xfc\xad\xc9\xe6\xb1\x44\x29\xca\xa7\xba\xce\xda\xe8\x30\x7a\x62\xae\x89\x4d\x6c\x22\xd7\x3f\x7c\xcf\xbb\x30\x31\xe8\x83\x70\x94\x98\xc6\x15\x21\xff\x80\x90\x37\x0e\x00\x8e\x78\x36\x60\x99\xb0\x7b\xf6\xc2\x40\x10\x9c\x2b\x2c\xd7\xe2\x3f\x92\x66\x66\x36\x77\x42\x52\xe7\x26\x75\xce\xb1\x05\x83\xe3\xd2\x75\xe6\x59\x30\x9c\xcd\x4b\x64\x01\x70\x8e\xa9\x48\x57\x02\x8e\x3e\x43\x9c\x75\xe7\x98\x6e\xcd\xc7\xb0\x0f\x5d\xd8\x83\x96\x29\x34\x75\x25\xdd\x6c\xba\x11\x30\xf8\x36\xbb\xef\x70\x8e\x79\x90\xdf\x2a\x57\x72\xac\x1f\x74\x7a\x69\x4f\xe8\xff\x55\xb7\x76\xde\x27\x5a\xe5\x0d\xab\x59\xde\x2f\xf9\xc1\x7c\x5c\xdf\x72\xa7\xd7\xfd\xc2\xe9\x09\xe7\x57\x5d\xd3\x4c\x51\xd1\x62\x10\x87\xce\x30\xce\x90\x54\x15\xe6\xed\x95\x08\x59\x9d\x0e\x7c\xe2\xb0\x61\xc0\x72\xa3\x3b\xe5\xf7\x0d\xce\x71\x15\xde\xe8\x5c\x3a\xc7\x57\xd0\x32\x46\xd8\x06\xbe\x15\xb0\xb2\xaf\x61\x80\xff\xb3\x59\x2d\x61\x87\xac\x4a\x1f\x28\x37\xc1\xb1\x68\x8a\x16\x19\x94\x07\xde\xca\x54\x88\x7e\x0d\xd2\x90\x3c\x71\xe8\xc9\xe3\xe9\x53\x39\x50\x4f\xf7\x15\x38\xe2\x26\x61\x7d\x59\x66\xb9\x97\xe4\x04\xb5\x60\xb5\x27\xf2\x66\x1a\xc5\x98\x54\x78\x50\x1c\xe0\x4b\x89\x65\x48\x77\x79\x96\x62\x6c\x16\x84\xc4\x02\xdf\x42\x03\x71\xf6\xf7\xd8\x82\xcf\x16\x7c\x1e\x5a\x10\x92\x19\xfe\x39\xc6\xbf\xb6\x05\x4b\xcf\x82\xe5\xb1\x05\xab\x61\xc9\xae\xbf\xa2\x85\xbe\x98\xcb\x44\x6c\x1b\xa6\x27\xc1\x55\x3b\x4a\x56\xb8\xb9\x92\xd0\x3e\x94\x34\x3f\x64\xfa\xb4\x12\x11\xd9\xc3\xb8\x9e\x27\xce\x89\x25\xe5\x93\x0e\xc1\x2e\xb9\x2a\x7b\xb2\x1c\x4a\x87\x50\xfa\x69\x35\xe4\xff\x95\x9e\x4a\x41\x97\x76\xac\x92\x91\x12\x77\x4c\x38\x71\x4e\x28\x26\x44\xc2\x3f\x08\x61\x10\xc4\x93\x6c\x3f\xca\xad\x33\x9f\xd0\x09\x40\xd4\xd9\xff\x54\x94\x87\x7d\x3a\x7e\xec\x38\x8f\x57\x65\xc0\xfa\xb5\x87\xfd\x6c\xc0\x3e\xb6\xbf\xb7\x86\xb5\x26\x22\xfd\xdf\xff\x65\x53\xa4\x97\xe0\x45\xf8\x39\x08\x61\xc5\x3e\x0b\x9c\xb1\x17\xa1\x28\x9d\x26\x73\x89\x44\xc1\x0a\x1d\x02\x46\x23\x32\xf6\xb6\x98\xa2\x7d\x88\x30\xc7\xca\x1e\x44\xd0\xd1\x64\x5e\x14\xcf\x66\x59\x48\x3e\x17\x14\xed\x91\x01\xd0\x67\x4a\xa5\x9f\x93\x48\xd9\xba\x62\x5c\x5b\x06\x2d\x58\x99\x52\xa0\x45\xcc\x5e\xbe\xc9\x62\x4f\x97\x89\x17\x4f\x4a\x19\x05\x7d\xb0\x50\x1f\xca\x45\x5d\x5a\x6a\xe0\xc5\x1f\xd8\x95\x39\xc5\x56\x36\xa5\xa7\x9c\x3a\xf9\x71\x95\xfb\xd1\x0c\x18\x35\x99\x72\x79\xa3\xf4\x5c\xc6\xf0\xf1\x1e\x20\xb9\xf5\xe4\x8a\xbe\x00\x6f\x65\xd1\x13\x8c\xb0\x03\x5e\x26\x8a\x1e\x04\x23\x30\x87\xde\xbf\xc8\x46\x09\x4f\xae\x82\x13\x2a\x88\xd8\x86\x2b\x9d\x0a\xb1\xed\x89\x53\x51\x35\x0e\xf9\x0c\x0c\x2b\x6e\x08\x00\xec\xe8\x8d\x4c\xc8\x86\x46\x92\x80\x41\x07\x04\xa7\x9e\x55\x7e\x93\xab\x0b\x0d\x20\xad\x56\x19\xd9\x44\x95\xac\x04\x3e\x73\xbb\xa9\x32\x6d\x0b\x3a\x3d\xb1\x6c\xf3\x0f\x0d\x4a\x7f\x9c\xbd\x8f\x30\x95\x5d\xd1\xb2\xab\x6a\x65\xbd\xf2\x90\x1b\x11\xb7\xb5\x2d\x53\xdc\x9d\x04\xe1\xcc\x99\x7a\x11\xa1\xfc\x89\x1e\xe2\x57\x43\x88\x02\x98\x78\xe3\x09\x89\x62\x08\x42\x97\x84\x5c\x77\x17\x8c\xe8\x8f\x5e\x04\x6f\x98\x0e\x19\x3a\xd0\x6b\x9b\x1b\xf0\xb3\x46\x2b\xbc\x56\x9d\xb3\x6e\x16\x8c\xbe\x82\x71\xc0\x49\x10\x83\x4f\x86\x24\x8a\x9c\x70\x65\xc1\x60\x81\xda\xf2\x89\xe3\xbb\x53\x02\x81\xcb\x34\x1e\x22\x8c\xfe\x4a\x70\xd8\xa4\xbd\x1e\xae\xf5\x12\xaf\x80\x4e\x47\x24\x10\x7c\xc3\xb4\xb9\x7e\xb3\x89\x79\xcc\x28\xd7\x66\x30\x5f\xcb\x00\x2b\xc4\xc9\x61\xa0\xaa\x9c\x2e\x50\x32\x48\xd5\x23\xab\x21\x0a\x3f\x8c\x19\x96\x1f\x18\x96\xd9\xda\xcb\xf5\x6a\xaf\x43\x78\xb0\x26\x51\x97\x44\x87\x5f\x52\x42\x5e\x1d\x9b\x61\x84\x64\xc6\x1a\x4c\xb3\x01\xac\x8e\xcb\xf3\xc0\xcd\x28\x9a\x21\x99\x95\xa7\x40\x01\xae\xd3\x72\x5d\x6e\xfc\x15\x07\x30\x73\x6e\xe4\x7c\xea\x4e\x04\xd3\xc0\x1f\xd3\xff\x8d\x72\xb0\x78\x84\x58\x8e\x68\xbc\xa6\x5d\xa4\x1f\x2f\xe9\x57\x66\x2b\x57\x1a\x0e\x69\xf5\x85\xcd\x8a\xd0\x07\x95\x14\x4e\x74\x0b\x76\x59\xd1\xa1\x0d\x5c\x74\xaa\x10\xf8\x64\x35\xbc\xec\x5e\xc9\xeb\x1d\x1a\x14\x42\xb3\x69\xae\xab\x5d\xb2\x73\x9e\x73\x2b\x0e\x3d\x67\xca\xd9\x8a\xcf\xb5\xf7\xe8\xc5\xea\xc3\x22\xa2\xdb\x0e\xb6\xb7\x55\xba\x62\x53\x6b\x7b\x26\x13\xf4\x61\x8b\x2d\x57\x9b\x2d\xdd\x06\xef\x6f\x97\xf2\x1a\xd2\xea\xee\x94\x90\x81\x1b\x54\x58\xac\x7e\xc5\x9b\x03\xbc\x02\x47\x55\x8c\x20\x1a\x9e\x4b\x9a\x53\x55\xb9\xd8\xc8\x14\x0e\x89\x3e\x67\x25\x0e\x3f\x2b\x76\xf4\x39\xae\x64\xd8\xcf\xdc\x71\x05\x06\xaf\xd7\x69\x9f\xf9\x31\xcc\xe6\xe5\x89\xa1\xf2\xfd\x76\xa6\xc3\xc5\xd4\x89\x89\x3c\xd3\x16\xf8\x25\xdb\x85\x78\xe8\xb1\x8e\xad\xde\xca\x5e\x39\x8c\x5c\x51\x3b\xc5\x46\x46\x02\x62\xa7\x47\xf4\x3a\x02\xed\x26\xa2\x49\xe5\x1e\xe1\xbd\xf7\x24\xb8\x63\xd1\xf0\x63\x6f\x46\x22\x7e\x43\xcb\xc6\x75\x1c\x10\xb4\x6d\x08\x32\xa2\xda\x1a\x63\x0d\xc5\xed\x12\x51\xef\x20\x09\xaf\x81\x29\x1c\x4c\xc7\x41\xe8\xc5\x93\x59\x65\xf7\x33\xe8\xb6\xb9\x2b\x76\xcc\x2e\xe0\xb1\x4b\xcf\x32\xcb\xb4\xee\x57\x77\xf8\xeb\xb5\xe9\x7c\x08\x88\x6f\xd2\x61\xd8\x4b\x5e\xb6\x92\x76\x2c\xf0\x5b\xad\xca\xa0\xb7\xda\x12\x5b\x6e\xf5\x05\xbc\xca\xf5\xb7\x33\xa8\xdd\x39\x91\xbc\x22\xc0\x89\xa1\x57\x7d\xd4\x00\x76\xda\x62\x75\xe2\x01\x4b\x02\xe4\xbb\xa2\x7f\x6b\x80\x7b\xde\xa6\x4b\x35\x85\xf2\x46\xc0\xd8\xcb\x76\x3a\x1d\xb9\x66\x73\x0d\xa7\x89\x54\x1e\xa9\x54\x07\x04\xe5\xf3\x13\xc9\x1b\x9e\xfa\xdb\x9f\xae\x18\xab\xc6\xaf\x5e\x54\xc6\xa7\xf3\x78\xf8\x62\x43\x69\x20\xc1\x23\x14\x73\x7e\x2d\x05\x56\x25\xe4\x5a\x1d\x23\x0a\xe8\x21\xc2\x97\x0c\x87\x8a\x1c\xf4\xff\xca\xb2\x94\x78\xd6\x15\x57\xe4\x47\xda\x66\xc4\xa0\xac\xb9\xcd\x48\x90\x8e\x94\xcb\x76\x2d\x10\x17\x99\xbd\x3d\x0e\xd8\x61\x62\x2d\x10\xc8\x6c\xbb\x49\x5d\x70\x06\xc1\x22\x86\x9d\x1f\xc4\x11\x96\xb2\x5f\x34\x52\x46\x63\xa4\x20\x86\x1b\x3f\xb8\xf3\xd9\x99\xe0\x56\x6f\x74\xa7\x68\x89\xdc\x92\x10\x06\x84\xf8\x72\x22\xba\x0d\x90\xbe\x9b\x78\x53\x42\x37\x4b\xb1\x57\x33\xc5\x25\xee\xd6\x5c\x7b\xc9\xb7\x25\x2e\x97\x54\x77\x5a\xa2\x8f\x6f\x3c\x03\x6b\xfa\x96\x68\xd6\xc5\x0a\xc1\xe3\x3e\x9f\xde\xea\x5d\xa3\x4f\x7a\x01\xc0\xfa\xb5\xa2\x52\x2d\xa3\xf8\x7d\x2a\xaa\xee\xe1\xaa\xe1\x1d\x5d\x77\xe5\xc0\x43\x57\x0f\x24\xa2\x52\xc5\x8b\x18\xa8\xe4\xba\x04\x9b\x7a\x7a\x21\xf9\xa2\x2a\xb1\x8b\x97\x6a\xf4\x6b\xab\xa2\x53\x3a\x24\xeb\x90\x41\x41\x8b\xd3\x90\x30\x42\x47\xc3\x3b\x4a\xe6\x62\xd3\x59\x0d\xc5\x5a\x07\x67\xec\x78\x3e\x0c\xc8\x34\xb8\x33\x6b\xa1\x73\x2d\x45\x01\x60\x22\x21\x82\x98\xc6\x01\x8b\x2e\xec\x60\x56\xeb\x75\xf8\xa8\x84\x74\xd7\x82\x29\x71\x6e\x19\x44\x27\xc2\x90\xba\x51\x90\x41\x36\x24\xa2\x0f\x2e\xc3\x7c\xbd\x2d\x84\x65\xaa\x5e\x43\x26\x95\xb0\x54\x48\xc2\x88\x9e\x0f\xb3\x45\x84\xf1\x65\x93\xd0\x33\x6b\xcc\x19\x24\x34\xe8\xaf\x4b\x87\x0f\xda\xf6\xd6\xdd\xe7\xaa\x1e\x29\xf3\x55\x37\x5a\x9c\x15\xfb\x86\x33\xca\x1a\x79\x2d\xd8\x24\xb6\x25\x1f\x6a\xab\x8a\x51\x09\xd7\x13\x43\x85\x5c\x6f\xcd\xad\x2c\xe5\x77\x09\x07\x17\x57\x4e\xeb\x70\xb7\x4d\xb7\xf5\xec\x46\x9c\x13\x52\xab\xf5\x20\x39\xba\xf5\xfb\x2c\x89\xf2\x7a\x2c\x4c\x75\x70\xcd\x48\xb8\xeb\x32\x86\x74\xd5\x65\xc0\x58\xc9\x50\x67\x77\xa9\xc7\x12\x3d\xa6\xc1\xdd\xa6\x92\xc7\x34\xb8\x33\x08\x1e\xb7\x24\x5c\x41\xe8\x84\x64\xba\x82\xde\xfa\x4d\x15\xe4\x05\xc5\xd9\x1e\xcd\x4a\xd7\x96\x14\x9a\xcd\xc7\x92\x14\x36\x98\x03\x28\xae\x9d\x55\xb2\xa8\x25\x49\x61\xfd\xa5\x24\x1e\xc5\x92\xaa\x5a\xb5\xe2\x8e\x5f\x5a\x2a\x13\xe4\x01\x57\x58\x95\xe8\xc4\xe2\xf1\xcb\x94\x67\xe2\x61\x7a\xcf\x4a\x0a\x90\xaf\x89\x2e\x4c\xe0\xd3\x65\x2b\x96\x99\x21\x0e\x08\xd8\x95\xf4\x44\x07\xdc\x5e\xd1\x27\xcb\x38\xd1\xd9\x58\x89\x65\x3a\xbb\xa8\xaa\x90\x6d\x16\xf0\xb6\x45\xf8\x05\xfb\xd5\x94\x54\x3f\xcd\x5d\x54\x18\x4d\xc8\xda\x2a\x2a\xa6\x28\xaa\x3c\x05\x59\x55\xec\x72\x78\xb9\xe4\xf7\x4d\x55\x46\xba\x62\xf6\x54\x48\x67\x50\xb4\x50\x51\x93\xc5\x29\xbc\x82\x08\x51\x62\x28\x94\x30\x99\x3a\x2c\xbd\x66\x13\x5e\xc3\xf2\x18\x4d\xe3\xd9\x68\x09\xd3\x7c\xb4\xca\x8f\x4a\xbc\x75\x21\xbd\x17\xcb\x56\x2f\xd7\xab\x1f\x4b\x96\x2c\x7a\x13\x06\x48\xae\x80\x3f\x73\x73\x85\xcf\x89\x1b\xec\xc6\x96\x77\x89\x56\x98\x5f\xf8\x96\xdb\xb1\x5f\x04\x30\x4c\x74\x97\x9f\xdb\xc4\xe2\x9e\xb3\x22\x17\x33\xf7\x63\x0a\x46\x92\x7f\x2d\x22\xbc\x86\xb3\x6c\x04\x7d\x56\xe7\x15\x44\xc2\x5b\x36\x92\xbc\x65\x4b\xef\x36\x82\x85\xef\xd6\xe1\xb3\xc5\x2e\xd6\xeb\x14\x4f\x34\x44\x6a\x02\x81\x67\xb2\xcf\x6b\x17\x2d\x1e\xbb\xcc\x06\x07\xa7\xaf\x8a\x0b\x31\x33\x60\xf6\x22\xb3\xbd\xb0\x78\x2a\xad\x88\xcf\x55\xb2\x73\x7f\x6e\x87\xd0\x87\x26\xc5\x73\x33\x5f\x5d\x66\xa3\xf1\xf9\xe1\x0e\xb6\xea\xc0\xae\xdc\x3b\x36\x24\xf3\x90\x44\xc4\x8f\xb9\x8f\x5f\x7a\x1f\x9f\x46\x7d\x45\xa3\xec\x91\xb7\x24\x6e\x6b\x1e\x78\x3e\x26\x59\x15\x17\xfc\x9e\x33\x95\xc1\xfb\x41\xec\xa0\xcd\x94\x70\x88\xe0\xdc\x36\x35\xe7\xce\xc7\x35\x0d\x15\x79\xf2\x75\x71\x55\x51\x9e\x71\xa4\x40\xa5\xf2\x8f\x9e\x08\xc8\x8b\x1e\xb5\x42\xc6\x99\xd2\x93\x0f\x93\xa1\x42\xf2\x79\xe1\xd1\x23\x5a\x3d\xef\xab\xa4\xf4\x48\xca\x84\x8b\x85\x70\x26\xc0\x27\x8e\x4f\xb3\xc0\xcd\x44\x80\x15\xc6\xf2\x94\xd6\xf8\x27\x77\x0f\xe2\xe0\x30\x1d\x2a\xe8\xbe\xb4\x84\x75\x3e\x66\x37\x14\x89\x2f\xa1\xd7\xa5\x5f\xce\x84\x23\x2b\xf4\xb6\xcd\x89\x05\x13\xe3\xb4\x11\xd6\xaf\xd3\x9d\xcd\x63\x4b\x83\x37\x6e\x72\x55\x1e\xda\x68\x9b\xe6\x13\x7a\xb6\xf5\x2d\x66\xb1\xaf\x2c\x8d\x9e\xc4\xe1\x2c\xe1\xaf\xb9\x88\xe5\xb4\x3d\x96\x66\x8c\xb5\x6a\x25\xc3\xf3\x31\x70\x89\xc1\xef\x69\x9f\x02\xbd\x47\x43\xa1\xca\x7e\xca\x4f\xfc\xf6\x30\x75\x40\xf4\xd3\xe8\x7d\x1a\x16\x83\x97\x65\x7e\x5b\x7f\x3b\xe8\x13\x2c\xa0\xf3\x13\xe4\x8e\x8c\xfd\x64\x6b\xd1\xb3\x05\xe6\x77\x91\xf3\x53\x45\x74\xcb\x7c\x35\xf8\x6c\x51\x29\xe7\x25\xdd\xcf\xd2\xef\xbd\x6d\x3a\xda\x3e\x81\xd7\x7d\x29\xaf\x93\x91\xe9\xec\x67\xc9\x8d\xfb\x7e\xf8\xa6\x89\x00\x74\x42\x53\x78\x8c\xf8\x7a\x97\x91\x32\x26\xe9\x53\x9a\x61\x7c\x3d\x6b\xa9\xe5\x37\x38\x95\x96\x79\xd6\xf8\x6d\xc9\x7c\x87\x69\x74\x5c\x8c\xf4\x9c\x70\x28\x7a\x8e\x2c\x73\x1f\x04\x6e\x35\xa5\x9f\x62\xd8\x70\xee\xa6\xc4\xe7\x3e\xe2\xa5\x47\xe1\x4e\x27\xb3\xb0\x19\xf5\x46\x32\x03\x4d\xb9\x26\xef\x61\xba\x2f\x17\x79\xa3\xa9\x9d\x94\xcb\x7a\x91\x14\x78\x5b\xb9\xd5\x27\xd7\xd9\x94\x45\x27\xbb\x00\x67\xa0\xe8\xd4\x6c\x6a\x09\xdd\xc3\x03\x79\x3e\x72\x5b\x84\xe8\x92\xe1\x8e\xb4\xd3\x81\x43\xc5\x20\x94\x44\xa7\x29\x5b\x2f\x75\xe6\x48\x45\xe8\x6f\xb9\xa5\x53\x96\x4b\x80\x23\x75\x30\x9f\x13\x9f\x5b\x4f\x98\x45\x3c\x61\x18\x41\xa9\xe1\x35\x78\x89\xef\x56\xcd\xae\x21\x63\x2d\x97\x7e\x84\xbf\x56\x71\xd1\x56\x92\x6e\x3e\x28\x47\x5c\x57\xa1\x92\x70\xe3\x41\xab\x0f\x7e\x89\x70\x63\x76\x33\x7b\xbc\x01\xc6\x19\x47\x5b\x4b\x78\x83\xa3\x5c\xe5\x50\x84\x95\x5a\x2d\x0f\xde\xe0\x41\x96\x4d\x92\x98\x99\x76\x8d\x3b\x23\xa5\x53\x55\x1a\x51\xab\xea\x29\x09\xa3\x91\x11\x68\x51\x4c\x2b\x46\x9e\x12\x48\x56\x3b\x82\x49\xc3\xd1\xef\xf3\xf1\x90\x3a\x56\x09\x46\x12\x59\x6b\xbd\x41\x80\x47\x77\xe5\xd0\xc8\xbe\xc9\xee\xce\xcc\x20\x9f\x3e\xa5\xdb\xf8\x3e\xd4\x5a\x35\x68\x22\xbe\x7b\x6b\xf9\x17\xfe\x99\x99\xd5\x65\x73\x46\x38\xbe\x9b\x4d\xa3\x60\x88\x0c\x93\xc9\xa4\x10\x59\x30\x23\xf1\x24\xd0\x3b\xd0\x72\x27\x3f\xbf\x24\x77\xb3\x51\xe2\xe0\xb9\xff\xb0\x41\x76\x7a\x6c\xe0\x67\x96\xb5\x39\xd2\x8a\x33\xb3\x82\x65\x74\x0a\x42\xd3\xa0\xa0\x86\x66\x13\x3d\x8f\xc2\x71\x94\x78\xc1\x9a\x88\xd2\xd7\xb4\xe4\xe9\x5b\x82\x44\x79\xea\xf8\x2b\xb1\x1d\x79\x11\xb3\xac\x0f\x13\x9b\xfb\x12\xee\xff\x84\xd2\x45\xd9\x72\x99\x31\x35\x8d\xa9\x48\x89\xf3\x92\xac\x14\x63\x13\xde\xa6\xfb\x4c\x1d\xa7\xb6\x42\x62\xc1\x12\x0c\x36\x59\x10\xb3\x8a\x24\xaf\x3a\xed\xc5\xe1\x02\x3b\xc3\xce\x4f\x49\xfc\x17\xba\x67\x63\x42\x09\x39\x61\x41\x3c\x09\x33\xaa\xdf\x67\x18\x19\x90\xd2\x89\xc8\x24\xc2\xf2\xc3\x33\x81\x05\x2d\x27\xd2\xe0\x7f\xb4\xa1\x8a\xe7\x16\x75\x96\x3c\x3c\xc7\xcc\x3c\xfa\xc7\x59\xa6\x87\x0a\xdf\x99\xe9\x0d\xe9\xf9\x15\xda\x6b\x5a\x0f\x4d\x5d\xe1\x0d\xad\xcd\x3e\x3e\x41\xd3\x67\x7f\xe8\xc4\xa4\xee\x9b\x27\x8e\x07\x60\x14\x6d\xd6\x59\xab\xf7\xf7\x50\xcb\x9e\x59\x6b\xa5\x81\x43\x95\xe8\xec\x43\x2d\x93\xc5\xa3\x06\x7b\x69\x82\x20\x3e\x23\x35\x68\x20\x81\xad\xe5\xde\x14\xa6\x93\xbc\x09\x8d\x50\x89\x77\x9e\x33\x91\xb7\x24\xbd\x11\x6a\x9d\xba\xb6\x14\xc8\xcc\x77\x61\x88\xa9\x36\xf0\xae\x33\x8a\x93\xd4\x99\xf4\x17\x9e\x6c\x32\x43\x41\xa9\xf3\xf6\xcc\xf3\x17\x91\x05\xf3\xe9\x22\xc2\xd2\x68\x7a\x56\x91\x62\x7c\x61\xdc\x8d\x44\x32\x44\x61\xc3\xc0\x85\x99\x7e\x4a\x3b\x4f\xd7\x54\xe6\xff\x16\xb1\x75\x9e\x0c\x2f\x5b\xad\xeb\xab\x24\x78\xa0\x96\x23\x66\x2d\x0b\x27\xc5\x91\x6e\xc3\x87\x52\x75\x9d\x5e\x5b\xc7\xf0\xb9\x4e\x62\xd9\x5d\x0b\xed\xdc\x75\x4e\x3b\xa7\x43\xee\xf4\x96\x84\xa3\x69\x70\xa7\x16\xd1\x70\xd5\xb1\x90\x7f\x1a\x5d\xdd\x9b\x24\xf1\x68\x59\x0c\x11\xe1\x86\xa4\x67\xfe\x3e\x7a\xf0\xf8\xa8\x78\x2b\x51\xd4\x62\x64\x30\xd7\x84\xba\xcc\xdd\x09\xbc\x4e\xc8\xb7\x0c\xcd\xff\x34\xc6\x8f\x64\x28\x5e\x72\x24\xb5\xa1\x1b\xcb\x0f\xcf\x65\xda\x45\xdf\x14\x9e\xaf\x44\xaa\x5a\x57\x6e\xba\x65\x09\x2f\x50\x43\x36\x72\xbc\xa9\x38\xf0\x79\x2e\xc6\xe6\x27\x51\x4c\x37\x11\x95\x42\x8e\x3f\x73\x27\x8c\xc8\x09\x8f\xc8\x57\x39\xa1\x10\x5d\x09\x67\x21\x19\x79\x4b\xe8\x43\xe7\xd7\x7a\x6b\xbf\x61\xd7\x2f\x97\x83\xe0\xaa\xd1\x31\x84\x4c\x70\x83\xf8\x60\x14\xd3\xd3\x20\xad\x74\xf9\x6b\xfb\xaa\xd9\xf8\xa5\xfd\xa7\x8e\xb1\xc6\x5b\x32\x62\x57\x01\x9d\x5f\x7f\x69\xf3\x4a\xa6\x2a\x5e\x24\xc8\xf5\x34\x3c\x71\x4e\xb0\x62\x6b\xbf\x2e\x5e\xde\x9f\x38\x27\xc6\xfa\x77\x13\x2f\x26\xd1\xdc\x19\x92\xd3\xf0\x8c\xf2\x41\x6c\x39\x7a\xf6\x4b\xf3\xfe\xd7\x5f\xa2\xe6\xfd\x2f\x51\xf3\x4f\x9d\x71\xd5\x54\x34\xb0\xb4\xb8\xba\x66\x31\xb3\x60\x50\xea\x96\x8a\xb6\xe9\xe6\xb3\x1d\x2e\xb1\x19\xec\xa7\xd2\x76\x1a\x08\x2f\x8f\xbc\x88\x8a\x67\x5a\x36\x27\x01\x90\xe5\x90\xcc\x11\xe1\x20\xe3\x76\x18\x84\x15\x64\xbe\xdc\x80\xb7\x29\xd5\xd5\xa3\x72\x21\x8c\x85\xe8\xf2\xa2\x13\xe7\x84\x16\xdf\x67\x5a\xbc\x3d\x10\xe1\x90\x5a\x5d\xd8\x33\x5d\x4c\x55\x3b\x2e\x33\xa9\x74\x31\xab\x1e\x04\x5f\x4f\xdb\xf5\xfd\xfe\x2f\x77\x97\xbf\xdc\xb5\xaf\x9e\xfd\xa9\xd1\xf1\x4a\xa1\xa1\xe3\x5e\x3a\x39\x29\x64\x4b\xa6\x90\x99\x05\xf3\xae\x05\xf3\x72\x3f\x1f\xf1\x70\x97\xc4\x3a\xad\xd3\x87\x79\x2f\x1b\x09\x92\x99\xfd\xd5\x96\x35\xd8\x87\xee\x73\xd8\xc3\x52\x7d\xa8\x0d\xe8\x8b\x1e\xec\xc1\x6e\xd5\xab\x41\xa4\xe5\x27\x03\x2a\x25\x0d\x28\x08\x6c\x77\x1f\xe6\x74\x62\x14\xf2\x6e\xfe\xf9\x5a\xc5\x90\x86\x4e\xd0\x60\xcd\x8e\x0f\xaa\x5b\xb6\x60\x08\xa0\x5a\xb7\x5d\x83\x38\x60\xf1\xac\x6a\xed\x2e\xfb\x62\xb7\xbb\xea\x4c\x97\xf9\x27\x37\x8d\x82\x8b\x59\x50\xfb\x53\xb7\x06\x8d\xcc\x2f\x8c\x5b\x59\x14\x3a\xfe\x58\xe5\xd4\x5e\x4d\x15\x41\x57\xfb\x93\x3e\x44\x92\x86\x3d\x7b\xc2\x8b\xaa\xd9\x38\x94\xb4\xd7\xe9\x40\x2d\x0b\xb8\xc1\xb3\x3b\x33\xf1\x66\x0f\x7e\xf7\x35\x19\x42\xcb\x41\x20\x7e\xbf\x0f\xbe\x56\x87\xa5\xc8\xd1\xee\xb9\x16\xd4\x10\x5e\x0d\x2f\x24\x07\x28\xba\x23\x68\xfa\x62\x40\xe5\x76\x3c\x03\x50\xf1\x1d\xdb\xa9\xb1\x38\x4e\x25\x03\xc3\xf8\x11\x93\x5e\x36\x18\x3c\x1e\xf5\x50\x12\x81\xb4\x7d\x32\x24\x31\x2f\x1e\x3d\x0d\x81\x79\x2f\xe8\x59\x50\xbe\x70\x03\x3c\xa5\x19\xb4\x25\xb9\x33\xd4\x2c\x1a\x5b\x54\x5c\x30\x0a\xea\x84\xc2\xe4\x0a\x05\x84\x5f\x87\x4b\x6d\xdf\xb2\x13\x5f\xb3\x04\xaa\x7a\x4f\xf3\xda\x70\x36\xe7\xe5\x32\x9d\xd3\x67\xc2\xe0\x29\x6c\xb3\x95\x3a\x1d\xd0\xa7\xbe\xa8\xb9\xde\xad\xaa\x8d\x2d\x63\x8d\x8b\xe0\xc8\x8f\xe5\x6a\x9d\x0e\xe8\x53\x80\xd4\xc8\xe7\x42\x13\x98\xd2\x4b\x5f\x63\x1c\x2b\x6b\x3c\x37\xd5\x20\xaa\x7e\xbc\xd0\xd7\x98\xaa\xdb\xd8\x35\xd5\x50\xb6\xa1\xcf\x18\x51\xc3\x53\x63\xae\x0e\x9d\x41\xc3\xa4\xcf\x02\x57\x39\xe9\x86\x59\xa7\x87\xd2\x7c\x1d\x5a\xc5\x30\xed\x49\xe0\x61\xa9\x1e\xad\x62\x98\x77\x96\xa2\xa0\x40\x5b\x5d\xc3\xc4\xe3\x35\x97\xa2\xfb\x86\x99\x47\x5b\x13\x45\x15\xc3\xd4\xe3\x51\x5c\x51\xc5\x30\xf7\x71\xf0\x1e\x0f\xa2\x39\x1a\xee\x1a\x26\x3f\x73\xd5\x91\x2e\xdf\xae\x61\xf6\xf9\x35\x43\x06\x35\xba\x1a\x0d\xb3\x2f\xae\xd5\x73\x88\xf5\x0c\xb3\x1f\x07\x1f\x78\xec\xe3\xb4\x12\xad\x62\x9a\xfd\xe0\x4e\x45\x63\x3d\xc3\xec\x4b\x37\x81\x49\x55\x5a\xc5\x30\xfb\xe2\x96\x3b\xdf\x17\xc3\xec\xa7\xfc\x31\x5b\x45\x3d\xfb\x57\x97\x8c\x5d\x5f\xd1\x1d\xad\xde\xc0\x4d\x6e\x16\x8d\xe9\xb7\x3d\xfc\x82\x0c\x5c\x23\x12\x21\xf3\x6e\xa3\xa6\xac\x2f\x35\xcc\x18\xb9\xe6\x4a\xc2\xb4\x3f\xa1\xfa\x91\x41\xdd\x48\xe1\x49\x97\x0a\x2c\xa9\xfc\x15\xb9\x8a\xdb\x53\xee\x90\x9b\xb1\xe1\x80\x70\xd6\xe6\xb9\x63\x47\x41\x08\xc1\x2d\x09\x3b\x0b\xdf\x25\x61\x6b\x34\xcd\xa9\x42\x8f\x46\x10\x72\x3d\x67\x3c\x59\x59\xe0\xc5\xf4\x1b\xf7\xd7\xa1\x67\x64\xe6\xde\xe0\x84\x84\x19\x28\xf1\x46\x9d\x11\x4b\x8a\x9c\x8f\x9a\x5b\x51\xf1\xc5\xaf\xd2\xe9\x49\xcf\x65\x26\x1e\x66\xeb\x0e\x17\x2f\xd9\xaf\x31\x2a\xa1\x6f\x81\xef\x59\x10\xba\xfa\x43\x5f\x79\xb8\x9f\x79\x70\x17\x75\x6d\x29\xdd\x87\x5e\x69\x24\xa2\xcf\x50\xf9\x29\x7f\xcc\xd3\xe4\x60\xa0\x22\xd8\x72\x58\xa6\x7c\x09\xdd\x82\x01\x8e\x3a\xf2\x70\x3a\xd8\xeb\xc7\x1b\x06\xc9\x6e\x9a\x4b\x93\x98\x92\x88\x89\x79\x56\xd6\x40\x0a\xb3\x3b\xa4\x39\x28\xd0\x92\x14\xa5\xb4\x61\xe0\xc7\x8e\xe7\x23\xa1\xb9\xe6\x86\x8a\x46\x4b\x3e\x66\xc5\xf6\x7c\x0a\xc9\x58\x37\x19\x0f\x95\x5a\xd0\x70\x0b\x4c\x27\xa9\xd0\x6a\xe8\x8a\x66\x7d\x96\xde\x1a\x07\xb8\x62\xd8\xae\x4e\x07\xae\x05\x44\x67\x18\x2f\x9c\xa9\x1a\x70\xdd\x1b\xd1\xa3\xb7\xc5\xe7\xd2\xc9\xc0\x6f\xe8\xe1\x07\x8b\x78\xaf\xc2\xbd\xfd\x8f\x06\x35\xa9\x32\x6b\xc8\xd2\x30\xc0\x90\x68\x50\x5d\x66\xe7\xc8\x62\xc4\xa2\x26\xf5\x46\x68\x52\x6f\x84\x26\xd5\x2d\xbf\xe9\xc7\x7c\x0f\x2e\xb4\x8c\x11\x8a\x21\xb9\x24\x2b\x12\x3a\x1d\x32\x1e\x1c\x55\xd5\x93\x92\x04\x27\x6b\xe4\x9f\x01\x71\x95\x9d\x66\x1f\x2a\x2b\x7e\x8d\x9d\x2b\x2f\xe7\xb3\x41\xa4\x74\xcf\x55\xa4\x55\x34\x26\x62\x62\x73\xe3\xe1\xc4\x9c\xcc\xae\x4b\x28\x5e\x3c\x21\x26\x48\x86\x0e\xe7\x67\x97\x40\xa7\xe3\x1a\xb5\xd5\x57\xf0\x03\x74\xed\xf2\xb0\xa1\x95\x6d\x01\xb0\x87\x69\xde\xe3\x3a\x2a\xc9\xbb\xd0\xa8\x9a\xfe\x58\x3c\xec\x72\x0b\x93\x67\xa4\x61\xae\x2b\xfb\xbf\x54\x8b\x78\x2d\x3f\x9d\x0e\x9c\xb0\x78\xf8\x83\x15\x44\x9f\xc3\x35\x7c\xe6\xd2\xe4\x02\x02\xcf\xd7\x7d\xf0\x3d\x96\x5f\x60\x11\x4d\xea\xf6\x5a\xa9\x4c\xd1\x88\xcc\x20\x29\xa8\x1e\x77\x3d\x6f\x35\x2f\x9b\x63\xab\x6a\xb5\x6b\xbc\xf3\x68\xa5\x13\xd9\xac\xda\xe8\x5a\x06\xf7\x20\xee\xa9\x29\x03\xac\xea\xa2\xf6\xa8\x18\xf8\x49\x12\x8a\x4b\xdf\xab\x9a\xd1\xb0\x84\x13\x57\xf4\x8d\x94\x78\xaf\x81\xe1\xae\x8b\x90\x6a\x5b\xaa\xe8\x81\x56\x31\x1d\xdb\x1a\x0d\x5b\x3c\x4d\x14\xcf\x3d\x51\x71\xb3\xcd\x35\x70\x91\x19\xe6\x0c\x0c\x26\x4d\x78\x11\x8c\xbd\x5b\xe2\xd3\xf5\x2c\x27\x69\xab\xd6\x82\x82\xd4\xcb\xf6\x2f\x45\xef\x1f\xca\xb8\x41\x30\xef\x6b\xae\xba\xb7\x61\xef\x81\x8c\x1c\x2a\xd8\x33\x19\x7f\x4e\xd2\x4a\x44\x22\xaf\x44\x35\xa7\x24\x96\x68\x87\x1e\x12\xfc\x55\x1a\x72\xbc\xe4\xa0\x60\xb6\x92\x83\x94\x14\xc8\x72\x1e\x92\x08\x0d\x4d\xc1\x87\x1f\x94\x03\x94\x98\xa0\x3a\xd3\xa9\xbc\x30\x33\x39\x3b\xaa\x34\x98\x49\xeb\xe1\xc4\xf4\xc4\x41\xda\xe3\x76\x62\x69\xf2\xd2\xde\x7d\xd1\xdd\xc6\x7b\x7e\x94\x0f\x7b\x96\xc8\x88\x26\x70\xa4\x94\x19\xc1\x8b\xee\x76\x39\x0d\x20\x0f\xca\x64\xed\x60\xb1\x34\x05\x49\xf8\x48\x12\xea\x1e\x97\x71\x8a\x34\xd1\x47\x29\x1a\xfb\x50\xa7\xa4\xf8\x88\x19\x40\xc4\xb3\x47\xe1\xbe\x81\x1d\x84\xe3\x52\x38\x3b\x32\x7c\x55\x32\x90\x6a\x51\x9b\xf0\x64\x7b\x37\x21\x18\xc8\xb4\x90\xa1\x65\x4a\x46\x89\x25\x6f\x51\xe0\x0c\xdc\x0a\x8c\xa2\x9e\x18\x32\xee\xc3\x35\xff\xbf\xb8\x3a\xaf\x60\x0f\xd7\x2d\x9b\x48\xcc\x60\xd2\x60\x8b\xb5\x51\x96\xca\x44\x3c\xeb\xa7\x34\x11\x0f\xbb\xe1\x50\xe5\x36\x29\x6b\x53\x4e\x2e\x52\x35\xc3\x46\x75\x91\x4b\xce\xd0\xe5\xb2\x24\x86\xb2\xa9\x51\x45\xff\x6e\x17\x5a\xec\x66\xc0\x1c\x94\x34\xd7\x70\xd7\x02\xbb\x8d\x7f\x6c\xf6\x57\xfc\x67\x77\x81\xc4\x25\x67\x23\xf1\xf0\x08\xb0\xc9\x5c\x47\x2e\xfc\x90\xee\x16\x15\x9d\x04\xd9\xa5\x46\x2b\x72\xd7\xf5\x5b\xac\xda\x55\xb3\xe5\x86\xaa\x3f\x0c\xa5\x4a\x9b\x48\x39\xd9\x1a\x03\xe0\x57\x04\x94\x1a\x23\x91\xe5\x90\x44\x51\xd1\x55\x4a\xf1\xa4\x1e\x2c\xd5\x8e\x7d\x32\xb1\xfb\x25\x91\xc6\x81\x27\x60\xaa\x20\xfa\xfa\x5e\xab\xf5\x48\xe7\xaa\x2c\x86\xd5\x24\xef\x1b\x89\x3e\xe5\x1c\x9a\x55\xcf\x9f\x78\xc7\xbb\xf3\xfc\x85\x6d\x27\x09\x5e\x76\x9e\x63\xd6\xf6\x11\xbc\x50\x6b\xa5\xca\x89\x0d\x95\x26\x94\x57\xce\x88\xe3\x47\xc8\x3f\x4d\x72\x5c\xc5\x0d\xf2\x0a\x85\x24\xc6\x82\xa5\xd8\x6b\x2a\x6e\x9c\x6c\x95\xd7\x57\x98\xb1\xe2\xa6\x3c\x4a\x7c\x05\x12\x45\xfd\xeb\x62\x5e\x6e\xd6\x5f\x8d\x47\x8a\x33\xe5\x7a\x29\xfc\x8f\x46\xd9\x7d\x2e\x9b\xa0\xe9\x41\x7a\x14\xb9\x0b\x78\xc8\x5f\x3b\x70\x0b\xea\xdf\x84\x27\x3b\xdb\x81\x91\x9e\xb1\x79\xca\x7c\x06\xcc\x20\xe9\x06\x75\x64\xae\x4b\x2a\x8a\xea\x90\xf7\xcc\xbd\x4e\x35\x56\x5a\xdb\xbf\xaa\x80\x13\x58\xd0\xc4\x94\x93\xeb\x21\xc4\xd8\x84\x02\x8b\x9b\x35\x8e\x71\x90\x28\x98\x31\x11\xdb\x8d\x3c\x76\x13\x4c\xc5\x38\x0c\x89\x13\xad\x33\x5c\x9c\x3d\x22\xb4\x75\x43\x53\x2c\xdb\xa4\x6a\xec\x83\x4c\x73\x49\xc8\x74\xee\xc5\x2d\x36\x9c\x6f\x13\x64\xa7\x62\x92\x0e\xd8\x44\x37\xc1\x19\xce\x7a\x04\x21\xc6\xc0\x67\x19\x4b\xf8\x20\xac\x81\x66\xd2\x72\xab\x75\xb5\x9e\x72\xa8\xe2\x66\x05\xd5\xf4\x28\x8f\xb3\x97\x57\x8d\x4c\x0e\x99\xc5\x2d\xe5\x2a\x5b\xb2\x44\xf2\x22\xd9\x76\x59\x2a\xcf\x12\xe4\x64\xab\xdf\x0a\x56\xb9\x6c\x32\xdb\x24\x67\xea\x6b\xea\x44\xd1\x8a\xa5\x6c\xa4\x52\x63\xde\x12\x31\x4e\x36\xeb\xa5\x0d\x64\x0d\x7b\xcb\x91\xba\x14\x42\x9f\x31\xeb\xba\xf2\x17\xb3\xd5\xad\x42\xf0\xd3\x65\x43\x3b\x3f\xbd\x38\xbd\xf8\x8f\xb3\xc3\xce\xd1\xc9\xa7\x8b\x83\x93\x77\x87\xf0\xf1\xf0\xe2\xcf\xa7\xef\x95\x29\xb1\xd5\x8e\xfd\x19\x03\x19\xb8\x9b\x04\x51\xe2\xa2\xc9\xaf\x68\x06\x51\x30\x5d\xc4\x99\x9b\x2c\x2f\x52\x7b\xd5\x17\x6f\x25\xcf\xda\xa2\xfe\x5f\xb0\x7a\x9f\xbd\x81\x3e\x54\x33\xed\x5d\x16\x5c\x87\x68\xeb\xa6\xcc\xb3\xe2\xb4\xd7\xe0\x16\x54\x9a\x35\x6c\x18\xec\x35\xc2\x22\x94\x8e\x9e\x66\xd0\xe4\x80\x07\x0e\xad\x37\x25\x32\x7c\xe1\xef\xe4\x73\xc1\x24\x24\x6c\xa8\x82\x91\x6a\x99\x29\x87\x7d\x48\xbc\x69\xa5\x51\x16\xd9\xd9\x55\x0e\xd8\x38\xd6\x16\xe2\xce\x8e\x8b\x16\xf4\x54\xbc\xa2\xf2\x90\xc9\x6f\xba\x59\x0f\xed\xe2\x18\x79\x11\x8c\x43\xe2\x30\x1d\x17\x77\x4c\x2e\xc6\x9b\xa8\xaf\x2c\x18\xc8\x99\xd0\x9f\x41\xab\x0a\xe8\xac\xc3\x73\x25\xb8\x36\x07\x2b\xf2\x44\x4c\x08\x44\xce\x8c\x57\xce\x94\x0c\x42\xa6\x73\xca\xa3\xc1\xf3\xc3\x30\x47\xb6\xd2\x29\xe4\x41\x02\x2f\x02\x5c\x36\x2c\xc2\x9e\x64\xa9\xbb\x32\x59\x70\x7b\x86\xcb\x0d\x91\x12\x4b\xc4\xdf\xa2\x83\x63\xe5\xed\x37\x39\xf4\x87\xcc\x76\x5e\xab\x9f\x8b\xe5\x61\x9c\x22\x4b\x39\x86\x99\x31\x56\xcd\xaa\xc9\x5a\x5c\x39\xc8\x1c\xa9\x33\x86\x13\x1d\x67\x77\x5e\x99\x3b\xf9\x16\xdc\xea\xed\x20\x30\x9f\x37\x5d\x3a\xba\xa4\x47\xcc\x1a\x5c\x0e\x52\xa1\x35\xd4\xf4\xd1\xc0\xba\x0e\xb7\x92\x1f\x12\x77\x9c\x91\x33\xb9\xf0\x95\x9a\xc9\xf4\xd2\x90\x7c\x6d\xf4\x96\x18\x49\x18\xb4\xec\x9c\x65\x25\x8e\x4c\x98\x14\x5f\xe3\xc3\x91\xf4\x0c\x71\xbd\xbc\xbd\x4a\xbc\xaa\x5f\xc1\x2d\x53\xe1\x31\xe9\xe3\x56\xc8\xf5\x3e\x0b\xc0\xa4\x05\xe4\x73\x86\x6e\x0a\x3e\x6f\x70\x59\x29\x27\x57\x3c\xf1\xda\xd0\x87\xa3\xc2\xdb\x13\xe8\xc3\x49\xe1\xed\x11\xc5\x24\xf3\xd6\x86\x0e\xc3\xaf\xf0\xd6\x2e\x40\xb0\x95\x70\x6d\x25\xdc\x13\x0e\xf7\xa4\xf0\xb6\x08\xf7\x44\x09\xf7\x84\xc3\xcd\xbe\x3d\xe2\x70\x8f\x0a\x6f\x8b\xe3\x70\xa4\x84\x7b\xa4\x80\xfb\xb8\x9b\x24\xcb\xc1\x86\x77\xcb\x72\x31\xb9\x91\x3c\xbb\x4e\x36\x56\x67\x38\x0c\x42\xd4\x8a\xc4\x01\xbc\x3f\x7c\x77\xf4\xf1\xe0\xf8\xb7\xb3\xe3\x83\x77\x87\x9f\xf0\x8a\x21\x13\x46\xa6\x94\x49\x30\x44\xde\xae\x18\x83\xc0\x6c\xb1\x6b\x31\xe2\x2d\x23\x23\xc6\xac\xef\x06\x26\x6c\xe5\x3a\x60\x65\xb1\x7f\x18\x8f\xae\x30\x3f\xc2\x01\x58\x84\xf1\xc0\xe1\x28\x44\x80\xa2\x1d\x50\x4e\x4d\x7e\xfe\xf2\x93\x56\x71\xf4\xd1\xfe\x99\xe2\x91\x4e\x03\xbe\x5a\x77\x2e\xb6\x1f\x38\x17\xb6\x45\xd9\xee\x83\xb6\x45\xee\x60\x5d\x22\xa1\x90\xcf\x0b\x67\x2a\x2e\x5e\x2a\x09\x28\xa9\x5f\xb6\xb8\xb4\x1b\x39\xd3\xa8\xc4\xc7\xfa\xac\x8d\x0d\xb1\xcd\x8f\x7c\x5e\x77\x38\x77\x1e\x41\xc6\xe0\xe7\xd1\x7f\x32\x51\xbc\x55\x55\x16\x47\xb5\xea\xb7\x10\xc6\xb7\xbe\x0b\x1d\x6e\x26\x84\x6f\x48\x8b\xbc\xb1\x0b\xda\x16\x25\xc8\xf1\xda\xeb\xfb\xf9\x63\x10\xe4\x9b\x87\x91\xe3\x26\x23\x1b\x84\xea\x15\x6f\xdc\xee\x1e\x3e\xca\xa7\xe1\x21\x6d\x95\x1f\x32\xc6\x31\x59\x77\xbc\x5f\x18\xc7\xbb\x0e\x03\x39\x8d\x8f\x79\xd4\x1b\x3c\x7e\x2b\xf7\xf0\x53\x4b\x7b\x8f\x3e\x0b\x0e\xe0\x32\x26\x89\xdd\xee\x86\x83\xea\x45\x1f\x18\x9c\x35\x56\xfa\x93\x27\xe2\x84\xf0\xed\xbb\x99\xc4\x8d\x28\xf6\xb0\x6a\x07\xf9\x0e\x8c\xa4\x82\xdf\x36\xe9\x2b\x3c\x7d\x5a\x72\x64\x79\xc3\xcf\x4d\xe9\x41\xa7\xf7\x1d\x06\x88\x65\x6d\xdd\x74\xee\x99\x73\x77\xf5\xc1\xc0\x1e\x46\xdf\xa1\x5b\x3e\x19\x3b\xb1\x77\x4b\x1e\xd2\x37\x0e\x82\xcf\xfb\x09\x19\xaf\xd3\x55\xd6\x53\x7a\x86\xfb\x0e\xbd\xc5\xd4\x13\x2d\xfb\x01\x9d\xfd\x4f\x12\x06\x9b\x92\x35\xfb\x24\xb2\xdc\x7e\x87\xee\x6e\xa0\xc5\xda\x70\x60\x68\x4b\xc9\xae\x3c\x5d\x7b\x57\xd6\x78\x79\xaf\xb7\x2b\x7f\x1f\x12\x4a\xc7\xf4\x7b\x6e\xc9\x62\x7c\xb3\xfb\xf1\x74\xfd\xfd\xf8\xe5\x63\xef\xc7\xad\xec\x86\xbc\xc1\xf8\x83\x0f\x2d\xd4\x2b\xf8\x85\xb7\x2a\xfd\x4a\x0b\xf5\x0a\xad\xa3\x9c\x7a\xa4\x85\x0a\x8b\x96\x5f\x78\x6d\x2b\xf4\x2e\x2a\xc8\xb6\x1a\xf2\x09\x87\x9c\x57\x9b\xb4\x94\x2a\x16\x15\xe0\x13\x0e\x38\xaf\x20\x69\x29\x55\x2c\x2d\xa5\x8a\x45\x05\xf7\x48\x01\xf7\x71\x0f\x3f\xe8\x2b\x5b\x95\xb6\xcb\x48\x98\x01\xa3\x64\x1b\x2d\x06\x6b\x90\x2d\xc6\x5f\x42\x17\xb4\xd8\x82\xe5\xf1\xc5\xca\xe0\x7d\xc6\x75\xaa\xfa\x12\x0e\xcb\x61\xaf\x53\xb9\xa2\x5a\x5c\x73\x0d\xbc\x2a\xc6\xa9\x63\x78\xab\x8b\x0f\x30\xa3\xbd\xae\xa5\x4c\x36\x7b\x43\x50\xa4\x27\x0e\x9a\x18\x0e\x74\x81\x14\x4e\x9c\x13\x43\xd4\xa5\x4f\xde\xd8\x8f\xc0\xf5\x46\x23\x12\x1a\x1a\x71\xe0\x49\xbf\x24\xdc\xcb\x0a\x6f\xcb\x5a\x03\xfd\x65\xa6\xb8\x32\x6b\xcf\xa7\x8b\xa8\xbe\x5a\x2f\xec\x18\x5e\xe6\x89\xbc\xe9\xa9\xb0\xa7\x9f\xc7\x15\xc1\xe1\xad\x56\xb8\xdc\x29\x11\xb3\x0c\xaf\x4a\x54\xf1\x4b\xc2\x12\xd3\x93\xd2\xe0\x4e\x7c\x6a\x85\x06\x40\x6f\xc0\xc4\x01\x0f\x45\xc6\xfb\x64\x96\x31\xab\x7f\x3d\x19\x76\x0b\x56\xd0\x80\xbd\x02\xfd\xd1\x52\x4b\xd8\xc3\x04\xff\x25\x91\x73\x38\x4e\xe6\x5c\x03\x02\x1f\x2a\xa3\x30\x94\x84\x69\xab\xb6\x0a\x08\x1b\x04\xc4\x7b\x45\x41\xac\x98\xd3\x26\x33\x47\xb7\x60\x29\x39\x72\x8a\x77\x01\xc3\x84\xfe\x32\x08\xe2\x09\x7a\xb9\x7e\x29\x35\xac\xe4\x83\xc3\xb0\xaa\x30\x3e\x4b\x5e\x70\x09\x7b\x95\x8c\xf3\x8e\x0e\x0f\x0f\xe1\xc5\xce\x36\xd4\x7b\xb6\xbd\xdb\x80\xe7\xed\xad\x3d\xdc\x71\x70\x6b\xb1\x59\x84\xc2\xc4\x38\x2f\x0e\x52\x25\x4f\x29\xf4\xac\x4e\x16\xd3\xf1\xee\x53\x90\x7b\xe6\x84\xc7\x6b\x99\x08\x2c\x33\x59\xfd\x97\x44\x03\x77\x95\x29\xb6\xd2\x15\x63\xab\x46\x4a\x8a\xa5\xe3\x32\xef\x49\x4c\xc2\x99\xe7\x13\xb8\x9b\x78\xc3\x89\xd8\x54\x06\xde\x98\x1e\x06\x4b\x2f\x82\x90\x25\x13\x68\x41\xd9\xc2\x62\x17\xf8\xc7\x17\x94\x11\x3b\x95\xbc\x0f\x29\xe8\x96\x63\x36\xd3\x89\xb1\x97\x0f\x8c\xdc\x84\x43\xba\x2c\x09\x74\x1c\x63\x92\xb1\x8d\x6c\x69\xe2\x76\x48\x6e\x49\x18\xe9\xe7\x01\xb8\xd1\x47\x48\xd2\x78\xc8\x94\x42\x51\x42\xa5\xe2\xa6\x08\x04\x68\x30\x0c\x62\xf7\x70\x74\xe3\x72\x5e\xc1\xa0\xd5\x7a\x05\x71\x15\x67\xbb\x0c\x72\xca\x8e\x95\x9a\x58\x4b\xe1\xbb\xb9\x66\x5b\x78\xd1\x33\xbb\xcb\xc1\xaa\xcc\x14\xf6\x1a\xaf\x40\x39\x75\x70\xa2\x92\x7c\x1c\x5f\xf3\x8e\xad\xa4\x77\x0d\xd8\x07\x07\xf6\x8c\x11\x9a\xd8\x90\x50\x68\x03\xbc\x5e\x84\x01\xbc\x86\xeb\x57\x30\x68\x36\xcb\xd9\xa2\xb0\x4f\x1b\xa0\x79\xda\x0a\x3f\x54\xb2\x9c\x66\xbd\x60\x35\x5f\xb3\x8a\xe5\xb6\x66\x15\xec\xde\x1e\x29\xac\x72\xa7\x03\x4b\x8a\xd7\x3e\x60\xb8\x6e\xca\x29\xf8\x11\x88\xf9\xad\xf3\x7b\xe0\x8a\x0c\x80\xf6\xb6\xc1\x97\xa1\xc5\xb8\xce\x6a\x68\xb1\x2d\x39\xb6\x04\x8b\xd7\x8b\x52\x03\x9c\xf0\xeb\xdc\xe4\xb6\xf2\xe6\x6d\xa6\xe8\x94\x72\x10\x71\xda\x95\xe5\x90\x62\x16\x4d\x82\x30\xd6\x61\xce\x62\xd1\x89\xac\x93\x8e\x9c\x21\x7f\x25\xd7\x06\x27\x4a\x13\xb9\x61\x82\x60\x5a\x07\xcb\x45\xb1\x13\xa2\xaf\x53\x82\xb7\x81\x4b\x0e\x32\x11\xc8\xf9\xf2\x5c\x26\x99\xa4\xb4\x9b\x08\x1d\x1d\x11\x47\xb3\xc2\xfd\xfe\x6a\xc8\x32\x9c\xe9\xdc\xd9\x45\xf3\xd7\xf0\x86\x32\x89\x0a\xfc\x9a\x45\x30\x65\x64\x7c\x5d\x4e\xff\xa9\x61\xe2\xf5\x2b\xf0\xe0\xe9\x53\x14\x47\x5a\x2d\xef\x8a\x75\xf7\x0a\x97\x62\x89\xb5\x71\xab\x85\x45\x4b\x42\x68\x21\x3e\x4d\x36\x3c\x9b\x45\xd1\x42\x08\xad\x3e\xeb\xda\x5a\x02\x6f\x6a\xb5\x99\x35\xcf\x77\x7c\x97\x7b\x72\x4a\x31\x72\xc5\x65\xf5\x54\x63\x3e\x99\x3a\x4b\x27\xba\x25\xdc\xb8\x59\x8e\x28\x0b\x5a\xad\x95\x3e\x96\x3e\xf7\x68\x31\x9d\x41\x96\xe5\x52\x60\xa7\x03\x1f\x82\xe9\x34\xb8\xa3\x5d\x51\x88\x51\x7a\xd1\x1b\x43\x64\x30\x09\xab\x69\x03\x0c\x16\x31\xc8\x22\x57\x41\xe6\xba\x73\x42\x37\x52\xde\xae\xe5\x1e\xc6\x38\xd4\x72\x57\x49\xb4\xc6\x15\xb7\xe1\x5c\x95\xdb\x70\x0a\x91\x74\xdd\xd9\x97\x98\xc7\x30\x09\x17\x93\x58\x27\x39\x11\x34\x97\xd0\x82\xe6\x8a\xee\x1c\xc9\xeb\xa7\x4f\xa1\x45\x5f\xb7\x32\xaf\x75\x4d\x50\x88\xfc\xee\x82\x85\xa9\xd7\x0c\x96\x38\x58\xa6\x21\x91\x57\x16\xf2\xe2\x95\x32\x3e\x5f\x15\x8d\x0e\x7a\x4f\xda\xd0\x87\x9c\xca\x02\xdf\x9f\x68\xde\x1f\xd1\xf7\x59\xfd\x0d\xd8\xf0\x03\x52\x42\x4e\x83\xd3\x12\xef\x5b\x76\xb1\xbc\xaa\x5d\x5b\xd3\xae\x2d\xda\xcd\xc1\x39\x11\xed\x9e\x14\xdf\xab\xe0\x9f\x68\xe0\x9f\x08\xf8\xb9\xf7\x47\x1a\xf8\x47\x1a\xf8\x47\x1a\xf8\x47\x2a\xf8\x8f\xac\x04\x0a\xdc\xc5\xb4\xb2\x86\xb3\x8d\xae\xc3\x3c\x21\xa2\x8b\xc2\x68\x04\x41\x4e\x01\xfd\xf1\xf4\xfd\x4f\xc7\xa7\x95\x8c\x6b\x78\xeb\x7d\xf6\x71\x4d\xb5\xd1\x67\x0b\x0c\xba\x20\xa1\x2d\x32\x2a\x83\x34\x5c\xc2\xa0\x0c\xd2\xf3\x7b\x91\x97\x20\x39\x0f\xe7\xac\x11\xf1\x58\xbc\xe2\x97\x4d\xe2\x88\x6c\x90\x09\x9e\x2c\xdb\x5c\x73\xd0\x8e\xe8\xff\x2b\x76\xcd\xf0\x64\xd5\x2e\xf5\x48\x5d\x57\x99\x94\xed\xc3\x32\x39\xe5\xcb\x3d\xc0\x2e\xe9\x51\x96\x4d\xea\x29\x8a\x14\xe3\x25\xc7\x78\xb9\x21\xc6\xcb\xf5\xd4\x4c\xd8\xb6\x44\x7c\x74\x3f\x78\x59\xae\xcd\x59\x0c\xa7\x9e\xeb\x39\x3e\x4b\x02\xeb\x05\xfe\x1e\x7c\x86\x3e\x46\x04\xab\xaf\x1a\xf0\x0c\x98\x67\xdc\x12\x3a\xe0\x0c\xa2\xfa\xaa\xa1\x77\xd0\xee\x74\xd0\x41\x9c\x32\xf1\xcf\x2b\xc0\x08\xce\x24\x24\x94\x0f\xbd\xee\x43\x08\xaf\x39\x00\x6d\xfd\x48\x68\x15\x75\x05\x56\x26\xb3\x76\xfa\x7c\x66\x19\xb2\x31\x04\xd8\x0a\xed\x95\x94\x76\x22\x59\x78\x86\x06\x3f\xb7\x23\x78\xa6\x2d\x51\x7a\x7a\x2e\xa2\x23\xcf\xcf\x46\xc9\x0b\x96\x4c\xd5\x5c\x87\xcf\x6d\x8c\x43\x48\xe7\xe8\x5b\x1b\xc1\xe9\x98\x27\x5e\xaf\x12\x37\x73\xc5\x86\x31\xc6\x66\x8b\x69\xec\xcd\xa7\x1e\x33\x60\xcc\xe4\xc0\x57\xf2\x41\x0e\x08\x19\xa1\x5f\xf1\x86\x75\x6d\x17\x09\xe6\x13\xd1\x5a\x32\x76\xa2\xb7\x37\x7e\x90\x7b\x04\xf8\xd0\x54\x5e\xf7\x34\x95\xd7\x3d\x4d\xdc\xdf\xf2\xb7\x3d\x4d\x96\x36\xa6\xf0\x56\x75\xd9\xa3\x82\x6b\x2b\xe1\x9e\x70\xb8\xf9\xfb\x9b\xa6\xf2\xae\x47\x05\xf7\x84\xc3\xcd\xdf\xd4\x34\x95\x77\x3d\x4d\xe5\x5d\x8f\x0a\xee\x91\x02\xdf\xc7\xa5\x54\x4c\xa3\xf1\x48\x57\x3d\xf3\x29\xbf\xe9\xa1\xe7\xe2\xf5\xb6\xec\xf8\x5b\xdf\xee\x28\x6c\x45\xe0\x7f\x6e\x77\x2a\xdf\xee\x30\xd6\xfa\x7f\xf6\xf5\x0e\x5f\x6c\x92\x37\x89\x97\x38\xef\xa4\x2f\xcb\xb2\x4f\x29\x6e\x7d\xf2\x69\xb0\xd0\xde\xfe\x31\xee\x75\xbe\xc7\x05\x8d\xee\xee\x28\x77\x69\xb3\x32\x5e\xd3\x80\x83\x9e\x54\xeb\x91\xd7\x1f\x74\xef\x51\x45\xd7\x0e\x1f\x9c\x08\x6d\x38\x03\x58\xa0\xcd\x07\x2a\xca\x29\x9f\xf5\xc1\x0d\x60\xe1\xa3\x92\x46\xa3\x93\x57\x5e\x8f\x18\x87\xdf\xa9\x94\x02\xf0\x71\x6e\x2b\xaa\xdc\x8b\x3c\xca\x05\x4c\xc5\x6b\x11\x5d\x21\xa1\x1d\x73\x1e\xf1\x42\x43\x8d\x52\xe6\xd2\xc1\xb0\x6f\x94\xa7\x8a\xca\xeb\xd5\xa7\x81\x3f\x26\x21\x53\xaf\x5b\xa8\xcb\x19\x24\xd9\xb0\xb9\x9e\xb9\x54\x83\xec\x40\x0b\x6f\x2f\x28\x7d\xc4\xb2\x92\x3d\x51\xbb\xc7\x16\xbf\xfc\xd1\xa7\x71\xf2\xa7\x2b\xa1\xb7\x76\x51\x21\x27\xab\xaf\xd1\xe5\xcc\x61\x52\xc4\x68\x11\x22\x5f\x4a\x63\x82\x2d\x87\x30\x74\x7c\x18\x10\xf0\xc6\x7e\x10\xea\x62\x2b\xa4\x37\x2e\xf6\x2b\x18\x98\x53\x07\x3a\xec\xd2\x67\x78\xd9\x6a\x0d\xae\x92\x1b\x93\x26\xbf\x6a\x69\x82\x83\x31\x32\x51\xfd\x6d\x8c\x05\xc4\xea\xfd\x60\x52\x05\x1b\x4e\x94\x8e\x09\xc5\xe5\xb0\xcd\x17\x79\xdd\x31\x90\x5c\xb3\xb9\xd2\xac\xc7\x35\x55\x88\x8c\x7b\x33\xfd\x61\x93\xeb\x0f\x6d\xae\x38\x6c\x72\xc5\xa1\x3a\xd8\x79\xa7\xc3\x18\x83\xf0\xbe\x6f\x42\x17\xe6\x41\x14\x79\x03\xd9\xe7\x41\x7a\x1e\x5f\x6f\xa8\x74\x0c\x55\x04\xde\x36\x3b\x87\xea\x32\xbe\x5f\x7e\xb9\x82\xdf\x07\x41\x30\x25\x8e\x7f\xcf\xe0\x7f\x85\xbf\x8a\x58\x66\x01\x0c\x83\x85\x1f\x0b\x2b\xe8\x16\xba\x33\x65\x1d\x1c\xf7\xd0\x46\xd0\x62\x16\x7b\x16\x74\xe9\x26\x69\x97\xca\xc2\x49\xb6\x66\x34\x7d\xca\xca\xc3\x5f\x36\xf7\x1f\x2d\x97\x86\xb9\x58\xa4\x5f\xcd\x69\xca\x81\x7a\x23\xcd\xc8\xc8\xb3\x9f\xb0\x71\xa2\x5d\x1c\x78\xbe\x13\xf2\x0b\xd8\x3d\xf8\xfd\x8b\x26\x0b\x0a\x32\x99\x2f\x72\x72\x77\xfa\xa5\x0f\x4f\x9e\x7c\x49\xbf\x74\xd3\x8f\xe6\x7d\x4a\x91\x4b\xa5\xbb\x65\x41\x4d\xa0\x59\xa3\x47\xaa\x20\x7e\x1b\x04\x53\x0b\xbe\x98\xb8\x79\x8a\x17\xc5\xa4\x01\x5f\x8c\x39\x4f\x4c\x9a\xa3\x6a\xce\xb8\x79\x27\x5c\xbd\xcb\xee\xad\x9c\xe7\x4e\x1f\x6e\x2d\xef\x2e\x6b\x16\x4e\xd7\x77\xd5\xe5\x31\x8b\xca\xec\x02\x4a\xfc\x73\x4d\x38\x1d\xb8\x6e\xf5\x68\xda\x65\x78\xdc\x26\x59\x08\x6f\x45\x0c\xa0\x14\x17\x7d\x24\x22\xd3\xc4\x22\x81\xf2\x98\x77\xf0\x06\xb3\xb2\xfa\x50\x16\x05\xef\x41\x3e\xc5\x8f\xec\x98\x36\x73\x96\xde\x6c\x31\xcb\x9d\xda\xdd\x79\xde\xab\x5e\x9d\xb1\x00\xa5\xfd\x38\x00\xbb\xe8\xfb\x0a\x21\x89\xe6\x64\x18\x7b\xb7\x64\x4a\x8f\x0e\x32\xf8\x60\xe6\xc5\x71\x66\x1b\xcf\xb2\x5b\x77\x7e\x05\xbf\x0b\x3e\xfb\x3e\x1b\x8b\x10\x8e\x84\xb3\x89\x4d\x5b\xfe\x78\xf0\x33\x0b\x15\x1f\x79\xb7\x19\x5b\x68\xb8\x0c\x67\x12\x94\x73\x19\xf3\x3c\x90\x5d\x35\x08\x19\x1a\x4b\x7f\x52\x6f\x14\xa2\x0d\x48\xb9\x54\xf7\xe0\x77\x77\x2e\xf3\x38\x7d\xb5\x6c\xc2\x55\x53\xc5\xec\x90\x17\xea\x85\xb3\x4a\xf5\x72\x68\xe6\x6a\xa9\x76\x1f\xac\x9f\x55\xc1\xb8\x73\x0b\xc2\x99\x51\x09\x53\x4c\xc9\xcc\x95\x86\xfa\x25\xe4\xce\x29\x5f\x10\xa1\x54\xbd\x08\xf3\xf1\x1e\xf9\x31\x6b\xcf\xb6\xe8\x14\x5b\xd0\xdd\x29\x49\x78\x2c\x7c\x1b\x2d\xf8\xfb\xdf\xdd\x39\x34\x33\x0e\x8d\x2c\x64\x27\x6f\x43\x0b\xe2\x89\xdc\x38\x25\x6e\xdb\x82\x5d\xda\xb4\x95\x8c\xe6\x47\x3a\x98\x0d\xd8\xcf\xd1\xfa\x1e\x6d\xe1\x7e\xed\xe3\xe7\x1f\xcc\x08\x50\xb8\x64\x6a\xe4\x1b\x4e\x98\x72\x03\xf5\x79\x70\x47\x42\x64\xb5\x5d\xbb\xd1\x86\x4f\xb4\x78\x26\x3c\x2f\x8b\xb1\xfb\x86\x8e\x13\xa6\xd1\x95\x62\xb9\xe2\x2f\xaf\xb3\x42\x8e\x0c\xfc\x26\x5d\x9a\xc9\x5a\x6c\x51\x01\xf2\xd3\xc1\x87\xc3\xdf\x8e\x4e\x2e\x0e\x7f\x3c\x3c\xe7\x0b\x3c\xfb\xae\x74\xa9\x1e\x8d\x58\xd4\x3a\x99\xe6\x11\xbf\x34\x43\x34\x97\xc3\xe2\xf4\xc2\x51\x84\xc2\xfb\xef\xff\xc2\x68\x2c\x37\x2c\x45\x44\x20\xab\x65\x32\x1c\x4c\x38\x63\x68\xf9\x05\xb7\xa1\xc8\x49\x47\xf2\xd2\xbd\xc9\xad\xdc\x42\x8d\xdc\xa2\xbd\x29\x5d\xb3\x08\x21\xb3\x66\x6f\xca\x16\x2b\xbb\xc6\x54\x95\xe0\xc4\x29\xaf\x89\x1b\xc5\x1c\x59\x85\x19\xb2\xa0\xfb\x3c\x23\x6f\x35\x8c\x5b\x3c\x1d\xea\x2e\x69\xf6\xba\x6c\x33\x11\x69\xb2\x6f\x1a\x6c\x4e\xe6\x61\xe0\x2e\x86\xa9\x66\xc4\x73\xa6\x74\x28\x31\xf1\xb7\x7e\xc3\xdf\x07\x9f\x5f\xe1\x40\xad\x4b\xa8\xc8\x27\x03\xd6\xdf\x92\x15\x34\x4c\x3e\xbb\x67\xf4\xd9\x35\x23\x46\x42\xa6\x08\x17\x49\xf5\xfe\x1e\x6e\x78\x04\xb2\xcc\x7b\x73\xc8\x65\x8a\x67\x44\xd7\x1a\xa3\x38\x1e\x54\xbc\xcb\xd4\x77\xc6\x9a\x7b\xea\x0c\xdd\x55\xae\x51\xa2\xcf\x61\x5c\x6f\xf9\x8d\xa2\x55\x00\xfe\x02\x27\xba\x5f\x5a\x47\xda\x3a\xec\x97\x23\xc5\x2f\x76\xa3\x68\x25\xc1\xa0\xe1\x2f\x19\x3b\x8c\xcd\xf8\x5b\xf4\x79\xe1\x84\x04\xc2\x20\x88\xcb\xa2\xff\xc8\x0d\x7c\x93\x90\x1e\x0c\x97\x73\x8a\x0a\x9e\xda\x3e\x87\xd5\x7c\x59\xe9\x7a\x9c\x61\xa2\xa4\xd0\x82\x90\xcc\xad\x87\xdd\x67\x94\x2a\xb6\x23\x76\xe1\xa1\x2f\xc0\x95\xee\x86\xa4\xc5\x73\xe8\xe7\x87\xab\x09\xdb\xfa\x0a\x13\x67\x3a\x2a\x88\x07\x35\xbb\xbd\x53\x33\xa8\x4a\x85\x8b\x68\xe7\xc4\x39\xe9\x08\x4e\xdc\xd1\xeb\xac\x59\x88\x6d\x7e\x62\xbc\xbf\xa7\x27\x2f\xfc\xbb\x91\x91\x00\x3c\xc1\xeb\x4c\x16\xf8\x0d\xd7\x3e\x03\xc7\xa1\xed\xa3\xb5\xc5\x1e\x08\x5f\x8a\xae\xd0\xba\xab\x1a\xd0\x6b\x63\x8e\x7c\x0f\xb9\x1a\x89\x62\x6f\xe6\xc4\x44\xcd\xd5\xe8\x84\x7d\x74\xe2\x49\x9b\xad\xaa\xe6\xd2\x64\x31\x92\x14\x84\x85\x88\x5b\xd8\x09\x8c\x89\xd4\x3b\x1d\x38\x73\xa2\x88\x25\x2d\x4b\xab\x63\x44\x53\xbe\x3d\xa3\xea\x99\x9b\x18\xf2\x08\xfa\xcc\xcc\x50\xc4\x6d\xc7\x9d\xd4\xa0\x47\x8c\x92\x08\xf5\xf8\x89\x0f\x97\x29\x09\x39\xba\x14\x92\xd1\xe8\x82\x67\x9f\xab\x0f\xcb\x8e\xeb\x94\x63\xf3\x13\x74\x13\x53\xf3\xff\x00\x3d\x11\x1e\xd7\x87\x66\x1f\x6a\xb6\x26\x1f\x5c\x71\x90\x7d\x43\x63\x99\x1b\x01\xcc\xe0\x2e\x12\xeb\xf4\xb8\x0d\x31\xe1\x09\x19\x80\x20\x0e\xa6\x83\x6e\x3a\x3a\xe5\x63\x22\xc6\x85\x6f\x6b\x06\x5d\x7c\x25\x3d\xbb\x8f\x59\x7f\x33\xf9\x10\x4d\x2a\x71\x51\xc5\xe7\x97\x1c\x54\x48\xf2\xdb\x98\x47\xe3\x74\x54\xaf\x91\x5a\x83\x8f\x83\x19\x33\xfd\x50\x84\x05\x0e\xa1\x9b\x85\xd2\xee\x15\x41\x41\x04\x4d\x9e\x2a\x7c\x0d\xb4\x92\xec\x05\x23\xa3\x61\x15\x1a\xe8\x0c\x83\xc5\xd4\xa5\x72\xa4\xb8\x02\x13\xc1\x3f\xbd\x08\x86\x13\x2a\xc8\xb9\x52\x7a\x0d\xdc\x9c\xd8\x56\x75\xe7\x44\x30\xc4\xe8\x19\x1a\xed\x36\xa6\xd6\xf0\x22\xb8\xc3\x16\x86\xce\x22\x22\xe0\x24\x76\x45\x54\x86\xc7\x26\xeb\xcb\x4e\xdc\xc0\x6d\x6b\x42\xfc\x21\x49\xcd\xac\x06\x64\x1a\xdc\x59\xdc\xd1\x46\x02\xa2\x6b\x2c\xb3\xee\x98\x68\x1f\x66\xf2\x10\x4a\x0f\xd2\x6f\x58\x6e\x89\x45\x17\x4d\xd8\x36\x10\x06\x5d\x7f\x74\x25\xb9\xf3\x92\x65\x4e\x59\xf2\x16\x34\xb0\x82\x29\x1f\x02\xee\x20\x77\x71\xe0\xb7\xce\x9d\xf9\x24\x0a\x7c\xf0\x62\x12\x96\x08\x8f\xd9\x70\xdf\xba\x52\xc0\x6f\xa0\x14\x19\x22\xe5\x87\x52\x21\xdd\xf9\x84\x38\x1a\x33\x37\xc7\xc4\x74\x29\xb6\xf0\x9c\xdb\x65\x8e\xd1\x55\x12\x48\x64\x39\x22\xc4\xed\x21\x00\x34\xa4\x05\x19\x71\x07\xeb\x3a\x5d\xaa\x46\x78\xf4\xc9\x81\x0b\xdb\x43\x8a\x48\x0e\x5c\xd5\xb4\xf2\x17\xb9\x7d\x21\xc4\x9c\x87\x68\xa9\x36\x20\x10\xf8\x24\x17\x59\x60\xe4\xf9\xce\x54\x9c\xc1\x44\x45\xbd\x08\x21\xb5\x44\xda\x63\x96\x26\xe2\xe5\xcb\x97\x2f\xa1\x4e\x5a\xdb\x0d\x68\xb5\xde\xb0\x0c\x12\xf4\xfb\x56\xc3\x82\x28\x10\x1b\x56\x44\x3f\x17\x23\xe4\x47\x55\x9a\xa2\xc2\x25\xb2\x38\xe2\xc2\x30\x08\x43\x32\x8c\x75\x06\xf5\xf2\xc3\x57\x05\xdd\x07\xe8\x46\xd4\x6a\x19\x6c\xe1\xc4\x93\xe1\xad\x11\xb4\x60\xcb\x42\xa6\xd5\xad\x14\xa0\x9c\x4f\xc0\x76\x3c\xc9\x27\x51\xe1\x99\x27\x3d\x9f\xe7\xb9\x46\xcb\x31\x3a\x24\x3c\x6c\xc1\xf6\xa6\xe3\x82\xa3\x1f\x84\xb0\x8d\xb3\x80\x06\x6a\xce\x7c\x1e\x06\xce\x70\x82\x97\x7c\x29\xdc\x01\xfd\xe0\x84\xab\x06\x66\xa6\xf4\xfc\x05\x5e\x66\x57\x69\xa7\xc2\x92\x15\x0f\x8f\xeb\xd8\xef\x43\x8d\x62\x56\x43\xe1\x2f\x24\x73\x3c\xc3\xe1\xeb\x6d\x7c\xbd\x4e\x1c\xff\x53\x39\x4c\x7f\x82\x0b\xba\xe4\x58\xfc\xfe\x2c\x0e\x20\x22\x68\xa1\x9a\x74\x77\x31\xe7\x79\x85\xaa\x74\x92\xb7\x44\x96\xce\x30\x16\xab\x81\x5f\x87\xfa\x9e\x4f\x22\x9c\x3f\x8f\xb1\x72\x32\x5d\xd1\x03\x02\x71\x2a\x26\xc5\x63\x17\x10\x74\x0c\xd6\x09\x2e\xcf\xd5\x69\xb1\x05\x31\x2a\xd1\x0a\x82\x7e\xcf\x2a\xb1\x30\x51\xe2\x11\x73\x36\x18\x37\xda\xe4\x73\x7d\x69\x56\xe9\x29\xf1\xa2\x47\x9f\xf5\x02\xde\xaf\x19\xd6\xbd\x3c\xea\x3a\x54\x8f\x7e\xef\xce\xa9\xcc\xa9\x09\x0d\x98\x7f\xa2\x35\xca\xd2\xf9\xac\x14\x48\x7e\xed\x2c\x35\x47\xa3\x3c\x2f\xc0\x85\xee\x2f\xa6\x53\x0b\xec\xdf\x6d\x6b\xfb\x2b\x5d\xf0\x3b\xf4\xe3\xd6\x57\x4b\xba\x41\x46\xf2\xad\xda\x0c\x3f\x2d\xd0\xe6\xfc\x20\xe6\xa7\x8b\x34\x71\x71\xce\x0a\x80\x0a\x34\xb3\x34\x5f\x05\x26\x3e\x5e\x87\xfc\x9b\x3e\x72\x82\xa6\xe0\xad\xdd\x06\xd7\xea\x4c\x9c\xf0\x20\xae\xdb\x0d\xe4\x0d\x3b\x6b\x30\x06\xde\x8d\x0b\xae\x56\x12\xca\x4f\xc6\x25\xd6\x4d\xc3\x22\x1e\xbe\xea\x42\x0b\xf7\x0d\xf5\xaa\x53\x06\x89\xd4\x3d\x33\xe8\xc3\x93\x90\x2f\xba\x90\x2f\xba\xaa\xd9\x07\x2a\x15\xab\xb8\xba\xca\x32\x15\xac\xf7\x8b\x59\x9f\x5e\x3a\x8a\xdd\x5c\xa4\x53\x0b\x66\x9b\xea\xd2\x7c\xb4\x3a\xcb\x9b\x0e\xd3\xb7\x2a\x93\xe4\x67\x4a\x93\xe4\x67\xca\x08\xbf\x2a\xb8\xb6\x12\xae\xcd\xe1\xe6\x0d\x8a\x9f\x29\x4d\x92\x9f\x29\x4d\x92\x55\x70\x4f\x94\x70\x8f\x38\xdc\xbc\xf1\xb1\x0a\xee\x91\x12\xee\x91\x62\x1c\x36\xd3\xfc\xe9\x6e\x36\x90\xde\x1f\xcb\x26\x99\x01\x43\x3f\xa2\xc5\x74\x4d\xa3\xe4\xa1\x05\x44\xca\x83\x3e\xb3\x60\x39\x3c\xb6\x60\x39\x0d\x2c\x58\x4e\x3c\x0b\x56\xf4\xeb\x8a\x7e\x5d\xd1\xaf\x5f\x0c\x2a\xbb\x81\x13\x11\x0b\x55\xa7\x6f\xe9\xa7\x07\x68\x07\x2b\xda\xbd\xd6\xb9\xe1\xf3\x0b\x4b\x6f\xe4\x0c\x0d\x93\x9d\x48\x6a\xcb\x6c\xe5\x42\xa8\xff\xf7\x7f\xd9\x66\xc7\x51\x61\xe2\x9a\xe6\xc9\xab\x18\x56\xa4\xe0\x30\x95\x09\x8e\x8f\x97\x0c\xf4\x1c\x82\x31\xdd\xf0\xd6\x6a\x42\xd8\x75\x8e\xec\x95\x54\x6a\xac\xca\x1c\x1d\x84\xff\xd4\x72\x28\xfc\x8d\xd9\x25\x01\x47\x7c\xc5\xbd\xaa\x92\xd7\xcb\x61\xb9\x89\x65\x7b\xc8\x2d\x98\xfb\xdc\xba\x5a\x6f\x30\x02\x95\xa3\x4c\x30\x57\x1b\xbd\x85\x7b\x71\xf0\x94\x36\xca\x99\x48\xf8\x15\x92\xbe\x65\x4c\x95\xcb\x05\x3d\xb9\xef\x15\xd2\xb2\xe4\x11\xb6\xf3\x98\xda\x66\x14\x2b\xe7\x1a\xe2\x5e\xbd\x76\x85\x58\x0a\xab\x2a\x19\xfb\x4c\xdb\x5d\x99\x86\x7a\x3d\x7f\xe1\xac\x9a\x72\x99\x0f\x88\xd9\x94\x7e\x5c\xe5\x7e\xd4\x98\x42\x4b\x94\xa4\xfa\x7d\x39\x3c\x2e\xb7\x65\x5d\x61\xa1\x0a\xd6\xac\x87\x7e\xb4\x08\x09\x5d\x5e\xf3\xc0\xf3\x63\xb4\x95\x96\x2d\x5a\x71\x01\xd3\x26\xe3\x00\xa8\x98\x58\x6a\xca\x4a\xcb\xbe\xc6\xf6\x1b\xf0\x65\xa8\x8c\x1c\xf1\x65\x68\xf1\xf8\x0f\xc7\x16\xef\x0e\x63\xd2\xf8\xd1\xd3\x23\xcb\xf5\xf8\x1e\xb3\xd2\x4e\x8e\x71\x88\xe7\x9d\x17\x4f\x4c\xd9\x95\xe4\xac\x4a\xc7\x68\x87\x7a\x6c\x31\x04\x2f\xaf\x5e\x81\xd7\x6a\xbd\x82\x2f\x99\xfc\xe5\x4a\x28\x74\x87\x00\x93\x2d\xaa\xd8\x3c\xa0\x0f\x9f\xfe\xfd\xfc\xc2\x94\xbb\x21\xc5\x68\x35\x3c\x7e\x05\xad\x16\x66\x7f\xb7\xcd\x3a\xb1\xa1\x99\xf6\x57\xe8\x8f\xbb\xc2\x60\x0c\x3f\x24\xc8\x18\xca\x4f\xbc\xa4\x7c\x27\x45\xfe\xde\xa8\xf2\x4b\x93\xbd\xe1\x0c\xb2\xdc\xd1\x4d\xb8\x61\xa1\x27\xbc\x72\xa5\xde\x12\xb1\x44\xdb\xe0\x9b\x6a\x78\x62\xad\x09\x9b\xbd\xcb\x9b\x22\xae\xa6\x7a\x54\x42\xa7\xfd\x7c\x86\xed\x36\x11\xce\x33\x3a\x52\x25\xcd\xb1\xa1\x9c\x06\x49\xc5\x3a\xd4\x61\x26\xa1\x8b\x09\x3a\xa4\x2f\x4d\xf8\xc2\xe2\x56\x80\xc1\x64\x1e\xf8\x24\xd6\x11\x68\x87\x51\x14\x1a\xb6\x60\x0b\xb3\x5c\xd7\xf0\x35\xc7\x7e\x52\x92\xa9\x93\xb6\xce\xb2\xa5\x51\xd0\x3f\x20\xe8\x8d\xae\x08\x58\x37\xfa\xba\x6e\x18\xcc\xf5\x86\xa6\xa9\x6f\x36\x75\x76\xd4\x65\xdb\xc4\x97\x24\x62\xc6\x46\xd6\x3f\x19\x2b\x68\xca\x7d\x1e\x68\x04\xfd\x6d\x4d\x03\x23\x57\x65\x57\x6d\x30\x0f\xcc\x5a\x4a\x79\x23\x08\x67\x68\x9d\x53\x62\x03\x18\xb9\x92\xf5\xde\xa7\x42\x8b\x92\x09\x5f\xf7\xbb\xda\x01\xc6\xc1\x7b\x44\xa0\xde\x80\xd4\x40\x3b\x67\xa9\x13\xb9\x39\xd3\x1e\x65\xa5\x82\x41\x90\xa9\xda\xba\x66\x80\xda\xaa\xeb\x5b\x02\x0a\x50\xd9\xa3\x4f\xe4\x6e\x6e\x0c\xa8\xaa\x80\xf6\xed\x91\x2b\xdb\x02\x66\xec\xf1\x68\x7b\x5d\x61\x0c\xb8\x6b\x49\x26\xe8\x35\xbc\x8a\xc7\x4a\x7b\x14\x82\x96\xe5\x86\x33\x96\x61\x5d\xd7\x84\x64\xf2\xb7\x5b\xd9\xe4\xcf\x68\x3c\x15\xb9\xb0\x2f\x59\x26\x26\x63\xb6\xf7\x50\x9b\xbf\x88\x5d\xcb\x85\x64\x1e\x92\x88\xf8\xb1\x32\x6b\x8a\x1c\x7e\xd8\x57\x1a\x50\x51\xf9\x49\x86\x9f\xe4\xa7\xc5\xe5\x9c\xed\x70\x1c\x80\x3b\x87\x91\xb7\x24\xae\x3e\x3f\xf8\x3f\xbe\x1d\x6f\xee\xaa\x7b\x7d\xc3\xdc\x12\x00\x65\x06\xc1\xf9\xea\xeb\x2f\x6c\x53\xfd\x4d\x56\xb7\x04\x6f\x2d\x7b\x5f\x11\xe2\x9f\xee\x5f\x71\xbd\x44\xe5\xe0\xce\x65\xff\x0e\xad\x95\xef\x4b\x5c\x69\xdc\x82\xb7\x4b\xd7\x09\xaa\x9d\xe9\xca\xa4\xbf\x7d\xf7\x45\x83\xe4\xde\xc2\xc3\x47\xba\x68\xc4\x90\xcb\x8d\xe8\x57\xc7\xe3\xef\x8d\x27\x41\x4c\xf6\xc0\x89\xd8\xa9\xe2\xdf\x9c\x5b\xe7\xd3\x30\xf4\xe6\x71\x2d\x12\x7e\x12\xf1\x6a\x4e\x2c\xa8\xb7\xec\x46\x3b\x0e\x3e\x50\xa4\xe8\xb1\xc1\x8b\xa0\x66\xd7\x32\x36\x70\x83\x45\x0c\xa4\x3d\x6e\xd3\xb2\x6d\xdb\xb6\xed\x6e\xa1\x46\xcb\xae\xfd\x93\x2d\x70\x86\xfe\x46\x4b\x5b\x53\xb5\x7c\x51\x8b\x8a\xeb\x2f\x67\x75\xcd\x4d\x16\x32\x42\x7a\xf0\x12\xae\xb8\x54\x7b\x26\x03\xd5\xfd\xa2\x11\x7e\x66\x2d\xf7\x94\x66\x72\x7f\xd8\x5a\x26\x99\x4d\x90\xaf\xd7\x59\x71\x81\xb2\x45\x9e\xa5\x0d\x66\xfa\xce\x46\x30\xce\x5b\x93\x52\x8c\xe6\x61\x30\x27\x61\xec\x65\x8d\xeb\xb9\xcd\xdc\x87\xd3\xf3\x8f\x07\x17\x10\x0c\xae\xc9\x30\x86\x7a\x44\x88\xe4\x8d\x38\x0c\xfc\x91\x37\x6e\xe8\x28\x9d\xd7\xed\xcb\x73\xfb\x8c\xfd\xc7\x51\xfc\x44\xe6\x4e\xe8\xc4\x41\x08\x7b\x50\x6b\x67\x57\x3e\x3e\xe3\x30\x58\xcc\x33\xa5\x2c\x6d\x29\xef\x0b\x81\x3d\xd8\x2a\xfe\x1c\x91\x61\x80\x37\xfb\x3f\x4a\xe5\xec\x62\xb9\x51\xe8\x20\x59\xfe\x58\x68\xf4\x97\xe5\x81\x5d\xb3\x80\xc7\xf4\x0b\xfc\x16\x5e\x2a\xd1\x31\x8c\xe6\xce\x90\x94\x40\xe2\x0d\xca\xa5\xbe\xbe\xfa\x27\xe3\x58\x6c\x01\x6e\xc6\xb2\x34\x75\x2b\xf0\x2c\x51\x73\x03\xa6\xa5\xae\xba\x11\xd7\x42\x50\x6b\x7b\x1a\x45\x31\xa6\x3e\xdb\x84\x73\x75\x1f\xc2\xb9\xf4\x96\x38\xcc\xc0\x81\xe5\x6c\x31\x29\x1a\x58\x5a\x02\xa3\xae\xc4\x09\x69\xe7\xa2\x38\x6c\x47\xf3\xa9\x17\xd7\x6b\xed\x5a\xc3\x5c\x63\xdc\x85\x3e\x34\x19\x4b\x68\x27\x0b\xb6\xa4\x4e\x4f\xaa\x53\x5c\xc5\x25\x95\xb3\xab\xb8\x0f\x99\xb6\xc5\x7b\x33\x0c\xcf\x8f\xcf\x1c\x34\x90\x77\xc2\xf0\xd2\xbe\x32\x97\x16\xab\x5e\xaa\xd2\x2d\xa9\x22\x72\x0a\xa5\xa9\x82\x4a\x11\x4a\xce\xba\xac\xee\xbe\x40\x32\x35\x1c\xd8\x13\xaf\xcc\xb0\xa6\x84\x9e\x7f\x13\x88\x66\x5d\x37\x70\xfa\x19\xf7\x1a\xa8\x73\x1d\x77\x2d\x36\xa5\xe3\x9e\xc5\xe6\xc9\xb3\x10\x62\x4b\xaf\x82\x16\x30\x68\xc5\x37\xcc\x60\x9d\xd6\xa8\x12\xd7\x83\x36\x49\xcb\xfe\x40\xeb\xde\xdf\xc3\xb8\xc4\xb0\x24\x9d\xb8\xb4\x7f\xd1\x62\x10\xc5\x21\x5a\x0c\x7a\xa5\x86\x41\xc2\xc4\xd2\x83\xd7\xb4\x61\xfa\xa1\x49\x7b\x5d\xe9\x9a\x48\xb4\x4e\x6b\x64\xa9\xb0\xa9\xc0\xc7\xc3\x91\x2c\x31\x7a\x28\x31\x76\x60\xc3\xda\xe3\x63\x59\xb1\x7d\xa4\x17\xaf\xa4\x61\x0a\x19\x29\xad\x21\x0d\x6a\xad\x55\x63\xa0\xe8\xf7\x8d\x74\xa4\x9c\x3b\x4a\x2b\xc6\x88\xc5\x7e\xda\x27\xb1\x8e\x0b\x22\x04\xd3\x30\x67\x78\x46\x71\x1f\x2e\xf3\x76\x92\x31\x6a\x87\x04\xb7\x2a\x96\x90\xf2\x9c\x8c\x0f\x97\xf3\x3a\xd4\x7e\xf9\xc5\xfd\x9d\x76\x7f\xdc\x83\x26\xd4\xbe\xfe\xf2\xcb\xdb\x9a\x05\xb5\x71\x0d\x4a\x38\x20\x7d\x6a\x7f\x7a\x5a\x4b\xbb\xa0\x11\x38\xca\xfc\xaa\x32\x7c\xa6\xac\xb0\x71\x92\xcc\x8a\xdf\x28\x56\x98\x0e\x6f\x20\x03\xb3\x1b\xa6\xca\x92\xb0\x13\xd1\xaa\xde\x6c\x3e\x25\x49\x57\xf1\x24\x29\xb7\x91\xee\xff\xf4\x48\x49\xd8\xc0\x61\x88\xe9\xf4\x07\x97\xf8\xc1\xcc\xf3\xe9\x4f\x2c\x76\xab\xf4\x22\x31\xd5\x72\x60\x1e\x44\x5e\xec\xdd\x66\xb3\x9f\xf2\x68\x51\x1c\x49\x7d\x66\xac\x68\x4e\x86\xde\xc8\x23\x6e\xa2\x8d\xce\xb4\x7a\x34\x4a\xf5\xd4\x32\x7c\x19\x13\x8f\x89\x51\x09\x24\xe6\xf6\xa9\xc2\x95\xc5\xcb\xb9\x23\x51\xcc\xf1\xf2\xc9\x90\x44\x91\x13\xae\x20\x0e\x32\x6a\x32\x31\xd6\x72\x6c\x02\xb4\x7e\xcb\xd8\x05\x67\x25\xcf\x59\xaa\xd0\xbe\x67\x33\x77\x9f\x4c\x4a\xe2\x04\x8b\x71\x09\x70\xa0\x5f\xa7\xb6\x08\x38\xb8\xaa\xfe\x1b\x04\x4a\x3e\xb1\xf5\x06\xad\x98\xe9\x6d\x41\xbc\x9b\x15\xd5\xce\x86\xea\x39\x11\x2f\x57\x59\x23\xe2\x09\x32\x93\x85\xbc\x99\x6b\x12\xef\x9c\x30\xb4\xc0\xb5\x2d\x70\x7b\x68\x42\x43\x96\x73\xf4\x8e\xf3\x6d\xab\x24\x38\xef\x0d\xf4\xb9\xa7\xef\xb7\x35\x91\x71\x0b\xda\xed\xd3\x93\x43\x03\x8f\xf2\xe9\x76\xee\xda\x6b\xd6\x72\x69\x2d\x5f\x5d\xcb\x24\x87\xce\xdc\x44\x14\x36\x6d\xa9\xdc\x25\xba\xcf\x3c\xa2\xf5\x5b\x4d\x51\x95\x3f\x73\x0d\x3b\x5b\x02\xf6\xa6\x4c\x56\x79\xc2\xae\x6a\x7d\x96\xf6\xb3\xde\x80\x06\x06\x10\x6d\x4f\x63\xec\x62\xb5\x54\x19\x22\xdc\x4b\xb9\xe8\xc0\x03\xc2\xf4\x7a\xe5\x7b\x09\x7d\x6a\x79\xea\xaf\xe1\x36\x78\x03\xfb\x50\x93\x17\x42\x8d\x9e\x62\xb3\x0b\x8b\xee\x57\x74\x16\x1e\x26\x78\x74\x3a\x79\xaf\xf5\xbd\xb2\xf2\x47\x23\xda\x6c\x26\xff\x6c\xe0\xb7\x3c\x89\xbf\xf0\xbb\x0d\xf0\xd0\x9b\x5f\xe2\xe8\x94\xef\x2c\x22\x02\x65\x26\xab\x33\x4a\xfb\x4f\x6e\xb8\x09\x2d\xfd\x2f\xbd\xe3\xf0\x45\xcc\x85\x2e\x34\xda\xe3\x98\xb0\x69\xdc\x07\x9f\x1f\xa3\x1e\x29\x63\x51\x62\x5e\x94\x26\xbe\x6a\xc7\xc2\x89\x45\x77\xc5\x54\x70\x27\x5c\x0e\x2b\x65\x27\xf2\xb8\x87\xa6\x9a\xf7\x66\x6b\xf1\xa1\xc7\xd8\x09\x2c\x74\x42\x62\x65\x36\xf3\x7c\x64\xe1\x79\xa2\x8a\x27\x4e\xba\x3f\xc9\x17\xb4\xc5\x2d\x45\x7a\x08\x65\x27\x68\x6d\x14\xa5\x31\x83\x96\x6d\xa2\x8f\x1c\xe4\xf2\x14\xa2\x70\x76\xfa\xd7\x4f\xbf\x5d\x1c\x9e\x5c\x42\x9d\x32\x56\x74\xba\xfa\x41\xb6\x0b\x62\xae\xe8\x52\x84\x21\x5a\x6a\x0f\xff\x6a\x6c\xa0\x18\x49\xcc\x5c\xb6\x7a\x87\xb3\x79\xdd\xc5\x6c\xd7\x98\x6c\x8b\xf0\x4f\x2e\xa5\x81\xae\xb8\xff\x52\xf7\x0a\xd1\xe1\x91\xc4\xd4\x4d\x89\x30\x63\xdc\x5b\x52\x1f\x25\x29\xcb\xb0\xb4\x51\x48\x3a\x1d\xc6\x60\x91\xd5\xaa\x23\x9c\xf9\xb6\x18\x3a\x9d\xe5\x89\xec\x33\x56\x25\x68\xb5\x6f\x81\xab\xcf\xf9\x2f\x1e\xb7\x87\x7b\x06\x77\x19\x13\x51\xa9\xdd\xae\x31\x08\x21\x8b\xaa\xd2\xc3\x49\xa0\x9b\x2c\xba\x95\x36\xca\x0c\xb5\x5d\x36\x04\x86\x02\xb8\x7f\x69\x82\xe0\x82\xd8\xe0\xfc\x3c\xb2\xac\x0f\x38\xeb\x06\x9c\xd9\x04\x18\x80\xe3\x6e\x9b\x0f\xcf\xcd\xc7\xa7\x04\xb4\x01\xb2\x86\xb9\x30\xb0\x74\x9a\x66\x2e\x6f\xd3\xb5\x1b\x16\xb8\x5d\xf3\x94\xb1\x7d\x5a\x8c\x80\xdb\xe3\x68\xfa\xfa\xf9\x62\xa3\x5e\xa8\x61\x98\x61\xdf\x66\x46\xa1\xdd\x76\x04\x06\x3b\x3c\x02\xcf\xfa\xd0\xab\x9e\x7a\x2d\x39\x09\x78\x11\x0c\xa7\x41\xc4\x02\xdb\x2d\xa9\xc0\xd5\x71\x31\xf9\xb1\xdf\xed\xb8\x5d\x25\x38\xa6\xad\x62\x64\xdd\x65\xa3\x44\x72\x16\xf6\xd0\xe0\xe3\xb8\x6c\xb4\x9d\x41\x54\x6f\x20\x75\xea\x67\x1b\x61\xd9\x4c\x02\x2c\x85\x85\x0c\x4b\x8d\x1a\xe0\xa9\xf3\x92\x0e\x57\xba\x3b\x50\x14\xa5\xaf\x70\xa5\xad\xba\x47\xab\xda\xd9\xaa\x76\xa6\xaa\x66\x84\x53\x1e\x45\x96\x1a\x5f\x56\xbe\x6d\x39\xe1\x83\x4e\x81\xa6\x93\xde\x30\xf0\x6f\x49\x18\x0b\x7b\x1e\x7e\x4e\x99\x87\xde\x0c\x0f\x64\xa5\x76\xef\x01\x87\xb3\x4e\xc4\x78\x43\x5a\x8c\xd4\x9e\x13\x8f\x7e\x13\x27\x12\xde\x94\x68\x51\x64\x4c\x31\xd3\x5c\xd2\x8d\xa5\x8e\x71\xe6\xf7\xf1\xef\x33\x8c\x94\xc2\xd3\x57\x6e\x3e\x7e\xce\x86\x86\x52\x54\x8e\x4c\x22\x1e\xb1\xcd\xde\xcf\xdc\x11\x1c\x8d\xc0\x97\xd3\xa4\x73\x99\x6b\xe3\x60\x23\x14\xe0\xd9\xe9\x5f\x7f\x3b\x3b\x3f\x7c\x77\xf4\xe9\xe8\xf4\x44\x1c\x6c\x6d\x21\xce\xc5\x41\xae\x80\xc2\x8a\x43\x77\x64\xf4\x55\xe1\x98\x5e\xda\xf6\x8b\xee\xcb\x97\xbd\x9d\xed\x17\xdb\xf6\xcb\x97\x3d\xda\x42\xe1\x9d\xfa\x86\xa4\x7e\x46\xc2\x51\x10\xce\x22\xd8\xd9\x86\x69\x10\xcc\x53\xc7\xc9\x08\xf7\x4a\x9f\x0e\x6a\x1e\x58\xbb\xa1\x3b\xd1\xce\x83\xbb\x7a\x23\xf5\x2c\x2e\x9c\x61\xfd\xdc\x11\x36\x57\x3e\x77\x68\xf5\x2b\x9c\x59\xcf\x70\x4e\xfb\x70\xd6\x9e\x07\x77\x99\x25\xe0\x9b\xd6\xc0\x8d\x05\x86\x34\xc3\x1e\xf4\x61\xe6\xc4\x13\x96\xf6\x83\x45\xc8\x82\x7d\x68\x51\xe1\xb8\xe9\x9b\x34\x5c\xe5\x6b\x0b\xc3\x77\x48\x56\xfb\x22\x8e\x07\x45\xdf\x1b\xa5\x63\x91\x8b\x8c\x65\xca\x12\x23\xdf\xa0\xf8\x15\x63\x3f\xf5\xb6\x2c\xa8\x89\xc6\x6a\xd0\x80\xa7\x4f\xb5\x9d\xc2\x26\x3e\xe0\x21\x85\x8e\xea\xfd\x3d\x78\xaa\x10\x4a\x18\x79\xc5\x87\x0e\x8b\xe2\x61\x08\xe0\x46\x9f\xb9\x13\x46\xe4\xc3\x34\x70\x62\x0a\x92\x9e\x81\xd1\xf3\xa2\xce\x7c\xa1\x28\xbb\x28\x0b\x24\xa7\x8c\x04\x23\x86\xb2\x0e\x4d\xba\x29\xea\x45\x0e\x7d\x10\x09\xee\x2a\x48\xd7\x24\x71\x86\x13\x76\x14\xf1\x86\x1e\x66\x6a\x43\x1d\x1e\xb2\x69\x2e\xcb\x07\x23\xb8\xe1\xa1\x22\xb0\xb4\x48\xfb\x31\x64\xf7\xd6\xe4\xf3\xc2\x89\x89\xda\x4d\xbb\xd3\x41\xc6\x94\x36\xa7\x30\xd8\x2c\x30\x8b\x26\x5c\xf6\x76\x2d\xd8\xee\x5e\x59\x2c\xcf\x08\xf3\xc5\x4c\xf4\x78\xba\x96\xc4\xa1\x26\x18\x41\x6f\x17\xc6\x0b\x27\x74\x45\x1b\x21\x89\x1d\xcf\x27\x6e\x1b\xea\x3f\x21\x2b\x6a\x42\xb7\xbd\xc3\x83\x4f\x8c\x29\x4f\xbc\x7c\x69\x41\xaf\x7b\x95\xa9\xd6\x56\xab\x5c\x6f\xd8\xb9\x45\xc2\x78\x1f\x97\xd2\x90\x78\xd3\x7a\xee\x97\x8e\x7c\x74\xe9\xe1\x79\xa3\x72\x6a\x6e\x83\x62\x25\x1b\x17\xc2\x2c\x7f\x7b\x2c\xe8\x4b\x99\x5f\x0e\xba\xbb\x30\x99\xaf\xcc\x2f\x33\x4d\x7f\x54\x2a\xcb\x27\xc5\xf1\x88\xbe\x6a\x27\x41\x65\xdf\xc0\x0d\x34\xe4\x17\xa8\xa1\xd1\x81\x30\xe8\x26\x72\x8c\xcc\x63\x71\x6f\x4a\xce\x24\x4f\xbc\x14\x75\x23\xa3\x5b\x56\x18\x92\xb4\x7f\x3c\x15\xd4\x32\xdf\xcd\x65\x85\x6e\x9a\xb4\x0b\x3e\x0f\x79\x4e\x27\xe9\xf4\xe4\xb0\x4d\x85\x52\x5d\xfa\x0a\xce\x34\x6e\x52\x73\xcf\x95\x95\x25\xca\x82\x10\x0b\x7b\x2a\x5f\x9e\x6f\x69\x03\x23\x59\x77\x2b\xad\xb8\xe5\x46\xd4\x46\x6b\x05\x93\x18\x54\xc1\x47\xa8\xf3\xc8\x06\xf6\xc8\x07\xec\xcd\x08\x1b\x92\x5a\x3d\xa7\x4b\x17\x9a\x28\x8c\xa1\x9d\x89\xe5\xa6\x31\xe0\xe1\x8e\xe3\x99\x40\x36\xcf\xca\x82\x05\xfe\xb3\x58\x9a\x9f\x49\x51\xb6\xd7\xb3\x1b\x57\xd7\x2c\x37\x53\x97\xeb\xad\x6f\x15\xa2\xaf\xbd\x89\x61\xc8\x99\x14\xff\xbc\xba\xe1\xb9\xd2\xa4\x2d\x72\x75\x86\x21\xb2\x59\x79\x6f\x3b\x67\x56\x6e\x38\x52\x32\x3b\xf3\xac\x69\xc8\xf6\x1f\x60\xd4\x86\x0e\x3a\x03\x34\x26\xc5\x8f\x5d\x74\x82\x1c\x80\x97\xb5\x36\xe3\x06\xa5\xec\xc2\x0c\x0b\x7a\x91\x7c\x25\x86\xd4\xc8\xc2\xb4\x30\x28\x56\xb5\xf3\x4a\xa6\x1b\x05\xc6\x90\x36\x95\xbb\x81\x63\x1a\xd1\x4c\x5f\x26\x4e\x24\x5d\x16\x26\x2b\x58\x6e\x00\xf5\xa4\x5e\x94\xde\x10\x06\x21\x8c\x31\x66\x56\xc8\x18\xcf\xc5\x29\x3d\x74\xff\x76\x76\xfa\x09\x07\xc4\x49\x0f\x5f\x89\xf4\x2b\xd7\x4d\x38\x96\xdc\x08\x87\x71\x72\xf8\xa3\x25\x68\x69\x2d\x6e\x32\xb8\x52\x1c\xa5\xf0\xe8\xf4\x7c\xbb\xd2\xa2\x4f\x74\x0b\x38\x76\x85\x65\x37\x28\xac\xba\x6c\x85\xdc\x4a\x1b\x54\x58\x68\x3c\xc6\x97\xbc\xca\x06\x25\xa6\x57\x86\x6b\xae\xd2\xab\xb5\x88\xc5\x38\x32\x87\x9e\xf4\xdb\xc4\xe4\x69\x99\xc9\x7e\x69\x70\x24\x27\x18\x08\x2b\xb9\x06\x33\x4a\x13\x51\x99\xb4\xc6\x8c\x2a\x6a\xa2\x75\x43\x1c\x41\x90\xe3\x94\x51\x19\x82\x57\x45\xbb\x0e\xe5\xbd\xbf\x78\x2a\xf9\x24\x73\x68\x27\xce\x89\x01\x07\xcd\x75\x4a\x19\x7c\x06\x3b\x17\x14\xcc\xa7\x52\x67\xd9\x55\xde\x40\xeb\x58\x33\xb0\xa0\x67\xc1\xf3\x6d\x0b\x7a\x3b\x16\xd4\x28\x9d\xd6\x4a\x4e\x61\x29\x2a\x04\x5e\xf7\xa5\x55\xc9\x22\x2a\xbe\xe9\x4b\x8b\xdd\x08\x04\x90\x5d\x67\xbd\x26\x90\x82\xa1\xcc\x5a\x05\x50\x01\xc8\xcd\xaa\x31\xaf\x4e\x5a\xf1\x51\x26\x90\xeb\xe7\xde\x3a\x11\xa9\x6b\xda\xb1\x60\x40\xf7\x19\x0b\x39\x72\x64\x6c\xb7\x34\xbc\x24\x0f\x62\xea\x8b\x38\x7d\x95\x68\xf2\x1b\x9b\xb0\x6c\x1e\xe1\x83\xc7\xdd\xe1\xba\xcd\xbb\x49\x30\xcd\x5a\x98\x14\x12\xba\x28\x79\x5f\x02\xa5\x2f\xbe\x55\xd2\x76\x66\x03\xcd\x28\x7c\xdc\xac\x4c\xf8\x79\xe5\x85\x01\x0e\x53\xc5\x71\xa2\xe7\x75\xb6\x16\x2d\x74\xd9\x70\x03\xb6\x31\x0c\x87\x64\x1e\x8b\x6d\x56\x04\xdc\x2e\xeb\x34\x0e\xe9\xe9\x88\x75\x39\xf8\xb7\x4f\xa7\x27\xeb\xf4\x19\xfb\x65\xba\x69\x55\x4f\x7f\x07\x0e\xa6\x9e\x13\x11\xa6\xfd\x7b\xeb\x8d\x85\xed\xf5\x8c\xc4\x93\xc0\x55\xb8\xc5\x77\x3a\x22\x0b\x24\x4b\x0b\xf9\x4a\x06\xc6\x7e\x62\x52\x0b\x71\xc1\x19\x04\xb7\xc5\x28\x6b\x14\x42\x24\x92\x9a\x60\xfc\x16\xcf\x67\x70\x10\x42\xb4\x18\x54\x81\xc0\x15\x2f\x2b\x36\x5e\xf4\x5c\x9a\x40\x98\x2d\xa6\x55\x20\xb8\xde\xad\xe7\x12\xac\xef\x7a\xb7\xc5\x11\xa3\x65\x42\x32\x73\x3c\xdf\xe5\x2a\xc7\x59\xe0\xaa\x8b\x0d\x83\xd9\xdc\x09\xc9\x05\xcb\x6b\x3d\x9c\x29\xee\x16\x68\x31\x96\xf3\x55\xa4\x7c\x55\x4d\x08\x8f\x24\xe9\x8f\xbc\xf1\xe9\xe0\x5a\xb2\x15\xc9\xbb\x1f\xd4\x93\x42\xaa\x0d\x80\x13\x45\x52\x29\x8b\xce\xd7\x5c\xc3\x74\xd0\xce\x8f\xfe\x72\x70\x71\x08\x7f\x3e\x3c\x3e\x3b\x3c\x87\x0f\x3f\x9d\xbc\xbb\x38\x3a\x3d\xf9\x94\x2b\x99\x90\x63\x12\x9f\x42\xa9\x75\x45\x53\x6a\x2a\x2d\xa8\x3d\x31\x85\x2a\x0f\xaf\x9f\xef\xef\x31\x02\x60\x1f\x3c\xd8\x07\x0f\xf6\xc0\x2b\xde\x94\x2b\x10\x4e\x58\x55\x51\x5d\xc7\x2c\xe9\x98\xf0\x14\x8c\x12\xf9\x9b\x1f\x1b\xd5\xfd\xc9\x6e\xac\xca\x04\x60\x28\x62\x59\xf0\x45\x2d\x20\xd1\xee\x76\xd5\x3f\x5d\x43\x1f\x1c\xae\xf2\x50\x97\xa0\xd4\xe5\x50\xee\xdf\x84\x5a\x4d\x31\x9d\xb2\x59\xee\xb5\x3e\x52\x42\x84\x60\xbc\x66\x53\x00\x52\x15\xfa\x02\xfd\x54\x0d\xd7\x82\xc8\x18\x11\x44\x34\xfc\xa5\xd5\x7a\x85\xe0\x6b\x36\x6e\x4b\xba\x1d\x2f\x84\xa6\x32\x73\xb4\x62\xbb\xca\x5c\x76\x66\x93\x19\x15\x99\x0e\xc3\xe3\x1a\xe3\xd4\x72\x7c\x21\xc4\x28\x71\xef\x02\x97\x1c\xc4\xf5\x56\xeb\x9a\x05\x57\xdd\xde\x7d\xa5\x42\x4e\xec\x0c\x52\xfc\xd4\x6b\x74\x22\xb8\xbf\x2f\x6e\x03\x0a\x72\x7b\xc7\x16\x78\x76\xef\x4b\x96\x57\x04\x4b\x3c\x3b\xad\xb4\xd4\x85\xb5\x59\x4e\x6c\xe5\xf4\xa1\x6d\x9d\x05\x03\x35\x7d\x98\xad\xde\x44\x32\x54\x3d\x61\x6a\x23\xca\x5f\xb3\x68\x46\xea\x1f\x6f\x4c\xa1\xe6\xa7\x2c\x22\x90\x82\x58\x4b\x72\xe5\x0a\x95\x23\x15\x46\xaf\xf3\xe9\xb7\x0a\xa5\x59\x06\xc6\x34\x8a\x53\x71\x66\x59\x02\xc6\x34\xa0\x93\x11\x23\x75\x80\x7a\x9e\x4d\xf1\xfe\x1e\xa4\xc4\xbd\x0e\xec\xc3\x80\x27\x7e\x68\x5d\x53\xbe\xa4\x86\x6c\x4e\xda\xcb\x95\xce\x4f\xfa\x20\xf5\x55\x05\xc9\xc1\x78\x28\xaf\x55\xec\x92\xf6\xf0\x86\x0a\xf1\xaa\x01\x4a\xfb\x26\xce\x3f\xba\x21\x57\xa4\xa7\x15\xfd\xa3\xbf\xfd\x8a\x3d\xee\xd2\xde\xaa\x12\x64\x49\x6b\x20\x4d\x86\xaa\x69\x49\x1a\xc5\x1b\x78\x03\xd3\x32\xd8\xd7\x18\xd9\xe4\x46\x0e\x50\x84\xb6\x03\x75\x46\x65\xd2\xbb\x7d\xb8\x81\x3d\xdd\x38\x08\xfc\x58\x68\xdd\xc1\x4a\x17\xe5\x31\x0d\xa1\x63\x27\x2c\xd5\x6b\x36\xa1\x21\x62\x11\x5d\x7a\x57\x74\xc6\x58\x80\x9b\xd4\x90\x0d\xbf\xbe\xe1\xaf\xab\x0f\x17\x43\x5e\x31\x58\xc9\x10\xd1\xa9\xe5\x33\xa1\x1a\x2f\xb9\x4e\x81\x35\x71\xb9\x34\x35\x3c\x08\x17\x84\x65\xf9\x41\x7b\xb7\x5b\x7a\xd8\x13\x5a\x5f\xcf\x67\xfa\x07\x2b\xcd\x93\xc3\x8c\x17\xdb\x02\xc8\x4f\xf4\x4d\x10\xa6\xb9\x6e\xb0\x3e\xbb\xda\xba\x9b\x10\x3f\x6f\xf5\x98\x54\x64\xbe\xcf\x78\xc9\x47\x8f\x96\xb5\x2e\x69\x76\x6b\xdc\xc6\x89\x4a\xc6\xd2\xf5\x9f\xfc\x9b\x88\x04\x96\x13\x88\x13\xc6\xe9\xf9\xf1\x5f\x18\x06\x41\x78\x12\x1c\x86\x61\x10\x46\x78\xe7\x39\xf3\xe8\x1f\x67\xa9\x64\xa6\x7c\x60\xd9\xdd\x62\x92\x60\xc6\xa7\x27\xdb\x37\x7d\x5a\x95\x85\x1d\x7e\xdd\xa7\x10\xcc\xe3\x9b\x62\x12\x1d\x50\xe1\xa2\x1e\x0c\xae\x0d\x4d\x9e\xa2\xc3\x68\x7b\x1e\x06\x71\x10\xaf\xe6\x24\x91\xc8\xdb\x43\x67\x3a\x65\x95\xfb\x7d\xa8\x5d\x72\xcf\x52\x84\x79\x55\xab\x3a\xc7\xef\xd8\xc9\x34\x27\xdc\x1c\xf9\xdc\x5e\x94\x89\x3f\xc1\x88\xcf\x77\x24\x0a\x9c\x2e\xe2\x64\xa2\x0e\xc7\xed\xcc\x01\xb7\xd6\xdb\xd9\xa9\xb1\x73\x6c\xf7\xb9\xa0\xf5\x08\x2e\xbb\x3b\x16\x74\x77\xae\xf4\xf5\x46\xa3\x1a\xcb\x28\xd4\xb5\xa5\x6a\x3d\x0b\x76\x2c\x48\xeb\x69\xe6\x35\x0e\xde\x32\xbc\xf8\x91\x9a\x75\xc3\x12\xd8\x6a\xb7\xc8\x6b\xf5\x66\xc4\x2c\x9d\xb4\x9e\x6a\x4e\x18\x1e\xeb\x37\x47\x8d\xef\x19\xf3\x13\x8b\xe2\x50\xef\x21\x56\xf0\x96\xd2\x09\x66\x3c\x05\x6e\x18\x1e\x33\x07\xb9\x44\x86\xa1\xaf\xa8\x68\xe5\x84\xe1\x25\xfd\x7c\x05\xcf\xfa\x62\x4e\x35\x22\x16\x2d\x8a\x1c\xd3\x86\x2b\x2a\x6d\x1d\x1c\x9f\xfd\xf9\xe0\xed\xe1\x45\x92\xed\x01\x71\xe6\x01\x74\x39\x5f\x2b\xbb\x39\xbd\x86\xd7\x19\xb4\xae\xb1\x56\xd9\x5d\x2a\xc5\xe4\x9a\x72\x44\x31\x6b\x2d\x28\x77\x14\x4b\x6b\x52\xf1\xeb\x0a\x52\x35\xa0\xfc\xb2\x2c\x3a\x97\x54\xb6\xd9\x17\x88\x74\x12\x44\x4a\xa3\x7b\xf1\x1a\x3f\xf4\x45\x95\xf5\xcd\x9f\x8b\x6f\x74\x4c\x81\x8e\xac\x26\xff\xb4\x96\xdd\x68\x14\x63\x26\x66\x97\x92\x2a\xbc\x81\x2e\xec\xcb\x74\x60\x37\xe8\x89\xa0\xcd\x55\x4a\xb2\xcf\x64\x14\x87\xd0\x80\xa6\xb2\x93\x22\x59\xca\x3e\xd4\x98\x29\x3d\x69\xd6\x54\x39\x44\x0c\xbd\x50\x29\xcf\x34\x6b\x7b\x4a\x7c\x0b\xbe\xa8\xf7\xd2\x93\xfc\x85\x81\x46\xc8\x21\x5c\xaf\xab\x21\xdd\x7c\x8e\x77\x53\xd0\xbf\x2f\x78\xda\x69\xd7\x5e\x61\x04\x32\xf8\xc2\x93\xd4\xe8\x96\x25\xd3\xda\x7d\x11\x3a\x3b\x6d\xf3\xfa\x8b\x14\xfe\x18\x15\x95\x15\x98\x12\x6f\xe8\x60\x5e\xd6\x4d\x1c\xb0\x66\x93\x50\x89\x83\xf8\xc6\x85\x2b\x8f\x48\x8d\xce\x61\xab\xcf\x18\x5e\xab\x55\x61\x68\xc4\xf0\x34\xfb\x74\x7a\x55\xbf\xf2\x3e\x27\x53\x58\x86\x0f\x1b\xec\x94\x92\xe9\x71\x8e\xc5\xd2\xcb\xd3\x38\xd1\x9a\x03\x15\xdf\xe8\x96\x56\x41\x9b\xaa\xa7\xf7\xff\x9f\xaf\x2b\x68\x71\x15\x06\xc2\xf7\xfe\x8a\xc1\x77\x50\x41\x94\xf7\x0e\xef\x50\xf0\xf2\xe8\xbb\x6d\xd9\xa5\xec\xc2\x42\xe9\xc1\x36\x69\xd7\xc5\x26\xd9\xa8\x2d\x45\xfc\xef\xcb\x38\xa9\x9a\x68\xf7\xd6\xc6\xd1\xce\x37\xdf\xa4\x4e\x86\xe8\x37\xaa\x35\xa6\xde\x23\x75\xd6\x36\xa8\x1f\x3a\x23\x34\xed\xfa\x8d\x34\x02\xa7\xea\xb0\xb1\xc3\x3d\x75\x66\xc1\xfa\xff\xfd\xe5\x79\xf3\xea\x8c\x0f\x7d\xda\x14\x32\xd1\x15\x81\x81\x7b\x6f\xc0\xdc\x59\xaf\xec\x94\xa1\x47\xe8\x6f\x8a\xcb\x23\x30\x7e\xc4\x15\x3b\x56\x31\x77\xdc\x3e\xd6\x53\x34\x1e\x67\x67\x36\x4b\x1d\x1d\x0e\xec\x86\xe6\xb4\x4f\x05\xed\xec\xcd\xaa\x13\x47\x67\x24\x17\x49\x2f\x05\xe6\xe2\x92\x6b\x29\xb0\x48\x2d\xcd\x93\x1a\xb5\x52\x52\x57\x70\x96\xac\x2e\x78\x8c\x13\x4c\xbb\x6b\x94\x71\xa2\x19\x3c\x64\x8e\xf5\xbe\x5f\x0b\xf2\x92\x75\x80\xec\xeb\xcc\x82\x72\x4c\xd2\x47\x0d\x37\x18\x04\xa7\xf5\x4d\x55\x12\x42\xa8\xf4\x0d\x1a\x30\x5f\x53\xd0\xfc\xab\xce\x35\x0f\x7c\x1a\xf1\x43\x8c\xc4\x21\xab\x0e\x1f\x10\xf0\x10\x9a\xf9\x06\xca\x3f\x2d\xaf\x25\xd7\xb3\x18\xa7\xde\x9e\x0a\xb9\xcf\x8a\x78\x9c\x04\x0f\x1b\x84\xfd\xa7\xd0\x52\x8a\x6d\xa3\xc6\x23\x0f\xbd\xe5\x9f\xbf\xed\x2e\xf2\x98\xe6\xca\x5b\x6e\xef\xb4\x06\x06\x48\x44\xa1\x89\x4c\x68\xc2\xc1\x1b\xfc\xab\x5f\x91\x4c\x42\x0f\x3a\x4e\x8a\x7c\x9f\xe0\xa5\x3a\xcd\xb9\x24\x01\x26\x45\x05\xf2\xc2\xb5\xce\x19\x37\x9e\xe3\xa9\x79\x36\x16\xaf\xc7\x98\x1a\x1a\xaf\xb9\x60\xf2\xda\x09\xcc\x39\x3c\x5a\x06\x31\xfd\xb4\x6d\xe5\x52\x6b\x99\x76\xce\x0e\xe1\x19\x11\x31\x61\x9f\x2c\xfb\x40\x0d\xa8\xbc\xe5\xef\x76\xd7\x46\x4d\x1b\x6d\x29\x60\xbb\x70\x91\x24\xbf\xa0\x94\xb5\x3e\xf0\x75\xa6\x54\x2e\x4e\x6f\x9b\xa7\x14\x0f\xc6\x9f\x65\x7c\xce\xd4\xe2\x3b\x00\x00\xff\xff\x89\x37\x29\x6f\xb6\xa8\x07\x00")
+var _drepJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x77\xdb\x36\xd2\xe8\xef\xfa\x2b\x10\xdd\x7b\x2b\xa9\x61\x28\xdb\x79\x74\x2b\x45\x6d\x6d\xc7\x4d\xfc\x35\xb1\x7d\x6c\x67\xdb\x5d\xd7\xd7\x87\x26\x21\x09\x0d\x45\xea\x23\x20\x3f\xd6\xd1\xff\x7e\xcf\xe0\x41\x02\x20\x48\x51\x8e\xd3\xbb\xfb\xd5\x91\x4f\x2b\x81\x33\x03\x60\x30\x33\x18\x0c\x06\x60\x86\xff\x7b\x41\x32\x3c\xea\x8e\x17\x49\xc8\x48\x9a\x74\x7b\x77\xea\x2b\xca\xba\xd8\x4b\x3c\xa6\x95\xa4\x5d\xe2\x8d\x7b\x77\x64\xdc\x7d\x92\x9c\x91\x73\xf1\x0d\xf3\x6f\x57\x41\x86\xc2\x51\x5b\x81\xb6\x47\x23\x76\x3b\xc7\xe9\x18\xc9\x2a\xbe\xf9\x46\x7e\x19\x02\xce\xf8\x9b\x6f\xc2\x5e\x86\xd9\x22\x4b\x50\xd8\x25\xde\x93\x8d\x1e\x94\x2f\x54\xd9\x42\x96\x01\xd5\x60\x94\xe0\x6b\xb4\x97\x65\x69\xd6\x6d\xef\x06\x49\x92\x32\x34\x26\x49\x84\x66\x69\xb4\x88\x31\xea\xb4\x9f\x92\xa7\xed\x4e\xbb\x37\x64\xd3\x2c\xbd\x46\x81\x1f\xa6\x11\x1e\xb5\x3f\x1c\xbe\xf9\xf8\x7e\xef\xe2\xe0\xf0\xf4\xe2\xe7\xc3\x8f\x07\x6f\xda\x5e\xb0\x04\x7a\xf3\x11\xb4\x7d\x74\x87\x6f\xe6\x69\xc6\xe8\xe0\x6e\xb9\x1c\x42\x1f\xce\x36\xce\xfd\x30\x88\xe3\xee\xdc\x97\x8f\x3c\xd5\x9b\x6e\xd6\xbb\x03\xd4\x64\xc4\x01\x37\xcf\xcf\xb2\xf3\xa1\x6c\x6a\xda\x4d\x3e\x7f\xce\x7a\x4b\x6f\xee\x15\x88\x99\x27\x58\xb7\x94\x40\x50\xa3\x7a\xb8\x1c\xa7\x59\x17\xa8\x2d\x9a\xb0\xcb\x23\xa3\x8d\x21\x79\xcd\xfc\x18\x27\x13\x36\x1d\x92\xa7\x4f\x7b\x69\x97\x01\xcf\xf3\x16\xa8\x5a\xb2\x65\xaf\xdb\xeb\xde\x6d\x0e\xce\x8a\x86\x4b\x2a\x82\x57\x9e\x6c\x42\xef\xae\x85\xe4\xbf\xfe\xb7\xfc\xeb\xe9\x94\x50\x34\x26\x31\x46\x84\xa2\x79\x90\x31\x94\x8e\x51\x94\xe1\xb9\xff\x07\xf5\x5b\x1c\x44\xfe\x02\x80\x71\x86\x31\xa2\xe9\x98\x5d\x07\x19\x1e\xa0\xdb\x74\x81\xc2\x20\x41\x19\x8e\x08\x65\x19\xb9\x5c\x30\x8c\x08\x43\x41\x12\xf5\xd3\x0c\xc6\x89\x8c\x6f\x39\x0d\xc2\xd0\x22\x89\x70\x86\xd8\x14\x23\x86\xb3\x19\x85\x7a\xe0\xc7\xdb\x83\x8f\xe8\x3d\xa6\x14\x67\xe8\x2d\x4e\x70\x16\xc4\xe8\x68\x71\x19\x93\x10\xbd\x27\x21\x4e\x28\x46\x01\x45\x73\x28\xa1\x53\x1c\xa1\x4b\x41\x0f\x30\x7f\x86\xc6\x9c\xc8\xc6\xa0\x9f\xd3\x45\x12\x05\xd0\x77\x0f\x61\xc2\xa6\x38\x43\x57\x38\xa3\x24\x4d\xd0\x73\x55\x97\xa4\xe8\xa1\x34\xe3\x54\xba\x01\x83\x3e\x64\x28\x9d\x03\x62\x0f\x05\xc9\x2d\x8a\x03\x56\xe0\x96\x59\x50\xf4\x34\x42\x24\xe1\xfd\x99\xa6\x73\x8c\xd8\x34\x60\xd0\xf7\x6b\x12\xc7\xe8\x12\xa3\x05\xc5\xe3\x45\xec\x71\xf4\xcb\x05\x43\xbf\xee\x9f\xbe\x3b\xfc\x78\x8a\xb6\x0f\xfe\x81\x7e\xdd\x3e\x3e\xde\x3e\x38\xfd\xc7\x10\x5d\x13\x36\x4d\x17\x0c\xe1\x2b\x2c\x68\x91\xd9\x3c\x26\x38\x42\xd7\x41\x96\x05\x09\xbb\x45\xe9\x98\x93\xf8\xb0\x77\xbc\xfb\x6e\xfb\xe0\x74\x7b\x67\xff\xfd\xfe\xe9\x3f\x50\x9a\xa1\x9f\xf7\x4f\x0f\xf6\x4e\x4e\xd0\xcf\x87\xc7\x68\x1b\x1d\x6d\x1f\x9f\xee\xef\x7e\x7c\xbf\x7d\x8c\x8e\x3e\x1e\x1f\x1d\x9e\xec\xf9\x08\x9d\x60\x68\x18\xe6\x14\x56\x33\x7a\xcc\xc7\x2c\xc3\x28\xc2\x2c\x20\xb1\x1a\xff\x7f\xa4\x0b\x44\xa7\xe9\x22\x8e\xd0\x34\xb8\xc2\x28\xc3\x21\x26\x57\x38\x42\x01\x0a\xd3\xf9\x6d\xe3\x81\xe4\xad\x08\xe2\x34\x99\xf0\x6e\xe7\x52\x86\xd0\xfe\x18\x25\x29\xf3\x10\xc5\x18\xbd\x9e\x32\x36\x1f\xf4\xfb\xd7\xd7\xd7\xfe\x24\x59\xf8\x69\x36\xe9\xc7\x82\x00\xed\xff\xe0\xb7\xbe\xed\x6b\x22\xfc\x6d\xfe\x1d\x7d\x8b\x7e\xe2\x62\x2c\xa9\x1a\x0f\x82\x05\x9b\xa6\x19\x1d\xe8\x85\x08\xfd\x17\x1e\x8f\x33\x7c\x8b\x7e\x25\x71\xf8\x09\xa3\xd7\x7f\xe0\xf1\xf8\x27\xcc\xa6\x11\xbe\xf2\xc3\x74\xf6\x83\x09\xfd\x21\xc8\xf0\x27\xf4\x4b\xca\xf0\x35\x09\xff\x85\x5e\xcf\xe0\x77\x2d\x38\x09\x12\x74\x18\x24\x21\x0e\x38\x34\x09\x92\x6a\xf0\x9f\x83\x4b\x00\xff\x7b\x3a\xc1\x31\x65\x38\x8e\x71\x86\x5e\x8f\x83\xcb\x5a\xa4\xb7\xc1\x15\xfa\x35\x4d\x23\xf4\x7a\x52\x05\xf4\x53\x14\x30\x8c\xb6\x36\x36\x5f\x68\xa5\xfd\x56\xfe\x03\xcc\xd1\x31\xfe\xef\x05\xa6\xec\x43\x90\x04\x13\x9c\xa1\x91\x32\x45\xdd\x8e\xdf\x07\x6e\xf6\xe1\x37\xa6\x6c\x26\x00\x3a\xbd\xa1\x81\xbe\x7f\x19\x24\x0e\x24\x72\x19\x24\x36\xe8\xee\xbb\xed\xfd\x03\x07\xec\x0c\xb3\x69\x1a\xd1\x7e\x38\x0d\x48\x09\xe9\xfd\xe1\xdb\x1a\x94\x38\x9d\xd8\x08\xdb\xbb\xbb\x87\x1f\x0f\x4e\x6b\x90\x82\x30\x4c\x17\x09\xb3\x11\x77\x0f\x0f\x4e\xf6\x0e\x4e\x3e\x9e\xd4\xa0\x86\x69\x42\x71\x42\x17\xd4\x46\x3e\x3d\xde\xde\xdd\xab\x41\x64\x59\x10\x62\x1b\x69\xe7\xfd\xe1\xee\x2f\x1f\xde\x1e\xd7\xe0\x5d\xc6\x69\xf8\x69\x36\x29\xb1\xfd\x68\xeb\xa8\x06\x6b\xbe\x35\xb7\x11\x4e\x30\x63\x24\x99\x50\x07\x16\x95\x8f\x6c\x14\x65\x40\x0d\x0c\x65\x19\xff\xa0\x69\x69\xac\x16\x8c\xc4\x56\x05\xbc\x48\xfc\xd7\x86\xa6\xd3\xe0\xb9\x0b\x18\xca\x6d\x58\x7c\xc3\x70\x12\x99\xd0\xbc\xed\xe2\x81\x0d\xbe\x13\xb0\x70\xea\x80\xbe\x84\x72\x1b\xf8\x28\x4b\xe7\x38\x63\xb7\x0e\xf8\xb9\x7c\x64\xa3\xbc\x63\x6c\x7e\x94\xa5\x57\x24\x72\x6a\x0c\xd8\xb0\xb9\x7c\x6c\xa3\xee\xcf\xc3\x1a\x4c\x32\x0f\xab\x10\x77\xc8\xe4\x60\x31\xbb\x34\xd1\x2e\xc9\x24\xe1\x85\xfe\x1f\x5c\x24\x5b\x85\x6e\x2b\x5f\x00\xbd\xc9\xf0\x1c\x75\x15\xd9\x1e\x2a\x9c\x00\xf8\xb0\x29\xa1\xfe\x45\x66\x1b\x01\xf0\xbd\x4c\xcb\x50\x50\x18\x96\x09\x84\x8b\x2c\xc3\x09\xd3\x7a\xa6\xa0\x87\xad\x32\xf4\x7c\x6b\x2e\xab\x38\xda\x3a\xea\x42\x91\x8b\x66\x9c\x4e\x24\xd4\xfb\xc3\xb7\x95\x50\xb9\x46\x4a\xd8\x5c\x8b\x2b\x31\xb8\x2a\x4a\x68\xae\xb6\x95\x90\xd2\x50\x48\x58\x69\x58\x2a\xa1\xb9\xf9\x92\xb0\xdc\xd4\x55\x42\x2a\xa5\x96\xc0\xca\x0c\x28\xf8\x32\x82\xd2\x50\x89\xa0\x74\xb9\xeb\x22\x2e\x15\x14\x8d\xac\x81\x86\xbf\x60\x4e\x06\xb9\x6f\x23\xff\x6f\x00\x2d\x1d\x04\xd5\x48\x52\x27\x49\x5d\x15\x06\xc6\x2f\xaf\x04\xab\xc9\xfe\x40\xff\xb1\xb2\x09\x17\xb9\xfe\x8b\x2f\x95\x9c\x95\x80\xdd\x72\x3b\xa5\x2a\x13\x4c\x07\xda\xf7\x6e\xcf\x00\x5c\x6a\x34\x97\xad\x56\xbf\x8f\xc0\x73\xa6\x18\x15\x3c\x48\x85\xa3\x16\xc6\x01\xa5\x39\x2c\xa8\x58\x0d\x9f\x9a\xf2\x68\x25\x7f\x96\xc3\x56\xa9\x4e\x96\xc2\x8a\xcb\xa7\x58\xd7\xbe\x5c\xf9\xd7\xd2\x7b\x9d\xc8\xbd\xd5\xbd\x41\x5b\x33\x4c\x31\x33\x5a\xf9\x09\xe3\xf9\x3e\x3d\xb9\x4d\x42\x92\x4c\x1a\x35\x95\xd3\xb0\xf0\x86\xf7\xd2\x9c\x9a\x86\xea\x36\x37\xff\x3e\xac\x82\x66\xe9\x3b\x7c\x83\x46\x88\x4f\x63\xe2\x57\x0d\xec\x36\x0d\x09\xd1\xa0\xf9\xef\x1a\xf8\x8f\x6c\xfc\x37\x0d\x1c\x7e\x56\x42\x8f\xb3\x74\x66\xd2\xcf\x4b\x6a\x71\x8c\x3a\x54\x41\x25\x06\x4b\xdf\xe0\x90\xcc\x82\x38\x47\xc9\x4b\x6a\x6b\xb1\xb1\xb4\xb2\x9a\xba\xf4\xb1\x50\xb5\x35\x19\x93\x5f\xb1\xce\xe5\x5f\x31\xa9\x84\x85\x76\xe8\xd0\xf2\x77\x25\x3c\xa1\xdb\x51\x94\x61\x4a\x73\x8c\xbc\xa4\x06\x67\x77\x8a\xc3\x4f\x74\x31\x2b\xe3\x5a\x4f\x2a\x69\xb0\xb4\x8a\x06\x4b\x9b\xd2\x20\x74\x7f\x67\xfb\x40\xab\x1c\x7e\x56\x42\xcf\x83\xe8\x3d\x1e\xb3\x1c\x5c\xfe\xae\x83\x3f\x26\x93\xa9\x81\xc0\x0b\x86\xad\x56\x15\x8e\x74\x07\x95\x4d\xe8\xc2\x3a\x3b\x99\x78\x72\x71\x4e\x6d\xa3\x20\x63\x1f\x9d\x8d\x9b\x0e\x7a\x8a\x00\xb9\x84\xe1\x56\x71\x6b\xd9\x78\x9a\x05\x09\x1d\xa7\x10\x92\x88\x48\x86\x43\x86\x48\x18\xcc\x11\x4b\x51\x20\x06\x48\x03\xee\x57\xb5\x1d\x24\x65\x7f\x77\xfb\xc8\xb0\x69\x40\xc7\x6e\x35\x38\x72\xb0\x34\x92\xe6\x08\x16\x4f\x02\x6e\xe8\xea\x1c\x40\xfa\xb2\x19\x55\x16\x0b\x28\x16\xf3\x99\xd1\x80\x0a\x96\x9d\x19\x85\xf0\x07\x96\x51\x79\xc1\x8e\xa9\x13\xfe\x92\x60\x86\x07\xa8\x23\x7d\x06\x3f\x49\x23\xdc\x29\x4f\xf0\xf0\x99\x60\xc6\x60\x9a\xeb\x5c\xe3\xcb\xe7\x17\x61\x4c\x70\xc2\xfe\x2e\xd0\x3a\x25\x84\xa5\x39\xff\x9e\xbb\xfb\x68\x71\x9b\xd0\xdd\x34\x49\x70\x08\x31\x98\xa2\xbf\xdd\x9e\xb3\xb7\x5d\xe7\x84\xf5\xcd\x37\xce\x89\x4c\x27\xdd\xed\x35\x9b\x23\xc2\x0c\x07\x0c\xab\x15\xc7\x4a\xee\x03\xaf\x39\xb0\xed\xc3\xe8\x55\x88\xb0\x9d\x8a\x1c\xa2\x11\xaf\x53\xe9\xce\xd2\xbb\x6b\xcb\xe5\x02\x5f\xcf\xb4\x07\x5b\x9e\x2a\x10\xee\x4f\x7b\xf0\xc2\x6b\x3b\xd6\x22\xed\xc1\xab\xbc\x1c\x64\xab\x3d\xf8\xce\x6b\x97\x57\x1e\xed\xc1\xdf\xbc\xb6\xb5\xa2\x94\xae\x70\x7b\xb0\xb9\xe9\xb5\x2b\xd6\xa8\xed\xc1\xe6\x56\xe9\x21\xf7\x8a\xdb\x83\xcd\xe7\x5e\xbb\x6a\x2d\xdd\x1e\x6c\xbe\x28\x3d\x8d\xd3\x49\x7b\xb0\xf9\xb2\x54\x3e\xdf\x9a\xb7\x07\x9b\xaf\x4a\xe5\xdc\xab\x6f\x0f\x36\x8b\x1e\x49\xad\xb8\x6d\x0f\x36\x8b\xfe\x98\xc1\x8c\xf6\x60\xf3\xfb\xfc\x91\x72\x15\xda\x83\xad\x0d\xaf\xad\xaf\x46\xdb\x83\xad\xe7\x45\x09\x2f\x6f\x0f\xb6\x78\x9b\x95\x42\xc0\x72\xb8\x3d\xd8\x7a\xe9\xb5\xf5\x25\x59\x7b\x60\xfe\x5c\x9e\x7b\x5b\x8f\x51\xda\xc7\x28\xed\x5f\x3d\x4a\xcb\xed\x96\x3b\x4c\xbb\x5e\xa4\x35\x0f\x70\xbe\xac\x0e\x70\xfe\x17\x4d\x93\x6c\x1e\x9a\xd1\x16\xd0\xd7\x6c\x1e\xda\x41\x16\x0c\xdb\x4d\x56\x08\x4b\x94\x75\xf4\x85\xb9\x1e\x63\x2a\x2c\x3e\xf0\xca\xb6\xfa\x60\xe4\x7d\x73\xe9\x82\x46\x42\x54\xad\x15\xcd\xb0\x12\x0f\xda\x73\x76\xde\xc8\x9b\x39\x11\x81\xfa\x4b\x8c\x60\x4b\x0b\x47\xd2\x8f\x41\x62\x92\xe2\x3e\x87\x24\x0a\x4f\xf8\x30\xa8\x02\x8d\x8c\x4e\xf1\x27\x61\x60\xc1\x1b\x32\x8a\xe7\x41\x16\xcc\xd0\xdd\xe1\xe5\x1f\x38\x64\x4b\x24\xf9\x29\x88\x31\x94\xf2\x62\xa7\xeb\xc4\xf9\xa6\xcd\x9f\xd0\x3c\x9d\x8b\xb2\x39\x75\x8c\xa4\xfe\x7c\x41\xa7\x39\xe4\xbd\x39\x83\x6f\x70\x08\x7b\x56\xeb\xf0\x41\xe2\x34\xea\x9a\xa2\xaf\x77\xcf\xee\x17\x88\x92\xac\x19\xc6\xd9\xe8\xe6\xb0\x92\x03\x52\x66\x7c\x8a\x93\x88\x33\x54\x31\x83\x7a\x5a\x5d\x38\xcb\x3c\x94\x61\xba\x88\x59\xc9\x7f\x86\x3f\xf9\x88\x8b\xbb\xf8\xf6\xf9\xb3\x21\x69\xea\xa3\x88\xfb\xb3\x60\xde\x2d\x0d\x95\x87\x48\x12\xe1\x1b\x57\x0d\x9a\xe3\x23\xab\x38\xe3\xb0\xe7\xe8\xf3\x67\x74\x67\x85\x7a\xe0\x6f\xd9\xf3\xc7\x69\xb6\x17\x84\x53\xa3\x1e\x40\x5d\x51\x0d\x19\xe7\x0d\x52\x95\xf0\x7d\xdd\xcb\x20\xfc\x04\x4d\x73\x22\x29\xc4\x27\xd2\x48\xf8\x84\xfe\x3d\x88\x49\x74\x8c\xe9\x1c\xdc\x13\x59\x75\xaf\xaa\xd2\x52\x1f\xdd\xf5\x77\x85\x0d\xf1\xf7\x93\x2b\x27\xf5\x61\xab\x82\x2e\x5a\x56\xb7\xbb\xb2\xb2\x64\x11\xc7\x5e\x99\x19\xb0\xc8\x09\x18\xfa\xb1\x84\x28\x1e\xc8\xc6\xf8\xb2\x4d\x68\x80\xcc\x02\xcd\x52\xea\x9f\x65\xa9\x54\x0f\xa2\x95\x82\x6a\x75\x4e\x2e\x17\x64\xd3\xcb\x15\x7c\x6b\x0f\xb8\xfb\x25\x6d\x4c\x7b\xf0\xfd\xf2\xdc\x7b\xfe\xe8\x47\x3d\xfa\x51\x7f\x75\x3f\x4a\x9a\x95\x3f\xc1\x91\x2a\x29\x6b\xa1\x5c\xf0\x91\x86\x4d\x6c\x50\x1d\x8e\x4f\xd2\x98\x44\x84\xdd\x6e\x67\x13\x3a\xa8\x99\xfc\x34\xd3\x59\x24\xfb\x74\x24\x31\x24\xd6\x4d\x20\xcf\x41\x36\x59\xcc\x70\xc2\x28\x78\x2d\x8a\x78\x4e\x57\x77\xe2\xe0\xb3\xf4\xea\xda\x76\x7c\xb4\x7b\x04\xbe\xcb\x83\x34\x8c\x24\xf3\x05\x83\xc4\x99\x60\x86\x19\x84\xfe\x59\x8a\x8e\x8f\x76\x91\x70\x9a\x9a\x35\x4c\x46\x1c\x48\x9a\xe8\x2d\x9a\xa6\x94\x69\x16\xac\xba\x51\xbb\x87\x07\x07\x7b\xbb\xa7\xfb\x87\x07\x68\xef\xf8\xf8\xf0\x78\x80\x76\x41\x7c\x93\xdf\x3b\x0c\x85\x82\x34\x70\x0d\x22\x36\xa8\xf3\x14\x01\x5d\xf4\xb4\xe3\x37\x6b\x9b\x0a\x8c\xac\xcf\x2b\x85\x09\xc2\x8e\x20\xdc\x9f\x66\x88\x08\xa2\xcd\xaa\x56\x93\xa4\x5e\xb5\x9c\x8a\xca\xf5\x83\x17\x35\xc3\x94\x06\x13\xf0\xb6\x9e\x3c\x11\x80\xe8\x9b\x6f\xf2\xef\x3e\xd7\x95\x72\x89\xaf\xd0\x7e\x44\xce\xe2\x01\xca\x87\xfd\xbf\x4e\x0e\x0f\xf8\xe8\x66\x79\xd3\x20\xf6\x08\xc5\x3e\xd8\xc9\x64\x42\xc6\xb7\xaa\x8d\xc3\xd5\x3c\x92\x55\xd4\x73\xa3\x90\x8e\x53\x32\xc3\xe9\x82\xe9\xfc\x98\xe9\x93\x5c\x65\x3d\xba\x88\x9c\xee\x7f\xd8\x3b\xfc\x78\x3a\x40\x4c\x50\x03\xed\x82\x4e\xcc\x28\x7a\x8a\x3a\x68\x46\x51\x10\x4e\xc1\xe0\x95\x06\xa9\xe4\x64\x2f\xbd\xbb\xe5\xb9\xf7\x62\x9d\x79\x18\x86\x49\x78\x1b\x0c\xdb\xcb\xac\xa2\xbc\x41\x5a\x81\x6f\x04\x63\x6c\x84\x0f\x5c\xfb\x4c\x8c\xb2\x46\x56\xef\xfd\xab\xc8\x11\x40\xc3\x26\xe0\xe9\xe1\x9b\xc3\x01\xca\xf0\x38\x08\x59\x9a\x79\x88\xa6\x7c\x12\xd0\x94\x9f\x22\x98\x96\x41\xd4\x83\x98\xe1\x0c\x47\x3e\xe0\x11\xd6\xa1\x28\xc1\x21\x8c\x73\x76\x0b\x5a\x38\x0b\x3e\x61\x44\x83\x19\x46\x1d\x1e\xaa\x83\x69\xb7\x83\xae\xd3\xec\x93\x98\x26\x66\x8b\x98\x91\x79\xac\x6d\x38\xba\xd3\x20\xea\x17\x9d\xfd\x6f\xd1\x1f\x74\x4a\x12\x86\x66\xc1\x4d\x98\xce\xe6\x31\xbe\x21\xec\x76\xf0\x52\x5f\xac\x14\x34\x0d\x7a\x79\xb3\x9c\x3e\x73\xd1\x08\x1c\x89\xa5\xdf\xb0\x65\x81\x20\x32\xd6\xa8\xf8\x8a\x97\x2e\xab\xa1\xa0\x9f\x40\x27\xce\xca\x38\xe7\x55\x48\xca\x4d\x71\xe1\xa0\x91\x73\x61\xe1\xf6\x55\xe1\x63\xf6\x07\x8d\x2a\x29\x97\x89\x2e\x11\x8e\x29\x46\x77\xcd\xe9\x3a\x68\xb4\x56\x70\x50\x46\x38\xab\x78\x51\x02\x74\x2c\xa0\xc4\x93\x3a\x6e\x0a\x08\x3f\x60\x2c\x08\xa7\xa7\xa9\xe0\x45\xd7\xec\x82\xa6\x3b\x15\xe8\x14\x33\x2b\x69\xc4\x15\xe9\xa8\xa0\xb3\xec\xdd\x83\x39\x72\x70\x08\x6e\xc0\x9f\x02\xd6\xc1\x22\xf9\xf0\xb6\x8e\x49\x0a\xe6\xde\x6c\xca\x09\x7c\x05\x46\xa9\x6f\xf6\x32\x0b\x3e\xf8\xc6\x37\xcc\x6e\xf1\xc3\xa4\x84\x6f\x7c\x65\x6c\xf9\xff\x4b\x4f\x73\xcb\x2a\xbe\x94\x9e\x6b\xf6\x54\x7d\xb5\x9a\x22\x67\x27\x7d\x6b\x7c\x69\xe4\x2d\x95\x3c\x4d\xc1\x5d\x73\x5d\xe8\x3b\x83\xf1\x45\xb7\xda\x03\xbe\x73\x20\xc4\xb2\x3d\xd8\xdc\x80\x5f\x8a\xfb\xb0\x21\xb0\x3c\xf7\x5e\x3e\xae\x20\x1f\x57\x90\x7f\xf5\x15\x64\xa1\x32\x0f\x12\x8e\x97\x48\xf7\xc8\x67\x5e\xb5\x00\xed\x2c\x28\x46\x20\x4b\x21\xeb\x28\x5b\x50\xed\x20\xd6\xba\x87\x61\x9a\x8c\xc9\xc4\x09\x2f\x1e\xad\xce\x71\x56\xe9\xcd\x55\xcc\x95\xd1\x66\xd0\x22\x19\x76\x4b\x17\x0c\xbc\x45\x88\xc7\xa3\x4b\x32\x91\x6b\x48\x0d\xc7\x15\x6c\x16\x48\x79\x96\xcb\xcf\x6a\xb0\x5c\x91\xf8\x13\xbe\x02\xf9\x2c\x96\xe0\x9f\x73\x1c\x6d\x62\xf8\x16\xfd\x24\xac\x2f\x45\x77\xc5\xf3\xba\x58\x3d\x74\xbe\xaa\x0d\x86\xd7\x28\x7a\x63\xcf\x9d\xd2\xd6\xab\xdc\x94\x9c\x86\x02\x1f\xb6\x5c\x13\x16\x54\x4a\xe8\x51\x86\x23\x3c\x26\x09\x8e\x76\x60\x7b\x39\x4f\xfd\x29\xea\xbc\x2c\xca\x2b\x2a\xd6\x20\xd0\x68\x34\x42\x1d\x38\xdc\x41\x59\x07\x82\xcf\xa5\x67\x73\x9c\x44\x24\x99\xb8\x1f\xe2\x20\x8b\x09\xa6\x20\x7a\x95\x4d\x86\xd5\xc0\x1b\x3c\x0e\x16\x31\xd3\x9a\xec\xe6\x57\x4d\xdb\xc9\xd8\x78\xcc\xeb\x87\xc3\x33\x9c\x17\x36\xb0\xd6\x59\x21\xba\x7e\xa4\xb5\x60\xd8\xaa\x76\x11\x24\x16\x5f\xc3\xb8\x9a\x6b\x34\x71\x45\xaf\xff\x7f\x74\x37\x87\xb1\x3a\x29\x3c\x72\xa0\x5a\x21\x43\x46\x63\x6a\x2a\xd0\xc0\xac\x2a\x5a\x0e\x68\x25\xe3\xef\xf0\x8d\x51\x81\x9b\x73\x96\xb5\x10\x32\x42\xb5\x45\x25\xc4\xbc\x10\x83\xd4\xa4\x40\xb0\x30\x48\x22\x88\xe6\x5c\x61\x08\x95\x07\x71\x8c\xae\x82\x78\x81\x79\xbc\xe9\xdd\xde\x6f\x1a\x31\x97\x19\xe1\x83\xb4\x1b\xc4\x71\x3e\x3a\x2e\x03\xa2\xb6\xf2\xf4\x6a\x65\x2a\x95\xd3\x86\xac\xb0\x1b\xe5\x4a\x0d\x91\x50\x49\x5a\xd6\x22\x53\x16\xf3\x3c\x41\x34\x52\xf5\x8b\x9f\x9f\x3f\x5b\x42\xbe\x2d\x92\x52\x86\xad\x92\x44\xe9\x78\xae\x31\x36\xe8\x8e\x04\xd3\x65\xca\x5c\xde\x5c\x93\x88\x2d\x03\x95\x55\xb2\xb4\x87\xee\x10\x5f\xfd\xa3\x19\xe4\xbc\x81\x7b\x13\xa6\x09\x24\xa8\x30\xb1\x11\x6b\x67\x5d\xeb\x2d\x62\xe9\xca\xf6\xb0\xb4\xbe\x35\x67\x9d\x49\x40\x8f\x32\x12\xe2\x8e\x87\xe0\x3b\xfc\x8f\xcb\x0b\x7c\x49\xd2\x24\xc4\x9d\x73\x7f\x4c\x20\x52\xa1\xad\x80\x3e\xe1\xdb\x1a\x75\x90\x95\x9f\x7d\xc2\xb7\xe7\xe8\x89\xae\xa4\xc3\xd6\x8a\x0d\x3c\x4e\xb9\x72\x0c\x04\x45\x95\x28\xa8\xe5\x82\xaa\xfe\x72\x00\xbb\xc7\xfa\xdc\x5b\x6e\xe5\xbf\x8f\xd6\x9d\x16\xd4\xf2\x81\xfc\xb3\x94\xcf\x55\xf7\xd7\xd3\xc1\x1a\x0a\x8d\xd4\xeb\x51\xa1\xfe\x27\x29\x94\x74\x77\x4b\x1a\xc5\x52\x44\x18\x95\x91\x14\xa9\x4e\x1a\x0d\x97\x1e\x09\x52\x6b\x2b\xd2\x8d\x53\x6f\xd4\xf3\x4a\xcd\xa9\xae\xcd\x50\x1d\x76\x63\x0d\x00\x19\x77\xd9\x8d\xaf\x4d\xfd\x7c\x4c\x61\x2b\xde\x4c\x71\x85\x8f\x05\x58\xca\x9e\xb7\x28\x59\x43\x25\x6a\xd2\x98\xba\x0f\xfb\xfb\xf5\xd5\x95\xa0\x9d\x75\xda\x50\x56\xc5\xec\xc6\xe7\x93\x87\x1b\x99\x3f\x2a\x63\x4c\x02\xea\x86\x9f\x04\xd4\x09\xcd\xf5\x0c\x8d\x1c\xab\x06\xed\x79\x19\x93\x8b\x52\x15\x1a\x7f\xd8\x1b\xba\x04\x9c\x59\x81\xa8\x2f\x90\x6d\xbe\xd2\x9f\xb3\x07\x91\xf1\x63\x41\xab\x91\xa8\xcb\x7a\x1f\x48\xde\xed\x9a\x0d\xb1\x97\x55\x95\x65\x5f\x3e\x68\xa8\x00\x2e\xe8\xb2\x90\x38\xa0\x7a\xc3\xaa\x8a\xd7\xd0\x87\x4a\x94\xea\x26\xd8\xa0\x25\x59\x12\x60\xe1\x62\xb6\x88\x03\x46\xae\xf0\xdb\x80\x7e\xa4\x38\xaa\xe9\x55\x09\xb6\x82\xe6\x64\x25\x25\x09\x51\x9e\x43\xbb\xea\x70\xc7\x76\x96\x05\xb7\x39\x7c\x9c\x4e\xa8\x33\xa3\x49\x07\x40\x23\xe3\xa7\x91\xf9\xd5\x8d\xd3\x89\x25\x02\x96\x56\x09\xa9\x7a\x9f\x4e\x72\x31\xe2\x38\x66\x0f\xd5\xbc\x53\x33\xf3\x4a\x72\xb2\x25\x5f\xa4\xa9\x5c\x8a\xee\xaf\x9b\x7c\x05\x99\xf7\xa6\x4e\x1d\x79\x45\x5f\xa2\x8c\x66\x55\x9a\xfe\x89\x95\x65\x69\x63\xae\xdf\x17\x66\x08\x22\x4d\xd0\x41\x3b\xb4\x04\x57\x50\x00\x22\x58\xcf\xf7\x64\x46\x98\x43\x96\x4c\x80\xde\xd0\x8d\x5d\x21\x88\xc6\x73\x27\x2e\x25\xff\xc2\x95\x88\xf0\xd0\x89\x05\x7b\xd4\x94\x05\xb3\x79\x25\x6a\x0e\x61\xe1\x93\xb1\x04\x48\x56\x99\x22\x03\xac\xaa\x9a\x3c\x5e\x65\xa0\x8b\x67\x11\x19\x8f\x49\xb8\x88\xd9\xad\x86\x5e\xcc\x3e\x36\x90\xbb\xa3\x29\x0b\xe2\x37\x4d\x08\x59\x90\x65\x9d\x47\x96\xd2\x4b\xb4\xc2\x7e\xb9\x55\xbf\x0c\x56\xf6\x3a\x09\xc3\xb3\x0a\xb5\x87\xcb\x7d\x54\xbd\x22\x10\x29\xa0\xcb\xfc\xb6\x34\xbb\x34\xfd\xe4\x72\x2f\x08\xdc\xd7\x62\x5c\x9a\xf1\xaf\x7b\xd8\x8b\x38\x9d\x68\x60\xd5\x86\x41\x37\x72\x75\x66\x01\x8e\xb3\x97\x56\x6d\x65\xdb\x60\xd5\xeb\xb2\x0f\x7a\x8d\xba\x75\x00\x0b\x6b\x0d\x2d\x19\x83\xdd\x35\x66\x50\xe3\x39\xfc\x59\x00\x0e\x25\xb0\x49\x0c\x5d\x75\x94\xa6\x48\x67\x45\x36\x54\x45\x6d\x25\x62\xce\x2a\xe3\x74\x52\x53\x95\x7a\x5a\x51\x45\x8e\x3c\x74\x0a\x50\x9c\x4e\xd6\x16\x1f\x2d\x8a\x70\x3d\x25\x14\xbc\xbf\x39\xa4\x5b\x7d\x79\x18\xe1\x28\xa5\xcd\x7c\x41\x8d\x71\x4d\xa4\xad\x52\xd2\xca\xb5\xea\x92\x06\xbd\x72\x4d\x43\x50\xee\xcf\x83\xdb\x38\x0d\xf4\x79\x02\x82\xa2\xfa\x23\x4b\x80\xf8\x23\xc6\x9c\x07\x82\xbb\xea\xa1\x0b\x07\xf2\x66\x4e\x53\xc8\x34\xc3\xd5\xb8\x1a\x90\x8b\xc6\x3c\x23\x69\x46\xd8\x6d\x35\x01\x05\x61\xdb\xda\x7e\x1f\x8d\x65\x7e\xb9\x51\x0e\x36\x38\x37\x86\xc2\xf3\xe2\x0d\x61\xe9\x9c\x84\x6e\xeb\xab\x3d\x47\x23\xe3\xd7\x8f\xe8\x4c\xfb\x79\x8e\x06\xa5\xfc\x7c\xcb\xfe\x41\xab\xf8\x90\x71\x89\x1c\xa7\x71\x9c\x5e\x93\x64\xa2\x16\xf2\x06\x6c\x65\xb5\xa6\xb7\xc7\x9b\xe2\x30\xfc\xfd\xbe\x92\x6a\x94\x26\xf1\x2d\x04\x6d\x20\x03\x6a\x8a\x6f\x4a\xa0\x52\xa9\x04\x29\x9f\xe7\x9d\x1f\x8e\xbb\x70\x78\xb7\xc7\x37\x38\x36\x7a\xe8\x47\xc4\x1f\xa2\x81\x36\x0e\x70\xfc\x5c\x56\x6f\x75\xba\x42\x6b\xa1\x0f\x6b\xab\xad\x6e\xf5\xf3\xdd\x5a\xa0\xa4\x52\x0a\x35\x44\x97\x86\x0a\xfc\xa6\x2a\x7a\x3f\x7d\x74\xd4\x51\x52\x48\x4b\x1f\xa1\x0c\xd2\x28\x48\xa6\x3b\x14\x86\x68\x8b\xa7\x2e\xb5\xa0\x38\x71\xb9\x89\xf9\xb3\x55\x2a\xcc\xd2\xa6\x0a\xcc\x35\xd3\xe5\x55\x5a\x10\xbd\x61\x9d\xb5\x39\x0e\xae\xd1\xc8\x28\xa9\x05\xd7\xaa\xe3\xb7\x22\xd8\xe6\xc9\xc6\xd5\xfd\x2a\x38\x2a\x62\xc2\xdb\x2a\xdd\xef\x17\x5d\x94\x30\x68\x24\xf2\x44\xe7\x41\x46\xb1\x5d\x9b\x85\xfb\x05\x1a\x0d\xed\x7c\xa2\x29\xf2\x6a\x63\x53\x36\x27\xd5\xb0\x6b\x5b\x08\xa9\x95\x26\xab\x1f\x46\x9f\xf3\x49\xca\x8e\xea\x6a\x6a\x81\xba\xf2\xf0\x7c\x93\x63\xf8\x0a\xd4\x6c\x18\x30\x14\x20\xd5\xc1\xa0\x6e\x0f\x52\x88\x65\xc9\x1b\x7e\x61\x40\xd7\x69\xd2\xcd\xfb\x09\xaa\x4e\xf2\x5b\x5b\x96\x4a\xc4\xc0\x85\x0e\x55\xbc\x3a\x6f\x59\x4d\x35\x12\x64\x25\xe1\x35\x48\xca\x96\xbb\x29\x1b\xbf\xc4\xfd\x9b\x5a\xbe\xb1\xcc\xf3\x56\x37\x28\xe8\xe9\x14\x4b\x3b\x77\x43\x18\x36\x79\x83\x8b\xd3\xb6\xc9\x84\x6a\xab\xa5\x30\x30\x32\x91\xbb\xa6\x13\x02\xc0\x6e\xbc\xf1\x53\x80\xf8\x94\x05\x19\xdc\x14\xc3\x17\xdf\x0e\x6b\xe4\x02\xeb\x0d\x5d\x94\xe4\x9d\x02\x2b\x08\xe9\x50\x6e\x3a\x53\x32\x99\x62\xba\x8a\x8e\x0e\x65\xd1\x01\x16\x49\xa8\x4f\x49\x7a\x9d\x9c\xb0\x80\xb9\xf3\x24\xcb\x50\xd5\x15\xea\xa4\x86\x55\x94\xe6\x0b\x38\x7d\xba\x8a\x94\x0e\xd5\x5b\x31\x48\xce\xe1\x5c\xdf\x2f\x7f\xb0\xdd\x3d\x4e\x68\xac\x25\x3c\xd4\x4c\xf9\xa6\x57\x6e\x9b\x6d\xcd\x0d\x28\x79\xec\xa6\x13\x50\xaa\xd3\xb0\x75\x8f\xbb\x79\x4d\x77\xf3\x82\x19\x6c\x59\xfe\x25\xb7\xec\x56\x9c\xe3\x5a\x95\xc4\x34\x58\x99\xe6\xe4\x95\xe9\xd5\x10\x6a\x48\xc1\x48\x23\x19\x38\xca\x1c\x38\xae\x98\xd2\xa0\xfa\x91\x83\x82\x2d\x61\x03\x77\xb1\x03\xd3\xf0\xd3\x07\x8e\x32\x13\x47\x86\x7d\x55\xa8\x4f\xc3\xac\x7a\xe2\xc2\x77\x77\xb8\xfa\xd9\x0a\x1a\xf6\x3e\xd0\x60\x35\x88\x8b\xa2\x19\xcc\x1e\x38\x4b\x5d\x78\x7a\x90\x6b\xe0\x28\x73\xe1\x58\x6c\x77\x14\xba\xb0\x6c\xdf\x63\x50\x51\xee\x95\x9d\x18\x9e\x8c\x6e\xe6\x8e\xb6\x07\x5b\x5b\x5e\x51\x68\xe4\xa7\xcb\x3b\x77\x96\xe7\xde\xab\xc7\xdc\xf3\xc7\xdc\xf3\xbf\x56\xee\xb9\x4c\x38\xd7\xef\xc6\x6d\x76\x51\xf7\xbf\xdd\xd5\xdb\xab\x52\xd5\x1b\x5c\x24\xd3\xef\xf3\x43\x7f\x41\x96\x2e\x12\x7e\x15\xc9\x82\x9a\xac\x81\xcb\xe5\x61\xd3\x08\xc3\x3d\x9b\x08\x27\x57\x94\x5f\x47\x7a\x99\xa5\xd7\x54\xf3\x35\xc1\xb3\x82\xab\x46\xd2\x31\xba\x26\x49\x94\x5e\x73\xb7\xa4\x93\xfb\x25\x1d\x58\xad\x8a\x27\xfe\x6f\x1f\xde\xc3\xd5\xa3\xf2\xdc\x91\xed\xe6\x98\x4f\xd1\xc8\x8d\x35\x84\x68\x84\x3c\x5b\x48\x26\x49\x0a\xa6\x25\x26\x09\x86\xb6\xc1\x09\xe3\x96\xb5\xf6\x5c\x51\x47\xce\x9c\x9b\x59\x0c\xbd\x97\xc7\x9f\x3a\xbd\xa6\xd5\x3a\x5c\x3c\xe0\xfe\x6f\xef\x8e\xb7\x0c\xf2\xd3\x6c\xab\xd3\xab\xa4\x52\xb5\x86\xd0\x6f\x6a\x55\x7a\x25\xee\xf6\xe7\x63\x06\x97\xaf\x20\xb8\xf4\x06\x6e\xde\xa0\x28\xbd\xc2\x19\x82\x5e\x54\xfa\xef\x06\x3d\xdd\x75\x87\x43\xd9\x9e\x3a\x92\xeb\x41\x05\x99\x87\xe6\x01\xa5\xd7\x69\x16\x79\x68\x8a\x03\xb8\x7b\xd6\x1e\x32\x7e\x21\x0c\xa0\xa2\x91\x38\xd6\xfd\xf9\x33\xea\x48\x9d\x8c\xd3\x30\x88\xa1\x70\xf0\xb7\x97\x2f\x5e\x76\x86\x65\x44\x75\x00\x78\x94\x1f\x05\xfe\xfc\x19\x6d\x38\x00\xa1\x35\xe0\x9b\x52\xe7\x1d\x45\xaa\x95\x68\x94\x37\xd8\x01\x25\x7b\x00\x0d\x15\xdf\xdc\xde\xa9\xfb\x14\x85\x71\x67\xcf\x3c\xc3\x73\x98\x44\x20\xdc\x60\x0a\x89\x86\xe9\x5a\xb0\x49\xc4\x32\x70\xbe\x5a\xdb\x49\xd3\x18\x07\x09\x6c\xa2\x2c\x78\x0a\xb9\x14\x47\x6d\xe8\x03\x7a\x9b\x84\x06\xae\x74\xba\xef\xcc\xb6\xd4\x9e\xaa\xd0\xc5\x40\xbb\x2d\xc8\x6c\xa0\xb1\xb4\xe3\xd5\xd6\xdc\x1c\xa4\xb1\x50\x59\x05\x27\x8a\x76\x6d\x8c\x0c\x7d\x81\xa6\xd8\x01\x29\x0d\x4a\x97\x12\x4d\x68\x9c\x71\xa6\x95\x55\x19\x1c\x2a\x45\xc1\x5a\x2d\x07\xaa\x9f\xce\x71\xd2\xed\x1c\x1d\x9e\x9c\x76\xbc\x42\xe0\x3d\x24\xba\x67\x92\x80\x6e\x17\x12\xfb\xcd\x37\xa6\x80\xba\x78\x01\x2c\x84\x49\x07\x8d\x50\x67\x27\xa0\x24\x44\x10\xac\x83\xc6\xee\x2c\xc0\xf6\x6a\xe4\x9e\xa2\xce\x00\x1e\x9a\x34\x7d\x96\xca\xed\xee\xce\x65\x40\xf1\xab\x17\x7a\xac\xcb\xee\x4b\x71\xe8\xf3\x1d\x57\x82\x6e\x67\x9b\x1f\x97\x22\xff\xe2\x19\xac\x1d\x8f\xb7\xc5\x22\xb0\xac\x41\xdf\x4d\x13\x86\x13\xf6\xec\xf4\x76\xce\x33\x51\x83\xf9\x3c\x26\x21\x27\xd6\xb7\xef\xe7\x97\xdb\xa6\xba\x36\xba\x38\xa2\x3f\x2f\x2f\x62\x85\x1a\xbb\xf0\x6a\xfb\x29\xd0\x7c\xb8\x43\x54\x19\x33\x67\x4a\x9e\x5a\xd5\x56\x87\x1a\xa5\xb6\xc9\x9a\xee\x6d\x44\xc4\x31\xfc\xdb\x24\x54\x6d\xd6\x10\x5c\xb6\x03\x2c\xbd\xcb\x62\xa8\xf8\x8e\x0c\xe5\x3b\x0d\x83\x82\x11\x91\xb1\x75\x0c\x02\xb5\xcf\xfa\xab\x1d\x83\x6a\x43\xa0\xf4\xd4\xb4\x25\xdd\x71\x10\x53\x6c\x87\x0b\x58\x76\xeb\x18\x48\x49\x08\x36\x79\xa2\xae\x75\xb5\x85\xaa\xdf\x1e\x22\x14\xc2\xcd\x4d\x48\xdc\x72\xe5\x92\x0e\x11\x21\x36\x2f\xc1\x2a\x2e\xb6\xe8\xe6\x7a\x5d\x1a\x7b\xe3\x27\x68\xab\xbc\xd8\x63\xa4\x3a\xec\xab\xbb\x38\x4e\xf1\x0d\x6b\xd8\x41\x49\x41\xdb\x8f\x91\x71\xe4\xaa\x6e\x35\xed\x92\x76\xaf\x57\xb9\x71\xf5\x7d\x5b\x3f\xc0\x79\x52\xf2\x47\xb8\x54\x07\x6b\x8a\xf5\x76\x69\x46\x6b\x20\xdb\x12\xe4\x67\x29\x99\xc0\x29\xb1\x11\x8e\x58\x46\x26\x13\xb8\x00\x03\xa5\x09\x02\xf9\x85\x65\x93\x71\x13\x5d\x6f\x5d\x15\xe0\x2d\x74\xe9\x81\x97\x57\xbb\xbe\x46\xc0\x14\xdf\x1b\x56\xcc\x39\x49\x86\x83\xe8\x96\x42\xf4\x3a\x9c\x06\xc9\xa4\xfe\x06\x3f\x35\xf5\x28\x74\x8e\xcc\x83\xda\x7c\x6b\xfb\x05\xb8\xe0\xf6\x74\x0a\x5e\xfa\xa6\x8b\x50\x43\x39\x57\xa0\xfa\xbf\x7c\xd9\x01\x53\xfb\x22\x8e\x87\x2d\x27\x98\x5b\x2b\xd6\xd6\x8e\x66\x5a\xa2\xfe\xa9\x76\xdd\x5f\x5f\x2a\xf4\x46\x7d\x94\x24\x80\xa4\xa5\x85\xac\x95\x69\x98\xf3\xc9\xb2\x52\x04\xd4\x48\xad\x1a\x79\xb3\x62\xea\x97\xee\xeb\x11\xd6\x4d\x92\x2b\x99\xce\x86\x26\xeb\x2b\xd8\x64\xbb\xe1\x75\x66\xd9\x26\xde\x72\xf1\xcf\xb6\x4f\xb7\x49\x38\xcd\xd2\x24\x5d\xd0\xf8\x16\xec\x02\xa6\xb9\x91\x02\x6d\x57\xbd\xd2\x70\x5c\x36\x4a\xbb\x91\xdb\x39\xbd\xe6\x4e\xbb\xda\x43\xb1\x9d\x77\x78\x69\x59\xd2\x61\x68\x1c\x90\x18\x47\x3e\x3a\x84\x80\xd3\x35\x81\x20\x0a\xcc\x8a\xeb\x58\x23\xad\x2d\xb5\x72\xe1\x1e\x45\xf9\x3e\x8a\x24\xaa\xb8\x62\x9d\x44\x03\xf4\x7d\xfe\xcf\x6b\x39\x40\xd4\x35\xab\x03\xd4\xd9\xf2\x37\x2a\x2e\x60\x17\x1b\x54\x03\xd4\x49\x30\xbb\x88\x09\x65\x38\x21\xc9\xa4\x02\x78\x2e\xaf\x42\x3b\x3b\x5f\xe9\x89\x69\xd3\x14\xf0\xb8\x4a\xde\x5c\xb2\x26\xd1\xb8\x1b\x62\xe1\x39\x45\xa9\xb4\x4b\xa1\x0f\xc8\xb0\xe2\x82\xc8\x9b\x69\xb6\xd5\x1e\xbc\xda\xf2\xda\x66\x34\xa1\x3d\xd8\xda\x5c\x9e\x7b\xdf\x3d\x86\x5c\x1f\x43\xae\x7f\xad\x90\x6b\xfe\x1d\xa9\xe8\x2b\xec\x40\x3c\xc8\x45\x1f\x0d\x42\xa0\xf9\xae\x95\x1e\x89\xd3\xaf\xc3\x2f\x5d\xa9\x5d\xbc\x66\xa4\xb0\xad\xea\xa5\x1e\x97\xb7\x8e\xdc\x05\xc3\x53\x12\x90\xa6\x79\xb9\x9e\xc2\x25\x27\x2a\xdf\x40\xbc\x4b\x15\xbd\x16\xc4\xd0\xb7\x68\xcb\x6d\xac\x24\xc1\xce\x06\x2c\xf8\x6d\x97\xdc\xb4\x5a\xf7\xf3\xdd\x8f\x64\x10\x2b\x48\x10\xbc\x77\x45\xca\x56\x84\xbe\xff\x0e\xc1\x75\x6d\x0b\xc6\xd7\xf0\xe8\xf2\x16\xcd\xd2\x2b\xc8\xde\x04\xfd\x1c\x93\x8c\x32\xf4\x02\x85\xd3\x40\x5c\x34\x09\x85\xe0\x62\x43\x1e\x43\x7e\x00\x44\x41\xc7\xfc\x35\x1c\x00\xa7\x57\x2c\x0e\x32\x50\xd4\xdd\x46\x23\xb4\xb9\xe1\xa1\x1d\xf8\xff\xa6\x87\x7c\xdf\xf7\xd0\x3f\xd1\x08\x3d\x7f\xd9\x83\xe8\x0a\xa2\x73\x1c\x92\x31\xbc\x8b\x94\x24\x68\xff\xe4\x70\xf3\xf9\xab\xcd\x57\xbe\x46\xcb\x3d\x59\xa7\x1c\x4e\xf6\xcf\xb5\x66\x10\xe1\x92\x25\x97\x44\xae\x19\xc0\x00\x67\x32\x44\x0e\x0a\x50\xd2\x7d\x8f\x6c\xf0\x42\xde\x41\x16\xac\xfa\x0d\x41\x82\x0a\x5d\xf2\x03\x9c\xe8\x6c\x77\x7c\x60\xeb\x6e\x1a\xe1\x6d\xd6\xdd\xb0\x66\x3e\x00\x03\xde\x74\xfe\x59\x02\x33\xe0\x64\x4a\x19\xfc\x0f\xde\xd0\x34\x9f\xe3\x6c\x37\xa0\xd8\x0e\x75\xe9\x60\x74\x71\x49\x59\xd6\x7d\xd1\x43\x4f\x8d\x82\x0d\xef\x85\x4d\x5d\x8a\x19\x60\xfb\x74\x1e\x13\xd6\xed\x74\x7a\x66\x1e\x5e\xa2\x4d\x61\xea\x03\x8d\x0f\xd3\x08\xd6\x30\x49\x5d\x27\xd5\x32\x86\xc3\xfe\x30\x42\xdb\xb0\x6e\xe1\x3f\x5e\x8f\xd0\x3f\x1d\x84\x65\x6e\xa2\x43\x92\xa4\x20\x39\x31\x64\x2f\x38\xe1\x67\x68\x1b\x3d\x45\x9b\x56\xd0\xb9\x36\xa4\xa8\x91\x48\x1c\x68\x46\xc9\xb2\xe7\xff\x91\x92\x04\xd8\xd4\x48\x2b\x77\x83\x38\x5c\xf0\x2b\x62\xb8\x60\x7e\x38\x7c\x03\x0a\xb9\xb9\xa1\x6c\x38\x44\x12\xa5\x04\xba\x34\xe4\xbb\x8d\x57\x2f\x56\x29\xc8\x2c\x8d\xbe\xff\x6e\x73\x63\x95\x62\xb8\xf5\xa1\x7c\xa1\x8f\x29\xfd\x92\x78\x23\xa9\xcf\xf0\x2c\x80\x34\xec\x4c\x0a\x62\xd9\x3b\x94\x27\x68\xdc\xe6\x54\x22\x2b\x8b\xfa\x03\xda\x72\x08\xc8\xa5\xcc\x5c\x2b\xc0\x29\xcc\x55\xdd\x0d\x0f\x7d\xef\x10\xbe\x1c\x8c\x6f\x25\x64\x14\xef\x27\x4c\x9c\xe2\xf2\xd0\xe6\x46\x0f\xfd\x1f\x18\x8d\xa7\x25\x6a\x1c\x42\x36\xa4\x51\x8c\x25\xa7\x9d\x53\x2a\xe8\x37\x92\x13\xfe\xd2\xec\x7c\x55\x80\x68\x29\x10\x23\xdf\xb6\x00\x7c\x95\xfb\x00\x08\xd2\xa2\xb8\x82\xa3\x30\xcd\x20\x6b\x94\xdf\x65\x95\x54\x1e\x31\xaa\x17\x8a\xbe\xeb\x7a\xaa\xfa\x41\xe7\xab\x8f\x0b\xcd\xf4\x34\xef\xaa\x94\xdd\xe6\xfd\xc4\xe0\x60\xe2\xc5\xcc\xf1\x0a\x2d\x97\x52\x00\x6f\xb6\x4b\x90\x25\x1e\x94\x89\x29\xdd\x40\x77\xc0\x82\x65\x3e\x9d\xc8\xc6\x38\x19\x06\x90\xbe\x56\xa3\xc1\x38\x59\x85\x4b\x61\x02\xba\xa3\xd2\x85\x73\xc7\x46\xa5\xd1\x7a\x68\xf3\x95\x25\x7a\x80\x72\x19\x50\xfc\xfc\x15\x1a\x71\xdc\x62\xa7\xe0\xb9\x0b\x76\x1e\x44\x11\xcf\x82\x97\x3e\x50\x57\x20\x7b\x68\xf3\x65\x6f\xe8\x92\xe2\xbc\x1f\x3b\x90\xbc\x2c\xd0\xcd\x49\xa7\xd7\x68\x84\x77\xe5\x09\x0e\xcd\xc0\xed\x00\x0b\x59\x6a\x78\x27\x20\x3e\x88\xe7\x06\x66\xb7\xca\xf2\x89\x9d\x06\xdd\xe4\xa1\xa3\x18\x07\x14\x83\xbf\x2a\x7d\xfe\xd7\xe4\x87\xf6\x84\xaf\x5c\x00\x56\x59\x52\x4e\x96\x4e\xe1\x1e\xa3\x4b\x00\xc4\x08\xdf\x84\xf1\x82\x12\xfe\x7e\x71\x1e\x03\x22\x97\x24\x86\x63\x39\x12\xe3\x32\x48\x3e\xf5\x2f\xb3\x20\x09\xa7\x88\xe2\xec\x8a\x84\xca\xd1\x51\x6f\xa6\x7a\xdd\x27\x3f\xf8\x55\xb2\x0b\xef\x55\xc7\xf2\x06\x71\xe8\x68\x86\xc7\x38\x83\x28\x65\x10\x4f\xe0\xf8\xcf\x74\x86\x22\x4c\xc3\x8c\x5c\x0a\x87\x47\xba\xd9\x38\xf1\xaf\xc9\x27\x32\xc7\x11\x09\xf8\xab\xc8\xe1\x57\x7f\x3f\x61\x38\x4b\x78\xe7\x83\xf8\x62\x27\x48\x3e\x5d\xc8\xbc\xc9\x0b\x61\xa2\xff\xd7\x5b\xd9\xe3\x64\x72\x01\x5d\xbd\x08\xe1\x7d\x7d\x17\x11\x99\x10\xa6\xcb\x6f\x95\x32\xec\xd8\x53\x80\xa5\x09\x97\xca\x77\x52\x23\xa5\x8e\xe1\xb0\xd4\xf6\x8f\x94\x8a\xd0\x7b\xe9\xc8\x8e\x6d\x59\x2e\x1d\x96\x05\xc4\x57\x4a\x06\x38\x46\xe0\x27\xfd\xb6\xd7\x19\xb6\x4a\x40\xb9\xbd\x45\x23\x35\x57\x75\x2d\x8f\xad\xab\x13\x7a\x8a\x3a\x1b\xdc\x05\xe7\xb5\x5a\x6a\xc0\x6b\x05\xb6\xbe\x01\xae\xa2\x11\xea\x0a\x77\xbd\xfb\xfd\xdf\xd0\xb3\x62\x92\xe8\xf5\xe4\x34\xf1\x6c\xab\xc2\x9f\xca\x0f\x00\x98\x55\x6b\xb4\x65\x03\xee\x19\x95\x97\x46\x52\xe3\x39\x57\xa8\x09\x81\x77\xfe\x93\x84\x32\xc2\x16\x79\x3a\x32\x89\x70\xc2\xc0\xa9\xd0\x4f\x10\xb9\xc4\x44\x50\xdd\x4f\xc4\x3b\x0b\x5d\xc2\xa2\xe2\xf7\x32\x1d\xd4\x53\x6b\xb0\x48\x95\xf0\x2b\xab\xdb\x5a\x0b\xda\xbc\x09\xed\xa2\x0d\x6d\x83\xee\x3d\x6d\xad\xd9\x52\x43\x9a\x64\x43\x6c\x81\x72\x5a\xb8\xce\xde\xe9\x3b\x18\x5f\x89\xe3\xeb\x9c\xd3\x4a\xf3\xb6\x37\xb3\x7e\xa7\xd3\xb4\x6e\x7e\x03\x19\x80\x58\xa2\x18\x2c\x31\x63\x43\x98\x83\x47\x4a\xf5\x79\x4f\xa3\xe9\x1a\x2d\x79\x78\xc4\x35\x4c\x4a\xa7\x39\xb1\x92\x4f\xe0\x88\x73\xaa\xf8\x26\x61\x45\x4b\x2a\x17\x45\xfb\xda\xd1\x95\x95\x2e\x02\xa8\x14\x91\x73\x1c\x20\x76\x89\x29\xf6\xda\xd8\x90\xe2\x3c\xcc\x9a\x6a\x51\x6c\xc2\xe6\xdc\x85\x6a\xa0\x2f\xd2\x33\xd2\xf0\x9a\xf2\x32\xb7\x71\x6e\x2e\x79\x28\x6d\x10\xf4\x85\x2e\x1b\xc1\xde\x32\xd7\x2a\x24\xb5\xff\x7f\x7f\xdb\x3b\xdb\x78\xf6\xfd\xf9\xdd\xd6\xb2\xbb\x77\xfa\x0e\xbe\x6f\x3f\xfb\xe7\xf9\xdd\xe6\xf3\xe5\xe7\xfc\xc7\xf3\x0d\xef\xf9\xe6\xb2\xf7\xbf\xfb\x3e\x5c\x42\xd9\x2d\x1c\x32\x38\x52\x64\x90\x95\x21\x50\xa7\x81\xd4\xd0\xc4\xd1\xc9\xcd\x07\x1a\x00\x79\x05\x02\x51\xef\x41\xd5\xb0\xdd\xc3\xf0\xa6\x6c\x7a\xbe\xce\x38\xbc\x29\x5b\x8e\x8a\x81\x28\x98\xa3\x56\x26\xc0\xa1\xe7\x2f\xe0\xa0\x43\xc5\xb3\x97\x0f\xcc\xbe\x31\xbc\xa5\xaa\x19\x03\x9d\xd6\xfb\xeb\xb0\xd0\x69\x7e\xd7\x62\xe2\xd6\xc6\xbd\x19\x35\xc1\x4c\x2e\x7d\xe4\xcb\x89\x75\x84\x8f\x54\x2e\xb7\x67\x69\xf4\xec\xfb\xef\x9e\x6d\x6e\xe4\x60\x33\x70\xf1\x78\x2f\xc2\x34\x46\xdd\xfd\x93\xc3\xfe\xfe\xde\x2e\x82\x75\xf6\x60\x6b\x63\xe3\xb9\xbe\x93\xec\xe2\xb0\xa2\xe3\xe6\xaf\x32\xbc\x0e\xa8\x4a\x46\x2a\xd8\x35\xd9\x28\x63\x3a\x5b\x1e\xda\xba\xbf\xb9\xe4\x5c\xd4\x26\x3d\xa7\xab\x80\xf0\xc4\x47\xbf\x1d\xef\xbd\xd5\xca\x5c\xac\xd1\x66\xcf\x7a\xee\xac\xac\xb1\x5a\xe8\x34\xcc\xa6\xec\x2a\x04\xb5\xcb\x0f\x84\x97\x58\xf8\x9d\x87\x5e\xf4\xd0\x00\x75\x3a\x5f\xc4\x47\xf1\x56\x62\xad\x43\x3c\xe6\x4e\x0c\x7f\x4c\xa7\x03\x5c\x7d\xbb\xfd\xf7\xc3\x9f\xff\x71\x78\xfc\x8b\xf6\xc0\xc5\x5a\x41\xbb\x9e\xab\xa5\xfa\x9b\x30\x54\x22\x3d\x18\x2f\x37\x37\x1f\x8e\x93\xd2\xba\xc8\xf5\xb0\x86\xe8\xe2\x50\x19\xaa\x9a\x45\x95\x84\x2b\xd9\x24\x41\x6b\x55\x34\xcf\x14\x54\x93\x8b\xfb\x0c\xaf\xb1\x88\x2f\x33\xf0\x45\x6f\xe8\x44\x71\x86\x0a\xd4\x7a\xbe\xb4\xfa\xd7\xc6\x4d\xad\xfd\xcd\x78\xc1\xe6\xab\x9e\x87\xb6\x36\x1a\xc5\xb6\xaa\x46\xd3\xe2\x91\xa2\xbd\x9e\x1d\x1b\x36\xdb\xab\x85\xba\xf4\x03\x32\x2b\x5e\x88\xfc\xb7\xc7\xa3\x30\x8f\x47\x61\xfe\x92\x47\x61\xb4\x57\xae\x37\x3b\x09\xf3\x15\xce\xaa\xb4\x8b\xd7\x2a\xb4\x87\x5f\xf4\x56\x85\x15\x27\x5e\x0c\xd8\xfd\x79\xa8\xb2\x39\x0c\x13\x34\x0f\xd8\xd4\x43\x09\x2e\x9d\xf6\x87\x0a\x2e\xc0\x12\x49\x43\x3c\x2c\xc7\xb6\x55\xee\x18\x9c\x15\x85\xbc\x26\xea\x78\x11\x94\x4c\xde\x06\x97\x16\x41\x5d\xc3\x56\xf9\xb1\x7c\x67\x1e\xb4\x07\x96\xc2\x4c\x15\x74\xef\x00\x63\x50\xd0\x58\xf6\xea\xd1\xfd\x34\xe9\x76\x38\x07\x3a\xc5\x4b\x79\xbb\xd8\xb1\x51\x12\xa6\x09\x4d\xc1\x86\xca\xd7\x0c\x1e\xed\x6a\xaf\x7d\x43\x7b\x92\x84\x2b\x5b\x9a\xf3\xc4\xbf\x90\xd9\x65\xf6\x46\x63\xb3\x16\x26\x91\xde\xbe\xde\xdd\x7d\x2a\x31\x4a\xfa\x7d\xf4\x7e\xff\xe4\x74\xef\x80\x2b\xb9\xf6\xf2\xb9\xe3\xbd\x93\xa3\xc3\x83\x93\xbd\x93\x95\x8d\x8a\x02\x16\xe8\xad\x82\xdf\xb6\x4c\xc0\xa7\xff\xad\xf3\x45\x67\xe8\x95\x21\xe4\x56\x47\xf8\x06\x50\x9e\x7b\x08\xa4\x8b\xc9\xb6\xe7\x38\xba\x2d\x73\x09\xad\x73\xf5\xea\x03\xa2\x49\xa2\xfa\x9c\xcb\x7e\x9f\x3b\x4b\x60\xcd\x49\x04\xbb\x68\xe1\x14\xcd\x20\x3f\x4f\xae\x7d\xc4\x54\x0b\xa1\xe7\xa8\x65\xe3\xca\xc4\x7d\xfb\x2a\xcf\x95\xaf\x25\x96\x39\x0f\xa5\xde\x40\xe6\x6c\xaf\x1a\x4d\x56\x77\xe1\xd6\xa9\x33\xc0\xf6\x49\x74\xde\x6b\xb9\x51\x8b\x7f\x9c\x27\x12\x7c\xd8\xaa\x02\x73\x25\x99\xad\xdc\x00\xce\xc9\xcb\x3e\x56\x55\xb0\x6c\x39\x8b\xe1\x8a\x2c\x92\xc1\xe4\x87\xf3\xf4\xcd\xd6\x9a\x7c\x00\x16\xd4\xb4\xae\x06\xad\x0b\x77\x42\xd7\x24\xa8\xaa\x4f\x84\x63\xcc\x70\x1d\xa5\xaa\x4e\xab\x6f\x55\x3c\x5e\xf6\xdc\x9e\x9c\xe1\xf2\xff\x4a\xe2\x58\x6c\xc3\x4a\x09\x15\x5d\xe1\x21\x62\x91\x68\x9e\x20\x98\xed\x6f\x91\x7c\x8b\x24\x61\x72\xe6\xe5\x7f\xca\xd7\x37\x95\x4d\x7b\x6e\x85\x3f\x41\x0b\xdd\x8b\xa0\x62\xaa\xd0\x1c\x58\x93\x2c\x1a\xd5\x5b\x0a\x7b\xf6\x28\x6f\x76\x0b\x05\xfc\x3b\x9c\x49\x51\x97\x22\x19\x30\xfd\x3e\x7a\xb3\xf7\x6c\xf7\xdd\xc7\x83\x5f\xf6\x8e\x8d\x27\x40\x3b\xc2\xe1\x74\x91\x7c\xc2\xd1\x9b\x80\x05\x68\x64\x75\x46\xfe\xf3\x33\x3c\x8f\x83\x10\x77\xfb\xbf\x2f\xcf\x7e\x4f\x7e\xcf\xce\x7f\xfc\xfd\xae\x3f\xf1\x3a\xcb\xcf\xcf\x9e\x7d\xbe\xeb\xf4\xe0\x74\xe2\xf2\xae\x16\xef\xf7\x73\x85\x79\x26\x71\xcf\x01\xf9\x4c\x61\x9f\x9f\xdd\x35\xaa\x57\x61\x1b\xc8\x67\x4d\xeb\xd6\x6a\xce\x2b\x76\xe0\xca\x1c\x15\xa8\xc3\xc8\xf4\x82\x3f\x83\x63\x65\x8b\x0b\x0c\xb4\xef\x31\x91\xc3\x00\x89\x40\x90\xf4\x04\x42\x19\x07\x94\x21\x4e\xa8\x55\xa9\xba\x00\xb3\x0b\x20\x6e\x8b\x05\x35\xa1\x11\xb2\x60\xd1\x53\x3e\x86\x56\xa3\x4b\x19\x67\x15\x16\xdf\x9d\xfe\x5b\x95\x5d\x0f\x15\xd9\xec\xd1\x32\x6a\x45\x42\xad\x93\x9a\xdd\xe8\x51\x55\xa3\x25\xeb\xf8\x15\x47\xf9\x69\x4f\x88\x60\x06\x49\x88\x63\x7e\xf5\x8d\xcc\x95\xa5\x2d\x1b\x0f\x3e\x61\x8c\x83\x4c\xa5\xb3\x5b\xb5\xca\xe2\xde\xd0\x89\xe9\x06\x86\x64\x3d\xcc\xe4\x8f\x6e\x9d\xeb\x61\xcd\xdc\x15\x2e\x48\xe3\x73\x39\x92\xd7\x0a\x56\xff\xb7\x84\x24\x90\x8d\x0d\xf4\xad\xd8\x71\x77\xd2\x17\x56\x62\xd8\x6a\x30\xcf\xc0\xdd\x88\x82\xbb\x8a\xdf\x60\x38\xe1\x3d\xc8\x5c\x5e\x21\x10\x05\xd2\xd3\x7a\x18\x56\x5b\x80\xd5\xa2\x49\xc6\xca\x75\xa8\xe9\xa0\x30\x83\xfe\x7c\x41\xa7\x0a\x7a\x85\x5f\x29\x43\x05\x3a\xfa\xb0\x7c\x5b\x46\x69\x8e\x79\x2b\x7d\xa2\x20\x8a\x28\xac\xcb\xe4\xe4\xa6\x72\x1b\x4b\x93\x9e\xdc\x6c\xd3\xcc\xb7\x70\xa5\xd4\xea\x55\x86\xa6\x08\x5c\xf4\x94\x4f\x58\xdc\xcf\x52\xc9\x02\x79\xe9\x3e\x9c\x4c\x8a\x63\x98\xc2\xc8\x15\x76\xce\x5c\x41\x14\x1d\x5b\x2d\x58\x67\x86\x72\xa0\xeb\xf3\x54\xa3\x33\x4c\xdc\xc9\x91\x90\x3e\x89\x60\x1b\x43\xfe\x3a\xdb\x38\x2f\xf9\x55\x60\x9b\x64\xe3\x0b\x2c\x59\x60\x62\xce\xe4\xdb\x3b\x0d\xf4\x6a\x47\x03\x8d\xf2\x56\x0e\x1b\xa2\xa8\x7a\x47\xb2\x45\x0d\x3c\x0e\x29\xde\x86\x39\x42\xd7\x53\x19\x62\xc0\x49\x24\x92\xfd\x79\xd4\x01\x76\xf5\xb8\x13\x17\xb9\x46\x4e\xaa\xdc\x3a\xa3\xa5\xb4\x54\x1b\x21\x7b\x40\xc6\x69\xd6\x05\x16\x7f\xc2\xb7\x90\x22\xe2\xee\xbb\x8d\x24\x95\xce\x0d\xec\x4f\x03\x7a\x78\x9d\x1c\xf1\x17\x45\xb0\x5b\xfe\x9e\xa4\x0a\x23\xe8\x26\xc0\xaf\x85\xaa\x3e\xc0\xd3\x49\x13\xb4\x7f\xb4\xdb\xb1\xcf\xef\x58\x2e\x66\x0d\xed\x61\xab\xde\xbd\x5c\x36\xd0\xf2\x5d\xb5\x4f\x06\x0a\x28\xaf\xbd\x53\x6f\xa6\x87\xf5\x2d\xa1\x88\x32\xd0\x60\xde\x7c\x97\x2a\xba\xcf\x00\xad\x1a\x53\xf7\x69\x9d\xee\x2a\xe7\xd0\xd2\x0a\x7e\xb9\xfe\x2d\xbc\x0b\x41\xb4\xd8\x13\x22\x69\xb6\x7f\x92\x6a\x77\x51\xc8\x21\x7f\x72\x61\x2f\x6c\xaf\x33\xc2\x82\xcb\x18\x97\x3d\x91\x12\xa8\x15\x73\xb8\xa8\x0e\x3a\x48\xd3\xfb\xe4\x49\x55\x6d\x6e\xcd\x73\xb3\xac\xee\xc4\xb1\xdd\x3f\x57\x7d\xf8\xa4\xe2\xd2\x81\xc2\x6d\x1a\x3a\xe7\xca\x7b\xb0\x58\xb1\xb9\x39\x97\x73\x3d\xd2\xa0\x2d\x46\x57\xf3\x59\xf5\x42\x3a\x8c\xae\x5a\x79\xef\x6b\xce\xe7\x3d\x8c\xa3\xa8\x40\x9c\x7e\xa2\x2a\xfc\x52\x8f\xc8\xe1\xd1\xe4\x73\xbc\x63\x14\x2b\x57\xeb\xa5\xab\x41\x21\x2c\x0b\x67\x01\x8b\x0b\x54\xda\x9d\xa7\xf6\x64\xf5\xb4\xd3\x46\x54\x3f\x3e\xe8\xeb\xa7\x09\x8b\xc9\x41\xbd\x73\x9f\x2e\xe6\xb0\x51\xc0\x03\xed\x7c\xb2\xd8\x3f\xda\xcd\xdf\xa0\xef\xeb\x81\xca\xb2\xcd\x5a\xad\x10\xf7\x38\x7f\x7c\x7f\xa3\xd1\x5c\x9a\x6d\xc8\x5a\x49\x6e\xd5\x61\x42\x1d\xb8\xe1\xb9\x52\x5e\xab\xcb\xb7\x71\x30\x65\xe8\x64\x74\x79\xbf\xa8\xe0\xbc\x6a\xe9\xd2\xbb\xab\xb8\x42\x2d\x3f\xef\xb7\x3c\xf7\xbe\x7f\xdc\x39\x7a\xdc\x39\xfa\x4b\xee\x1c\xc9\x63\xc0\x5f\xe5\xfe\xb4\xed\x20\x4b\x13\xf4\xcb\x62\x16\x5c\x11\x8a\x5e\x07\xf0\xf3\xa7\x4f\xe2\xa7\x3f\xc3\x8d\x76\x97\xfa\x7d\xb4\x9f\x10\x46\x82\x18\xde\xfb\x03\x17\xa6\xc3\x35\x59\x01\x2c\xf2\x28\x4f\xc5\x96\x2b\x39\xc1\x18\x08\x6d\x43\xaa\xb7\x52\x66\x5a\xa4\x71\xc3\x8c\xab\xd0\xed\xbb\x4a\xe5\xe5\xfc\xfb\xd1\x00\x6d\x38\x2d\x4d\x83\x14\x06\xee\x72\xf2\x53\xd5\xea\x30\x83\x34\x63\xb2\x81\x1a\xbe\x4e\x4a\x39\xa6\x2c\x3d\x6a\x72\x3b\x86\x84\x4e\xc7\xea\xfc\x36\x6f\x41\x91\x91\xeb\xc2\xe6\x71\x7e\xb8\x59\x06\xce\x67\x7b\x5a\xb4\x75\xac\xc8\xa9\x47\x22\xf7\x35\x88\xdd\xf9\x14\x2a\x0d\xb8\xe8\x29\xf0\xb2\xb2\x97\x85\xb4\x49\xb6\xfb\x79\x1f\x8d\x29\x50\xb4\xc1\x93\x8d\xb0\xe7\x3f\xc8\xaf\x78\x22\x40\x7a\xab\x76\xbb\x54\x93\x64\xaf\xa4\xe6\x5d\xe2\xe2\x7c\xd5\x93\x52\xf8\xb0\xdf\x47\x41\x74\x05\xf1\x15\x25\x05\x68\xff\x8d\x01\xa1\x5a\x9f\x0b\xc9\xd3\xa7\x6e\xc7\xf9\xae\xb5\xee\x19\x7b\x38\xa7\x5f\x22\xef\xb5\xaa\x8e\xe1\x4b\x4e\xb5\xaa\x4e\xde\x0b\x0e\xc2\xd2\xde\x3a\x83\xbf\x1c\xde\x57\xaa\xf3\xcc\x44\xc5\x5b\xb5\xb8\xcb\x33\x86\x35\x0a\x2e\xb9\x96\xb9\xaf\x6a\x8e\x77\xc9\xa7\x94\x2b\xb7\xd0\x95\x72\x17\x4b\x0d\x68\x96\x92\xab\xd8\x6c\xb5\xc7\x90\x44\x45\xda\x96\x41\x39\xbe\x5c\x8f\xf4\x7d\x33\x09\xfc\x63\xce\x14\x1f\x5f\xe1\xec\xb6\xcb\x9b\x15\x30\x7c\x42\x92\x49\x8c\x3f\x08\xb9\x81\x0c\x29\xe7\x83\x82\x92\x25\x56\x79\xc3\xdc\x68\x52\x60\x1c\xab\x7c\xd9\xde\x27\x4f\x24\x88\x2b\x29\x18\x3e\xea\xb9\xd8\x2d\xae\x82\x52\x40\x4a\x02\x46\x70\x2d\x24\xc8\x73\x15\x82\xbc\x45\x52\xe1\x41\xe4\x09\x50\x44\xaa\x4e\x67\x55\x35\x72\xbd\x62\xde\x89\x0d\x0b\x3b\xfe\x62\x98\xbc\x08\x86\x1f\x1c\x76\xcd\xec\xda\x16\xa8\xd2\x41\x6f\x22\xf7\xc2\x84\x5f\x42\xc4\x6f\x5d\x43\xbe\x03\x48\x35\xd6\x5c\xda\x63\xd9\x61\xcb\x22\x8b\x4e\x50\x31\x9d\x49\x92\xca\x25\x8d\x7a\x7c\xcf\x4c\xaa\xb9\xbc\xd0\x3b\x88\x7b\x68\x4c\x70\x1c\x51\xb7\x02\xc9\xda\x8c\x9e\xd4\x6a\x88\xd9\x01\xcb\x54\x8b\x26\x57\x28\x88\x7a\x6c\x9c\x24\xce\xb1\x7a\xa8\x52\x52\x8b\xaa\x65\xad\x0a\x47\x2e\xe3\xbc\x5c\x3a\xe4\x24\x51\x0e\x22\xbb\xc6\xb9\xb4\x3e\x90\xf5\x68\x6b\x83\xe5\xb9\xb7\xb9\xf1\xe8\xfc\x3f\x3a\xff\x7f\x2d\xe7\xbf\x7c\x9d\x87\x50\xb4\x3f\xeb\x42\x8f\x87\x4d\x08\xd3\x41\x3f\xa8\xcd\x82\xc2\xfe\xc8\x13\x5d\xb6\xfd\xe1\xf1\x07\xb8\x48\x52\x7b\x95\x05\xfc\x1c\x96\xa1\xc0\x1f\xd2\xa0\xe0\xa7\x03\x4a\xda\xe5\x02\x4e\x16\x54\x5c\x86\xcb\xaf\xf2\xcf\xaf\x84\xd7\xc8\x9b\x0f\x1c\x98\xe2\x52\x79\x17\xaa\xf5\xc4\x81\x2b\x23\x5e\x1f\x82\x24\x98\xf0\x94\x39\xd8\x3b\x74\x9b\x4f\xc1\x4a\x23\x84\xc5\x8e\x6d\xf4\x82\xcd\xd9\xcc\xc9\xe1\x52\x85\xd9\xcc\x5d\x5d\xe5\xac\xac\xce\xd0\x45\x98\xe1\x6c\x46\x12\x8c\x60\x98\x94\xfe\x28\xb7\x44\xce\x95\x90\x1c\xcd\x6f\x0c\x0c\xb2\xc9\x82\x1f\xd3\xd5\x88\xba\xe6\xeb\x09\xe6\xef\x7f\xa8\x99\xa8\x5d\x94\xd4\x04\x5b\xa4\x9d\xa8\x36\x99\xed\x71\xce\xb4\x25\xc6\xca\x36\x18\xec\x0c\xb2\x49\xd5\x3c\xab\x12\xb8\xd4\xb2\x50\x06\xd0\x83\x38\xce\xd3\xf3\x41\x44\x25\x09\x19\xc0\x0b\x83\xaa\x71\x5e\xc9\x78\x7c\x23\x5f\xa9\x22\x63\x94\x08\x8e\x52\x16\x2e\x4b\xce\x1f\x1f\x7d\x80\xa9\x8b\x60\x2a\xdf\x93\xc3\x15\x40\xa3\xed\xe2\xbf\x24\xae\x82\x7f\xf7\x1e\x07\xc5\x8c\xcf\x07\x8b\x38\x2e\xae\x91\xf4\x60\xbb\x08\xdf\x10\x23\x21\xa1\x6e\x2c\xac\xf6\xac\x1c\x13\xfd\x9d\x58\xf9\x80\x00\xe7\xcf\xe0\x3f\xea\xdc\xd3\x33\xb4\x79\xee\xcc\xb1\x93\xcd\xe7\xb0\xf3\x74\xde\x15\x57\x8b\xcf\x80\x8f\xb7\x7c\x7e\x80\x27\x82\xd7\x4f\x1e\xc8\xb5\xd5\x37\xd1\xe4\x59\x33\x7d\x14\x9b\x9f\x9f\x54\xeb\x92\xed\x6c\x42\xd7\x1e\x37\x1e\xcf\xa7\xe8\x8e\x47\xf3\x97\xf9\xa9\x34\x98\xd1\x9a\x0d\x94\x5e\x7b\xa3\x51\xd2\xc7\x03\x96\x17\x9a\xd1\x76\x8d\x8c\x6b\xb3\x43\x9c\xc0\x38\x1c\x1f\x1f\xed\x1e\x71\xc4\x52\x0a\xeb\x7d\x47\x45\xbe\x97\x4f\xe8\x0d\xb4\xb4\x08\xcf\x68\xa8\xae\x51\x10\x98\xfb\x80\x58\x3d\x08\x4e\x95\x29\x3d\xaa\xe1\xb6\x56\x4b\x23\x66\x3f\x71\xcc\x71\x2b\xe4\xdf\xe6\x64\xcb\x01\xe7\xa0\x6a\xad\x36\xc6\xaa\xdc\x83\xe3\x93\xf8\xa6\xa6\xd2\x1c\x14\xfd\x28\xb9\xc8\x70\xc6\x7b\x74\xc6\x51\xcf\xc1\x78\x6a\x3f\xad\x06\xf6\x86\x5f\x38\xd8\x62\xb6\x56\x89\x31\x6b\x0e\xf8\x21\x47\x76\x8d\x78\x75\x3c\xc5\xf5\x6c\xe5\x98\x8b\x8a\x8c\x41\x57\x2d\xbe\x6b\x39\xb8\xea\x74\x51\xf8\xc5\xb5\x80\x84\x7e\x74\x02\xe4\x4c\x18\xe4\xdb\x82\xcd\x79\x6b\xc5\x5b\xf9\xec\xa4\x2e\x45\x50\xea\xa4\xa1\xb9\x78\x9a\xaf\x3c\x5d\x0c\x2d\xec\x18\xfd\x72\xa6\xe6\x35\xad\x54\x23\x70\x67\xa5\xe7\xc9\x79\x2a\xbd\x04\x01\x3b\x74\x82\xca\x19\x8b\x83\x5b\x13\x59\x15\x5a\xee\xb5\x72\x24\x4d\xcd\x5d\x08\x1c\x46\x37\xbc\xdd\x7c\x41\xee\x12\x85\xbb\xca\x50\x26\x34\x76\x55\x20\xb3\xfc\x5c\x75\x71\x90\x7f\x6b\x12\xe6\x2c\x0d\x41\xc0\x58\x10\x4e\x4f\x53\x31\x6e\xc6\x38\xa4\x97\x7f\xb8\x86\x01\xbc\x5c\xc5\xa2\xcb\x05\x89\x23\x70\x57\x6c\xdb\x0f\x40\xbe\x3e\x60\xf0\x9d\x4f\xe7\xa7\x87\x6f\x0e\x9f\x3c\x79\xc2\xef\xb1\xe9\x50\xb4\x98\xc0\x3e\xb4\x78\x63\x1c\x44\x08\x16\x70\x70\x99\xb0\x52\xad\x72\x71\x92\x2f\x54\xd4\x5d\x6d\xa5\x7d\x68\xf0\x41\x00\x58\xcd\x6e\x3f\xb8\x6f\x84\x4e\x2f\xff\x38\x03\xb0\xb3\x8d\xf3\x73\x34\x32\x7f\x7e\xfe\x5c\x3a\xe2\x62\xa3\x08\xe0\x4d\x8e\x0b\x7d\x1d\x36\xdb\xb8\x37\xaa\x71\x62\x36\x1b\xb5\x9c\xef\xab\x52\x70\xa4\x4a\xbb\x8e\xf7\xc0\xe3\x52\x66\x8a\x8b\x55\x00\xa8\xec\x89\x4a\x3c\xd3\xc2\x53\xdc\x22\x68\x6d\xe3\x17\xc3\xe4\x4e\xb7\xf0\xa3\x5c\xa9\x52\x30\x50\x92\xac\xaf\x44\xd8\x55\xbd\xa6\x43\xb2\x6e\x73\x19\x55\x64\x13\x14\x9b\xe5\x45\x87\xf4\x2b\xd2\x2b\x88\xc3\x9f\xdd\x10\xc8\xfe\xf2\x54\x5f\x75\xdb\xaf\x8c\xb3\xa3\x43\xf6\x44\xa8\xfe\x2d\x4b\x25\x66\x77\x0c\xf2\xd5\x5d\x54\xbd\xb3\xab\xb6\x24\x15\x40\x15\xba\x52\x18\xf9\xd3\xbf\x24\x49\xc4\x17\x48\x16\x09\xd9\x1e\x40\x1d\x3a\x05\xb0\x79\x94\x78\xbe\xc8\x30\xcf\xc3\x3e\x3e\xda\x55\x29\x25\xf2\x68\x0e\x84\xf9\xd4\x4a\x8a\x24\x32\x9e\x2c\x41\x34\xea\xae\x29\xa9\x0c\x95\x4f\x48\xbe\xef\xab\xfd\x3c\xe3\xb1\x35\x21\xe5\x6b\xd1\x32\xa9\x9a\x09\x4a\x02\xd7\xaa\x88\xa9\x1e\xc0\xdd\x2f\x54\x0e\x49\x4c\xca\x85\xa2\xa9\x4b\xc9\xaa\x81\x94\x14\xdc\x63\x59\x8a\x04\x7f\xd0\xd3\x56\x9b\xe5\x88\x6c\x6e\x36\x89\x13\xbb\x83\x51\x45\xdc\xca\xef\x0b\x69\xb7\x43\x5c\xb9\xff\x69\x87\xc5\x8a\x07\x36\x8a\x2b\x88\x56\x8e\xa3\x19\x28\xdb\xbb\xbb\x87\x1f\x0f\x4e\x8d\xb1\x85\x78\xa2\x3d\xbe\xc0\x66\xff\xc2\x54\x48\x38\x8d\x02\xf1\x5c\xab\x58\xab\x41\xd5\x42\x71\x3c\x96\x43\x68\x3d\x15\x7d\xa7\x5d\xc7\xd1\x38\xb9\xc7\x5b\xe9\x33\x58\xb3\x76\x17\x2a\xe9\x0d\xab\x80\x4b\xa1\xaa\xae\xab\xed\x0d\xf7\x11\x8a\x49\xc5\x5c\x68\x96\x72\x19\x35\x8f\x0d\x12\xd5\x84\x90\x39\xee\x68\x87\xe9\x70\x80\x3a\x00\xea\xd8\x15\x86\xe2\x01\xea\xc8\xdb\xe8\x2e\x2a\xa0\x94\xaf\xf4\xaa\xfc\xc8\x5c\x1f\x0d\xd0\x59\x21\x43\xbe\xfb\xbd\xa3\x68\x35\x04\xc4\x09\xbd\xf2\x6b\x65\x9b\x15\x59\xdb\xd1\xb6\xbb\xc8\xf9\x16\xa7\x14\xff\x0a\xa6\x95\x35\x65\x5f\x81\xd1\x80\x8b\xb5\xc0\x8a\x99\x1b\x0d\x98\xd9\xa4\x2f\x7c\x5e\x90\x37\xf9\x35\xed\x8d\x8e\xd3\xa0\x3f\x2b\xc0\xbf\x4a\x8f\xe4\xa5\x7c\xcd\xbb\x03\x08\x4d\xfb\x52\x01\xab\x3a\xf2\xb2\x41\x47\xfe\x0d\xa4\x98\xf3\x69\x3d\x31\xd6\x50\x9a\xf2\x6a\xa5\x20\x6f\x36\xe0\x16\xf0\xa2\x41\x97\xa2\xc5\x6c\x7e\x94\x91\x2b\x38\xac\xd0\xac\x47\x1a\xc6\xea\x0e\xd5\x03\xaf\xd3\x9f\x55\xa3\xdf\xa0\xaf\x93\x80\x1e\x65\x24\x6c\x2a\xe4\x0a\x7c\x75\x2f\x6b\x20\x1f\x58\x53\x21\x4a\xd0\x5c\x4d\x25\x74\x83\x0e\x54\x02\xfe\xc9\x43\x14\x13\xaa\xd0\x1a\xf6\x51\xc3\x58\xdd\xcf\x7a\xe0\x07\x1e\xab\x38\x0d\x3f\xad\x65\x2b\x0a\x84\x06\x3d\xa9\x83\x7d\xe0\x8e\xc0\xbb\x15\xd7\xea\x48\x81\xb0\xba\x23\xb5\xb0\xeb\x48\x5f\x43\x83\x47\x31\xdb\x8e\x49\xd0\x54\xbc\x14\xf8\xea\x8e\xd4\x40\xaa\x6e\xbc\x68\xd0\x8d\x87\x9c\xe5\x9a\xb0\x83\x4c\x92\xa6\xac\x20\x93\xa4\x01\x1b\xdc\x50\x8a\x05\x5b\x0f\xc6\x82\x06\x9d\x13\x2f\xbb\xc0\x59\xc3\x0e\x2a\xf0\xd5\x9d\xac\x81\xfc\xb3\x3d\xf7\x26\x72\xe0\x2c\xaa\xe0\xa1\x51\x02\x4e\x8f\x3c\xda\x7f\x7a\xd3\x90\x8b\x39\xfc\x6a\x36\xd6\x81\x2a\x3e\x7e\xf7\x9f\xc0\x47\xc7\x83\x06\xe2\x39\xc1\xec\xf4\x66\x3f\x39\x4a\xd3\xa6\x6b\x4a\x0d\x63\x35\x77\xeb\x81\xbf\x82\x69\x5d\x24\xef\xf3\x59\xa9\x61\x87\x74\x94\xd5\x3d\x5a\x01\xfd\x15\xba\x44\x66\x10\xfa\xf9\x05\xdf\x9e\x30\xc8\x01\x6b\xd6\x29\x13\x69\x75\xb7\x56\xc2\xaf\x63\x3c\xa1\x63\x8d\x0d\xa4\xa8\x59\x3a\xe8\x6b\xf5\xae\xda\xa9\x77\x76\xae\x1a\xfc\x2b\x0c\x9a\x7c\x8f\x00\x96\x4a\x8e\x9b\xce\xf5\x25\xbc\xd5\xbd\x6b\x82\xb2\x4e\x0f\x57\xd9\xa9\x72\xef\x1d\xc1\xd2\x33\x4d\xf3\xbd\xc2\x7e\x8b\x13\x77\x9e\x16\x2b\xf1\x8c\xb0\x84\xfc\x05\x0b\x01\xf9\x55\x42\x69\x8b\x38\x4f\x2d\x75\x3c\xb9\xb6\xf0\x34\x97\xda\x2b\x1c\x58\xaf\x70\xeb\x3c\xe5\x18\x79\x94\x4c\x12\x4f\x4d\x9d\x9e\xae\xcc\x9e\xa9\x02\x9e\x21\x34\x5e\x89\xc9\xe7\x2d\x47\x1e\x41\x29\x0a\x2c\xc3\xa1\x66\x18\xd8\x19\x09\xd6\xe3\xb0\xed\xc1\x4b\x5e\x22\x42\x82\xed\xc1\xe6\x06\x64\x11\x6f\xfd\xe7\x47\x87\x77\xde\x1f\xee\xfe\xf2\xe1\xed\xf1\x63\x78\xf8\xeb\x85\x87\x95\x76\x34\x35\x38\x12\xbc\xda\xce\xf0\x97\x16\xcd\x26\xd9\x9f\x1a\x62\x10\xda\x76\x2a\x15\x95\xff\x3f\x28\x2e\x3a\x6c\x6e\x47\x1d\x14\x9a\xf4\x74\x2d\xec\x87\x0d\x20\x3a\xdc\xbb\x7b\x16\x35\x62\x34\x03\xd7\xef\x03\x49\xc8\x11\x4e\x22\x92\x4c\x0e\xd2\xa4\xb9\xec\xb8\xb1\x1b\x31\xb8\x29\xe6\xd7\x9d\xb9\xaa\x18\xa2\x0d\x78\xf3\x99\xbb\x84\xd9\x9c\x11\x2b\xb0\xfe\x64\x26\xc0\x96\xf5\x71\x70\xad\x35\xaa\x21\x0f\xca\x88\x0d\x58\xd0\x08\xe9\x81\x5d\xb4\xdc\x4b\x91\x26\xcd\xab\xd1\x78\xaf\x42\x58\x55\xb9\x06\x4a\xbd\x72\x67\x9a\x39\x0a\x6a\x66\x7c\x10\x4f\xe1\xf9\x7f\xbe\xa7\xb0\xfb\x6e\x7b\xff\xe0\xd1\x4d\xf8\x8a\x6e\x02\x56\x96\x61\xe7\x76\x9d\x60\x64\x09\xaf\x5a\xc3\xc3\x69\x40\x92\x8b\x26\x08\x0f\xac\xdd\xaa\x7f\xd0\xbc\x9d\x5b\x59\xfb\x1a\xfd\x33\xf0\x9a\xf4\x6f\x15\xc2\x3a\xfd\x7b\x00\xfb\x3d\xc1\x6c\x27\x88\x83\xb5\x26\x72\x89\xd0\xa0\xb7\xd5\x90\xeb\xc4\x08\xd6\x8f\x97\xbd\xc1\xe3\x60\x11\xb3\x1d\x70\x47\x45\x9a\xfc\xba\x3c\x91\xef\xf8\x6c\xca\x11\x00\x6f\xc2\x8f\x0a\xb8\x75\x06\xfd\x9e\xbe\xdb\x87\xe0\xe6\x1d\x26\x93\x69\xd3\x4d\x11\x1d\xa5\x41\xcf\xea\x60\x1f\xdc\xe3\x67\x6b\x7a\x9e\x2b\x5c\xcd\xbc\x13\x07\xe9\x7f\xa4\xb0\x1e\x65\xf8\x8a\xa4\x0b\xca\xc5\xfd\x5d\x40\xa7\xcd\x39\x53\x42\x6d\xc0\xa5\x26\x38\x0f\x3f\xe4\xc7\x38\x7f\x7f\x78\xe3\xde\x15\x38\x0d\xba\x55\x0b\xfc\x6f\x2e\x01\x9a\x0f\xb9\x73\x2b\xc6\x85\x2b\xe3\x76\x12\xed\xc3\x69\x91\xe6\x02\xb1\x8a\x52\x03\x46\xde\x83\xc4\x3a\xec\x6d\xbe\x37\x54\xcf\xa8\x5d\x88\xf7\x1a\x0d\xbc\x17\x97\xca\x64\xd6\x63\x51\x23\xfc\x3f\x61\x7e\xd0\x9a\x44\x7f\xce\xd2\xd9\x9a\x33\xa0\x13\x7d\x3d\x4e\xd4\xe2\x7d\x4d\x0e\x68\x71\x68\xd3\xfd\xf4\x4a\x0e\x9b\x57\x38\x35\x9e\x9a\xcf\x3d\x7d\xfa\xf3\xd4\x34\xe2\xb9\x2c\xa5\x67\xd8\x19\xcf\xec\xbf\x53\x59\xbc\x55\xd2\xe2\x55\x31\xb1\xd9\x62\x92\x2f\x9e\x1e\x64\x25\xf9\xe2\x7f\xc0\x4a\x12\xde\xe2\x71\x70\xf2\xf1\xe4\x71\x35\xf9\xf5\x56\x93\x33\x92\x24\x70\x6f\x75\x33\xd3\x22\xa1\x6b\x2c\x09\xdc\x7b\x94\xd0\x05\xbd\xa8\x06\x7d\x60\x6f\x64\xd6\xdc\x32\xce\xea\xad\x60\xd1\xf6\x7a\xab\xf7\x00\x0d\x57\x46\x4e\x72\xc9\x9b\x35\x37\x11\x4a\x2b\x1e\xc4\x4c\xbc\xfc\xcf\x37\x13\xef\x0f\xdf\x3e\x1a\x88\xaf\x67\x20\x28\x4c\xb1\xe1\xa7\xd3\x2c\x08\xf1\x76\xd3\xe5\x2a\xc5\x6c\xa7\x40\xaa\x56\xb9\x38\x9d\x5c\xac\x84\x5d\xc7\xd9\x68\xe8\x7b\x52\xcc\xde\xe3\x2b\xdc\x34\x73\x46\x81\xaf\xec\x48\x15\xd4\xd7\xe9\xc2\xdf\xc5\xae\x75\xf3\x4e\x48\x84\x95\xdd\xa8\x86\x7b\xe0\x8e\x28\x33\x68\xca\x80\xa7\x38\xe9\x15\x6d\x69\x66\x1c\xdf\x1f\xbe\x7d\x10\xb3\xf8\xea\x3f\xdf\x2c\x1e\x6d\x1d\x3d\x9a\xc5\xaf\x67\x16\x83\x28\x3a\xc2\x38\x6b\x1a\x7c\x57\xe0\xd5\xaa\x37\xdf\x9a\x5f\xd4\x40\x7d\x05\x0b\x02\x4b\xa2\x35\xba\xa0\xc0\xeb\xbb\x50\x03\xf5\x80\xee\x13\x8c\x40\x86\x67\xe9\x15\x5e\xa7\x07\x1a\x46\x7d\x27\xea\x01\xbf\x92\x0d\x54\x83\xef\x29\x16\x7a\x5a\x33\x9a\x99\xbf\xa3\xad\xa3\x07\x31\x7f\xdf\xfd\xe7\x9b\xbf\xd3\xe3\xed\xdd\xbd\x47\x03\xf8\xf5\x0c\x60\x84\xc3\x34\x82\x5d\x77\x9a\xac\x95\x69\x50\xc2\xab\x56\x45\xb8\xa4\x04\x5f\x34\x41\xf8\x3a\xd6\xd1\x4c\x07\x68\xd8\xbf\x12\xde\xaa\xfe\x35\x41\xf8\x4a\xfd\x13\xd7\x4e\x6a\x55\x8b\xad\xd0\x35\x7a\x5a\x41\xa1\x49\x9f\x9b\xa3\xaa\xde\x3f\x6f\xd0\xfb\xd5\xe1\xfe\xb5\x72\x9c\x27\x98\x9d\xe0\x24\xfa\x02\x16\x39\xd1\x1b\xf0\xa7\x29\xde\xff\x5f\xe6\x68\x2d\x6c\xce\x12\x0d\xa9\x01\x23\xea\xa1\xbf\x5c\x33\xec\x5e\x65\x98\x5f\x27\xd3\xb0\x3b\x12\x7a\x55\x3f\xaa\xc1\x54\x07\xb6\x6a\x1b\xa6\x9c\x84\x92\x31\xf4\x4a\xe6\xc3\xab\x51\x2e\xaf\x4a\xb0\xac\xb8\xb5\xa7\xb8\xd0\xcc\xef\xe0\x93\xed\x83\x78\x1e\x8f\xef\xe9\x7f\x7c\x4f\xff\x5f\xec\x3d\xfd\xf9\xf7\xfc\xc2\xe5\xb9\x7c\x55\x5f\xc5\x95\xcb\x75\x2f\xe8\x1f\x67\xe9\xbf\xf0\x2c\x48\xfc\x08\xff\xe0\xc2\xfd\xff\x71\x5d\xb3\x01\xaf\x5e\x43\x68\x38\x98\xf2\x8e\xe2\x2f\xb8\x86\x79\x82\xad\xeb\x8e\x45\x81\x03\x92\xda\x90\xb4\x0a\xd2\xbe\xa8\xb0\x40\xb1\x9e\x38\x70\x49\x52\x81\x4a\x92\x15\x98\x6b\xdc\xc2\xac\x78\xa9\x5f\xa8\x64\xaf\x07\xfe\x9c\x7b\x98\x4b\xf7\x57\xfe\xbb\x5c\x56\xea\x60\x91\x56\x8f\xc1\x9c\x20\x9b\xd8\xdc\xa9\xbe\x59\x14\xfd\xe8\x2a\x85\x7b\x11\xe5\xc5\xa0\xff\xc3\x2e\x00\xad\xe4\xe3\x03\x5f\x01\x0a\x97\xef\x82\x9b\x66\x15\x15\xef\xf6\xf8\x0a\x77\x84\xfe\x45\xae\xb3\x76\x0c\xa1\xd5\xa2\x95\xd7\x8e\xfe\x7b\x5c\x68\xbd\x62\x38\xc5\x65\x60\x45\x4f\x58\xda\x4c\x75\xcc\xb8\x8b\x6b\x74\x5c\xca\x23\x1f\x29\x6e\x2c\x9b\xb2\xde\xac\xad\xc9\x45\xa3\xdc\xc8\x97\xde\x4f\x06\x7f\x13\xcc\xe4\x4d\xee\xdc\x67\x7f\x8b\x59\xb7\x57\x5e\x68\xe0\x64\x31\xc3\x19\xbc\xe6\x71\xc0\xdf\x98\x64\x00\xe8\x5a\xa2\x2a\x84\xa9\x96\x36\xba\x64\x54\xbb\x91\x14\xfe\x47\xcf\x36\xce\xdd\xb7\x90\xd2\x86\xd7\x90\x52\xeb\x1e\x52\xba\xe2\x22\x52\x1b\xb4\x0c\x64\x34\x6f\xd3\x02\xd0\xd6\x36\xf0\x27\xc6\xc4\x17\x26\x47\x8d\x1d\x0c\x8b\xc7\xf1\x3d\x78\xeb\x27\x4b\x2d\x1e\x40\x53\x03\xb8\x76\xf3\x2d\xf7\x27\x0e\x82\x19\xee\x02\x74\xef\x5c\xb1\x90\x0f\xce\xb6\x04\xe9\xf6\xdc\x26\x0a\x58\x69\x91\x31\x84\x83\x93\xb4\x38\x27\xf5\x0a\x96\xd6\x1d\xf4\x94\xb7\xd1\x0f\xa7\x41\xb6\xcd\xba\x1b\x3d\x9f\xa5\x1f\xe7\x73\x9c\xed\x06\x14\x77\x7b\xea\x31\xbf\x95\xb4\xbb\x59\xd1\x08\x87\xbc\x2a\xc9\x32\x1a\x53\x21\xa6\xca\xc1\x2b\x5f\xb2\x2a\x5b\x9a\x53\x98\x60\x56\x22\xa2\xc1\xe5\x8e\xb0\x3e\xd7\x74\xf3\x52\xd3\x6f\xe1\x17\x9f\x3a\x16\xea\x45\xb4\x74\x90\x37\x4e\xba\x87\x25\xd8\x65\xcd\x6d\xa2\x4d\x18\xa4\x46\xf7\xcb\xb8\x24\x63\x2b\x06\x11\x65\xe5\x5d\xdc\xaa\x63\x08\x6f\xd1\x97\x72\x65\xcd\x1b\x64\x55\x5b\x05\xac\x7b\x10\x25\x05\x8b\xdd\xae\xd0\x87\xa5\xf1\x13\xcc\x54\x3f\x25\xf3\xd4\xcf\x55\xd7\x80\x4e\x30\xbb\xb7\x57\xf6\x3f\xe1\x3a\x57\x87\xd0\x4a\xf0\x35\x2e\x74\xbd\x6b\x55\x49\x91\xb6\x0e\xab\x8e\x72\x9d\x9d\x7b\xad\x2a\x71\x91\x24\x2c\xa7\xa4\xe1\x9d\xb1\x75\x22\x23\x5b\x2f\x05\x50\x49\x8d\x2e\x8e\xab\x44\x47\x52\x70\x8b\x4f\x79\x1b\x4e\x32\x7a\xd8\x32\x42\x62\x56\x3c\x0c\x6e\x06\x78\x7c\xb9\xf0\xe3\xcb\x85\xff\x62\x2f\x17\x2e\x87\xbb\xa4\x11\x9a\xc9\x59\xcb\x1d\xf4\xfa\x2f\x3c\x1e\x67\xf8\x16\xfd\x4a\xe2\xf0\x13\x46\xaf\xff\xc0\xe3\x71\x65\xdc\xea\x5e\xc1\xae\x02\x89\x04\x09\x3a\x84\x43\x78\x01\x0f\x90\x41\x5c\x6d\x05\x52\x5d\x48\x6e\x05\xea\xdb\xe0\x0a\xfd\x9a\xa6\x11\x7a\x3d\x59\x19\x87\x7b\x51\x1d\x87\x93\xef\x2f\x34\x22\x71\x7d\x39\x2b\x34\xd9\x4e\xb7\x62\x76\x3a\x78\xe8\x04\x0d\xd3\x64\x4c\x26\xf7\x7c\x1d\x9b\x25\x07\xfb\xac\x43\x91\x7c\xd7\x29\xb9\x8c\x85\x5c\xcf\x03\x4a\x21\x33\x59\xbe\xdf\x91\xc2\x34\xac\x5e\xb7\x6f\x88\x08\xc7\x0e\x62\x9a\x96\x49\xa4\x71\x0c\x24\x40\xd8\x31\xbc\xc0\x10\x2f\x66\x28\x81\x2b\x30\xa1\x02\x92\x84\xe9\x4c\xaf\x41\x27\x2a\x0f\x01\x71\x12\x88\x91\x19\x86\x77\x0a\x12\x8a\x36\x11\x85\xf7\xed\xeb\x8b\x49\x24\x5e\xbe\xca\xd2\x44\x2b\x2c\x64\x1e\xd8\x52\x13\x93\x53\x5d\xb2\xa7\x5d\x98\x8c\x7c\xf5\x10\x8d\xf2\xae\x0f\x1d\x50\x69\xcc\x63\xb0\xf6\xe2\x88\x3f\x54\x6d\xaf\x0b\x24\x56\x3a\x40\x2a\x2e\x02\x4e\xe4\x34\x4b\x93\x74\x41\xe3\x5b\x04\x7e\xa5\xc3\xd3\x70\xb9\x36\x00\xea\xf2\x6b\x94\xdf\x12\x05\x2c\xa8\x73\x6c\x9c\x1c\x35\xb9\xa9\xbb\x06\xf6\xfb\x1b\x80\x7c\xf5\x2b\x88\xaa\x58\x5f\x7e\x7f\xb4\xf9\xaa\xa7\x23\x89\xd7\xb5\x57\x0b\x9a\xc7\x60\x32\xdb\xb1\xc8\x34\xdd\x2a\xa9\xbe\xda\x55\xf9\xd0\xf4\xfc\x1d\xa7\xfc\x47\xfe\x3e\x15\x9b\x8c\x0c\x94\x8d\x90\xd1\x2b\xf3\x2d\x09\xc3\x56\x99\x09\x15\x2f\x3e\xce\x7d\x73\x07\x5b\x5c\x6f\xbe\xb2\xf1\x6a\xbb\x2d\xd9\x23\xaa\xf0\xbf\x28\x58\x17\x7c\x91\x54\xf2\x85\xd1\x5a\xa2\x69\x87\x7a\x72\xc7\x75\x7d\x21\xe5\x95\x97\x24\xd5\xcb\x29\xde\x4f\x66\x25\x6f\x15\x91\xa6\x52\xfb\x75\x04\xb3\x2c\x8b\xf7\x7d\x29\x09\x74\x1e\x67\xce\x1e\x57\xf4\xda\x6a\x8a\xa3\x93\x5f\xa2\x03\xab\x59\x5d\xa2\xd2\xa8\x3d\x39\x31\xb0\x1d\x9e\xa9\x22\x56\x8f\x96\xbd\x35\x75\xa6\x58\xc9\xba\xb4\x66\x9d\xc5\x2a\x8c\x24\x7f\x0b\xb4\x4b\x37\x64\xfc\x5a\xd0\xfb\x9a\x0a\xc4\x5b\xf0\x1f\xa6\x40\xfa\xbb\xb3\x79\x73\x6d\xa3\x7c\x3f\x95\x29\x45\xe9\xbf\xba\xce\xa8\x2d\x80\xfc\x6d\xf7\xbc\x15\x0f\xa2\x2a\xb4\xd7\xac\x1d\x3a\xb5\x82\x11\x5f\xa8\x24\xb9\xb7\x83\x59\xee\x6e\xc1\x5a\x49\x2a\x06\x92\x2b\x14\x0d\xd9\xad\x20\x4c\x49\x8a\x4b\xfa\xef\xe9\xd8\xe4\x34\x0d\xa1\x77\x27\xb4\xe6\x6d\x1f\xa1\xf9\x7d\x59\xc0\x20\xb0\x20\xfd\x67\x0d\xd4\xd9\x61\x80\x3d\x2a\x81\x36\x9e\x50\xc5\x19\xfb\x25\x77\xb5\xf7\xa3\xb5\x2d\x86\x03\x6a\x91\x90\x84\x32\xf3\xd5\xbd\x06\x06\x4b\xa3\x14\x85\x31\x0e\x92\xc5\x5c\x7b\xd1\x69\x29\xd0\xd6\x64\x68\xb4\xde\x3b\x0c\x92\xe8\x54\x61\x98\xbc\xa2\x71\xee\xb1\x03\x5f\xfe\x4c\x60\xc1\xce\xc1\x1d\x18\x8a\x01\xe7\x9d\x87\x48\x34\x28\x11\x1c\xb8\x48\x0f\x8a\xaf\xc6\x8e\x19\xfc\xf5\xfb\x55\xa3\xab\xf4\x5b\x5f\x37\xd8\x8d\x34\x1a\x6a\xbf\xe6\x6e\x79\x5f\x61\x4b\xe7\xaa\x35\x7c\xdd\x26\xde\x7f\xc7\x43\x2d\xea\x6d\x8d\xba\x5c\xb8\x85\x30\x9d\xd7\xc8\x60\xa5\x8c\x35\x1a\xe1\x74\xee\x1a\x60\x41\xcb\x66\x50\x84\x63\xcc\xb0\x63\x30\x87\x8e\x61\x28\xfa\x6d\x3c\x23\xe3\xae\xdc\x83\xfa\x84\x6f\x69\xb7\xa0\xd5\x53\xfb\x67\xa3\xd1\x08\x6d\xc0\x26\xf9\xaa\xb1\x02\x21\xcf\x4e\xc5\xf3\xae\x01\x3c\x74\x8f\x6b\xd5\x7a\xf1\x1e\xe3\x5b\x38\x1d\x19\xa6\x98\xf1\x80\x9e\x1a\xe6\x19\x0e\xa7\x41\x42\xe8\x4c\x05\xa3\xd6\x33\xb2\x9c\xe0\x7a\xc3\xc8\x51\x8c\x01\xfc\x84\xf1\x7c\x9f\x9e\xdc\x26\x21\x49\x4a\xb9\x1f\xfd\x6f\xff\xa0\x53\x92\x40\x83\x6e\xc2\x74\x36\x8f\xf1\x0d\x61\xb7\x83\x97\x46\xe4\x05\xfe\x40\x60\xbb\xb0\x0c\x83\x5b\x90\x89\xcc\x70\x80\x6e\x3a\x27\xe6\x7e\x5f\x1e\x5c\x41\xf0\xba\x42\x0e\xe6\x21\x7c\x13\xe2\x39\x43\xe0\x99\xc9\x22\x17\x1e\x9b\xe2\x5b\x94\x60\xc1\xd1\x4b\x2c\xe9\x44\x30\x2d\x2d\x82\x38\xbe\x45\x97\xb7\xdc\x16\x00\x7b\x81\x14\x49\x26\x5c\x76\x7f\x05\xbf\x83\x24\x93\x6e\xaf\x44\x95\x8c\xbb\x4f\x0c\x2e\xa0\xcf\x9f\xd1\x27\x7c\xeb\xf3\x97\xdc\x1e\x8e\xbb\x1d\x20\x04\xc2\x7f\xd1\xe9\x71\xa1\x7b\xe6\xdc\xb7\x35\xac\x02\x3d\xfb\x84\x6f\xcf\xfd\xdc\x02\xd9\x56\xa2\x5a\x53\x00\xaf\x0c\xbb\x6c\xd5\x38\x03\x7f\x29\x35\x82\xc6\x73\x03\x09\xba\x33\xc1\x14\x5e\x66\x7f\x0f\x5b\x09\x64\xd6\xd3\x1d\xc0\x30\x54\xa7\xa1\xb6\xa0\x57\x3a\x79\x07\x73\x28\x66\x06\x57\xa1\x22\x6d\x33\xc6\x43\xa1\xbf\x77\xfa\xee\xe2\xe8\xf0\xfd\xfb\xfd\x83\xb7\x17\xa7\xfb\x1f\xf6\x0e\x3f\x9e\xba\xa2\x19\x4d\x46\xda\x35\xb2\xc2\x15\xb6\x07\xa8\x62\x52\x54\x9e\xd5\x57\x88\x18\xd5\xb6\x00\xcc\x0b\xb0\x86\xbe\x09\x58\x80\x46\xe8\xec\x7c\xe8\x7e\xbe\x1f\x51\xc7\xe3\xf5\x4c\x54\x5e\x91\x3f\x5f\xd0\xa9\xc6\x4c\xa1\xd7\x72\xe5\xa2\xa0\xd5\x3f\x55\xbd\x40\xfa\x84\x6f\x7b\xb5\x3d\x82\x21\x2b\x2a\x7a\x80\x41\x6a\xb4\xea\xca\x6b\xb4\x25\x08\xf2\x90\x82\xb9\x31\x11\x11\xae\x72\xdc\xe8\x02\x9a\xa1\x56\x26\xcb\x3f\x04\x73\x47\xf4\x55\x36\xa6\x7c\x02\x0c\x5a\xa2\xde\x40\x5e\xc1\x7d\x4e\xf3\x0c\x00\x7d\xc2\xdd\x40\x59\x5a\xfd\xba\xf1\x15\xa7\xd3\x4a\x2a\xd8\x78\x99\x99\x9a\x0b\x4d\xb3\x1e\xc9\x3a\x78\x89\xf1\x40\x29\x00\x8a\xd3\xc9\x8f\x55\x0b\xd2\x74\xc5\x92\xd4\x6c\xa9\x63\x98\x15\xad\x35\x17\xa1\x0d\xe2\x96\xb4\xb7\x6a\xea\x81\x8f\x84\xb5\xde\x2c\x2f\x83\x34\x15\x95\xc3\x68\x90\xa8\x18\x45\x18\x5b\x81\x01\xa3\x3b\x6c\x39\x91\xb8\x44\x7e\xc2\x88\x42\xca\x01\xc8\xa5\x34\xf4\x84\x22\xca\x60\xc7\x52\xce\xae\x38\x42\xc1\x18\xf6\x3f\x83\x2c\x23\x57\x41\x6c\xf9\x53\x4e\xda\xc0\x40\x10\x0f\xa9\xd6\x24\x3a\xaf\xe2\x5b\xd1\xe5\xfc\x5d\xc0\xdc\x6a\xeb\xb8\xf9\x93\x61\xab\x82\x82\x1c\x5c\x49\xc9\x0d\x26\x5e\x12\xbd\x8a\xc2\x38\x88\xa9\x95\xff\x0d\x7f\xcb\x9e\x2f\xd8\xd3\x78\x4c\x24\xbd\x27\x4f\xaa\xda\xb4\x3e\x49\x18\x66\x1e\x28\xd2\xec\x4f\x45\x58\x71\x58\x39\x2c\x4f\x38\x85\x35\x86\xa3\x3e\x9e\xe2\x9a\x6f\xdc\x82\xad\x31\x85\x13\x2a\xe3\x2d\xcb\xa7\x59\x57\x73\xd9\x6c\xeb\xea\xc8\xa6\x1d\xb8\xb1\x7f\xd7\x26\x5e\x98\xfe\x8b\x33\xfd\x42\x6c\x5b\xb6\x07\x5b\x5b\xde\xaa\x17\xfb\x7a\xed\x7c\x07\xb5\x3d\xf8\x7e\x79\xee\x6d\x6d\x34\x49\xd7\x28\xda\x07\x02\x71\x82\x19\x23\xc9\xa4\x7c\xa0\xb6\x64\x91\x23\xed\xda\x41\x34\x42\x1d\xc8\x6a\xa0\xac\x33\xac\x84\x94\x6f\xde\x40\x5a\x7a\x74\x43\x3e\xa9\x46\x69\x1c\x82\xee\x35\x7a\x8b\xb1\x22\xda\x59\x50\x8c\x28\xcb\x48\x08\x6d\x6c\xf5\xfb\x68\x92\x22\x9c\x5c\xa1\x28\xc5\x34\xe9\x30\x91\x79\x10\x24\x91\x58\xb2\xfc\xf6\xe1\xfd\x3b\xc6\xe6\x72\x84\xf2\xa6\x81\xc8\xc3\x22\x3b\x1d\x5b\x10\xdc\x17\xe8\xe4\x1d\xeb\xd8\x2c\x93\x0d\xf2\x6d\x2c\x63\x1a\x76\xbe\x78\xbe\x12\xd3\x2c\xe0\xb9\xc9\xd2\xa9\x25\x93\x24\xcd\xf0\x20\x26\x49\x61\xa0\x96\x26\xef\x1a\xbd\xe3\xe3\x31\x93\xe7\x31\x93\xe7\x7f\x4e\x26\x8f\x3c\xad\x26\x2c\xaa\xfb\xac\x1a\x1d\xe8\x85\xe8\x61\x8f\x9f\x59\x0b\xe6\x8f\x60\xc4\xb5\x02\xfd\xd9\x4f\xf2\xa6\xa4\x85\x05\x53\x4f\x8d\xb0\xdb\xdc\x68\x57\x52\x0e\xe3\x80\x52\x79\xcb\xe5\x39\xf8\xc0\x63\xa2\x45\x21\x00\x20\x4d\x28\xcb\x16\x21\x4b\x33\xb3\xe6\x56\x9f\x07\x83\xb8\xad\xe0\x6b\x0d\x61\xeb\x10\x2c\x78\x77\xf6\xdf\x1e\x7c\xfc\xb0\xb3\x77\x7c\x71\x7c\xf8\xf1\xe0\x0d\xac\x7d\x3f\x1c\xbe\xd9\xcb\x09\xc0\xd4\xb2\x43\x26\x22\xac\xa9\xa7\xdb\x5c\x92\x89\x88\x67\xfb\x7f\x94\x0f\xdf\x01\xe5\x8f\x07\xfb\xa7\x70\xa3\xe2\x59\xfe\x04\xfe\x3a\xd7\x98\x58\x87\x93\x3b\x9f\x1c\x65\x1f\x1c\x65\x6f\x1d\x65\xf4\x5f\xc1\x65\x6a\x17\x8e\x49\x92\x94\x5e\xe3\xd5\x19\xe3\x19\x4b\x79\x76\x8e\xfd\x64\x4e\x42\xf7\x83\x24\x48\xdc\x0f\x66\x24\xcc\xaa\x9e\xc4\x31\x71\x3e\x01\x62\x65\xe8\x30\x73\x14\xc6\x71\xa9\xa3\x4e\x92\x93\x2c\x48\xec\xb3\xde\x9d\x0f\x4e\xd0\xb7\xce\xd2\x53\x67\xe9\x91\xb3\x74\xcf\x59\xfa\x4f\x67\xe9\x3f\x9c\xa5\x07\xce\xd2\x37\xce\xd2\xbf\x3b\x4b\x3f\x8a\xd2\xbc\xf0\xbc\xce\xfb\x28\xe6\x41\xf8\x80\x4c\x1e\x6d\xbf\x01\x11\x1f\xa0\xe7\xd6\x95\xc3\xf0\xf0\x64\xff\xed\xc1\xf6\xe9\xc7\xe3\xbd\x8b\xf7\x7b\x07\x6f\x4f\xdf\x95\xde\x84\x9a\x4b\xf5\xa0\x10\xf0\x32\x44\x85\x46\x0d\xd0\x1d\xb2\x0a\x72\xbd\xf2\xf9\x83\x8b\x37\x87\xbf\x1e\xa0\x65\x99\xa2\x15\x94\x1a\xa0\xcd\x8d\x8d\x8d\xbe\xd5\x03\xdd\xab\x1b\xe4\x4e\x9d\x13\x46\xfa\x73\x83\xc2\x9d\xcb\xa1\xf2\x4d\x1c\xf0\x65\x75\x15\x6f\x0f\xcc\x9f\xe0\x8a\x34\x7a\x89\xc8\xa3\x2b\xf2\xe8\x8a\xfc\xcf\x71\x45\xca\x49\xc5\x74\x1a\x3c\xaf\x38\x3f\xff\xa0\x4e\x08\x4c\xab\xbb\xd9\xed\x9c\xa5\xff\x75\xa2\xcf\xc3\x21\x2f\x7b\xf6\x47\x29\x9b\x16\x1a\xe6\x04\xec\xc3\x93\x4e\xaf\xce\x72\x2a\xb5\x46\xdd\xab\x20\x5e\x80\x00\xbb\x0f\xce\xc3\xaa\x4a\x3e\x82\x3d\x42\xf9\xd5\xc7\x49\x98\xc2\x1b\xbb\xc4\xda\x6a\x8a\x6f\x4a\xab\x2a\x85\xcc\xc9\xab\xa8\xec\x0f\x68\x0b\xa8\x88\x32\xba\xb8\xa4\x2c\xeb\x6e\x78\x68\xab\x27\xe8\x6c\xb8\xc9\xc0\x87\xa3\xa0\x91\x89\xba\xa5\x31\xa4\x3a\x1c\xa1\x50\x15\x6f\xa1\xf1\xfe\x3b\x7c\x03\xc9\x66\x14\x8b\xfe\xf7\x6a\x03\xc2\x32\x98\x01\x5c\x55\xec\x2a\xb7\x52\x1c\xb3\x7e\xcf\x3b\x3a\x40\x5b\x2f\x5f\x19\x10\x4b\x38\x3d\x77\xc2\x32\xbe\x79\x36\x74\x5b\xe3\x7c\xfc\xda\x83\xe7\x2f\xbd\xb6\x39\x9c\xed\xc1\xcb\x57\x60\x92\x5f\x3c\x9a\xe4\x47\x93\xfc\x57\x37\xc9\x7c\x89\xf4\x67\xd8\xe4\x7f\xc7\x85\xa1\x5d\x41\xdd\xba\x70\xed\x45\x5e\xdd\xfc\xe2\xf7\xe5\x54\x68\x03\x2e\xd8\xf8\x6f\x3a\x20\xfc\x2e\xad\x18\x17\x09\x61\x72\x7b\x2b\x2f\x87\xbf\x8e\x5a\x80\x0d\xe4\xef\x0d\x7b\x49\x00\x8b\x42\xf9\x10\xfe\x3a\x9b\x36\xc0\x27\x13\xa2\x03\xde\xb3\x0d\xf3\x4b\x03\x98\xcb\xe0\xf2\x32\x98\xe0\xce\xa0\x06\x46\x5b\x63\x0e\xaa\x60\x66\x8e\xba\x1c\x60\x1f\x9a\x81\xc5\xe9\x15\x8e\x83\x50\x35\xab\x0a\xac\x58\xe2\x0e\xea\xc0\x26\xee\x4a\x1d\x90\x6f\x1b\x43\xd2\x69\x90\x24\x69\xd2\x19\xac\x84\x2c\x96\xdb\x83\x06\x90\x0d\x6b\xe7\x01\x82\x81\x03\xd2\x01\xac\x2d\xeb\xf3\xe1\xab\x07\x6e\x4a\x59\x86\x24\x06\x4e\x60\x27\xf1\x3c\x8c\x30\x68\x0c\x5f\xd5\x18\x07\x7c\x41\xda\x0d\xef\x40\xf9\x64\xe2\xb8\x50\x1c\x58\x22\x44\x31\x40\xeb\x61\xcd\x1a\xd5\xe5\xaa\xae\x29\xa2\x03\x97\xad\x81\x0b\xe8\xce\x3d\x90\x26\xd9\x35\x01\xa4\x11\x82\xb3\x07\xc1\x38\x7c\x33\xc7\x21\xc3\x11\x12\x5e\xb0\x86\xec\x4c\xa9\x09\xa2\xf7\x78\xcc\x5c\xa9\x87\xc2\x7f\x5c\x6a\xa4\x2f\x31\xd4\x15\xe1\xc8\x05\xae\x32\x15\xe1\x02\x87\x20\x84\xbb\x1a\x85\x03\x23\x0f\xf9\x48\x2a\xda\xac\x5f\x5b\x27\xbc\x34\x1d\xf2\xc2\x64\x88\x01\x6d\x18\xd0\xc2\x45\xa6\x05\x7c\x06\x6f\x3c\x42\x41\x4c\x26\x70\xd7\x8e\xa8\x4b\xc3\x28\x26\x78\x98\x40\x64\x9f\x8d\x75\x89\x40\xf1\x78\xeb\xa9\x87\xe0\xa5\xed\xf6\xe2\x40\xfa\xe5\x70\x7d\xa6\xd8\xbb\xe7\xb0\xe8\x99\xac\x4e\xad\x3a\x9e\xa2\xcd\x9e\xff\x47\x4a\x92\x2e\x10\x41\x3f\x72\x5a\x68\x80\xda\x1b\x6d\xb8\xc5\x42\x00\x0f\xff\x3f\x8d\xf5\x31\xf0\xa9\x96\xf1\xff\x03\x07\xfb\x58\xbe\x95\xeb\x3e\xa3\x2d\x7b\xf2\x14\x75\xbf\x64\xdc\x7b\xf7\x1e\x6f\xb8\x4e\x03\x1c\x1c\x71\xa1\x13\x81\x13\xa3\x53\x7c\x83\x32\x3c\x87\xb4\xd0\x44\xbc\x01\x4a\x23\xe2\x1a\x77\x96\x7e\x64\xe3\xbf\xd5\x8e\x80\xe8\x25\x49\xd0\x14\xdf\xd4\x33\x3f\xa0\x21\x21\x0a\xc1\x6c\x06\xb8\xed\xd0\x38\xbe\x68\xad\x1c\x10\xd1\x1a\x6d\x38\xba\x53\x7c\x03\xba\xd6\xef\xa3\x9f\x49\x12\xf1\xf0\x1a\x49\xac\x8e\x49\x64\xca\xc0\x9f\x6c\xb7\x0b\x7e\x2a\xb2\x04\x8d\xd0\x86\x87\xe0\xcd\x18\x53\x7c\x23\x07\xc5\x04\x83\x00\x01\x3c\x13\xa1\x00\x58\x1b\xaf\x8e\x06\x00\xd9\xad\x61\xab\x7a\xcd\x0f\x2b\x9c\xee\x10\x11\xf4\x1a\xc5\x43\x44\x9e\x8e\xb6\x5c\x64\xa0\x85\x21\x9c\xdf\x1d\xc1\x8a\x98\xe2\xfd\x84\x69\x4d\xe9\x12\x68\x87\x87\x36\x5f\xf5\x86\x25\x54\x68\xb5\x40\xe5\x49\x66\xa5\xe7\xf0\x77\x99\xe1\xe0\x53\x19\x15\xb8\xf5\x74\x84\xc4\xd0\xf9\x20\x41\xbb\xd3\x20\xdb\x4d\x23\xcc\x29\x36\x0a\x43\x80\xf0\xf9\xe2\xf6\xd6\x2e\x65\xd9\x97\x49\xb2\x10\x9e\x2f\x12\xe5\x6d\x20\xf1\xef\x23\xcb\xbc\x39\x8f\xc2\xdc\x54\x98\x1f\x42\x22\x29\xcb\xbe\x48\x08\xcb\x42\x87\xba\xf3\x0c\x8f\xc9\x0d\x8f\xcf\xa3\x8d\x9b\x1e\x8c\x3e\x08\xbe\x94\x0d\x8d\xaa\x4b\x28\xa1\x1b\xcd\x2c\xac\x0b\x62\x27\x4d\xe1\x3c\xcf\x12\xf2\xea\xd3\xeb\x7f\xe2\x2c\x85\x86\x86\x69\x72\x85\x33\x26\xf8\x3c\x4f\x21\x9f\xff\x5f\xf2\xd1\xc6\x06\x4f\xa1\xc3\x41\x04\xcd\x84\x63\x90\xe9\xd8\x45\xbf\x24\xee\x8e\x8e\xa7\x63\x79\x0f\x60\x09\xdf\x14\x74\xd5\x45\x5d\xd2\x29\xcb\xbc\xa2\xd1\xb6\xa0\xc0\x48\x8f\x38\x13\x21\x02\x5a\xb6\x1e\x8a\x32\x34\xaa\xac\x04\xe3\x34\xeb\xe6\x8a\x20\x04\x92\xb2\x4c\x29\x02\x22\x4f\x9f\xae\x10\x4c\x80\x0e\xa5\x68\x6d\xb3\x2e\x59\x6d\x58\x1d\xf4\x14\x54\x65\x27\xf5\x0f\x74\xe4\x29\xe8\xde\x86\x95\xfa\x54\x9b\xd4\xd3\xc0\x8a\x97\xd5\x74\x25\x35\x60\x44\x82\x46\x5c\x7a\x8a\x48\xb0\x53\x23\xb5\xa6\x27\x92\xbf\xe8\x35\xda\x42\x3f\xa2\xce\x06\xbf\x94\x0d\x0d\x90\x95\xc0\x5c\x6e\x90\x5b\x51\xdb\x1b\x37\x6d\xf4\x14\x5c\x8a\x3f\x43\x5f\x75\x63\xae\x91\xad\x52\xd8\x86\xf3\x48\x9d\xf3\x2b\xb6\x27\x82\x18\x1c\xeb\xe8\x6b\x6b\x5f\x69\xa2\x01\x75\x42\x77\xff\x46\xfa\xb4\x86\xd0\xad\x29\x70\x0f\x2c\x5e\x0b\x6a\xdc\x8d\x36\x86\x6b\x5b\x15\x5b\xfb\xf8\x0a\x27\x8c\xdf\x35\xc8\xd9\x8e\x20\x91\x12\x05\x97\xba\xa8\xb8\x44\x8a\xc1\x8d\xf5\xe3\x34\x9b\x9d\xa6\x3f\x2f\xe2\x18\x6e\x51\x74\x89\x8e\x3a\x52\x0a\x54\x9f\x99\x54\x95\xc8\x14\x12\x23\x1a\x56\x6a\x57\xa5\x98\x38\xda\xa0\x09\x0c\xea\x42\xa5\xf6\x30\x83\x79\x83\x72\x1f\x7a\x5c\x9c\x9e\xea\x76\x7a\xe8\x49\xe5\xa9\x29\xd9\xd0\x1c\xaf\x76\xb4\x40\x2c\x20\x53\x52\x36\x87\x23\x71\xa9\x37\x73\xd1\xbb\xa4\x77\x27\xe9\x12\x1f\xe0\x87\x68\x29\xd7\x53\x96\x00\xd9\xb5\xa3\xa7\xa8\xd3\x05\xc9\xc9\x6b\x79\x8a\x3a\xbd\xce\x9a\x42\x61\xda\x9c\x88\xd0\x79\x1c\xdc\x72\x7e\x83\x9a\x86\x69\xc2\x2f\x77\xcb\xb9\xa9\x11\x71\x89\x83\xbc\x0b\xee\x8d\x20\x53\x25\x0d\x6a\xa0\x55\x2d\x8a\xb8\x18\xec\x7a\x73\x62\xb4\x10\x16\x21\x26\x32\xc2\x13\x1f\xcd\x16\x31\x23\xf3\xf8\xb6\xbb\x20\x09\xdb\x7a\xf9\xaa\x87\x9e\xfd\x90\x17\x56\x8a\x51\xb9\xed\x2b\x2f\xf2\x84\x31\xa6\x6c\x27\x0b\xc2\x4f\xfc\x0e\xc9\x92\x30\x99\x23\x08\xe0\x70\x1b\x43\x05\x7c\xaf\xe3\x1e\xf1\x6e\x51\x85\x90\x4d\xd8\xdd\xd5\xe8\x28\x81\xfd\x51\x5e\x15\x9a\xef\xf8\xe6\x78\x70\xc7\xb5\x29\xb0\xf7\x90\x0d\x10\xb3\x2f\x15\x8c\x53\x29\xaa\x0f\x2d\x15\x45\xdb\xd6\x11\x09\xf9\x7d\x95\x44\xa8\x46\xff\x47\x8b\x43\x81\xf4\x14\x6d\x7a\x3a\xfc\x33\xad\xcd\xcf\x20\x88\x97\xe1\x39\xec\x84\x74\x3b\xa8\xe3\xa1\x4e\x07\x84\xa7\xdd\x6e\x24\x3a\xbb\xc2\x7b\xa7\x32\x1b\x80\xa5\x62\xb9\x1b\x89\xf7\x88\xdb\x6e\x00\x51\x61\x26\x8d\x84\x4b\x76\x58\x2a\x5f\x44\x5e\x2d\x35\x9f\x85\x6f\xf2\x39\xdf\x7d\x5b\x3a\xe4\x25\x17\x97\xca\x01\xcf\x6b\x32\x86\x9a\x77\xc6\x1e\x6b\x49\x92\xa5\x79\x95\x32\xc7\xc1\x67\xa9\xfc\xdd\xfb\x12\x9e\x95\xdd\x26\x0d\xc9\xc5\x25\xed\x85\xed\x5f\x9d\x4f\x5a\x5d\xab\x39\x05\x62\x2e\xaf\x6c\x18\x39\xf8\x35\x2c\x01\xcb\x60\xea\x48\x62\x59\x3e\x95\x6b\x14\x24\x60\x8c\x29\x3d\x9d\x06\x49\x77\x03\x8c\x61\xe7\xd9\xc6\x0d\x4c\x8f\xf2\xf4\x8a\xd4\x83\x4d\x10\xe7\x8e\xfe\xa4\xd1\x28\x6d\x2f\x8a\xc5\x29\xe5\xf9\x66\xe2\xec\x2e\xef\x03\x22\x49\xf5\xb0\xf9\x1a\x19\x83\x62\x12\x89\x5c\x05\xa1\x03\x64\x7c\x4b\xe1\x4e\x6c\x1c\x32\x8a\x2e\xf1\x38\xcd\xb0\xbf\x62\xc4\x59\xfa\x0e\xdf\xac\x31\xd6\x9f\xa5\x17\x76\xaf\x21\xe7\x95\xd9\x83\xdd\xf4\x54\xf1\xdf\x8c\x0d\x7a\xe5\x7f\x11\x2a\x63\x00\x9c\x54\xf9\xb0\xbb\x6c\x9a\x26\x6c\xdd\xa7\x00\x39\x74\x92\xd2\xc5\xaa\x19\xb1\x0a\x5a\xf2\x78\x0b\x1c\xcd\x83\x15\x72\x47\x0c\x4a\xa7\x96\x24\xc4\x08\xba\x70\xcf\xb0\x9f\x8f\xa6\x68\x87\x45\xbf\xdf\x87\x2a\x08\xa3\x28\x40\x09\x9e\x04\x8c\x5c\x61\x29\xbd\x1e\xbf\x40\x11\xd2\x7b\xe0\xec\xe3\x62\x32\x75\x6b\x74\xde\x61\xa9\x13\xbc\x16\x6b\x18\x14\xd0\x55\x10\x17\xd3\x09\x68\x43\xaf\x2e\x98\x5a\xc9\x21\x05\xa0\xfe\xf1\x05\x38\x19\x9b\xe4\x1b\x52\xbf\x0a\xe2\x4a\x82\xa8\xfb\x84\xd0\x9f\x49\x42\x18\xae\x18\x44\xab\x99\x9c\xeb\x57\x41\xec\x6d\xf6\x6a\x5d\xf1\x95\x3d\xab\x51\xfc\x63\xe9\x6a\x08\x45\x87\xf8\x58\x42\x18\x44\x59\x7f\xc5\xab\xd6\x46\x13\xcc\xfe\x0e\x58\x87\xe3\x8f\x09\x61\xd5\x8a\xba\x14\x34\x21\xf3\x47\x7c\x29\xc2\x60\x2c\xf5\xf2\xdd\x21\xbe\xab\xea\x76\x83\x72\xe1\x5f\x4a\x83\x24\x13\x89\x38\xb9\xae\x68\xac\xc6\x4d\xb8\x50\x07\xce\xd7\x52\xc4\xcf\xce\x21\xa2\x41\x13\x0a\x39\x9d\x28\x4c\xb3\x0c\x87\x6c\x70\xad\x61\x99\xa1\x31\xb3\x77\x86\x65\x00\x42\xb6\x69\x80\x32\x7e\xf2\x8d\x30\xf4\x23\xff\x9f\xcf\xd2\xf7\xe9\x75\x7e\xd1\xfd\x40\x6d\xa6\x9b\x43\xa9\x92\x5a\xfe\x2e\xf3\x0b\x65\x82\xcb\x19\xfc\xdf\x3a\x56\x0d\x12\xa4\x81\xea\xaf\x21\xa9\xbe\x1a\x11\xb6\xb7\xf6\x80\x0b\xdd\x0e\x4f\xe0\x03\x02\xe2\x48\xdc\xef\x1d\x26\x5f\xcf\xe1\xa1\x79\x8c\x03\xca\x93\x83\x39\x5f\xd3\x24\x67\xf1\x38\x85\x20\x19\x04\xd2\x01\x93\xc2\x84\x63\xd9\x01\xd9\x62\xf5\xae\xbe\x2d\xfb\x88\xe7\xd2\x25\xab\xd0\xac\xc2\xa4\xe5\xbd\xf2\xd0\xe6\x46\x33\xb9\x3d\x0d\x3e\x61\x6e\x65\xf2\xcb\x92\xae\x31\x81\x74\xc2\x62\x16\x13\xa2\x06\x73\x59\x0a\xa2\x85\x38\xfb\xc5\xd0\x68\x94\x74\xa2\x47\x29\x15\x97\xb5\x02\x10\x45\x41\x86\xad\xf3\x42\x27\xfb\xb0\x7c\xc8\x98\xf8\x0a\x11\x01\xf5\x74\x6f\x3c\x26\x93\x5b\xf9\xe3\xd0\x16\xe5\x67\x08\xb2\x8b\xe4\xcf\x22\xf9\x87\xff\x94\xf9\x42\x26\xf8\xac\x00\xcf\x53\x72\xf8\x2f\xa4\x12\x79\x4c\xf8\x49\x01\x9f\x67\xc7\xc8\x80\xad\x48\xab\x29\x9e\x99\x88\xcf\x9e\xa9\x5f\xa8\xc8\x6a\xe1\x3f\xf9\x91\x19\xe3\x59\x0d\xa6\xca\x42\xe1\x3f\x45\x12\x8b\xfe\xcc\xc4\x2c\x20\x75\x22\xf0\x79\xf6\xcc\x84\xfc\xa4\x81\x9a\xff\x0c\x44\x9e\x3c\x62\x62\xce\x38\xa6\x59\x36\x71\x94\x31\xbb\xcc\x65\xe9\x60\xa6\x32\xed\x61\x6e\xe2\xa4\xeb\xa1\x2c\x9d\x74\x04\xe5\xe5\xfb\x4a\x3c\x3d\x55\x2e\xf7\xa4\xd2\x0c\x05\xe8\xdd\xde\x6f\xa0\x64\x81\x5a\x3e\x7c\x35\x03\xaa\xc8\x28\xff\x08\xfd\x3a\xc5\x89\xbc\x9b\x25\xd0\x12\xfa\x84\x23\x00\x33\xb4\x32\xbc\x60\x07\x02\x8a\xae\x31\x28\x36\x57\xa2\x6b\x42\x8b\x6e\x55\xda\x4e\xc9\x2f\xcd\x68\x76\x15\x23\x5c\xb6\x13\x6c\xa0\xa8\x52\x59\x41\xdd\x8d\x16\x98\x3d\x3f\x22\x70\xdf\x5c\xb4\x73\xdb\x35\x4d\x73\x97\x93\xb4\x3d\x11\xd9\x75\x42\x4b\x84\xd0\x8f\x46\x65\x03\xfd\x97\xe6\x8b\xdf\xdb\x0e\x05\x62\x90\x1c\xa6\xe8\x1a\x93\x47\xcb\xf3\x68\x79\xd6\xb1\x3c\x2c\x6d\x62\x77\xb4\xf5\xee\x9f\x6e\x81\x40\xd7\xff\x1d\x6d\x10\x4b\x1f\xdc\x02\xc1\x1d\x5a\xf4\xdf\xd1\xfa\x24\x72\xbf\x29\x48\xb4\x1d\x0b\xee\x00\xf1\x95\x3b\x4c\x46\x2a\x43\x5b\xc3\x76\xcb\x5b\xde\x62\x97\x34\x54\x4a\x5d\x21\x67\x2a\x07\x0b\x84\xab\x10\x36\x37\x55\x25\x1b\x1a\x21\x17\xa0\x3d\xb0\x39\x4c\x79\x78\x1b\xae\xd8\x5f\xea\x44\xe1\x4f\x6a\x87\x8a\xc9\xc0\xb5\x73\x1b\x65\xef\xdb\x31\x9e\xe5\x45\x9c\xec\x94\x78\x3e\x6c\x55\x2f\x6d\x55\x8b\xbf\xf9\x06\xc9\xef\xae\xe5\x26\x34\xc5\x7e\xaa\xaf\x75\xed\x1e\x57\x3a\xd9\x92\x48\x1e\xfd\xdc\xb8\xe9\x78\x9d\x8e\x2b\xa3\x63\xd9\x5a\xed\xb2\x4b\x6a\xba\xc4\xae\xed\xbc\x27\x91\x94\x5b\x87\xcc\xe6\x02\xcb\x7d\x7a\x1e\x54\x80\x63\x45\x79\x50\x41\x9e\xa0\xe2\xb0\xec\x3a\xed\x50\x24\x86\x77\x66\xc6\xd2\xdd\x32\x7e\x7a\x9d\xd2\x5d\x17\xf8\x4a\x41\x37\x60\xcb\xe2\x5b\x23\xb4\x66\x9d\x9a\xe4\xa2\x0a\xd1\x05\x59\xbf\x54\x94\x2b\x2c\x52\x96\x2e\x92\xc8\xbe\xcf\x10\x24\x35\x47\xd4\x83\x86\x8d\x45\xa5\x3d\xfe\xc2\x7f\x6d\x2e\x55\xfe\x3c\x5e\xd0\xa2\x29\xf2\x77\x39\x98\xe1\x68\x4f\x8e\xd4\x48\x9e\x76\xa7\x38\xfc\x44\xd5\x3a\x5f\xcc\x2a\xd2\xf6\xf0\xeb\xa9\xe0\x52\x98\xf8\x16\xec\x64\x20\xde\x30\xaf\x21\xbb\x24\x44\xa8\x68\xc8\xb6\x4b\xd0\xa5\x79\x51\x12\xd4\x2a\x06\xc3\x17\x94\xf1\x64\xc7\xf2\xbc\xa0\x4a\x51\xb1\x2a\x37\x24\x45\xd6\x66\x0f\xa4\xa4\xdd\xff\xbf\x1b\x37\x67\x1b\xcf\xbe\x0f\x9e\x8d\xcf\xef\x5e\x6c\x2c\xff\x77\x9f\xf8\x70\x67\x4f\x8e\xf6\x00\xcc\x5c\x83\x87\xff\x9f\xb8\xb7\x06\xdf\x40\x53\x9e\xf4\xff\x6f\x77\xe3\xa6\xf7\x63\x2d\xe3\x6c\x44\x19\xeb\x0c\x81\x55\x20\x76\x04\xae\x17\x12\xdd\xb8\x0c\x28\x09\xd5\x29\x26\x50\x75\x7e\x7e\xd1\xc5\x36\x73\xf0\x1c\x37\x89\x2d\x8b\xc8\xa1\xb3\x91\x66\x1b\x61\xa2\xd0\xc0\xb6\x9f\xfd\xec\x04\xab\xe8\xca\x3e\x74\x82\xbf\xb7\x26\x46\x74\x06\x77\xc1\x86\xc1\x9c\xc2\xd4\x0d\xdf\xd5\x6f\x4f\xb5\xb6\xf4\xc2\x4e\xad\x27\xf0\xcc\xd9\x11\x67\xc5\x87\xb9\x4b\x27\xb8\x89\x03\xfe\xf2\x3a\x79\x08\xd0\x41\x9e\x50\xa1\xee\x8b\x99\x1c\xe9\xbc\x6b\x56\xa5\x0f\x20\xec\x28\x94\x55\xcd\x70\x54\x1e\xc0\x0a\xb9\xb7\x9a\xf7\x67\xcb\xbf\x55\x7d\x13\x3d\xe8\xf7\xd1\x6e\x0d\xf3\x55\x2b\x47\xaa\xbd\x25\x27\xc2\xe4\x3c\xb4\x43\x42\xbe\x0b\xe8\x14\xd2\xf6\xe0\xd0\xb3\x42\x36\xe2\xa2\xbd\x61\xc5\xed\xc5\x5a\x4a\xd3\x0b\xf8\xff\xd3\xa7\xa8\x42\x74\x81\x79\x09\x9b\xa2\x98\xbf\xeb\x4f\x9d\x4e\x80\x84\x20\x78\xcf\x28\xf4\x46\x8d\x2f\x40\x45\x64\x42\xf8\xd1\x65\x78\x30\x0b\xe6\x30\xce\x9b\x25\xb2\x60\x19\xba\x79\x16\xac\xd6\x99\x33\x72\xce\xe7\x36\xf4\x03\xfa\x0e\x36\xa6\xe5\xa3\x33\x72\x6e\xbd\xd8\x14\xf6\xae\x8b\x87\x5c\x3b\xeb\x09\xbe\x1e\x39\x28\xea\x11\x64\x8b\xa2\x8b\x1b\xf5\xf6\xa4\x3c\xdb\x2e\x5b\x0e\x4c\x53\x7f\x61\xa6\xa8\x54\x9f\x0f\x62\xfd\x91\xab\x89\x6a\x9f\x06\xe3\xd2\x11\x96\x7e\x4d\x1d\x91\x88\x95\x2a\xc2\xd2\x7b\xa8\x88\xb6\x73\xa6\x1a\x54\xba\x11\x4e\x36\xa0\xd3\xb1\x64\x3a\x47\x40\x4e\x15\xf8\x22\x6d\x72\x00\x87\xa5\xde\xc1\x7e\x70\x03\x3d\x93\x24\x65\xf6\x5e\xad\xce\xf1\xe9\x62\x5a\x9c\x13\x02\x2d\xfa\x1e\xc2\xed\x5c\xd1\x12\x71\x55\x28\x61\x85\x0a\x96\x88\x90\xf1\x0a\x7d\xf8\x01\x7d\xe7\xaa\x1c\x3e\x76\x0f\x9f\x8e\x2a\xf5\xd0\x64\x4f\xed\x64\xb4\x92\xb2\x83\xd6\x6a\x6d\xb2\x28\x0e\x9b\xcc\x4a\xa7\xc5\x7a\xc8\x98\x92\x58\x2a\x2f\xf4\xdc\xda\x40\x97\xb7\x0c\xd3\x67\x32\xd1\x52\xf4\x9e\xdf\x11\x80\x36\x6e\x90\x48\x7b\xd7\x28\xba\x95\x50\x36\xa9\x81\xf2\x19\x20\x96\x9e\xc9\x77\xfc\x33\xe7\x0c\x69\x6b\xde\x9a\x1a\x67\xf9\xc2\x39\xa8\x4b\x30\x64\xb3\x02\x9b\xcf\x8e\x15\x2d\xc8\x5e\xff\xff\xd6\x39\x53\x35\x15\xc8\xe4\x8a\x26\xf5\x98\x18\xf2\x7c\x62\x97\xa7\x1a\xe4\x35\xa9\x04\x26\x38\x28\xf4\x62\x63\xbd\x4d\x5b\xb0\xd4\xc0\x27\x15\x35\xa3\xc5\x1a\x4e\x8f\x95\x71\xd7\x52\xc3\x77\x49\x83\x16\xda\x70\xc9\x43\x5d\x6a\x45\x03\x9f\x24\xa7\x6d\x0c\xbc\x68\xb6\xcd\x6a\x49\x56\xf5\x09\x6e\x15\x4e\x42\x9c\x8e\x8b\xbe\xa1\xcf\x9f\x0d\x0c\xf8\xeb\x4a\x78\xb8\x5e\x86\x7f\xf3\xb5\xfb\x0c\xdc\xa5\x3c\xc7\x95\x07\x59\x3a\x39\xe9\xce\x97\x0e\x80\x0a\x7f\xad\xcb\xfd\x13\x3b\x53\xec\xa1\x58\x2f\x08\x37\xe7\xbb\x9c\xe4\x64\x8f\x38\x77\x44\x9f\x3a\x0f\xcf\x76\xd1\xb6\x2f\xe6\xb9\xea\xd9\xfa\x5c\x57\xaf\xe8\xf9\x0a\x7c\x57\xa4\xbf\x88\xf3\x0a\xb3\xf3\x85\x1c\x82\xbe\xb0\xf5\xd9\x03\x68\x21\xfb\x0a\xcc\x39\x94\x7d\x6c\xca\x1a\xd9\x13\xf0\x80\x13\xd8\xfa\xfa\xe6\x1b\xf4\x44\xbe\x88\x5b\xdd\xb8\x2e\xf1\x79\x38\xd5\xc1\x49\x99\xe5\xf4\x85\x7c\xbc\x14\x5d\x5b\x9f\x93\x92\x27\x5f\x81\x95\x12\xe4\x8b\xc4\x4c\x76\xeb\x4b\xa5\x0c\xee\x86\xbb\x5d\x9f\x37\x7c\xfc\xbe\x02\x67\x38\xdd\xe6\x7c\x71\x8a\xd3\xbd\x38\x62\x87\x11\x84\xcf\x06\x27\x24\x24\xb3\x34\x64\x37\x4b\xfe\x8b\xa6\x49\x8d\x5b\x76\x5f\x8e\x00\x59\x83\x21\x8e\xc3\x46\x2c\xbb\xb5\x4a\x34\x1e\x3d\x79\xc2\x93\x7f\xb8\xdf\xee\x38\xf8\x07\x6f\x50\x84\xf7\x20\x76\x71\xaf\x9a\x86\x2b\xd0\xf5\x10\x5c\x0e\x24\x9f\xf7\xd4\x8b\x95\x2f\xe1\x8e\x4b\x34\xc5\x01\xdc\x26\x76\x19\xa7\xe9\xcc\x5f\xc9\xf8\x1d\x00\xab\xe1\x3c\x1c\x4b\x42\x38\x81\xb3\x50\x91\xa0\x29\xdf\x83\x60\xe0\xac\x31\x24\xbc\x42\x63\x4c\x38\xd5\x46\x91\xca\x97\x9b\x5b\x45\xa8\x52\xa0\xad\xc9\xf7\xea\x00\xa3\xa0\xad\x93\x76\xc5\x17\xcb\x50\x35\x0d\x30\xe3\x0a\x95\x0b\x26\xab\x9d\x0f\x20\x11\x71\x0a\x37\x5f\xcc\x49\xb8\x7a\xfc\x4f\xd3\x39\x09\x9b\x8e\x3f\x2b\x01\xaf\x31\xf0\xbc\x26\x63\xe0\x39\xb9\x46\x03\xff\xea\x45\x31\xee\x02\xeb\xc1\xc6\x9d\x93\xd6\x29\xbb\x86\xbd\x04\xf4\x55\x47\x7d\xc5\x85\xbd\x72\x59\x35\x50\xeb\x2b\xcf\x7e\xca\xef\x09\x19\xe4\xdf\xcc\xe7\x7c\x31\x36\x10\xff\xb3\x9f\xc8\x64\xda\x41\xf1\xd5\x84\xd0\x12\x6e\x07\xfa\x0f\x9b\x0e\xe4\xf1\x0e\xe4\xff\xed\x67\xfc\xfc\xe7\x40\x7d\x31\x9f\xaa\x1c\xe0\x41\xfe\xad\xfc\x5c\xe2\xe7\x5f\x4d\x08\xc7\xd1\xc1\x81\xab\xd0\xc4\x92\xe7\x82\xb4\x53\x6e\x03\x47\x99\x13\x47\x9d\x25\x1a\xd8\x05\x26\x34\x4f\xce\x80\x5e\xff\x8a\x89\xf9\x44\xa6\x8e\x0d\xd4\x17\x1b\x2f\x5f\xa9\x0d\xf4\x1f\x36\x94\xb9\xd9\x3a\x28\x95\xd8\xf0\x32\xbe\x30\x28\xbe\x9a\x10\xda\x0a\x76\xa0\xff\xb0\xa1\x8c\x68\xc5\xc0\x2e\xb0\xa1\x35\xb8\x0a\x08\x2b\x50\x39\x28\x17\x99\x18\xa5\xd0\xe6\xa0\x5c\x64\xd7\xa1\x16\x2a\x03\xed\xbb\x0d\x03\xdd\x48\x26\xaa\x43\xc9\xc4\x7e\x2e\x3c\xb5\x41\xfe\xcd\x7e\x2e\xed\xe1\xa0\xf8\x6a\x43\x70\xaf\x6b\xa0\xbe\xd8\x4f\xc1\x71\x19\xc8\xff\xdb\xcf\xf8\x0c\x3a\x50\x5f\xec\xa7\xdc\xcc\x0e\xd4\x17\xaf\x64\x60\x96\xde\x5d\x3b\xbf\x92\xb0\x3d\xd8\x7a\xee\xd5\xde\x67\xed\xb5\xe1\xea\x83\xf6\xe0\xd5\x26\x5c\xa3\xfa\x72\x9d\x6b\x54\x4d\x33\x36\x32\x8d\x58\x5b\xde\x22\xda\x86\x8b\x85\xfc\xad\x0d\xff\x55\xbb\x65\x05\x95\xe4\x8b\x3d\x5e\x35\xa9\x53\x47\xf8\x6e\x9d\x46\x0e\xf5\x57\xe9\xa4\x70\xf9\xf1\x38\x80\x58\xc9\x2d\x64\x6e\x46\x78\x5c\x13\x16\x97\xc4\xf8\xca\xbe\x2d\xfc\xdc\xb6\x6b\x5e\x80\xcd\xa6\x74\x36\x4b\x93\xff\x3a\x29\x3d\x2b\x99\xfa\xe2\x9b\x6c\x87\xea\x42\xb7\xed\xf7\xc3\x34\xc3\xed\x9e\x97\x5f\x19\xd9\xf6\xfb\x38\x09\x9f\x5d\x06\x14\xbf\x7a\x61\x3d\x98\x45\x2f\x6d\xd0\xab\xf9\xa7\x68\x6c\x15\x86\x64\x3e\xc5\xd9\x33\x41\xd9\xf6\x6e\x8d\x5f\xf9\x0c\x2a\xbb\x2f\x5f\x96\xc0\x7b\xaf\x78\xd8\x86\xc5\xa8\x78\xe0\x07\x33\x67\x96\x7e\xbf\x8f\xb6\x3f\xbc\x29\x15\x0b\xa4\xee\x99\xea\xa5\x87\xcc\xce\xf1\xdf\xd0\x27\x0f\x15\x5d\xf1\x90\xd5\x83\xf3\x7c\xf8\x56\x77\xc5\xd9\xb4\xb7\x71\x7a\x19\xc4\xa8\x7b\x09\xa7\x29\x70\x56\x4e\x76\xca\x47\x25\x4d\x99\xaf\xee\x46\xae\xac\x6c\xd9\x65\x53\x42\xf5\x17\xba\xe5\x28\x48\x0a\xad\xfa\x68\x72\x58\xc1\x36\x9e\x0e\x1b\x2e\x18\x2d\x3d\x84\xc5\xce\xae\x76\x57\xf3\xd0\x0d\x71\x11\x93\x4b\x80\xf2\x63\x72\xe9\x06\xd9\x81\x15\xc4\x2e\x67\x28\x00\x02\x82\xaf\x95\x55\xd1\x0d\xe2\x49\x0a\xf0\x3e\x7c\x19\xb6\x4a\x50\xfd\x3e\x7a\x9f\xa6\x9f\x16\x73\xc4\x82\xcb\x18\xbb\x3b\x70\xb2\x73\xf8\x9b\xe3\xd5\x89\xea\xf1\xfe\xc1\xdf\x2f\x56\x80\x9c\x7c\xdc\xb9\xf8\xb0\xff\xdb\xc5\x46\x03\x98\xcd\x06\x30\x5b\x0d\x60\x9e\xd7\xc0\xf0\x26\x37\x68\x93\x0e\xb7\xd9\x10\x6e\xab\x21\x9c\x6a\x5f\x09\x50\x58\xa5\x39\x5c\x4b\x1d\xd7\x8e\xcd\x0a\xc1\xb4\x68\x45\xe9\x02\xce\x93\x70\x5a\x4e\x58\x68\x60\x54\xd1\xf8\xaa\x7d\xba\xad\x97\xaf\x2a\xef\xf6\x50\x1f\x30\xcc\x00\xbb\xb9\xf5\xb7\x3a\x30\xf8\x44\x67\x04\x5e\xe9\x48\xd0\xeb\xd7\x68\xd3\xdd\x0a\x6d\xd1\xd0\x88\x56\x57\x10\xeb\xa1\xff\x8b\x36\x6e\x36\x37\x1d\xea\x55\x36\x0e\x35\xfb\x28\xea\xd3\xef\xa3\x5f\x83\xf8\x13\x7a\xfb\x73\x77\xeb\xff\xfe\xad\x57\xc9\x50\xb8\x7a\x68\x63\x58\xfd\x98\x54\x3f\xbf\x2f\xbf\xb5\x31\xa7\x97\xe9\x4d\xcb\x0d\x25\xcf\xd0\x43\xfb\x6e\x08\xfa\xbf\xa8\x7b\x53\x30\x4a\x7e\xdf\xd2\xbe\x3f\xd7\xbe\xbf\xb0\xac\xaa\xfe\xe1\x04\xbb\xf4\x06\xfd\xf0\xc3\x0f\xe8\x6f\x1c\x89\xde\xa0\x6f\xd0\xc6\xcd\x78\x0c\xbf\x36\x6e\x5e\x3d\xaf\xc6\x06\x33\x72\x76\x03\x22\x40\xb5\x2b\x57\xec\x8f\x32\x38\x67\x94\x83\xde\x0c\x5b\x95\xa0\x1a\x2f\xe4\xbd\x04\x24\xb4\x8e\x78\x5b\x1f\xe0\xca\x0d\xe8\x70\x74\x66\xbf\x4c\x54\xff\xc7\xc1\x5e\x08\xb0\xad\x55\x70\x7f\x13\x70\x2f\x5c\xda\xee\x68\x29\x5d\x5c\x8a\x3d\x55\x0f\xcd\xc8\x0d\x0a\xd3\x78\x31\x4b\x68\x95\x15\x50\xff\xa0\x45\x70\x33\x46\x37\xe2\x7c\xf9\x16\xc4\x7d\x63\x53\x8d\x80\xfc\xb9\xb1\xb9\xa1\x6f\xec\xd9\xff\x94\x6d\xda\x10\x83\xd0\x65\x20\x12\x5b\x2f\x7a\xe8\x33\xea\x32\x39\xa4\xab\xb1\x37\x75\xec\xcd\x57\x1a\xf6\xe6\xab\x06\xe8\x5b\x3a\xfa\xdf\x7a\xa8\x40\xdf\x7a\xd1\x00\xfd\xb9\x40\x67\xcd\x78\x4d\x92\x2b\x9d\xdf\xf0\xf3\x3e\x3c\xbf\xf9\x9b\xc6\x61\xa9\x43\x2f\x64\xd1\x86\x1c\x85\x9b\x2d\x05\x23\x7e\x36\x1c\x13\x7d\xce\xd8\x38\xa3\xf7\x19\x18\x9d\xc4\xe6\x19\xbd\xd7\xe8\xe8\x34\xb6\xce\xe8\xbd\x86\x48\xa7\xf1\xfc\x8c\xae\x33\x4e\x09\xbe\x81\xab\xea\x16\x89\x11\xe0\xb4\x3e\x30\xd3\x3c\xb9\x59\x35\xc9\x48\x93\x37\xfa\xe2\x19\x86\x13\xda\x42\xff\x17\x45\x67\xf0\xb9\xf9\x1b\xfa\xbf\xe8\x66\xeb\xfc\xbc\xc6\x1c\xc0\x1f\x98\x5b\xb0\x07\xd1\xd9\x0d\x39\x3f\x5f\x7f\x3e\x52\xdf\xd4\xbf\x65\x39\x75\x4d\x32\xef\x28\xc3\x90\x8a\xce\x5f\xfb\x70\x1c\xa6\x89\xf4\x27\x4a\xa0\x60\x3a\x8e\x77\x0f\x0f\x60\xf2\xdf\xb8\xd9\xd8\xf0\xd0\xc6\xcd\xc6\x26\xff\xef\x16\xff\xef\x0b\xfe\xdf\xbf\xc1\x7f\x37\xf9\xd3\x2d\xfe\xdf\x17\xfc\xbf\x7f\xe3\xff\xdd\xbc\x84\xff\x3e\x7f\xe5\xf4\x68\xbe\xfd\xb6\x54\x86\xbe\x45\xdb\x7b\x27\x32\x32\x2e\xd6\x3a\x08\x9c\xd4\x8c\xb0\xe9\xcc\x77\x80\xf7\x5b\x56\x09\xd7\x3e\xa0\x31\x92\x8e\xae\x2f\x7e\x68\x6e\xb1\x8f\x6f\x18\x4e\xa2\xae\x7b\x1c\x2f\xa2\xf4\x18\x53\xcc\x06\xda\x2a\x60\xc5\xc4\x7a\xf2\x89\xcc\xe1\x32\x0b\xcc\xf3\xf6\x92\x63\x48\x7a\xa7\x3c\xe1\xf6\x12\x43\xc0\x17\x33\x79\xa3\x04\x3f\x2b\xf0\x09\xdf\xa2\x88\x44\xe2\x98\x37\x7f\x2f\x7d\x25\x6d\x10\x5d\x58\x91\xf8\x17\x8a\xa8\x7a\xf5\xfe\xc5\x27\x7c\x7b\x94\x91\x34\xe3\x6d\xe5\x6b\xda\xbc\x7c\x95\xa8\x57\xbd\x39\x7a\x85\x73\xb3\x72\x59\xa3\x3e\xea\xc5\xe9\x23\x77\x53\x8b\xd2\xe1\x2a\x12\xbf\xa6\x19\x7f\x3f\xfb\x27\x7c\xeb\x5f\xc3\xf7\x95\x18\x27\xe4\x5f\x58\x22\x50\x32\xd9\x81\xf9\x12\xf5\xd1\x8b\x61\xab\x12\x51\x37\x27\xf9\x19\x49\x7e\x6e\xa1\xbe\x87\x6a\x44\x46\xc8\x1c\xa1\x51\xde\x8c\xa7\xe8\xd5\xba\xf5\x02\xdb\x68\x38\xc5\x10\x65\x40\xb0\xa6\xad\x6d\xc2\x27\x7a\x0c\x97\x08\x8c\x50\x57\x55\x0e\x97\x41\xa3\x6f\x9b\xf6\x57\xaf\xad\xbe\x22\x7c\x7b\xa2\x5a\xa5\x8d\xaa\x56\x56\xb5\x3c\x30\x5c\x56\xde\x5e\xe1\xb6\x8a\xaf\xaf\xc5\xff\xa9\xfc\x5d\xef\xc1\x2a\x85\xc8\x51\x05\x9b\x57\xa1\xc0\x47\x6b\xeb\x19\x47\x07\x5f\x40\xc9\x97\x2c\xa9\x6e\x7f\x63\xd3\xaf\xd8\xc5\xd0\xa8\x5c\x25\x7a\x86\x36\xeb\x5c\x3d\xf5\x0f\xba\xf8\x44\x76\xf2\xff\xe4\x9d\x6c\xd2\x4b\x39\xba\xc7\x29\x43\xa0\x2c\x8d\xe0\x99\x3e\x61\x9b\xf3\x75\xd3\xfa\x4e\x16\x97\x6b\xd6\xc7\xdd\x74\x55\xd3\xb9\xe6\xb4\xf0\x07\xb2\x0d\xe0\x22\x8a\x15\xc2\xb9\xe6\x93\xe8\x10\x7f\x33\x00\x78\xfb\x25\x65\x59\xdc\xbc\x0f\x1f\xc8\x0d\x9f\x0b\x1b\xf6\xe1\xff\x8e\xf8\xcc\x78\x26\x87\xa9\x9f\x0f\x13\xfa\x8c\x36\x64\x87\xea\xe5\x49\x93\x29\x18\x70\x89\x8f\x7e\x40\xaf\x20\x24\x67\x8d\x3e\x1a\x8d\xd0\x8b\x35\x24\xe0\xdf\x79\x44\x54\xb5\xf7\x98\x7c\x56\x69\x73\x49\xdf\x24\xfb\xce\xd1\xff\x45\x8e\x17\xd6\xd7\xfb\x4f\x0d\xda\xa3\x59\x52\x58\x21\x34\xb6\xa6\x24\xb9\xfa\xc5\x61\x50\x4b\xc5\x8d\x6c\x2a\x60\x15\x66\x35\xff\x55\x58\x56\x55\xb4\xda\xb8\xea\x26\x5a\x4e\x2d\xcf\x72\xf4\x15\xba\x04\x42\xac\x40\xd1\xff\x69\x26\xad\x95\xa6\xf2\xcf\xb0\xc5\x2f\x56\x55\xb2\x46\x7f\x5f\xa3\x17\xe8\xf3\x67\xc9\xbb\xd7\x0d\x75\xd5\x1c\xee\x33\x45\x4c\xae\x7e\x14\xd4\x17\xf5\xbf\xa6\x0a\x7d\xd1\xb5\x71\x66\xaa\x3f\xe8\x8b\xfe\x7c\xf3\xac\xc2\x0a\x9c\xa3\xff\xbb\xb2\x0d\xe5\x55\xa2\xdb\x60\xd8\x95\x3e\x57\x8d\x52\xcf\x57\x0d\x97\xfa\xd6\xec\xc9\xd2\x73\x8f\x2f\x4e\xf8\x0b\x09\xe5\xeb\x67\x8b\x15\xc0\x07\x0f\xa5\xe3\x31\xc5\xa5\xe4\x2c\xfd\x23\x1c\xa3\x28\xdd\xcd\x49\x14\x78\x9e\x7c\xaa\xc9\xa2\x97\x47\x27\x36\x8a\xaf\x9b\xc5\xd7\xad\xe2\xeb\x73\x0f\x01\x3b\x7a\xc3\xb5\x3a\x13\xe1\x2f\xe8\x0c\xcc\x23\xd7\xc1\x1c\x6d\x25\x11\x5f\xb6\xbc\x60\xd3\xd5\x1e\x29\x68\xdb\x87\x33\xd1\x63\xf4\x94\x3b\x3c\xea\xb1\xfd\xcf\x00\x33\xd1\x9e\x37\x43\x7b\xbe\x2a\x52\xd0\x64\x3c\x4c\x1d\xf1\x0c\x19\xdc\x30\x7f\x6e\x9a\x3f\xb7\xcc\x9f\xcf\xe5\x4f\x31\x4c\x95\x6d\x82\xb3\x29\x10\x5d\xfa\xcf\x60\xad\x7a\xd4\x44\xda\x0c\x46\x3b\xc5\xcd\xd3\x2d\xf1\x3d\xa4\x1f\xdd\x35\x59\x98\xde\x67\xd5\x56\x3f\x60\x6f\x31\x1c\xfa\x9f\x2f\x98\x07\x87\x6d\xc4\xe2\x10\xba\x52\x5b\x13\xdd\xd0\x38\x7f\xce\x8b\xff\xaf\x31\x13\x6d\xd4\x0c\x05\xe8\x12\xdd\x34\x87\x6e\xf3\xdc\x22\x50\x27\x02\x9c\xc0\x96\x49\x60\xcb\x26\xb0\x2a\x44\x4d\x9f\x9b\x04\x9e\xdb\x04\x9e\xd7\x2d\x68\xfa\x7d\xf4\x8b\xe6\x12\x81\xf1\x58\x19\xab\xd3\x5d\x90\x15\x8b\xd8\xe3\xfa\x25\x7a\xee\x20\x89\xd1\x82\xb8\x9e\x1c\xb8\xd7\x4a\x08\x64\xc1\x6a\xc7\x08\x4c\xe1\x94\x8c\x19\x74\x81\x7a\x7a\x64\x58\x8b\x0a\x37\x95\x0d\xd5\x4d\x06\xf2\xa1\x24\x7c\xe3\x8c\x6e\xe4\xde\xf7\xff\xcd\x8b\x37\xcf\xba\x74\xb3\xe4\x7b\x17\xcf\xb7\xce\xba\x74\xcb\xf6\xbc\x8b\xc7\xcf\xcf\xe8\x73\xad\x58\x1f\x39\xb9\xde\x3e\x1f\xae\x6e\xe7\xa6\xd9\xce\xcd\x8a\x76\x6e\xad\x68\xe7\xf3\xda\x76\x6e\x7c\x79\x3b\xb7\xcc\x76\x6e\x55\xb4\xf3\xf9\x8a\x76\x6e\xd4\xb6\x73\xf3\xcb\xdb\xf9\xdc\x6c\xe7\xf3\x8a\x76\x6e\xac\x68\xe7\x66\x6d\x3b\xb7\x56\xb6\xb3\xb6\xa1\xfd\x3e\xfa\x38\xe7\x6f\xf2\xa6\x2c\x60\xd5\xcb\x19\xf8\x70\x43\xc7\x36\x86\xf5\x40\x20\x45\xac\x26\xb6\x0e\x7f\x14\x86\x90\x59\x6f\x0a\xb2\x3f\xdc\x28\xb1\xe7\xc3\x7b\xae\xd8\x2a\x14\xb9\xb9\x5d\xe7\x7a\xdb\x15\xcb\x5e\xba\x51\xb9\x64\x76\xe8\xad\x1d\xc5\xa0\x5b\xb5\x8b\xe6\x42\x77\x7b\x6b\x0b\x5b\xae\xb8\xaa\xa1\x9b\xd5\x0d\xdd\x5a\xdd\xd0\xe7\xf5\x0d\xdd\xf8\xc2\x86\x6e\x69\x0d\xdd\xaa\x6e\xe8\xf3\xd5\x0d\xdd\xa8\x6f\xe8\xe6\x17\x36\xf4\xb9\xd6\xd0\xe7\xd5\x0d\xdd\x58\xdd\xd0\xcd\xfa\x86\x6e\xad\x6e\x68\x65\x4b\x41\xc8\x61\x27\x82\xbf\xa9\xbd\x12\x4a\x4d\xeb\xc2\x33\xa9\x57\x60\xcd\x05\xd8\x3c\x5f\xa1\xc7\x1a\xec\xd6\xf9\x0a\x75\xd6\x60\x9f\x9f\xd7\x68\x75\x95\xc7\x29\xe3\x3b\xfc\x3d\xf4\xfd\xe7\x5b\x25\x98\xa5\xcb\x11\x37\x72\xf4\xd5\xe7\xdb\xdc\x7b\xcc\xfd\x56\x0a\xa7\xc5\xe1\x52\x06\xb1\x0d\xd5\x51\xb7\x83\xc3\x65\xe3\x38\x1b\x07\x21\xf6\x1d\x74\x5c\xa4\x7f\xc2\x37\x01\x24\xf6\x36\x04\x57\xd2\x26\xea\x65\xb0\xc5\x59\xa4\x8a\xc1\x6e\x96\x2f\x17\xa9\xdd\x19\xa6\x34\x98\x60\xee\x56\x7b\x28\x1c\x4f\x7a\xc3\x3a\x82\xf3\x38\x80\xb6\xdf\x30\x64\x13\x94\x0b\xc5\x6e\x51\xe5\x0a\x9a\xe5\x6d\xb7\x5d\xc7\x3e\xdb\x85\x78\xd3\xcd\x3b\x1c\xcf\x71\xd6\xdd\xde\x2b\x25\xde\x89\x3d\x4a\x57\xba\xbd\xde\x3c\x6d\x10\x97\x2a\xe7\x51\xa4\xc5\xea\xb9\x84\x83\xad\xbf\x79\x2a\x1b\x71\xb0\xf5\xbd\x67\xe6\x23\x0e\x9e\x6f\x78\x45\x22\xe2\xe0\xf9\x96\x27\xf3\x13\x07\xcf\xbf\x5b\x9e\x7b\x5b\x7f\x7b\x90\x24\xd4\x7f\xc3\xec\x53\x9b\xe5\xff\x1f\x73\x44\xff\x83\xb3\x3d\x4b\x46\xe3\x5b\x24\xd3\x2e\xa1\x67\xfc\x6a\xb7\x34\x81\xbb\x94\x2c\x9b\xa0\x9d\xaa\x81\x3f\x45\x1f\x32\x3a\x7d\x49\x00\xee\x5d\x29\x5a\x50\x7b\x63\xf6\x9f\x94\x54\x0a\x17\xd2\xe4\xd9\xa4\x01\xc5\x6e\x30\xd8\x9b\x55\x27\x18\x05\x6c\x5e\x52\x41\x77\x31\x1e\xe3\x0c\x47\x3c\x3e\xb0\xad\x36\xf5\x8b\x9a\x9c\x8f\xdd\xa4\x76\x2f\x70\x12\x02\x26\x98\x42\x37\x88\x7c\x6b\x20\x87\xf4\xe1\x87\x1b\x6c\x87\xa7\x2b\xe7\x80\xe2\x67\xc3\xbc\x59\xf5\x50\xfd\x03\xa0\xbd\xab\xf9\x2f\x6f\x7e\x2e\x92\x0f\xc4\xef\xe6\xd3\xd0\x36\xbc\x24\x03\xde\xeb\x03\x76\x4b\x4e\x01\x88\xe1\xd9\x3c\x0e\x58\xf3\x09\x67\x9e\xa5\x73\x9c\xb1\x5b\x74\x97\xc8\x6b\x2b\xe5\x54\x89\xf8\x1d\xeb\xf9\x8c\xc6\xb7\x2c\xc8\xbf\xb0\x8f\xde\x88\x3b\x9a\x07\xe8\x05\xea\x6e\x6e\xfd\x0d\x5d\x12\x46\x7b\x0d\x69\x93\x2b\x07\xe9\xfd\xbf\x3f\x00\xe5\x8b\xbd\x83\xdd\x8b\xdf\x7e\x3e\x3c\xfe\x70\xf1\xe1\xf0\xcd\x1e\xda\x86\x5b\x5d\xe1\xbe\x05\x56\xbc\xe4\x03\xce\xd2\xc9\x09\x11\x72\x35\x66\xf0\xea\xc1\xa6\xe4\xdf\xec\x35\x22\x1f\xe1\x95\xe4\xcb\x13\x22\x48\x83\x54\x71\x25\xe2\xf9\x4f\xb7\xac\xd7\xe7\xa5\x38\x05\x46\x9a\xa2\x34\x19\x93\xc9\x22\x93\x6f\xf7\xe3\xaf\x07\xa4\x7e\x05\x74\x15\x11\x8d\x3f\xb9\x22\xc3\xd8\xa2\xd3\x29\x46\xfb\x7f\x07\x6f\x08\xae\xdf\x87\xb8\x0a\x98\x4d\x04\x52\x60\xbf\x60\xa5\xc6\xf4\xa9\x4f\x38\x9e\x0c\x10\xa8\x99\xea\x6d\xcf\x6b\xad\xdd\x5f\xee\x56\xc0\xad\x64\xb9\xc4\xc1\x1b\x21\x56\x4b\xc1\x0a\x06\x88\x93\x94\x5a\xef\x41\x3f\xa0\xfb\x90\x4e\xb2\x0a\x4d\x5d\x1c\x1c\x8e\x27\xa8\x7b\xc8\xc7\x00\x5e\xd0\x02\xe8\xa1\x6b\x7c\xd6\x67\x68\x65\x0b\xa4\xcf\x74\x27\xe4\x6b\x89\xb6\x0b\xa6\x80\x34\x87\x78\x6d\x8a\xb0\xea\xd7\x8f\x8e\x36\xc3\xaa\xf4\x73\x6b\xd1\x94\xb2\xc8\x36\x6b\xae\xa9\x4a\xdf\xf2\x85\x1f\xb9\x27\xc6\x37\xcd\xba\x32\x75\x83\x8f\x92\x87\xee\x10\xb9\x1a\x20\x72\x95\x17\xa1\xa5\x35\xe7\xaf\x14\x4a\x93\xbe\x1e\xbc\xce\xdd\x60\xc7\x7c\x6c\xf9\xac\x30\x8a\xb2\xa9\x32\x6b\xcb\x34\x5f\xf5\x3e\x75\xcd\x3a\x67\x7d\x55\x58\x6d\xb1\xea\x47\x52\xca\xf4\xa3\x2a\xfc\x9b\xaa\xc2\x1b\xfc\x75\x55\xe1\x0d\x7e\x68\x55\x78\xb3\xf7\xb5\x55\x61\x3f\x21\x8c\x04\x31\xf9\x17\xbf\xf1\x2f\xc1\xd7\xf1\xad\xec\x4d\x24\x19\x7a\x5f\x35\x50\xfe\xc2\x0d\x1c\x4a\xfe\x00\x2f\xa6\xdd\x23\x70\x89\x03\x5c\x25\xa7\xcf\x3a\x69\xa6\x2b\x1e\x3f\xc6\xcc\xaf\x1e\x53\x6a\x98\xbb\x17\xfe\xaa\x1a\xff\xf3\x14\xef\x2b\x0b\x7c\xb7\xfc\xc0\x72\x0e\x3d\xf4\xd0\xaa\x00\x6f\xc2\xd2\xe5\x3f\x1f\x7e\x0f\x35\x51\x05\x58\x15\xcf\xe7\x20\x84\x7c\xfa\x57\xaf\x20\xa0\xad\x0a\x78\xa9\x34\xe3\x89\xda\x9c\x0c\xc7\x13\xe5\x24\x81\xd6\x0d\x5b\x95\x98\xb0\x30\x64\xb0\x10\xcd\x8f\xce\x73\xb3\xaf\xd2\x8d\xeb\x6b\xbc\x28\xc4\x7a\x54\x88\xf8\x70\x05\x12\xb8\x46\x3c\xb3\x66\x45\xb3\xf8\xd6\x29\x57\x4c\xb8\xec\x62\x51\x73\x6c\x82\xd3\xe5\x59\xd4\xdd\x87\x33\x0a\x3c\xe7\xd8\x9c\x1e\xf9\xab\x02\x69\xde\x2a\xb0\xef\xf8\x4f\x14\x77\x69\x8a\x6a\x3b\x5a\x25\x91\xd9\x9a\x89\xe9\xbc\xf7\x28\x0a\x58\x80\x2e\xf9\x92\xa3\x12\xb8\x62\x45\xc2\x2b\xf4\xe1\x1d\xc5\xdc\xa3\x59\x21\x83\x47\x38\x03\xf1\x01\x81\x0f\x33\xcc\xf0\x33\xc9\xf1\x38\x9d\x90\xb0\x7e\xdc\x55\xd6\xfd\x03\x0e\xfd\x76\x14\x51\xd1\x77\xb8\xae\x3f\x37\xd4\x38\xd2\xec\x34\x8e\xd6\x1e\x79\xdb\x42\x7f\xa6\xf2\xe6\x15\xa8\x4a\x6e\x9a\x81\xc1\x55\x35\xcb\x6a\xb5\x4a\xef\xed\xd4\xe4\x75\x2e\x8b\x1a\x82\x31\xdc\x29\x3c\xcf\xd2\x10\x53\x4a\x92\xc9\x9f\x28\xc9\x60\xb8\x0b\xa6\x8e\x94\x64\xcb\xb6\x74\x3b\xc0\x01\xfd\xee\xbc\xf5\x49\x5c\xab\x0e\xaf\xa9\x27\x12\x5f\xd7\x94\x62\x74\x56\xdb\x6c\xac\xbf\x32\xc7\xfa\x07\x6a\xe0\x5f\x04\x1c\x48\x27\xba\x42\x33\x44\x83\x50\x70\x15\x90\x18\x4e\xec\x89\x23\x2e\xb4\x91\x1f\x75\xa1\xd8\xf1\x70\xba\xf1\x33\x49\xa4\xa7\x54\xeb\xc2\xc8\x8a\xab\x64\x0a\x1d\xa4\x0c\x23\x36\x0d\xc4\xcb\x13\xc7\x92\x68\xe1\x45\xc0\xb5\x57\x78\x3c\xc6\x21\xbc\x12\x03\x5e\x70\x80\x22\x2c\x6e\xb7\x24\x57\x18\xee\x35\x08\xa2\x67\x69\x12\xe2\x2f\xf0\x3b\x9a\xaa\x23\x6f\xdc\x9f\xab\x94\xa2\xca\x7f\x27\xd5\x54\x23\xd4\xbd\x97\x56\xe6\xd8\x5f\xa0\xd9\x39\x8d\xfb\xaa\xb6\x22\x70\x2f\xdd\xfe\xb9\x10\x82\x05\x17\x8d\x4a\x60\x32\x6e\x4a\xb6\xde\x28\x28\x88\xf5\x92\x14\xee\x37\x91\x02\xcb\x39\x7b\x8e\x84\xc8\xe1\xe8\x0d\x08\xa3\xf4\x26\x2f\xa2\x54\x69\x7d\xb7\xce\x5a\x49\xb9\x2e\x13\x5a\xcf\xf8\xe4\x7b\xb3\x9b\x5b\x7f\xeb\x3f\xdf\xaa\x80\x22\x57\x0d\x80\x2c\x47\x7f\x80\x36\xab\x00\xcd\x45\xe6\x00\x6d\xad\x6f\x19\x55\x40\x85\xca\x8d\x96\x5c\xcc\xf8\x62\x29\x58\x6b\x37\xb8\x91\xe5\x52\x91\x0a\x39\xc4\xa7\xf9\x8e\x33\xd4\x27\xd6\xaf\x28\x40\x53\xbe\x87\x0a\x0b\xb5\x7b\x1b\x2a\xb5\x38\xdc\xce\xaf\x2b\xe5\xd7\x71\x4b\x25\xe5\x6b\x05\x69\x0b\x1d\x9d\xff\x8f\x08\x9b\xc8\x63\x9f\xe5\x4d\x36\x6b\x27\xba\xb4\x96\x5c\xd3\x06\x19\xd4\x06\xfa\xf6\x5d\x9d\xa1\xc8\xa1\x28\x8e\x71\xc8\x44\xcb\x4e\x58\x16\x30\x3c\xb9\xed\x36\x38\xb7\xa9\x6d\xd4\xf2\xd5\x57\x71\xf3\xf1\x2a\x4c\x4d\xaf\x8f\x02\x4a\xc1\xf6\x42\x08\x3e\xaa\xba\x26\xe6\x5e\xa7\x0d\x64\x05\x27\x38\xe3\x51\x18\xf0\x72\x1a\xd1\xbf\x8f\x8d\x94\x75\x15\x8c\x17\x2a\xb3\x8a\x0f\x12\x6d\x75\x5f\xa4\x52\xe8\x93\x4c\x39\xd9\xa2\x01\x4f\xb4\x4a\x2b\x47\x3d\x4f\xe7\x10\x7d\xf0\x50\xa3\xb4\x8e\x86\x66\x58\xff\x44\xb8\xd4\x29\x67\xc2\xc7\x43\xf5\xcb\xcc\x2a\xf1\x90\xb3\xb2\x06\x5d\xab\x85\x58\x0e\x5b\x6b\x3e\x82\x93\xf1\x5f\x94\x2a\x64\xee\xd1\x52\x96\xe1\x60\xf6\x80\x5b\xb5\x7c\x65\x20\x77\x54\xf5\xb3\xc1\xcf\xb7\x9e\x5d\x12\x71\xb4\xd3\x0c\x68\x08\xe7\x19\xc3\xab\x28\xb5\xed\xd6\x4d\xd4\x7d\xbe\x55\xb9\xdb\x5a\x36\x6c\xe0\x3d\x9c\xf0\xbe\x58\xbb\x96\x76\x61\xb3\x33\xf4\xca\xd7\xd0\xa5\xad\x4e\x63\xb4\x45\xd2\x3c\xc8\x78\x64\x66\xc5\x12\xa9\xec\xee\xf0\xe0\x45\x91\xdb\x2f\x3d\xef\xee\x93\x27\x9d\x71\xbc\xa0\xd3\xce\xda\x6e\x8f\x20\x38\x6c\xad\xa3\x71\xf9\xf0\x0d\xd0\xe6\x17\x89\x19\x44\xe1\x10\xdc\x45\x4f\xe7\x15\xc9\x66\xee\x41\xdc\xbd\xe0\xb1\x3f\xc8\x8e\x90\x5f\xee\xf4\xdb\x4a\x57\xd4\x6a\x0a\xb7\x71\x11\x03\xa7\x56\x27\xe0\xee\xf6\x68\x19\x61\x32\xc8\x28\x13\x3e\x4a\xe5\xfa\xb6\xb0\x5b\x52\x1a\x38\x6e\x30\xf6\x22\xfa\x09\x3b\xab\xd2\xb6\x7e\xc1\xca\xd2\xf2\xcf\xb6\x4d\x9e\xac\xda\x56\xca\xc9\x94\xf6\xd3\xb9\x1e\xff\x47\xb8\x55\x4a\x9c\x94\xd7\x04\xbf\xfd\xdd\x9d\x5d\xdf\xda\x36\xcd\x0d\x3d\xb9\x12\xb7\x35\xac\xe9\x56\x59\xe4\xca\xf3\x94\x87\xc8\x55\x9d\x0d\x91\x3a\x0c\x12\xe0\xe7\x64\xd4\x06\x82\x46\x62\x3d\x85\x5e\x5b\xe6\x22\xfc\x28\x73\x5f\x59\xe6\x8a\xfd\xcf\x07\x91\x39\xe7\x76\xe7\xfa\x32\xf7\x06\x7f\x81\xcc\xfd\x3f\xf6\xbe\xfd\xbb\x6d\xdc\xf8\xf7\xe7\xea\xaf\xc0\xee\xbd\x5d\x4b\xb6\x22\x5b\x4e\xe2\x64\xad\x55\xef\x71\xbc\x71\x93\x26\x69\x72\x93\x6c\xdb\x7b\x73\x72\x7c\x68\x11\x8a\x98\x48\xa4\x4a\x52\x7e\x34\xf1\xff\xfe\x3d\x03\x0c\x1e\xa4\x88\x07\x49\x39\x4e\xb6\x6b\x6f\x1b\x8b\x22\x06\x83\xc1\xcc\x07\x83\x01\x30\xf0\xd5\x39\xdb\x32\x67\x9b\xb5\xfe\x6f\x4b\xe7\x6e\x48\x7b\x2c\xa8\xd0\x48\x7d\xca\x4b\x84\x7e\x3a\xc3\x00\xea\x14\x25\x2b\x42\x63\x23\xc7\xeb\xd1\x39\xe4\x40\x3c\xaf\x7e\xed\xba\x95\xa7\x81\xbe\x1d\x1b\xa8\xc9\xf1\x2c\x88\x62\xd8\x54\x67\x50\xa6\x0a\x49\x80\xc8\x8f\x1f\xb1\x8c\xa6\xa7\x42\xd4\x70\x52\x42\xc9\xc5\x24\x0d\x8b\x9e\x17\x1d\x11\xa0\x68\xd3\xee\xea\xee\x51\x8c\x95\x9c\x10\xe1\x65\x54\x09\xc9\xc1\x17\xd0\x43\xaf\x22\x49\xeb\xb1\x53\xd0\x40\x90\xd6\xa3\x63\xbb\xbb\x63\x65\x05\xfe\xdb\x16\x4e\x33\x46\xf2\x21\x64\x89\x86\x1b\xe4\x78\xea\xd6\xc0\xa3\xc5\x90\x2a\x2d\x9a\x99\x87\x0a\x71\xe3\x34\x24\x11\xb3\x0f\x62\x1c\xed\xaa\xf7\x52\xe0\xc9\x0f\xa0\x87\x7f\x5e\xc0\xb5\x18\xac\x15\xbc\x01\x59\x1e\xa4\x6b\x1b\xaa\xfd\xb9\xb7\x22\x88\xb3\x38\x6e\xb7\xa7\x62\x29\x8a\xe9\x4f\x17\xc4\xcb\x71\x42\x1c\x69\x36\xe1\x85\x45\x0b\xb4\x05\xaa\xb5\xa3\xd2\x45\xda\x16\x08\x71\x6e\x07\xd7\x7f\x0b\x3b\x2c\x74\xf0\x19\x39\x8b\xa9\xc9\xa8\x0c\xe1\xcb\x47\xa3\x8e\xb5\xf8\xee\x2e\xf9\xd7\xcb\xd7\x2c\xae\x88\xf6\x62\x7d\xfd\x32\x49\x99\x38\xd4\x6a\x73\x9f\x14\xc4\xd1\x57\xcc\xd8\xa4\xae\x96\xd8\xb1\x56\x46\xb5\x24\x59\x37\xeb\xaf\xe9\x82\x82\xa6\xb2\x91\x1d\x4d\x0a\x77\xcb\xb0\xb0\x29\x4b\x3d\xc8\x1e\x5b\x29\x89\x99\x28\x3d\x67\x6c\x90\x31\x8e\x87\xd9\x3c\x9a\xd0\xae\x68\x18\x5a\xc0\x8e\x57\x0b\xd7\xb1\xde\x88\xf7\x1e\x50\x16\xd2\xc6\x50\xf6\x2b\xfd\x03\xca\xfe\x80\xb2\xff\x12\x28\xdb\x10\x1e\x00\xff\x80\x09\xb5\xd1\xc0\xc5\x1f\x1a\x23\x83\xdb\x7f\xbd\x7c\xed\x83\x8f\x21\xb5\xe0\xa3\x78\x77\x03\x50\xed\x62\xfd\xad\x92\xe8\x19\xa4\x26\x45\x00\x00\xd0\x8c\x92\x15\x7e\x55\x13\x65\xa5\x8c\x37\x86\xa3\x52\xb3\x45\xeb\xbb\xc6\x26\x5b\x94\x5d\x53\x74\xe3\x3b\xa0\x23\xcc\xdb\x17\x8e\xbf\x45\x84\x90\x83\x6c\x96\x24\x19\x85\xa4\xd0\xe0\xb5\xdb\x85\x05\x4b\x48\xf6\xb9\x89\x60\xe0\x0c\x05\x69\xad\x1d\x39\x78\x4d\x17\xc9\x39\x0b\x6a\x41\xc8\x0d\xee\x8b\xa5\xff\x5e\xd1\x18\xed\xc1\x6e\xd0\xda\xe4\x46\x9e\x7d\x1b\x75\x5a\x2d\x46\xe9\xec\x97\x34\xc3\xa6\x0d\x1d\x87\x23\xe3\x68\x4c\x55\x62\x76\xa9\x12\xce\xf4\xec\xf0\x1f\xd3\x27\x75\x24\x3a\x7a\x0f\x19\x81\x19\x89\xca\xbb\x9d\x1d\x7a\xdc\xe9\x58\xc2\x15\xc7\x8f\x8e\x47\xde\x29\x83\xab\x46\xed\x6d\xf2\x2a\x08\x43\x50\xb8\x46\x11\xea\x65\x00\x7b\x42\x8e\x07\xfc\xdf\x3a\xf1\xe9\x57\xcf\x8e\xdf\x90\xff\x75\x7f\xf7\x01\xdc\x97\xc5\x18\xc8\x70\xd1\xc9\xbf\xfe\x57\x9f\x26\xd9\x03\xa8\xff\x74\x19\x84\x03\xf1\xa9\xba\x73\x2a\xd9\x90\x12\xc0\x0d\x86\x2b\xd8\xde\xc3\x40\x4b\x26\x2b\x26\xa8\xcb\x70\x1a\x43\xf2\x3c\xe8\xd4\x19\xbe\x2b\x76\x37\xf1\x5d\x86\xca\x75\xc9\x13\x10\xc3\xc0\x55\x5e\x78\x2a\x52\x1f\x19\x05\xcc\x7d\xaf\xed\xe0\x62\xc4\xb3\x59\xb2\x9a\x87\xe4\x8c\x02\x69\x7e\x8d\xdc\x37\x1f\x32\x94\x91\x1e\xd9\xa1\xa0\x5a\x6a\xff\x60\x9f\x18\x33\x90\x57\xe8\x08\xde\xcb\xa6\x7b\x33\x20\x99\x0d\x43\xbc\x24\xc6\xb3\x14\xa3\xa9\xc3\x98\xe9\x93\xc1\x77\x15\xe7\xd2\x02\x58\x0e\x13\x6b\x5d\x31\x5a\xeb\x5a\x55\xfc\xc1\x1d\xa6\x4d\x2a\x63\xf2\x9f\x4b\x2f\x38\x98\x61\x31\x54\xc9\x8d\x35\xf3\x28\x98\x1f\xbe\x08\x4a\x0d\x51\xa2\x22\x6f\x2a\x99\xc6\xda\xf3\xe1\x41\xf5\x73\x96\x37\xa3\xf0\xb4\x0e\xbf\xbe\xac\x66\xde\x19\x39\x15\xfe\x17\x99\x22\x11\xd9\xf1\x48\x0e\xa9\xd7\x39\x58\xae\xb2\x59\x57\x7b\xd2\xab\x3b\x0e\x94\x5a\x42\xc6\xea\xe0\xb6\x88\x81\x6a\xe4\xb3\x7e\x91\xe7\x9e\x5d\x92\x47\x61\x28\x08\x1b\x5f\x63\x9a\x05\x1b\xd9\x82\x5c\xd4\xb4\xb9\x60\xf8\x6f\xf1\x52\x82\x30\x43\xb1\x59\x00\xc8\x45\x63\x81\x5d\xb7\x89\xcc\xab\xd8\x82\xcd\xdf\x32\x74\xae\xe2\x02\x78\xd6\x44\xce\x55\x5c\x81\x9d\x0e\xc0\x84\x0c\x7b\x6a\xa7\x03\xaa\x09\x4f\xb2\xc6\x2e\x5a\x24\xf3\x20\xcb\xd9\xe7\x5a\x30\xa7\x26\xb9\xef\xba\x45\x84\xbb\x03\x89\xd2\x21\x05\xcf\xfe\x7b\x4c\xb5\x63\xd7\x74\x74\x71\x91\x33\xe3\x9b\xa5\x4a\xc6\x45\x8e\x0c\x6a\xbf\xf6\x74\x33\xcb\xf5\xdf\xce\x56\x14\xc7\xc9\x7f\xe7\xb6\x81\xaa\x2d\x03\x9e\x3b\x51\x2c\xd8\x71\x5c\x75\x22\xae\xb6\xb9\x2a\xa1\xc1\x26\x86\x6b\x16\x69\x61\xd2\x62\x23\x28\xff\xc8\xe3\x04\x9a\x40\x8e\x1f\x1d\x7b\x10\x44\xd5\xb9\x96\xf6\xf0\x76\x26\x35\x50\x7a\xbd\xeb\xc4\x99\x0d\xd7\xb2\x58\x76\x26\x1f\xa5\xa9\x1d\x3a\x33\x5b\x2c\xb4\xea\x10\x62\x80\xfd\x4e\xc5\xb7\xfa\x28\x66\x63\xe7\xda\x74\xf4\xbf\xd9\x31\x27\x1e\x16\x32\xbe\x87\xed\xab\x77\xa2\x49\x38\x72\xe6\x69\x1f\xa8\x6a\xf1\xcc\xde\xc8\xfa\x2e\x9b\xe9\x82\x90\xa3\x73\xfb\x8b\xb8\x86\x09\xaf\xc2\x9f\x76\x3e\xb9\x04\x94\xca\x79\xdc\x28\xa2\x9d\xfa\x13\xd3\xe4\xe2\x3e\x6b\x9b\xd0\x75\x26\x99\x3b\xc5\x82\xc0\xf0\x09\x9d\x0a\xb9\xcc\xe5\x9c\xcb\xef\x6e\xdb\x99\x2a\xee\xe9\xee\x91\xed\xdd\x36\x7c\xc9\x98\xb5\x45\x9e\x28\xd3\x67\x94\x2e\x21\xf4\x3c\xa7\x30\xf6\x24\xb1\x30\xe9\x08\x8e\x37\x53\x3c\x50\xc7\x36\x7c\xac\x62\xd7\xb8\xa0\x22\x1d\x8b\x28\x7e\xc4\x4a\x62\x24\x72\xd8\xc4\xa1\x43\x52\xac\xf3\xf4\xb6\xf2\x80\x1c\x3c\xe0\xe9\x8d\x61\x21\x9a\xfc\xf4\x93\x6b\x75\xdb\xe4\x78\x9d\x86\xc9\xab\xb6\xd1\x5d\xc5\x6a\x31\xfe\x5c\x24\x50\x9b\xaf\x06\xdb\x0b\xbd\x26\x65\xd8\x91\x9a\x39\x0f\xf0\x91\xdd\x00\x05\x3f\x5f\xc3\xf0\x60\xab\x64\x10\x32\x7f\xd3\xfa\x1e\x32\x0e\x0d\xc0\xaa\xa1\x08\x26\xbe\x96\x43\xbb\x0d\x01\x4b\x1b\x33\xd9\xe6\x48\x9f\xa0\x5e\xa3\xad\x99\xa2\xf0\xd7\xc2\x89\xaf\xd6\x30\x17\x17\x6c\x12\xe3\xdf\x9d\x0c\x6d\xba\x55\x5c\xd8\x84\xd8\x71\xed\x60\xaa\x22\x58\xcf\x2c\xa5\x4a\x89\xf3\x3c\x1d\xaf\xe0\xba\xc9\xb3\x85\x2b\x0e\x61\x6b\x39\x78\x00\xc9\x54\x78\x98\x2c\xa2\x45\x73\x9a\x56\xb9\x6a\xdb\x1d\xbb\x47\xa5\xcd\xd6\xd4\x66\x74\xe6\xad\xa5\xc1\x05\xd6\x00\x8f\x06\xfe\x74\xb4\xf4\x0c\xe0\x8a\x69\xce\x70\x4d\x42\x6a\xc7\xd4\x2a\xe3\x61\x43\x95\x7c\x12\x10\xc9\x76\x44\xd2\x44\x33\x0b\xe6\x39\xa3\xca\xfe\x60\x74\xd9\xe2\x75\x00\xfb\xef\x49\x48\xd3\xe8\x9c\x91\x94\x20\xea\xa2\x2d\x36\x89\xa9\xc9\xb4\x76\x5e\x49\x3e\x1c\x74\x9a\x7a\xca\x2d\x9b\x6e\xf7\x98\x27\x33\xba\x68\x5d\x45\xf5\xd4\x08\x97\x79\xe1\x63\x32\xd5\x48\xbb\xa8\x9d\xb0\xfc\x20\xd7\xe0\x45\x2c\x82\x3c\xc7\xf3\x5f\x98\x29\x42\x3c\x2d\xbb\xfb\x93\x24\x3e\xa7\x69\x5e\x98\x78\x31\xb3\x90\x87\xd2\xd8\x49\x35\x7e\x2e\x7d\xd0\xf1\x99\x05\xa8\x64\x65\xaf\x38\x21\x31\xe3\x2a\x3d\x6c\xb5\x43\xdb\x9d\xa0\xa5\xd8\x8c\xfa\x73\xb1\x52\x16\x14\x9d\xf7\xd2\xa9\xb7\x20\xbe\x12\x3d\xb5\x4c\xb2\x2c\x82\x23\xd9\x3e\x08\x73\x53\x51\x18\xb5\xae\x2d\x65\x5d\x71\x8e\x8d\x7f\x87\xae\x6c\xf7\xb3\x8d\xa0\x5a\xe7\x05\x1c\x3a\xd4\xfe\x96\xf8\xd0\x77\x95\xff\x44\xaf\x0e\x8b\x59\x55\x5c\x25\x4a\x29\x57\x9c\xef\x03\x2e\x1d\xb2\xff\xf7\x2f\x23\x61\xe6\x90\xac\x1d\xe4\x73\x16\xc6\x99\x6b\x79\x63\xa8\xb3\x1c\x82\x88\x56\x94\x85\xcb\x9e\x1d\xbf\x79\xe0\x2c\xab\x8d\x8b\xf7\x9c\x2f\x4b\x24\xd0\xaa\xe2\xcf\x06\x2f\x97\x34\x7e\xf3\xe6\xb9\x8d\x42\xdb\x14\x37\xba\x0a\xda\xdc\x50\x80\x9e\xc1\x22\xba\x7c\x1a\x17\x8b\xd4\x73\x18\x2c\x58\x71\xcc\x31\x2e\x6b\x0c\x72\x5e\x48\xb1\x0e\xbf\xa5\xac\x49\x06\x04\x86\x80\x4b\xdd\x2a\xd1\xcd\xfa\x2c\xf2\x13\x00\xd0\xf3\xbf\xa3\x69\x54\x46\xc0\xda\xd4\xf3\x19\xe4\xc4\x25\x8f\xd3\x34\x49\xc9\xd3\x29\x89\xa9\xca\x50\xa5\x5a\x17\xb3\x3c\x4f\x6b\x03\x0c\xa4\x47\xcb\x88\x79\x7f\xd5\x4d\x01\x1e\x6f\x3e\x19\x17\x91\x6f\x87\x6c\x6d\x8d\x1a\x15\x1d\xe4\xc9\x1b\xf6\xbc\xdb\x6b\x4b\xc0\x60\x7b\x35\xcd\x4b\x90\xd3\x4d\x4c\xca\xdc\x66\x5f\xa8\x2c\xea\x65\xb8\x5f\x0c\x4c\x01\xf9\x81\xe2\x03\xa1\x3d\x57\x22\x92\x25\x4a\xdb\x83\x08\x75\x9c\x70\x6e\x20\xcd\xd6\xf8\x39\xa7\x30\x90\x21\xd3\x35\x57\xfa\x51\xe4\x55\x36\xe8\xcf\x06\x12\x39\x91\x72\x1c\x4b\xce\x44\x9f\x36\x59\xfa\x97\xf0\x14\x14\xed\x56\xc7\xa6\x58\x54\x7e\x07\xd2\x43\x07\x39\xf3\x31\xda\xe1\x95\xee\x05\x94\xfc\x1b\xcd\x0f\x2f\xb0\xb2\x11\x9c\xda\x5c\x43\xbe\xf6\xea\x16\xe8\x40\xc2\xb9\x7f\x23\x2c\xde\x60\xdb\x9a\x3d\xe9\x92\xad\x69\xf2\x92\x48\x93\x61\xd5\x3f\xe2\x2c\xbd\xb9\x32\x82\xa9\x6f\x46\x56\x0a\xe0\x73\x95\xcb\xc2\xb3\x51\xc7\x58\x0c\x12\xa8\x30\x89\x19\xdf\x80\x00\x0d\x10\xb1\xb5\x51\x30\x20\x97\x17\xab\x96\xa4\xdf\xed\x5d\xde\xbf\x7b\x30\x3c\x98\x3c\x60\x97\x8c\x1f\xdc\x3f\xb8\x77\x7f\x7a\x7f\xfa\xbe\x27\x56\x92\x59\x2d\xe2\x83\x6a\xb3\x3b\x8c\x54\x8f\x31\x1f\x69\xba\x43\x2b\x92\xa2\x1a\x5f\x60\x22\x75\x70\xef\x06\xfc\x25\x1b\xec\xe8\x19\x4c\x5a\xa2\x04\x02\x92\x00\x09\x65\x62\x1b\xc6\x0b\x81\x46\x45\xe4\xdb\x18\xd8\xdd\x06\x1c\x99\xa6\x79\x25\x34\x5a\x06\x69\x46\xbb\x05\xec\xaa\x09\x45\x8c\x82\x0e\x43\x8a\x98\x03\x84\x5e\x41\x49\x76\xf0\xea\xe0\x9e\x3f\x0e\x71\x9d\x5e\x63\xbc\xca\xdd\xa8\x00\x3c\xcf\x7a\xc4\x56\x1c\xf5\x44\xdc\x0c\x6f\xab\xe4\x2d\xcd\x98\xb7\xcb\xa6\x9a\xc6\x17\x01\xbe\x4a\x35\xbd\xdb\x7b\x0f\x61\x7a\x05\x47\xb0\x9c\x52\x7e\x67\x88\xef\x08\x98\x72\xc1\xdf\xee\x2e\x79\x7c\xc9\x97\xed\xad\xfc\x94\xb0\x7a\x0d\x25\x4b\x7c\xe0\x36\xf2\x7d\xd8\xec\x66\x13\x7a\x71\x4b\x03\x23\xce\xf6\x58\x28\x72\xd6\xa2\x6b\xb5\x2e\x59\xb5\x7b\x96\x3d\x76\x6b\x25\x0b\x7b\x24\x86\x07\x6d\xc0\x55\x47\x06\x21\x98\xcf\x86\xd0\x47\x5f\x0b\x38\x90\x6b\x7f\x8f\xb9\x42\x98\x95\x58\xbc\xad\xf2\x4d\x5f\xa4\x90\x0e\x0c\xa6\x5b\x41\x8e\xac\x8a\x79\x2c\x88\x8e\x04\x10\x03\xcb\xb4\x5c\x39\xbe\x80\x56\x61\xec\xa0\x23\xeb\x69\x77\x64\x0c\xaf\xf2\xab\x56\x91\xbc\x4d\x6f\x9d\x90\xee\xb9\x98\x8a\xdb\x26\xdf\x22\xfc\xa9\xcb\x0b\xc5\xc5\x4e\xf1\xc0\x09\x08\xa6\xcf\x32\x3a\xa0\xf6\x46\x20\xb8\xd6\x82\xd1\xb5\x1b\x0b\xcc\xc6\xcd\x99\x3f\x5c\x9b\x75\xd4\xdb\xfe\x60\x11\x3c\x2e\xab\x83\xee\x60\x96\xa0\x76\x53\x09\x31\x89\xa8\x0c\xe3\x3b\x22\x1d\x92\x94\xc4\x25\x99\x7b\x11\x79\x63\x54\xc5\xdf\x2a\xeb\xa2\x3f\xc1\xc2\xf2\xca\xc0\x3b\xee\x7b\xdb\x69\xe7\x85\x9b\x72\xe4\x6b\xd3\xdf\xaa\x93\x02\x48\xb5\xee\xa8\x54\x63\x8a\x4c\x63\xb5\x1e\x94\x2d\x64\xb4\xea\x8d\x6e\xaf\x6e\x95\x15\x9c\x5c\x7f\x1b\x7c\xf4\x25\x66\x18\xfc\x40\x0b\xa3\xd5\x70\x85\x2c\xac\x4f\x3f\xfb\xa4\x56\x0a\xb3\xda\xe9\xcb\x8b\xbb\xa0\xfc\x33\x97\x23\xa6\x19\x5f\xd1\x32\x89\x26\x2a\x5d\x02\x0e\xf5\x58\x98\x5f\x84\xe0\xca\x28\x56\xec\x54\x32\x56\x64\x55\x6e\x53\x94\xd1\xe6\x9c\xd6\xe3\xe9\x87\x42\x4d\x93\xe9\x07\x3b\x6d\xdc\x9e\x0e\xc3\x18\xc2\x8a\xd9\x4d\x30\xd2\xb1\x39\x47\x9e\x3e\x5a\xc1\x71\xb2\x96\x11\xeb\x44\xf6\xb7\x40\xe1\xa5\x48\x06\xd1\xb9\xfd\x6d\x6d\x95\x07\xd5\xd7\xfa\x3a\x2c\xe4\xe8\xe4\xe1\xb3\xbd\x02\xb9\xa2\xa3\x0a\xe1\x23\x7b\x39\x6d\x35\x07\x35\x51\x3e\xb1\x17\x44\x77\x86\xa6\x87\xa0\xa6\x68\xe6\xc6\x12\xd7\x9b\x8b\x0d\xe0\x9e\xb7\x4c\xba\x9b\x34\xb4\x6f\x51\xb0\x0f\x2c\x9b\x77\x20\x74\x2d\x95\x3e\x84\xe2\x50\xa3\xcd\x3e\xe6\x89\x2b\x69\xf3\xef\xc0\x93\xd0\x38\x07\xae\xe5\xdd\x9d\xdf\x85\xf7\xa0\x6e\x1a\x75\x0f\x99\x21\x35\x0e\x99\xc2\x62\xc2\xe3\x72\xe6\xc8\x4d\x0c\x9f\x9b\xe6\x56\x69\x28\xd7\xe4\x1b\x1f\xe9\x43\x6a\x1c\xe9\x27\x65\x81\x7d\x2b\x83\x3d\xc6\x29\x71\x66\x06\x76\xa3\x1b\xbf\xb9\x3a\xd9\x1e\x51\xeb\x29\x0f\x33\xe9\x0d\x55\xa8\xea\x60\x02\x01\xd1\xf8\x4a\x59\x29\x0a\x1e\xc7\xaf\x74\xcd\xe3\x50\xde\x83\x62\x47\x0b\xc4\xdb\xb8\x41\x73\x97\xb5\x6d\x0c\xf4\x65\x40\xb8\x12\xf4\xcb\x82\x37\x8c\x5c\xdb\x3c\x66\x1e\x64\xd9\x6a\x41\xc3\x42\x09\x12\xcc\x53\x1a\x84\x57\x9a\x9f\x52\x88\x6f\xac\xe2\xc9\x2c\x88\x3f\x34\xbf\x60\xa3\xde\xa0\x30\x70\x91\xab\x15\x62\x00\x2c\x67\x47\x6b\xd3\x8c\x6e\x68\xd4\x14\xb8\xae\x37\x8b\x07\xb2\x57\xf1\x66\x6a\xf8\x4e\xa6\x89\x6b\x86\xcb\xd7\xe5\x5e\xa2\x4c\x04\x4c\xd6\xc4\xc2\xd3\xb5\x60\xb7\xaa\x40\x92\xb4\x00\xa0\x96\x5b\x5b\x15\xac\x93\x62\x1b\xfb\x17\x21\x7d\x1d\x9c\x2c\x4b\xf4\xde\xab\x53\x58\x87\x22\x6b\xa1\xe7\x1b\xda\xac\x02\xa7\x4a\x58\xd9\x26\xcf\xaa\xf7\x8c\x36\xdb\x1d\xf0\x29\x9c\x82\xb2\x0c\xf8\xbf\x4d\xf6\x05\xd4\xd8\xc2\x5a\xcd\x04\x12\x7a\xc6\x38\x60\x1c\xb5\xd9\x12\xf0\x2b\x48\x86\x5d\x3d\x07\x8c\x01\x2a\x42\x3e\x0c\x1e\x87\x5c\x62\xd2\xf5\x41\x4d\x33\x13\xe0\x25\xe0\x4f\xd0\xc1\x7d\xad\xf8\x81\xf9\xc3\x50\x39\xab\x6e\xe0\x22\xb6\x7e\x25\x30\x65\x57\xf5\xc2\xa6\x58\xa0\x97\x89\x8d\x91\xb8\xa5\xf9\x03\x8d\x21\x2c\x46\xbd\x09\x47\xe7\x76\xba\x4f\xff\x51\x8b\xac\xf4\x84\xe5\x28\x00\x41\x7c\xdd\x2f\x3f\x22\x07\xf7\x58\x6e\x6c\xf6\x05\x4e\x3a\x60\x17\x54\xb2\x88\xf2\x9c\x86\x7d\x08\xb5\xc3\x57\x17\xd1\x7c\x0e\x89\x0d\x44\xdd\x21\x49\x83\x38\x4c\x16\x73\xe3\x94\xc0\xc8\x5c\x35\x9e\x57\x47\xfc\x78\x86\x20\x14\x69\x9f\x3c\xfd\x47\x9f\x8d\x9a\xc0\xed\x77\x81\xf2\xcc\xca\x68\xb8\x0e\xf1\x9a\xc9\x0c\xe8\x25\x9d\xac\x72\xda\xdd\x12\x37\x0c\x6c\xf5\xc9\xfe\xfd\x03\xb8\xfb\x03\x8f\x03\xf4\x46\x5f\xa9\xa2\x3e\xd9\x02\xd9\xc2\xff\xcc\xb7\xd4\xac\x63\x02\xfc\x22\x71\x7d\x20\x11\x76\xd6\x17\x16\xd3\x47\x0d\xe7\xab\x49\x0e\xb7\xfa\xaf\xa8\x6a\xa8\x69\xee\x05\xc8\x1f\x7c\x36\x50\xac\xad\x08\x72\xea\xdd\x83\x7b\xbb\x0f\x7b\x8d\x56\xd3\x98\x5b\x0c\xd2\xd7\xe0\xcb\xf8\x32\xf4\x15\xbc\x36\xc6\xfb\xce\x65\x68\x49\x88\xe8\x50\xfc\x41\x76\x04\x1c\x5c\xc3\xde\x8d\xc5\x12\x54\x44\x49\x14\xda\x61\x73\x8f\x21\xd4\x46\x01\x07\x72\x6f\xbe\xa2\xf3\x82\x60\x90\x33\x98\xf5\xeb\xb9\xb6\x90\xbd\x9e\xe8\x4b\x48\xb9\x61\x11\x1c\x14\x97\x4b\x96\x63\xd9\x38\x67\x9e\x8e\xd7\xe8\xde\x37\x0f\xd6\xa9\xe0\x9a\x9c\x47\xde\xfc\x1a\x66\x55\xc0\xb1\xb0\xa0\x19\xe2\x60\x27\x86\x89\xd2\x38\x57\x31\x9d\xd8\x36\x4d\x14\x36\xbd\x10\x5a\x71\xc1\x89\x5c\x09\xad\xfe\xae\xc2\x49\xc5\xf9\x6c\x6d\x27\x60\xd3\x0b\xa3\xcf\x7e\x3d\xb9\x26\xe0\x22\x61\xe4\xaa\xd2\xff\x52\x13\x16\x31\xae\xd9\x9d\x90\x0d\x2c\x87\x56\x88\x4c\x0b\x03\x98\xb1\xeb\x53\x38\x95\xb5\x3e\x0b\xa7\x37\xb8\x2e\x8a\x99\x3f\xb4\x66\x1b\xca\xba\xdc\x8f\x6f\x79\xa1\x54\x90\xa9\xf4\x0b\xbf\x87\x28\xa7\x8f\xf7\xf4\x5d\xb8\x48\x0a\xd0\xd6\x9d\x17\x03\xf2\x78\x2d\x16\x6e\x89\xde\x34\x3b\x32\x5f\x91\x07\x08\xbc\x6e\x3a\x8a\x89\x1c\xac\x4f\xdd\x35\x0e\x04\x03\xdf\x4e\x1c\xb3\xe4\x2b\x25\xb9\x52\x5b\x63\xb1\x2a\x0f\x17\x50\x13\xbc\x68\xe1\xd4\x6a\x2d\x65\xbd\x39\x40\x57\x43\x7e\xe6\xee\x8a\x83\x3b\xc8\xcb\xc4\x67\x59\x5c\x0e\xc6\x77\xa1\x7a\xe6\x30\x15\xf8\x1a\xb8\x52\x4e\x22\xdc\x1a\x5f\x29\x6a\xa3\x61\x88\x45\x8c\xd3\x72\x88\xae\xf5\x7b\x91\x2b\x19\x6f\xb5\x33\xf7\x22\xba\x84\xa9\x2c\x96\x75\x75\x8a\x62\x13\xcf\x33\x15\xea\xb4\xc9\x19\x31\x4c\x11\xd8\x58\xec\xd6\xbe\x60\xf7\x2d\x8e\x6c\x3a\x8e\xcb\xc1\x4d\x63\xb9\xf9\x0a\xde\x77\x3f\xc4\xc9\x41\x9f\x47\x7b\x65\xa4\xff\xbb\x18\xd6\x24\xb7\x3e\x63\x49\x48\x8d\x63\x49\xe5\x4a\xde\xa6\xc6\x95\x8d\x73\xab\x34\x55\xc4\xa4\x6f\x76\x08\x0c\xa9\x71\x08\x54\xac\x7c\x8b\xa3\xe0\x37\xb2\x9a\x77\xab\x43\xb1\xf8\x08\x5c\x0f\x3c\xe2\x19\x37\x3d\x36\xe3\xe0\x61\x7c\xa5\x6c\x28\x15\x43\x33\xea\x63\xd5\xd0\xac\xf7\x50\xfd\xd1\x19\x31\x51\x56\xef\x1d\xbc\x28\x19\x14\x66\x2a\x96\xcf\xae\x7b\xf8\xc2\x75\xff\xf3\x8f\x83\xdd\x49\x92\xd2\x1f\x0f\xf7\x7f\xbe\x7e\xdf\xdf\xff\xf9\xf0\x9d\xb0\xaa\x6e\x4a\xff\xbd\x8a\x52\xda\x5f\x24\xe1\x6a\x4e\xfb\xf4\x72\x99\xa4\x79\xd6\x53\x86\x34\xd2\xae\xac\x49\x93\x24\xef\x93\x69\x30\xc9\x93\x74\xed\x6a\x56\x6d\xb9\x08\xa9\x90\xf1\x78\x4c\x7e\xe4\x33\x96\x1f\xab\x8c\x13\x32\x75\x27\x8b\x45\x12\xff\xed\xcd\xda\x77\x9c\xa1\x81\x24\x45\xd4\x5f\xc8\x40\xf9\x44\x6b\x51\x46\x6c\xed\x48\xe3\x89\x67\xb8\xe4\x2c\x89\x16\xfd\x08\x27\x17\xf8\x17\x83\x60\x11\x1a\x58\x3c\x7a\xf1\xeb\xda\x63\x5e\xa8\xfb\xee\xbd\x12\x87\x93\x99\x4a\xe2\x7f\x9d\x27\x67\xc1\x9c\x74\xcf\xe0\xac\x32\x4d\xd7\x73\x01\x82\xcc\x07\x02\x55\x7d\x1a\x7f\x8d\xea\xa9\xba\x0d\xda\xd5\xb1\xfa\x59\xdb\x12\xb7\x09\x28\x0a\x81\xa8\x64\x12\xd3\x78\xed\x82\x86\x12\x5a\x83\xe1\x68\xbc\xc9\x3f\xbf\x7c\xd1\x6f\x3a\x7a\x11\xe4\xb3\xbe\x4a\x65\x5e\x29\xe6\xed\xce\x9f\x90\x93\xe7\xc9\x24\x98\x93\x65\x32\xbf\x9a\x46\x73\x58\x1f\xe1\x0e\x0b\x46\xff\x3a\x7f\xaa\xe2\x43\xf0\x82\x77\x43\x8f\x8b\x65\x4a\xec\x54\xd5\x5f\xc8\x66\x7f\x02\xc9\xf5\xaa\x82\x83\x9a\xbd\x2a\x72\xc9\xd9\x47\x13\x45\xc1\x56\xb6\x3a\x03\x2d\xb4\xa0\xc0\xc9\x60\x99\x26\x79\x02\x6f\x91\x31\xec\xdc\xb7\xbc\x8b\xd4\xc8\x98\xc4\xf4\x82\x9c\x74\x7b\xde\x74\xe3\xd5\x7c\xee\x86\x22\xc9\xad\x78\xee\xb8\x40\x16\x90\xa7\xd3\xf1\xf2\xe5\x35\x3d\x6b\xb2\x42\x5a\x73\x55\xf4\x79\x74\x96\x06\xe9\x55\xb3\xc5\xd8\x79\x74\x06\x1a\x0d\x51\x82\x9a\xd5\x82\x47\x85\x71\x1a\x16\x17\x12\x3d\x10\xcc\x49\x14\xcf\x68\x1a\x99\x6e\x8d\x33\xf0\xc2\xe8\x89\x70\x2d\x7e\x28\xa9\x73\xa7\x63\xe9\x4f\xb3\x6e\x56\x36\x40\xfc\x6e\xe3\xae\x5b\x4c\x4c\x23\x5a\xc4\x62\xdd\xd8\x0e\x4c\x44\x0b\x78\x83\x5f\x0f\x3a\x26\x72\x26\xc7\xba\x7a\x72\x92\x9c\xd3\x34\x8d\x42\x9a\x41\x3e\x32\x48\x33\x14\xc1\x0d\x18\xe0\x1f\x2c\xaf\xe0\x7e\x79\xc8\x2a\x45\x35\xb6\x1a\xd7\x2b\x64\x24\x2a\x7e\xbb\x19\xb2\xb6\x89\x89\xbb\xb4\x75\x82\xe2\x2c\x2e\xf4\xe6\xc5\xd5\x5b\x0e\x13\xc2\xe6\x06\x42\x81\x84\x37\xfb\xd9\x45\x06\xfe\x9b\x46\x74\x1e\x1e\x92\xad\xf3\x60\xbe\xa2\x5b\xfd\x16\x4c\xc1\xef\x82\xe6\xb3\xa4\x90\x0a\xb9\x47\xbc\xd8\x28\x0e\xa8\x75\xf2\xbc\x18\x4c\x4b\xfc\x72\x59\xe8\x1c\x49\xe5\xb3\xb2\x86\x4b\x71\xcb\xe0\x22\xb6\xbe\xa4\x0d\x00\xb0\x53\x9f\x8d\x50\xce\x3c\xab\xc2\xf5\x58\x7d\x58\xd0\xd8\xbc\x38\x2a\xfc\x2e\x6f\x8e\xb5\xf1\x03\x63\x3b\xaa\xa8\x45\x7c\xf6\x75\xd2\xe2\x26\x7d\x9c\x59\x91\x48\xa6\xb6\x4a\x9d\xfc\xff\x20\x78\x9a\x05\xd9\xcb\x8b\x18\x6d\xfe\xaa\xbb\x05\x44\xb6\x7a\x32\xd3\x07\x7c\x64\x2e\x9c\x78\x1f\x1e\xd4\x69\x33\xbc\x4f\xc6\x5a\x5f\xfb\x14\xd6\x09\xfc\xef\x6c\xb5\x84\x79\x4e\x1c\xe5\x83\x00\xce\x82\xa0\xb7\x15\xa4\x1f\x56\xd0\x55\x2e\x31\x1a\xc6\xd0\x7a\x92\x56\x59\xc3\xd2\xad\x8c\xa8\x11\x3e\xe2\xab\x8f\xc8\x2b\x62\x58\xc7\xa7\x59\x20\x97\x82\xab\x80\x5f\xb8\x55\xf4\x35\x9d\xd2\x94\xc6\x13\xa8\x16\x80\xfa\x6a\x49\xbd\x6a\xe4\x82\xc4\xc9\xae\xa3\x1a\x04\x69\xc9\x93\x78\xee\x1b\x57\xf4\x18\xeb\x1e\x33\x14\xc0\xdc\x4a\x38\xd4\xb1\xd5\xd9\x15\x44\xc0\x66\xb0\x63\x27\xca\x11\xbd\x6c\xa3\x02\x39\x12\x8a\xc0\x86\x2b\x66\x12\xdd\x9e\xdc\x65\x03\x13\x68\x76\x7d\x08\xa3\xd7\xed\xfd\x31\x6e\xad\x8f\x5b\xe2\x5e\x5d\x32\xc6\x21\x0c\xdd\xf8\xf2\x94\xc7\x17\xe0\x79\x69\xef\x21\x67\x9d\x0b\xd0\x09\x31\x64\x3a\xcc\x5b\x94\xd2\x01\x42\x3c\x2b\x82\x84\x8f\xc2\x8b\x92\x37\xa2\xf1\xb6\xd4\x83\x6e\x2d\x22\x2f\x71\xdc\xc0\x8b\xbe\x99\x61\x80\x5e\x07\x61\x48\xb2\x64\x41\xc9\x3c\xf9\x10\x4d\xc8\xc5\x8c\xc6\xe4\x2a\x59\xa5\x48\x33\x23\x01\xcc\x2f\x59\x97\x84\x83\xdb\x55\xb4\x0d\x39\x48\xe5\x84\x71\x3d\xe2\x55\x0c\xf1\x73\x30\xb0\x8a\xf9\xc6\xdd\x9f\x3a\xdc\xb7\xd0\xb6\xe3\x64\x09\x2e\x3c\x6e\xe3\x80\x3f\xd1\x8b\xdf\xf8\xd4\x41\xab\x02\x9c\x79\xed\x63\x9e\xc0\x8d\x70\x24\x8a\x1b\x57\xd5\x5e\xef\x10\xd1\xb8\xef\xd5\xc0\xff\xbe\x29\x35\x60\x0c\xe9\x7a\xa0\xe4\x66\xd5\x67\xfd\x96\x1f\x2c\x72\xf5\xf7\x60\x01\xe3\x25\xf1\x27\x21\xfc\x40\x55\xa2\xec\x09\xe2\x37\x8c\x76\xcf\x87\x9c\x48\xc9\xf8\x4e\x2f\xf9\x9e\x8c\x35\xae\x8a\x5f\x8d\x3a\x2e\x72\xd7\x6d\xdd\xb6\xc7\xe4\x22\x89\xb7\x72\x3e\x8b\x15\x79\x8f\x70\xed\x12\x1c\xb7\x79\x92\x2c\x49\x70\x96\x9c\xd3\x4e\x73\x49\x6d\x09\xc2\x5b\x5e\x72\x02\x19\xc9\x1c\x4c\x05\xf1\xc8\xa7\xa3\x4e\x33\xa9\xb4\x81\x0b\x19\x7a\x60\xb2\x4a\xa6\x1b\x41\x8a\x2a\xa7\x69\x32\x4f\x62\x3a\xb0\x14\xbb\x61\x40\x00\xc3\x61\x3c\x90\xb1\x1c\xef\x07\xec\x41\x63\x7f\x07\x0a\xfb\x42\xba\xe6\x6d\xc8\x19\x96\x9a\x08\x88\x71\xd0\x75\x52\xa3\xe3\x39\xcd\xa9\x77\x9d\xe2\x51\x4c\x02\xd8\x1a\x56\xbe\x5e\x67\xd0\xf1\x11\x73\x61\xd3\x20\xae\x36\xab\x5b\x8e\xbd\x09\xeb\x54\xc4\x6e\x7e\xb9\xed\xb5\x78\x05\x50\x16\x7d\x88\xa3\x69\x34\x09\xe0\x9a\x4f\xf6\x3d\xbb\x17\x23\xca\x18\xdb\xbc\x2d\x83\x8e\x4f\x57\x82\x52\xc8\x45\x72\xb9\x55\x53\x7f\xe2\xf6\x52\x1a\x66\x9d\xb6\xb2\x6a\xd1\x68\x35\x10\x17\x64\xad\xed\x30\xa8\xd1\x9d\xd5\x87\x28\xa4\xd8\x4b\xdb\x16\x9c\x3d\x40\xed\x15\x75\x1a\x59\xf7\x76\xc7\x61\xd5\x7a\xd2\xbc\x82\x87\x29\xfb\xd1\x35\xb1\x69\x42\xeb\xdd\xde\xe5\xde\xde\xde\x70\x6f\x7f\xef\x2e\xa4\x09\xdc\xbb\xb7\x77\x7f\xef\x60\xef\xc1\xfb\xaf\x51\x47\x9f\x1c\x18\xab\xd9\xed\xf8\x78\x9f\x78\x05\x8a\xe8\x69\x1b\x72\x5d\x60\xd6\x33\xc0\xa6\x81\xf8\xc0\xff\xfd\xf2\x85\x5d\x4b\x68\x2c\x0a\x03\xa8\x54\xa6\x1f\xb4\x5b\x76\x6d\xf5\xc9\xc1\x52\x96\x1c\x4b\x8d\x6c\x79\x88\xad\x4c\x96\xb5\x62\x30\xa7\xf1\x87\x7c\x06\xbb\xf0\x2d\xe4\x3b\x75\x06\x5e\x9f\xb3\xb1\x25\xbc\xda\x44\x4a\xe9\xc7\xf1\x24\x09\xc1\x80\x29\xff\xa3\xbc\xed\x88\x3d\xb6\x5f\xe0\x25\xf5\x91\xc6\x93\xc1\x13\x7a\x59\x97\x13\x31\xf2\x9b\x32\x4d\xb7\x40\xbd\x76\x20\x81\xdb\x44\xc6\x9a\xf5\xd5\x4b\xf6\x2c\xcb\x49\x77\xad\xdb\x6b\x55\xba\x20\xea\xdf\xf2\xa9\xf1\xf0\x8c\xc1\x05\x11\x84\x74\xb3\xc6\x8e\xb7\x99\x17\xf6\x90\x78\x15\x42\xbe\x4f\xe8\xa5\x6f\x46\xe7\x46\x0a\x3f\x09\x72\x1a\xa3\x9b\x59\xd4\x78\xf7\xa0\xed\xa5\xf8\x12\x38\xaf\x95\xa0\xd9\x88\x55\xac\x0d\x0e\x91\xc4\x61\xed\x4a\x84\x4e\x6b\xb5\xbc\x6d\xc9\x77\x73\x5d\x96\xed\x1b\x8a\x0c\xb4\xf2\xc9\x7e\x4d\x05\xe2\xe5\xcb\xa3\x02\x23\x65\x53\x20\x2d\x05\x91\x7d\x93\x13\x74\xee\x3f\xd7\x06\x8f\x91\xa3\x4c\x90\x8b\x32\x92\x1b\xbf\x82\x51\xf6\x46\x21\x7b\x01\xe9\xdd\x55\x6a\x25\x55\xad\xaa\xb8\xb1\x3c\xac\xc6\xcc\x83\xc5\x92\xd0\x4b\xb8\xbb\x88\x5d\x33\x69\x7c\x99\xf1\x34\x81\xd7\xad\x5b\x09\x80\x26\xeb\x19\xe7\x7d\x5e\x92\xeb\x3f\xbb\x6f\xf6\x65\x54\x61\x86\x07\x77\xc9\x5d\xc1\x31\x9d\x9c\x04\x24\x8f\x16\xd4\x5a\xac\xea\x66\x61\x5d\x60\x5e\x97\xcb\xeb\x92\x86\x0e\x81\x75\x75\xd9\xd1\xef\x22\x71\x17\x2a\xfc\xd3\xdd\xbf\x47\xee\x90\x6e\xc4\x9b\xb4\x4d\x1e\xf6\x7a\xf2\x8e\x54\x41\xca\xf4\x23\xf5\xed\x5d\x51\x3a\x3b\x24\x92\xf7\xad\x7e\x19\x2b\x2e\x7e\xf9\x45\x54\x57\xf1\xbe\xac\xbf\xf1\xa4\xdc\xc7\x21\xd1\x7b\x05\x14\x6f\x73\xbd\xe2\x75\xdd\xb3\xaf\xd4\xc6\x64\xbd\xbb\x1a\xcb\xa5\xe3\xe7\x9d\xed\x8c\x8b\x4d\xb2\x1b\xcc\x2c\x88\x62\xd8\x45\xe8\x1a\xf6\xa0\xb5\xa3\x4d\xf9\x72\xfc\x62\x5e\x98\xfb\x14\xe6\x43\xd1\xda\x6e\x2e\x27\xb2\x6f\x62\x40\x50\xd0\x22\x5e\xf0\x1a\x04\xa0\x90\x3e\x06\x6c\x04\xfa\xd7\xe7\x0c\x23\xeb\xfb\x99\x11\xb9\x9d\xd0\x6b\x7c\x81\xf1\xf0\x4e\x52\x46\x55\xfe\x09\x92\x25\x4f\xf1\x87\x41\xc0\xdd\x7d\x80\x00\xf9\x9e\x87\xe1\x17\xa6\x0d\x63\x02\xdb\xee\x06\x13\x1a\xcd\x15\x91\x5d\xe3\x89\xde\x26\x53\x86\xea\x18\x5d\x0b\xf7\xa3\xd2\xa3\xb1\x87\xe8\x6e\x42\x75\xf5\x90\x9c\xae\xc6\xb6\x98\x9c\x51\x8d\x6b\xc4\xe2\xf4\x7a\x59\x7c\x87\x7d\x28\x5c\xcc\x2b\x5e\x2d\xff\xf0\x37\xd7\xd5\x1b\x8f\x76\xef\xd9\x06\x76\x94\x3a\x23\x71\x03\xca\xa1\xf4\x81\x4c\xa3\xf9\x5c\xdc\xb7\x87\x67\xff\x59\x94\xa6\xa9\x7f\x2d\x02\x43\x71\x55\x34\x4e\xaf\xc0\x27\xb9\x46\x5d\xad\x44\xfa\x2d\xd4\xdd\xb6\xd8\x7e\x53\x7a\xed\x11\xe4\xc1\xbc\x09\xc3\xba\xb1\x1c\x5e\x4e\x57\xf6\xd8\x19\xc4\x11\x2c\x81\xd6\x5a\x43\x36\xf0\x62\x5a\xdc\xf8\xb8\x38\xbd\xb0\x91\x16\xa5\x16\xa7\x17\x70\xdf\xf1\xe9\xc5\xc8\xe3\xd5\xff\x80\x3b\x79\x79\x37\x08\xe9\xc1\xc3\xb3\xa1\x47\x89\x20\xfb\x44\x74\xe8\xb6\x34\x41\xb3\x35\xd5\x08\x57\x0b\xe0\x97\xb3\xd5\xdd\xbb\xfc\x79\xef\xe0\x67\xb2\x4d\xba\xf0\x00\xbc\xcf\x93\x93\x93\x93\x1e\xd9\xe1\x0f\xfe\xf2\x17\xb2\x77\x39\xdc\x63\x8e\xe9\x22\xc8\x3e\xd9\x99\x87\x5f\x2e\x99\xee\xde\xe5\xbd\x83\xfb\x7b\x9c\xee\x45\x99\xee\x45\x03\xba\x20\xca\x94\x66\xb0\x09\x6c\x4c\xba\x8c\xb9\x5f\x7e\xe1\xcc\x91\x1d\xe8\x09\x7f\x52\x48\x66\x17\x44\x3c\xdc\xc3\x1f\xef\x62\x3b\x63\xb2\x37\xb8\xef\xf3\x3a\xeb\x15\x2c\xb5\xcd\x77\xac\x0b\x3b\xe8\x91\xbf\x90\xc1\x7d\xf2\x7f\xc8\x90\x1c\x92\x3b\xc3\x5e\x63\x07\xd3\x86\xc1\x45\xcf\xb9\x4f\xd2\x49\x30\x99\x51\x3e\xaf\x89\x6b\xf9\xce\x20\xfc\x53\xb0\x94\xb4\xdb\xe5\x54\x20\x94\x52\x68\x50\x8f\x6c\xeb\xe2\xb4\xf1\x05\xbf\x48\x65\x4c\x4e\xd3\x2e\x2f\xca\xec\xe3\xc1\xd9\x03\xbb\x28\x98\x5d\x0f\x96\xab\x6c\xd6\xed\xca\xa2\xaa\x56\xf2\x85\xec\x59\x84\x69\x59\x4e\xc5\xee\x82\x6d\xba\x0a\xb5\x20\x92\x2c\xc2\xc7\x88\x3b\xa3\x8e\x5f\x0f\xd5\x49\xf8\x85\xb1\xcc\x66\x7b\xca\x69\x3c\x81\xc5\x1c\x88\xac\xd5\xdc\x53\xfe\x84\x5e\xae\x87\x49\xfd\xab\x86\xe2\xb0\x8a\x84\xd1\xd3\x96\xd7\x7b\x69\x23\x3a\x8b\x0e\xcf\xe8\x25\x46\x14\x07\x35\x47\xa5\x1a\x81\xb2\xda\xa4\xab\x42\xbe\x2d\x38\xbd\x8d\xc1\x7a\x46\x2f\xe5\xba\x7c\x21\x2c\xfb\x84\x5e\x6a\x81\x51\x29\x32\x93\x39\xd5\xb9\x97\x4b\x11\xdb\xe4\x84\xab\x56\xcc\x4c\x4e\x57\xf4\x72\xe2\xe1\xa8\x63\x2c\xab\x4e\x5f\x5a\xc9\xcf\xe8\xe5\xf1\x2c\x48\x85\xcb\x21\xbe\xb2\x43\x32\xc0\xef\x2f\x24\xab\x13\x5e\x82\xf6\x9f\x45\x3c\xac\x74\xb1\xb9\x90\x92\x60\x1f\x91\x95\xd5\x00\x64\xef\xf5\x54\x0c\x7f\x78\x20\x4e\xff\xd5\xa1\x02\x43\xff\xde\xd4\x9b\x8c\x1b\xa1\x65\x25\x1f\x93\x28\xee\x6e\x6d\xf5\x36\x37\xbd\x50\x68\xa4\x6c\x1a\xbc\xfc\xa0\x0d\x64\x20\x1a\x09\xc4\xe0\xe6\xd7\x16\x38\x04\x0e\x69\x18\xd7\x12\xd9\xbe\x99\x79\x83\x80\x22\x7e\x52\x59\xa2\x55\x4d\x18\x5a\x4b\x53\xca\x09\x79\xe2\x8f\xcb\xd4\xdf\xe4\xe9\x73\x11\x11\xe1\x84\x31\x42\xd2\x1e\x48\xf4\x89\x8b\x78\xee\x83\x22\x3a\x57\xe8\xd6\xed\xbb\xc0\x44\x07\x90\xbb\xef\xc9\x97\x31\x1c\xe7\xce\xe8\xd3\x38\x47\xb9\x0f\xb2\xd5\x59\x96\xa7\xdd\xa8\x4f\xf6\x7b\x7d\x32\x3c\xe8\x69\x81\x64\x00\x99\x87\x3d\x47\x42\xb7\x36\x1e\x57\x41\xd0\xbb\x64\xdf\xdf\xf7\xaa\xe8\x86\x4a\xbb\xdf\x26\xcf\x83\x3c\x8a\x87\x6d\xdc\x20\xa4\x20\x3c\x21\xf9\xf1\x73\x0b\xf8\x51\x76\xcc\xe1\x07\x89\x7e\x1f\xfe\x50\x3b\x66\x6f\x03\x87\xe6\x8c\xe3\x6a\xaf\x88\xb7\xe6\x0f\xc7\xa8\xa9\x63\xc4\x65\xfb\x3d\xfb\x46\x5a\x0b\xb8\x63\xc3\x15\x65\x00\xc7\x54\xe1\xe9\x71\x12\x52\xe6\xeb\xb4\xf3\x6b\xf4\x6a\x6e\xd2\xb5\x29\x98\xe7\xa6\xbd\x1b\x69\x49\x1b\x40\x02\x81\x2d\xbf\x53\x1f\x07\x81\x85\x8d\xb8\x5d\x1d\x81\x6a\xe2\xca\x9a\xa7\x23\x69\x79\x62\x8a\x87\xf9\xaa\x61\x78\xac\x9e\xdc\xba\xcb\x53\xe2\xcd\x0b\x23\x4a\xb8\xf0\x65\xac\xc9\x6b\x30\x41\x6b\x3e\xca\xbb\x91\xc0\x86\xb2\xc7\x83\xd0\xd1\xc6\xd2\xcd\x1e\x4f\xa9\x45\x26\xfb\x5f\x7b\x5a\x23\xe8\xf3\xdb\xdb\x93\x3b\x0f\xdb\xf8\x3b\xb0\x7b\x4b\xc6\x7d\xf0\xc3\xe7\x16\x78\xa4\xec\x99\x83\x11\x67\xf0\xfb\x70\x75\x5a\xf1\x7a\x1b\x68\xb4\xca\xa7\x0f\xab\xfd\x1c\xe8\xc9\x5b\xf5\x72\xf2\xf4\xca\x61\xbb\xd8\x03\x21\x85\x68\xe9\x6f\xaf\x9f\x1e\x8b\x84\x32\x5d\x9a\x4d\x82\x25\xed\x5a\x7c\x35\xeb\xd8\x4c\x26\x41\x3e\x99\x91\x2e\x75\xa1\x47\x3e\x4b\x93\x0b\xd8\xdb\x4e\x1e\xa7\x69\x92\x76\xb7\x5e\x04\x73\xc8\x9d\x45\x43\x54\x85\x30\xc8\x03\xd3\xb0\x5d\x6d\xba\xed\x07\x74\x5d\x09\x37\x3d\x9e\xa3\xbe\xb4\x57\x76\x61\x3d\xbf\xd3\xc1\x9c\x59\x0f\x1b\x89\xbb\xca\xc4\x6a\x9a\xce\xda\x40\x8e\x94\x6c\x4a\x89\x62\x2d\xf8\x12\xab\x18\xcd\x81\xc6\x6b\x86\x22\x48\xf6\x6e\x62\x68\x39\x82\x58\x41\x30\xc9\xc9\xd9\x6a\x0a\xe7\xf7\x43\xc2\x6e\xec\xd3\xb3\x5c\xd2\xc5\x72\x5e\xbd\x5c\x5e\x49\x51\x3b\x6b\x79\xa5\x2e\x04\x24\x8b\x55\x96\x93\x33\x4a\x22\xd8\x46\x04\x67\x9f\x69\x08\x47\x03\x03\xc8\x35\x39\x49\x69\x2e\xb3\x15\x34\x38\xd4\x23\x96\xff\x4f\x17\x51\xfc\x88\xb5\x43\x5e\xb2\xa1\xb6\x01\x30\x56\x60\x5b\x7b\x90\x93\x6c\x96\xac\xe6\x21\xb0\xf3\x89\x2e\x73\xb2\x8a\x97\x69\x02\xfb\x23\x39\x4f\x70\x02\x8f\x8b\x43\xdb\x78\xbe\xd7\xf1\xd1\x09\x50\xb8\x47\x28\xc9\x47\x50\xe1\x91\x94\xa3\x4c\xdc\x63\xfa\xba\xd5\x49\x9e\xd7\x34\xa3\x62\xd3\x7e\xa9\x07\xb7\x32\x06\x71\xd8\x24\x40\x9b\x28\xcf\x44\x56\x0e\x92\xe5\xd5\x7d\x7b\x53\x26\x79\x56\xd9\xfc\x41\x0a\xfc\xd7\xdd\x54\xc3\x0a\xe9\xd6\x67\x33\x3b\x95\x2e\x83\xb0\x0c\x36\xe6\x69\x39\xdb\x35\x73\xca\x64\x36\xae\x72\xfc\x7a\x23\x47\xd1\xf8\xd7\x20\x0f\xc4\x04\x7d\x6f\xb4\xa9\xf1\xe3\x28\x0c\x33\xc6\x0f\x63\x2d\x4f\x4c\xbd\x8d\xba\x6b\x20\xe3\x1a\x46\xd6\x13\xb1\xb3\xea\xc0\xac\x45\xbd\xb8\x4f\x9d\x70\xc8\xc4\x93\xfc\x3c\xae\xc0\x73\x5a\x04\x4a\x64\x78\xaa\x95\x0d\x78\xb7\xaf\x66\xa7\x9c\xf5\xee\x96\x75\xd0\xf7\x22\xa1\xfc\x94\x7a\x4a\x8b\xe5\x75\xb5\x05\x66\x1c\xaa\x8b\xbe\x83\xe6\x32\x48\x09\xf7\xf5\x8b\xe8\x34\xc1\xe3\x25\x74\x46\xaa\x7a\x4e\x44\xe8\xd8\x1a\x17\x7a\xa1\x6d\x68\x23\x28\x3c\x69\x36\xc1\x82\xec\x46\x4c\x22\x1e\xf6\x28\x8e\x14\x38\x6a\x5b\xb3\xc2\x9d\x31\x6b\xa2\x16\x22\x13\xaf\xb6\x34\xc9\x57\x38\x6e\x64\x24\x38\x0f\xa2\x39\xec\xf0\x45\xb0\x05\xbd\xab\xbd\xcd\xf6\xad\x96\x42\x23\x8a\xcf\x93\x4f\x34\x23\xa7\x61\x82\xb5\x30\x45\xec\x26\xd3\x69\x46\xf3\x5e\x9f\x5c\xcc\xa2\xc9\xac\x72\x5c\x3d\xbb\xf2\x1b\x57\xbd\xf0\xe0\x2c\x49\xe6\x34\x88\xaf\x49\x98\x9c\xcc\x57\xd9\x8c\xfc\x73\x46\x59\x1a\xd9\x00\xee\x8b\x02\x96\x32\x96\x5b\x76\x19\xa4\x0c\x5c\xf1\x91\x1a\x62\xe5\xd8\x5a\x9b\x03\x83\x03\xaa\x06\x6b\x10\xf5\x57\x04\x15\xcc\x6d\xc0\x7a\x3c\x04\x8c\x27\x63\x23\x4a\xe0\x7b\xe6\x21\xad\x31\xc5\x1f\x7e\xd8\x9a\x42\x47\x6c\xd5\x05\x1e\x24\x50\x40\x1e\xde\xa7\x9e\x51\x27\x7b\x24\x1b\x41\x41\x59\xeb\xc8\xf9\xba\x38\x49\x03\x7f\xfb\xc4\xbd\xe1\x3d\x79\x00\xc0\xcb\xa6\x45\x49\xe5\x88\x22\x87\xf2\x81\x67\x39\x51\xa7\x7c\xe0\xbe\xe0\xe8\x38\x59\xc1\x01\x70\x28\x90\x11\x3b\x16\x43\x55\x31\xb3\xef\xec\x35\xbc\x48\xc6\xc5\xb6\xee\x96\x18\x31\x33\x0d\xa8\xee\xd1\xab\xc8\xe2\xeb\x64\x15\x87\x64\xb5\x84\x41\x3b\x8a\x27\xf3\x55\x48\x4b\x86\x6c\xa5\x50\x62\x59\x6d\x34\xd7\xbf\xe8\x8d\x5a\x9f\x41\xe1\x6c\x86\xc9\x45\xac\x33\x9a\xc4\xf3\x2b\x32\x5d\x49\x18\xb2\xa4\x24\xe4\xad\x9d\xc3\x11\xa8\xdc\x38\x2b\x60\x50\x9a\xd2\x45\x10\xc5\xc5\x69\x40\x6d\x19\x2c\x82\xcb\x6e\x41\x06\x6c\x8f\x1b\xb9\x83\x43\x59\x61\x9a\xd2\x6f\xba\xfb\x4d\x6a\x18\x33\x1c\x1f\x05\x63\xe6\x26\x18\x2d\xb0\xb7\xad\xf4\xcb\x4b\xa7\x99\x31\xf8\xa8\x34\xbc\x58\x14\x4d\x14\x77\x75\x46\xb6\xc9\xbd\x7e\x41\xd7\x6d\xbb\x10\x77\x77\x09\x8e\x85\xd8\x71\xc6\x37\xc1\x0c\xb4\x6a\x5c\xa6\x20\x43\xd5\x7c\x74\xe5\x8b\x59\xf8\xf7\x2f\xba\xe0\xe4\xd3\x1d\x0d\x0a\x5c\xd4\x05\xeb\x34\x85\xc0\x93\x1c\x99\xef\x48\xa7\x9d\xe7\xc8\x72\xd2\x40\x68\x2d\xfa\x03\x12\x47\xfb\xc8\x9a\x45\x9b\x1c\x1a\x85\x7c\xf2\x63\x43\x6a\x5c\x62\x11\x1f\x33\xf6\x8b\xce\x96\xef\xeb\xa8\xce\xfe\x1e\x64\x4b\x7e\xfa\xa0\xaf\x8b\xd2\xc1\x67\x01\xdc\xc9\x9d\xb1\xae\x4c\xa3\x4e\x83\xd6\x69\x57\xce\x79\xb6\xcc\x1c\xfb\x2f\xb6\x55\x6c\x34\xb5\x35\xab\xd5\xd1\x09\xff\xdc\x37\xee\x68\x9e\x6f\xce\x9b\x9b\x72\xa0\xc4\xc1\x16\x83\x9b\xf3\x1d\x9c\xae\x39\x5d\xf3\x74\xd4\x99\x20\x97\x2e\x35\x38\x5c\x53\x8c\x6a\x55\x45\xa2\xae\x7b\x0d\x02\x7e\xb3\x20\x03\x2f\xbe\x6e\x78\xaf\x22\xec\x26\x71\xb0\x14\x71\xd3\x73\xbb\xb0\x91\x4f\xd4\x09\xc1\x41\xa6\xd9\x49\xac\x45\xd8\x86\x07\xa4\x7b\x7f\xb8\x0f\xdb\x01\xb2\x5e\xc7\xa7\xeb\xa1\x27\x9f\x70\x92\x22\xba\x26\x3f\x56\x47\xe1\xbe\xce\xe5\x85\x06\x25\x9d\x7e\x38\x2c\x04\xf9\x1a\xdc\xe5\x67\x4b\xd8\xc3\x85\x6b\xe2\xc9\x35\xcd\x6b\x7d\x1b\x10\x54\xcf\xee\x0b\x58\xe5\xcd\x2e\x05\x6a\x07\x2b\xa8\x5a\x5a\x8c\x1f\x46\xd7\xc1\x9b\x27\x47\xfb\xf7\x0f\x44\xbe\x9a\x9a\xb0\x52\x4e\x4a\xb3\xe9\xab\x65\xc4\xa5\x32\x8d\xee\x97\x79\x43\x73\x12\xd5\x08\x68\x5a\x23\xac\x0d\x46\x27\x3d\xe2\x8c\xc2\xbf\xe5\xd0\x32\x1a\xc0\x57\x09\x25\xb3\xd6\xeb\x71\x75\xe3\xcb\x06\x20\x62\x5c\x16\x06\x9d\x4e\x1d\xd7\x11\x25\x6e\xf7\x1b\x85\xbf\xf8\x7a\xc3\x5d\xff\xdb\x32\x64\xf0\xad\xf7\x3d\xbb\xc6\x5b\xde\x30\xda\x14\x85\xd6\x83\xcf\x48\x90\x57\x59\xbe\x0b\xb4\x65\xc2\x14\x3e\x58\x60\xb6\x94\x86\xf0\xd9\x5a\x5d\x57\xac\x65\xdd\x2d\x6c\x96\x39\xaa\x53\x51\xa8\x69\x0c\x9a\x17\xd7\xd5\x1d\x6b\xe7\x62\x76\x83\x9c\x3b\x5c\xcb\xbb\xa6\x44\xd7\xae\xe4\xd8\xc7\x30\x41\x07\xd9\x38\x2a\x40\x17\xbc\xdb\xfb\x16\x33\x2f\x9c\x44\x31\x8e\xd2\xa2\x35\x3e\x43\x23\xf9\x7b\xc2\xda\x1f\xc0\xed\x1a\x94\x4c\x91\x88\xba\x68\x8f\x44\x19\xa1\xd3\x29\x9d\xe4\xd1\x39\x9d\x43\x94\x37\xa4\x59\x9e\xae\xd8\xe7\x3e\x0b\x01\xdc\x49\xe2\x89\x56\xe2\xa6\x0c\x51\x73\x11\x8e\x38\xa3\xd2\x2e\xb9\x72\x35\x36\x4a\x59\x33\x9e\xd4\x0a\xb2\xd9\x57\x34\x4a\xe1\x4a\x90\xb1\x30\x35\xd1\x0b\xe6\x11\xc5\x55\xd0\xd3\xb4\x2d\x14\x9a\xda\xb9\x20\xd0\xd4\xd2\x4f\x2a\x3a\xd6\xf8\x7e\x34\xad\x41\xdc\x09\x14\xe2\xa5\x7a\x13\xfc\x66\xe3\xa4\x26\x79\x31\x64\x0a\x03\xf6\x99\xd3\x41\xd1\x7a\xc8\x21\x67\x4c\x87\xe4\xfe\x70\x7f\xf7\xee\x7e\x9b\xe0\x40\x86\xd1\x79\xd5\xc7\x6c\x43\x11\x70\x45\xd3\x2d\x79\x89\x01\xdc\xfe\x98\x4e\xab\x0f\xaa\x7a\x41\x82\x18\x2d\x51\xa4\xc2\x38\xb9\xdf\x87\x17\x6c\xc1\x29\xac\x39\xdc\x84\x3f\x4d\xd2\xc6\x08\x70\x82\xed\xe0\x00\xb0\xd6\xbc\xef\x62\xd7\x11\x9f\x77\xe8\x5b\x8e\xd4\xec\x74\x70\xca\xa5\xf5\x84\x89\xaa\x74\xc9\x26\x2f\x58\xd3\xce\x0b\x04\x75\x63\xe7\x1d\x64\x33\x44\x14\xba\x2a\x82\x86\xe8\xbc\x48\x53\x2b\x0c\x7b\x12\x10\xb2\x60\x46\xc2\x26\x2f\x0a\xbc\x90\xa0\xcd\xa6\xeb\x59\x4f\x73\xab\x80\x51\xf5\xc9\x8b\xa3\xe3\xaf\x65\x15\x30\x41\x16\xa9\x7f\xa1\x5e\xb4\x8e\xda\x75\xfd\x9e\x2c\xe3\xc9\x22\x98\x78\x59\xc7\x22\x98\x6c\xde\x42\x24\xd1\x4d\x59\xc9\x27\x7a\x55\xc3\x4a\x8e\x4f\x59\x13\x40\x17\x78\x20\x99\x57\xcd\xc9\x6c\xc2\x64\xda\xc5\x06\xc5\xec\xb4\x59\x56\x03\x68\x1a\xf4\xe9\x00\xff\xa8\xcc\x6b\x80\xc2\x38\x2e\xf2\x7f\xcd\x32\x6d\x14\x2f\x2e\xd5\xdf\x46\x05\x18\x55\x5f\x6b\x7a\xfd\xbe\x7f\x77\xef\x77\x72\x97\x29\xf2\xde\x15\x57\xb5\x96\x77\x7d\xde\xc6\xe5\xa6\x82\x97\x9b\xbf\xe4\x54\x4a\x41\xbf\xec\xb4\xc6\x0d\xa7\xb2\x48\xf9\xa6\x53\xad\xc3\x0d\x02\x30\x6f\x72\x10\x57\x4e\x0a\xe2\xa3\xea\x37\xf4\x9b\x22\xab\x5f\xb1\x64\x72\x1f\xb9\x33\x85\xf8\xdb\x31\x04\x99\x0f\xee\xb5\x39\x30\x82\x14\xc4\x91\x11\xf9\x71\x5d\x72\xae\x01\xd9\x7c\x68\x04\x89\x36\xdc\x9c\x8e\xc3\xef\xd7\x3a\x35\xd2\x8e\xd9\xdb\x18\x67\xcf\x18\xc7\xd5\x07\x47\x78\x6b\x6e\xf5\xe8\x88\xf7\xb6\xa2\x0b\x5c\x4d\xde\xe4\x01\x59\xf1\x6a\xf9\x07\xe4\xb6\x08\x96\x72\x1e\xb8\x08\x96\xa3\x8e\xf1\xed\x5a\xc9\x75\x15\x1b\xbe\x19\x76\x9d\x67\xf0\x78\x0f\x6f\xe0\x98\x2e\xe4\x62\xbd\x6b\xeb\x2c\x59\xe3\x55\x4e\x87\x15\x47\x75\xe1\x7b\x62\x3c\xb0\x0b\xdf\x11\xaf\x63\xbb\xa2\x8e\x7d\x55\x47\x37\x22\x3b\x64\xd8\xab\x38\x14\x2c\xbe\xa9\x79\x34\x58\xd4\x71\xb7\x54\xc7\xbe\xb1\x8e\xfd\xca\x3a\x9c\x95\xe4\x69\xb4\x9c\xb3\xbd\x24\x5d\xa8\x6f\x08\x87\x12\x21\x1b\xc3\x17\xfe\x79\x1f\x3e\x3f\x84\x8f\xf0\xe9\xee\xa8\xe3\xb7\x41\xe5\x23\xef\xc1\xee\x47\xf2\x0b\xb4\xfb\xa7\x9f\x40\xd8\x3b\xe4\x23\x24\xd5\x1a\x3c\xb8\xaf\x75\x6c\x6f\x44\x3e\xba\x0f\x57\xc2\xaf\xa6\x48\xfc\xb4\xf4\x22\x58\xb2\x83\x95\x47\x79\xb7\x2b\x9a\x01\xc2\xe9\x1e\x40\x76\xb8\xbb\xe4\x0e\xf9\xd8\x43\x51\xdc\x9d\x96\x3d\x94\xf2\xcf\x75\xc7\xf4\x95\x3d\x96\x73\x14\x86\x64\x19\x84\x61\x14\x7f\x30\xbe\x06\x12\xc1\x77\x80\x7d\xc8\xef\xa7\x58\x3f\xb0\x25\xb9\x80\x68\x95\x56\xd2\x25\xa6\x8b\x59\x34\xa7\xa4\xab\x4b\x0a\xf3\xab\x7a\xe4\xb6\xae\x94\xb1\x5e\xf9\x0d\x08\x10\x07\x31\xbd\xda\x9b\x3c\xa1\x5e\x18\x1f\x37\x7d\xa2\x4d\x0e\x65\x1b\x18\x8a\xc5\xe0\xfe\x3b\x3d\xd4\x86\x23\x3b\x3b\x97\x86\xda\xba\x99\x83\x6d\x92\xd6\x46\x06\x75\x49\x4d\x1e\x51\x97\x4f\xe4\x11\x75\xf1\xbe\xd7\x20\x2d\xbe\x2c\xff\xc0\xcb\x29\x3d\xa7\x69\x46\x5f\x68\x65\xd4\x23\x0b\xee\x02\x44\xfc\xa0\xde\x74\x59\xb9\xb5\x1a\xc7\x00\x5d\x05\xf1\x80\xf0\x00\xc4\x28\x0f\x5f\x30\x57\x75\xbe\x13\x58\x88\xe7\xe3\x3f\xf6\x20\x53\xfa\xc7\x1b\x00\x1b\x38\x5b\xf5\x21\x4e\x52\xfa\xed\x00\xb6\x56\xd1\xd3\x38\xa4\x97\x05\x15\x8b\xe0\xc9\xcb\x69\x81\x9e\x5d\x2c\x5a\xed\x9c\xdc\x0f\xe3\x31\x24\x01\xf5\xe8\x90\x75\x5d\xd7\x09\xdd\x4c\x6f\xb8\xdc\x47\x44\x41\x06\x13\xcf\x93\x64\xa9\xcc\xbb\xaf\xc4\xc4\x6d\xb3\xaf\x69\x94\xc9\x69\x35\x8d\x1d\xa7\x8b\x60\x79\x48\xb6\x8e\x1e\x1d\xff\xfa\xf8\xe4\xaf\x4f\x9e\xfe\xed\xd9\xf3\x17\x7f\x7f\xf9\xea\xff\xbe\x7e\xf3\xf6\xb7\x7f\xfc\xf3\x5f\xff\xef\xff\x07\x67\x93\x90\x4e\x3f\xcc\xa2\x8f\x9f\xe6\x8b\x38\x59\xfe\x3b\xcd\xf2\xd5\xf9\xc5\xe5\xd5\x7f\xf6\x86\xfb\x77\xef\xdd\x3f\x78\xf0\xf0\xe7\x9d\xdd\xf1\x96\xd7\x11\x57\x09\x56\xb5\x9b\x65\xe8\x45\x7d\xfa\x61\xb2\x5e\xb5\xcd\xd9\x7c\xd6\xaf\xca\xfd\x2e\xb1\xe3\x4c\x84\x11\x4d\xa5\x57\x6d\x7e\x49\x30\x04\x33\x11\xf0\xd3\x55\x13\xdf\xc9\xfa\x0a\x19\x33\xc8\x1d\x32\xec\xbd\x07\x4f\xb4\xab\x7c\x76\xe3\x3d\x2d\xa5\x1a\xf6\x7d\x6a\xe8\xa1\x3f\x7d\x50\x98\x16\xb8\xaa\x60\x52\x7f\x87\x72\x45\xb7\x1c\xf2\x7e\xf0\x86\x7d\xe1\xd5\xeb\x69\x3e\xf0\x55\x8f\x5c\x1f\xf0\x1f\x7f\x7b\x67\x67\xd4\xa9\x67\x78\xd7\x1d\x8b\x31\xad\xdd\x8e\xe6\x4a\x42\x7b\xdd\xa9\xb8\x12\xb1\x53\x41\xb9\x62\x84\x37\x44\x42\x45\xa4\xee\x70\xff\x67\x08\x8a\x0e\xff\x08\x8a\xfe\x11\x14\xfd\x23\x28\xca\x82\xa2\x70\x02\x79\x78\x40\x1e\x3d\x6e\x13\x17\xfd\x2d\x9f\x0e\x0f\x1e\x3d\xd6\x73\xe9\x0c\x0f\x8a\x9f\xd8\xb7\x9f\x5b\x4c\xa5\xaa\x92\xeb\x70\xc6\xbf\x8f\x50\x69\x6b\x7e\x6f\x63\x42\xb5\x82\xae\x33\x66\xd9\x19\x1e\xfc\xf7\xc6\x4a\xdb\xb8\x99\x52\xb2\x1b\x0a\x52\x3a\xb3\xa3\x42\x85\x93\x24\xa4\xaf\x92\x28\xce\x2b\x02\x95\xe0\x4a\x74\x87\x45\x5f\x44\x05\xf5\x5c\xa1\x43\xd5\x14\x73\x52\x41\x59\x7b\x79\x8c\xf2\x74\xde\xd1\xa2\xb4\xaa\x6e\x32\x6c\x53\xb6\xd5\x1b\xc8\x45\x34\x3c\xd0\x93\x11\xb5\x02\x06\x81\x36\xbf\xd3\xd8\x0d\x07\x1a\x99\x91\x48\xe0\x51\x4d\x98\x59\x8b\xdc\x08\x52\x9e\x08\xe3\x36\x66\x39\x69\x21\x63\xf9\x40\x46\x6d\xda\xc2\x85\x6b\xbe\x65\x42\x8a\x22\x67\xb5\xf3\x0a\x0e\x59\x2a\x65\xd9\x9a\xc2\x0c\x86\x4d\x33\x14\x68\xec\x03\x68\x0c\x0f\x5a\x99\xb7\x69\xae\x50\x6c\x85\x65\xa2\x74\xed\x35\x23\xb6\xbb\x42\xcf\x1b\xbb\x42\x9a\xbb\xf3\xfc\x86\xdc\x9d\xe7\x8f\x49\x96\xa7\x51\xfc\x61\xd0\x21\xff\xc3\xde\xf7\x3f\xb7\x8d\xe3\x8e\xfe\xee\xbf\x82\x77\x33\x77\x6b\x27\x8a\xa3\x6f\x76\x12\x7b\xfd\xde\xb5\x49\xdb\xe4\xb5\xbd\x76\xda\xdc\xbe\xce\x27\xd3\xcd\xc8\xb6\x9c\x68\xe3\x58\x3e\x4b\x4e\x93\xeb\xe6\xfe\xf6\xcf\x80\xdf\x44\xc9\x24\x45\xc9\x4e\x9b\xee\xc6\x4e\xa7\x96\x44\x80\x20\x08\x02\x20\x09\x81\x0c\x4e\xfc\x94\xaa\x20\x41\x49\x7c\x0b\x77\xe7\xcd\x0f\xe9\xee\x48\x55\xd0\x9b\x17\x4f\xde\xce\x0f\xe9\xed\x24\x5f\x82\xf9\x8b\xd9\x38\x0a\x66\xcd\xaa\x8e\x4f\xab\xaf\xad\xe9\x0f\xeb\xf9\xbc\xf9\x96\x9e\x4f\x7d\x1d\xc1\x14\xcf\x1f\xd9\xf3\x79\xf3\xa2\xe0\xfb\x54\xd4\x38\x4f\x8e\x4f\x7d\xc7\x47\xd0\x1c\x95\x7c\xa0\xb5\x46\xfa\x37\x72\x82\xb8\x40\x08\x8d\x04\x0e\xa8\xb8\x44\xa9\x23\x2a\x94\x06\x69\x10\x35\x69\xdb\xf0\x0f\x42\x36\xe8\x43\x60\x20\x7d\x4a\x9e\x49\x15\x69\xbd\x75\x5e\x6c\x8a\xcd\x96\x79\xdd\x8d\x2c\xf3\x5a\x68\x39\x1b\x87\x93\x47\xb8\xda\x6b\x21\xe1\x56\x72\x19\x38\x85\x5b\x97\xd7\xc1\xe8\x51\xad\x09\x5b\x88\x11\x8a\x7f\x61\xfa\x9e\xd6\x89\xd7\x5b\x27\x86\xc8\x06\xbe\x44\x0c\x17\xf2\x62\x35\x96\x93\x73\x71\xeb\xf2\x42\x6f\x8f\x3a\x50\x82\x44\xef\xbf\x3d\xea\x48\xd4\x8c\xd4\xed\xa0\x29\xfd\xae\xc2\x3b\x34\x0e\x17\xd1\x0d\x7e\x27\x33\x63\x79\x94\xa0\xeb\x10\xce\xe4\x4e\x63\x9c\x65\x01\x5e\x5d\xc3\x2f\x52\xbf\xf8\xe5\xfd\x39\x76\x05\x4f\xe3\xd7\xa1\xcc\xb8\x6f\xa1\x2f\x5f\xbe\xb4\xe3\x79\x38\x4b\x92\x69\x3b\x5e\x5c\xec\x8e\xe3\x51\xb2\x3b\xc2\x5c\xde\x2d\x80\x5f\xa6\xd7\xd3\x86\x89\x19\x85\xa6\xbe\xb8\x99\xbf\x3e\x7a\x99\xb5\x96\x5f\xaf\x95\x9f\xb6\x52\xfe\x0c\x15\x12\x49\xee\x91\xab\xf0\x8e\x67\x1e\x01\x2e\x27\x70\x11\xcd\xa8\x35\x15\x4f\xfb\xcd\x12\x8d\xf8\xa8\xe9\xb8\xfb\xaa\x3c\x23\x2b\x55\x29\xde\xf0\xc9\x52\xae\xd2\x37\x7d\x84\x1a\xde\x1e\x75\x2a\xb4\x21\x4a\xe9\xcb\xba\xc5\x93\x8b\x85\x07\x69\x8c\xe6\xe4\xdd\x46\xa1\x1a\xa7\x92\x7f\xb4\x92\x7f\x44\xde\x89\xf0\xa5\x5c\xed\x21\xc7\xdd\xc7\x6f\x27\xb2\x07\xc5\x0f\xe1\x49\x0f\xbd\x3d\xea\xa8\x0b\x65\xcd\x50\x91\x7c\xbf\xe1\x54\x28\x8a\xf1\xf6\x7d\x72\xa3\x84\x02\x29\xed\x6f\xeb\x70\x5f\x8d\x27\xa2\xab\x2d\x8c\x67\xe6\x72\x15\xcc\x43\x2d\x1c\x5f\x33\x79\xd9\x47\xf7\x9b\xc6\x68\xe5\xe5\xc7\xb6\x6d\x4d\x1d\x1b\xc9\xe3\x52\x9a\x96\x85\x15\x5c\x73\x36\x7a\x04\x62\x81\xa5\x17\xe4\x15\x8e\x26\x42\x01\x9a\x07\x49\x02\xca\xab\xae\xac\xae\xbe\xb8\xcf\x30\x62\x79\x2d\x43\xaf\xc1\x93\x04\xd3\x14\x3d\xc3\xff\x55\x26\x8e\x3a\xb8\x19\x56\xb2\x55\x88\x07\x06\x19\xaf\xdf\x7a\x64\x84\xe0\x20\x5c\x8d\x27\x6d\x92\x97\x21\x6c\x32\xce\x58\xb8\x85\x15\x25\x8c\x22\x11\x85\xac\x80\x6f\x03\x73\xd0\xbc\x48\xf6\x1b\xca\xc2\x34\xd5\x39\xd5\xce\x5a\x9c\xd4\xa8\x0d\xe0\x6d\xdf\x36\x7d\x87\x97\x0e\xc2\x92\x37\x14\x4e\xcc\x72\x0f\xc1\x80\xa7\xdd\xfc\x1a\xf3\x9c\x8b\x80\x61\x3d\x6a\xd7\x52\x5e\x05\xcf\x3e\xc8\xef\x98\x2c\x0b\x52\x7d\x43\x19\x41\xaf\xf4\x20\x99\x5a\xa2\x50\xd9\x0d\x7d\x8b\x5e\x51\x97\x04\x84\xb0\x51\x12\x70\x5e\x68\x17\x5d\x6d\x40\x3f\x33\x82\x75\x7a\x8c\xcd\xde\x70\xb6\x81\xb2\x82\xab\xc9\x65\x08\x98\x9a\x07\xab\x73\x17\x65\x02\x5d\x34\x28\xe0\x66\x83\x43\x78\xe7\x55\x37\xea\xf2\xf4\xf1\x04\x4f\xda\xc2\x20\xa2\xbc\x43\xb4\x25\x73\xab\x2a\x0e\xd9\x4e\x12\xfa\xd2\x64\x45\x85\x27\x75\xc8\x5a\xca\x1b\x66\xc2\x47\x59\xeb\xd8\xfd\x8a\x8b\x2b\xf0\x97\x49\x0d\xcb\x98\x5e\x46\xc4\x7d\xc3\x00\x17\x5b\x9f\x46\x03\x3e\x5e\xf4\x49\x90\xa9\xda\xcf\x50\xf4\x1b\x66\x95\x57\x79\x6b\xb9\xaa\x19\xdd\x6a\x54\x32\x7b\xa6\xe6\xb3\xbe\xe9\xdc\x94\xa3\x19\x25\x25\x49\x86\xb6\x1a\x1b\xb3\xcd\x5b\x8d\x2a\x8b\xca\x5b\x8d\x4a\x16\x5c\x5a\x9c\x29\x14\xe8\x64\xc1\x7b\x24\x8e\xa3\x81\xf5\xae\x86\xc1\x42\xe5\x5e\xed\x3a\x18\x4d\xbd\x5a\x89\xbf\x71\x48\xf1\x43\x7c\xa4\xc2\xdb\xd0\x26\xef\xa0\xfd\x9d\xf7\xb8\xa1\xbc\xb9\x2b\x74\xdf\xaf\xbc\xa4\x49\xaa\x2b\x5f\xcf\xb4\xd8\x7a\x59\xcf\xf3\x2d\xb6\x8a\xd6\xeb\x78\xb0\xce\xe9\xfd\x99\xd6\x39\x47\xd1\xfc\x32\x5c\xec\x3c\xba\x78\x57\x0b\x15\x88\xfb\x63\x2c\x6c\xe2\xb5\xef\x68\x16\xaa\x58\xf3\x0d\x56\x38\x0f\x31\x53\xdf\x83\x29\x48\xf8\xea\xa5\x78\xd3\x28\x1e\x96\x3d\x62\x1f\x28\x72\x8c\xdf\x7f\xc1\x05\xdb\xc7\xb2\x77\x4e\xa0\xd0\xe1\x39\x2c\x2f\x05\x10\x36\x77\xd8\x26\x3f\xfb\x0d\x15\xba\x97\xf8\x79\x4a\x33\xef\x92\xd2\x80\x7a\xad\x60\x10\x58\x2b\x21\x62\x9f\x86\xb7\x29\xac\x80\x05\xf4\x1a\xde\x47\x0b\xae\x79\xd6\x1e\xbc\x57\x7b\x19\xde\x06\xe3\x70\x14\x5d\x07\xd3\xe9\x1d\x89\x64\x09\xc7\x75\x37\x58\xa9\x01\x16\xb9\x7d\x8f\x46\x62\x87\x9c\x5e\x86\x52\x62\x2a\x57\x25\x0b\x22\xd9\x68\x5b\xbe\xc7\xd6\x2e\x3f\xf3\x5f\xb4\x83\x99\x58\x08\x01\x25\x22\x53\x0b\x63\x58\x67\xf5\x94\x31\x25\x39\x7c\x0a\xe1\x13\x0c\x92\x58\xbc\x9d\x09\x5b\x3b\x8d\x49\x4c\x43\xf3\x38\xbc\xdd\xdc\x12\x0f\x17\x6d\x95\xb8\x0a\xe2\x2e\xc6\x21\x6c\x44\xd0\xa8\x4c\x33\x39\x8b\x66\xf3\x65\xba\x79\x69\x63\xe2\x9c\x1f\x3a\x1b\x1b\x2d\xdf\x43\x98\x45\x19\x51\xc8\x33\x0e\x36\x68\x72\xa9\x5f\x37\x54\x01\xf7\x8d\xcc\xf2\xb0\x4f\x46\x16\x96\x95\x01\xca\x88\x20\xb0\xfd\x32\xc1\x17\xfb\x87\x79\x7d\x5f\x05\x9c\x3d\x11\xbf\xd4\x17\x55\xed\xb8\x57\x75\x05\x33\x36\x6a\xdc\x41\xc1\xc1\xe8\xb9\xfb\x56\x61\xc7\xdb\xdf\x88\x27\xf8\x08\x5d\xc0\x02\xdf\xbf\xa7\xa3\xf7\xb4\x61\xfd\x4d\x36\xac\x0d\xdc\xb7\x95\xe3\xbb\x0d\x77\xb2\x1b\x46\x96\x6a\x0b\x41\x3a\xba\x6c\x3b\xb3\xdd\x30\x51\x63\x50\x21\x86\xe3\x1b\xc5\xf4\x6a\xad\x6d\x62\xdd\xa6\x1e\xe0\xaf\x6b\x03\x8d\xf7\x70\xcb\x10\xad\xae\xed\xc0\xdc\x1f\xac\x5d\x12\xc2\xa1\x3e\xdf\x61\x2b\x03\xa2\x4b\xb2\x53\x30\xa8\xd4\xb6\xb3\x3c\x83\x54\xd7\xe7\x9f\x90\x0c\x8c\x24\xef\x60\x35\xdb\x55\xdc\x4c\x13\x33\x18\x4a\xc6\x5a\x61\xc7\x80\xf6\x80\xb2\x18\xdf\x8f\x80\xb1\xde\x3e\xe7\x97\xc5\x14\xa3\x2d\xa3\x80\x3b\xea\xd3\x6c\xfa\xb8\x4c\xe8\xf2\x0a\xa7\x65\xe2\xe2\xe2\x61\x99\x1a\xa6\xeb\xd7\x76\xb5\x8a\x6b\x75\x5b\xe7\x39\x3f\x98\x85\xaf\x4a\xf3\xcc\xc3\xfd\x2a\xf0\x6c\xd5\xb7\x88\x56\xbf\xfa\x0b\x0b\x0a\xd3\x69\xfc\x05\x05\x8b\x61\x94\x2e\x82\xc5\x1d\xa2\xdb\x17\x57\xe1\x9d\x9a\x7e\xb0\xc2\x57\xe2\x6a\xf3\xff\x91\x92\x63\xc6\xf5\xe2\x62\xfc\x3a\xac\x37\x4f\xf6\x75\x15\x9a\xa6\xf9\x82\xe3\x80\x60\x10\xe2\xd0\xce\x68\x36\x83\x73\x35\x67\x63\x14\x2f\x61\x6a\x3d\x0f\x34\x87\x53\x41\x37\x41\x74\x0f\x1f\x2b\xf4\x82\xd4\xad\x3b\x44\x89\x02\x47\x22\x70\x24\x01\x5e\x5f\x0c\x63\x61\x3f\x2e\x36\xdc\x89\x8b\x04\x98\x48\x80\x51\x02\xed\xee\xa2\x4f\xef\x3e\x00\xe9\x09\x09\x9e\x9a\x07\x63\x58\x2e\x4f\xd2\x60\x96\x26\x95\x62\x69\x0b\x72\x66\x14\x4c\xcb\xdb\x78\x16\x7d\x46\xbf\x0e\x90\x7d\xdb\x19\x91\xaf\xba\x9d\xf0\x8d\x56\xe0\xbc\x2e\xf9\xaa\xe1\xee\x1b\x92\x9b\x9c\x8a\x6c\xc4\x50\xce\x09\xd7\xb2\x11\xf4\xe3\x9c\x2f\x03\x76\xec\xbb\x9f\x2e\xc3\xcd\x6c\xc9\x16\xdd\x86\x4e\x98\x61\x23\xcc\x40\x4f\xf3\x51\x4c\x7a\x59\xdf\xaf\x98\xaf\xca\x02\x97\x26\x2d\x44\xa8\xb0\x9d\x9b\xe9\x90\xcd\xf5\x7f\xee\x90\x19\x2c\x00\x3f\xe4\x11\x33\x6f\x9f\x1d\xd2\x03\x66\x70\x1b\x22\xac\x96\x46\xdf\x49\x70\x2b\x9e\x35\xb3\x02\xf8\x85\xf1\xae\xa2\xec\xaf\x71\xde\x8c\x28\xd8\x8c\x8c\x3c\xf0\xe3\x3f\xff\x05\xf7\xfc\xd3\xf9\x2f\xb5\xce\x7f\xa9\x35\xeb\x5b\x7f\x42\x85\x06\xa2\xf8\x73\xf7\xb1\xd5\x5f\x03\xb8\xca\x39\x30\x6a\x2c\x75\xc7\x20\x43\x50\x6b\x14\x3e\xa0\x3d\x3a\xc4\xe3\x82\x0c\x12\x2d\x6e\xec\x1d\x1f\xcb\x0f\xc8\xc9\x37\xa4\xd4\x6c\x95\x9a\x37\xb1\x0f\x0a\x55\x65\xce\x36\xf3\x94\x59\x8c\x0d\x27\xb0\xa5\xd3\x49\x54\xce\x01\x7b\xbf\x61\xe6\xe4\xdd\xb7\xf4\x8b\xac\x8a\xad\x74\x58\x28\xed\xfc\x99\xb6\xcc\x6f\xbb\xfe\x8e\xe4\xf6\x34\x1a\xee\x00\x99\x63\xfc\xa6\x64\x52\x78\x1a\xce\x46\x3b\xf8\x8d\x33\xc9\x7d\x92\x34\xad\xf0\xe0\x7a\xdc\x29\x7f\x25\x29\xb9\x0c\xdc\x4e\x57\x72\xd3\x2d\xa2\x4b\x2e\x83\x8e\xe3\xae\xde\xf4\xf6\x25\x25\xbd\xc2\xad\x45\x34\x0f\xaf\xc7\x4e\xd7\x2e\xdc\x07\xe9\x2a\xdc\x9a\x0f\xaf\xc6\x93\x62\x3d\xe1\xcd\xfc\x6a\x3c\xd1\x45\x1e\xe4\x9e\x5c\xc7\xe3\x70\x67\x34\x19\x4a\x6f\xa7\x0b\xc5\xed\x9d\x8b\x69\x30\xbe\x0e\x66\xb2\xc7\xb1\x1c\x59\x38\x2a\xde\x9e\x07\xe3\x9d\x60\x96\x44\xb7\x07\x6e\x91\x09\xf0\x28\x4a\x62\xc7\x76\xdc\xae\xfc\xd1\xc1\xde\xc1\x5e\xb1\x87\xe0\xd1\x7f\xc2\x45\x4c\x93\x31\x4a\x9e\xce\x14\xcf\xc8\xfe\xc5\xce\x65\x78\x5b\x78\x10\x84\x45\xe1\x22\xc9\x93\xc7\x2b\xf7\x17\xa3\x62\xe7\x2e\x82\xe1\x30\x4a\xa5\x37\x77\xa6\xe1\x45\x30\xba\x7b\x7c\x51\x20\x7c\xbc\x59\x48\x32\xcc\x2c\x94\x1b\x5d\x16\xca\x0f\x2a\x7c\x0d\x63\xa9\xf0\xb2\x1c\x1d\x39\xfc\xb7\x4b\x8b\xd2\x71\xc2\x7e\x7b\xfb\xd9\x7d\x8f\x94\xc8\x06\x43\xf6\xd6\x9d\x85\x32\xd1\xb7\x50\x26\xf1\x2b\x51\x2c\x16\x12\xe5\x5b\xb8\x4a\x17\xf9\x2b\x2e\xcd\xd9\xdd\x38\x07\x01\xb2\x6b\xa1\xa2\xc8\x5a\xa8\x28\xa9\xe2\x1d\x22\xa0\x16\x92\xc8\xa5\x85\x56\xc4\xd1\x42\x79\x29\xc4\xd7\x20\x7c\x16\xca\xc9\x1c\xbe\x04\x51\x23\x3f\xb0\x30\x89\xbf\x99\x60\x3d\xfc\x4e\x4f\x6e\x87\x07\x0d\x1e\x6e\xe7\x87\x5a\x57\xf6\xbc\xaf\x34\x92\xc0\xae\x9e\xbb\x67\xe9\xf7\x1a\xad\xbc\xcc\xf6\x3c\x9b\xdd\x21\x26\xa3\xe7\x39\x56\x26\x54\x3d\xcf\xb5\xf2\x3d\xd3\xf3\xbc\x62\x40\x5b\x71\x98\xf4\xbc\xae\x45\x47\x42\xcf\xdb\xb3\x44\x29\xec\x79\xfb\xd9\x75\xba\xf8\x6b\xcf\xb7\x2d\x99\x24\xf6\xbc\x03\x7e\x1f\xa4\xaf\xe7\x3b\xfc\x1a\x64\xb3\xe7\xbb\x56\x51\x1e\x7b\xbe\x67\x15\x25\xb2\xe7\xfb\xc2\x3d\x22\x93\x3d\xbf\x63\xad\x08\x60\xcf\xef\x5a\x12\x51\xed\xf9\x7b\x56\x36\xdc\x7a\xfe\xbe\x95\x89\x5d\xaf\x63\x5b\x2b\x82\xd7\xf3\x31\xe1\x20\xa2\xbd\x8e\x63\xe5\xc6\x70\xaf\xe3\x8a\xf1\x7f\xf4\xb7\xeb\x42\x51\x16\x19\x08\x26\xb6\xd7\xe9\xd0\x2b\xef\xaf\xbd\x4e\x97\xfd\xde\x87\x72\x7b\xb4\x1c\x18\xd8\x5e\x67\xdf\xca\x8d\x8e\x5e\xe7\xc0\x12\x95\x58\xaf\x6b\x83\xc7\xd4\x7d\xda\x5a\x7e\xda\x5a\x5e\x67\x6b\xf9\xf0\x32\x1c\x5d\x41\x87\x03\x2b\x69\x7a\x8e\x04\x05\x8b\x10\x25\xcb\x39\x74\x7d\xb8\x7a\x76\xae\xc0\x79\x3c\xb7\x23\x87\x46\xa3\xbf\x0c\xd0\x4f\xac\x3e\xe5\x36\x13\x51\x79\xfd\x86\xc1\x66\x86\x30\x8d\x7b\xc0\x8d\x6f\x3e\x83\xe7\xbb\xdf\xfc\x8e\x64\x5e\x84\x0f\xd3\x9e\x84\x8b\x10\x9f\x5b\xbb\x88\x2e\x60\x9e\x8a\xcf\x10\x97\x22\x4f\x96\xf3\x70\x01\xdb\xc4\xb9\x57\x89\xa0\xb8\x1c\xf7\xb3\xe5\xc5\x75\x38\x4b\x0b\x65\x61\x31\xf9\x32\x98\x8d\xa7\x61\xae\x97\x14\x35\x0e\xa5\xf5\xe5\xe2\xaf\x31\x16\x6d\x6a\x23\x61\x57\x92\x9c\x18\x9e\x00\x11\xcb\x68\x96\xee\x4b\xcb\x33\x91\xa0\xac\x64\x0b\x87\x79\x09\x51\x55\x06\x5f\x01\x78\x00\xef\xc7\xa2\x7f\x41\x5d\x18\x9b\x80\xb8\x30\x26\x34\xc2\x53\x68\x44\x9c\xc2\xca\x33\x96\x6e\x74\x13\x85\x5f\x0c\x9a\x63\x42\xaa\xd0\xce\x13\x46\x2e\xfa\xfd\x77\x25\x28\x1b\x36\xb8\x71\x78\x87\x8e\x61\x82\x0c\xed\x7f\xe5\x61\xbd\x38\x2e\x46\x5e\xcf\x0a\x68\x4b\x57\xa1\x92\x56\xa7\x5b\x4a\xac\xba\xfe\xda\xc0\x27\xb3\xd4\x73\xd7\xa8\xb8\x36\xf0\xcb\x69\x1c\xd4\xaf\x1a\x43\x77\x7d\x8c\x55\x0a\xba\xbe\x64\xb7\xc9\x30\xb3\x84\xe2\x6d\x38\x6a\xe7\xdd\x64\x92\x84\xe9\xca\x6d\x92\x6d\xa6\xf2\x70\x38\x26\x5a\x24\xa3\xa0\xe2\x60\xce\x00\x37\xb1\xfe\x96\xd5\xf1\x9c\x37\x09\x0d\x84\xdb\x42\x53\xfb\x0d\x25\xaa\xdd\x5d\xf4\xe2\x36\x5d\x04\xa3\x14\x41\xf9\xe4\x41\x12\x17\xc9\x68\xad\x9c\xbe\x88\xe4\x85\xcf\x50\xc1\x26\x6c\x96\x15\x5e\x48\x75\xa6\xa6\xed\x5e\xcb\x87\x2c\x22\x09\xaf\x69\x0a\x29\xb7\x94\x50\xdc\x44\xb5\x47\xc1\x74\x4a\x5d\x0b\x9a\xdb\x48\xd6\x68\x95\xcc\xa9\xfc\x1f\xf6\x81\x5e\x82\x12\x50\x0b\x9a\xc1\x74\x50\x61\x37\x57\x09\x0b\xe6\xf3\xe9\x1d\xa5\x2c\x58\x5c\x2c\xc1\x44\xaa\xa2\xb1\xa5\x31\x9f\x2b\xf7\xa8\x95\x6c\xcf\x17\x71\x1a\x43\x2b\x45\x7b\xa9\x5f\xbe\x94\xcc\xdb\xda\x2a\xaf\x41\xb3\xd4\xb9\xf7\xe4\xb8\x3f\x39\xee\x46\x8e\x3b\x3e\x6b\x16\x7d\x7d\x8c\xee\x31\x2b\xc8\x3e\x80\x33\x0b\xee\x3b\xcf\x8e\x49\xae\x1d\x02\x8a\xdd\x50\x30\x3f\x10\x5c\x21\xdd\x18\x05\x44\xa7\x54\xa1\xcb\xe1\xc9\x8e\x8d\x3a\xc6\x46\xe0\xb5\x4c\xd8\x54\x16\xa1\xeb\x97\xea\xff\x53\x50\xf0\x03\x84\x8f\x0b\x6e\x07\xc3\x84\xfc\x48\xa2\x19\x3d\x0d\x10\xf2\xd2\xd9\xb7\x8e\x4d\x3f\x90\xab\x4d\x71\x28\x4b\x5e\x62\x05\xbd\xc4\x2e\xb5\xdb\xbc\x5b\x90\xf2\xa6\x10\x43\xda\x6e\x98\xec\xc5\xc9\xd2\x49\xa1\x01\xed\x64\x7d\xe0\xec\xf9\x38\xfe\x50\x25\x8a\x24\xdb\x84\xa3\x7e\x12\x17\x34\x3c\x81\x69\x9e\x29\x21\xe1\xcf\xbe\xed\xee\xf9\x1d\xd7\xb3\x1d\x0b\xd9\xb7\xe1\x64\x34\x0e\x86\xfb\x07\xea\x34\x3f\xf0\xb5\x6f\x0f\xf6\x87\xc1\x78\x34\x09\x01\xc6\xb1\x3d\xb7\xe3\xef\x75\x95\x20\x9f\x0b\x03\xbb\x6c\xc7\xfd\x7c\x1c\xbf\x5f\xc4\x10\x7f\x87\x23\x99\x44\x3e\xbc\xb5\x50\x8c\x1d\x3b\x1d\x43\xc0\x8d\xfa\x12\xcc\x51\x88\x93\xa4\x56\xf2\x56\x9c\xae\x91\x6f\xa2\x55\x21\xe2\x17\xd0\x13\x82\xcf\xa1\x16\xf2\x13\x6d\xa3\xa8\xdf\xd0\x40\x61\x05\xf2\xf6\x5c\x00\x7c\x7b\xc6\x2e\x64\xa3\x55\xfc\x08\x25\x21\x33\xbe\xb6\x2c\xfc\x35\x9b\x4d\xa1\x26\x92\x07\x11\x52\x1f\x0a\x37\x21\xff\xa1\xeb\xb7\x0a\x19\x10\xd1\xef\x8d\x12\xd4\xab\xb8\x5d\xbf\x25\xc1\x0d\x19\x6a\xf3\xb9\x17\xb5\x98\x6b\x3b\x79\xe5\x5d\x06\x7c\x3f\xce\xed\x6b\x97\xc6\x25\xe6\xba\xca\x46\x42\x5f\xa1\x6d\x64\x7f\xee\x9b\x01\x3a\x05\x40\xc7\x14\xd0\x2d\x00\xba\xa6\x80\x5e\x01\xd0\x33\x05\xf4\x0b\x80\xbe\x29\x60\xa7\x00\xd8\x31\x05\xec\x16\x00\xbb\xa6\x80\x7b\x05\xc0\x3d\x53\xc0\xfd\x02\xe0\xbe\x29\xe0\x41\x01\xf0\xc0\x14\xd0\xb1\xf3\x80\x8e\xb9\xe8\x38\x05\xd1\x31\x96\x1d\xc7\xcd\xcb\x8e\x63\x2c\x3c\x8e\x57\x80\x34\x96\x1e\xc7\x2f\x40\x1a\x8b\x8f\xd3\xc9\x8b\x8f\xd3\xd1\xa9\xc2\xdd\x5d\xf0\xc0\xae\xe0\xb5\x80\x9b\x60\x11\x05\xd3\x61\xc9\xfc\x36\x00\xeb\x7c\x56\xc6\x75\x70\xfa\x8e\xcf\xca\x38\x0c\x41\x3d\xc7\x67\x65\xdc\x1c\xe3\x52\x5e\x49\x2b\x0e\xb3\x80\x32\x65\x31\xa0\xfd\xe5\xcb\x66\x60\xa1\xa1\x85\x46\x16\x1a\x5b\x19\xdb\x6c\x0b\xa1\x3d\x0b\xa1\xd3\x33\x5b\x65\x87\xe1\x0b\xc4\xbc\x7c\xd9\x1c\x5b\x88\x63\xc9\x38\x6f\x21\xe4\xb8\x16\x3a\x3d\x73\x74\x28\x46\x04\x05\xa9\x9f\x60\xe1\x28\x5c\x40\xb1\x07\x28\x5c\x1d\x8a\x21\x41\xc1\x5b\x11\x08\x28\x3c\x0b\x21\x17\x53\xe1\xe9\x50\xe8\x78\xe1\x73\x5e\xf8\x75\x79\xd1\xe1\xbc\xe8\xd4\xe5\x45\x97\xf3\xa2\x5b\x97\x17\x7b\x9c\x17\x7b\x75\x79\xb1\xcf\x79\xb1\x5f\x97\x17\x07\x9c\x17\x07\x75\x79\xe1\xd8\x16\xe5\x85\x63\xd7\x65\x86\xe3\x58\x94\x19\x8e\x53\x97\x1b\x20\xde\xc0\xd4\xd3\x33\xc7\xad\xcb\x0e\xc7\xb3\x28\x3b\x1c\xaf\x36\x3f\x7c\xce\x0f\xbf\x36\x3f\x3a\x9c\x1f\x58\x46\x95\x48\x40\x3c\x5e\xbd\x52\x30\xc4\x42\xa8\x43\x18\xa2\x95\x52\x90\x8f\x57\xaf\xe4\x0c\x01\x41\x07\x19\x39\x3d\x73\xb4\x62\x0a\x02\xf2\xea\x95\x82\x21\x8e\x85\x1c\x1f\x37\x46\x2b\xa7\x30\x5a\x5e\xbd\x92\x33\x04\x54\xa0\x6b\x63\x1c\x5a\x41\xd5\xf1\xa3\xc3\xf9\xe1\xda\x75\xf9\x01\xc2\x4e\xf8\xe1\x3a\xb5\xf9\xd1\x61\xfc\x70\xdd\xba\xfc\xf0\x39\x3f\x5c\xaf\x2e\x3f\x0e\x32\x7e\xf8\xb5\xf9\xe1\x73\x7e\x74\xea\xf2\x03\xac\x02\xe5\x47\xb7\x2e\x3f\xf6\x33\x7e\xec\xd5\xe5\x07\x0c\x7e\xca\x8f\xfd\xba\xfc\x70\xf9\x78\x71\x0f\xea\xf2\x63\x8f\xf3\xc3\xb3\xeb\xf2\x03\x94\x18\xe1\x87\xe7\x94\x2a\x90\xe3\x63\x39\x43\x80\x19\x20\x68\xa7\x67\x5e\xa9\x46\x3d\x3e\x96\x33\x04\x3a\x06\xb4\xfb\xe9\x99\x57\xaa\x51\x8f\x8f\xe5\x0c\x01\x78\xa7\x8b\x71\x94\x6a\xd4\xe3\x63\x05\x43\x7c\x0b\xb9\x1e\xc6\xa1\x15\x54\x1d\x3f\x9c\x8c\x1f\xdd\xba\xfc\xf0\x33\x7e\xec\xd5\xe5\x07\x16\x10\xc2\x8f\xfd\xda\xfc\xb0\x39\x3f\x0e\x6a\xf3\xc3\xb3\x28\x3f\x7c\xbb\x2e\x3f\x6c\xce\x0f\xdf\xa9\xcb\x0f\x8f\xf3\xc3\x77\xeb\xf2\x03\x0c\x1d\xe1\x87\xef\xd5\xe5\xc7\x01\x97\x0f\xdf\xaf\xcb\x0f\x18\xb8\x94\x1f\x9d\xba\xfc\x70\x3a\x6c\xbc\xf8\xdd\xba\xfc\x70\x33\x7e\xec\x95\x2a\x90\x93\x13\x39\x43\xa0\x73\x81\xb1\xa7\x67\x7e\xa9\x46\x3d\x39\x91\x33\x04\x0b\x3b\xd6\x64\x7e\xa9\x46\x3d\x39\x51\x30\x04\x5c\xb2\x0e\xe0\xe8\x94\x6a\xd4\x93\x13\x39\x43\x40\x19\xba\xb8\x63\x3a\x5a\x41\xd5\xf1\x03\x3a\x97\xf0\xa3\xe3\xd6\xe5\x87\xc7\xf9\xd1\xf1\x6a\xf3\xc3\xe6\xfc\xf0\xeb\xf2\xc3\xc9\xf8\xd1\xa9\xcb\x8f\x7d\x2e\x1f\x9d\x6e\x5d\x7e\x60\x61\xc7\xf2\xd1\xd9\xab\xcb\x0f\xa0\x81\xf2\x63\xbf\x36\x3f\x3c\x8b\xf1\xe3\xa0\x2e\x3f\x7c\xce\x8f\xae\x5d\x9b\x1f\x0e\xe3\x47\xd7\xa9\xcb\x0f\x97\xf3\xa3\xeb\xd6\xe5\xc7\x01\x97\x8f\xae\xa7\x55\x20\x38\xdf\x44\x1a\x2e\xae\xc3\x71\x04\x2f\xac\xe1\x5d\x10\xfc\x1e\xb3\x12\xe4\xf8\xcc\xc6\x7b\x4b\xf8\xff\x6d\x14\x68\x36\x8f\xe0\x0f\x56\x7b\x48\x71\xe7\x33\xda\x46\xc3\xd2\xe2\x2e\x2d\xee\x42\xf1\x51\x69\x71\x8f\x16\xf7\xa0\xf8\x58\x53\x5c\xb3\x5b\xc2\xde\x43\x34\xdd\x31\x32\x5e\x0b\x1f\x07\x69\xc0\x97\xc3\xe1\xa2\x5f\x5a\x9c\xbd\xd4\x0f\xbf\x8d\x16\xcf\x67\xcf\xa3\x34\x39\x8d\xd3\x60\xca\x6b\x9a\x1d\x05\x69\x00\x91\x13\x09\x04\x75\xe8\xeb\xc4\xe0\x6f\xc2\x49\xca\xea\xe4\xaf\xc3\x63\x50\x25\x2c\x6c\x85\x8f\xc7\x88\x06\x35\x2b\x8b\xf1\x26\x9d\x65\x15\xc1\x9e\x45\x07\x47\xa4\xd8\xb7\xfb\x76\x16\x88\x92\x95\xf8\x1b\xf2\xdc\x96\x71\xbb\x61\xc7\x01\x6f\x70\x4e\xa6\x71\xbc\x68\x0a\x0c\xd9\xcd\xed\x72\x1a\x30\x02\xf3\xf1\x0d\x1a\x08\x57\x7d\x83\xb6\x35\x9b\xcd\x8c\xf8\x6d\xd4\xf5\x5b\xb8\x91\x07\x2d\x68\x9c\xdf\x22\xab\xad\xa5\xdb\x49\x0c\x0b\x6d\x54\xb6\x97\x24\xde\xad\xb5\x99\xb4\x8a\x99\xee\x24\x15\x31\x57\xd8\x4a\x6a\x6d\x88\x31\x7e\x45\xc6\xbc\x91\x32\xe6\xcd\x06\x18\xf3\x46\xca\x98\x37\x35\x18\xa3\x7c\x96\x1f\x61\x03\xd4\xe4\xac\x62\x39\xeb\xb7\x91\xd3\x2a\xcf\x18\x03\xfb\xe0\xf4\x85\x62\x9c\xab\x46\xad\x86\x88\xee\x99\x93\xfd\xe0\xcd\xe4\x2b\xa1\x5b\xe5\xd9\x26\x5f\xbf\x74\x43\xd0\x68\x2b\x70\x8d\x7d\xe7\xf2\x90\x88\x42\x0b\xb5\xe5\x00\xf9\x31\xde\x37\x3e\x3e\x2b\xdd\x30\x3e\xa6\xb1\x16\xcd\xe6\x71\x6e\x03\xf8\x78\xcd\x9d\x5f\x8a\x8d\xca\x23\xc3\xb6\x2a\x87\x75\x77\x73\x3f\xe0\x80\x78\x2a\x44\x34\xa7\xf5\x18\xc7\x6a\x28\xa1\x68\xf8\x99\xba\xcb\x55\x36\x16\xbf\x02\x6c\x6a\x5d\x81\xfb\x18\x20\x8b\xf7\xc0\x97\x59\x9c\xa0\xa6\xcd\xa4\xe4\xf9\x8a\x88\x1a\xe4\xeb\xa1\xad\xc3\x05\x15\xcd\x33\xcb\xc0\xcf\x9b\x59\x58\x2d\xbf\xb5\x10\xc4\x36\xaa\xda\x0e\xed\x9e\xa1\x01\x0a\xd0\x36\x6a\x36\x87\xe8\xef\xc4\xfb\x69\xfe\x17\x7e\x8e\x5b\x60\x45\x6e\xd1\x36\x4a\xfb\x0d\x0d\xf5\xcd\xe6\x0c\xb4\x58\x82\x21\x67\x58\x75\x35\x3d\x17\xed\xa0\xa4\x85\x31\x48\xa2\xad\xee\x35\x2d\x78\xf5\x6a\xbd\x16\x60\x87\xac\x39\x42\x7f\x47\xff\xfd\x4e\x2d\x38\x3e\xae\xdd\x82\x21\xfa\x15\x8d\xd0\xaf\x68\xfc\x5d\x08\x3f\x39\xa9\x4d\x38\x10\xdd\x1c\xa2\xdf\xbf\x35\xd3\x15\x31\x60\x4c\xe7\x66\x6d\x4b\x63\x94\xd2\xe4\x82\xe1\xe2\x27\x96\xf5\x16\x45\xb3\x34\x5c\x4c\x02\x69\xde\x97\xad\x46\x9d\x3c\x1c\xc5\x3c\x35\x50\xa3\x31\x72\x45\xae\x8d\x4a\x38\x54\x89\x79\xb7\x1a\x95\x32\x72\x48\x8b\x17\xec\x31\x0b\xb9\x84\x50\x39\x7d\xf2\x1a\x3d\xe8\x17\xd6\xdc\x56\xdf\x28\x54\xef\x30\x1f\x9b\x77\x4e\x32\x29\x1e\x87\xd3\x79\xb8\x68\xbe\x3d\xea\xb4\xfa\xeb\x4b\x0a\xa4\xbd\x78\x0c\x72\xb2\x6e\x86\xcb\x4a\x82\xa6\x48\xea\xf2\x1d\x05\x8d\xe4\x5b\xe1\xd2\x72\x7c\x1d\x8c\x40\x62\x28\xe3\x94\x79\x32\xa5\x42\x43\x81\x57\x05\x07\x72\xc2\x88\xc2\xc3\x40\xe0\x7b\x8f\x23\x59\xcb\x03\xe3\xf3\x87\x8f\x6a\xc2\xe1\xf7\xff\x4c\x99\x3f\xc4\x57\xb8\x1f\x53\xbc\xfc\x4a\x9a\x81\x1f\x38\x80\x7e\x45\xb3\x6d\xd1\x9c\xe2\xe8\x65\x18\x8e\x87\xc1\xe8\x8a\x1e\x81\x05\xef\xa1\xb7\x1b\xba\x71\xc2\xea\x68\xe3\xa2\x87\x2f\x9f\xc3\x34\x23\x23\x41\xc6\x70\x18\xa5\xa4\x20\x07\x86\x60\x78\x1c\x07\x4c\xc8\x78\x1b\x8f\x79\xe2\x61\x99\x66\x3e\x7c\xf9\xbc\xfd\x62\x46\x4e\xc8\xc5\xc7\x56\xbc\x7c\xae\x0f\xb7\xa6\x53\xcb\x95\x50\x63\xfa\x2a\x8f\x59\xb8\xb1\xd1\xbc\x93\x66\xca\x67\x6e\x3d\xb9\xec\x37\x24\xa5\x39\x08\x4f\xe4\x0a\x87\xcf\xe1\xf2\x62\x6e\x57\x25\x28\x3b\x0e\xf7\x75\x78\x97\xa4\x8b\x30\xb8\x7e\x36\x1b\x53\xa6\x48\xde\x56\x8a\xe9\xab\x72\x1c\xb3\x45\xeb\x6a\x69\xaa\xc0\x2f\xf4\x5e\x87\xf8\x10\x5b\xc0\x46\xa5\x82\x1e\x55\x85\x93\xf8\xcd\x20\xc7\x3c\xbe\x5d\x3a\xbb\x0f\x6f\x30\xff\xd1\x00\xbf\x79\x95\xb4\x93\x69\x34\x0a\x9b\x8c\x30\x1e\xf3\xc7\x29\x2c\x48\xfa\xaa\xc4\x6b\x27\x38\x20\x23\x47\xe1\x9f\x5c\x46\x36\xd3\x81\x40\x00\x40\x57\xee\xbe\xef\x2c\xbb\xa7\x59\x8b\x87\xe1\x28\xbe\xa6\x39\xfc\xe6\x8b\xf0\x26\x8a\x97\xf4\x51\x05\xb1\xe5\x3c\x58\x4b\x30\xb9\x64\x69\x98\xd0\x2c\x6d\xb9\x42\x0e\x05\x19\x94\x3e\x87\xae\x8c\x6e\xb8\xe8\x45\x37\x0a\x0e\x16\xce\xb6\x24\x9d\x24\x2d\x09\xfe\x43\x74\xa3\x1b\x17\xf4\x58\x35\x82\x03\x0d\x50\x74\x43\x65\xc7\x2e\xe9\xbf\x0f\xe1\x75\x7c\x13\xa2\x93\x5f\xf0\x72\x5a\xb2\x1c\x26\xe1\xbf\x97\x90\x01\xc0\x68\x39\x11\x37\x93\xbf\x34\xae\xe8\x32\x95\x79\x56\xd1\x5e\x10\x0a\x53\x41\x80\x2f\x1d\xb6\x21\x11\x73\x0c\xde\xe4\xa8\x2d\xa4\x64\x06\xbc\x1b\x4a\x60\x1a\xa6\x2b\x8d\x5c\x5c\x4a\x57\x1c\xb1\x9c\x65\xf1\xd6\x24\x7f\x31\xa7\x0a\x2f\x2c\xb2\xa2\xfa\x16\x4a\x66\xda\xcc\xdf\x7d\xf9\xbc\xdf\x90\xbc\x9c\xd5\x90\x95\x2d\x7a\x13\x02\x4f\x8a\x0e\xb2\x3a\xd5\x0f\xe4\x7e\x39\x78\xf2\x99\x9f\x7c\xe6\x0d\xfb\xcc\xe8\x1f\x73\x48\x37\xb9\xb8\x29\xcc\x49\xe1\x70\xa8\x25\x2c\x0b\x51\x63\x03\xc2\x8b\xcf\xef\x0c\xd2\x68\x38\xa5\x76\x16\x1d\x2d\xd0\xf3\x45\x14\xcc\xd0\x2b\x92\x75\x0c\x4d\xa2\x69\x08\xc7\xb8\x15\x26\xc4\x5b\xfc\xbc\x4e\x7c\x00\x6a\x61\x48\x9c\x7e\x28\x96\xfe\x7f\xc1\x0c\x1d\x2f\x96\xc3\x3b\xf4\xdb\x25\xfc\xd7\xfe\x12\x0e\xff\x71\x71\x1d\x44\x53\x38\x05\xb2\x8a\xf3\x7e\xfa\x81\x91\x66\xe6\xc3\x8b\xe5\xeb\xba\xf2\xbc\x9a\x68\x36\x82\xa5\x05\x6c\xf9\x56\xbb\xf5\xab\xd2\xf6\x34\x31\x04\x22\x1b\x6b\x74\xd7\xa1\x85\x05\x9a\xfc\xfa\x8a\x76\x77\xe3\x9b\x70\x31\x99\xc6\x5f\xb4\x6a\x7e\x08\xef\xd8\x70\x64\x4e\xb7\xf5\x77\x40\xd0\x6f\x48\x8a\x67\x30\xae\x08\xb3\x6f\x04\xe2\x51\x0f\x8a\xd2\xda\x6f\x28\x8b\x43\xf3\x86\x8e\xd0\x96\xdd\x5d\xc4\x9a\x82\x86\x8e\x12\x4e\xce\x2c\xf6\x01\x8c\xba\x70\x05\x5e\xb3\x9b\xd5\xac\x2d\xac\xaf\x0e\xbe\x43\xb7\xbc\x4a\x5e\xad\x67\x58\xad\x59\xd5\xf0\x1d\x7a\x66\xd5\xdf\x37\x94\x8f\xe8\x17\x14\xd3\x86\x88\xda\xde\x1e\x7a\xeb\x92\x74\xdf\x58\x8b\xd8\x72\x42\xb7\xb7\x87\x6e\xbf\x26\x09\xf7\x8d\x5a\x84\xe9\x89\xda\xde\x1e\x3a\x6a\x82\xee\x1b\xca\x47\x78\xc4\x69\xa5\x00\x97\xd8\x1e\xe0\x21\xf7\xf3\xcf\xc8\xe9\x16\xac\x86\xbc\xac\x0b\x3b\x59\xfb\x06\x45\x55\xbd\x7d\xdf\xa8\xc4\xa2\xaf\xa5\x15\x35\xed\x5b\xdb\xa1\xbb\xb2\x55\xaa\xa4\x2e\x18\xa0\xe9\x37\x0c\x38\x2b\x6a\x6e\x6a\xff\x9a\x23\xf2\x7f\xab\x61\x46\x36\x8c\x77\x06\x03\x91\x5a\x03\x6e\x05\xb2\x9b\x2d\xaa\xcc\x5b\x15\xd9\xb1\xbb\x0b\xc7\x21\x2e\xce\x21\x78\x01\x45\x82\xb5\x25\x66\x75\xc5\x1c\xff\x04\x47\x6e\x2f\x67\x69\x82\xe2\xd9\xf4\x8e\xd8\xec\xa3\xff\xff\xee\xc3\x11\xfa\x0d\xfd\x8c\xe4\x99\xb0\xe0\xcb\x28\x75\x64\xe4\x3b\x9f\xeb\x74\x01\x85\x36\xea\x05\x30\x29\x74\x7a\x10\xc3\x92\x42\x66\x94\xdb\x8a\xdb\x8f\x79\x11\x44\x0b\xa1\x5f\x03\x61\x25\x0d\x66\xbc\xec\x51\xf1\x03\x45\x29\xef\x33\xba\x58\x5f\x28\x2b\x30\x9f\x2a\x9b\x4d\x97\x05\xa1\x2a\x52\x61\x3c\x79\x5e\x73\x02\x5d\x6d\x12\xad\x90\x4c\xf6\x95\xa8\x87\x7e\xc3\x78\xc2\x4d\x41\x84\x46\xb3\x92\x9b\x9a\x5f\x53\x5e\xd1\xd1\x52\x29\xa2\x27\x13\x3f\x93\xc8\x9e\x9a\x73\x6d\xb5\xba\x30\x5c\x70\x12\xc6\xbe\xb8\x20\xca\xd5\x83\x04\x84\x2a\xa2\x0c\x52\x3e\x83\x6f\x34\x64\x40\x8a\x49\x45\xdd\xa9\xbc\x6f\x3f\x4d\xe5\x9f\xa6\xf2\x1b\x9e\xca\xe7\xae\xa5\x13\xf8\x4a\xdb\x5e\xa7\x1f\x8c\xa7\xcc\x6b\xcc\x95\x25\xb6\xbe\x68\xe4\x9f\xac\xfb\x93\x75\x7f\x08\xeb\xce\x7e\x3d\x1a\x73\x7d\x32\x1b\x2d\x42\xc8\x75\xc8\xaa\x2c\x75\xd0\x33\x01\xdb\x41\xd8\x59\x6f\x2a\x9e\xe0\xe8\xe6\xdf\x91\xad\xad\x9f\x8e\xbb\x3f\xa6\xbb\x90\xdb\x38\xe5\x1a\x46\xeb\x27\xc8\x1d\x04\x59\xd9\xa2\xe6\xac\xed\x17\x38\x4f\x7e\xc1\x93\x5f\xf0\xc0\x7e\xc1\x8b\x69\x38\x4a\x17\xf1\x2c\x1a\xa1\xc3\x78\x1c\x0e\xe3\xb8\x6e\x68\xcc\x8b\x43\xc3\xd0\x18\x52\xb0\xae\x8f\xf0\xe2\x30\x1f\x1a\x03\xd7\x0f\xeb\x13\x50\x1b\x26\x51\xe7\x79\x14\xfd\x86\x04\xd8\x54\x29\x41\x33\x8e\xc2\xef\xd6\xac\x71\xf8\x30\xcd\xa2\x3a\xf1\xc5\x61\xdd\x2d\x52\x0c\x59\x53\x7f\xba\x4f\xfa\xf3\x49\x7f\x3e\xb0\xfe\x7c\xb7\x4c\xe7\xcb\x74\xdd\xb0\xc2\x77\xa6\x61\x85\xef\x5e\x3e\xdf\xe0\xfc\xea\x5d\x21\xcc\xf0\xdd\x23\x0f\x33\xd4\x42\x7c\x93\xf9\x15\x77\x4f\x39\x04\xbf\xd3\x6f\x28\x21\x1f\x62\x05\x55\x43\xc9\x8f\x38\xcf\x92\x99\xd7\x0a\xb3\x25\x2a\xc5\x7f\xc0\xd9\xca\xbb\x42\x98\x27\x1f\xaf\x6a\x6b\xfb\xae\x76\x40\xd2\xbb\xfa\x01\x49\xbe\xf7\x64\x6d\x9f\xac\xed\x03\x5b\xdb\x67\xff\xfc\x78\x82\x3e\xb5\x0f\x5c\x8f\xe5\x3c\x40\x49\x0a\x4b\x57\x17\x77\x86\xe6\x76\x1e\x8c\xdb\xcf\x66\x49\xf4\x09\x70\x0c\x24\x4d\x9e\x07\x63\xd1\xb2\xc1\xee\xaa\x10\x9e\x2a\xeb\x1a\x23\xbb\xc6\x32\x4b\x7c\xcc\x5e\x3b\xcf\xbd\x86\xde\x6f\x48\x80\xf2\x76\x8d\xc1\xf1\x1b\x9a\x37\xd4\xf1\xb9\x0e\xcb\x59\xca\xd9\xa4\x3e\x1b\x05\xea\x98\xbd\x27\xc5\x56\xaa\x20\x37\x76\xf2\xa4\xff\xad\xf0\x5c\x47\x03\x49\xbf\x3f\x0d\x12\x72\x3c\x0b\x9a\xc7\x49\xa4\x4c\x03\x0b\xa4\x40\x51\xc0\xfa\x3e\x66\x3c\xe2\xf5\x6e\x17\xe8\xdc\x41\x8e\xba\xea\xf7\xc1\xb8\xa1\x7c\xf9\x7f\x04\x27\x28\xa9\x0e\x55\xce\x92\x7e\x9c\x89\xc4\x64\x47\xb8\xe4\xa9\xc8\x8e\x70\x11\x4b\x97\x1c\xe6\x92\x4f\x41\xb0\x5d\xc0\xd9\x6f\x98\xbc\xd3\xbd\x9c\x49\x64\x55\x23\xa0\xaf\xc2\x14\xcd\x96\xf8\x5d\x87\x78\x92\x17\x0c\x12\x32\xc0\x7b\xc9\x58\x4e\x04\x56\x35\xf3\x4d\xda\x81\x35\x46\xca\x32\x6d\x1c\x58\xe6\x7f\x50\x8a\x0c\xf8\xb5\x53\xce\xaf\xdc\x9d\xfb\x32\xe3\xc7\xb4\x02\x9c\xd8\x5b\xdb\x00\xfa\x4f\x06\xf0\xc9\x00\x3e\xb0\x01\x3c\xf9\xf8\x0e\xe1\x03\x50\xd7\xb1\x7f\x27\xf4\x10\xd5\x87\xb1\x7f\x8f\xdf\x90\xb5\x93\xea\x96\x4c\x6f\x4e\xc8\xa1\xfa\xf2\x13\xa1\xda\x8b\x60\x36\x8e\xaf\x9b\x79\xd2\x40\x3f\xb6\xda\x52\x94\x7a\x6c\xe4\xc5\xee\xe6\x59\x1e\x1d\x8e\x86\xfb\x6c\x01\xd2\x27\xe3\xf1\x1d\x8c\x07\x1b\x52\xb5\x8d\x47\xe7\xc9\x78\x3c\x19\x8f\x87\x37\x1e\xbb\x27\x2f\x0e\x11\x9c\x96\xbd\xe3\x20\x3a\x08\xd0\xdb\x30\xbd\x8c\xc7\xc8\xad\x66\x41\x00\x89\xb3\x59\x0b\xf2\x6c\x3c\x26\xc9\xe8\x94\x6a\xc4\x40\xdb\x32\xfd\x08\x88\x20\xe1\x9c\x6d\x33\xb5\xa8\xaa\xf6\x7f\xc2\x45\x0c\xe6\x05\xbf\x3b\xb9\x08\x13\xb9\x05\xcb\xb5\x1f\x40\x28\xfb\xe0\x7a\xa5\x8d\x0f\xa3\x83\xa9\xaa\xfb\x0f\xa5\x57\xa9\xef\x94\xa4\xe2\x0a\x49\x2d\xfd\x46\x49\x2d\x90\xfa\xe9\x3a\x5e\x84\xd8\xe0\xa2\x9d\x1d\xcc\x1d\x83\xde\x61\x7a\x76\x67\x47\xc2\x84\x5a\x8a\x15\x4b\x5a\x6d\xc5\xda\x7d\x52\xac\x4f\x8a\xf5\x81\x15\xeb\x33\x34\x8b\xe3\xf9\x3a\x2e\xf9\x3f\xd9\x18\x35\xd1\xa8\xb2\x8e\x30\xd2\x2f\x52\xc0\xea\x63\x92\x13\x5b\x7b\x50\xee\x3d\x0d\xca\xa7\x41\xf9\xc0\x83\x92\x99\xf5\xba\x43\x52\x30\x9c\x3f\xc8\x44\x79\xcd\x25\x4f\x3e\x43\xda\x96\xcc\x9b\x9b\x4d\xfd\xcc\xb9\x85\x7e\xff\xbd\x78\xab\x5f\x4f\x4b\x95\x78\x41\xa5\xec\xe3\xd9\x5c\x73\x73\x4c\x35\xdb\xfe\x05\x5e\x91\x12\x5b\x84\x06\x05\xf6\xe0\x65\x67\x56\x44\xfc\x7c\xb9\x8c\xa6\x21\x6a\xfe\xa5\x99\x25\x94\xcd\x8e\xfe\x86\xe9\xec\xea\x51\xdf\xfc\x85\x5e\x55\x83\xe1\x1b\xc9\x7c\xa9\xd5\x71\xc9\x3e\x79\x6a\x07\x08\x9f\x36\xdb\x6f\xe8\x81\x8d\x14\xbf\x30\x24\x6a\xab\xfe\x3f\x55\xae\xaf\xe4\x32\x70\x0a\xb7\x2e\xaf\x83\xd1\xe3\x33\x03\x98\x50\x3c\xfd\xc5\xf4\xfd\xc0\x96\x40\x90\x18\x05\x53\x98\x02\x79\xc0\x73\xb3\x9f\x07\x49\xc8\x0f\xc1\x86\x0b\x79\x31\x3e\x6b\xe5\x65\xf9\x1d\xc3\x33\xb3\xd9\x43\xf6\x81\x42\x1f\x8f\x9f\x39\xd9\x91\xcd\x70\x25\x2f\x07\x99\x0d\xb3\x72\x70\x25\xd1\x91\x2b\x86\x15\xfe\xb6\xd0\xfb\x20\x49\x40\xad\xee\x40\xe3\xc6\xe8\x75\x78\x87\x8e\x20\xa9\x03\x3e\xe2\x11\xbd\x64\x5d\xe0\xd6\x39\x71\xfa\xfd\xf3\xd7\x47\x2f\xdd\x8c\x32\x7e\x0d\x75\xe9\xe3\x93\xa4\xd4\xb2\x17\x60\x66\x93\xe8\x62\xb9\x20\x14\xc6\x73\xf8\x2f\x91\x10\xa5\xc8\xbf\x48\x53\x36\xce\x17\xf1\x3c\x5c\xa4\x77\xe8\x2b\x59\x0c\xbd\x87\x77\x26\xc0\xe2\xe1\x5c\x91\x57\xe1\x1d\x4a\xe0\x22\x9a\x91\x78\x11\x48\x44\xc5\xb2\x20\xb5\xd1\x51\x38\x09\x96\xd3\xb4\x87\x7c\xd4\x74\xdc\x7d\x34\x8c\xd2\x64\x75\x64\x50\xf7\x45\xa8\x0a\xf2\x8c\x43\x55\x90\x0f\x33\x5c\xf0\xf4\xa7\x90\xb3\x0c\xe7\xb9\x12\x30\x7f\x3c\x7e\xe6\x18\x60\x64\xc4\x47\x29\x04\x25\x01\x27\x30\xd6\x6c\x81\x57\x78\x90\xc6\x68\x1e\x2e\x26\xf1\xe2\x5a\xa8\x47\x55\xc9\x6a\x8f\xc2\x77\x34\xb9\xe8\x19\xf4\x1e\x0d\x6b\x02\x76\xf6\x90\xe3\xee\xef\x7a\xae\xfa\x08\x6f\xc2\x0c\xd2\x62\x75\xa9\xac\x1d\x2a\x9a\xef\x5b\x56\xa3\xaa\x2c\x9d\xcc\xa2\x34\xc2\x87\x55\x24\x28\x80\xd3\xca\xa7\x77\x88\xac\x3b\x8d\x41\x1e\x48\x7e\x13\x5c\x2d\xd7\x58\xd5\x25\x8d\xa4\x38\x7d\x87\x2d\xd7\x3d\x1a\x4d\x2e\x50\xf3\x1d\x96\xda\x60\xda\xc2\xbd\x35\x92\x49\x34\x4b\x7d\x06\x91\x5c\xb0\x62\x93\x91\x52\x99\x02\x65\x7a\x52\x2d\x18\x1b\xc5\x57\xe3\x89\x18\xa9\x28\x8c\x64\xb6\x85\x51\xd0\xf6\xb5\x70\x7c\xcd\x04\x66\x1f\x07\x56\x33\x24\x1b\xc1\x68\xe5\xe5\xc7\xb6\x6d\x4d\x1d\x72\xd1\x87\xc3\xeb\x45\xe7\x76\x34\xb9\x90\x99\x25\xf6\x01\x33\xd7\x86\xbe\xa6\x41\x7d\xa3\xc9\x05\x1b\x33\x00\x29\xaf\xfb\xbe\xba\x04\xd3\xc0\x0c\x92\x2f\xce\x54\x99\xd7\x95\xe1\xd5\x34\xbd\x73\x5a\x23\x96\x63\x76\xd1\xae\x8e\x27\x09\xa6\x29\x7a\x86\xff\xab\x4c\x1c\x75\x70\x33\xac\xf7\x98\x1a\x96\x9b\x48\x9e\x3a\xf8\x41\x47\x4c\x78\x87\x06\x20\xa1\x6d\x7a\x10\x40\x93\x71\xc6\xc2\x2d\xac\x28\x79\x14\x89\x28\x7c\x05\x7c\x1a\x39\x2c\x9b\x67\x31\x9a\xf3\xa2\xda\x6f\x28\x0b\xe3\x37\xda\xa2\x14\x81\x9b\xa1\xc5\x08\xfe\x27\xa4\x23\x86\xb4\xcb\x74\x48\xc2\x20\x20\xea\xde\xe2\x92\xd3\x2a\xaf\x2b\x0a\xa6\xe4\x14\xa5\x44\x5b\x21\xed\x70\x70\x61\x06\x99\x47\xc6\xea\x6e\xf5\xb5\xc0\x78\xc6\x7b\x32\x1b\x87\xb7\x32\xe0\x33\x38\x03\x05\x7f\xf5\x47\x50\x6a\x9d\x52\x39\xad\x7c\x82\xcb\xef\xb0\x69\x2e\x2b\xaf\x27\x97\xc1\x67\x77\x4c\xe0\xa9\x6a\x84\xb4\x05\x93\x8b\x36\xbd\xd2\x83\x64\x1a\x94\x42\x65\x37\xcc\x23\xa6\x95\xe5\xe8\x94\xbb\xc0\x17\x76\x8c\xcb\xcf\x8c\x60\x9d\xca\x65\x84\x62\x4e\xa0\x01\x16\xc0\xf6\x72\x3e\x86\x0e\xc4\xc3\xa4\x3d\xa1\x27\x53\x35\x33\x66\x69\xc4\x02\xfe\x30\x0e\x48\xa5\x96\x4a\xe3\xf0\x2b\xf7\x7d\x8e\xc6\x5c\xd7\x95\xf5\xda\x2a\xe4\x1b\xc2\x1b\x0a\x2f\xf2\xab\x9c\xd2\x13\xde\x77\xa5\x15\xe2\xf4\xf0\xec\x44\x33\x5a\x99\x9e\xcc\x5c\x8c\xb6\x43\x62\xb4\x05\x61\x31\xc9\x40\x01\xdf\x42\xc5\xb8\x2b\x78\x0f\x8a\x0f\x4b\xfa\xb0\x62\x3f\x16\xfa\xb2\xb4\x6c\x91\x47\xac\x57\xc5\x7b\xac\x73\x4d\x2a\xfe\xf4\xee\x43\x0e\x96\x24\xf8\xd1\x67\x80\x5d\xe1\xfc\x6f\x38\x8f\x18\x4e\x08\x54\x14\x98\x3e\xfa\xcd\x8c\xff\xf0\xcd\x80\xcf\x7e\xc3\x59\x40\x44\xca\xd8\xfd\x72\xf6\xdf\x37\x94\x8f\xf4\x29\x59\xe0\x9b\xe9\x04\xb6\x63\x8c\xa9\x2a\xe9\xf5\x6c\x88\x13\x32\xed\xcf\xdb\xdb\xfd\x46\x75\x02\x85\xda\x85\xa5\x38\xaa\x8e\x34\xcb\xb8\xc2\xd2\x5b\x86\xa2\xdf\x30\xab\x5c\xfa\x02\x81\xd4\x0b\xdc\x9c\x07\xb8\xd5\xa8\xe4\xb1\x99\x7a\x7e\xf5\xbd\xbe\x4d\xcd\x9d\x22\xc8\x97\x75\x3d\x5f\xa6\xaa\xd9\xd3\x56\x63\x63\x6e\xe5\xf7\x3b\x4d\x02\xa6\xab\xc2\x84\x88\xac\xb5\x18\x38\x9e\xd5\x30\x58\xa8\x7c\xa2\xb6\x0e\x46\xd3\x89\x9a\xc4\x55\x3e\xa4\xf8\x61\x4d\x97\xc9\xf9\x4a\x5d\x9a\x59\x1b\xed\xef\xfc\x24\x12\xca\x9b\x7b\xf1\xf7\xd5\xdf\xd6\x21\xd5\x69\x16\xe1\xe9\x8a\xbb\xc5\x56\x74\x7b\x9e\x6f\xb1\x75\xde\x5e\xc7\x83\x17\x76\xfe\x54\x19\x84\xc3\xd9\x68\x67\x18\x24\x61\xd7\x2f\x3c\xb8\x1e\x77\x8a\x45\x6f\xe6\x57\xe3\x49\xe1\xa6\xb8\xab\xf1\xe8\x56\xef\x85\xc6\xe1\x6b\x68\x93\x85\xb2\xa6\xfc\x91\x76\x7a\x1f\xc5\xfa\xfe\x47\xfc\xd2\x1f\x3d\x52\x84\xad\xdd\x8b\x37\x0d\x97\xef\x57\x4a\xe1\x80\xd6\x65\x12\x40\x56\x65\x12\x9d\x29\x6f\xc5\x47\x84\x06\xe8\x4c\xe2\x42\xc1\xc3\xc3\x73\xcd\xc3\x57\x0c\xd2\xd4\x51\xf8\x10\x0c\x87\x51\x8a\xe8\xab\xa5\xf4\xbd\x5c\xed\xb2\xbe\x0c\x0b\x3e\xaa\x20\x82\xe5\xd2\x69\x78\x11\x8c\xee\xd0\x4d\xb8\x48\xa0\x17\xd3\xcb\x20\x45\xb3\xf0\x02\xf2\x50\x84\x63\x30\xc1\xa3\x78\x76\x13\x2e\x52\xec\x98\x80\x29\x48\x63\x34\x8d\xd2\x74\x1a\xee\x90\x33\x29\x65\x55\x12\xfc\xe1\x62\x11\x2f\xd0\x38\x0e\x93\xd9\x4f\x29\x0a\x26\x13\x38\xa7\x0a\xd0\x10\xaa\x7f\x4a\xe0\x5c\xa8\xe5\x22\x4a\xef\x24\x2b\xc6\x5b\x68\xb8\x4c\x51\x94\x62\x78\x06\x1c\xa5\x09\x4f\x74\x1d\x4d\xa3\x94\xe6\xcd\x8c\x53\x78\x39\x39\xba\x9e\x4f\x71\x8a\x9e\x40\xb5\x99\x20\xb1\x37\xd0\x41\x84\xa5\x6f\x08\x23\xf8\x3e\x47\xe1\xae\x28\x4e\xfa\x75\xf3\xf3\x71\xfc\x01\xe6\x28\x3d\xa4\x1f\x1b\x46\x63\x84\x7d\x81\xce\xd7\x6c\x6d\xe7\xfc\xca\x6c\x7d\x21\x7b\x93\x1a\xd6\x6c\x94\x87\x2f\x14\x67\xf8\x11\x5d\xad\x01\x87\x27\x34\x59\xb3\xf9\xc4\x09\x83\x5f\x67\xca\xb2\xf0\xf7\xfa\xcc\xfe\x6c\xa1\xe6\xeb\x33\xef\x33\x24\x8d\x75\xba\xf8\x78\xbd\xd7\x67\x74\x4f\xde\xe9\xb6\xd4\xdb\x07\xf0\x7d\x7d\xe6\x10\x78\x3b\x0f\xef\x19\xc3\xbb\x04\xde\xc9\xc3\xdb\xc6\xf0\x1e\x81\x77\xf3\xf0\x0e\x87\x57\x82\x7f\xae\xc8\x7f\x9a\x2f\xca\xa4\x07\x0e\x79\x0f\x1c\x96\xf6\xc0\x2a\xed\x02\xef\x29\x2b\x48\x18\x04\x9c\x17\x6c\xdb\x59\x03\xe1\x2e\xdc\x81\x27\x25\x6c\x5a\xed\x60\xa1\x83\x04\x74\x85\x4a\xdc\xaa\x95\xd8\xca\x5e\x14\xd0\x15\x2a\xf1\xaa\x56\xe2\xa8\xba\xda\x12\xd0\x15\x2a\xb1\x0b\x95\xd4\x95\x8a\xc3\x60\xb1\xb8\x83\xbd\x49\x65\x21\xd2\xef\x60\x22\x6d\x3d\x2a\xb2\x5c\x14\x62\x4d\x9e\xdc\x25\x69\x78\x8d\x26\xf1\x72\x81\xd2\xe8\x5a\x23\x5e\xb2\x77\xf5\xcd\x8e\x16\x86\xb3\x9a\x3e\x82\x0e\xc9\xce\xd8\x29\xb8\x1c\x86\xeb\x07\xbb\xbb\xe8\x6d\x3c\x8e\x26\x77\x98\x74\x3a\x2c\xaa\x51\xbc\x6f\x44\xf1\x21\x9c\x5b\xfc\xeb\x00\x7d\x3a\x6b\x42\x98\x0e\x4e\xf0\xbf\x27\xb1\xdc\x66\x34\x9f\xfc\x82\x92\x30\x5d\xce\xd7\x4c\x2d\x61\x64\x1f\x98\x22\x38\xf9\x85\xa4\x9b\x28\xb1\x0e\x59\xf1\x73\x1b\x0d\xd0\xc9\x2f\x67\xf6\x67\xa3\xd2\x0e\x29\xed\xe8\xa4\xb6\xa8\xcf\xb0\x90\x25\xcb\xe1\x4d\x08\x3e\x6e\x79\x1b\x22\x20\xa9\xd9\x6c\x62\xe2\x70\xba\x73\xd0\x20\xf8\x4a\x75\x78\xb4\x58\xdc\xf5\xf3\xe5\xb3\xb0\xae\xd2\xd3\xa1\x39\x05\x2e\xa7\xc0\xc9\x51\xe0\x94\x51\xe0\xe4\x29\x70\x6a\x53\x00\x8c\x6e\x46\x36\xd3\x34\x80\x2f\x72\xf3\x8a\xc6\x00\x8b\x87\xb1\xb8\x4c\x7d\x61\x2c\x76\x5e\x31\x95\x77\x24\x1d\x7b\x86\xe6\x08\xfe\x0e\x41\x11\xc3\x3a\x63\xc9\x29\x08\x87\xa0\x4b\xa1\x9c\x53\x56\xce\x25\xe5\x4a\x4e\x07\x38\x04\x65\x0c\xe5\x4a\x8e\x3a\x38\x3c\xf3\x0d\xe9\xeb\x18\xd2\xd7\x35\xa4\x6f\x8f\xd3\x67\xb6\xb8\x5f\x55\x5b\xaf\xa7\xb1\xab\x6b\xed\xd5\x59\x6a\xf9\x13\xd5\x06\xf9\xf9\x38\x7e\xaf\xc8\x68\xf4\xb6\x62\x36\x23\x53\x77\xb5\xaa\xc1\x6c\x54\xe3\x5a\xc3\x58\x3d\xf2\xd4\x35\x24\x52\x49\x09\xf8\x11\x46\x16\x18\x28\x18\x60\xa8\xf9\xe9\xac\xc3\x9d\x11\x72\xcd\xbd\x2e\x75\x9f\x7d\x84\x51\x07\x38\x5c\x8a\x63\xaf\x80\xa3\x63\x82\xc3\x25\x38\x7c\x8a\x23\x73\x8a\x38\xce\x72\x1c\x1e\xc1\xd1\xa5\x38\x32\x17\x91\xe3\x64\x38\x1a\x9b\x17\x76\x10\x97\x2f\xc1\x1c\x91\x69\xac\xb6\xe8\x47\xf0\x0b\xb0\x45\xc0\xbf\x88\x45\x00\x65\x8a\x2f\x55\x26\x41\x8b\x12\xfe\x04\x7c\xd4\x64\x70\x7c\x60\x32\x8a\x36\x43\x8b\xcf\x20\xeb\x12\xfc\xbd\x2d\xe6\x4c\xfa\x58\x27\x57\x92\x62\x00\xf3\x80\xf3\x2c\x5e\x4c\x5a\x2e\xba\x81\x5d\xe0\x1e\xea\xfa\xbb\x9e\x6b\xb6\x6d\xc2\x55\x01\x1f\x68\xca\x39\x75\xa9\xbf\xb4\xa2\x03\xd8\x03\xcd\xac\x4a\xc1\x7d\xa8\x2b\x80\xd7\xf3\xa6\x63\x93\xe9\x5a\x3d\xcf\xf4\xf0\x1c\xa4\x62\x80\x0e\x95\x5d\xa5\xf0\x43\x61\x09\x37\x98\x8e\x96\x53\x50\x62\xb3\xf0\x8b\x09\x89\xd8\x74\x0f\x50\x13\xff\xbf\x8d\xec\x5b\x7f\xec\xe1\x3f\xb4\x4d\x79\x31\x04\x49\x55\x18\xce\x43\xa2\x5a\x9a\xf8\x7f\x80\xa6\xc0\x1e\xda\x46\x4d\x82\x13\x46\x8b\xdd\x42\x3f\xa3\xe6\xe1\xb9\x70\xfd\x7f\x91\x83\x7a\xc8\x6e\x69\x91\xbb\x14\xb9\x4b\x90\x53\xdc\x3e\x45\xee\x14\x90\x3b\xd5\x90\x7b\x14\xb9\xb7\xd2\x6e\xa0\xdc\x2d\x20\x77\xab\x21\xf7\x29\x72\x5f\xca\x16\xaf\x80\xdc\xab\x86\xbc\x43\x91\x77\xa4\x6c\xf1\x0b\xc8\xfd\x6a\xc8\xbb\x14\x79\x57\xca\x96\x4e\x01\x79\xa7\x1a\xf2\x3d\x8a\x7c\x4f\xca\x96\x6e\x01\x79\xb7\x0a\xf2\x6c\x5a\x4c\xf0\xe7\x30\xed\x15\x31\xf5\x0d\x46\x10\xb8\x01\x17\x3b\x1b\x1f\xde\x00\x70\x01\x01\x4b\x9f\x60\x98\x6f\xd3\x61\xae\x2c\x0e\x83\x3a\x9e\x25\xe9\x62\x39\x4a\xd1\x65\x74\x71\x89\x82\xd9\x18\x4d\xe3\x2f\x28\x58\x5c\x2c\x61\xfd\x13\xd3\x91\xfc\x7b\x19\x2c\x54\xaf\x24\xf3\x6a\x03\x34\x40\x17\xb7\x7c\x5a\xd1\xd7\x97\x1e\x92\xd2\xc0\x49\x47\xcc\xad\xa0\xe5\x5a\x8e\xc4\x45\x98\x2c\xa7\x29\x84\x34\x9b\xd1\x07\x81\x31\xcd\x66\xb3\x79\x11\xa0\x2d\x74\x11\x90\x34\x14\xce\x5e\x0b\x6d\x23\x72\x6b\x48\x6f\x75\xf0\xad\x21\xbe\xd5\x6f\x20\x84\x10\x42\x08\xfd\x2f\x7b\x5f\xba\xdc\xb6\x0d\x2d\xfc\xdf\x4f\x81\xef\xce\xb4\x57\x8a\x19\x9b\x00\xf7\x28\xee\x8c\x22\xcb\x91\x26\xde\x3e\x49\x6e\x9a\xc9\x24\x19\x90\x04\x63\xf5\xca\x92\xaf\xa4\x34\x72\x6e\xd3\xf7\xf9\x9e\xe3\x7b\xb1\x3b\x07\x04\x37\x89\x0b\x28\xdb\xad\xdb\x2a\x76\x12\x2d\x67\xc7\x01\x08\x9c\x73\x00\xac\xff\xc7\xb5\x98\x84\x34\x53\x6a\x87\x61\x9b\x67\xe8\xf3\x8a\xfb\x13\x90\x4a\x00\xe2\x85\x52\x0a\xa0\x5c\xf7\x1e\x68\x0c\x05\x20\x65\x57\x00\xbe\x86\xc6\x3e\x02\x0d\x3f\xa2\xcf\x93\xfb\x0d\xeb\x95\x81\xda\x5f\xc4\xa0\xfe\x3a\x1c\xd4\x1b\x8d\xd7\xc9\xfc\x0c\xa6\x31\xaf\xa3\x0e\x82\xcd\x66\x53\x00\x98\x59\x00\xd1\xf7\x00\xa0\xa4\xd3\xfd\x22\xc6\xff\xd7\xe1\xf8\x0f\x74\xd4\xf4\x7c\xe9\x75\x34\xe2\xf3\xf9\xd2\x3e\x02\xc6\xa5\xe4\x88\x20\x47\x22\x72\x99\x58\xdc\xeb\xf7\x78\x43\xee\x4c\x44\x30\xe6\x57\x29\xb7\x26\xe4\xd6\x22\xb9\x49\x56\x6e\xb2\x26\x37\x2e\x97\x5b\x17\x72\xeb\x91\xdc\x99\x68\xe8\xeb\xf7\xda\x86\xdc\x99\x98\x6c\xcc\xaf\x52\x6e\x43\xc8\x6d\x44\x72\xeb\x59\xb9\xf5\x35\xb9\xb5\x72\xb9\x4d\x21\xb7\x19\xc9\x1d\xaf\x05\x42\x72\xc6\x86\xdc\x7a\x56\x6e\x5d\x52\x6e\x4b\xc8\x6d\x45\x72\x9b\x59\xb9\xcd\x35\xb9\x8d\x22\xb9\xbf\x4b\xa7\xce\xa2\x59\x61\x3c\x99\x5c\x40\x7e\x2b\x93\x90\x0a\x93\x7c\x61\xe5\x56\x40\x3d\x26\x9b\x4f\xdb\xb2\xdc\x23\xe4\xbb\x84\x2b\xd1\x53\x15\x16\xe9\xcc\x53\x74\x04\x6b\xe3\x86\x2d\x16\xf4\x33\x53\xa0\x70\x43\x41\x05\xd5\xe7\x09\xe5\xdb\x09\x05\x25\x56\x4b\x54\x48\x59\x1c\x32\xde\x48\x84\xa8\x20\x9e\x57\xad\x51\x96\x25\xfb\x14\x16\xed\xf6\xd8\xe4\x96\xcd\x1b\x69\xc8\x66\xfd\x12\x8b\x34\x7a\x49\xa1\x45\xf1\x6e\x47\x25\x9b\x99\x7f\xa1\xa9\x4a\x92\x92\x7f\xa1\x11\x45\x64\xea\x5f\x68\xd6\xf7\x0f\x8a\xf1\xaf\xba\x05\x2a\x65\x97\x5d\x39\xc6\xae\x1c\x63\x57\x8e\xf1\x40\xe5\x18\x52\x83\x68\x52\x82\xb0\x5e\x7c\xf0\xaf\x28\x3b\x90\x89\xc1\x6d\x1f\xe4\x7b\x13\x47\xee\xde\x64\x23\x77\x6f\xee\x1b\xb9\x7b\x93\x8d\xdc\xbd\x29\x8b\xdc\x45\x68\x12\x73\x97\xbc\x08\xf1\xae\x20\x63\x57\x90\xb1\x2b\xc8\xd8\x15\x64\xec\x0a\x32\x76\x05\x19\xbb\x82\x8c\x5d\x41\xc6\xae\x20\x63\x57\x90\xb1\x2b\xc8\xd8\x15\x64\xec\x0a\x32\x76\x05\x19\xbb\x82\x8c\x5d\x41\xc6\xae\x20\x63\x57\x90\xb1\x2b\xc8\xd8\x15\x64\xec\x0a\x32\x76\x05\x19\xbb\x82\x8c\x5d\x41\xc6\xae\x20\xe3\x31\x0a\x32\x1e\xa3\x14\xe3\x21\x8b\x30\x64\xca\x2f\xb6\x2e\xbc\xf8\x33\x4a\x2e\xf0\xae\xe4\x62\x57\x72\xb1\x2b\xb9\x78\x5a\x25\x17\x05\x65\x0f\x1d\xbd\xce\x11\x14\x05\x45\x0f\x1d\x3d\x55\xf1\xc0\xdf\x3c\x81\x72\x07\x38\xe3\x22\x8a\x32\xfc\x57\xd1\x61\x5f\x29\xe0\xe8\xa4\x38\xc9\xe2\x08\x7e\x4a\x52\xfa\x04\xb2\xf8\x6e\x80\xd6\x5e\x21\xa2\x38\x42\x13\x2d\xdc\xd9\xaa\x94\xfa\x30\x16\x7d\x58\x50\xe5\x52\xb6\x34\x22\x86\x59\xb9\x38\x4a\x45\xdf\xc6\xc5\xd4\xbf\x97\xea\x02\x07\x9a\x95\x27\xe2\xb2\xd2\x29\xe8\xd7\xfa\x42\x0a\x63\x83\x69\xa3\x13\x41\xc7\xe8\x87\xb4\xfd\x5b\xb2\xf8\x30\x03\x8b\x5a\xfa\x7d\x86\xe6\xe6\x6d\x12\x99\xaf\x37\x2e\x96\x28\x69\x63\xf8\x05\x35\x1b\xbf\xa2\x7d\x1e\x04\x44\xfb\x11\xff\x26\xfa\x81\xab\xbd\x27\x13\x3e\x2d\x85\x01\xa5\x96\xa8\x2a\xc8\x98\x6a\xe3\x61\xe5\xc9\x80\x00\x01\x5e\xb7\xad\x33\x74\xaa\xb2\xc7\x61\x57\x1c\xc7\x9e\x0d\x46\x52\x5b\xb5\xe2\xa0\xf7\x4d\x64\x44\x41\x5a\x1e\xa0\x8d\x4e\x90\x7f\x13\x65\x08\x60\x04\xa8\xcc\xcd\x14\x89\x16\x9f\x9a\x46\x0c\xb3\x3a\x40\xab\xd6\x0c\xce\xe6\xca\x5a\x38\x4e\x56\x8e\x91\x6b\x43\x4c\xbe\xa6\x51\xb7\x15\xed\x56\x0c\xf4\x6b\x0c\xf4\x6b\x41\xbf\x48\x67\x65\xb2\x09\x99\x42\xa2\x31\x18\xb4\x4a\xb1\xa7\xc4\x03\xcc\x94\xc3\xa0\x69\x98\xa5\x98\x96\x8f\x2b\xa0\x16\x2f\x44\xc0\x71\x97\x8c\xbe\x2a\xed\xca\xd5\x1d\xb8\xaa\xf3\xca\x75\x5c\xa9\x4e\x9b\x74\xd8\x42\x90\xac\x11\x7f\x07\xb6\x0d\x31\x24\x01\xb6\x50\xe7\x43\x72\xd9\xfa\xb4\xe4\x72\xf5\x82\xee\x7f\x78\x88\xae\xf8\xb1\xbe\xc8\x2b\x1b\x01\x92\xde\x5f\xf0\xb8\x49\x06\x85\x22\x2f\x12\x2b\x9a\x8c\x52\xad\x3d\x09\x31\xff\xee\xcb\xd6\x8e\xfe\xc0\x6b\xd6\x8e\xfe\x40\x0b\xd6\x8e\x5e\xb1\x5a\xed\xe8\xcd\x96\x74\x83\xf0\x7a\x88\x31\xf3\x1f\x6c\x5a\x7a\x3c\x9f\xdd\x66\xa6\xa6\xe2\x83\x41\x47\x2f\x9f\x95\x96\x9e\xf1\x9f\x73\xc6\x69\x8e\x54\x05\x8d\x5d\x78\x69\x88\x0f\xa2\x65\xaf\x0b\x89\x3d\x3d\xb9\xf6\x04\xa0\xe2\x0b\x43\x10\x76\x36\xb3\x7c\x95\x37\x86\x80\xf2\xa9\x0b\x10\x8a\x47\x49\xe0\xf5\xa2\x90\x47\xe1\x0d\x1f\x75\x67\xf4\x20\x4f\x84\x23\x9d\xe4\x87\x86\x94\x9b\x75\xc6\x05\xcc\xa0\x0e\xcc\x8f\x7f\x82\xe7\xc4\xf8\xf9\xf3\x32\x99\xe0\x67\xbb\xf9\xc1\xe6\x62\xb7\xf8\xd3\xef\x35\xfa\xc6\xdf\x68\xb0\x82\xb6\x79\xf0\x01\x8b\x13\x7d\xa8\x41\x4b\x0c\x03\xe5\x03\x17\x00\x6d\x13\x67\xeb\xe8\x7f\x46\x90\x8d\x3c\x48\x90\xed\x09\x46\xd7\xd6\x4d\xfe\x17\xc6\xc0\xfe\xc6\xd1\xac\xc3\x67\xfc\x21\xc3\x16\x6c\xfe\xdb\x5a\xc7\x6e\x78\x4d\x44\x54\x4c\x90\x7b\x87\x3a\xff\xff\xff\xf9\xf3\xb1\x87\xce\xd8\x62\x3a\x9e\x1c\xa0\xf6\x64\x82\xe6\xe3\xcf\xd7\xcb\x05\x64\x14\x01\xd7\x3f\xc8\x12\x46\x03\xe6\x8f\xe1\xc8\x70\xf7\x0b\x17\x01\x72\xa4\x70\xba\xf7\x78\x8a\x16\xb3\x2f\x73\x8f\xf1\x94\xa4\x3b\x9e\xd2\xf9\x1d\x0c\xc4\x37\x0b\x45\x1c\xee\x39\xe7\xff\xcf\xbe\x2c\xc1\x2d\xc6\xc1\xd8\xe3\x4f\x50\x05\xd1\x39\x83\xab\xb0\x6e\xc6\x4b\xb8\xe8\xe9\x76\x3e\xfb\x6d\xec\xc3\x91\xa5\x70\x82\x29\x8c\x6f\xc1\x6c\x32\x99\x7d\x85\xfb\x42\xbd\xd9\xd4\x1f\x03\xd2\x82\x23\xdd\xb0\xe5\x8b\x35\xe1\x9e\xaf\x89\xb7\xe0\x5b\xd4\x43\xb9\xbc\x99\xcf\xd0\xcd\x97\xc5\x12\xba\x2c\x1d\xc3\x19\xa9\x0c\x51\x77\xf6\x1b\x7c\x75\x7b\xc7\xd5\x46\xd3\xd9\x72\xec\x31\x85\x07\x5f\xd0\x64\xbc\xe0\x29\xd5\x34\xdf\xa9\xbf\x26\x94\x3f\x5e\x78\x13\x3a\xbe\x61\xf3\x83\x2a\x59\xc6\xd3\xb4\x61\x22\x59\x6e\xe7\x33\xff\x8b\xc7\x1e\x5c\x1c\x24\x54\xf4\x67\xde\x97\xf8\x38\x55\x50\xe0\x70\x36\x17\x47\xad\xde\xd0\x25\x9b\x8f\xe9\x64\x91\x98\x1d\xda\x28\x44\x4b\x89\xbe\xee\x03\xa3\x5e\x7f\x88\x86\x17\x27\xa3\xb7\xed\x41\x17\xf5\x87\xe8\x72\x70\xf1\x73\xff\xb8\x7b\x8c\x5e\xbd\x43\xa3\x5e\x17\x75\x2e\x2e\xdf\x0d\xfa\xaf\x7b\x23\xd4\xbb\x38\x3d\xee\x0e\x86\xa8\x7d\x7e\x8c\x3a\x17\xe7\xa3\x41\xff\xd5\xd5\xe8\x62\x30\x44\xff\xd1\x1e\xa2\xfe\xf0\x3f\xf8\x17\xed\xf3\x77\xa8\xfb\xcb\xe5\xa0\x3b\x1c\xa2\x8b\x01\xea\x9f\x5d\x9e\xf6\xbb\xc7\xe8\x6d\x7b\x30\x68\x9f\x8f\xfa\xdd\xa1\x82\xfa\xe7\x9d\xd3\xab\xe3\xfe\xf9\x6b\x05\xbd\xba\x1a\xa1\xf3\x8b\x11\x3a\xed\x9f\xf5\x47\xdd\x63\x34\xba\x50\x38\xd3\x4d\x34\x74\x71\x82\xce\xba\x83\x4e\xaf\x7d\x3e\x6a\xbf\xea\x9f\xf6\x47\xef\x38\xbf\x93\xfe\xe8\x1c\x78\x9d\x5c\x0c\x50\x1b\x5d\xb6\x07\xa3\x7e\xe7\xea\xb4\x3d\x40\x97\x57\x83\xcb\x8b\x61\x17\x81\x5a\xc7\xfd\x61\xe7\xb4\xdd\x3f\xeb\x1e\x1f\xa0\xfe\x39\x3a\xbf\x40\xdd\x9f\xbb\xe7\x23\xb8\x1a\xed\xf4\x34\x57\x4b\x90\x3d\xa3\xe3\xab\x2e\x3a\xed\xb7\x5f\x9d\x76\x43\x4e\xe7\xef\xd0\x71\x7f\xd0\xed\x8c\x40\x9d\xe4\x55\xa7\x7f\xdc\x3d\x1f\xb5\x4f\x15\x34\xbc\xec\x76\xfa\xf0\xa2\xfb\x4b\xf7\xec\xf2\xb4\x3d\x78\xa7\x08\x9a\xc3\xee\xff\xbd\xea\x9e\x8f\xfa\xed\x53\x74\xdc\x3e\x6b\xbf\xee\x0e\x51\xa3\xc2\x24\x97\x83\x8b\xce\xd5\xa0\x7b\x06\x32\x5f\x9c\xa0\xe1\xd5\xab\xe1\xa8\x3f\xba\x1a\x75\xd1\xeb\x8b\x8b\x63\x6e\xe8\x61\x77\xf0\x73\xbf\xd3\x1d\xb6\xd0\xe9\x05\x98\xfe\x04\x5d\x0d\xbb\x0a\x3a\x6e\x8f\xda\x9c\xf1\xe5\xe0\xe2\xa4\x3f\x1a\xb6\xe0\xf5\xab\xab\x61\x9f\x1b\xad\x7f\x3e\xea\x0e\x06\x57\x97\xa3\xfe\xc5\x79\x13\xf5\x2e\xde\x76\x7f\xee\x0e\x50\xa7\x7d\x35\xec\x1e\x73\xeb\x5e\x9c\xf3\x06\x1d\xf5\xba\x17\x83\x77\x40\x14\x6c\xc0\x8d\xaf\xa0\xb7\xbd\xee\xa8\xd7\x1d\x80\x41\xb9\xa5\xda\x60\x82\xe1\x68\xd0\xef\x8c\xd2\x60\x17\x03\x34\xba\x18\x8c\x52\x3a\xa2\xf3\xee\xeb\xd3\xfe\xeb\xee\x79\xa7\x0b\xd2\x5c\x00\x95\xb7\xfd\x61\xb7\x89\xda\x83\xfe\x10\x00\xfa\x21\xdb\xb7\xed\x77\xe8\xe2\x8a\xab\x0c\x6d\x74\x35\xec\x86\x2f\x53\x1e\xab\xf0\x96\x44\xfd\x13\xd4\x3e\xfe\xb9\x0f\x62\x0b\xe0\xcb\x8b\xe1\xb0\x2f\xfc\x84\x9b\xac\xd3\x13\xe6\x5e\xeb\xdb\xcf\x0e\x0b\x93\x07\x67\x74\x79\x5d\xf0\xcc\x29\x0e\xca\x3c\x58\x02\x21\xbe\x1f\x21\xce\x1e\xc4\x9f\xe4\x23\x84\xb7\x20\xc6\xd0\xe1\xdb\xad\xb3\x0c\xa2\x84\x86\x4e\x97\x0b\xb4\x84\xe3\xb6\x73\x09\x7d\xfa\x36\xc9\xbd\xd7\x69\x03\x18\x7e\x55\x05\x21\xac\x20\x44\x14\x84\x34\x05\x21\x5d\x41\xc8\x50\x10\x32\x15\x84\x2c\x05\xc1\x2d\x05\xc8\x51\x10\x56\x15\x84\xb1\x82\x30\x51\x10\xd6\x14\x84\x75\x05\x61\x23\x7f\x07\x12\xa0\xc1\xd7\x40\x0e\x50\x80\x85\xc9\xc1\x39\x0b\x20\x01\xc1\x6a\x20\xcb\x59\x11\x41\x0e\xc4\xb0\xf3\x49\x6a\x42\x02\x5d\x48\xe8\x08\x72\x76\x4a\x7a\x4b\x90\x35\x85\x84\xf0\xb9\xc1\x25\xce\x25\x89\x05\x99\x48\x42\xf8\x6b\x0b\xe9\x22\xe9\xe1\xaf\x15\xb2\xc2\x69\xc3\x14\x90\xd4\x05\x19\x43\x28\x67\x09\x72\x24\x25\x3d\x16\x64\x6d\x21\xa1\x30\x0c\xd6\x3e\x34\x5b\x05\xad\x39\x97\x6f\xcd\x48\xd0\xc8\x16\x8e\xe0\x8e\x53\x4a\x99\x59\xdb\x61\x35\x6e\x96\x5c\x92\xa6\x40\x17\xb6\x80\x96\x03\x07\x40\x46\xa2\x14\x36\xb2\xb6\xe3\x2d\x8b\x79\xcb\xe6\x92\xe4\xf0\x46\xca\x18\x96\x10\xdb\x4c\xda\x04\xd9\x59\xe3\x21\x35\x56\x21\x97\xa6\x2d\xd0\x53\x36\xe6\xfe\x6a\x08\x54\x23\x45\x4e\x13\x22\x5a\xb1\x0a\xf9\x62\x12\xd1\x36\x82\x35\x12\x1e\x05\xa2\xf1\xbe\x61\x26\xf4\x70\xd4\xf6\x82\x36\xc6\x85\xed\xb9\xa8\xd1\x3b\x31\x4e\x2c\x8c\xc9\x1a\xfb\xc8\x7b\x93\xee\x98\x74\x5b\xa7\xb8\x2f\x59\x0a\x87\x42\x76\xaa\x9f\x44\xd6\x30\xc4\xff\x42\xf3\xb8\x31\x2c\x01\x5b\xd4\xa0\x42\x8c\x88\x3d\x8e\xfa\xa8\x26\x44\xd7\x45\x83\x46\x30\x91\x3a\x20\x6a\xc1\x28\x12\x8f\x36\x91\xfa\x91\x8a\x8e\x30\x81\xb3\xd6\x29\x23\x0f\x28\xe9\xf3\xd1\xb0\x61\x08\xb5\x04\x9a\x50\x2d\x16\x2b\xb6\xa8\xf0\x43\xf8\x1c\x99\xa8\xb8\x45\x6b\xf4\x50\x21\x79\x64\xd9\xd8\x42\x51\x97\xb0\xc4\x5f\x31\x3e\x70\x31\x74\x21\x9e\x59\xac\x95\x96\x6d\x3c\x64\x67\x1b\x2f\xfe\x5c\xb8\x2d\x67\x09\x38\x05\xb7\xd0\xa6\xfc\x21\xea\x8c\xbc\x6d\xcd\xb0\x6d\x23\x23\xa1\xa8\x5d\x34\xf1\xbe\xac\x3d\x23\x0d\xd7\x34\x43\xe6\x5a\xe7\x27\xa9\xff\x23\xcb\x14\xb8\xb2\x68\x9b\x0d\x14\x3d\xdb\xb6\xb1\x5b\x24\x5a\x23\x8c\x79\x7b\x6e\x90\xe5\x0d\x7a\x0d\x5d\x34\xa7\x45\x45\x59\x25\xfc\x2a\x48\x5d\x19\x6d\x9b\x58\x8e\xe3\xc0\x6b\xb3\x7b\xec\x74\x5f\xb5\x31\xbc\xb6\x4f\xf0\xab\x57\x9d\xe3\x0e\xbc\x6e\x3b\x86\x76\x72\xac\x77\x0b\x9d\xe7\x7a\x5e\xc8\xcb\x50\xdb\xc4\x7e\xd5\x35\x81\x8e\xd1\xd1\x8f\x8f\x31\xd1\xe1\xb5\x79\x6c\xa9\x5a\xf7\x44\x83\xd7\x56\xdb\x3c\xb6\xcc\xae\xa3\xa4\x64\xfb\x50\x23\xf0\x35\xe8\x5f\x76\xcf\x8e\xb1\xa9\xf2\xdb\x99\xb7\x0a\x04\xc7\x14\x92\x50\x70\xea\xa3\x70\xca\x53\x1e\x0e\xad\x1b\xd3\x84\xf5\xda\xc1\x27\x2e\x70\x6e\xcf\x53\x57\xa6\xa5\x1b\x44\x53\x79\x73\x74\x4f\x3a\xc7\xed\x57\x36\x37\x91\x63\xbf\x6a\x1f\x77\x4e\xba\xf0\x1a\xab\x1a\x31\x74\x8b\x9b\xb7\xa3\x1d\x93\x2e\x3e\x51\x3f\xd4\xcc\x4b\xca\xa6\x4c\x73\x91\x6b\xec\xe6\xc9\xab\x43\xc0\x72\x19\xfe\xca\x74\x65\xf4\x03\x8d\x19\x4a\xfc\x09\x22\xbb\xc9\x86\x9b\xd6\x5e\x09\x16\x7f\xa8\x9d\x7d\x4a\x21\x46\x69\xe0\x4f\xa5\xf5\xe0\x29\xe5\x4b\x61\x52\xd4\x20\xad\x28\xb3\x39\x29\x25\x4d\x52\x9a\x99\xfa\xf0\x3e\x1b\x9f\xb2\xb4\xc5\xf6\xa7\x35\xda\x9b\x9b\xa0\x4a\x29\x17\xb8\xdc\x66\x24\xac\xa0\x55\x0b\x61\xa0\x65\x7a\x28\x0a\xd2\xf3\xfe\x22\x53\x7b\xc3\x47\xbf\x4f\xd7\x13\x29\xd8\x39\x87\x9d\xcb\xc0\xf2\x65\xc9\xa7\x6f\x52\x74\xbf\x71\xba\xdf\xa4\xe8\xf2\x09\xd5\xa7\x85\x14\xdd\x05\xa7\xbb\x88\xe9\x16\x02\x1f\x1e\xc2\xb8\xf2\x5f\x10\xf3\xf9\x8d\xce\xc7\xb0\xd8\x2a\xee\x3d\xa0\x1d\x9d\x28\xc8\x9d\x28\xc8\x9b\x28\xc8\x9f\x28\x88\x4d\x5a\xe5\xf0\x73\x05\xb9\x73\x05\x79\x73\x05\xf9\x73\x05\xc1\xb2\xb0\x10\x81\x82\xd8\x14\xf4\xec\x95\xee\x78\x77\x01\xce\x0d\xe1\x70\x09\x9c\x07\x70\x5e\x08\x47\x4a\xe0\x7c\x80\xf3\x43\x38\xad\x04\x8e\x2f\x71\x59\x08\xa7\x97\xc0\xc1\x16\x80\xe4\x7a\xc2\x42\x30\xb0\xe7\xb2\x55\x6b\x30\xb4\xf9\xff\xfb\x47\x08\x57\x8d\x87\x90\xfc\x6d\xd0\x09\xda\x4f\xc6\x96\xfd\x6f\x93\xf7\xe3\x0f\x1f\x9a\xbf\xab\xad\x52\x54\x08\x8d\x8f\x5f\x62\x33\x15\xfb\x2f\xfa\x59\xa2\xfd\x23\x84\x02\xdc\x70\x27\x8a\x37\x51\xfc\x49\x13\xed\xa3\xeb\x49\xe5\x79\x05\xdf\x93\x20\xfc\xf8\xa5\x46\x9a\x48\x9a\x15\x59\x67\x85\xeb\xb0\xd2\xed\x1a\xac\xb4\x75\x56\xa4\x0e\x2b\x53\xaf\xc1\x4a\x5f\x67\xa5\xc9\xb1\xfa\x9f\xc3\x43\xc1\xce\x56\x6b\xb0\x33\xd6\xd9\x95\x79\x73\xf9\x08\x1d\xf9\xda\xb2\xca\xab\x00\x08\xcd\x67\xcb\x49\x63\xa9\x2c\xc0\x11\x9b\xd5\xf0\x8d\xe5\x3e\x9b\x54\xfa\x2b\x1f\x31\xca\x06\x22\xf8\xe1\xdd\xd6\xaf\x00\xe2\x63\x00\x97\x11\xc6\x37\x5c\x75\x88\x03\x1f\x5a\xdc\x0a\x9a\xee\xa4\xa2\xbe\x26\x56\x96\xce\xd1\x7e\xaa\xb3\xce\x1f\xa9\xb3\x1a\x0d\x77\xae\x78\x73\xc5\x9f\xf3\xce\x3a\x7f\xc4\xce\xaa\xaf\xb3\x7a\xd4\xce\x9a\x65\xf5\x78\x9d\x95\xac\xb3\x7a\xdc\xce\x8a\xd7\xd9\xfd\xd9\x9d\x15\x1c\xb1\x89\xaa\x11\xa0\xb7\xce\xab\x7b\x2b\x7f\x88\xe6\x44\x87\xd3\x3f\xfc\x49\xeb\x57\x00\xf1\xc7\x36\x17\xd2\x9b\xcb\xf4\x56\x00\x77\x2b\x68\xba\xf3\xaa\xf2\xd5\xe8\xd5\xfa\x1f\x5e\x96\x9d\xba\xe3\x1b\xe6\xa1\xe1\xae\xbf\x42\x94\x25\xfc\x7b\x84\x1a\x30\x8b\x41\xfb\xc8\x83\xa7\xb5\x5f\x6e\xc1\x9e\xd8\xc2\x07\x13\x1a\x80\x06\x94\x0a\xa3\xf7\xc4\x36\x3d\x98\xdb\x00\x34\xa0\xd0\x2a\x14\x4d\xa0\xf0\x1d\x72\x7c\x1a\xe1\x56\xa1\xe8\x02\x85\x6f\x62\x74\x01\xc5\xab\x42\xe1\xfb\x1e\xd1\xb2\xf6\x62\xf4\x44\xdc\x19\x2f\xbb\x92\x96\x5a\x1c\xc2\x54\xcb\xa7\x4b\x1a\xaf\x24\xe0\x4d\xab\x12\x3c\x2a\xf9\x87\xd7\x95\xf3\x6d\x40\x9a\xbe\x1a\x2f\x17\xa3\xd9\x92\x3f\xb6\x42\x4e\xd3\x63\xba\xa4\x50\xdc\xbd\x80\xd2\xc9\x72\x9e\x1c\xfd\x94\x05\xcb\x88\x67\xb4\x65\x20\x44\x2d\xc4\x85\x72\x05\xdf\x47\xb7\xd4\xf7\xcb\x36\xf6\xc6\x2a\xbd\x4f\x18\xc1\x4a\xd2\xf8\x80\x7e\x3f\x82\xa8\x8f\x9a\x2a\xf4\x8c\x21\x7e\x40\x1a\x69\xb6\x24\x88\x36\x1a\x8d\x04\x6b\x1f\xc1\x24\x09\xa8\x3b\x4d\xa0\xaa\xc3\x03\x09\x87\x7e\xb4\x57\xb1\x42\x4d\x59\x31\x59\xfd\xa6\x3e\xdc\x6a\xf5\xbb\x41\x57\xac\x7c\xd7\xe8\xd6\x58\xf9\x56\x18\x25\xde\xef\x01\x2a\xc7\x56\x3a\x98\xf0\x6b\xfd\xc1\x18\xcd\x8a\xfb\xe8\x0f\x0f\x79\xe8\x09\x05\xd0\x23\xc2\x23\x3e\x8a\x3d\x3c\x74\xb6\xdb\x30\x92\xd3\xc8\x0b\x9f\xd5\xee\x30\x7c\x94\x8b\xdc\x18\xde\x14\xeb\x0b\xce\xdb\x43\x47\x28\xbd\x40\xdf\xab\x88\x99\x6c\x13\x30\x32\xea\xc6\x8b\x4a\xe1\xb8\xd4\x3c\xe0\xd3\x2b\xdf\xf9\x9f\x92\xba\x14\xa6\x17\x9f\x56\xd3\xcb\x44\x6e\x7a\xf7\x0c\xd9\xf4\x32\xb1\x9a\x5e\x61\x90\xa6\xb8\x81\x0a\x6a\xb3\x85\x62\x83\xb0\x44\x2d\x74\x34\x71\x7f\xba\xcf\x1b\xb3\x10\x4b\x54\xb5\x15\xbb\x45\xd1\x10\xef\x4d\x66\x53\xe9\xc1\x1d\x5a\x88\x23\x24\x61\x58\xfe\x56\xaa\xca\x32\x84\xfc\xb4\xe1\xc6\x07\xfc\x8b\xd2\x3e\x22\xb4\xe3\x80\x05\xea\x6d\x7c\xfa\x7d\xa3\xf2\x2f\xb3\x43\x23\xc0\x8d\x95\x82\xee\x14\xf4\xad\x48\x61\xc1\xb4\xd1\x58\xf1\x53\x98\xee\xf8\xbf\xdf\x9a\x79\x72\x7e\x2f\x63\x44\xa4\x19\x35\x56\xcd\x1f\x1b\x77\xfc\x2c\x8f\x46\xe3\x0f\x78\xf3\xad\xb9\xbe\xc7\xb4\x92\x9d\x56\x87\x1d\x70\xfa\x03\x58\x26\xca\x45\x60\x52\xcc\xf4\x5a\xcc\x7e\xe4\x2c\x80\x67\xe3\xae\xf9\x63\xe3\x0f\x78\x57\x9b\xa5\x21\xcd\x32\x6c\xb7\xc6\x5d\x13\xfd\x9e\xf0\xaa\xc5\x8c\xcf\x78\x57\xca\xb4\x8a\xd5\xea\xe5\xcb\x29\x6f\xb5\xd5\x4f\x3f\xfd\xd4\xd0\xc8\xf3\x69\x81\x5a\xb2\x49\x94\x68\xbc\x4c\x44\x11\xc5\xc3\xd0\x61\xee\x57\x3c\x7c\x4b\xe7\xf4\x06\xfd\x4f\x9c\xe3\xf8\x1d\xca\xc6\xa6\x9f\xbf\x23\x51\xf9\xcb\x6b\xda\xa3\xd7\xcb\x19\x1f\x76\xa4\x89\x0b\x83\x24\xd4\xbf\x73\x72\xb5\x68\xc0\x69\xcb\x63\xef\x71\x6b\xa1\xc5\x20\x14\x95\xef\x24\xf9\xa5\xc6\x7f\x0a\xd5\xff\xb3\xd9\xda\x8e\xc0\xd7\x48\xf5\x66\x4b\x2a\xe3\xd5\xc9\x4b\x6e\xad\x15\x37\x47\x00\xcd\xd6\xfd\x3d\xa8\x77\xd6\xee\x3c\x05\xff\x29\xa1\x04\x5b\x81\xc1\x6d\x16\xcc\x9b\xb3\x25\x6c\x73\xb9\xaf\x03\x82\xd2\x4f\xcb\x01\x6f\xa8\x97\xf6\x9f\xde\x0d\xf5\x12\x1f\x12\xe6\xe3\xc5\xf2\xd2\x6e\x94\x21\xb1\xe9\x4a\x37\xd4\xcb\x71\xa7\x08\x1d\x7e\xbe\x87\xb5\x70\xd5\x15\xf3\x11\x7e\x49\xdd\xbc\x28\x92\x87\xe3\x25\xb4\x5d\xe5\xfb\xae\xf2\xbd\xb8\xf2\x3d\xd5\xe0\x05\x06\x28\x5e\x1e\xfd\x73\xca\x30\xd7\xee\xd7\xc8\x25\xf4\xb6\xe6\x1d\x19\xc3\x5e\xfb\x39\xde\xba\x0a\x63\xd8\x6b\xe3\x64\x2f\x9e\x78\xf7\xc8\xb5\x17\x47\xfc\x70\xc6\xd8\xf4\x07\x70\x01\x40\x41\xd9\x53\xf4\x93\xad\xcc\x60\x81\xe7\x53\xd7\x76\x94\xbd\x12\x14\x5e\xb5\xe1\x52\xdf\x0b\x58\xa6\x6a\xa3\x02\xc7\xd3\x7c\xc2\x70\xa0\xee\x15\x40\xe4\x96\x73\x3d\x44\xa9\x47\x04\x5f\xd2\x35\x2a\x23\x01\xa9\xe5\xd6\xc3\x27\xaa\x2b\xb3\xc8\xd0\x63\xdc\xca\x1c\x32\x40\x79\x1c\xaa\x2c\xa1\x00\x50\x7e\x65\xfe\x18\xa0\x58\x9c\x3d\xbe\x6f\xfa\xb8\x30\x3f\x5c\x1d\xfc\x80\x47\x00\x80\x63\xb3\x0a\x12\x7e\xde\x86\x11\x8b\x28\x33\x05\xb5\x32\x1f\x8a\x0f\x0f\x5b\x4f\x7a\x54\x92\x8f\x36\xde\xbf\x7d\x3f\x46\xcf\x11\x1c\x11\x2e\x5e\xda\xc9\x4b\xac\xa7\x5e\x9b\x1f\x5a\x95\x44\x85\xcc\x8d\x29\x44\x58\x30\x5f\x06\x4d\x79\xec\x4e\xc3\x05\x1d\xa2\x64\xe9\x95\xb7\xf7\xbe\xd1\xa0\x40\xda\xe0\xa4\x29\x27\x4d\x2c\x7e\x1a\x1a\x43\xfb\xa0\xc0\x87\x96\x54\x13\x10\xf9\x54\x50\xa3\xe1\xa2\x1f\x91\xc7\x39\xfe\x01\x2f\x7d\xce\x4f\x5d\x19\x34\xac\xd0\x93\x4f\x78\xc1\x01\x07\x35\x18\xbb\xe8\x23\xf2\xd0\x47\xe4\x87\xfc\x4c\xe6\x3b\xcc\xa5\xb8\x16\x3f\x73\x3b\x45\xe1\x95\xcf\x55\xf6\x84\xc6\xcf\xa1\x1e\x50\x65\xba\xae\x11\x5d\x4a\x82\xc3\x67\xb1\x10\x90\x25\x7f\x76\xb8\x8d\xde\xc0\x55\x33\x1c\x5f\x63\x84\xde\xcb\x81\x60\x00\xf0\xcb\x29\xc0\x48\xe2\x95\x83\xc0\x90\xd4\x70\xc1\x03\x35\x55\xd8\x89\xbb\x60\x85\x73\xc3\x78\x57\x21\x3e\xbd\xcf\xf9\x2d\x75\x33\x60\x22\x05\x14\x65\x8d\x68\xc9\xa1\x84\xd9\xec\x17\x4f\x98\x95\x9d\x75\x9c\xcd\x7c\xf1\x64\x99\x57\x09\x1e\x65\xbd\x78\xa2\xcc\xaf\x04\x8f\x32\x5e\x3c\x49\xc6\x4a\xc0\x77\xa9\xac\x7f\x71\x2a\x0b\xd6\xaf\x07\xc1\x64\x36\x9b\xa7\x93\x4b\x87\x7c\x92\x27\x4a\x99\x1f\x8a\x1f\xbf\xff\x35\x61\x22\x9f\x7b\xda\x48\x3d\xfd\x55\x59\xa7\xed\xb3\x0c\xc9\x64\xb2\x5e\x1f\xfc\x67\xe7\x1a\xa2\x37\x55\xab\x32\x31\xa4\x6c\x04\xe6\x76\xa1\xdd\x47\x09\xed\xc2\xca\x75\xdb\xa8\x2e\xc7\xad\x1f\xd0\xcd\x2e\x96\xb3\xb1\x5c\xf8\xee\x21\xbc\x65\x17\xc6\x7d\xaa\x61\x5c\x68\xe1\xed\x23\xb8\xf9\xbe\x93\x04\x6f\x85\xff\xd4\x3d\xe5\x04\xd0\xe4\xc2\xb5\xfa\xbf\xe9\x34\xe0\xc5\x35\x25\x86\xf9\xa4\x42\xb9\x0a\x4a\xe4\xda\x85\x75\xff\xe4\xb0\xee\x46\xac\x36\xfa\x72\x2d\x32\x4a\x0c\x33\x13\x1b\xcd\x3f\x64\xb0\x68\x4c\xef\xb5\x9f\x13\xa2\xdf\x27\x32\x0b\xe8\x19\xfe\xfc\x7d\x28\xc8\xd3\x8a\xce\x7a\x58\x35\x1c\xe6\xdb\x10\x69\xd5\x4c\xcb\xf3\x0d\xd5\xe2\xaf\x55\x4b\xf5\x7d\xcc\x5f\x07\x96\xca\x0c\x47\xab\x8c\xda\x06\x81\xa7\xaa\xae\xc6\xf7\xe0\x99\xb6\x61\x63\x03\xf6\x92\xaa\x2b\x53\x0f\x1c\x3b\xa0\x9c\x96\xcb\x02\xaa\x07\x54\x7f\xc8\x28\x6d\xdd\x85\x63\x6a\x2a\x21\x9a\x24\x45\x24\x33\x7f\x2d\x24\x01\xe8\xc9\xaa\xe1\xf9\x51\xe9\x1a\x41\x0c\xf6\x25\x13\xf2\xdd\x8c\xf5\xef\x35\x63\x25\x44\xbf\xc7\x9c\x15\xb0\xb7\x9a\xb5\x66\x86\x91\x8d\x79\x2b\x21\xfa\x6e\xe6\xfa\x0f\x9f\xb9\x82\xeb\xdc\x67\xee\x9a\xeb\x41\x99\xd9\x6b\xe8\x45\x5b\xcc\x5f\x09\xd1\xab\x67\xb0\x4a\x32\x79\x7a\x61\x18\x30\x9f\x35\x76\xe5\x07\xbb\xf2\x03\xa9\xf2\x83\x7f\xf9\x49\x50\xfd\xf0\xb6\xfd\xf1\xb7\xf8\xe0\x39\x34\x9f\x7d\x99\xfa\xc8\xcb\x1e\x11\x95\x6f\x83\x5e\xc1\xbd\x07\xf0\xdd\x9b\xc2\xc2\x85\x28\xfd\xca\x12\x26\x1b\x40\xa9\x16\xca\x6b\x9d\x4c\xc1\xe6\x78\x71\x39\x1f\xdf\xb0\x46\x61\xc5\x66\x24\xd2\xe2\xbf\xe7\xcb\xf3\x28\x62\x0c\x6f\x1a\xd3\x35\x6f\xca\xcd\xff\x86\x5e\x8e\x8e\x10\x69\x09\x97\x44\x2f\x8f\x10\xe0\x9f\x47\x1f\xc8\xa5\x84\xff\x4f\x63\x8a\x7e\x10\x18\xcd\x2a\x84\xd4\x80\x18\xd0\xc9\xa2\x20\x2c\xb9\xd9\x0d\x6a\x24\x94\x04\xf9\xe5\xfc\x4b\x61\xd0\xb3\xdc\xf2\x9f\xd9\xf2\x64\x4e\xf9\x6b\x3a\x81\xb0\x78\x79\x1b\x08\x7e\x8d\xc6\x14\x2e\x6f\x98\x42\x3e\xa7\x09\x1b\x30\xd2\x91\xfa\x92\x24\xcf\xde\x5e\x59\x7a\xbb\xe0\xf2\x69\xfe\x35\xf7\x8f\xe2\xf3\xe9\xbf\x5e\x8f\x27\x0c\x35\x04\xd8\x4b\x54\xbe\x5b\x11\x5a\x32\x71\x39\x99\x76\x8f\x09\x4b\x6d\xb8\xec\xbd\x0f\xe1\x21\xd7\xb0\x69\x60\x18\xaf\x0e\x6e\x67\x5f\x1b\x53\x05\x61\x74\x88\xc8\xfa\x23\x41\xde\x33\xe0\xe7\x4d\x2d\x66\x5a\x6e\x31\x75\xfa\x27\xa4\xb6\xbf\xdf\xda\xc6\x1f\xa7\x45\x78\x9b\x3a\x88\xe9\x43\xf4\xf6\x4f\x28\xaa\x22\x86\x79\xaf\xc5\xfb\x66\xf0\xe0\x2f\x2a\xad\xea\x1d\x2c\x26\x63\x8f\x35\xd4\x66\xfd\x45\xf1\xae\x74\xe9\x4f\x29\x5d\x8a\x3e\x5d\xff\x03\x83\x59\xc0\xa1\x8c\x0a\xa8\xcf\x1c\xaa\xac\x92\x07\x68\x5d\x73\x5a\x56\x5e\x4f\x78\x80\x62\x29\x53\xee\x26\xf2\x27\x57\x2c\xf5\x99\xde\xdc\x50\x75\x15\x97\x4c\xe1\x32\x63\x6f\x22\xf2\x2d\xc7\x8d\x88\x08\x6c\x19\x0b\x8b\x98\xa2\x4f\x20\xa9\x0c\x95\x4c\xe8\x63\x25\x51\xf8\xcd\x90\xc2\xfa\x06\x29\x0c\x5b\xd0\x24\x49\xa5\xf1\xb4\xaa\xa1\x3c\xa3\x15\x4e\xcc\x51\xe6\xec\x9b\x78\x29\x6b\x60\x6e\x0d\x9c\xb2\x06\x16\x2a\x58\xf2\x2a\x64\x48\x69\x9b\xa4\x9c\x9a\xd6\x88\xf0\x72\xb7\x59\x14\x38\x9f\x68\xe7\x7d\x61\x10\x0b\xca\x53\x84\xb6\xd1\x67\x95\x45\x74\x25\x8f\xc1\xc8\x80\xde\x35\x37\x1e\x43\x3f\xa2\x80\xef\x6a\xfa\x03\x5e\xe6\x9e\xa1\x9f\xfa\x01\xcc\x1b\x0a\x57\xb4\x34\x28\xfa\x11\xb9\x1c\x13\x5e\x79\xfc\x95\xcb\x5f\x55\xe8\x0a\x34\x16\xe3\xcf\x37\x54\x4d\x2a\xf1\x34\x35\x5d\x8a\x07\x56\x8e\xbe\xc1\x4e\xea\x1b\xac\x65\xbe\xca\x20\x55\xce\x55\x62\xbe\x98\xf3\x65\x40\x82\x84\x97\xaf\x32\x4e\xdd\x14\xc4\xc3\x6f\x70\xea\x1b\x8c\xd3\x5f\x59\xe1\x7e\xe5\xf0\x2b\x62\x54\x4e\x5b\x80\xf1\x12\x98\x5e\xa3\xfd\x48\x82\x7d\xe4\xc1\xbb\x37\x50\xe9\x28\x53\x71\xc8\x69\x10\x74\x14\x59\x6e\x1f\xdd\xd0\xa2\x5b\x72\xa2\x3f\x30\x00\x7f\x2e\x27\x0b\x23\x79\xc9\xd5\xd4\xf0\x0b\x8f\x84\x8a\xf5\x01\x54\xc5\x35\xe0\xda\xf2\x25\x2e\x99\x62\xd7\x2c\x90\xcb\x59\xd4\x6e\x51\x0c\xd7\x58\x82\xad\x97\xa4\x42\xae\x5d\x61\xdc\x16\x85\x71\xf0\xdb\x13\xb7\x24\xf7\xc2\x5b\x92\x83\x4a\x70\x53\x80\xf3\x5b\x90\x3f\x57\x82\x5b\x02\x9c\x0f\x83\xd7\x25\xe0\x0f\x98\x6c\x29\x8d\xce\xac\x97\xdd\xed\xaa\xf4\x76\x55\x7a\xbb\x2a\xbd\x5d\x95\xde\xae\x4a\xef\xe9\x55\xe9\x11\xc3\xbc\x4f\xce\xd3\x30\xb7\xcc\x79\xa6\xa3\x2f\x9b\x39\x4f\xc3\x7c\x08\xaf\xd9\xe5\x3c\x9f\x72\xce\xd3\x30\xef\x97\xf3\x34\xcc\x8a\x8a\xbd\xd0\x8b\xb6\xd9\x6b\xbd\x51\x5b\x54\x52\xb9\x67\xfe\x9b\x2a\xf7\x56\xa6\xfe\x44\x2f\xe1\x8f\x25\xdb\x65\x45\x9f\x7a\x56\x74\x65\x42\xb5\x42\xe7\x60\x65\xe6\x6c\x6f\x03\x90\x5f\x4c\x5d\xdc\x67\xcc\x81\x0f\xf2\xaf\xb0\xdd\xf6\xb2\x9d\x7c\xbb\x0d\x7a\x17\x9f\x2e\x4e\x4e\x86\xdd\x51\xd1\x5d\xf2\x00\x75\xd9\xff\xd4\x3f\x3f\xee\xfe\xd2\x1d\xa2\x12\xa8\xc1\xc5\xd5\xf9\xf1\x27\xb8\x8f\x6a\xd4\x3e\x8f\xe9\xed\x15\x46\x76\x59\x22\xe2\x06\x50\xca\x27\xf2\xfc\x61\x8d\xce\xfc\x7a\x26\xf2\x00\x25\xa9\xdd\x48\x4c\x88\x28\x62\x05\xdd\x15\xe7\xe5\xe2\xa0\xf2\x32\x0c\x2a\x2f\x61\x3f\xa7\xde\x42\xcb\xf2\xa0\x72\xca\x96\xef\x57\x68\x1f\x19\xe8\x19\xba\xe3\x8b\xd4\xc6\x32\x3a\xf3\x8d\xbf\x22\x4d\x9e\x40\x43\x3f\x20\xb3\x6c\x9e\x0f\xc2\x4e\xd9\xd7\x5f\xd0\x11\xba\x43\x3f\x20\xa3\x55\x05\xf9\x0e\x78\x11\xf4\x0c\x01\x77\x0d\xb8\x37\xcb\xf1\xc0\x14\xc0\xa1\x18\xe2\x2e\x84\x78\xd7\xda\xab\x11\x1b\x49\xb5\xcc\xed\x18\x8d\xf9\x2d\xf9\xe5\xed\x12\x5b\x7c\x15\x5a\x7c\x15\x9e\xf7\xb6\x2a\x37\x78\x8c\x75\x17\x62\xdd\x85\x58\x77\xe5\x58\xf0\x93\x38\x74\xb6\xa5\xee\xd0\x3e\x6a\xe4\x98\x10\xb2\xc5\x25\x76\xfc\xbe\x97\xf3\xa1\x84\x75\xd6\x4a\x1e\x72\xc1\xa1\x6d\x4f\x4f\x86\x03\x50\x71\xa5\xe2\x56\xb9\xfd\x52\x69\x10\x52\x9d\x06\x01\x0c\x2e\x43\xd4\x11\xcf\x16\x5f\x8b\xbb\x45\x2e\xc6\x69\x84\x51\xdd\x4a\xbf\x86\xad\xf4\x2b\x7a\x89\xac\x16\xfa\xb5\xba\x95\x60\x1a\xc0\x55\xff\x91\xab\x5e\x05\x1e\xc9\xe7\x8e\x97\x97\xb3\x05\xbf\x93\x11\x3a\x04\x86\x15\xfa\xaf\x4d\xf4\x1c\x55\x6c\x6c\x8e\x58\xa6\xf1\x5f\x22\xb9\x83\x91\xe1\x77\xc3\x2e\x1f\x8f\x10\x67\x9e\x22\x58\x2d\x41\x76\x73\x73\x5a\x96\x9f\x8e\xb8\x30\xcf\x0e\xb7\x91\xe7\x2c\x25\x4f\x86\xea\xf3\xf2\x88\x4b\xb9\x8b\x57\xb8\x7a\x8e\xcb\x4f\xe1\xfa\x5e\x68\x54\xf9\x96\x97\x3b\x55\xf9\xf0\x10\x41\x0d\xc0\x78\x39\xfe\x8d\xa1\xdb\xd9\xe4\x6e\x3a\xbb\x19\xd3\x09\x9a\xfd\xc6\xe6\xe8\xf5\x49\x83\x34\x5f\xa0\xd5\x47\x1b\xed\xa3\xd5\x47\x93\xff\x6b\xf0\x7f\x75\x18\x9a\x2b\xa9\x8b\x1e\x18\x0a\x05\xd9\x24\x08\xfa\xab\x2b\x0b\x3f\x50\xfe\x4f\xd0\x3d\xaa\xf2\xd1\xef\x7b\x5b\x34\xc1\xda\x83\x19\x12\x0b\x47\xd1\x6c\x23\xba\x75\x66\xdd\x59\x94\x0d\x77\x2e\x70\x92\x7b\xd4\x48\x2c\xf8\xd8\xb0\x64\x37\xb7\xb3\x39\xdc\x08\xcb\x43\xe7\x9b\x0f\x08\xe8\xd3\xa3\x82\x79\x87\xc4\x3c\x21\x77\x6c\xac\x3e\x4c\x74\x94\x6b\xa6\x7b\x1b\xa1\x60\x2d\xdf\x6b\x3f\xd7\xee\x53\xe6\xa1\x65\x8a\x3c\x34\xc9\x12\x8f\xbf\xe0\x26\xfb\xd9\x97\xe5\xed\x97\xe5\x29\x8f\x73\x16\xa1\x21\xbe\xa2\xf7\xd9\x62\x3c\x67\x7e\xea\xca\x7b\x77\xbc\x5c\x44\x17\xd7\x86\x74\x8a\x62\x0f\x11\x9d\x8b\xe9\x24\x72\xab\xd4\x35\xc2\x74\xce\x5e\x20\x02\xb7\x51\x11\xc3\x54\x90\x66\xeb\x0a\x32\x30\x29\xa1\x23\xee\xd4\x7f\x01\x60\x05\x50\x25\x97\xea\x8b\xa6\x90\xba\x57\x3f\x6d\x9f\x62\x76\x0f\x76\xbf\x3e\xf4\x2d\x88\x86\xb0\x38\x26\x1a\xbd\x7b\xff\xa1\xfa\x89\x5e\xaf\x47\xc1\x0f\xa7\x1e\xf6\x2c\x28\x11\x8a\x7a\x17\x2f\x10\x6a\xb6\xb6\x19\xdc\xb8\xd4\x3c\x08\x3e\x1c\x7f\x03\xc1\x1b\xd8\x54\x55\xf4\x1c\xc1\x2c\x2e\xbe\xdf\x3f\x6d\x56\x98\x7d\x6b\xa4\x5e\x9c\xfa\x01\x6b\x90\x16\x75\x1b\xa3\xd8\x2c\x80\x30\x7d\x15\xe9\x7e\x4a\xa7\x3c\x9d\xb0\x66\x91\x43\x44\x5a\x7b\x85\x24\xa0\xe8\xda\x5d\xcc\xe6\x6e\xad\xd6\x5e\x63\x2a\xd5\xf4\x52\x26\x88\xb4\x3a\x23\x63\x94\x2d\xb7\x81\xe6\x94\x49\x85\x9f\x91\x31\xce\xc1\x84\xa7\x7c\x59\xbd\x51\x8d\xc3\xa9\xe1\x17\xe4\x93\xbd\xcf\x8b\x64\x2e\xf2\x22\xf7\xbc\xc1\x8b\x64\xae\xee\x22\x45\xc7\x41\x97\xd2\x6a\xb6\xaa\x94\xc3\x35\xb4\xc3\x59\xf5\xf0\x7d\xf5\xc3\x59\x05\xf1\x96\x1a\x96\x7e\x1f\xbb\x7d\x1c\xc8\x1e\x4f\x97\x33\xc4\xfb\x5b\x29\x22\xb8\xd8\x84\x42\x54\x2f\x1e\xcb\xca\x8d\x09\xc0\x07\xd7\xe3\xcf\xd7\xe8\xe3\x11\xb7\xac\x04\xf8\x64\xf6\x15\x09\xf0\xad\xc6\x44\x28\x47\x85\xd9\xdb\xa2\xba\x53\xf3\x59\x5e\x38\x8c\x87\x2f\xc1\xb7\xc4\x6b\xa9\x3e\x3d\xba\x66\x4b\x5a\x0a\xb4\xdd\xe2\x3e\xfa\x73\x78\x88\xce\xc6\x2b\xe4\xcd\x26\x5f\x6e\xa6\xdc\x3c\xe5\xa3\x47\xd4\x48\xd1\x5a\x56\x41\xc9\x1a\x35\xfa\xbe\x52\xd2\xda\x01\x85\x42\xf7\x48\xc2\x0b\xd5\xec\xe1\x27\x5a\xa8\xc5\x6e\x23\x89\x76\x9a\x42\x9b\xcc\xbe\x56\x63\x7d\xdf\xab\x04\x81\xd6\xad\x9a\x9c\xaf\xff\x01\x03\x8c\x20\x8a\x33\x7a\xbf\x92\x50\x79\xb4\xe2\x4a\xc2\x53\xeb\x6c\x21\x21\xf5\x68\x15\x76\x8e\x23\xde\xaa\xe5\xf0\xdf\xf7\x0a\xbe\x78\x18\xaf\x94\x7b\x98\x25\x36\x81\xe8\xef\xe8\x7d\x03\x1c\x42\xe7\x41\x25\x09\xf3\x84\x98\x38\xc1\xc4\x35\x31\xc1\x9b\x8e\xd0\x68\x85\x25\x7d\x49\xa0\x9d\xc6\x68\xdc\x97\x64\x3b\xe9\xe2\xcb\x9c\x0f\x1c\x70\xef\x60\xd8\x61\x6b\xf5\xd5\xd1\x4a\x17\x23\x25\x6a\x34\x84\xf0\xf1\x31\x7f\x42\x2a\x71\xd6\x5f\xb3\x25\x47\x38\x52\x44\x0f\xdd\x06\x6a\xf8\x04\xa1\x34\xe1\xb3\x5a\x84\x9f\xc0\x28\x91\x79\xa8\xc8\xf5\x9c\x8d\x87\x4b\x75\x07\xaa\xee\x44\x15\x83\x08\x3c\x87\xae\x67\xe8\x72\xbc\x27\x65\x4f\x10\xaf\xcf\xa3\xc5\x10\x07\x49\xbd\x0d\x17\xec\xf1\xfb\x47\xea\xa2\x99\x16\x89\x99\x49\xf6\x35\x80\x07\x37\xaa\x35\x72\x47\x88\xa7\x09\xa2\xd4\xd8\x0d\x78\xf3\xeb\xd9\x45\x98\xf2\x38\xca\x64\x1e\xd2\x82\x57\x12\x82\x06\x9a\x81\xbe\x92\x0f\x56\x08\x49\x26\x8c\xeb\x04\x47\x53\xdd\xbc\x11\xd9\xea\xe5\xcb\x44\x0b\xde\x15\x23\x5b\x40\x5f\x6c\x68\x04\x3d\x4f\x7d\xdf\x6c\xc9\xf3\x39\x4d\xf8\x9c\x16\xf1\x39\xdb\x9e\x4f\x36\x44\x1b\xa3\xd6\x0e\xd0\xae\xdb\x44\xc8\x9a\xa2\xf8\x1c\x2c\xbc\x29\xb2\xa9\x3f\x84\x69\xce\x2a\xd8\x9d\x6e\xcf\x4e\x72\x72\x31\xa7\xd3\xc5\xed\x6c\x21\xeb\x7d\x60\xaf\xd1\xe5\x18\x96\x9c\xf0\xb4\x78\x9f\xca\xe2\x24\x5e\x2f\xd1\x5f\x05\x8d\x9a\x33\x0f\x81\x54\x63\xfa\x21\x33\x36\xde\x8e\x11\x5d\xf2\x99\x31\x9f\x73\x96\xa2\x70\xfd\xa1\x34\x7e\x54\xba\x8b\x28\x02\xe5\xeb\x13\x35\x1e\xd1\xaa\x50\x46\x6a\x64\x10\x0e\xaf\x4a\x8c\x61\x23\x35\x9a\x8d\x09\x94\xea\xd9\x02\xa4\x02\xae\xc7\x8f\x38\x33\xbb\xff\xd3\xb9\xd6\x83\x23\x32\x76\xec\x80\xe8\x08\xc5\x4f\xf3\xd6\x9e\x04\x76\x8c\x1e\x99\x31\xe5\xcc\xf2\x04\x46\x71\xaf\xd8\x0a\x7b\x85\x63\xfc\x46\x6a\xa2\xd9\xac\x39\x2f\x09\x69\x91\x35\x5a\x64\x83\x96\x14\x31\x31\xa9\x84\x7a\x94\x9a\x93\xa2\x23\x34\x4a\x3a\x38\x6c\x60\x81\x39\x5f\xfc\xc1\x8f\x91\x88\xdc\xc3\x9b\x75\xa7\x4e\x11\xf1\x68\x42\x19\x13\xe7\x1f\x24\xc4\x27\xb3\xaf\x52\x23\x65\xf4\x6a\xbb\x31\xa4\x3f\xab\x58\x7d\x6f\x78\x97\xcc\xd0\x91\xc9\xfd\xa0\xa3\xf5\xd2\x8e\xf7\xfc\xfb\x0a\x3a\x49\x73\x7c\x3c\xca\x12\x94\x18\x59\x12\x73\x6f\x20\xc3\x18\xd3\xda\xab\x67\xcf\xbf\xf1\x16\x80\x08\xa6\xb0\x84\xff\xd1\x76\x00\x44\xa0\xeb\x7f\x00\x35\x0e\x2f\x43\xb9\xfb\x66\xcc\xf9\x19\xd2\xc8\xe3\x6f\x21\xc0\xf7\xdc\x41\x00\xd5\x66\x07\x1e\x1b\x4f\xb2\xb5\xfd\x18\x12\x04\x19\x05\xa1\xfc\x63\xed\x03\x2e\x07\x2f\x2a\x88\xf7\x33\xfc\x0d\x0b\xfe\x1f\x29\x27\x91\xce\xb6\x44\x3a\xe7\xa6\x62\xd0\x21\xb2\xe5\x49\x45\x09\x8e\xf4\x67\x21\xf9\xc3\xaa\x1d\x2b\xc3\xff\xfe\xc2\xd8\x37\x56\x08\x12\x15\x7a\x47\x3d\x30\x2f\xd7\xbc\x31\xc3\x48\x25\x44\x36\xc4\x7c\xf8\x94\x48\xad\xa8\xf3\x56\x4b\xd2\xa2\xe5\xe8\x43\x25\x4d\x12\x81\xa4\x52\x0b\x11\x78\x92\x5d\x88\x3e\xb9\x4f\x82\x21\x45\x55\xe4\x18\xd2\x54\x1f\x36\x91\x92\x18\x53\x5a\xe1\xd3\x0d\x85\x4f\x1f\x40\xe1\xd3\x0d\x85\x4f\xb7\x56\xb8\xf4\xfb\xa4\xab\x89\x51\x63\x39\x83\xed\x33\xf3\x31\xfb\x8d\x95\x6f\x24\x8a\x0e\x22\xe4\x3d\xf0\xe0\xf6\xcb\xe2\x3a\x92\xb3\xd9\xaa\x8b\x74\x56\x8a\xf4\xfd\x91\xf6\x40\xe5\x9c\x79\x11\xcb\xa6\x6c\x0e\x5a\xcd\x56\xad\xa9\xca\xc3\xef\x94\x92\x1a\xf3\xc5\xb6\xa9\x9c\x47\x40\x7c\x9c\x47\xbd\x91\xb2\x7e\xa1\x40\xf4\x32\xd9\xa9\x15\x01\xd5\x68\xd9\xdd\x1e\xae\x7f\xdc\x1e\x2e\xed\x1e\x3b\xb8\xb4\xed\xf6\x6f\x69\x9b\x7b\x6f\xe2\x7d\x37\xda\x43\x78\xcb\x6e\xef\xd6\x13\xde\xbb\xa5\xdd\x6b\xe7\x96\x56\xb1\x6f\x4b\xdb\x7e\xd7\x96\x56\xbd\x67\x4b\x49\x6f\x16\x7a\x61\xaa\x70\x5a\xa5\xf5\xef\xdc\xc3\x95\xf9\x78\x71\x4d\x0d\x4c\x9e\xf0\xc6\xae\xe8\x88\x76\x90\xf2\x9f\xb1\xc9\xab\xc0\x34\xc5\x2b\x21\xd9\x0d\x5e\x0f\xb8\xc3\x4a\x40\x26\xbb\xc1\x22\xf0\x92\xdd\x60\x1b\xbb\xb2\xa2\x2f\xd7\x6a\x75\x0d\x4c\x32\xd5\xba\x06\x26\x35\x9e\x1c\x50\x29\x6c\xdf\xeb\x3c\x77\xcd\xce\x9e\xe7\x1e\xbe\x0f\x05\x7b\xe4\x23\xe1\xd2\x66\x95\x39\xd2\x7d\xa3\x46\x54\x5d\x79\xae\xeb\x3a\xbe\xe1\x2b\xa9\xf3\xde\x9b\x4a\x1e\xa4\x49\x1c\x4a\x1c\x42\xd3\xa7\xc1\x37\x95\xba\xfc\x1c\x6c\x38\x2a\x36\x68\xfa\x1c\xf9\x7c\x7e\xd8\x20\x01\xf3\x7c\x3b\x7d\xca\x7c\x7d\x7e\xa6\xa5\x69\xc4\x34\x2d\x25\x75\x06\x7d\x3e\x3f\x9b\xb9\xba\x4e\x6d\x2b\x7d\x42\x7d\x7d\x7e\xbe\xab\x7a\x84\xa9\x7e\xfa\x6c\xfb\x7c\x7e\xba\xe5\x1a\xba\x8d\xfd\xf4\xc9\xf7\xcd\xa7\x73\xf4\xbd\x81\xc9\x41\x8a\xc8\x96\x47\xdf\x63\xb3\x04\x5a\x3c\x7a\x4b\x8e\xb9\xd8\xad\x21\xfe\x66\x6b\x08\xfb\x3e\x67\xdf\x6b\xf6\x96\x67\xdf\x67\x86\xdc\xcd\x95\x84\xbd\x3b\xfb\xfe\x9f\x7e\xf6\x3d\xb8\xce\xbd\x56\x13\x79\x1e\x94\x5d\x4f\xd8\x5b\x9e\x7d\xaf\xd9\xba\xdc\x7a\x42\xcc\x4a\x5f\x18\x76\xde\xea\xc2\xfe\x77\xae\x2e\xd6\x6d\xfe\x64\x16\x12\xbb\xc5\x43\xe9\xe2\xa1\xf2\x74\x88\xbf\xea\xb0\x87\x07\x59\x8a\x6c\x40\xc5\x16\x15\xe4\xc5\x10\x52\x38\xdf\x12\xe3\x44\x76\x3b\xe4\x01\xbd\xbd\x9d\xdc\x35\xc4\x87\x0a\xa2\xf3\xcf\x5f\x6e\xd8\x74\x99\x17\xdb\xfe\x5e\x7e\x48\x45\xf1\x59\xfe\x1b\xdf\xc0\xef\x9a\xd4\xea\x4a\x27\x36\x25\x81\xc3\x27\xdf\xbe\x45\x6c\xca\x08\x69\x2a\x9b\x70\x16\xd6\x2c\x5d\x77\xf8\x55\x4e\x44\x63\x81\x69\x78\x7e\x53\x91\xe3\xe1\x1a\x9e\x1a\xb8\x5e\x00\xb8\xcc\xd3\x7d\xcd\x25\x41\x1e\x0f\xe6\xb8\x86\xef\x52\x03\xe0\x6c\x6c\x3b\xbe\xeb\x7a\xb2\x3c\x34\xc7\x30\x3d\x62\xb8\x80\x1b\x68\xba\xed\x1a\x9a\x9d\xc7\xc3\x70\x02\x8c\x71\xc0\xf5\x70\x4d\xd5\xf0\x55\xec\xc8\xf2\x70\x88\x16\xd8\x84\xea\x80\x4b\x03\xec\xe8\x81\xe3\xe6\xf1\xa0\x2e\xf6\x0c\xe6\x73\x3d\x7c\x6a\xfa\x36\xc6\xb6\x2c\x0f\xdf\x56\x2d\x4a\xc3\xf6\xa0\x9a\xaa\xa9\x44\xcf\x6d\x0f\x4c\x6c\xcd\x70\x55\xae\x87\x6e\x58\xaa\x19\xb8\x4c\x96\x07\xd1\x35\x6c\x1b\x2e\xe3\xb8\x8c\xe9\x2e\xb1\xbd\x3c\x1e\x86\xa1\x7a\x96\xef\x69\x00\xe7\x1b\x41\xe0\xea\x8c\xc8\xf2\xb0\x88\xcb\x0c\xdf\xe2\xb6\x0a\x88\xe5\xda\x8e\x99\xdb\xe6\xb6\xea\x33\x17\x07\x5c\x16\xcd\xc5\xa6\x63\xba\x58\x96\x87\xe3\xfa\x9e\x6a\x86\x57\x8a\x11\xc3\xb3\x30\xd1\x8c\x3c\x1e\x1e\x76\xdc\x00\x87\xb2\x78\x81\xe9\x10\xd3\xd1\x65\x79\x30\xdd\x71\x4d\xc7\xe3\x76\x76\x58\x80\x75\xea\xe7\xb6\x07\x0b\x5c\xa6\x5b\xb6\x09\x70\x9a\xad\x07\xc4\x60\x9a\x2c\x0f\x35\xf0\xb0\xe3\x7b\x1c\xd7\x76\x6d\xcf\x37\xdc\x5c\x3d\x88\xae\x7a\x14\x7b\x1e\xc0\x59\x16\xf5\x1c\xcf\x34\x64\x79\x10\x9f\x39\xc4\x33\x79\x1f\x34\x1c\xe2\xaa\xc4\xca\xe5\xa1\x53\x4b\xb7\x75\xca\x97\xed\x26\xa3\x26\xd3\x6d\x69\x3d\x0c\xcf\x55\xa9\xe3\x73\xf9\x5c\x5f\xc7\x81\xeb\xeb\x79\x3c\x2c\x33\x70\x6c\xdb\xe7\x3c\x6c\x0d\x63\x43\x73\xa5\xf5\x70\x6c\x8d\x19\xd8\x20\x80\xcb\x98\x69\xfa\x01\xcd\xef\x83\xb6\x86\x3d\xd3\xe4\x8b\x6e\xe2\xbb\xba\x46\xb0\x2a\xcb\xc3\x55\x55\x8d\x58\x1e\xef\x83\x8e\x1d\xb8\x04\x6b\xb9\xbe\xeb\x06\x86\x63\x05\x9e\xb8\xd2\x8e\x05\x2a\x63\xd2\x7e\xe5\x99\x4c\x55\xdd\x80\xf7\x2d\xcd\xa7\xb6\x1d\x78\xb9\x7e\xe5\x1b\xd4\x72\xb0\xce\x79\x38\x9a\x4a\xa9\x45\xa4\x6d\xa5\x9a\x1e\x35\x35\x83\xfb\x2e\x53\x55\xcd\x26\xf9\x7d\x10\xeb\xc4\x21\x4e\x18\x3a\x51\xa9\xca\x4c\x66\x49\xdb\x8a\x58\xae\xa5\x52\x9b\x8f\x75\xba\xe9\x13\x12\x04\xb9\x63\x09\x61\x18\xec\xc8\x6d\x6a\x78\xc4\xf4\x1c\x62\xca\xf2\xd0\x7d\xe2\x99\x7e\xc0\xfd\xca\xa0\x9e\x4e\x28\xf3\xf3\x78\x18\x9a\x66\xab\x3e\xe6\x36\x75\x7c\xc7\x70\x35\x3f\x90\xe5\x61\x1a\x2a\xb5\x34\x83\x8f\x11\xb6\x4b\x03\x53\xf3\x59\x1e\x0f\xcb\x34\xa9\x4a\x5d\xfe\x9c\xd1\x3c\xcb\x72\x09\x95\x1e\xdb\x6d\xec\x11\xcf\x21\x7c\xac\xd3\x2d\xe6\x53\xc6\xcc\x3c\x1e\x0e\xb1\x08\xf1\x42\x9b\x62\xdd\x26\x9a\xa1\xb9\xb2\x3c\x28\x71\x03\x66\x53\xde\xe6\xba\x17\x60\x55\x33\x73\xfb\x20\xb5\x31\x35\x4d\x9d\xeb\xe1\x7a\x3a\xd1\x54\x55\x7a\xdc\xf5\x88\xee\xda\xae\xa5\x02\xae\xaf\x06\xb6\x63\x39\x38\x8f\x87\x67\x99\x9e\x81\x29\x6f\x0f\xd5\x34\x74\x97\x69\xd2\x7e\xe5\x63\x87\x30\x1b\x3b\x80\xeb\x9b\x2c\x30\x08\xce\x7d\x9e\xfb\xa6\xe3\xa8\x26\x9c\x49\x00\xcf\x2b\xd3\xa0\x8e\x7c\x3f\x0f\x74\x95\x69\x46\x68\x67\xc3\xb2\x30\x51\x09\xcd\xe3\x81\x55\x93\x52\x35\xd4\x57\x23\xae\xeb\x63\x57\xba\xcd\xb1\x43\x75\x0f\x63\x3e\xb6\xbb\xb6\x4f\x7c\xd5\xcb\xd5\x03\x33\xcd\x32\x3d\x35\xec\x1f\x58\xc7\xd4\x35\xa4\xc7\x5d\x62\xe9\xb6\x65\xe9\xbc\x7f\xf8\x81\xcd\x98\xeb\x38\x79\x3c\x34\xdd\x55\x5d\xcf\xe5\xfa\x32\xec\xb8\xba\x2d\xef\xbb\x9a\x83\x3d\xd5\x73\x79\x5b\x7a\x86\xe7\x18\xd4\xd4\xf2\x78\xe8\xcc\xb7\x29\xd5\xf9\xd8\xce\x34\x1d\xdb\xd4\x93\xf6\x5d\xc3\x75\x3c\x8f\xea\xfc\x19\x65\x59\xa6\xc6\x34\x2b\x97\x87\x69\x13\x66\x06\xe1\xd8\xe9\x9b\x2e\x71\x6d\x2a\x6d\x2b\x4b\xb7\x03\x9b\x30\xde\x07\x0d\x9f\x05\x2e\xc9\x1f\xaf\x2c\x9b\x1a\xa6\x16\x3e\x2f\x75\x0d\x5b\x24\x30\xa5\xfd\xca\xd6\x3d\xdb\xb2\x31\xf7\x49\x8a\x03\x95\xba\x56\xee\xd8\x6e\x7b\x9e\xa5\x92\xb0\xcd\x31\x35\x75\xcd\x61\xd2\xf3\x5d\x47\x75\x59\x10\x04\xdc\xce\x44\x33\x35\xcc\x48\xae\x5f\x51\xdd\x50\x4d\x8f\xf1\x7e\xee\x33\x9b\xb8\x3e\x93\x9e\xef\xba\x2c\x70\xa8\x16\xf0\xe7\x82\x4b\x3c\xd3\x72\x70\xee\x9c\xc1\x33\x2d\x6c\xd9\x41\xf8\x4c\xd6\x2c\x62\x68\x44\xba\xcd\x3d\x4a\x2c\x8d\x79\xbc\x3d\x18\x25\xa6\x89\x9d\xdc\xf6\xf0\xb1\x6d\xba\x76\xf8\xac\x25\xe0\x8a\x44\xb5\x64\x79\x30\xea\x53\xcb\xf7\x79\x1f\xf4\x7c\xa6\x32\x17\xe7\x8e\xed\x81\x61\xf9\x7a\x60\x05\x62\x6e\xc1\x7c\x6c\x49\xf7\x0f\xd5\x0c\x54\xd3\x0a\xe7\x4d\x16\xc1\x96\x19\xb8\xb9\x63\x89\x4a\x4d\xcd\xf2\x3d\xde\x07\x29\xf1\x6c\xc7\xa6\xd2\xcf\x41\x8c\xb5\xc0\xb1\x55\x5d\x24\x12\x1c\xd5\xa7\xb9\x7a\x60\xd7\xc2\xaa\xab\x71\x1e\x58\xc3\x9e\x6e\x61\xe9\xf6\x20\xb6\xef\x5a\x56\xc0\x71\x89\xa6\xea\x96\x6f\xe7\x3e\x3f\x34\xe2\x51\xea\x5a\xdc\xaf\x74\xd5\xb3\x88\xee\x48\xf7\x41\xcd\x73\x98\xcb\x54\x6e\x2b\x6c\x78\x8e\xcb\xdc\xdc\x36\xd7\x35\xec\x9b\x96\xc7\xf5\x75\x3c\xac\xaa\xbe\x2e\xdd\x3f\x74\xcf\x33\x7c\x3d\x5c\xe3\x78\xae\xc6\x74\xe2\xe6\x3e\x6b\x61\x06\x47\x1c\x87\x8f\x9d\x81\x67\x1a\x16\x23\x54\x96\x87\x11\x78\xae\x19\x50\x6e\x03\x8d\xfa\x66\x40\x59\xae\x1e\xa6\xa7\xeb\xd8\xb1\x39\x0f\x9d\xea\x96\x61\x63\x6b\x33\x0a\xf4\x41\xee\xec\xa7\xd2\x0b\xb2\xa2\xcf\x24\x03\x40\x45\xb5\x66\xb6\x5a\x59\x6b\x26\xae\x56\x59\xd3\x35\x27\x7e\xf1\x50\xc7\x3c\x41\xf6\xf7\x1e\xc9\xdb\x9c\xe4\x71\x52\x57\xf2\xe4\x93\xb7\x26\x55\x1d\x16\x25\x37\x35\xd7\xf3\x1c\xb5\x20\x79\xeb\xba\xa6\x45\x59\x38\xa7\xb1\x75\x8f\x52\xab\x60\xee\xf8\xbf\xec\x7d\x7d\x73\xdb\xb6\xba\xe7\xdf\xeb\x4f\x81\x73\x66\x6f\x2c\xd5\xb2\x0c\x90\x14\x05\x45\x76\x7b\x12\x27\x9e\xf4\x26\x69\x33\x49\xba\xc9\x4c\x4e\xda\x52\x24\x64\xb1\x91\x49\xad\x48\xd7\xce\x6d\x7c\x67\xbf\xc6\x7e\xbd\xfd\x24\x3b\x0f\x08\x90\xa0\x44\x02\xa0\xec\x24\x3d\xf7\xd6\xf6\x24\x7a\xf9\xe1\x79\xc7\x03\x10\x04\xf1\x68\xf9\xb9\xa1\xcf\xe6\xee\x98\x67\xf4\x39\x9b\x78\x73\xea\xcc\x9a\xf9\x05\x23\x6f\x3e\x1f\xb9\xbc\x2f\x8f\xe6\x24\x72\xfd\x39\xe9\xce\x6f\x44\x30\x1b\x39\x45\xe6\x0d\x22\xe6\x53\x27\x22\xcd\xfc\x26\x33\x3c\xf2\x69\xd1\x97\x9c\x99\xcb\xfc\x90\xcc\xbb\xf3\x23\x73\xea\x46\x93\xa2\xe7\xce\x67\x1e\x99\x45\x7e\x8b\x7e\xa3\x19\xc3\x61\x54\xcc\x43\x89\x3b\x66\x0e\x19\x4f\xfa\x7b\x2d\x9c\x76\xb9\x79\xfb\x65\x8e\x47\xea\x58\xa3\x8d\x37\xc1\x56\x75\xd7\x9e\x10\xab\xc2\x6b\x4f\x1c\xab\xca\x6b\x4f\x5c\xab\xd2\x6b\x4f\x3c\xab\xda\x6b\x4f\x46\x56\xc5\xd7\x9e\xf8\x56\xd5\xd7\x9e\x8c\xcd\xe5\xd7\x38\x0e\x43\xcf\x7f\x62\x7a\x10\xb4\x80\x2e\x0b\xa8\xf6\xf1\x75\x8e\x24\x9c\xa8\xe9\x3c\x8a\x02\xba\x2c\xa0\x66\xa2\x0e\x27\xea\xd8\x10\x75\x38\x51\x47\x7f\x48\x0a\x67\xef\x72\xa2\xae\x0d\x51\x97\x13\x75\x2d\x88\x7a\x9c\xa8\x67\x43\xd4\xe3\x44\x3d\x0b\xa2\x23\x4e\x74\x64\x43\x74\xc4\x89\x8e\x2c\x88\xfa\x9c\xa8\x6f\x43\xd4\xe7\x44\x7d\x0b\xa2\x63\x4e\x74\x6c\x43\x74\xcc\x89\x8e\x0d\x8f\xa0\x74\xaf\xc2\xc8\x25\xc0\x06\xee\xbc\xa6\xce\x13\xac\x29\xe2\x02\xea\xcc\x38\x2d\x62\xa0\x35\xe3\xb4\x88\x81\x56\xc8\x69\x39\x06\x5a\x21\xa7\xe5\x18\x68\x45\x9c\x96\x6b\xa0\x15\x71\x5a\xae\x81\x16\xe3\xb4\x3c\x03\x2d\xc6\x69\x79\x06\x5a\x73\x4e\x6b\x64\xa0\x35\xe7\xb4\x46\x06\x5a\xfc\x31\xdf\x27\xbe\x81\xd6\x39\xa7\xe5\x1b\x68\x2d\x38\xad\xb1\x81\xd6\x82\xd3\x1a\x2f\xa7\x77\x72\xf0\x56\xb7\x69\xea\xc6\x20\xa9\xc5\x01\xf5\x37\x40\x1e\xe6\xb6\xd3\x3d\x13\xcd\xc7\x7c\xd2\x28\x37\x72\xdc\x61\xc9\xcc\x42\x0e\x30\xed\x9b\x58\x3e\x97\xad\x1c\xcb\x17\xa3\x6f\x90\xf3\x9e\x23\xb5\x95\xbe\xe4\x6f\x41\x6e\x59\x90\x13\x4f\x62\x6f\x92\x83\x07\xd7\xef\xb2\x2c\x27\xdc\xf5\xe6\x45\x17\xed\x2b\x4f\xe2\x6b\xb4\x6b\x05\xcf\xeb\x45\x59\xe4\xf1\xda\x90\x21\x1b\x1a\x2f\x95\xc6\xda\x44\xbc\xdd\x76\x51\xab\x1d\xba\xe0\x87\x8c\x90\xaa\xc6\x25\xbe\x5e\xc2\x93\xb6\xae\x2c\x75\x58\xc3\xd1\x2d\x1c\x7f\x50\xed\xe7\xf2\xb3\x85\xa8\x3c\xda\x45\xa0\x65\x4d\xa0\xe5\xb6\x40\x8b\x26\x81\x96\xdb\x02\x2d\x54\x81\xea\xb8\xf1\x36\xce\x5c\xb0\x51\x0d\x0a\x62\xaf\x10\x51\x82\x42\x37\x75\x6c\x68\x58\xc6\x04\xe9\x1c\x13\xa4\x8a\x09\xd2\x35\x26\x88\x1a\x13\x44\xc6\xc4\xa4\xb2\x19\x10\x3f\x3e\xae\xea\x6e\x96\x40\x70\x4c\x1d\x06\xd6\x76\x26\x4a\x50\x48\x7a\x9b\x85\x6a\xf4\x12\xa9\x41\x21\xc9\xd6\x25\x5a\x34\x49\xb4\xdc\x92\x68\x51\x93\xa8\xc2\xc1\xa7\xfe\x16\x3d\xc7\xb7\x8d\x8a\x4e\x65\x5a\xad\x14\x7f\x13\x8f\xab\xc0\x19\x5b\x06\xce\x9b\x98\xcf\xb2\xde\xc4\xa6\x69\xd6\x46\xa3\xa5\x68\x64\x7e\xe6\xb7\x6a\x44\xfc\x4a\x3e\x63\xed\x59\xb5\x59\x21\x21\x31\x4d\x2f\x37\x9b\x2d\x65\xb3\x2e\x42\x56\x99\x71\x89\x0e\xb8\xa6\xb6\x0c\xab\x84\x0c\x25\x59\xb9\x61\xa1\x40\x04\x90\x84\x60\xc1\x7d\x74\x2c\x82\x05\x97\x9f\x7c\x87\x08\xba\x8f\x70\xdf\x96\x07\x48\x07\xff\xca\x20\xe9\x24\x1c\x88\x28\x1b\xb6\xca\x46\xee\x40\x36\x30\xfa\x0e\x92\x41\xb3\x26\xb9\xe0\xf3\x06\xa9\x8c\xf4\xab\x99\xc4\x9b\xd8\x22\x6c\xaa\x99\xc2\x9b\xd8\x20\x7d\xc3\xfe\x24\xf5\x17\x7c\x15\x2e\x44\x65\xe4\x05\xba\x87\xe6\x0b\x51\x1b\x19\xde\x18\x8f\x91\x29\x9a\x17\x19\x8c\x2d\xa1\xf9\x52\x34\x87\x37\xe7\x4b\x8b\xe6\x17\xc1\x6f\xa0\x77\x2f\x00\x86\xb3\x82\x3b\x7f\x1d\x16\xaf\x67\xe2\xb5\x15\xa5\x25\xa7\x04\xbc\x67\x85\x20\xfc\x75\x58\xbc\x9e\x89\xd7\xd3\x3d\xbb\xaa\xc9\x7c\x9d\xa0\x07\x82\x81\x43\x9d\x62\x00\x0e\x78\xde\x85\x91\x17\x48\xc2\x97\xa2\x98\xb3\xf8\xb2\x56\xce\x59\x0e\xbf\xca\x77\xe3\xcd\x9d\x94\xed\xec\x97\x05\xfb\x65\x8d\xfd\xa2\xce\x7e\x59\x63\xbf\xa8\xb3\x5f\xaa\xec\x17\x1d\xd9\xf3\x05\x8d\x5e\x8f\x89\x21\xb2\x78\xc4\x9e\x71\x92\x45\x8d\x74\xe5\x4b\xaa\x7e\x29\x67\x25\x8c\x8b\xea\xb8\xf2\x3b\x40\x4e\xec\xd9\x17\xda\x8b\x76\xa2\x50\x3b\x5b\xd4\xd9\x2f\x6b\xec\x17\x75\xf6\x4b\x95\xfd\xa2\x62\xaf\xe5\x7f\x74\x64\x51\xb8\xda\xa8\xc1\x53\x7e\xca\xf7\x53\xab\xe3\x2c\x9e\xf2\xb4\xf2\x34\xb6\x18\x31\x0a\xf8\xb2\x80\x9b\x47\x0a\x80\xe7\x04\xe0\x8b\x65\xa9\x8e\x21\x5d\x14\x6d\x40\xa2\x45\x65\x02\x78\xd5\xeb\xe5\x55\xbe\x3d\x46\xbd\x45\x43\x9a\x93\x44\xf4\xc2\xc0\xbf\x50\x0c\xdc\x5a\x12\x90\x07\x1a\x34\x89\x11\xde\x56\x8e\xa7\x71\x47\x39\x9e\xc6\x4d\x72\x3c\x8d\x6f\x29\xc7\x9b\xae\x72\xbc\x69\x94\xe3\x4d\x93\x1c\x5a\xba\x47\x47\xdb\x65\xd6\xcd\x82\xf0\xe5\x46\x99\xa6\x78\x69\x76\x1b\xf1\xf9\x7a\xa6\xcc\xad\xbc\x95\xd0\xc1\x51\x75\x90\x64\x3b\xeb\xf1\xd3\x2a\x82\xe3\x1c\xae\xac\x17\xce\xe0\x8f\x2f\x92\x98\x7a\x1e\x5f\x23\x39\x37\xa8\xc8\x97\x6e\xe6\x06\x4a\x7c\xe5\x66\x6e\xa0\xc4\x17\x94\x98\x81\x12\x5f\x4f\x62\x06\x4a\x7c\x01\xab\x17\x81\x8f\x72\xb2\x34\x14\x1f\x87\x3f\xbe\x2e\xd6\x8b\xc0\x2f\xb9\xe8\xf8\x22\xcd\x16\xce\x89\xb6\xfc\x62\x41\x94\x2f\xdc\x85\x06\x7d\xf8\xba\x5d\x68\xd0\x87\x2f\x27\xce\x0c\x94\xf8\x6a\xe2\xcc\x40\x89\x2f\x72\x06\x06\x4a\x7c\x8d\x53\x57\xf3\x1a\x7e\xf9\xa2\x2a\x4f\x8f\x50\x77\xdf\xc6\xc8\x01\xf0\xee\x15\xf6\xcd\x1d\xf8\xb7\x1c\xe7\x0b\x2b\xe7\xa4\xab\x99\x35\xf3\xbc\x5d\xea\xf9\x2b\x37\x3e\x60\x28\xeb\xc1\x9d\x90\x03\x14\xe8\x26\x74\xe2\x8e\x4a\x81\x06\x9d\x02\xf8\xa7\xc7\x9b\x56\x9a\x05\xdb\x8a\x69\x48\x2a\xb7\x4a\x0a\x31\xb8\x91\x67\x5a\x31\x48\x25\x06\x37\xf0\x4c\x88\x51\xcb\x93\xb3\x4e\x62\x28\x37\x57\x0a\x31\x1c\x10\x23\xd4\x8a\xe1\x54\x62\x70\x0f\x87\x42\x8c\x5a\xaa\x0b\x3b\x89\xa1\xdc\x8e\x29\xc4\x70\x41\x8c\x48\x2b\x86\x5b\x89\xe1\x82\x04\x91\x10\xc3\x35\x74\x6a\x0d\x49\xe5\x06\x4e\x21\x86\x07\x62\x30\xad\x18\xe2\x04\x74\x8e\x06\x09\x98\x10\xc3\x53\xc5\x60\x9d\xc4\x50\x6e\xf9\x14\x62\x8c\x40\x8c\xb9\x56\x8c\x51\x25\xc6\x08\x24\x98\x0b\x31\x46\xaa\x18\xf3\x4e\x62\x28\x37\x89\x0a\x31\x7c\x10\x43\x7b\xe9\xf3\xc4\xaf\xc4\xf0\x41\x82\x73\x21\x46\x75\xed\x08\xd7\xdf\x9d\xc4\x50\x6e\x2b\x15\x62\x8c\x41\x8c\x85\x56\x8c\x71\x25\x06\x5f\x01\x10\x33\xac\x27\x63\xfd\x44\xaf\xfb\x4d\xf4\xae\x4f\x40\x7f\xe9\xc3\x28\xbf\xe2\x69\x94\x9f\xfb\x30\x49\x8a\x6f\x79\x9a\x64\xfd\x0c\x49\xb8\x0c\x05\xf2\x04\x82\xe3\xb8\x38\xfd\xd6\xc5\x70\x9c\x15\x9c\xea\x32\x9c\x2f\xd3\x74\xdd\x53\x2c\x76\x04\x7b\xc8\xb0\xf8\xb9\x3b\x8e\x04\x38\x56\x6c\xf4\x74\xff\x94\x47\x54\x9e\xa6\xc9\xef\x6c\x9d\x17\x83\x71\x9e\x22\xd7\x39\x9c\xc5\x39\xba\x4a\xd7\x11\x0a\x60\x2b\x12\x9a\xb1\x79\xba\x66\xe2\x49\x56\x5d\x08\x28\x4f\x71\x2b\x7b\x48\xf2\xf4\xad\xeb\x98\xa4\xd8\xfd\x54\x38\xcd\x61\x01\x5f\xec\xa4\x37\x09\xde\xfc\x11\x87\xbb\x6d\xdb\xa4\x3c\x69\xcd\xa4\x9d\xee\x3c\xb5\x16\xf5\xca\x83\x54\xef\x23\x82\x1d\xef\xc8\x75\xfe\x3a\x34\xe1\x5f\xeb\xd0\x84\x11\x71\x6e\x71\x68\x02\xb4\xde\xe9\xd0\x84\xda\x56\xc7\xad\x43\x13\x46\xc4\xb9\x8b\xa8\xf9\xeb\xd0\x84\x3f\xf1\xa1\x09\x10\x3a\xb7\x39\x34\xa1\x31\x82\x6a\x87\x26\x14\x51\xd4\xb0\xc3\xb7\xf6\x99\x30\xe3\x46\x54\xdb\x1d\x9a\xb0\x79\x4c\xc2\xe4\xbf\xd3\x31\x09\x2c\x09\x0f\x67\x41\xc6\x7c\x6f\xe3\x8b\x8b\x68\xb4\x09\xfd\x7d\xf5\x21\x9a\x6f\x7c\x18\xc6\xab\x05\x5b\xff\x49\x4f\x5a\x50\x94\xe3\xef\x41\xa7\x01\xaa\x54\x19\xa0\x0d\x0d\xfe\x3a\x91\xe1\x76\x27\x32\x94\x59\xac\x3c\x94\xa1\xfc\xa4\xb9\x01\xaf\x5a\x7a\x1a\xaf\xd4\x73\x1c\x94\xcf\x76\x3d\x4b\x01\x4a\x8f\xb3\xf5\x05\x9f\x12\x9e\x2e\xd2\x38\x64\x88\x68\xaa\xfa\x03\xd1\x17\xa7\xa4\xf5\x6c\x83\xd1\x78\x80\xbc\xc9\x00\x79\x64\x80\x5c\x17\x8a\x14\x0f\x10\x19\x0f\xd0\x64\x80\x10\x69\xde\x22\x3e\xa2\x03\x34\xc2\x03\xe4\x39\x03\xe4\x42\x5d\x63\x7f\x80\x08\x1d\x20\x82\x07\xc8\x69\x69\x32\x81\xa2\xc7\x03\xe4\xb9\x03\x04\x8f\xd8\x38\xe3\x01\x82\xed\xe1\x04\xb8\x36\x37\xf1\xf1\x00\xc1\x13\xcb\x9e\x37\x40\xae\x3f\x40\xbe\x3b\x40\xa3\xd1\x00\xc1\x53\xbd\xee\xa4\xb9\x8d\x4b\x06\xc8\x71\x07\x88\x8c\x06\x68\x3c\x40\xc8\x77\x06\x68\xe4\x0d\x90\xc7\x0b\x2f\xb7\xb4\x01\xa9\x9d\x01\x22\xde\x00\xf9\xd0\x86\x0c\xd0\xc8\x1d\x20\x6f\x34\x40\xee\xb8\xb9\x8d\x33\x19\x20\x87\x0c\x10\x3c\x35\x3b\x1a\x20\xe4\xd0\x01\x72\xf0\x00\x11\x90\x77\xab\xc5\x7b\x3b\x2f\x3a\x46\x2f\x3a\xad\x5e\x04\xe1\xc1\x6b\x60\x4f\x78\xac\x93\x0c\x10\x1a\xb5\xe8\x2b\xe4\x05\x1b\x81\xbe\x5c\x0f\xdc\xa2\xa7\x2b\xdc\x04\x7a\x01\xd6\x1f\x20\xda\x0c\x85\x87\x35\xc1\xe4\xe0\x59\x6e\x0a\xb7\x35\x18\x20\xd4\xc0\xb3\xe0\x2d\x17\xc2\x6f\xcc\x3d\xdb\x88\x75\x21\xce\xb0\x08\x1e\xf0\x08\x78\xa6\x45\x04\x88\x14\x08\x65\x17\x82\x0d\x3c\x0e\x95\xb6\x5b\xa2\x0b\x22\x02\xe2\x17\xe2\x18\xa2\x0b\x3c\xea\x3a\xb6\xae\x3b\xbd\xbc\xb8\x5c\x06\xbc\xf8\x3f\x5c\x1e\x66\x8b\x78\x9e\x1b\xbc\xf7\xf0\xfb\xd7\xbf\xbc\x7a\xf2\xfd\xd9\xeb\x57\xe0\x44\xf0\x8f\x33\x40\xdc\xa8\xe0\x04\x0a\xbd\x4d\x04\x10\xf7\xa5\xe8\x85\x44\x04\x9a\x23\x7a\x26\xb7\x2e\x6d\x91\xea\xd5\xc3\x1f\xdf\xb2\x0c\x05\x49\x24\xaa\xab\x42\x11\xf2\x4b\x38\x75\x2a\x4d\x0c\xd2\x41\xd3\x5f\x5e\xb4\x86\xd7\x76\xce\x95\x3f\xf8\x1a\xdf\x87\x87\xbf\x31\x75\x70\x4b\x10\xc1\x5f\xb5\xdc\x50\xa0\xf5\x58\xa7\x86\xa5\x18\xb7\x04\x12\xfc\xe1\x6b\x57\x41\x6b\x81\x9e\x0a\xd4\x4b\x30\x52\xa0\x5c\x37\x2d\x61\xbf\x86\xc6\x06\xf4\xb8\x8e\x36\xd8\x82\x2a\xb6\x30\x10\x9e\xd4\x09\x1b\x54\x0c\x54\xb4\x01\x3b\xab\x51\x36\xb9\x2f\xac\xa1\xf5\x32\x47\x75\xac\xc1\xd5\x4c\x41\x6b\x45\x98\xab\x64\x0d\x66\xa3\x96\x34\x09\xed\x12\x13\x0e\xb5\x97\xc0\xad\x51\xd6\x1b\xd7\xab\x61\x4d\x8e\x1b\x29\x68\x03\xd4\xa7\x5d\x3a\xdd\x58\x41\x6b\x81\x94\x76\x09\xca\x89\x8a\x36\x60\x03\xda\x25\x28\x67\xb4\x4b\x1f\x0d\x69\x97\xb0\x8c\x36\x68\xeb\xb0\x4c\xc1\x1a\x84\x98\xd3\x2e\x89\x82\x48\x98\x16\xc4\xc9\x60\x62\x74\x9b\x48\xc1\xc4\x2e\xcc\xdc\x1a\xda\x24\xa9\x57\x43\x9b\x0c\x26\x52\x31\xb1\x09\x09\x91\x88\x89\x45\xb8\x8f\x6b\x42\x98\xe2\x81\xd6\xd0\xd4\x2e\x11\x4b\x34\xb6\x4a\xc4\xc4\x2e\xd6\x66\x75\x73\x38\x16\x89\x58\x98\xc3\x26\x0f\x4b\x21\x0c\xc6\x63\x1b\x68\x8b\x54\x4c\x8c\xf9\x95\x76\x31\x31\xa9\xa3\x0d\x26\x76\x6a\x68\x53\x7c\xba\x54\x35\x9b\x4d\x36\x2e\x09\x5b\xe4\xe2\x4a\x08\xc7\x22\x1d\x9b\x7b\xea\x98\xda\x07\x04\xad\x8b\xe0\x58\xa4\xe2\x12\x8b\x1d\x8b\x64\x6c\x13\x6a\xb3\x1a\x61\x53\x97\x0e\x6b\x68\x53\x60\x46\xd4\x32\xd8\x58\xdd\x6a\xd8\x22\x0f\x4b\x81\x31\x76\xda\x6e\x41\xc8\x97\x9d\xe6\xce\x1e\xc6\xd4\xc3\xc4\x66\xf6\x2c\xe0\xd8\x22\x73\x0b\x7b\x59\xe5\x6d\x29\x05\xc6\xc4\x26\x71\x4b\x29\x4c\xf0\x51\x0d\x4e\x3d\x6c\x95\xbc\x25\x75\x0f\x5b\x25\x70\x80\x13\x6c\x91\xbb\x01\x68\x94\x61\xb2\x29\x03\xb1\x49\xdd\x12\x8e\xb1\xd5\x34\x5a\x48\x42\xb0\x45\xee\x16\x6e\x24\xd8\x22\x79\x9b\x83\xbe\xc2\x99\xd8\xcf\x6b\x9a\x19\x03\x89\x76\x72\x1d\xa1\xf6\x76\x70\xa8\xa5\x9b\xdd\x4d\x19\x88\x4d\xe6\x16\x70\xa3\x18\xa3\x1a\xdc\xe8\x69\x9f\xaa\xde\xc3\xd8\x22\x81\x4b\x49\x4c\xce\xa6\x35\xd2\x04\x5b\xe4\x70\x63\x60\x04\x35\x11\xb0\x45\xf6\xae\xa4\xb5\x4a\xdf\x92\xb2\x49\xe0\xa8\x16\x19\xd8\x26\x85\x4b\x49\x3c\x6c\x95\xc5\x6d\x82\x5f\x5d\xab\xd0\x03\x09\xee\xe4\x3b\xe2\xe0\x4e\x41\x4a\x5c\xdc\x49\x47\xe2\xd5\xf1\x26\xef\x10\x25\x45\x1b\x24\xf1\xb1\x7d\x9f\x25\x4a\x6e\x36\x8a\xac\xa4\x67\x23\xdd\x09\xb6\xef\x56\x24\xc0\xf6\x1d\x85\xcc\x70\xa7\x38\x25\x6a\x7a\x36\xaa\x18\xe1\x6e\xe9\x91\xe1\x4e\xc9\x86\x6c\x24\x6b\xa3\x19\x31\xed\xe0\x4b\x42\x3b\xd8\xdc\xa1\xdd\xe2\x6f\x23\x69\x6b\xb1\x1e\xed\x66\xc5\x7a\xca\x36\x2b\xaa\xe4\x6c\x03\x72\x4c\xbb\xf9\x87\xd2\x6e\xbd\x78\x42\xbb\x05\xe3\x46\xfa\x36\x6a\x3a\xa3\x1d\x7a\x86\x92\xc1\xf5\x40\x25\x77\x1b\x25\xd8\xc8\xdd\x46\x21\x94\xe4\xcd\xcd\x77\xa7\x13\x70\x82\x3d\x2d\xf3\x92\xb5\x56\x46\xa7\x84\x81\x80\x44\xef\x62\xb7\x04\x13\x6c\x60\xef\x95\xec\x61\xb0\xd1\x42\x47\x25\xb4\x68\xa4\x05\xfb\x0a\xd8\x28\xc4\xb8\x14\x02\xc0\x86\xf8\xad\xf9\xd6\x04\x9e\x28\x60\x62\x32\x5b\x50\x33\x9b\x16\x3a\x2b\xa1\x1e\x36\x1a\x2e\xac\x19\xce\x60\x8b\xa8\xb4\x85\xd1\x12\xac\xb2\x84\x0e\x36\x2f\x61\x06\xa5\x68\x07\x5b\x11\xda\xc1\x00\x4e\x09\xd6\xd2\x74\x69\x87\x18\xf7\x6a\x60\x83\x00\x23\x6a\x6d\x54\xbf\x06\xd5\x52\x1d\x2b\x54\xb5\x40\x5a\x02\xb5\xb0\x49\x09\x33\xe8\x1e\x28\xba\x1b\x15\x9a\x29\x0a\x19\xe4\x0c\xa9\x75\x07\x88\x68\x87\x98\x66\xb4\x43\x32\x98\xd7\x28\x63\x6c\x33\x97\xae\x48\xeb\xd1\x04\x5b\x3b\x97\x38\x35\xac\x9e\xae\x8b\x3b\x44\x2e\xf1\x54\xca\x5a\xe4\x08\x77\x30\x1c\xf1\x71\x87\x2e\x41\xc6\x76\x03\x0f\xa1\x5d\xb2\x17\x99\xe0\x2e\xde\xab\x32\xae\x9e\xea\x0c\x5b\x07\x26\x09\x6b\x36\x33\x49\xa0\xa6\x5b\xbd\x0c\x55\xb6\x35\x7b\x62\x8e\x3b\x64\x47\x82\x3b\x45\x3c\xa1\xb6\x12\x3b\xb4\x4b\xdf\xa8\xb2\xaf\x1e\xe7\x51\xfb\x3e\x34\xa2\x5d\xfa\x45\x2d\xf5\x6a\x91\x63\xda\xc5\xbe\x94\xda\xf7\xe4\x09\xed\xd2\xe3\x02\x6a\x1f\x13\x33\xda\xa5\x77\x86\xb4\x4b\xaf\x8b\x68\x97\x98\x57\xd2\xb1\x16\x57\x65\x62\xd0\xee\x6e\x67\xc5\x94\xaf\x4e\x18\x04\x95\xec\x41\x0e\x82\x3d\x2d\xd8\x91\x60\xcf\x08\x75\x25\x14\x84\x30\x59\xcb\x93\x60\x2d\x6a\x24\x51\x66\xad\x7c\x55\x2b\x6c\xd0\x6a\x5c\x69\x65\x82\x96\xce\x12\x4b\x1e\x5a\xf0\x44\x82\xcd\x06\x08\xa4\x01\xf4\xec\x67\x2a\x7b\x93\x0d\xc2\xca\x06\x66\xc5\x22\xa9\x98\xc9\xaf\x4c\xfa\xd5\xc4\x7e\xae\xb2\x37\x51\xa5\x1d\x82\x90\x48\xb0\x1e\xe6\xd0\x0e\xea\xbb\xb4\x83\x61\x3d\x6a\x1d\x87\x23\xda\xc1\x08\xbe\x04\x6b\x51\x63\xda\xa1\x63\x51\x09\x36\x09\x3a\xa9\x0b\xaa\x07\x07\xd4\x3a\xb0\x67\x12\x6a\x52\x3e\xa4\x1d\x7a\x56\x24\xc1\x66\xcf\x32\xe9\x59\xb3\xfd\xe7\xb4\x43\xd2\xa8\x66\xb9\x66\x19\x08\xa9\xb0\x26\xdb\x12\x07\x77\x91\xa2\x4c\xb3\x7a\x98\x87\x2d\xdd\x40\x46\xb8\x43\xb7\x21\x3e\xee\x10\x36\x64\x8c\xbb\x76\x73\x85\xb6\x01\x3d\xc1\x1d\xe2\x87\x04\xb8\x43\x27\x22\x33\x6c\x1d\x43\x24\xc4\x1d\x32\x09\x89\xb0\x75\x4f\x22\x65\xe6\x35\x48\x50\x26\x5e\x23\x77\x4c\xbb\x58\x81\xd0\x2e\xfe\x28\xd3\xaf\x1e\xe6\x52\xfb\x9e\xa1\xe4\x5d\x63\x60\x8e\x68\x97\x70\xf0\x69\x97\xc0\x2c\x33\xb0\x01\x47\x69\x97\x9e\x3c\xa1\xb6\x6e\x0b\x68\x97\x10\x9b\xd1\x2e\x1d\xba\x4c\xc3\x46\x2b\x44\xb4\x4b\xd7\x57\xd2\xb0\xd1\x1b\x65\x1e\x2e\xba\xdb\x1d\x4f\x89\xb5\xac\x05\x63\x60\x6b\x90\xd2\x29\x65\x34\x00\x5d\x01\x14\xf7\x0d\xb5\x58\xaf\xc2\xc2\xfd\x50\x2d\x76\x24\xb0\xd0\xcb\x0d\x50\x5f\x40\x1d\x0b\xec\x58\x60\x8d\xfc\xa5\x93\xc4\xea\x90\x0e\x3a\x11\xd0\x42\x2d\x3d\x36\xa8\xb0\x46\x59\x67\x42\x56\x87\x60\xa3\xb8\xa1\x10\xd7\xb1\xf0\x6d\x24\x7c\xab\x05\x31\x01\xb2\xe0\x3d\xaf\x78\x1b\x6d\x45\xad\xa9\x12\x6a\xef\x55\x47\x60\xf5\x28\x97\x5a\x87\xbf\x27\xa0\x46\xe0\x88\xda\x87\xb4\x4f\xed\x7d\x34\xae\xe8\x9a\x6d\x5a\x61\x8d\x74\x27\x82\xae\x16\x14\x50\xfb\xc0\x9b\x09\xac\x85\x97\x42\x6a\x1f\xfb\x11\xb5\x8f\x29\x26\xb0\x16\xd0\xb9\x80\x1a\xd5\x52\xa6\xb4\x06\x92\x7c\xf6\x6b\x91\x7b\x1d\x6c\xef\x51\xe2\x62\xfb\xe0\x27\x1e\xb6\x76\x01\x19\x61\xfb\x18\x24\x3e\xb6\x0f\x6e\x32\xc6\xd6\x5e\x20\xb4\x43\x66\x23\x65\x7a\xb5\xa1\x2c\xf3\xab\x8d\x7a\x33\x6c\xdf\x6f\x88\x4c\xb0\x66\x79\x23\x6c\x1f\xe6\x44\xa6\x59\x3d\x4a\x66\x58\x1b\x73\x61\xda\x21\x74\x08\xb5\x77\x9a\x43\xed\x4d\xeb\xd2\x0e\x91\xe3\xd1\x0e\x3d\x63\x44\xed\x4d\x21\x13\xae\x01\x56\xe6\x5a\x1b\xcd\x64\xb2\x35\x73\x9f\xd0\x0e\x41\x2e\x53\xae\x1e\x35\xa3\x1d\xba\x42\x48\xed\xd3\x82\x4c\xb7\x66\xfd\x19\xed\xd0\x69\xe6\xb4\xde\x15\xee\x74\xae\x2b\x6c\x40\xb5\x12\x54\xfc\x75\x30\x47\x5a\xca\x31\x9a\xd5\xad\xa0\x8e\x81\xb9\x27\xa1\x18\x9b\x04\x18\xa9\x76\xd2\x01\x7d\x05\xa8\x65\x3e\xae\x98\x9b\x68\x0a\x27\x69\x31\x93\x9a\x2e\x5a\xce\x81\x14\xd1\xa4\xf4\x4c\xf4\x4e\x1d\x26\x94\x7c\xcd\x1a\x47\x0a\x5f\x2d\x90\x49\xa0\x9e\xde\xbc\x62\x6d\xd2\x84\xaa\x42\x6a\x91\xa4\x82\x9a\xf8\x3b\xd4\x6c\x1e\x97\x4a\x55\x0c\x7c\x3d\x09\x34\x71\x1d\x51\xeb\xce\xe5\x57\x50\x93\x81\xc6\xa5\x9c\x5a\x8a\xb4\x84\x19\x80\x93\x0a\xa8\x83\x05\x35\x09\xb5\x14\x67\xd4\x3a\xd0\x42\x6a\xee\x2f\x51\x45\xce\x24\x24\x93\xba\x98\x80\x73\x6a\xdd\xa5\xe5\x9c\xd4\xc6\x37\x84\x60\x6b\xd5\x89\x92\x29\x4d\x16\x25\x22\x55\x1a\x71\x1e\xb6\x0c\x62\x32\xc2\xf6\x16\xf0\x6b\x58\xbd\x04\x63\x6c\xe9\x82\xf2\xa2\xd4\xac\xbd\xc8\x98\x7a\x72\x22\x57\xea\x29\xcd\x6a\xfe\xd1\x13\x14\xf9\xd2\xa8\x47\x54\x8b\x0f\x3d\x7f\x56\xf3\xb9\x9e\xae\x48\x9a\x16\x91\x84\x2d\xd2\x1b\x21\xd4\xd6\x31\x0e\xb5\x0d\x22\x97\xda\x07\x86\x47\x55\x33\xe9\xe9\x8a\xcc\x69\x34\xa7\x4f\xed\xbb\xd0\x98\xda\xbb\x9e\xd6\xe8\xea\xb1\x93\x1a\x5d\xbd\x0c\x22\x89\x1a\x69\x8a\x0c\x6a\xa1\x55\x48\x6d\x83\x44\xa4\x51\x3d\x63\x56\x73\xa8\x1e\xab\xe4\x50\x10\xf3\x33\x4c\x48\xb5\xec\x4b\xbd\x3d\x4c\xb4\x63\xbf\x9c\x8d\xeb\x40\xae\xe4\x6a\x20\xe6\x95\xd7\x0c\x06\xe0\xc8\x6c\x6c\x9f\x43\xb4\x54\xc6\xca\x25\x8a\x16\x58\x45\x81\x41\xd1\x49\x65\x5e\x2d\xc1\xa0\x24\x68\x00\xce\x14\x8b\x68\x39\x87\x8a\x2e\x5a\x60\x24\x1c\xa6\x65\xcb\x2a\x87\x69\x89\xcd\x15\x3d\xb4\x40\x5a\x49\xa7\x65\x4c\xa8\xd1\xb1\x0e\x95\x3c\x0d\x4a\xb8\xd4\xd2\x76\x1e\x2d\xbd\xa6\xc5\x8d\xa8\xa5\xd7\x7c\x05\xa8\xa5\x38\xa6\x16\xce\xa0\x52\x3c\x03\x6e\x52\x72\x35\xe8\x1b\x28\xde\xd0\x02\x67\x95\x61\xb4\x9c\x43\xa1\x86\x96\x58\xa4\xb8\x43\x4b\x8d\x51\x63\xcf\x9d\x57\x26\xc1\x96\x57\xd5\x06\x55\x09\xa9\xba\xae\x1e\xe8\x94\x24\x0d\x7a\x10\x57\xe9\x93\x7a\xa4\x57\x31\xd7\x03\x47\x0a\x73\xbd\x98\x7e\xc9\xdc\x84\x1c\x4b\xe6\x26\x7d\xa8\x4d\xea\x20\x13\x85\xb1\x1e\x19\x54\x8c\xf5\x12\xce\xcc\xb9\x9c\x84\x16\xe3\x6f\x54\x8a\x66\x72\x32\x2b\x9d\x6c\xf2\xc8\x5c\x18\x45\x4f\x0f\xdb\x06\x2c\x51\x3a\xb1\x9e\xb1\x43\x6d\xa3\xcb\x35\x77\x28\xe2\x95\x7c\x4d\xa6\x19\x49\x55\x4c\x40\xdf\x26\xb9\x91\xb1\x92\x16\xf4\xf4\xa8\x22\xa2\x9e\xe6\x84\xda\xfa\x39\x30\x8f\x37\x64\xa6\x48\xa8\xe7\x1b\x56\x5e\xd6\x03\x23\x9b\x84\x49\x58\xa9\xb0\x09\x59\xe6\x43\x8c\xc9\x9d\xce\x14\xe1\xfe\x07\x75\xf4\xcc\x4b\xaf\xe8\x50\x8e\xa4\x66\xc0\xb9\x80\xd3\x33\xf4\x04\x43\x3d\x6a\x04\x28\x8a\x1d\x93\xf8\x7e\x89\xd3\x0b\x36\x16\x0a\xe8\x30\xb4\xc0\x18\x8d\x31\x91\xc6\x30\xd0\x0b\x00\x67\x94\x6c\x56\x72\xd5\x6b\x1a\x8a\x03\x3a\x74\x98\xc8\xd8\x1d\x98\x94\x1d\xeb\x29\xcd\x85\xec\x7a\x94\x88\x08\x19\x6b\x16\x50\x62\xe9\x57\x01\x2f\xe3\xce\x0e\xee\x5a\x86\xa9\x80\x78\xa5\xe9\xad\xe0\x23\x2b\x7f\x0a\xb0\x2f\xc0\x56\x72\x8f\xcd\xdd\x46\x20\xa9\xa5\xff\x04\x7c\x62\x11\x34\x02\x2a\xa2\xd5\x8e\x6e\x19\xb4\x76\xc6\x08\x8d\x71\x29\x80\x51\xe9\x11\x2b\x31\xca\x68\xb6\x13\x43\x04\xb5\xc1\xdb\x04\x5b\xb9\x8e\x54\xa1\x6c\xa0\xe7\x14\xf4\xb4\x18\x19\xb9\x5a\x90\x67\xa9\x2e\x19\x59\x46\x09\xf1\x2d\xed\x4d\xc6\x76\xa6\xb3\x18\x8f\x27\x82\x92\x81\x61\x50\x4a\x66\x60\x39\xb3\x4c\x11\x24\x94\x14\x4d\xc0\x48\xf8\xdf\xc0\x98\x59\xf4\x2d\x32\x2f\x3d\xa1\xa7\x46\xc5\xd3\xed\x56\x66\x16\x60\x11\x80\x36\x50\xc7\xd2\xcf\x02\x2e\x86\x71\x6a\x05\x16\x03\xba\x05\xb2\x1c\xd4\xed\xf4\xf3\xbb\xd9\x6e\x6c\x19\x08\x02\x5e\x8e\xf6\x76\xf0\x72\xd0\xb7\xb3\x60\x60\xd9\x55\x05\x7c\x66\x99\x20\x49\x68\x15\x9b\x02\x1c\x59\x67\x7f\xc2\x3a\x64\x7f\x19\xd4\x5c\x8c\x46\xf4\x8d\xed\xf1\xc1\xcf\x83\xec\x43\x86\xf2\x45\x90\xa3\x8c\x2d\xa1\xf4\x03\x14\x83\x80\x33\x78\x51\x9c\xac\x2e\xf3\xf6\x23\x7a\x9f\x3f\x78\xf5\xb4\xf5\x94\x5e\xf9\x64\x2e\x26\x03\xb9\x3d\x00\x42\x0e\x6c\x2c\xde\xc0\xe7\x58\xbc\x69\xd6\x18\xbe\x2f\x00\x05\xb6\x40\xcb\xd7\xf0\x66\xa0\x58\xc4\x4e\xe1\xe6\xa2\x18\x8f\x1e\xbf\x2a\x4a\xf7\xa0\xe2\x9c\x7d\x04\x07\x9f\xaf\xe3\x7c\x71\x31\xb4\xaa\xb3\x00\x07\x9b\x03\x8d\x13\x71\x66\xfa\xb0\x78\xa3\x9c\xb0\x3e\x64\xd7\x39\x4b\xa2\x5e\xf3\x05\xc3\x2f\x51\xfa\x92\x65\x2c\xbf\xf3\xea\x57\x1f\xd8\xc7\xb2\xbe\xcd\x07\xf6\x71\xba\xd7\x82\x95\x60\x59\xfa\x0a\x4a\x6b\x98\x2a\x5f\x81\x10\x45\xc4\x8c\x7c\x34\x8b\xf3\x0c\x05\x61\x98\xae\xa3\x38\x39\x87\xfa\x1e\x2f\x4e\x89\x89\x1b\x54\x67\x82\x08\xd2\xd4\xc0\x9d\xa7\x6b\xd4\x03\x78\x8c\x4e\x10\x9e\xa2\x18\x1d\xa3\x91\x3f\x45\xf1\xc1\x81\xce\x40\x75\x26\x2f\x52\xd0\xe9\xc5\x29\x81\x32\xf5\x87\x88\xb4\xb3\x83\x5f\x21\x18\x60\x4f\x50\x4f\x1a\xe5\x5d\x45\x4a\x14\xcf\x82\xff\x7a\x2e\x41\x87\x0a\x17\x5e\x35\xab\x8f\xee\x21\xb2\x6b\x1d\xc6\x07\x59\xc6\x2e\x66\x4b\x86\x88\x8f\xb2\xcb\xd9\x07\xf6\x51\xef\xe1\xec\x72\xf6\x94\x7d\xcc\x4a\x2f\x57\xef\xad\xcc\x9a\xbc\xe2\xf8\xc2\xb8\xf2\xcd\x31\x22\x7e\xf9\xce\x6c\x68\xa8\x55\xc5\x6b\xd2\x08\x81\xb5\xe0\x4a\x66\x28\xf4\xca\x39\x64\xef\x04\xab\xf7\x42\x6a\x13\x37\x19\xfb\x46\x46\xb3\x38\x7f\xc5\xcf\x43\x3f\x51\xce\x3c\x2f\xb9\x59\x30\x2a\xe2\xdb\xa3\x8d\xf1\xed\xec\x69\x5a\x37\x46\xae\xe3\x59\x45\x6e\x9d\xfb\x7c\x9d\x5e\xf0\xba\x4e\x4b\xa8\x77\xe6\x50\x08\x37\x2e\x8f\x91\x46\x61\xe7\x77\xbd\x18\x1d\x21\x9f\x17\x1d\xe5\x25\xdf\x64\x80\xf7\x7a\x2f\x4e\x1d\xd1\x25\xa0\x4a\x9b\xb4\x56\x1f\xfd\x1b\x72\xe8\x7b\xa8\xa5\x56\xc4\x77\x8c\xfe\x0d\xf9\x4d\x35\x86\x2c\x84\x5e\xc7\xe7\x8b\x9d\xa4\xf6\x78\xa5\xc1\x4a\xf4\x7e\x4d\x76\x87\xf2\xaf\x0b\x0d\xd0\x01\x72\xbc\x16\x35\xfa\x0d\x7a\x48\x4e\x1d\x7b\xa7\xd4\x30\x4e\x42\x86\x58\x10\x2e\x44\xbc\xa3\x38\x43\xc1\x6a\xb5\x8c\x59\x04\x89\x2f\x48\xa0\xe4\x4c\x90\x44\x2c\x92\x35\xdb\xf8\x48\x3a\x30\x11\x06\x1f\x0b\x8a\x61\x90\xa0\x19\x43\xb3\x75\xfa\x81\x25\x50\xc0\x2b\x45\xb4\x28\xc8\x9a\xa1\x2c\x0c\x96\x05\xa7\x82\x7a\x66\x24\x7c\xb5\x88\xc3\x05\x0a\x96\xcb\xf4\x0a\x86\x7a\x06\x56\x84\xf6\x33\x86\x2e\x33\x16\xa1\xab\x38\x5f\xa4\x97\x79\x21\x76\x16\xa7\xc9\x9e\x85\x7f\x78\x49\xbf\x5e\xf5\x06\x2a\xab\x43\x8c\x29\x1f\x41\x86\x74\x49\x7f\x6a\xdf\x4f\x48\x91\xe1\xc7\xd6\xdd\x44\x30\xe3\xb9\xba\x7a\x0d\x8c\x7b\xbd\xb8\x88\x88\x6f\x10\x84\x92\x6b\xf4\xbb\x7c\xa5\x61\x33\x56\x75\x1e\xbf\x17\xd5\x06\x2b\x9d\xc7\x05\x6b\x67\xdc\xdf\x35\xfd\x9f\x16\x15\xf6\x50\x0c\xb5\xff\x32\x66\x35\x04\xc4\xc9\xef\xaf\x36\x46\x81\xda\x47\x5d\xc7\x57\x62\x37\xbe\x56\x3c\x54\xf3\x67\xef\xc8\x08\xba\x9a\x86\xe7\x4d\xa7\xaa\x79\x2c\x09\xa1\x5c\x0e\x9f\x4b\xa9\xf3\xa3\xe7\x03\x94\xce\xe7\x19\xcb\x75\x72\x8a\xb2\x9f\xe9\x69\x49\xa2\x6a\x37\x10\xdf\x0a\xb9\xfb\xd3\x4e\x62\x45\xec\x33\x8b\x55\x99\xb7\xa3\x64\x35\xba\x8d\xa2\x0d\xa4\xaf\x74\x32\x42\x95\x25\x26\x52\x57\x2b\xa8\xb0\xe0\x92\xb3\x82\x0a\x9f\xef\x0a\xd3\x6a\x9c\x5f\xb4\x58\x6f\xb6\x80\xca\xa1\xba\x91\x9f\x57\xa8\x8e\xf3\x38\x58\xaa\x95\x44\x5a\xe1\xec\x3a\x5c\x04\xc9\x39\x7b\xf6\xb2\x2a\x07\x59\x94\x8d\xc1\xd7\x78\x5e\xfc\xf6\xa7\x1d\x9b\x43\x59\x99\xe2\xda\x63\x3e\x9f\xdb\x34\x7f\xf9\x4c\x6d\xee\x70\xee\xae\xf8\xe9\xdc\x9c\xf2\xe6\xc0\x1b\x63\x2b\xee\x1b\xc2\xf3\xe6\x23\xf1\xa3\x1b\xcc\x8f\x8e\xd0\x4b\x28\xd9\x92\x99\x93\x46\x51\xda\x85\x4f\x6f\x8a\x97\x45\xf2\xe0\xaf\xcd\x09\xc4\xea\x2a\xa6\x75\xce\xc8\x99\xbc\x9f\x1a\x1b\x96\xc1\xa9\xc6\xaa\xb9\x59\x19\xa1\x6a\xc0\x4e\xf7\x4c\x0a\x9d\xb1\x38\xcb\xd9\xb2\xec\x75\x46\x3e\x73\x9e\x77\xb5\xb0\xa6\x1c\x4d\xad\x47\xc8\x39\x4c\x9b\xe0\x7a\xfe\x97\x17\xef\xe2\xf7\xef\x7a\x3d\xa1\xd9\xcf\xc2\x92\xef\xe2\xf7\x70\xc1\x52\x5e\xd2\xf3\xf7\x30\x8a\xe1\xf7\xb7\x19\x31\x37\x32\x83\xb4\x9f\xfc\xda\x22\x35\x88\xb6\x3f\xa3\xf9\xae\x83\xe9\x4f\x49\x94\xa2\xec\x2a\x58\x15\xd3\xd1\x65\x90\xe5\x45\x35\xa2\xd6\x46\xe0\xf9\xdc\x3a\x56\x36\x74\xac\x47\x8a\x04\x19\xb4\xcc\xf5\x3d\x91\xd7\xc5\xbe\x45\xca\xdb\xea\xf6\x12\xde\xd6\xfc\x96\x49\xe7\x96\x29\xef\x96\x09\xd7\x90\xee\x5b\xdb\x43\x26\x62\x39\x4a\x2f\x73\xdd\x60\x57\x8e\x6e\xd6\x01\x52\x1b\xdd\xac\x22\xa4\x6d\x50\xff\xc0\x3e\x16\x95\x83\x7d\xa8\x1b\xdc\x02\x8a\x7f\x37\x63\x94\x22\xc4\x7e\x87\x12\xc4\x47\xe8\x15\xf4\x23\x71\x19\xbc\x4e\xb3\xac\xba\x24\xe5\x45\xbe\xf8\x65\x1e\x5f\x32\xda\x6a\x5c\xce\x40\x2a\x37\xf5\xe4\x44\xe4\x22\xc8\x3e\xb4\x65\x31\xd9\x19\x7b\xbd\x5a\x47\x83\xdc\x24\xa7\x58\x3f\xd7\x4c\x0a\x79\x0c\x08\x36\x9b\x56\x45\xa2\x9f\x4f\x50\x3e\xdd\x33\x76\x6a\x80\xa1\xe3\x63\xc1\x6f\xba\x67\x91\x7f\xb6\xb4\x7d\xf9\x6c\x27\x6d\xd7\xed\xda\x2e\xad\xb5\x55\xd4\xb0\x34\x4a\x47\x6d\x6d\xab\x0c\x67\xb2\xcc\x70\xb1\xc0\x7a\x9b\x42\xc3\xbb\x15\xd6\x2d\xf8\xe6\xec\x3a\x57\xcb\xeb\x3e\x7a\xfc\x6a\x28\x2e\x30\x6a\xf5\x75\x07\x28\x9c\x9f\xf7\xa7\x3a\x82\xab\x65\x00\xb2\x5f\xe7\x68\x93\xa0\xb8\x34\xe8\x55\x2c\x0d\x34\x9b\x0a\xf7\x6e\xaf\x20\xd7\x0b\x3f\x3f\x7a\xfc\xaa\x3f\xb5\xf6\xc7\xeb\x75\xbc\x5a\xb2\xc3\xbb\x58\xe7\x2e\x48\xd5\x56\xbb\xd5\x8f\xfe\xcb\xaf\x79\x9f\x72\x2f\x20\xd0\x36\xe6\x65\x0c\x43\x96\x99\x2e\xf4\x58\x06\x45\x4f\x21\x36\x0a\x1f\x3e\x2e\x42\x2e\x5d\xf7\xca\xba\xad\xe2\x9b\x72\xbd\x79\x98\x2d\xe3\x90\xf5\xa0\x20\x68\x7f\xb3\xe0\x6f\x23\x07\x67\x47\x0e\x50\x4e\xd3\x8e\x83\xbb\x23\x07\x28\x66\xda\xef\x77\xbc\x8a\xbd\x8b\xcb\x7e\x96\x11\xd9\xbb\x37\x2e\xb2\x6d\xd4\x75\x64\x47\xde\xa1\xad\xdb\x8d\x6f\x9b\x11\x22\x76\x17\x46\x70\x6f\xa1\x88\x73\x0b\x03\x92\x6e\x7c\x8d\x53\x1f\x32\x71\xbe\xca\xdc\xe7\x5f\x7b\x88\x2b\x93\xf3\xdd\x0e\x74\x15\xd9\x3b\x19\xee\xda\x86\x90\xfa\xa0\x57\xa2\x76\x28\x55\x5f\xb6\xd5\x54\xab\x57\x4a\x94\xdf\x77\xe8\x60\xa3\x80\xbd\x52\xe6\xfc\xbe\x8b\x07\x55\x7d\xf3\xfb\xae\x33\x10\x65\xcf\xef\xbb\xe3\x9b\xf7\x03\x1f\xdf\x49\x6d\xfb\x3f\x61\x51\xfb\x4d\xc3\x7f\xc5\xd2\xf3\xff\x35\x8a\xc8\x5f\x26\x85\x31\xda\x2c\xf1\x05\xaa\xc9\x3f\x0c\x32\x56\x55\x85\x0f\x32\xd6\x0c\x7b\xeb\x3a\xe5\x48\x5f\xc2\xcb\x4f\xec\x13\xe7\xb5\xef\xa1\x24\xb8\x60\xd9\xaa\x25\x25\x36\xcf\x3b\x4f\x7f\x81\x86\xa0\x48\xf1\xff\x1f\x37\xf6\x2c\x1f\x20\xdf\x83\x5b\x65\x08\xe6\x77\xf6\x2c\xdf\xfa\x1e\xe8\xc7\x95\xbd\xf6\xbd\xa1\x78\x03\xf6\xd2\xcf\x6d\x1b\xc5\x80\xbf\x6f\xe4\x2a\x76\xfc\x1f\x50\x1d\x1b\x25\xec\x6a\xf9\x11\x15\x39\x2e\x32\x08\xd9\x92\xff\xc5\x90\xb1\x0a\xd6\xc1\x05\xfa\x23\xb9\xbc\x98\xb1\xf5\x0d\x5a\xc4\xe7\x0b\xf4\x7a\xc1\x8a\x17\xae\x03\x77\x45\xb3\xa1\x6d\xeb\x65\x7a\xc5\x1b\xc3\xff\x86\xb6\xad\x24\x5b\xc7\x30\x6d\x33\x69\xfa\xeb\xca\xf4\x22\xbc\x87\xd2\x03\x72\x96\xc9\x57\x86\xe0\xf1\x74\x97\xaf\x12\x79\x78\x84\x7d\xdc\x7a\xe7\xad\xc1\xc5\xf0\x17\x27\x71\xed\x0a\x04\xcc\x35\x40\xcb\xf4\xaa\xa9\x3b\xca\x1f\xe8\xe7\x43\x40\xa2\x13\x6e\xdf\xa9\x1e\x09\x56\x3c\x01\x9a\xcd\xb8\x9b\xbd\xae\x31\xf4\x30\xce\xaf\xe2\x8c\xa1\x1f\x7e\x7c\x0d\xcb\x30\x71\xb6\x5b\xc8\x88\x11\xf2\x0f\x11\xe6\x37\xe8\x01\xc4\x23\xba\xf6\xbd\x43\xb0\xb3\x9c\xbe\x04\xf3\x9c\xad\x51\xc2\xce\x83\x3c\x4e\xce\xbf\x70\x1c\x70\xb6\x0c\xee\x31\x88\x88\x18\x26\x69\xde\xeb\xe8\xe4\xa3\x23\x94\xa4\x56\x17\x9a\x47\x47\x9c\xab\xf0\xed\x7f\x96\x8e\x9e\xea\xd0\x85\x7f\xff\x53\xfa\x7a\xba\xd7\x06\x16\x06\x17\xf6\x96\x71\x5c\x85\x5c\x2b\x97\x9b\xc1\xce\x31\xf2\xe0\x87\x47\x4a\x8c\xf0\x5b\xfd\x7c\xe5\x6e\x15\x64\xfc\xd6\xff\x2d\x72\x4d\x19\x37\x10\x7d\x3c\x5f\x94\xa1\x93\xa7\xe8\xc1\x0f\x8f\x24\xb7\x2f\x12\xa0\x0f\x7e\x78\xf4\xe5\xc3\xb3\xd8\xe5\x51\x05\x67\x90\x44\xbd\x20\x49\xf3\x05\x5b\x0b\xa1\xbb\x87\x6a\x90\x44\x6a\xa8\x82\x71\xed\xc2\xb5\x4a\x4b\xf7\x0a\x7b\x5b\x46\x6e\x99\xa4\x44\xb3\x3f\x5d\x0c\xff\xf8\xf2\xeb\x84\xf0\x8f\x2f\xbf\x68\x04\xff\xf8\xf2\xcb\x07\x70\xba\xae\xc5\x6f\xba\xbe\x75\xf8\xa6\xeb\xdb\x46\xef\xa7\xdd\xa2\xf7\xd3\x9f\x34\x7a\xdf\x7e\xad\xf0\x7d\xfb\x85\xe3\xf7\xed\xd7\x08\xe0\xeb\x8d\x08\xbe\xbe\x83\x10\xbe\xbe\x7d\x0c\xff\xbc\x5b\x0c\xff\xfc\x27\x8b\x61\xbe\x91\x52\x8d\xde\x84\x5f\x0c\xc8\x95\xae\x25\x9b\xe7\xbb\xc6\xae\xbc\xd6\x48\xf8\xcc\xa1\x78\x87\xd2\x79\x49\x3f\x5b\xc4\x3b\x10\xef\x14\xb0\x9c\xc5\x97\x0f\x59\xce\xb6\x16\xb4\xfc\x93\x67\x3d\x67\xd4\x3d\x56\x8b\xa6\x6a\xb8\x26\x9a\x58\x85\xc5\x99\x04\x1d\xc3\x2e\x75\xab\x80\xee\x55\x11\x7d\x7c\x8c\x12\xbe\xd7\xb1\x0c\x56\xb8\x11\xdb\x73\x1d\x74\x88\x92\x7e\xbf\x4b\x94\x03\xa9\x56\xfc\x4d\xdb\x8a\xcd\xb6\x74\x2a\xc1\x5e\x82\x0e\x41\x2d\x0b\x39\x5a\xb6\xdb\x00\xef\x7f\x85\x4e\xc7\x77\x54\xff\xd5\xeb\xee\xa8\xd7\xbd\xec\x8d\x77\xec\x74\x2f\xef\xbc\xd3\x41\xdf\x38\xd9\xe8\x60\x4a\x9f\x93\xdd\xd0\xae\xcf\xa9\x7d\x84\xbf\xe6\xd4\x6e\xd5\xed\x94\x6e\x5c\x52\xb4\xea\x77\x42\x96\x3f\x7b\xc7\x7b\x99\xe6\x41\xce\xbe\xce\x70\xb7\xe6\xbc\x3f\x6f\xcf\xe3\x3c\xbe\x7c\xcf\xe3\x6c\x6b\x3d\x6f\x9d\xee\x38\xda\xad\x53\xfb\xb1\x4e\x98\x06\x9c\x29\x07\xd8\xa4\x3f\x4c\xd7\xbd\xea\x93\x97\x3d\xdf\x33\x74\xa5\xcf\x10\x46\x9f\x35\x81\xff\x77\x8b\xa3\x9d\xf2\xf7\x3a\xcd\x5f\xee\x1c\x46\x2f\xb7\xc2\xe8\xd9\x67\x09\xa3\x07\x51\xf4\x75\xae\x1b\x83\xa8\xe4\x16\x67\xbb\xf1\xe9\x14\x4e\x41\x14\x7d\xf9\x60\x0a\xa2\x8d\x95\xbb\xe8\x0e\x56\xee\xa2\xae\x2b\x77\x9b\x23\xfe\x41\x79\xfd\x07\xc3\x7e\xfb\x78\x09\x6d\xc3\x60\xbd\x86\x9d\x4e\x3d\x39\x55\xc0\x7d\x74\xac\xd0\x12\x1f\x7d\x87\x08\xba\x8f\xb0\xcd\xf8\xac\x4c\x33\x84\x20\xe2\x35\x67\x25\x24\x32\xf4\x93\x1d\x06\xea\x5b\x6d\xc3\x78\x90\xa0\x00\xf6\x1e\xc1\x58\xaa\xdc\x3f\x6b\xba\x5f\xd5\xd8\xfe\x1f\xab\x75\xba\x62\xeb\xfc\x23\xfa\x83\xdf\xc7\xbc\x29\x9a\xf3\xec\x5a\x12\xde\xba\x07\x25\x02\xb8\x91\x8b\x4a\x52\x66\xeb\x2c\x3e\x7f\xf8\x11\xa6\x16\xf5\xa4\x9d\xc5\xe7\x49\x3c\x8f\xc3\x20\xc9\xd1\x8c\x7f\x1f\x27\x4a\x97\xe7\xfc\x87\x7b\x36\x11\xa8\xdc\xae\xac\x6e\xd0\x4a\x69\xe5\x27\x9f\xed\xc6\xa5\x56\x5a\xab\x8c\x54\xb3\x7d\xef\xc7\x15\xe4\xe5\x60\xd9\x47\x0f\x92\xdd\xbc\xd0\x3c\x68\x96\x6e\x50\x38\x58\x79\x84\xb5\x06\xd5\xe7\xcc\x51\x57\xaa\x37\x37\x95\xaf\xed\xb8\xeb\x4f\x3f\x3b\x8b\x77\x3a\x52\xf0\xb7\xd5\xdc\x70\xa3\x76\x70\x0b\x82\x84\x92\x09\x09\xc8\x0c\x08\x92\x90\x44\x84\x91\x79\x5f\x47\xef\xfd\x5f\x16\x32\x59\x68\x80\x08\xee\x38\xe6\x6d\xde\x3d\x87\x80\xcd\x06\x65\x37\x6b\x1b\xfc\xe0\xf7\x4a\xec\xba\x85\x6c\x37\x94\x6f\x8a\xff\x3f\x7d\xd2\x9f\x02\x00\xd7\xd3\x65\x4f\xfe\xdb\x09\xd2\x6e\xa1\x51\x7f\x39\xaf\xb2\xe5\x49\x29\x67\x8b\xd2\x08\xe9\xaf\x8a\xdb\xc8\x72\x2d\x86\x4b\x96\x9c\xe7\x0b\xf4\x0d\xa2\x1a\xf2\x8d\xdf\xec\x30\x57\x3c\x4d\xe1\x79\x61\xb9\x68\xa4\x8c\x83\x22\x7b\xc2\x63\xe9\xf2\x61\xf4\x5b\x24\x6b\x39\xad\x2b\xe3\xae\xb6\x05\xe8\x06\xbd\xae\x0f\x5d\xfb\x19\x8a\x82\x3c\x40\x41\x76\x27\xdc\x6f\x97\x4c\xaf\xeb\xdb\x97\xc4\xbc\x8f\xbf\x1d\xe6\xe9\x5b\xd7\xe9\xba\xab\x80\x37\x52\xa3\xff\x4e\x76\xaf\x0b\xb9\xea\x5d\x63\x6a\xd5\xe4\x59\x11\x71\xa5\x6e\x32\x06\x35\x5d\x09\xf6\xb3\x17\xa1\xa3\xe7\x50\xd8\x6e\x97\x87\xc9\xeb\xc2\x59\x3d\xb5\xa8\xe8\xa4\x28\xf3\x4e\xf7\x48\x39\xfc\x4a\x21\x87\xab\xcb\x6c\xd1\x13\xcd\xf8\xdd\x98\xfe\x2e\x0d\xdb\xe7\xac\x9a\x4d\x3b\xca\xc6\x53\x75\xbb\x9c\x4c\xcb\x92\xd3\xa0\x9e\x33\xfa\xd3\x3b\xcb\x03\x9c\x0f\x74\xb7\x30\x5d\xf1\xa9\xab\x79\x36\x69\xd7\xe5\xd5\xc9\x25\x74\x74\x86\xc2\x65\x9a\xb0\x2f\xdc\x89\x39\xcf\x2a\x28\x84\x71\xe1\xc3\xae\xbd\x97\x53\xb2\xed\xbd\x2a\x6b\x3e\x89\xe6\x6f\xaa\x67\x0d\x9b\xae\x57\xe4\x0f\x74\x31\x40\xa3\xbf\x43\x62\xcc\xfe\x5e\xa4\x46\x2d\x2b\x39\x1c\x16\x5c\xae\xb6\xd2\x81\x78\xae\x02\x5b\xb1\xe5\x07\x95\x08\xef\x89\x09\xb3\x99\x79\x99\x4a\xd4\xb1\xac\x5b\xc7\xbf\xea\xd8\xeb\xaf\x44\x27\x97\x4c\xdf\xc5\xef\x0d\x9e\xb5\xea\x86\x9c\x44\x33\x81\xe6\x0b\xcf\x8e\xfb\xca\x35\xbb\xc9\xc5\xd6\x71\xd8\x0f\x4e\xba\xec\x07\x3f\xfa\xe6\x6f\x68\x91\xe7\xab\xec\xfe\xd1\xd1\x45\xbe\xc8\x86\x33\x76\x74\x99\xcf\xe9\x6f\x19\xfa\xdd\x19\x92\xa1\x83\x66\x1f\xd1\x3f\x2e\x82\x7c\x11\x07\x99\x1a\xdf\xd5\x46\x72\xbe\x65\x79\x6b\xdb\xf1\xd1\x11\x7a\xc4\x72\x58\xc7\x9b\xaf\x19\x03\x6f\xc7\xc1\x6c\xc9\x32\xf4\xab\x10\xe2\xd7\x1a\x1c\xa2\x1e\x80\x8f\xcb\x6d\xe1\x5b\x9b\xcf\xd1\x7e\x11\x51\xfb\xe8\xde\x3d\xf9\xf1\xd4\x8a\x29\xfa\xb5\xd0\xbf\x99\xe5\x73\xfe\x5d\xc5\xf1\x42\xbc\xaf\x33\x14\x9f\xde\xbb\x67\xdc\xda\x7e\x52\x53\xa4\x6c\x6a\x2b\xea\x39\xdf\x29\xfe\xeb\xa0\x78\x8e\xfc\x87\x34\x62\xc3\xdf\x32\x94\xae\xd1\xc3\x62\xeb\x78\x3c\x87\xb3\x7f\xc2\x34\x62\xf5\x29\x7b\xb1\x9b\x0b\x0e\xd9\x41\x71\x0e\xd3\xa0\x5f\xc1\x2f\xcd\x2a\x8b\xdd\xe8\xa5\xca\xe7\xe2\x7d\x5d\xe5\xe2\xd3\x7a\x94\xc2\x7c\xb8\xa2\x30\x2c\x1b\x9e\xa8\x74\x3f\x7d\x52\xde\x0d\xaf\xe2\x24\x82\xa5\xa7\x1a\xa6\xa9\x87\x82\xbc\x48\x05\x6d\x74\x90\x0d\x03\x7e\x73\x78\x67\x3f\xdf\x1c\xd5\x69\x83\x9d\xb2\x7c\x1d\x27\xe7\x67\xeb\xf4\xe2\x74\x11\xac\x4f\xd3\x08\x12\xf2\x2b\xfe\xe1\x70\xae\x7c\xba\xed\xd7\xd7\x01\x1c\xa4\x04\x98\xad\xae\xb5\xba\x4c\x3e\x82\xeb\x6a\x4d\x64\x47\x42\x97\x61\xe6\x44\x0c\xbe\xef\x15\xdc\x9b\xac\x04\xb2\x15\x0f\x8f\xb7\xcc\x90\x00\x10\xa6\x97\x09\xac\x9e\x37\xde\x07\x03\x80\xb8\x62\x38\x11\x6a\xb6\x66\x5d\xc0\xf2\xb3\xa0\x9a\xbf\x62\xd7\xf9\x3a\xd8\xfe\xea\x6a\x11\x2f\x19\xea\x49\x29\x8e\x05\xbb\x26\x75\xe0\x97\x33\xa8\x64\x09\x85\x65\x1f\xe4\x92\xc2\xc1\x41\x7f\xda\xd8\x12\xc2\xb1\x68\xfd\xed\x09\xc2\xd7\x8f\x28\xc6\x10\xb9\xc5\x47\xc7\xfc\xa3\x87\x67\x67\xf0\x91\xad\x2c\xc2\x87\x30\x95\x43\xd9\xe5\x7a\x9d\xc2\x9e\xe0\x01\xef\x5a\xb0\xcf\x87\xf1\x03\xb8\x50\x02\x8f\x28\x81\x9c\x41\x98\xb3\x75\x2b\x21\x6e\x9e\x9d\x14\x93\xca\xf5\x0a\x12\xf7\x10\xbe\x3e\x3b\xc5\xb8\x0f\x7d\x14\x5f\x3f\xe2\x2f\xff\x80\x60\x83\x05\xd9\x52\xce\x56\x52\xf0\x57\x04\x4d\x31\x63\xed\x09\xab\x01\x5d\xf7\xec\xac\x0f\xb7\x7a\x09\x86\x83\xd9\x14\x86\xfc\x8b\x03\x79\x56\x7d\xff\x96\x57\xae\x47\x47\xe8\x32\xb9\x08\xf2\x70\xc1\xa2\x4a\xe2\x29\x4a\x93\xe5\x47\x38\xd2\x8c\x71\x0b\xc7\x19\x82\xf8\x47\x97\x49\x9c\x0f\x50\x9c\xa0\x10\x1e\x18\x81\x1b\xa2\x60\x72\x13\x83\xb2\x29\x78\x29\x97\x4f\x24\x94\xcc\x60\x8e\x1a\x54\xbc\xd1\x2a\x88\xd7\xd6\x26\xe3\x06\xd3\x18\x01\xfe\x84\x57\x0f\x0f\x35\xb6\xda\xdb\xc9\x82\xb6\x92\xdc\xec\x99\x3f\x11\xb3\x8a\x82\xe4\x74\x4f\x33\xc7\xb9\x6d\x32\x63\x09\x7c\xdf\xe3\xb3\xd0\xa6\x0e\x57\xcb\x44\x1c\xa5\x4d\x44\x71\x12\xb1\x6b\x74\x82\x0e\x49\xe7\x44\x55\x66\xcc\xfd\xfd\xd6\x74\x75\x70\x50\x30\xb0\x4d\x57\x5c\xe0\x77\xbc\xcd\x7b\x63\x7a\x82\x0e\x7c\x76\x76\xd6\x46\xb3\xa2\x7b\x78\x22\x7b\xdc\xd4\x10\x0d\xe8\xe0\xa4\x61\x94\x92\x0c\xbf\xfd\x16\x11\x2c\xfb\x31\xdc\x4e\xe1\xd9\x51\x13\xc0\x52\xad\x22\xbd\xa0\x4f\x42\x1e\x41\xc1\x36\xdc\xec\xc4\xeb\x4f\xef\x34\x4e\xcb\xa0\x0b\x17\x2c\xfc\xf0\x2a\x0c\x96\xc1\xfa\x7f\x01\x9f\x1e\xc4\xdf\x8b\x34\x4e\x1a\x9f\x5e\x86\x04\x5b\x02\xea\x23\x48\xf5\x71\x31\x8a\x68\x5c\x97\x2f\xd6\xe9\x15\x7a\xbc\x5e\xa7\xeb\x5e\x23\x00\xfe\xf6\x9f\xc1\xf5\x51\x95\x7a\x7e\x3a\xd8\x47\x07\x15\x97\x61\x9e\x16\x53\x8b\x1e\xf1\xfb\xc3\x3c\xfd\x69\xb5\x62\xeb\xd3\x20\x63\xbd\x3e\x3a\x68\x27\x0a\x69\x2e\x49\x73\x14\xf0\x83\x1a\x65\x0f\xd8\x6f\x6c\x60\x34\x79\xfd\xdd\xe7\x9c\x5e\x55\xee\xe2\x4b\x08\xb0\x2e\x51\xf9\x61\x50\xec\x78\x6a\xb2\xb6\x08\x88\x86\xa0\xea\xa9\x7e\xfc\x56\x52\x28\x42\x97\xdf\x4b\xbc\xa6\xb8\x6f\x17\x42\x45\xce\x3a\x95\xe4\x2a\xc2\x4d\x12\x41\x04\x29\xac\xef\x89\x5e\x7e\x76\x46\xc5\x50\x2d\x46\x69\x72\x08\x37\x78\x50\xc6\xfe\xf7\x25\x4b\xc2\x7a\xc6\x34\x6b\x57\x89\x60\xf2\xa1\x4c\x77\xd9\xc7\x8b\x59\xba\x6c\x49\x77\x6d\x42\x53\x5c\x17\xda\xb1\x10\xba\x64\x64\x76\x8a\x5f\x38\x84\x08\x87\x9c\x62\x2b\x6d\xca\xe7\x7c\x1b\x24\xc6\x78\x43\x62\xd7\x42\x62\x4d\x8a\x98\xde\x56\x45\xe2\x14\x3a\x62\xa1\xe3\x63\xac\xa7\x79\x70\xd2\xd2\x05\xfc\x5b\xda\xe6\x31\xde\xb2\x8d\x77\xb7\xde\x24\x54\xa8\x3a\x2e\x54\x3d\xdb\x55\x55\xe2\xec\xd8\xd0\xce\x46\x15\x95\x26\x9d\x4a\x72\xfa\x5c\xa1\x76\x4f\x4e\xcf\x2e\x95\xc0\x42\x0a\x4b\x2c\xae\xe7\x4a\x31\x60\xed\x6d\xfb\x2a\x70\xaa\x9f\x38\x55\xad\x6f\x35\x7b\x2a\xc9\x34\x7f\x0d\xd1\x53\x8c\x51\x77\x33\x8b\x2a\xd9\xd5\x34\xd0\x4e\xa7\x14\x11\x0e\x4e\x34\x89\xda\x26\x2e\x84\x3b\x2b\x92\x5a\x97\x7e\x91\xd1\x70\xcd\x82\xe8\x34\x4d\xf2\x38\xb9\xe4\x27\x8e\xf2\x80\x6f\x32\x1f\x24\x70\x10\xfc\x7b\xb0\x15\xcc\x5b\xe0\xcd\x29\x5c\x7b\xd8\xcc\x51\xf6\xbf\x4f\x7e\x0f\x96\x71\xc4\x77\x81\x14\x51\xb1\xdf\x10\x62\x37\x7b\x8d\x61\x10\x6e\x08\x88\x0a\xee\x7c\x75\xfc\x5d\x29\xd4\x7b\x91\x87\xb6\xc9\x96\x90\x83\x83\xe9\x5e\xa3\x66\xbd\x2d\x16\x40\xeb\x54\x64\x33\xe8\x9c\x2d\x4a\x0a\x97\x36\x36\x77\xcf\xac\x34\x84\xa3\x5f\xe7\xe8\x8a\x21\xb8\x1a\xbd\x5c\x21\xb8\xe2\x1f\xa0\x38\xff\x7f\xff\xe7\xff\xca\x99\x96\x4a\x9e\xab\xbe\x67\x65\xec\xad\x66\x9b\x36\xbf\x69\x89\x8a\x22\x15\xbc\xe2\x69\xa7\xf1\xc6\x81\xec\x9c\x2d\xdd\x1a\xbe\x72\xda\xbf\x72\xdb\xbf\xf2\x9a\xbf\x2a\xfb\x59\x8b\xff\x4a\x0f\xa3\x6f\xbf\x54\x60\xd6\xd9\x9e\x58\x74\x08\x11\x2b\xf3\x60\x99\x31\x2b\x16\x47\x47\xe8\x25\x0b\x22\x34\x8f\xd7\x59\xde\xec\x77\xf8\x90\xdc\x6d\x7f\xd8\x9e\x3a\xa2\x5e\x92\x6e\x07\x53\xb6\xbd\xdd\x02\x6c\xd2\x83\xef\x08\xe7\xad\x4c\x48\x75\x06\x69\x09\xa4\x66\x83\x98\x26\x88\x1b\x22\x3c\x96\x5d\xf8\xb4\xb5\x0b\x03\x3d\x07\x9d\xb4\xa4\xc2\xa9\x71\x20\x51\xd9\xc1\x44\xf3\xf8\xb8\x28\x46\xd0\xd2\x09\xa4\x90\x15\x89\x6f\xf5\x29\x46\xb1\x54\xd9\x66\xba\xdb\x8a\xce\x6e\x59\xa2\x7d\x40\x6b\x76\xd1\xc6\x8c\x18\xf5\x2e\x82\x8f\x28\x4e\xc2\xe5\x25\x5f\x29\x83\x15\x30\x75\x39\xce\x1c\x47\x67\xd2\x89\x8f\xef\xd4\x89\xd0\xc6\xed\xd8\xa6\xcd\xf1\xb8\x70\x3c\x4c\xc6\x3f\x15\x79\xc1\xa9\x05\x82\x6b\x1d\x08\x98\xe2\x56\x2d\x77\xba\x96\xf8\x97\x8b\x1f\xaf\x5b\x17\x3f\xa3\x22\x3a\xce\xbe\x7a\x74\x40\x1b\xaf\x63\x9b\xd6\x88\x1a\xf3\x88\xc2\xd7\x9b\x31\x85\xaf\xf1\x69\x1f\x7d\x6a\x24\x06\x7f\x1c\xe9\x0a\x64\x19\x80\x9e\x7d\x00\xf2\x85\xc0\x86\x05\x29\x82\x4d\xab\x89\x96\x61\x66\x13\x07\x8d\xa1\xf6\xd3\xeb\xb3\x43\x8a\x22\x7e\x8f\x97\x45\x9b\x71\xb6\x41\x45\x4e\x29\xc4\xf1\x4d\x4d\x5f\xf1\xe1\xba\xf9\x2b\x3e\x84\x4e\x9b\xa7\x47\x70\x8d\x25\xae\x96\xaa\xb9\x7c\x93\x5d\x4a\xf6\xf5\x4b\x2c\xa5\xd1\xb4\xb1\x0d\x97\x4b\x1d\xd5\x5b\x2f\xb2\x4a\x51\xdb\x6f\xbb\x95\xde\x68\xdb\xde\x04\xa0\xfc\x62\xd5\x7a\x75\xd5\xcb\x2f\x56\xe8\x64\x63\x52\xd8\x47\x7f\x83\x9b\xab\x30\x97\x69\x8b\x88\x8a\x71\x71\x0f\x21\xbf\x58\x35\xf4\x81\x9b\xbd\x96\x28\x52\x16\xf3\x2b\x4a\xfd\xaf\x76\xc9\x04\x56\x02\xc7\xa3\x93\x06\x75\xf7\xa1\x9a\x49\x9c\x26\xfb\xf7\xd1\x3e\xdf\xc2\xb0\xbf\xbd\xb7\x75\xbf\x50\x66\xff\xbe\x72\x8d\xde\x80\x8a\x98\x82\x2a\xde\xd4\x40\x9b\xc7\x82\xc1\xce\xbb\xf4\x82\xc1\x91\x72\x68\x76\x19\x2f\x23\x94\xae\xf2\xf8\x22\xfe\x0f\xb6\xce\x06\x68\x19\x7f\x60\x68\x3d\xfc\x2d\x1b\x14\x03\x07\x2f\x47\x93\xad\x58\x08\x9b\xc0\x61\xe8\x8f\x62\x1e\xd2\xab\x20\xcf\xd9\x3a\xa9\xef\xdd\x83\x5b\x7d\xd0\x1e\xee\x6a\xcd\x53\x28\xaf\x13\x27\xe7\xf7\x6b\x10\xc8\xc5\xb5\x0f\xe0\x6f\xf3\x78\x3c\xb4\x2f\xbb\x0e\x6c\x6b\xd0\xc3\xe1\xd0\xbc\x8d\x4d\x01\x5b\x0d\x2a\x64\xed\xab\xa6\x38\x14\x87\xea\x49\xfe\xbd\xb6\x58\x95\x31\x97\xcf\xe9\xd4\xbc\x6d\xe6\x7f\x94\xab\x60\x1b\xdb\x2e\xfe\xa6\xbc\x1f\x26\x69\xc4\x5e\x7f\x5c\x35\xf6\x0f\xd9\xb6\xd8\x08\x22\x96\xcb\xe2\x44\xdd\x7b\x01\x87\x4d\xa4\xff\xfe\x0a\xfd\x8e\x87\x74\x88\x9b\x57\xe4\x2b\x12\xd5\x5e\x90\x36\x25\xc4\xe0\x2e\x19\x05\xeb\xab\x45\xb0\xdc\x60\x34\x1e\xe2\xc3\x46\x46\x10\xfd\xe2\xa1\xa6\xe2\x68\x3a\xf9\x85\xfa\x03\xa0\x45\x90\xfd\x78\x95\xbc\x90\x4f\x88\x9c\x88\x56\xc3\xfa\xe7\xd3\x3d\xed\x36\x2b\x38\xe2\x38\x2e\xd6\xb2\xda\x1c\x06\xbf\x75\x9a\xc5\x76\x35\x68\xc3\xcf\xe7\xec\xc3\x08\xd6\x53\xfc\x01\x15\xee\xde\x0b\xf3\xf0\xd7\x1b\x5e\x6d\x4f\x48\x37\x7b\x1a\x43\xbe\x5c\xc4\x49\x0a\x66\x0c\xd0\x15\x9b\x21\x71\xd8\xe2\x16\x0d\xd8\x68\x32\x14\xf9\x63\xdb\x41\x4a\x1e\x2b\x8e\x59\xec\xcb\xbd\x6f\x37\x83\x3f\x60\x83\x95\xd3\x65\x83\xd5\xd6\xc1\x97\x6f\x9f\x3f\x7b\x92\xe7\xab\x97\x30\xa3\xca\x64\x45\x86\x82\xf2\xdf\x67\xf1\x79\xf1\x78\xc8\xf0\xb7\xec\xef\x5d\xb7\x71\xa9\x8d\x61\xf3\x16\x1e\x8e\xcb\x5b\xb6\xe7\x71\xbe\xb8\x9c\x0d\xc3\xf4\xe2\xe8\x79\xfc\x81\x3d\x0f\x97\x47\x2a\xfc\xe8\xd9\xf7\xa7\x8f\x7f\x38\x7d\x8c\xd4\x44\xab\x1e\x15\x7a\xde\xb8\x69\x67\x1f\x36\x1b\xc1\xb2\x6a\x98\xef\x4f\xf7\x6a\x5f\x1d\xc9\xed\x95\x0d\x52\x89\x6f\x1e\xa0\x7f\x0f\x7e\x0f\x5e\x85\xeb\x78\x95\xa3\x65\x3c\x5b\x07\xeb\x8f\x3c\x25\x06\xeb\x59\x9c\xc3\xbb\xc3\xd5\x9a\x85\x71\x06\x39\x31\xe0\x15\x32\x59\x1e\x87\x72\x17\xa8\xa5\x6a\x02\x7d\x9a\xae\x3e\xf2\x67\x61\x51\x2f\xec\x23\x07\x93\x11\x7a\x1e\x87\x8b\x80\x2d\xd1\xf3\x70\x19\x5c\x9e\x2f\x96\x71\x82\x8e\x9f\xd3\x70\x41\xe9\xf2\x1f\xe7\x17\x41\xbc\x04\x9a\xdf\x8a\xf6\xcf\xbf\x7f\x8d\x1e\x5f\xaf\x82\x1c\x3d\x8b\xc3\x72\x26\x0c\xe6\xda\xdb\xec\x75\x0f\xe3\xf3\x1f\xb8\xc6\x03\xc4\x0f\xab\x4d\x07\x68\x15\xac\x33\xf6\xc3\xe5\x05\x5b\xc7\xe1\xf6\x30\x13\x67\xe2\x2b\x74\x82\x8e\x7e\x3e\xfc\xae\xf7\xcf\xe8\xa0\xf7\xcf\xe1\x3f\xa3\x6f\xfa\xdf\x7d\x82\xff\x0f\xfa\x3d\xf6\xee\xe0\xf0\xfd\x77\xf0\xf2\xbb\xff\x79\x14\x6f\xd3\x80\x1d\x7a\x21\x8b\x61\xed\xfe\x79\x90\x2f\x86\xf0\xba\x19\x35\x5f\xa6\xe9\x5a\xc2\xf8\x9b\x6d\x5c\x92\xe6\x0f\xd3\x74\x89\x4e\xd0\x3e\x4a\xd2\x1c\xfd\x7f\xea\xde\x7d\xbb\x6d\x5c\xc9\x1b\xfd\x5f\x4f\x51\x59\x6b\x77\x4b\xb2\xa9\x9b\x2f\xb9\xd8\x51\xbc\xdc\x89\xd3\xdb\xe7\x38\xb6\x27\x71\xef\xdd\x33\x8e\x3b\x8b\x92\x20\x9b\xb1\x44\x2a\x24\x65\x4b\xbb\x93\x79\xa7\xf3\x0a\xe7\xc9\xbe\xf5\x2b\x00\xbc\x89\x04\x29\x25\xbd\x67\x3e\xca\x9d\xb6\x29\xa0\x00\x14\x0a\x85\x42\xa1\x2e\x36\x0d\x3c\x6f\x22\x6c\x17\x6b\x6b\xe0\xb8\x98\xa9\x91\x73\xeb\x84\x39\x5b\x2b\x27\x3d\x71\xdc\xdb\x77\xb0\xc5\xe8\x53\x5d\xff\x0d\x33\x38\x91\x53\x3e\xf4\xbc\x77\xb6\xbb\x7c\x03\x70\x90\x8a\xea\xca\x41\x0a\x1b\x17\xdd\xd9\x01\x4d\x3d\x1f\xdb\x9e\xed\x52\x6f\x3f\xe5\x33\xc5\x3d\x08\x72\x40\x1e\x9f\x5d\xfe\xfd\xf8\x97\x93\x2b\x40\xeb\xf6\x76\x76\xf7\xf6\x9f\x3e\x7b\xfe\xc2\x1e\x0c\x47\x62\x7c\x7b\xe7\x7c\xbe\x9f\x4c\x5d\x6f\xf6\xc5\x0f\xc2\xf9\xc3\xe3\x62\xf9\xaf\xe3\x5f\x5e\xbf\x39\x79\xfb\xeb\xdf\x4f\xff\x9f\xff\xf7\xec\xdd\xf9\xc5\xe5\x7f\xbc\xff\x70\xf5\xdb\x3f\xfe\xf9\xfb\x7f\xfe\xd7\xdf\x3e\xe5\x80\xff\xe5\xf8\xc3\x09\xf5\xa9\x27\x7a\x7b\xab\x5f\x9e\x5d\xfc\xfa\x49\x17\xc8\xf9\xfa\xdd\xf1\xef\x9f\x3e\x1c\xbf\x3d\xf9\x74\x7a\x7e\x75\xf2\xeb\xc9\x7b\x88\x8a\x8b\xde\x38\xf9\x58\xba\x2c\x98\xda\xce\x1f\xfb\xbb\x48\x2d\xb7\x02\x08\xe9\x74\x8f\x7f\x07\x98\x5d\x9c\xa6\xba\x8b\x67\x2b\xd5\xe3\x0f\x03\xda\xed\xe5\x02\xba\xbc\xf8\xe7\x87\x4f\x57\x27\xe7\x10\x48\x7b\xf0\xcb\xc1\x7f\x5d\x8b\x7a\x62\x17\xff\xec\xe1\x9f\x7d\xfc\xf3\x14\xff\x3c\xc3\x3f\xcf\xf1\xcf\x0b\xfc\xc3\xa5\x45\x0f\xf5\x44\x6f\x87\xff\xdd\xbd\x59\x1d\xf5\x87\xff\x78\x7f\x15\x61\x45\x3c\xcb\xb1\x35\x8f\xf8\x05\x56\x15\x9b\x7c\x4f\x9c\xa9\x13\x92\x07\x1f\x45\xa1\x4c\x11\xbc\x31\xbd\x39\x79\x7d\xfa\xee\xf8\xec\xd3\xe5\xd9\xf1\xeb\x93\x0f\x16\x5d\x5d\x7c\x3a\xf9\xfd\xf2\xd3\xf9\xc9\xaf\xd1\xef\x97\x17\x1f\x2c\x7a\x77\x7a\x8e\x3f\x2c\x46\x12\xff\x62\x27\x53\xf1\x6c\x31\x54\xdb\xbf\x9d\x4f\x85\xab\xbc\xe5\x3d\xec\x4d\xae\x70\xe1\x79\x68\x51\xe8\xbd\x75\x16\x62\xc4\xbf\x78\xfe\xd4\x0e\x95\x0d\x98\x77\xa9\x79\x92\x45\x03\xb1\xf4\xdc\x91\x4e\x79\x98\x48\x97\xb3\x85\x84\x1c\x82\xdd\xfe\x70\x45\xcf\xe7\x27\x97\x1a\xce\x98\x4e\xde\xbf\xbf\x78\xff\x81\x5f\xfa\x73\xd1\x6c\xd7\x4c\xb6\xe3\xef\x8e\x7f\x07\x19\x9d\xbc\x38\xd4\x6f\x0c\x4f\xa7\x43\x5d\x8c\x23\xa2\x8a\x5a\x3e\x47\xd6\x86\xfb\x3c\x1c\x25\x6a\xd9\x31\xd3\x82\x1c\x1a\x84\xfe\x1c\xd1\x88\xdb\xb5\xbc\x9e\x45\xbb\x82\x72\x61\x86\xbe\x7e\xec\xdc\x5e\x0c\x3e\x67\xf7\x07\xcd\x11\x47\xce\x43\x91\x7b\x41\x87\x9c\x11\x85\xbe\x3d\xe4\xc4\xd0\x82\x20\x37\x08\x3f\x6a\xc3\xa2\xc0\x23\xf0\x05\x04\xf1\xd5\x99\x2a\x95\xfa\x6a\x04\xf3\x30\x01\xfd\x07\xa9\x28\xe3\x05\x3e\x93\x0e\x7c\xaf\x0b\xf2\x3e\x5f\x52\x3f\x1e\x7b\x7b\xe6\x7b\xa1\x07\xc6\x93\x5f\xf8\xe2\x1c\x04\x8c\x58\x05\x51\x95\x46\xaf\x69\xd5\x6a\xb9\xa5\x3b\x5b\x65\x0f\x9d\xbc\x39\xbd\x3a\xfe\xe5\xec\x84\xde\x9c\xbc\x3d\xfe\xed\xec\xea\x03\xe9\xaf\x8a\x9f\x4e\x61\x73\xe9\xd7\x72\x15\x8d\xc4\xd8\x9e\x4f\x42\x9d\xcf\x73\x20\x60\x2f\x38\x9d\x43\x85\x0d\x15\x7b\x28\x6e\x85\x1f\xb0\x07\xbe\xf2\x3d\x65\xe4\x06\xce\x83\x20\x1f\xe9\x73\x02\x0a\x10\xe3\x21\xeb\x95\x2f\x81\x2b\xa0\x98\x16\x7b\x12\x70\x8e\x4f\x99\x74\x67\x44\x76\x48\xfe\xdc\x6d\x85\xce\x14\x79\x3f\x91\xc6\x36\xc6\xb2\xa4\x97\x2c\xc4\xcc\x11\x4f\x7f\x60\x82\x76\x27\x68\x6a\x2f\x9c\xe9\x7c\x9a\xf0\x9e\x1d\x89\xa1\x33\x45\x8a\xbe\x89\x3d\x14\x01\x0b\x0e\x90\x3d\x59\x75\x0a\x4f\xda\x07\x6f\xf2\x80\x76\x47\xce\x03\x8b\x10\xed\x5c\xf0\x69\x7e\x42\x7d\xda\xe9\x5a\x65\xcb\x6b\x1d\xfc\xa7\x76\x3f\x95\x01\xf5\x4e\xb8\xf1\x7b\x15\xa7\xc3\x1e\x78\x0f\x22\x33\x26\xc9\x71\xb8\x38\xa3\x30\x0b\xbf\x8c\x63\xad\x32\x2c\xbc\xe0\x96\xa9\x91\x22\x8c\x24\x1f\xc2\x67\x8b\x7e\xbb\xd4\xbf\x53\x97\x8e\x1f\xed\xa5\x34\x01\xfc\x97\xf0\xbd\x95\xb2\x6f\x2e\xfe\x79\xae\xfe\xe8\xd1\x95\xf7\x68\xc3\x87\x24\xb7\xe4\xeb\x93\xd3\x33\xf5\xc7\x4e\x54\x72\xfb\xd4\x1d\xc3\xb9\x33\xeb\x2f\xb4\x45\x6f\xcf\x2e\x2e\xde\xe3\x57\xa2\xdd\xa8\x78\xab\xb0\xf8\xdf\x8f\xcf\xde\x7e\x92\x1d\xdf\x8b\x8a\xbb\xc2\xf6\x45\x10\x92\x2b\x9c\xdb\xbb\x81\x37\xf7\xdb\x74\x3a\x26\xc8\xd3\x23\x07\x19\x55\x42\x8b\xe6\xb3\x7c\x50\x72\x5c\xfb\xeb\x80\x1a\x79\x8f\x6e\x3e\xb0\x93\x7f\x9c\x9c\x13\x3d\x5d\x07\x58\xa8\x8a\x8a\x07\xe1\x26\xca\xe5\x82\x97\x98\x7d\xb6\x09\xf8\x62\xfc\x33\x64\x39\x09\xcf\x37\x81\x5c\x38\x55\xab\x5b\x1d\x3e\xef\x2f\x7e\x3b\x7f\x73\x7a\xfe\xeb\xa7\x77\x17\x6f\xc0\x68\xf7\xf2\x57\x62\xbc\x16\x9f\x17\xac\xc4\x0e\x9d\xfc\x7e\x79\x71\x7e\x72\x7e\x75\x7a\x7c\xf6\xe9\xf8\x8a\x0e\xe8\x3a\x16\x14\x28\x29\x29\xdc\x14\x82\xc0\xea\x15\x6a\x7d\x29\xf9\x43\x2d\xa9\x81\x70\x85\x1d\xde\xa9\x7d\x5f\x5b\xee\x29\x55\x59\x10\x55\x42\xfe\x50\x17\x61\x64\x0a\x99\x4f\xa7\x43\x6a\xd3\xc5\x9e\x73\x40\xad\x67\xb9\xc5\x12\x5d\xef\x53\xeb\x59\x11\x56\x62\xbc\xb4\x8a\x99\x94\x69\x5c\x92\x07\xfd\xf0\x51\xed\xf4\x4c\xa3\xba\xbc\x40\x1a\x8e\x9d\x5e\xf9\xa8\x4c\x83\x7a\x7f\x7c\xfe\xeb\x09\xa6\x39\x2b\xf7\x99\xe7\x77\xea\xb8\xbc\xab\xa4\xf1\x61\x65\x66\x78\xee\x8e\x84\x3f\xc6\xc6\x19\x7a\xcc\xd7\xc8\x1b\x0e\xe7\x7e\x50\x6d\xf8\xad\xdd\x9d\x3d\xa2\xc6\xbe\xc0\x2f\xab\xd7\x6b\xf8\xa8\x4e\xb3\x6d\x8e\x30\xcc\x2f\x60\xb7\x7a\x95\x66\x58\xef\x97\xd9\x91\x25\xe7\xd8\x7b\x88\x87\xa5\x57\xea\x5a\x43\xa3\xdd\xee\x73\xa2\x46\xaf\xfd\xec\xc5\xb3\xa7\x2f\x76\x7b\xbb\x7b\xcf\x9f\xee\xec\xf6\xf6\x9f\x89\xed\xdd\xee\xf3\xfc\xb1\x76\x3a\xf4\xd6\xf3\xf5\xf4\xd0\x2b\x79\xa6\x10\xed\xdb\x76\x46\xb0\xaa\xe3\x74\xa1\x9e\x7a\xb3\x3d\x9b\xcc\x83\x46\xaf\x49\xb8\xbf\x1c\x08\x0a\x26\xde\x63\x7e\x27\x35\x64\x79\xd2\xd0\x6f\x73\x1e\xdc\xad\x97\xd1\xd5\x3f\xef\x04\xbc\x3e\xe2\x6e\xc9\x0b\xb7\x80\x6c\x5f\x90\x78\x00\x26\x58\xb6\xcf\xef\x8a\x12\xf6\xfb\x14\xfa\xc0\x7d\xf1\x83\x84\xec\xfe\x5c\xe0\x74\xcd\x16\x09\x85\xfd\x79\xcd\xe2\x15\x7a\xed\xb8\xe1\x3f\x70\x0f\x63\x87\x9e\x7f\xee\xa9\x5e\xa5\x0e\x18\x0c\x29\xbf\x63\x4e\xc0\x75\x4f\xf9\xa2\x2b\x09\xe9\x9f\x4e\x78\x27\x61\x59\xba\xc9\xfc\xaf\x3b\x79\xed\x97\xa2\x31\xf4\x20\x02\x29\xb5\xc8\xad\x6f\xcf\xee\x1c\x08\xfc\xcb\x56\x20\x86\x73\x9f\x45\xce\x91\x17\x09\x79\xb7\xc2\x55\xf2\x9c\x05\xfd\xae\xfd\x60\x3b\x13\xf8\xc8\xe5\x8f\xe9\xf5\xfb\xff\xbc\xbc\xba\x20\x75\x0f\x62\x7d\x37\xb2\x73\xc5\x39\xd6\xe6\x79\x59\x61\x6e\x68\x4f\x86\xf3\x09\xa7\x2c\x60\x61\x8e\x4b\xcd\x83\x03\xb2\x51\x92\xb2\x7c\x52\x4a\x86\x5f\xe6\x5e\xe8\x08\x37\xa4\xc6\x17\x38\x3f\x50\x07\x21\x4f\x9d\x20\x02\x26\x46\x64\x0f\x87\x9e\x9f\x94\x12\x87\x9e\xef\x8b\x60\x86\x9b\x02\xf7\x36\x96\x21\xd1\x9d\xdc\x36\x7c\x31\xb5\x61\x47\xe3\x53\xc3\x5f\x01\x1e\x1c\x10\xf4\x40\x36\x82\xd2\xd1\x16\x7d\xc9\x42\x28\x16\x09\xbb\x19\xe0\x4e\x40\x33\x2f\x70\x42\x9c\x1a\x9c\x31\xa3\x00\x52\xf7\x08\x46\x54\xb0\x50\xe7\x74\x0e\x0f\xc2\x52\x06\xab\xf1\x1b\x83\x28\xd9\xcb\xb4\x01\x4d\x10\x00\x07\x38\x08\x42\x0b\x44\x76\x90\x6a\x69\x05\x96\xfe\x0d\x1f\x0e\x16\x91\x9c\x3c\xe0\x82\x73\x15\x4d\x96\x74\xef\x7a\x8f\x0c\xae\x1e\xfa\x73\x77\x88\xe3\x4e\x74\x6a\xa8\xf3\x8e\xef\x04\x26\xe0\x10\x26\x1f\xec\x09\xe6\x32\xf4\xa8\x61\xd3\x4f\x3c\x95\x6e\x42\xbf\x69\x10\x6d\x77\xd7\x19\x68\x80\xf4\xcc\x97\xcb\xf0\xce\x73\xe9\xa7\xa6\x41\xd2\x7c\x9a\x33\x64\x44\x30\x53\xea\x8e\x3b\x41\xa7\x27\x27\x27\xf4\x6c\x7f\x2f\xd1\xb4\x3e\x75\xaf\xc0\x3d\xf9\xed\xf5\xd9\xe9\x1b\xfc\xf1\x82\x4e\xe6\xc3\x89\x33\x72\x6c\x37\x3e\x59\x11\x28\x18\x5d\x6d\xb8\x4d\xda\x22\x56\x2a\x36\x6c\xea\x90\x3d\x08\x1a\x6e\x73\xb5\x9f\xc9\x27\x3d\x7a\x78\xd7\x4d\x1e\xed\x65\x4c\x51\xd9\xca\x59\x58\xa8\xbf\x3a\x6f\x96\xec\x45\xea\xcd\x6a\xcf\x79\x72\x57\x11\x91\x6d\x02\xeb\x4b\xb2\xfc\x88\x66\x78\xf1\xe3\xcc\x99\x58\xef\xf1\xf9\x73\x65\xc0\xc7\x93\xf0\xce\x9b\xdf\x22\x3c\xba\x20\xd6\x9a\xa4\x57\x6f\xfa\x10\x0d\xe0\x08\xdb\x20\x96\xbc\xdd\xc1\xee\xf0\x16\x6b\x6b\x1e\x88\xf1\x7c\x42\xbe\x08\xe6\x93\x30\xbb\x47\xe7\xe8\x8f\xf0\xf3\xee\xe2\xcd\x6f\x67\x17\x5a\xa4\xae\x20\x66\xbd\xa8\x24\x57\xe4\x47\xb1\x92\x1a\x59\xbc\xc5\x38\x65\x3f\xf5\x5f\xa1\x77\xe9\x3d\x0a\xbf\x10\x49\xea\x23\x2d\x30\x2f\x2f\xfe\xf9\xe9\xf2\xfd\xc9\xeb\xd3\x0f\xa7\x17\xe7\xd0\x96\x75\x19\x1f\xbe\xa0\x47\x67\x32\x61\x1c\xb9\xac\x20\x14\xa3\x9c\x0e\xe4\x43\x4e\xc3\xec\x4b\x2d\xa7\x09\x13\x65\x32\xd6\x58\x9e\xb2\xf5\x0d\x2e\xcf\x3c\xf6\x37\x58\xd6\xa2\xb7\x79\x8a\xa5\x76\x74\x38\x9f\x8a\xf0\xce\x1b\xb5\x73\x1b\x78\x7b\xf1\xfe\xdd\xf1\x55\xee\xed\xb6\x7e\x94\xaa\xe0\x83\x40\x50\xb3\x10\x41\xe4\xeb\xed\xba\x55\x58\xfc\xd6\xf7\xe6\xb3\x64\x61\xab\xb4\x30\xe7\x2e\xdc\x2d\x2e\x15\x08\x5c\x59\xdb\xfe\xf2\xd7\xb8\x78\x81\x96\x0d\x3f\x63\xdf\x66\x95\xde\xaf\xd9\x9e\x7c\x5c\x1c\x77\xeb\x6a\x26\x38\x7b\x8e\xdb\x1a\xf8\xc2\xbe\xc7\xda\x08\x66\xf6\x50\x54\x04\xc9\xfd\xed\xe6\x16\xfe\x96\x0d\xac\x50\x4d\x3f\xb7\xf1\x93\xa7\xa3\x83\xf4\x76\x71\xfe\xe1\xea\xfd\x6f\xaf\xaf\x2e\xde\xe7\xf6\x27\x7e\x25\xd9\x5a\xae\x52\x96\x79\x96\xbc\x4e\x14\xa3\x3c\x96\x9d\xaf\xe0\x85\x70\xad\x73\xc8\x62\x49\x26\x55\xbe\xea\x5a\x38\x01\x22\x09\xcd\xd5\x31\xed\xbe\xe2\xaa\xcf\xbd\xfd\x1a\x55\xe4\xc8\xad\xea\xea\x8a\x4f\xc8\xa9\x5e\x5c\x0f\x6e\x74\x4d\x19\xc4\x05\xf9\x0c\xd1\xb4\xdb\xa6\x53\xa9\x79\xb4\x68\x07\x0b\xed\xe9\x5e\xac\x76\x6c\xd7\x4c\x1c\x4d\x0f\x37\xee\x7c\x83\x5c\x8b\x06\xd4\x2c\x58\x2a\x50\x3f\x0f\x2d\x12\x16\x39\x16\xb9\xf3\xa9\x05\xdf\x4e\x0b\xae\x3c\xc5\x94\xba\x50\x01\x50\x32\x57\x9a\xfa\xd3\xe9\xd0\x89\x0b\x39\x34\x35\x2b\x73\xa4\xb9\x64\x45\xaa\x37\x87\xb6\xa9\xe0\x74\x02\x5b\x03\x7a\xd2\xa0\x45\x34\x19\xde\x38\x31\x13\x4d\x5a\x09\xa4\x91\x7c\x3a\x1d\xaa\xc7\x23\x6f\xa6\x3a\x00\x41\x3a\xd9\xfe\x01\xfd\xe9\x7e\xcb\x77\x05\xd4\x1d\x91\xe7\x93\x26\xf9\xb6\x13\x88\x06\xed\x3c\xb5\xa8\x6e\x02\x59\xb7\xc8\xa5\x82\x9b\xfa\x84\xe1\x46\xfa\x1c\xa7\xe6\x27\xbf\xd6\xb7\x5a\xee\x6b\x8c\x33\x0d\xa5\x29\xa9\x07\x5b\xa1\xed\x6a\xc5\xf5\x01\xfd\x39\xf8\x56\x5f\x0f\x02\x66\xc7\x1b\xe3\xa4\x71\x2b\x0c\xf5\x81\x1e\x1a\xc0\xf8\xc5\x9d\x4f\x26\xf4\xf5\x2b\x3d\x89\x0f\x4d\x0d\x1a\x58\xb4\x63\xd1\xd3\x3d\x8b\x9c\x91\x45\x75\xf4\xad\x5e\x61\xf2\xde\xcc\x67\x13\x6c\x14\x59\xa1\x26\xf1\x70\xc3\x6e\x11\x75\x14\xef\x07\xf8\x2c\xda\xb8\x40\x75\xdb\x86\x18\x5e\xf8\x59\xb4\x71\x31\xeb\xb6\x45\x59\xb1\x21\x22\xbe\x92\xcb\x85\x87\xd4\xa4\x23\x72\x55\x38\xa0\x26\x1d\x90\x6b\xae\x2e\x6f\x5d\xcc\x65\x24\xc1\x14\x97\xf9\x56\x33\xe3\xa9\x81\x25\x1d\x47\x6e\x71\xa9\x1f\xdd\x1f\x63\x3a\x7e\xfe\x99\x4f\x36\x5d\xbc\xee\x56\xc4\x5e\x0f\x07\x31\x7a\x49\x5d\x3a\x52\x63\x6f\xb9\x16\xb5\x7a\x84\x21\xf7\x0e\x6b\x46\x10\x50\x6e\xd8\x41\x48\x33\x28\x8f\x20\x1d\xea\x1b\x96\xe2\xf9\x4e\xcc\x39\x72\x97\xfe\xf7\x7f\xbb\xa5\x1d\x8d\xcc\x73\x08\xf3\xd8\xb5\x38\x12\x92\x8b\x48\x48\xaf\x20\xd5\xe0\x97\x0e\x7e\xb1\x48\x6c\x6f\x9b\xd6\xab\x7e\x24\x41\x94\x90\x43\x4c\x12\xd7\x6e\x81\x7f\xd6\xba\xd3\x5f\x85\x04\x4a\xc8\x00\x3f\x41\x88\x73\xac\x4b\xdb\xb9\x6e\x69\x59\x53\x21\x23\x28\x9e\x89\x27\x91\x41\x46\x3b\x14\x41\xd8\x48\x35\xc0\xab\x5c\x31\xba\xa4\x59\x47\x83\x16\x72\x5b\x61\x92\x2c\x41\xba\x5c\xa8\x41\xe8\x27\x43\x82\xb0\x61\x7d\x9f\xf6\xf6\x99\xf2\x64\x9b\x28\x22\x57\x5c\xaf\x99\xa4\x42\x0d\xc8\x6c\x1d\x55\x79\xe8\x48\xda\x3a\x28\x0e\x02\xbd\x8a\xe4\xc2\x62\xbc\x39\x06\xac\xcb\x91\x38\x62\xb9\x00\x12\x3c\x9f\x77\x04\x6e\xd0\xb3\x97\x6e\xb6\xbc\x80\x54\xe7\x22\xf0\x52\xc3\x7a\x41\xc2\x5e\x58\x3f\xe6\x2a\xa4\x29\x8c\xce\x4f\x12\x24\x80\x21\x0c\x82\xbe\xe9\x2f\x86\x1b\x33\xfc\x5e\x05\x56\xb1\x72\x0d\x5c\xc4\xb2\x8f\xc8\xa5\x03\xd0\x45\xd9\x3a\x54\x24\xc5\x58\x62\x5a\xca\x20\x69\x9b\x57\xe9\x36\xf5\xac\xcc\x3d\x49\x73\x23\xf6\x09\x34\x3e\x78\xce\x88\x66\x5e\xa8\x90\x08\x5e\xe5\xcf\x7c\xa1\x50\xe9\x8d\x63\xa5\x30\x44\xce\x73\xfb\x1c\x9a\x08\xc6\xe8\xde\xde\xb6\xba\xfc\x35\x4f\x94\xbb\xd4\xba\x3c\xc7\x4d\x4d\x18\x8e\x4e\xf2\x28\x37\xb6\x9d\x09\x8d\xe6\x42\xab\xb8\xae\x4f\xc4\x0d\x6c\x9d\xda\x3f\x86\xf3\x3f\x01\xe7\xff\x5a\x6c\xa0\x8f\xcf\x93\x06\x4f\xe6\x7b\x71\x7b\xb2\x98\x35\xa8\xfe\x47\xeb\x08\xc1\x19\x1a\x04\x66\x57\xbf\xc6\xef\xda\xa2\x48\x2d\x46\xea\xb2\x4c\x03\x86\x70\xb3\x5d\x27\x53\x8c\x06\xfd\xd4\x1b\x47\x07\x1f\x3f\xb6\x01\x6c\x88\x7a\xcd\xa3\xbf\xd5\xad\x01\xd2\x23\x3d\xa3\x23\xaa\x3b\x75\x3a\x50\xdc\x45\x32\x9d\x20\xf4\x9b\xa5\x74\x58\xc6\x87\x0c\x92\x57\x09\x7d\x00\xc9\xee\x7c\xba\xc9\x9e\x69\xe6\x5c\x46\x70\x68\x55\x6b\xb0\x7f\xfe\x19\xcb\xa6\xed\x0b\xbe\x0c\x6f\x50\xe7\x8f\xee\xc7\x76\x77\xeb\xeb\xc7\x76\xc7\x62\x4c\x29\x3b\x7c\x5c\x21\xec\x9b\x25\x2f\x93\x50\xb8\xa6\xb5\x59\x89\x54\xad\x3f\x4a\xa2\x86\x70\x98\xb2\x6f\x2b\x11\xa0\x4b\x26\x45\x8d\xe1\xd2\xc7\x8d\x70\x48\xd0\xe3\xfa\x09\x53\x72\x85\x0f\xc4\xd5\x90\x71\x56\xc5\x48\x0d\xaf\x78\x31\xe1\x83\x0d\x4b\xa9\xcd\xbf\x73\xfb\xfc\x0b\xf7\xb5\xc2\xaf\x24\xb9\xa9\x11\x23\x60\x26\x53\x20\x8c\xd8\x2c\x88\xe9\xe8\x52\x01\xc6\x0b\x80\x72\xe4\x42\x65\x87\x01\xc7\x99\xa3\x9a\x81\x0b\x09\x35\x18\x68\x2f\x17\x17\xe3\x46\xbd\x5d\xc7\xb2\x7d\x25\xc7\x14\x0f\x36\x22\x63\x68\x69\x98\x7c\x0b\x96\x02\x36\xd0\x0c\xa3\x34\x76\xc0\x51\x1d\x08\x84\xed\x0f\xef\x1a\xd4\x11\x1d\x87\x65\x94\x57\x4a\xe4\xcd\xad\x1c\x8d\x33\x14\xfe\x14\x8e\x01\x9a\x39\x97\xb0\x5d\xc1\x92\x71\x93\x05\x4f\xa7\x78\xba\x04\xe2\x2d\x6c\xc7\x93\x4c\x0e\x6d\x53\xcf\x44\xf9\x09\xb2\x98\x0f\xa4\x9e\x81\xb9\xac\x53\x38\x79\x71\xf4\x8b\xa8\x57\xe6\xc1\x2a\x9d\x43\xf1\x08\xf5\x5c\x16\xf9\xf7\x94\xd1\x8c\xc6\xe5\x44\xd8\x7c\x3f\x83\xab\xe2\x02\x49\x66\x0c\x3d\xbe\x8e\x60\x9a\x59\x2f\x8e\x5a\x2f\xcf\x39\x80\xb1\x89\x50\xe2\x26\x43\xdf\x76\x26\x95\xda\x9c\x08\x37\x3d\xcc\x6c\xf3\xad\xd6\x44\xb8\x71\x17\x0a\xd0\x9f\x98\x2f\x35\xc3\xac\x59\xd1\xd3\x9c\x5b\x07\x73\xc5\xdb\x5a\x31\x13\x59\xe9\x5d\x3e\x24\x3d\x7e\x27\xb0\x59\x16\x94\x5c\x4e\x8b\x91\xb8\x96\xcd\x65\xde\x58\xb4\x09\x7e\xdf\x36\xc1\xfe\x8b\x37\x0a\xe0\x02\x20\xe1\x18\x11\x6f\x78\x18\xbe\xda\xce\x8a\x77\x10\x70\x34\x68\xe5\x8a\xd0\xac\x49\x59\x50\x8b\x1c\x6a\x19\x37\xdd\x4e\x87\x2e\x94\x15\xc0\x91\xb9\xaf\x82\x5e\x45\x57\xeb\xc6\x95\xa6\xc0\x6a\xd9\xb1\x5d\x2b\x3f\x50\x2a\x85\xc4\x7c\x32\x39\x2c\x85\xfb\x9b\x3b\x2a\xeb\x6f\x96\x35\x68\xf3\x8a\x2a\xdd\xfe\xaf\x55\xb3\xb5\xfc\x33\xb0\xea\x74\x97\x6e\x0e\xcb\x3a\xf2\x67\xed\xbb\x0f\xdf\xaa\xd1\x9b\xc3\xd2\x01\x5c\xf9\xb6\x1b\xb0\x78\x0d\x49\xbd\xb4\xb8\xd0\x91\x12\xf5\x49\xa9\x7c\x23\x50\x35\x1d\xd4\x7c\x44\x80\x0c\x88\xee\xcc\xe7\xc1\x4a\xf0\xc7\xad\x08\x19\xa6\x8c\x95\x20\xe4\xad\xa3\xbe\x07\x1a\x7a\x62\x3c\x76\x86\x7c\xf5\x6d\x0a\x13\xae\x1e\xf0\x48\x4c\x23\x33\x16\xfa\x29\xb2\xaf\x37\xe3\x2b\x9a\x7b\x6c\x0b\x0e\x76\xa3\xb8\x5e\x79\x45\x84\x94\xc6\x52\x2c\x93\x7e\x75\x05\xa7\x09\xaa\x90\x8e\x95\xa9\x6d\x4f\x6e\x60\xc6\x95\xba\xca\x9d\x5b\x89\xce\xea\xae\x1c\x56\xea\x8b\xa2\x94\x9c\x8e\x38\x56\x1a\x0b\xb2\x53\xba\x4e\xd1\x53\xb0\xd9\x99\xf6\x01\xa7\x02\x58\x87\x12\x1d\x69\xa5\xb8\xbd\x2e\xb2\xf1\x6a\xc2\x8f\x43\xad\x3e\x23\xad\xf6\x1d\x83\x93\xf3\x71\x48\x4e\xab\xc5\x3b\x34\x68\xa8\xde\xad\x97\xa1\x2d\x8d\x7e\x6a\x6e\xac\xaa\xa9\x6d\xcc\xa4\x2a\x32\xa8\x82\xf1\x17\xe9\xed\xbe\x95\x5e\x69\xd1\xe5\xfb\x8b\xcb\x93\xf7\x57\xa7\x27\x1f\x72\xca\x46\x5b\x69\x5b\xb9\x0d\xc0\xf4\x44\xfe\x76\x68\x2a\xcd\xca\x0e\x58\xf5\xe6\xf6\x2a\x5b\x8e\xed\x47\xfa\xd4\x2b\x2f\xc9\x06\xb3\x7d\xda\x29\x2f\x29\x0d\x60\xfb\xb4\x5b\x5e\x54\x5b\x20\xf7\x69\xaf\x62\x61\xd5\xe1\xfd\x8a\xc5\xd9\x8a\xb8\x4f\x4f\x2b\x16\x57\x83\x7c\x56\xb1\xb8\x1e\xe9\x73\x53\x79\x65\x0b\xd2\xa7\x17\xf9\x97\xaa\xf1\xab\x2d\xe4\xfb\x18\x3b\xb7\xd0\x06\x3a\xee\xd8\xe7\x08\x36\xe1\x64\xd9\x62\x27\x01\x48\xab\xca\xeb\xaf\xf5\xe8\x8c\x10\xe1\x26\x84\x05\x55\x52\x7a\x4d\x01\x3b\x1e\x0e\xc5\x8c\xaf\x7f\x94\xf3\x2b\x5c\x05\xdd\x48\xb5\x47\x13\x27\x08\x2d\x25\x02\xba\x6c\x4b\x37\xb5\x5d\x95\x97\x22\xe1\x33\x4d\x2a\x63\x9a\x23\x02\xf2\x12\xa6\x1e\x5b\x50\xa3\xd8\x53\x88\xd4\x01\x4c\x2c\x66\x62\x08\xf3\x93\xc9\xf2\xa0\xa0\x3f\x2b\xce\x03\xf1\x6d\x67\x7c\xbf\xa9\x0d\x09\xe2\x0b\xce\x34\x88\xb4\x2e\xaf\x18\xc4\xf3\x22\x00\x19\x4b\x67\x0d\xe0\xab\xfc\xdf\xf5\x4d\x12\x12\xac\x46\x57\xfa\x93\x41\x82\xfe\xcd\xf8\x5c\xab\x7b\x8d\x08\x62\x97\xbb\xd7\xce\x8c\xb7\x7d\x93\x86\x2c\xad\x74\xe3\x27\xa7\xaf\xe7\x9e\xdb\xc2\x19\x86\x9c\xbf\xbe\xd3\xad\x9e\xee\xb5\xfe\xa6\x67\xea\xbd\x12\xd3\xf5\x2b\xfa\x53\xf9\x44\xaa\xee\x7f\x83\x2f\x23\xdb\x79\x2a\x03\xc4\x1e\x48\x30\x61\x8f\xb0\x15\x1b\x29\x7e\x17\x8c\xa4\x45\x4f\x02\x8b\xdf\x74\x19\x76\xd9\x80\x21\x4f\x8c\xae\x34\x80\xb4\x21\x4c\x31\x80\x14\xc6\xd3\x20\x94\x81\x8a\x7e\x45\x7f\xca\x25\x99\x04\xf1\x41\x98\x0d\x60\xd2\x00\x73\x2c\x5a\xd4\xb7\x7f\x4a\x6d\xc0\xb7\x95\xf2\x69\x93\x16\xfd\x65\x59\x79\xe7\x5f\x42\xbf\xa7\x14\xfa\x56\xca\xaf\xda\xb7\x98\xcb\xe7\x1b\xb8\x14\xf7\x67\xc5\x7a\xa5\xb0\x3f\xc9\xaa\x8d\x84\xe3\x95\x1d\xe0\xdc\x29\x46\x69\x27\x22\x35\x37\x8a\x49\x26\xb8\x9d\xed\x0b\xdc\x94\x48\x8d\xa1\x32\x5f\xe3\xb0\x41\xf0\xd0\x68\x16\x70\xb9\x93\x76\xd2\x53\x6b\x2b\x31\xa5\xd2\x8d\xab\x01\x87\xa9\x3d\x36\x2e\x4d\xd9\x41\x1a\xeb\xfc\x99\x65\x9d\x07\xec\x77\x95\x66\x86\x07\xb4\x47\xdf\x8a\xba\x75\x7a\xeb\xe2\xfc\x1d\x8f\xae\x93\xe0\xde\x01\xce\x1f\x9e\xbc\xb9\xf7\xfc\x38\x67\x5b\x6a\x20\xef\x95\x65\x4c\xb4\x9d\xc4\x49\x6f\x63\x94\x0d\xe7\xbe\x1f\x59\xcf\x07\x66\xdb\x94\xec\x28\xa9\x1f\x9b\xab\x14\xc6\xba\x80\x7d\xca\x83\x45\xb3\x62\x6b\x14\xa7\xd8\x79\x11\x3f\x10\xbf\xff\xfc\x56\xfc\xbd\x4d\xfd\x68\x8b\x0c\x8a\x8b\x79\x28\x76\xdd\xbd\x29\x2e\x01\xe5\x78\x9f\x3c\xa8\x29\xd4\xa5\x8b\x97\x8c\x0d\x52\x58\x0f\x3f\x47\x69\x4c\xc8\x53\x96\x97\x89\x43\xd1\x98\x25\xae\x56\x1b\xf4\x40\x7d\xf2\xae\x67\x37\x84\xe8\x36\x3c\x97\x87\xf4\xcd\xd8\x4a\x26\xc3\x92\x6c\xc5\x56\x67\x0b\x7a\x45\x4e\x16\xbc\x7d\xed\x6c\x6f\xa7\x1b\x28\x38\xa9\x75\x3a\x59\x8a\xd5\xcb\x34\xb3\x4f\xa7\x78\x66\xbb\x96\x85\xa3\x60\xd5\xd5\x3a\x68\x66\xa1\xae\xd8\xb7\x3c\x7c\xab\xaf\x0d\x24\x63\xe2\x52\x04\x82\xd1\x73\x67\x07\x0d\x42\x44\xa1\x7a\x1a\x88\xba\x44\x4b\xda\xbc\x3c\x58\x50\xc8\xbe\x3b\xfe\x9d\x6d\x5f\x66\xca\xdc\x45\x43\xcb\x3e\x99\x3e\xf5\xe9\xa1\xf8\x7e\x39\x7f\x5a\x7d\x4c\x7e\x3f\x03\xa8\x78\x7e\xd2\xcc\xa3\x60\x7a\x9e\xaf\x35\x39\x69\x90\x9b\xcd\x4d\x1a\xc6\x66\x53\x93\x82\x51\x38\x33\xcf\x2b\xcd\x4b\xba\x3f\x9b\x4f\x4b\x0a\xce\x61\x55\x47\x3c\x35\x2d\x4a\xdc\xb9\xbe\xf9\x56\x33\x2b\xeb\x8b\xc5\xbf\x55\x71\xae\x1b\x7f\x9f\xb7\x18\x6f\xda\xe5\x93\x95\xe9\xed\x66\x33\x9e\x01\xb2\xd9\x94\xa7\x81\x94\xda\x96\xf1\x5a\x76\x02\x8e\x6f\xd6\x78\x30\x53\x40\xa2\x7c\x82\x84\xc0\xfb\x19\xd9\x4c\x4b\x8a\x90\xb2\x74\x76\xdd\xbb\x59\x8b\x09\x64\x7c\xfd\xa4\x07\xe3\xc3\x75\xf7\xa6\x98\xe8\x72\xaa\x49\x17\xc1\x87\xeb\x5e\x85\x6a\xdf\x6a\x25\xea\xa3\xd5\x91\xeb\x61\xaf\x33\xa8\xd4\x80\x5a\x8d\x74\x4f\xb1\xb9\xe0\x42\xea\x88\x5a\x0f\x74\x40\x0f\x84\xb8\x7f\xdd\x0d\x74\x41\xa6\xf5\x77\x5d\x10\xfa\x82\x6e\x0e\x4b\xbc\x24\x57\xd6\xe0\x46\x87\xae\xc4\xd3\xe9\x14\x9e\xad\xd4\x6a\xcc\x3d\x5f\x55\x5e\x95\xb2\xdb\x1b\xb2\x5f\xae\x3b\xb4\x5d\x54\x1f\x08\x76\xdf\x5c\xaf\xee\x86\x2c\x1b\xed\xfe\x7b\x97\x6d\xab\x67\x5e\xb7\xbd\xf5\xd7\x6d\xec\x98\x5a\x7d\xd1\xc6\xfe\x97\xff\xab\x56\x2c\xaf\x7b\xde\xec\xa8\x99\x18\x57\xab\x91\xe8\xf0\xfa\x0b\x37\x95\x85\x21\x6b\x4e\x0d\x3c\x6f\x53\x3d\x43\x7d\x75\x8b\x1e\x4c\x20\x37\xe2\x05\x59\xb7\x67\x13\x17\x50\x7a\x8c\x15\xd5\x43\x8e\xe2\xa1\xca\x96\x29\xa1\x19\xa3\x44\x55\x5f\x36\xb2\x6f\xd5\xd6\xcd\x03\xdf\x51\x3f\x79\xf2\x00\xeb\x6c\xf9\x47\x2f\xfe\xb5\x5b\x4e\x11\x15\x2c\x54\x63\xb2\x63\xf2\x50\x83\x95\xad\xc2\x1e\x3a\xdf\x39\x96\x0e\x28\xcf\x3d\xf6\xb0\x0a\xa5\x6b\x22\x32\xf7\x3d\x4d\x62\x2a\x5c\xd7\x8f\x27\x2c\xd9\x99\x62\x52\x52\xea\xac\x1f\x44\x4a\x0a\xda\xa6\xa4\x94\x82\x25\x5d\x8c\x69\xee\x46\x5e\xc3\x07\xf4\xa7\x7c\x59\x8d\x12\x65\x67\xfe\x3d\x94\xa8\x06\x0e\x10\x00\x86\x68\xbf\xdc\xd3\xc4\x59\x5b\xbd\x48\x06\xe3\x34\x4c\x75\xa2\x67\x08\x84\xa9\xe0\xc7\xa6\x06\x49\x1e\x55\x5f\x45\x55\x5d\x47\xae\xa3\xe6\xff\x7d\x34\x2b\x07\x5b\x4c\xb3\x49\xf5\x69\xc1\x29\x31\xa1\x39\xad\x40\xb6\x49\x80\x9b\x09\x29\x49\x08\x9b\x89\x1b\x09\x08\x85\xe7\xc3\x17\x95\xf6\xca\x64\x5f\x36\x3f\x1d\x26\xa0\x14\xcf\x44\x5a\x0f\x5d\x30\x17\x29\x69\xb1\xc2\x6c\xa4\x81\x6e\x36\x1f\x69\x18\x9b\xcd\x48\x0a\x46\xe1\x9c\x54\x95\x60\xd2\x3d\xda\x7c\x5e\x52\x70\x8a\x67\x46\xa9\x90\xb5\x56\xbf\x1c\x63\xaa\x82\x42\xb7\xac\x56\x1d\x57\xb2\x76\x35\x5e\xab\xf8\xe1\x43\x52\xf7\x68\x44\x5d\xca\x9f\xf6\xe1\x2f\xe3\x67\x75\x4d\x6b\xaa\x4f\x3f\x9e\xb1\xc9\x41\x14\x4c\x9a\xd2\x6b\xfa\x87\xb5\x8a\xbe\xaf\x79\xaa\xf0\xb4\x4f\x03\x3d\xde\x79\x81\xba\x6b\xd0\x56\x42\xda\xfd\x5b\x5d\xaa\x46\x8a\xe5\x76\x81\x9a\x3e\x2a\x50\xec\x43\x5a\x51\xa7\x3e\xb5\x17\x59\x85\xba\x56\xe6\x4e\xed\xc5\x85\xff\xce\x71\x1b\x09\x45\x37\x5d\xb6\x27\x21\x35\x0f\x7f\xf0\xf0\x1d\xf7\x7f\x6a\xf8\x8e\xbb\xe6\xf0\x6f\xbf\x77\xf8\xb8\x0e\xb1\x75\x48\x16\x49\x05\xe2\xcb\xdc\x9e\xe0\x78\xef\xf9\x74\xcb\x89\xeb\x10\x00\xc1\x76\xa9\xcb\xce\xc6\x13\x11\x04\xca\x24\x32\x71\x8f\xb0\xc5\xdf\xf1\xed\xca\x68\x66\xe1\x5c\x91\xd1\x0d\x3b\x63\x1a\xcd\x80\x62\x6f\xea\x84\x21\x42\x1f\xa4\xe3\xe0\x51\xc3\x43\xb6\xb3\x20\xc0\xe2\xd4\x96\xae\x49\xf8\x38\x56\xc9\xf8\x0c\x33\xdf\x1b\xcd\x87\x62\xd4\x2c\x9a\x91\xeb\xd1\x2c\xe1\x95\x1c\xd9\x79\xc3\xc5\x20\x68\x57\xdc\x7a\x52\x00\xeb\x12\x41\x8d\x66\xb6\xd3\x2b\x5b\xcf\x68\xf6\xad\x5e\xa9\x66\x66\xc3\x29\xae\x57\x51\xc8\x35\x12\x96\x9a\xdf\x3e\x25\xa2\x3d\x17\x71\x3e\x5c\x56\xcd\xbc\xc7\x9d\x4f\xfb\x48\xc6\xd1\x5d\xec\x74\x93\x4f\xf1\x76\x12\x51\xd8\xfe\x2e\x0d\x9c\x50\xe3\x04\x9e\xc1\xd2\x80\xb0\x4b\x2f\xe1\x5b\xf6\x92\x5e\x74\xbb\xcf\x7a\x2f\x5e\xec\xec\xef\x3d\xdb\xeb\xbe\x78\xb1\xd3\xae\x65\xc1\x29\x90\xaf\x71\x87\x09\x7a\xe0\x30\xc6\x11\x46\xd4\xfc\x27\xad\x73\x77\x77\xd0\x66\xa0\x30\x3a\xf2\xa6\xae\x08\x92\x0b\x36\xf1\x40\xef\x3c\x46\xf6\xfc\x91\x27\x02\x8b\xec\x20\x98\x4f\x39\x3a\xda\x44\xc0\xab\x53\xf6\x3e\x4d\x68\x96\xf4\x5a\x7b\x74\x02\xb1\x52\x7e\xb7\xcb\xe5\x0b\xdb\xea\x2e\xf6\x14\xea\x40\xfc\x3b\x7f\xec\x76\x2d\x4e\x3e\x14\xbf\xd9\xd9\xb5\xa2\xf0\xc0\xea\x0d\x07\xf1\xcd\x07\x89\xe9\x91\x43\xdc\xdf\x1d\x38\xa1\x3a\xb4\xa6\x11\xb4\xa5\x27\x50\x25\xe3\x04\xe4\x71\xad\xea\xbd\x5d\xc4\x71\x54\x18\xe9\x06\x15\x40\x67\xd6\x53\xab\x7a\x51\xa7\xc0\x36\x1a\x59\x68\x09\x0c\x7d\x45\xea\xaa\xad\x08\x3f\x65\x2e\x57\x39\xa0\x14\x62\x01\xa8\xf8\xa2\x4f\x75\x25\xee\xdf\x68\x66\x92\x03\x80\x72\x9b\xfd\x4c\x84\x45\xf7\x16\x3d\x14\xdf\x9f\x56\xb8\xcb\xc5\x0f\xdb\xee\x19\xae\x61\xf1\x83\x61\xad\x78\x20\x5e\x9c\x9f\x98\xec\x4c\x47\xb8\xe4\x1b\xcd\x0a\x3d\xda\x47\xb3\x58\x20\xed\xed\xb1\xb3\x77\x86\x4d\x1f\x80\x4b\x17\x8a\x9d\xf8\xb9\xa7\x7e\x14\x88\xbc\x81\xd2\x9d\x84\xc1\xa9\xa1\x6f\x38\xaa\xca\x63\x9b\x51\xfe\x53\xcb\xe6\x17\x19\xd5\x3f\xa0\x60\x3e\x43\x14\x0c\xd8\x74\x49\x4e\xd8\xbe\x15\xe1\x7b\xa6\x9e\x7f\x94\x38\x27\x46\xe2\xa4\x62\xa1\x3f\xff\x5c\x00\xc2\x2c\x91\xea\x07\x97\xec\xf9\xf5\xa5\x6b\xe1\x6f\x8e\x1b\xee\xee\x48\x35\x2f\xdd\xd3\x56\x9f\x76\xd6\x32\x0b\x96\x76\xc0\xf7\x87\xd5\x7a\xa3\xf0\xa4\xd8\xd5\x41\xad\xb0\x50\xe2\xd3\xe9\xe8\xe5\x37\xf3\x1e\x1b\x3b\x16\xed\xee\x34\xc1\x69\x9a\xb4\x45\xc9\xd7\x3b\xbd\x66\x33\x4e\x56\xbd\xd3\xac\xe5\x83\x4b\x3f\x88\xb4\xd7\xeb\xf5\x7a\xf2\xdf\xc2\x5f\xb0\x3c\xbb\xa4\x16\x7c\xfc\xcb\x77\xf6\xff\x15\xf2\xf2\xf6\x36\xea\xb4\xfe\xd5\xf4\xac\x0e\x83\x3f\x55\x9b\x50\x9b\xa8\x66\xee\xed\x5a\x85\x6a\xda\x76\xe1\x86\xd9\x9a\xac\xbf\x4d\x0d\xfb\x9a\xbd\xac\x6e\xf4\x88\x0f\x6b\x55\x3b\xf1\x5e\xe0\xc8\x02\x76\x1c\xd8\xd3\xd9\x64\x25\xb1\x4c\xd1\x83\x3d\x0c\x3b\xf7\x43\xce\xce\x5d\x15\xc0\xa5\xef\x0d\xec\x81\x33\x81\x9b\x71\x78\x67\x87\xf4\x80\xcc\x57\x2f\x44\x6f\xdf\x5a\x09\xbb\x56\xf0\x74\x3a\x94\x6a\x9a\x3a\x2b\xbd\xa1\xff\xee\x53\xb7\xdd\xed\x76\x9f\x5b\xe4\xb4\x45\x9b\x60\xfa\x47\xbd\x9d\xfd\x5e\xad\x14\xbc\x66\x15\x51\xc7\x4a\x8f\x9b\xc9\xcf\x60\x0d\xd6\xb0\x53\xc9\x2a\x5f\x7f\x98\x02\xfa\x34\xb8\xee\x1a\x5c\x50\xb2\x9f\x88\x48\x50\xb1\x57\xb1\xa2\x3a\x1a\x57\x64\x3d\x19\xca\xe8\xd3\xf3\x17\xe9\x67\x6d\x28\x8d\x07\xfa\x09\xa9\x05\xf6\x9a\x80\xb9\x21\x30\x6d\x97\xaf\x61\xad\x83\x6a\xf6\x9d\x28\xc8\x1f\x99\xfd\x7c\xab\xe5\xbf\x5f\xa7\x04\xe4\x84\x7b\xea\xc0\x3a\xbd\xb4\x6c\xa7\x13\x25\x2a\x5a\xdd\x10\x21\x29\x78\x53\xa4\xbc\x2b\xd9\x0c\x13\xda\x0f\x55\x37\x56\x43\x27\xa1\x54\xdb\x81\x3a\x1d\x1a\xcc\xc7\xe3\x9c\xd4\x3b\x86\x9d\x33\xd1\x8a\xda\x24\x9f\xfd\xd5\x5b\x64\x77\x11\x85\x98\x8d\xb9\xf0\xde\x73\x2b\xfd\x85\x7a\xdd\xdd\x00\xaa\x12\xec\x77\x12\x10\x15\xab\xdf\xab\x0a\xad\x60\x83\xa9\xf2\x4b\x6d\xcd\xa5\xba\x19\x13\xc7\x6e\x04\x57\x68\xe6\x47\x3f\xd3\x2e\xfc\xb4\xb6\x92\x48\xc0\x0f\x62\x24\xc8\x32\x72\x8f\xca\x14\xa8\x14\x42\x41\x7f\x34\x98\x9d\x0c\x98\x54\x1b\xbb\xa9\x2f\x37\x82\xbf\x77\x83\xc4\x8d\xbd\xa7\x29\xb8\xfb\xfc\xf2\x39\xbf\x93\xdd\x78\x5a\x74\xd7\xfa\x03\x77\x91\x9c\x25\xf2\xac\xd9\x1e\x7a\xb3\x65\x83\x6c\x83\x97\x74\xc1\x32\xaf\xb8\x42\xfe\xf7\xf1\xe0\x1c\x4f\x96\xcd\x38\x6c\x75\x1e\xfc\xec\xb0\x2a\xe3\xac\xa6\x36\x4e\xa8\x8e\x7b\x7b\x9b\x5f\xbf\x99\x07\x61\x70\x6e\xeb\x74\xe8\xb7\x40\x24\x0f\xee\x07\xfa\x02\x52\x07\x8d\x86\x8e\x4e\xf5\xc0\x09\x92\x3d\x63\x4d\x5e\xa5\x08\xd3\x9a\xde\x9f\x38\xa5\xfc\x38\x87\x7f\xeb\xaf\x8a\x1e\xb0\x9c\xb4\xa6\xa3\x28\xa3\x6b\xce\xfa\x7b\xa9\x97\xdf\x50\x9a\xff\xf6\x23\x72\xfc\x0b\xb0\x8d\x23\xf1\xf0\xba\xd5\x72\x0c\xa2\xd6\x68\x46\x3f\xc5\x1e\x91\x06\x66\x02\xbd\x97\x8c\x73\x11\x3b\xfc\x2b\xcf\x76\x15\x99\x3e\x48\xc7\x8c\x1e\xcd\xcc\xb3\x43\xf7\xd8\xe5\x47\xb3\x52\xa4\x03\xe1\x3a\x51\xd4\x75\x74\xa6\x6f\xd1\x68\x56\x22\x42\x0e\xa5\x90\x9a\xd0\x18\x61\x4d\xc1\x78\x62\x8b\x1e\x0e\x37\xc1\x28\x9f\x57\xa6\x70\x74\x88\x70\xa0\x3c\x8c\x03\x15\xd7\x1e\xfa\xb9\x9c\xf4\x23\x39\x24\x27\x7b\x87\xe8\x70\x87\xe0\x4d\xde\xac\xd1\xb4\xc8\x69\xb5\x8c\x72\x87\x72\xc0\x2c\xf1\x97\x77\xa2\x98\x14\xfa\x75\xde\xc3\xda\x1e\xaa\xee\x48\x6e\x04\x16\x63\x46\x47\xa0\x28\x40\x0c\x2f\x63\x7b\xf4\x19\x69\x80\xb4\xbf\x77\x4c\x38\x93\x12\x8f\xec\x38\xf4\x5c\xab\xc7\x38\xec\x26\x70\x18\xdc\x39\xe3\x10\x58\x14\x49\x3f\xe6\x32\x31\x8e\x09\x1b\x69\x76\x71\xab\xa2\x28\xda\x1b\xe7\xfb\x90\x0f\x99\xb0\xf3\x83\x6d\x70\x86\x9b\x76\xbb\x4a\xff\xc1\xde\x7b\xb8\xa4\xc3\xfa\xec\xde\x1c\xca\x8d\x19\xd1\xf3\x1e\x74\xf4\x3c\x67\x7b\xbb\x42\xc7\x15\x1e\xc3\x3b\xb1\x01\x2e\x23\x52\x89\xd6\x54\x33\x8d\x39\xc4\x70\xd8\x68\x99\x80\x3d\x96\x45\x16\xe0\x32\x20\xc1\xe1\x61\xad\x2c\x2e\x99\xed\x8e\xf2\x0b\xe5\xe4\x1f\xfd\xd6\x04\x3b\x5e\xed\x1b\x62\x17\xbd\x3f\xfd\xc7\xf1\xd5\x09\xbd\xfd\xed\xfc\xf5\xd5\xe9\xc5\x79\x9e\xab\x70\x82\xcd\xd9\x51\x7c\x59\xe9\x45\x05\x02\x18\xd8\x81\x38\x75\x41\x05\x45\xdf\x5e\xcc\x73\xc2\x17\x44\x2a\xdd\xd5\x50\x41\xaa\x8e\xa5\x40\x5b\x1c\x3a\xa4\x70\xed\x42\xe7\x3b\xd2\x0a\x5f\xdf\x42\xbc\xad\xc5\xd0\xa2\x65\xb1\xce\xd6\xc9\x04\x09\xa2\x7a\xbb\x4e\xcd\xe2\xf2\x50\xd3\x66\x2e\xc9\x8a\x0b\xfb\xd3\x8a\xd6\xf8\x4c\x6b\x0a\x79\x1c\x76\x2c\x19\x9f\x28\xf4\xce\x10\x9d\xfb\x35\x90\x52\x44\xf4\x7c\xd2\x74\x5b\xea\xf6\xa6\x5d\x33\x10\xf4\xab\x32\xb3\xa7\xfb\x8a\xc6\x08\xaa\xdd\xdf\xa2\x68\xdf\x51\x4a\xd0\x76\x65\x63\x09\x83\xc6\xda\x1c\xa0\x49\x97\xca\x3e\xcb\x15\xe5\xbb\x44\xab\xa1\x0a\x2e\xaf\x97\xac\x9b\x4c\xc4\x3b\xc0\xe2\x36\xb5\x93\x1d\xc8\x7d\x35\xd9\x00\x83\xb2\xf9\xb2\x34\xbe\x7f\xe4\xf0\xe9\x2e\x21\x73\x13\xfc\xe6\xc0\xad\xb4\xff\x21\xfc\x9e\x43\x84\x2c\x47\x54\xfc\x91\xca\x61\x61\x6a\x47\xc5\x75\x1f\x2c\x39\x33\x1f\x86\x2e\x6d\x21\xd8\x0f\xd1\xc6\x15\x90\x29\xca\xd2\x92\xc3\x13\x84\x1e\x42\x75\x5d\xcc\xc3\x86\xce\x5b\xc6\x39\xc5\x1b\x32\x3c\xc8\x95\xd6\xd5\x22\xae\x06\x35\x11\xf3\x46\xe0\x7f\xbd\x6e\xb4\x58\x8d\xf3\xc3\x8c\x6f\xd9\x1e\x2a\x3c\x1f\xd6\xd6\x60\x9e\x09\x44\x02\x4b\x2a\xec\x0f\xf0\x69\xa2\xfb\x45\x66\x50\x11\x5f\x39\x75\x4b\xbb\x8c\xce\x62\x3d\x2c\xe2\xfe\xd6\xcc\xfb\x7b\x24\xf9\x94\x86\x7b\x3a\xa4\x05\xc4\xcf\x7b\x6c\xd1\x90\x72\x16\x4a\xcc\x29\xea\x0b\xaf\xdf\x27\x0b\x6c\x89\xb1\xb7\x5c\xbd\x5b\x14\xf2\xb3\xa2\xa8\xd3\x6a\x15\xec\x42\x91\x60\xa3\x5f\x14\x04\x08\x32\x6c\x50\x51\xfc\x9c\xc2\x12\x9d\x8e\x64\xe8\x9c\x78\x44\x8c\x94\xe7\x2b\xe7\x52\x19\x86\x51\x1e\x86\x76\xad\x24\xaa\x9d\x73\x6b\x88\x28\x82\xf5\x3d\x72\x1e\x38\x72\xe7\xd2\xe2\xdb\x34\x7f\x5a\x89\x58\x99\x72\x16\x6d\xd3\x16\xcc\x05\xfc\xe2\x02\x82\x0b\x14\xa1\x38\x7f\xe6\x60\xfc\x8c\xa8\x3a\x23\x18\x10\xf7\x0e\x8d\x89\x0e\xa2\x54\x15\x2c\x9a\x29\x0d\x7a\x24\xaa\x69\xef\x63\x99\x5e\xd9\x1b\x27\xbf\x81\x42\x5f\x65\x6c\xd2\xf1\x5f\x57\xb2\xed\xa9\x0f\x36\xc9\xc5\xf0\x7a\x54\x20\x06\x63\x81\x68\x6c\x76\xf2\xc2\x76\x68\x4c\xf9\xb8\xda\x1c\x31\x49\x7e\xfd\x0a\xea\x1f\x61\x80\x37\x91\x8f\x67\xfe\x48\xb9\xe6\x94\x5e\xd2\x1e\x47\x29\x74\x74\x71\x00\xf3\xa5\xf5\x5e\x83\xb7\x5a\x6c\x6c\x78\xc7\xbf\x36\x98\x36\xd0\xd4\x11\xed\xd2\x81\xbc\xd5\xcb\x85\x8f\x9f\x03\x6c\x8c\x74\x0f\x90\x0e\x6a\xe3\xf0\xa5\x81\xee\xe1\xad\x1f\x43\x7e\x8a\x16\xb9\xf3\x2d\x74\xfe\x67\x36\x28\x2e\x84\xbc\xda\x9b\xe7\x74\x40\xcf\x0c\x77\x8c\xbc\x70\x81\x25\xc0\x8d\x17\xbc\xe1\x84\x81\x4b\xbc\x3f\x5a\xa3\x99\xc1\x9a\x3b\xde\x51\x7d\x3a\xca\xf0\xf5\x7a\xaf\x6e\x11\xaa\x23\xd2\x23\x33\x14\x5d\x63\x3d\x7e\xa0\x79\x24\xd6\x5b\xc1\xd8\xf4\xf8\xfc\x52\xd5\x03\x4e\x4c\x9a\xb6\xe7\x33\x4e\xb6\x32\x85\xd1\x39\xc8\x79\xe6\x8b\x07\xc7\x9b\x07\x8a\x94\xe1\xfe\x1c\x7a\x69\x42\xc6\x69\x03\x09\x6a\x3d\xb7\xca\x89\xa3\xd5\x52\xf4\x7b\x48\xdb\xdb\xcc\x95\x47\x37\xf4\x4a\x13\x75\x35\xc5\x07\x28\x02\xe7\xe9\xee\x61\xad\xb4\x2c\x50\x40\x4f\x46\x95\xe0\xe2\x67\x7b\xbb\x80\x83\x64\x3f\x8b\x61\x7b\xee\xca\x83\x5e\xcf\xc0\xd8\xca\xf5\x26\x1b\x6b\x55\xd6\x8e\x7d\x18\xcf\x42\x7a\xa3\x65\xca\xc7\xf6\x78\x58\x76\xe4\x47\x1c\x04\xba\x46\x36\x6c\x24\xbb\xde\xbf\xa1\x81\x18\x7a\x53\x11\xd0\xde\x60\x5c\xd6\x26\x58\x5b\xd7\x52\x4b\xa3\x5e\x67\x55\x17\xa4\x39\x1d\x57\x2a\x8a\x2e\x8c\x28\x90\xc7\x61\x03\x5c\x4b\x79\xa6\x1b\xf0\x8b\xca\xfd\xcc\x2a\x63\xb1\x43\x50\x73\xad\xad\x40\x71\x79\x95\x7a\x99\xa3\x32\xdb\xa3\x11\xb3\x71\xec\x9b\xed\x9a\xe1\x5c\x18\x84\x79\x26\xa7\x05\x67\x3f\xe1\x73\x60\xba\x28\xfb\x92\x4a\x39\xac\x12\xe8\x88\x11\xaf\x85\x36\xbd\x46\x47\x46\x4a\x22\xe5\x35\x96\x38\xb2\xad\xf6\x66\xe4\x3c\xac\x9a\xaf\x15\x0d\xf4\x58\xda\x68\xb9\xda\xe3\x71\xc1\xf0\xef\xf3\xc7\x18\x81\x9c\xce\x27\xa1\x33\x9b\x2c\x79\x7b\xbf\x97\xdb\xba\x71\x5d\xe1\x8c\x38\xb5\x28\x14\xd3\x99\x45\x8b\x89\x67\xd1\xe2\xce\xb1\x0a\x8b\xe3\x67\x68\xfb\xfe\xb2\xdc\x3e\x08\xb4\xb4\x50\xe4\x6b\x2e\x79\x3f\x41\x50\x87\x7b\xfa\x29\x4e\xbf\x5e\x52\xe1\x4e\xeb\xbc\xa3\x0a\xf4\xd5\xc8\x6a\x24\x79\x43\xf6\x59\xa8\xf8\x6f\x4d\x15\x31\xad\x8c\xe9\x2c\xb8\x73\x0b\xe8\xdf\x12\xfd\x3b\xac\x19\xaa\x00\x85\xba\xce\x4a\x17\x75\x99\xbc\x07\x07\xe4\xfb\x3b\x87\xb6\x30\x13\xb4\xcd\x70\xb6\xe8\x7e\xe2\x99\xab\x61\xf2\x50\x73\xe2\x45\x35\x71\xcd\x35\x4d\x76\x98\xf5\x98\xc9\xbf\xb6\xe5\x3c\x1e\x56\x9a\xea\x06\x13\x08\x75\x24\x3d\x49\xd7\x44\xc4\x1d\x9f\x66\xc7\xc7\xb7\x4d\x6a\x08\x77\x06\x7d\x10\x7e\x18\xa7\x7d\x09\xf9\x27\x86\x7c\xb8\x09\x8b\xc5\xee\xc1\xfd\x44\x6c\x43\xcd\xee\xe5\x0b\x03\x41\x28\xae\xb0\x38\xac\xad\xd1\x5c\xb4\xcc\x86\xde\x74\x66\xfb\xa2\xa1\x4c\xea\xec\x33\x8b\x06\x67\xa5\xeb\xcc\xb1\x68\x38\x9d\x19\x3a\x85\xa1\x90\x7d\x06\x91\xae\x04\x1c\x7e\x86\x3c\xeb\xf6\x19\xb6\xe6\x33\x3a\xa2\x1e\x1d\x50\xcb\x14\x9a\x5a\x8b\x2c\x15\x95\x8e\x12\x7e\x97\x37\x01\xb2\xcf\x54\x90\xdf\xb2\xfa\xf1\x38\x30\xbd\x18\x09\xfe\x5f\x36\x96\xcc\x98\x50\xe5\x95\xac\x59\x3e\xae\xe4\xc3\xf9\xb8\xfe\xca\x9d\x5e\xff\x56\x40\x4f\x3c\xbf\xfa\xdd\x3a\x14\x15\xcc\x07\x21\xd4\x1c\x29\x92\xaa\xc2\xbc\x9d\x12\x21\xab\xd3\xa1\x0f\x0a\x36\x0d\x64\x6e\x74\xbb\x5d\x33\xcf\xfe\x21\xd9\x67\x55\x78\xa3\x7d\x6d\x9f\xdd\x40\x7d\x5e\xb2\xd2\xd1\x47\x59\xf6\x25\x0d\xf8\xff\x72\x56\x4b\xd8\xa1\xac\xd2\x27\x70\x13\xb0\x07\xbe\xc8\xc6\xab\x96\x84\x52\x5c\xfb\x9b\x11\x1f\x99\xbb\x87\x4a\xd2\xd8\x21\x3d\xb1\xa1\x6a\xf8\xf9\xe7\x64\xa0\x9e\xde\x21\xd9\xfa\x26\x61\x7d\x59\x66\x71\x10\xe5\x04\xb5\x68\x79\xa0\xf3\x66\xb6\x6b\x06\x02\x8b\x08\x26\xef\x00\x5f\x4a\x2c\x43\xec\xf2\x08\x73\x60\xb1\xdd\xb9\x45\xae\x85\x20\x52\x23\xf9\xef\x99\x45\x5f\x2c\xfa\x32\xb4\x90\x60\x92\xff\x39\xe3\x7f\xbb\x16\x2d\x1c\x8b\x16\x67\x16\x2d\x87\x25\xbb\xfe\x12\x85\xfe\x65\x2e\x03\x25\x05\x0e\x80\x7d\x68\x1a\x83\x68\x85\x9b\x2b\x69\xed\x83\xb9\xd4\x12\xa5\x96\xd0\x51\x14\x16\x83\x88\xec\x70\x5c\xcf\x73\xfb\xdc\x8a\x53\x87\xe0\xb4\x58\x72\x55\xf6\x64\x31\x4c\x1c\x42\xf1\xdb\x52\xbe\x58\x96\x9f\x4a\x13\x73\x98\xd6\xc8\x9a\xab\xa4\x1d\x13\x90\xda\xc4\x19\x93\x48\xf4\x1f\x6e\xc7\x5e\x78\x97\x1e\x47\xbb\x56\x02\x92\x9e\x60\x02\xb8\xeb\xf2\xff\x10\xe5\xe9\x88\x96\x43\x75\x9c\xe7\xab\x32\x92\xe3\x3a\xc0\x00\xd9\xd0\x1a\xed\x1f\x94\x77\x38\xdd\xe9\xff\xff\xff\xeb\x42\xc5\xbb\xc0\x45\x3c\x7e\xf7\x7c\x5a\xca\xdf\x75\x9f\xd9\xd7\x46\x21\x27\x7e\x8b\x24\x2e\xaa\x60\x85\x01\x29\x1a\x49\xf6\xbe\xab\xe6\x86\x8e\x08\x81\xc8\xbb\xc8\x6f\x43\x9d\x64\x94\xc2\x9c\xa7\xb9\x11\x57\xf9\xb2\xa2\x68\x0f\x0c\x80\xbe\x80\x4a\xbf\x44\x91\xb2\xf5\xeb\x02\x6d\x19\xb5\x68\x69\x4a\x81\x86\xe5\xc4\xca\x31\x61\x50\x90\xc5\x34\x5c\xca\x28\xf0\xc3\x85\xfa\x54\x2e\xea\xa2\x8f\x03\x27\x7c\x8b\x1c\xb6\x50\xeb\x88\x94\x29\x3d\xb5\x12\x5f\x2e\x33\x5f\x9a\x01\x63\x54\x41\xb2\xbc\x51\x7a\x36\x4c\x0d\x13\x22\xdf\x03\x44\xb7\x9e\x4a\xd1\xe7\xf1\xad\x2c\x7b\x82\x09\x79\xc0\x4b\x45\xd1\xc3\x25\x9d\xc1\x68\x44\x1f\x46\xe3\x28\xe1\xd1\x55\x70\x44\x05\x81\xdc\x70\x13\xa7\x42\x56\xdd\xdc\xd9\x15\x55\xe3\x2b\x19\x18\x96\xca\x10\x80\xd7\x2b\x7e\xfd\xca\xc2\x78\x94\x80\x41\x57\xca\x3e\x3c\xf5\xb2\xf2\xab\x4c\x5d\x6a\x92\x68\xb5\xca\xc8\x26\xa8\x64\x25\xf0\x45\xd9\x4d\x95\x69\x5b\xb0\xf9\xe0\x1a\xc2\x9f\x8b\x0a\xf2\xaa\x7e\x91\xf7\x2c\xce\x52\x6a\x12\x63\xd9\x25\xca\x2e\xab\x95\x55\x62\x95\xfe\x33\xef\x09\x94\xad\xad\x11\x0e\x5f\x45\xfa\x53\x7b\xe2\x04\x02\x2c\x16\x87\xf8\xe5\x10\xba\xb8\x3b\xe7\xf6\x4e\x04\x21\x79\x3e\xd2\x49\x4b\xdd\x9d\x37\x06\x07\x76\x02\xdc\xf0\xf3\x12\xec\xd0\x4e\xbb\x78\x66\xf0\x71\xd3\x46\x2b\xaa\x56\x43\xb1\x6e\x19\x8c\xbe\x59\xa5\x93\xc8\x05\x3a\x14\x41\x60\xfb\x4b\x8b\x06\x73\x0e\x4c\x79\x67\xbb\xa3\x89\x20\x6f\x24\x35\x1e\x3a\x8c\xfe\x52\x73\xd8\xa8\x3d\xe8\x93\x0b\xdd\xbd\xf4\x07\xb1\xf8\x41\x4b\x9c\x3a\x02\xd4\xe7\x6e\x6f\x83\x96\x7b\xd8\x73\x24\xcc\x97\x49\x80\xe6\x99\x4f\x80\x2a\x2b\x1a\x49\x06\xb1\x7a\x64\x39\x64\xe1\x87\x5b\x2b\x21\xd6\x48\xfa\x48\x28\x57\xd6\xab\xbd\x0e\xe1\xad\x4b\xd4\x06\xce\x87\x9f\x05\x08\x79\x79\x66\x86\xe1\x0b\x68\x1e\x16\x43\xa5\x1a\x41\xe0\xb4\xe5\x59\xd9\xc0\x20\x1e\xe2\x2e\x42\x4c\xa3\x7e\x1a\xcb\x23\xcd\xda\x68\xa4\x8c\xbf\x42\x8f\xa6\xf6\x7d\x32\x9f\xba\x1d\xd0\xc4\x73\x6f\xb1\xe9\x1b\xe5\xe0\xac\x58\xce\xdd\x78\x49\xcb\x33\xfe\xf5\x1a\x7f\x42\x21\xd9\x2f\x0f\x87\xb4\xfc\x17\x50\xa3\x07\x5d\x5a\x38\xd2\x2d\x74\xcb\x8a\x0e\xbb\x0c\xb8\xd4\xdd\x41\x33\xe5\xde\x4d\x72\xbd\x53\x13\x10\xb6\xb7\x0f\x37\x5b\xb2\x33\x95\x73\x2b\xf4\x1d\x7b\xa2\xd8\x8a\xab\xb4\xf7\xec\xc5\xea\xd2\x3c\xc0\xb6\x83\x17\xb4\x5b\xba\x62\x63\x6b\x7b\xae\xd0\xef\xd3\xae\x5c\xae\x9c\xac\x14\xdc\x85\x7f\xa5\x1e\x84\x0f\xd1\xea\xed\x97\x90\xc1\xc8\xab\xb0\x58\xdd\x8a\x37\x07\x7c\x05\xce\xaa\x18\x4d\x34\x2a\x97\xb4\xa2\xaa\x76\xad\x9a\xc2\x21\xd2\xe7\x2c\xf5\xe1\x07\xe7\x18\xd0\x12\x35\xab\x75\xe3\x74\x1c\xf5\xe0\xe5\x3a\xed\x33\x0d\xa0\x13\x7a\x7b\x2d\xad\xa1\xc7\x6d\x4f\x86\x73\x24\x56\x4b\xce\xb4\x45\x6e\xbb\x1a\x04\x1c\xeb\xe4\xea\x2d\x25\x53\xfd\xe1\xae\x2e\x59\x3b\x25\x31\x93\x00\xd2\x8d\x8f\xe8\x0d\xbc\xe6\x80\x6a\x2a\x24\x59\x25\xe0\xc8\xa7\x0e\x59\xff\xce\x7b\x94\xd1\xf0\x43\x07\x77\x16\xe1\x5d\x3c\xb3\xb7\x9e\x60\xdb\x06\x2f\x25\xaa\xad\x81\xeb\x9c\xed\x92\xbb\xde\xc1\x82\x5b\xa7\xa7\x74\x3c\xb9\xf5\x7c\x27\xbc\x9b\x56\x76\x3f\xa3\x5e\x5b\xb9\x62\xc3\xd7\x59\x0f\x69\x2b\xb5\x4c\x1b\x6e\xb3\x56\x02\x29\x02\xb7\xd3\xc6\xa2\xd4\x10\x5f\xc5\x68\x38\x88\x5e\xb6\xa2\x76\x2c\x72\x5b\xad\xca\xa0\x77\xdb\x31\x34\x28\x77\x14\xbc\xca\xf5\xf7\x52\x5d\x7b\xb4\x21\x3a\xc6\x00\xed\x90\x76\xaa\x63\x8d\x68\xbf\xad\x57\x27\x1f\xb0\x12\x80\xdc\x91\x1e\xdf\x1a\xe0\x9e\xb6\xe9\x74\x9c\x80\xf2\x4a\xc3\x38\x48\xbc\x4c\x61\x6e\x7b\xbb\x1a\x5d\x64\xe4\x91\x4a\x75\x54\xbf\x5c\x6d\x7a\xf0\x4a\xa5\xfe\x76\x27\x4b\xc9\xaa\xf9\x4f\x27\x28\xe3\xd3\xc9\x07\xfd\x70\xf5\x86\xd2\x24\x57\x99\x23\x94\xe4\xd7\xca\x7e\x3a\x9d\x68\x06\x0b\xc8\xb5\x7a\x8f\x00\xe8\x7b\x84\x2f\xfd\x00\x0e\x24\x29\xfc\x3f\x92\x39\xf4\x97\x65\xcf\xba\xe2\x4a\xc1\x36\xa3\x91\xb2\xe6\x36\xa3\x3f\x32\x70\x43\xce\xb2\x5d\x0b\xc4\x55\x82\x69\xc0\xbe\x54\x1e\x26\xd6\x02\xc1\x46\x46\xbd\xa8\x2e\xd9\x03\x6f\x1e\xd2\xfe\x4f\xfa\x08\x0b\xf6\xcb\x46\xca\x28\xe7\x7a\x21\xdd\xbb\xde\x23\xda\xe2\xc3\xeb\x3a\x2d\x89\x07\xe4\x4a\x16\xc2\x4d\x26\xa2\xdb\xa0\xd3\x8f\x77\xce\x44\x50\x23\xde\xab\x81\x45\xb5\x5b\x2b\xed\xa5\xda\x96\xfa\xfd\x8a\x67\x82\xe4\xc7\x35\x9e\x81\xcb\x34\xeb\x7a\x85\xf0\x71\x5f\x4d\x6f\xf5\xa1\xe1\x13\x5f\x00\xa0\x3a\x0b\xe0\x2f\x15\xc5\x1f\xd1\xf2\x5f\x74\xc0\xab\x46\x0d\x74\xdd\x95\xf3\xdd\xab\x27\x16\x95\x2a\x5e\xc4\x98\x6f\x4b\x72\x8f\xf9\x6b\x93\x2f\xab\x12\x7b\x7c\xa9\x06\x2a\x6d\xf5\xda\xeb\x80\x38\x1d\x2b\x28\x6c\x71\x8a\xcc\x3f\x20\x74\x36\xbc\x03\x99\xeb\x4d\x67\x39\xd4\x6b\x9d\xec\x5b\xdb\x71\x69\x20\x26\xde\xa3\x55\xab\xd0\x88\x6e\x29\xf0\x88\x13\x09\x09\xee\x69\xe8\x31\xf9\x93\xcd\x59\xad\x9d\x70\xa3\x4e\xf7\x90\xc4\x12\x5a\x24\x40\xb4\x31\x76\x0b\x3a\x85\x64\x67\x7d\xa1\xc7\x30\x92\x3d\xaf\xde\x90\xda\xca\xa2\x90\x90\x95\x2b\xaa\x5e\xe6\x48\xc2\xdc\x3d\x97\xa6\xf0\xe4\x18\x24\x42\xcf\xf4\xda\x1b\xd0\xa0\xbb\x2e\x1d\x7e\xd7\xb6\xb7\xee\x3e\x57\xf5\x48\xf9\x43\x16\x67\xc5\xb1\xf1\x8c\x02\xf8\x99\x14\xc6\x70\xbb\x8c\x3f\x53\x87\xda\xda\xba\x5c\x4f\xa3\x8a\xb9\xde\x9a\x5b\x59\xcc\xef\x22\x0e\xae\xaf\x9c\xd6\xe1\x6e\x9b\x6e\xeb\xe9\x8d\x38\x23\xa4\x56\x1b\x41\x74\x74\xeb\xf7\x65\x12\xe5\x3f\x37\x92\x28\xf4\x62\x01\x97\x49\x49\xb8\xed\x75\xa0\xa5\xce\x9f\x29\x30\x56\xb4\xb5\x44\x25\x36\x99\xaf\x42\xd1\x63\xe2\x3d\x6e\x2a\x79\x20\x23\x6e\xb1\xe0\xf1\x20\xfc\x25\xf9\xb6\x2f\x26\x4b\xda\x59\xbf\xa9\x15\x79\x21\xe7\x6c\x4f\x2f\x37\x91\x14\xa0\x9b\xf9\x31\x92\xc2\x06\x73\x90\xb3\x76\x96\xd1\xa2\x4e\x48\x0a\xeb\x2f\x25\xc3\x92\xd2\x5f\xfd\xa0\x1d\xbf\x56\x51\x26\x48\xac\xb0\x32\x37\x9d\xe4\xe3\x96\x29\xcf\xf4\x83\x4d\xbd\x4f\x95\x14\x20\xdf\x22\x5d\x98\xee\x4f\x8f\xb7\x5a\x57\x9a\x21\x0e\x04\x75\xcb\x69\x42\xa9\x40\x41\xe6\xae\x58\xa8\x04\xcc\xac\x49\xd6\x96\xe9\xd2\x61\xa5\x42\xb6\x59\xfc\x7c\x89\xfc\x82\xdd\x0a\x04\x09\xe7\xa4\xd9\x88\x15\x46\x77\x62\x1d\xca\xe3\x59\x80\x4e\x47\x5d\x71\xeb\xf7\xa6\x27\xad\x8a\x5d\x0c\xaf\x17\xea\xbe\xe9\xb0\x56\x59\x1e\xd4\x2f\x2a\xcc\xa0\x6e\xe1\x66\xad\x4d\xa3\x82\x08\x61\x26\xe8\x6f\x11\x93\x69\xd0\x02\x37\x70\x2f\x69\x71\x86\x61\x2a\x6c\x69\xd3\x7c\xb6\xca\x0f\x4a\xbc\x75\x13\xf7\x62\xe9\xea\x25\x75\x3a\x1d\x3a\x4b\x58\xb2\x1c\xd5\x4a\xe7\xf2\xc9\x17\x65\xae\xf0\x25\x72\x83\x3d\xac\x6d\x20\x60\xa4\xb4\xc2\xea\xc2\xb7\x64\x0f\xc4\x0e\xe2\xd1\x30\xd2\x5d\x7e\x69\x23\xfc\x3a\x7b\xce\xde\x8a\x94\x1f\x93\x37\x4e\xf8\xd7\x72\x87\xdb\x15\xed\xd6\x7a\x16\x5f\x1e\x73\x9d\x43\x0a\xb4\xb7\x6c\x90\xf0\x96\x2d\x63\x89\x6c\x39\xdf\x80\x35\x0c\x5f\xac\x37\xe8\x4b\x1b\x17\xdd\x08\xa2\x21\x68\x2b\xbe\x93\x6e\xf1\xf6\xb1\x4d\x3d\x69\x83\xc3\xd3\x57\x36\xc7\x50\x26\xc0\x6e\xd8\xc7\x3e\x68\xb4\x17\x5e\x6b\x45\x7c\x29\xf3\xa1\xc5\xcf\x97\x36\x2c\xb1\xb7\xd1\xcf\x8d\x66\x5c\xd9\x68\x7c\xf9\x7e\x07\xdb\x54\x68\x52\x65\x28\x62\x6b\xef\x58\x5f\xcc\x7c\x11\x08\x37\x54\x3e\x7e\xf1\x7d\x7c\x74\xb3\x0e\x31\xc2\xa5\x31\xfc\x36\x5a\x33\x98\x94\xe3\x2c\xa8\x2f\xf8\x1d\x7b\x92\x04\xef\x7a\xa1\xcd\x36\x53\xda\x21\x22\xf4\x32\xe6\xdc\xd9\xb8\xa6\x7e\x4e\x9e\xfc\xa2\xb8\xaa\x7c\x1e\xb3\xe3\x9e\x25\xcb\x91\xa3\x03\xf2\x62\x97\x5f\x68\x19\x67\x82\x20\x9d\x4c\xdd\x84\x5c\xb3\x8e\x2f\x46\xd4\xc8\xfa\x2a\xe5\x7a\x24\xa5\xc2\xc5\xc2\x03\x47\x81\x8f\x1c\x9f\xa6\x88\xb0\x94\x2c\xa3\x8c\xe5\x9d\x20\xfa\x6d\x74\x40\xa1\x77\x12\xa3\x8a\x7a\x2f\x2c\x6d\x9d\xcf\xd9\x0d\x75\xe2\x4b\xda\xe9\xe1\x8b\x4b\xed\xc8\x4a\x3b\x7b\x6d\x63\xb0\xd6\xc8\x38\x0d\xd6\xf3\x76\xd8\xc0\xce\xe6\xc8\xa5\xa1\x1a\x2f\xda\x3f\x60\xc9\x38\xec\xb2\x6d\x9a\x2b\x70\xb6\x75\xd9\x0b\xa1\x60\x29\xc1\x8b\x08\x83\xd7\xfc\x35\x13\xb1\x1c\xed\xc9\x34\x63\xb2\x55\x2b\x72\x9d\x7b\xe7\x8d\x84\xc1\xef\xe9\x08\x40\x71\x6b\x71\x50\xdd\x4f\xf9\x89\x0b\xc7\xcf\xc8\xb4\x2b\x8e\xde\x57\xc0\x62\xf8\xb2\xcc\x6d\x0f\x0b\xa5\x0e\x17\xeb\xd8\x6d\x0b\xb3\x23\xa3\x8e\x8e\x69\xda\x90\xa5\xdf\x45\xc6\x4f\x95\xbb\x7b\x58\x56\x87\xf1\xc6\x17\x7c\x2f\xb0\x9f\xa9\xd9\xeb\xf7\x69\x67\x0f\x7b\x99\x2b\x10\x5b\x27\xce\xeb\x54\x08\x0e\x3f\x47\x69\x72\x53\xbe\x1f\xae\x69\x22\xf0\x39\xc8\xf3\x18\x71\x0b\xa5\xd9\x52\x26\x09\xa5\x81\xe2\xeb\x69\x4b\x2d\xb7\xa9\xa8\xd4\xb8\x3d\xe3\xe4\xd2\x4e\x98\xef\x48\x8d\xce\x08\xf3\x11\x73\x28\x9c\x23\xe3\xc5\xda\xae\xe5\x83\x22\x32\x4f\xf1\xa6\x73\x37\x11\xae\xf2\x11\x2f\x3d\x0a\x77\x3a\xa9\x85\x2d\xa9\x37\x48\x32\xd0\x98\x6b\x3a\xe3\xcc\xbe\xbc\xca\x1b\x4d\xed\xc4\x5c\xd6\x09\xb4\xb9\x95\xed\x66\x40\xaa\xad\x3e\xba\xce\x86\x82\x2c\xda\x05\x14\x03\x65\xa7\x66\x53\x4b\xec\x1e\xae\x58\xac\x8a\x86\x9e\xde\x22\xf4\x90\x0c\x77\xa4\xb0\x0e\xcd\x41\x42\xf1\x54\xb2\x10\x14\xaf\x8f\xfc\xf5\x82\x15\xfb\x12\x6e\xac\x5f\xbf\x52\x66\xe9\x94\xe5\x12\x50\x9d\x3a\x9e\xcd\x84\xab\xac\x27\x8e\x2a\x19\x46\x80\x1a\x5e\x92\x13\xf9\x6e\xd5\xbb\x75\x66\xac\xe5\xd2\x8f\xf6\xd7\x5a\x5d\xb4\x95\xa4\x9b\xb7\xb9\x18\xd7\x05\xd6\x5e\xb7\xf8\x71\x70\x0f\xe9\x8a\x6a\xdd\xce\x77\x33\xfb\x71\x08\xe6\x19\xc7\x4d\x77\x8f\x5e\x01\xa1\x95\x0e\x45\x5c\xa9\xd5\x72\xe8\x15\x1f\x64\xe5\x24\xe9\x99\x69\xd7\x95\x33\x52\x3c\x55\x65\x73\x54\x09\x6d\x51\x34\x32\x41\x2d\xf4\xf4\xb0\x5a\x37\x75\x27\xcb\x81\x47\x55\x24\x3a\xfa\x7d\x85\x8f\xc4\xc0\x2a\xc1\xd0\x34\xbb\x26\x12\x2a\x9c\xd0\x6a\xeb\xd5\x2b\x90\x7d\xa3\xdd\x1d\x6a\xc2\x2e\x76\xc0\x21\x7c\x97\xeb\xad\x3a\x6d\x73\x7f\x0f\xd6\xf2\x2f\xfc\xbb\x34\xab\x8b\x45\x46\xe4\x8c\x80\x36\x21\xf1\x26\x4f\x5f\x1f\xc9\x57\xa9\x4c\x0a\x81\x45\x53\x11\xde\x79\xc5\x0e\xb4\xca\xc9\xcf\xb5\x6a\x25\xe6\x8e\x35\x43\x2e\x3f\x15\x14\x1a\x0d\xca\xd3\x63\x13\x69\x1c\x70\xd2\x52\xaf\xf2\xe7\x6a\xba\x62\x19\xad\xcb\x17\xae\x4a\x4d\x0d\xdb\xdb\xec\x79\xe4\xdf\x06\x7a\x43\x33\x12\xa5\x5b\xd0\x92\x53\xdc\x92\x9a\x8f\xd3\x31\xd9\xee\x52\x6f\x47\x4e\x20\x2d\xeb\xd5\xa4\x9f\xdb\xe7\xc5\xdc\x4b\x8b\x80\x81\xb1\x67\x11\x1e\x0e\x6b\x86\x12\x65\xce\x4b\x6a\xc9\x73\x93\x72\xc2\xdb\xd8\x77\x1a\x3c\xb5\x6a\x1b\xd1\x65\x37\xe8\xc1\x26\x0b\x62\x5a\x91\xe4\xf3\x4e\x7b\xc8\xe2\x07\xda\x92\xe7\xa7\x28\xfe\x0b\x8e\x75\x3e\x44\xab\x64\xc2\x82\xf0\xce\x4f\xa9\x7e\xb7\x38\x32\x20\xe8\x44\x67\x12\xc1\x11\x11\xd9\xed\x20\xb0\xb0\xe5\x44\x1c\xfc\x0f\x0d\x55\x3c\xb7\xe4\x67\xc9\xe3\x73\xcc\xd4\xc1\x3f\xf6\x22\x3e\x54\xb8\xf6\xb4\xd8\x90\x5e\x5d\xa1\xbd\xa4\xa9\xe3\x42\x22\x80\x09\x1e\x56\x39\xff\xfa\x84\x4d\x9f\xdd\xa1\x1d\x8a\x86\x6b\x9e\x38\x15\x80\x51\xb7\xd9\x90\xad\x7e\xfd\x4a\xf5\xf4\x99\xb5\x5e\x1a\x38\x34\xb7\x3b\x47\x54\x4f\xa5\x8d\xaa\xd3\x41\x9c\x20\x48\xcd\x08\x22\x40\x81\xc0\x0e\x37\x20\x90\x7c\x0b\xef\x72\x1a\x41\x54\x9d\x59\xc6\x44\xde\x4a\xe8\x8d\x58\xaf\xdd\xeb\x46\x02\x2b\xeb\x62\x87\x9c\x6a\x83\xef\x3a\x83\x30\x4a\x9d\x89\x6f\x54\xb2\xc9\x14\x05\xc5\xce\xdb\x53\xc7\x9d\x07\x16\xcd\x26\xf3\x80\xe1\xe0\x0a\x22\xa8\x48\x31\xae\x36\xee\x66\x22\x19\x5a\x54\x4c\x12\xda\x5b\x2f\x99\x72\x26\xf3\x7c\x86\xdb\xe5\x5f\x11\x5b\xe7\x09\xa2\x38\x7c\xbe\x89\x82\x07\x16\x72\xc4\xb4\x65\xe1\xdd\x2a\xa6\xdb\xf4\xb6\x54\x5d\x57\xac\xad\x93\xfd\xf9\x1c\xc5\xb2\xfb\xac\xb5\x73\x9f\x33\xda\xb9\xa2\xce\x5d\x3c\x08\x7f\x3c\xf1\x1e\xf3\x45\x34\x5e\x75\x0d\x32\xe8\xea\x5e\x45\x89\x47\x8d\x52\x37\x36\x05\xe5\x9c\x94\x3f\x10\x7c\x70\x94\xe6\x73\x1c\x95\x2a\x6a\xa1\x7c\x77\x47\xa6\xae\x27\xb9\xbb\xa0\x97\x11\xf9\x96\x75\xf3\xbf\x8c\xf1\x23\x65\x17\xaf\x55\x27\x0b\x43\x37\xaa\xc6\x8b\xd9\x90\x5b\xa6\x5d\x94\x0d\x0d\x37\xe1\x11\x6e\x45\x06\x11\xc9\x4d\x7c\xb6\xe3\x63\x64\x48\x63\xdb\x99\xe8\x03\x9f\x33\x82\xff\x5b\x08\xa7\x0a\xc7\x8d\xc5\x80\x55\xec\xcc\x6c\x3f\x10\xe7\x2a\x22\x5f\xe5\x84\x42\x58\x09\x97\xbe\x18\x3b\x08\x23\xd0\xf9\xa3\xd1\x3a\x6a\x76\x1b\xd7\x8b\x81\x77\xd3\xec\x18\x42\x26\x8c\xbc\xf0\x78\x1c\xe2\x74\x48\x9d\x3f\x1a\xd7\x7f\xb4\x6f\xb6\x9b\x1f\xdb\x7f\xeb\x18\x6b\xfc\x22\xc6\xd0\x25\xa3\x9d\x8f\x6d\x55\xc9\x54\xc5\x09\x34\xb9\x5e\xf8\x70\xd2\x43\xc5\xd6\x51\x43\xbf\xfc\x7a\x6e\x9f\x1b\xeb\x3f\xde\x39\xa1\x08\x66\xf6\x50\x5c\xf8\x97\xe0\x83\x00\xf0\x31\xd8\xfa\xb8\xfd\xf5\x8f\x8f\xc1\xf6\xd7\x8f\xc1\xf6\xdf\x3a\xb7\x87\xc6\x79\x8c\xb1\x08\xd7\x54\x3e\x79\xb9\x73\x84\x96\x32\x6e\x70\x1a\xb3\x56\xa9\x37\x98\x3b\x9f\xd2\x51\x2c\x6d\xc7\x81\xf0\xb2\x9d\xd7\x51\xf1\x0a\x21\xb2\xf1\x3c\x89\xc5\x50\xcc\xb8\xc3\x5e\xca\xed\xd0\x63\xcf\xca\xe2\x35\xa5\xe4\x61\x5d\x9e\x11\xde\x06\xd5\x35\x82\x72\x21\x0c\x6e\x97\x7d\x72\x82\x73\xfb\x1c\xc5\x8f\x98\x71\x60\x40\x2a\x1c\x52\x0b\x7e\xd1\x86\x8b\xa9\xd2\x95\x1a\xf5\xf0\x09\xf0\x65\xe4\x1e\xfa\xd3\xe9\x98\x68\xbb\x71\xd4\xff\xf8\x78\xfd\xf1\xb1\x7d\xb3\xf5\xb7\x66\xc7\x29\x85\x86\xf1\x05\xf1\xe4\xc4\x90\xad\x24\x85\xc0\xe4\xa3\x67\xd1\xac\xdc\xcf\x27\xe3\x92\xd8\x40\x9d\x3e\xcd\x76\xd2\x91\x20\xa5\xd9\x5f\x7d\x51\x87\x3b\xf1\x53\x3a\xe0\x52\x7d\xaa\x0f\xf0\x62\x87\x0e\xe8\xf9\x61\xad\xa4\x81\x24\x2d\x3f\x19\x40\x68\x1b\x00\x26\xb7\x7b\x44\x33\x4c\x4c\x8e\xbc\x9b\xfd\x7c\x33\x11\x9e\x7e\x30\x41\x83\x35\x07\x3e\xa8\x00\x57\xcd\x26\x87\x00\xaa\xf7\xda\x75\xa8\xd1\x38\x9e\x55\xbd\xdd\x93\x7f\x74\xdb\xbd\x7a\x2d\xbf\xa2\x71\x1a\x35\x17\xb3\xa8\xfe\xb7\x5e\x9d\x9a\xa9\x6f\x24\xb7\xb2\x00\x9d\xbf\xac\x80\xa5\x6a\x38\xc2\x3a\xc7\x35\x68\x90\xd0\xb0\xa7\x4f\x78\x41\x35\x1b\x87\x6f\x35\xe3\xd7\x48\xcc\x9a\x06\xdc\x54\x89\xc2\xe5\x99\xf0\x80\xfe\x74\x93\x89\xea\xd6\x02\xc1\xfd\xfb\x73\xf0\xad\x3a\xac\x64\x8c\x76\x75\x00\x70\x46\x16\xd5\xb9\x4b\x50\x39\x34\x68\x00\xf5\x83\x1c\x3a\x5e\x0c\x20\xb7\xf3\x19\x00\xe2\x3b\xb7\x53\x67\x1e\x5c\x86\x18\xc9\x8f\xc0\x84\x0e\x6b\x1b\x20\x4f\x45\x3d\x4c\x88\x40\xfa\x9b\xec\x63\x4a\x62\xfe\xad\xfa\xbd\x21\x9c\x6e\x7d\x98\x0d\xc5\x88\x26\x3e\xa5\xad\xf2\xeb\x88\xdb\x64\xce\x50\xd3\xe0\xd6\x82\xb8\x50\xc8\x79\xb0\x25\x09\xc0\x54\x0a\x05\x86\xdf\xa0\xeb\xdc\xc2\xf8\x49\x4f\x7c\xdd\xd2\x5d\x2d\xf6\x34\xaf\x0f\xa7\xb3\xba\x95\x79\xdf\xe9\x50\x71\x26\x0c\x95\xc2\x36\x5d\xa9\xd3\xa1\xe2\xd4\x17\xf5\x91\xf3\x90\xd7\xc6\xae\xb1\xc6\x95\x77\xea\x86\xc9\x6a\x9d\x0e\x15\xa7\x00\xa9\x8b\x2f\x2b\x4d\xa0\x8d\xfd\xe2\x1a\xb7\x61\x6e\x8d\xa7\xa6\x1a\x62\xa5\x0a\x52\x29\x15\xd7\x98\xe4\xb7\xf1\xdc\x54\x23\xb7\x8d\xe2\x8c\x11\x75\x3e\x35\x66\xea\x60\x06\x0d\x93\x3e\xf5\x46\x79\x8d\x18\xf2\x9f\xd4\x67\x93\x95\x46\xb8\x8a\x61\xda\xa3\xc0\xc3\x89\x7a\xa8\x62\x98\x77\xc4\xd7\xf6\xa6\xe9\x76\x50\xc5\x30\xf1\x7c\xcd\x95\xae\xc1\x1d\x33\xcc\x3c\xdb\x9a\xe4\x54\x31\x4c\x3d\x1f\xc5\x73\xaa\x18\xe6\x3e\xf4\xde\xf0\x41\x34\x59\x0b\x1d\x33\x4c\x7e\xea\xaa\x43\xd5\x43\x15\xc3\xec\xab\x6b\x86\x64\x23\xe8\xd8\x8e\x61\xf6\xf5\xb5\x7a\xb2\x0e\xaa\x18\x66\x3f\xf4\xde\xaa\xd8\xc7\x71\x25\x54\x31\xcd\xbe\xf7\x98\x6c\x20\xaa\x62\x98\xfd\xc4\x4d\x60\x54\x15\xad\x18\x66\x5f\xdf\x72\x27\x9b\x42\x15\xc3\xec\xc7\xfc\x51\xbf\x92\x55\xf2\x67\xff\xe6\x5a\xaa\xbc\x6e\x68\x9b\xea\x8d\x26\x6f\x72\xd3\xe0\x16\x7f\x41\x43\xb5\x2d\x19\x78\x81\x48\xc4\xcc\xbb\xcd\x9a\xb2\x7e\xa2\x61\xb9\x51\x14\x5c\x49\x98\xf6\x27\x56\x3f\x4a\xa8\x15\xcf\xaa\x69\x85\x27\x96\x0a\x2d\x20\x7f\x05\xa3\x1c\xcb\x12\xe5\x90\x9b\xb2\xe1\x20\x7f\xda\x56\xb9\x63\xc7\x9e\x4f\xde\x83\xf0\x3b\xb8\x57\xf6\x5b\xe3\xb4\x15\xec\x16\xec\x98\x7d\xa5\xe7\x0c\xef\x96\x16\xd2\xc2\x3a\x81\xf6\xd7\x81\x15\x49\xc8\xa9\x6b\x61\x6b\x0c\xc3\x1f\xdd\xa8\x0d\x71\x2e\x6d\x3c\x92\xf5\xeb\x32\x29\xbe\xd4\x55\x3a\x4e\x7a\x23\x5c\x9e\x5b\x64\xb6\xee\x18\xf1\x25\xfb\x67\x4e\x3e\xea\x5a\xe4\xc2\x2e\x64\x54\x7c\xe8\x2b\x0f\xf7\x33\xf3\x1e\x83\x5e\x37\x91\xee\xa3\x80\x18\x64\xd4\x81\x85\xf6\x63\xca\x1e\xf3\x0a\x72\x30\x40\x04\x5b\x0c\xcb\x94\x2f\xfe\x68\xc5\x00\x27\x3f\xf2\x70\x8c\xec\xf5\xe3\x0d\xab\xb6\x94\x9d\x11\x8b\x7c\xc8\x01\xa3\xc4\x3c\x2b\x71\xdd\xad\xee\xbe\x13\x39\x28\xd8\x92\x14\x78\xa4\xa1\xe7\x86\xb6\xe3\xa2\x8b\xfe\xc8\xdc\xd0\xaa\xd1\x12\xac\x5c\xc3\x3b\xc7\x05\x24\x63\xdd\x08\x1f\x79\x6a\x41\xc3\x2d\x30\x26\x69\xa5\x55\x7f\xa4\x9b\x85\x2e\x7a\x38\x99\xf3\xea\xa8\x18\xb6\xab\xd3\xa1\xcf\x1a\xa2\x3d\x0c\x91\x0e\x3c\x17\x70\xc3\x19\xe3\xe8\x6d\xa9\xb9\xb4\x53\x61\xc1\x92\xe6\x56\x99\xc7\x9b\x87\x07\x65\x87\xeb\x4e\x87\x7e\x35\xa8\x49\x73\xb3\x86\x2c\x0c\x08\x8e\x35\xa8\x60\x56\x3d\x4b\xc5\x88\xc5\xdd\x1b\xdd\x6b\x4d\xea\xbd\xd6\xa4\x8e\xca\x6f\xfa\xa1\x8e\x0e\x46\xd4\x32\x46\x28\x56\x23\x39\x1d\xe7\x10\x3a\x50\xe6\xb8\x85\x23\x29\x49\x70\xa2\x6e\x9b\xab\x44\x96\x89\xae\xb2\xb5\x3e\xd7\x3c\x30\xad\x4c\x0f\x0a\x72\x81\x24\x3f\xb8\x37\x5c\x0c\xaf\x41\xf7\x4a\x45\x5a\x5a\x25\x31\xb1\x19\x7c\xd8\xa1\x22\xb3\xcf\x25\x14\xaf\x3f\x3e\xa6\xd2\xa5\x8e\xe2\x67\xd7\x84\xe9\xf8\xcc\x96\xa5\x88\x38\xda\xeb\x9a\x03\x1f\x55\xd6\x09\xe1\xc7\x75\x52\x79\x8f\x61\x95\x82\x6b\xfb\x66\x26\x2c\x53\x29\x1c\x9e\x37\xd7\xa1\x57\x89\x28\x25\xd5\x54\x4d\xd5\x23\x5e\x27\x1f\xe8\xeb\x64\x3c\xfc\xc1\x92\x82\x2f\xfe\x1a\x3e\x73\xfa\x02\x24\xee\x27\x12\xb8\x3b\xfc\x82\x43\x15\x75\xd7\x4a\x65\x0a\x5a\xf1\x0d\x92\x42\xde\x33\xaa\x66\x6a\xae\x3f\x4e\x3a\xc7\x96\x7e\x5d\xf6\x80\xda\x1d\x6a\xc5\x13\xb9\x5d\xb5\xd1\xca\xe4\xa3\x3f\x7c\x4f\x8d\x6b\xc3\x8a\xf0\x6b\x3f\xb4\x07\x6e\x94\x84\xe2\xda\x75\xaa\x66\x34\x2c\xe1\xc4\x15\xd6\x69\x86\xf7\x1a\x18\xee\xba\x1d\xca\xdb\x96\xda\xb5\x0d\x48\xe5\x47\x34\x6c\xa9\x34\x51\x2a\xf7\x44\xc5\xcd\x36\x63\x28\x79\x95\x42\x73\x0a\x06\x73\x45\xec\x1a\xb7\xce\x83\x70\x71\xfb\x1a\x11\x6c\x8b\x0c\x72\x49\x09\xa9\x97\xed\x5f\x39\xa3\xff\x5e\xc6\x1d\x31\xef\xcf\x4a\x75\x0f\xb3\xe1\xef\x63\xe4\xe5\xab\xc5\xa0\x8a\x4b\xa5\x95\x08\x74\x5e\x89\x72\xb4\xc0\xb8\x50\x26\xda\xc1\x21\xc1\x5d\xc6\x21\xc7\x4b\x0e\x0a\x47\x35\x03\x54\x0d\x1a\xa4\x20\x16\x30\xe4\x64\x43\x53\x72\xe9\xa7\x5c\x04\x29\xf5\x6e\x40\xf6\x44\xb9\x17\xca\x85\xa9\x6d\xf5\x39\x67\x47\x95\x06\x95\x5c\x15\x4d\xea\x67\x8b\x04\xd4\xe1\xda\xd2\xe4\x45\xf7\xf9\xb3\xde\x1e\xdf\xf3\xb3\x7c\xb8\x23\x05\xe8\x44\x1f\x41\x99\x01\x3d\xeb\x25\x8d\xdd\x0b\x1e\xe6\x41\xa9\xac\x1d\xb8\x36\xc0\x05\x37\xb0\x7f\x44\x2e\x1d\x14\x8e\xb8\x8c\x53\xc4\x89\x3e\x4a\xbb\x81\x44\x20\xfe\xe8\x47\x66\x00\xd1\xcf\x01\x78\xc3\x2b\xda\x67\x38\x23\xc0\xd9\x4f\xc2\xcf\x4b\x06\x52\x89\xe2\xe4\xc9\xf6\xf1\x4e\x84\x77\xe9\x73\x91\x9c\xed\x89\x18\x47\x96\xbc\x99\x75\xea\x04\x08\x47\x57\x3e\x35\x8d\xc8\x90\xf1\x88\x3e\xab\xff\xaf\xae\x4e\x04\x37\xc5\xba\x95\x13\xc9\x19\x4c\x9a\x52\xea\x6a\x96\xa5\x32\xd9\x3c\xa5\x89\x7e\x20\x08\x51\x90\x97\xdb\x44\x97\x28\x7a\x62\x69\xa6\x5a\x9c\xac\xf5\x44\xae\x64\x86\x2e\x76\x96\x49\x9b\x1a\x95\xe3\x1e\x1f\x1c\x2b\xe4\xcd\x80\x39\x28\x69\xf2\x81\xce\xcd\xa2\x6e\x9b\xff\xe9\xca\x7f\xf5\xff\xba\x3d\x12\xe1\xb0\x5d\x09\x8e\x8a\x00\x1b\xcd\x75\x30\xa2\x9f\xe2\xdd\xa2\xc0\x06\x22\xfb\x41\xd7\xfb\xd4\x0a\x46\xeb\xfa\x2d\x56\x82\x5e\x6a\xb9\x91\x37\x1e\xd9\xa5\x4a\x9b\x48\xad\xe2\x45\x67\x41\x00\xfc\x8a\x80\x62\x63\x24\x5c\xa6\x07\x2a\x05\x4d\x50\xe9\xd4\x17\x45\x97\xd0\xef\xab\x10\xbb\x5b\x12\x69\x5c\x27\x60\xaa\x20\xfa\xba\x30\x36\xae\x55\x9a\x51\xfd\xa2\x5a\x0f\xab\x49\xde\xf7\x09\xfa\x8c\x48\xb3\x45\x4e\xd5\xf3\x27\xdf\xf1\xee\x3f\x7d\xd6\xed\x46\x09\x5e\xf6\x9f\x22\x81\xb8\x33\xa6\x67\x5a\xf1\x91\xe6\x9d\xe5\xc4\xc6\x4a\x13\xf0\x4a\x24\x17\x0a\x38\x0b\x94\x49\x8e\x2b\x07\xc8\x7c\x15\xa4\xab\x59\x70\x22\xf6\x5a\x1e\x37\x8e\xb6\xca\xcf\xb0\x30\xde\xa2\xfb\xf2\x28\xf1\x15\x48\x14\x58\xa0\xf9\xec\xa8\xf6\x63\x78\xa4\x3e\x53\x1e\x56\x3f\xf3\xc6\xfa\x93\x68\x9f\x4b\x69\xfe\xbe\x4f\x8f\x92\x3d\x97\xaf\x1f\xb8\x85\xf5\x6f\xda\x93\x1d\xdd\x54\xf4\xcc\x0a\x29\x30\x9f\x01\x5f\xf1\xd3\x3d\x3a\x6a\x8f\x46\xa2\xa2\xa8\xbe\xe2\x99\xfb\x39\xd6\x58\x15\xda\xfe\xe9\x8a\x65\x4f\x04\x0b\xee\x02\x06\xc3\xe9\xe2\xfc\x4a\xbd\xbc\x5e\xdc\xaf\x71\x8c\xd3\xb8\x1b\x93\x03\x3b\x85\xfb\x24\xee\x90\x88\xcb\x71\x87\xbe\xb0\x83\x75\xd0\xa5\xd8\x23\x43\xab\xc2\x1e\x93\xcf\xa2\x2d\xaa\xc6\x3e\xd0\x0f\x37\xa7\x36\x98\xc8\x8b\x5b\x6f\x38\x15\xf8\x58\xc5\x65\xb8\x86\x9d\xfb\x46\x2c\x38\xc3\x70\xd6\x23\x08\x8d\x03\x57\x66\x2c\x51\x48\x58\xa3\x9b\x51\xcb\xad\x96\x4a\x7a\xa6\xdf\xfe\xa0\xcd\xaa\xa2\x1e\xa5\xf6\x1d\x33\xb4\x9e\x61\xf1\xea\xe2\x8e\x36\x41\x95\xc9\x33\x4e\x58\x5e\x96\xca\xb3\xa4\x73\x49\xab\xdf\x0a\x56\xb9\x72\x32\xdb\x22\x63\xea\xab\xbf\xae\x66\xc5\x62\x2c\x9e\x32\xe6\x2d\x11\xe3\x14\x01\x47\x9d\x4a\x1b\xf6\xea\x52\x79\xcf\x42\x99\xee\x2e\xcc\xa6\xbb\xc5\xf3\x5e\x80\xd2\x62\xc1\xaf\xc0\xea\xf6\xf2\xfd\xc5\xd5\xc5\xd5\x7f\x5e\x9e\x74\x4e\xcf\x3f\x5c\x1d\x9f\xbf\x3e\xa1\x77\x27\x57\x7f\xbf\x78\xf3\xa1\xfc\xe6\x53\x3b\xf6\xa7\x0c\x64\xe8\xf1\xce\x0b\xf4\x9d\x95\xbe\xa2\x19\x04\xde\x64\x1e\xa6\x6e\xb2\x9c\x20\xae\x63\xbe\x95\xbc\x6c\xeb\xfa\xff\x80\x6d\x30\x2e\x05\xf1\x86\xfa\x54\xcd\xb4\x77\xb1\xe2\x3a\x14\xde\x39\x45\x19\x1c\xd4\x5c\xea\x88\xf8\x38\xf7\x15\xae\x61\x03\xb2\x0f\x7f\x20\xf6\x0a\x90\x16\x09\x18\x9c\xee\xf8\xf1\xce\x9b\x24\x22\x37\x6e\x69\x15\x9d\x92\x3b\x46\x8e\x2f\x24\xaa\xbc\x71\xde\x32\xcb\x45\xfb\x50\x38\x93\x4a\x58\x56\x88\xc8\x75\xc0\x66\x5c\x43\x0d\xe3\x04\xf2\xb8\x68\x21\x0a\xf6\x77\xa0\x2c\xf9\xa6\x97\xf6\xd0\x5e\xc5\x11\x74\x91\xbe\xb0\xe5\xfd\xac\xed\xa6\xcb\xc6\x9d\x44\x50\xfc\x64\x26\xf4\x2d\x6a\x55\x01\x9d\x76\x78\xae\x04\xb7\xab\xc0\xea\x3c\x11\x88\x54\x01\x53\x06\xae\x9c\x2a\xe9\xf9\x52\xe7\x94\xed\x86\xca\x0f\x23\x1d\xd9\x4a\xa7\x50\x05\x09\xbc\x42\x9e\xbb\xcb\x36\x87\x37\x4a\x4c\x28\x2d\x4d\x16\xdc\xce\xa8\x94\xf6\xa3\xf8\x5b\x40\x8e\x95\x99\x7a\x0d\xfd\x7b\x66\x3b\xab\xd5\x4f\x29\x2b\xc8\xcb\xe0\x26\x3d\x45\x56\x2e\x0e\x93\x6d\xe4\xce\xaa\xc9\x5a\x3c\x17\xc9\xaa\x53\x97\xb2\x4f\xc0\xf3\x68\x56\x69\xdd\xc0\x74\xc2\xb5\xe8\xc1\xaa\x65\xbf\xd1\x0f\x76\x09\x0c\xaa\x30\xe9\x11\xf3\xab\x27\xc9\x20\x15\x85\x86\x9a\xb8\x5b\x81\xe2\xec\x21\xe1\x87\xa4\x1c\x67\x92\x99\x5c\xd4\x4a\x4d\xde\x1a\xf2\x69\x4d\xff\x59\xd0\x91\x64\x18\xb4\xf4\x9c\xa5\x25\x0e\x3d\x67\x1c\x26\xc5\x2d\xf0\xe1\x88\x46\xc6\x7d\xbd\x7e\xb8\x89\xbc\xaa\x0f\xe9\x41\xaa\xf0\xa4\xf4\xf1\xa0\xe5\x7a\x57\x06\x60\xd2\x95\x73\xbd\xf5\xc0\xd0\x4d\xc1\xe7\x35\x06\x37\x22\x57\x3e\xf1\xc2\x62\xe5\x74\xe5\x2d\x3c\x36\xce\x57\xde\x9e\xa2\x27\xa9\xb7\x5d\x42\xec\xd3\xbc\xb7\xdd\x15\x08\xdd\x5c\xb8\xdd\x5c\xb8\xe7\x0a\x6e\xba\xec\x79\x2e\xdc\xf3\x5c\xb8\xe7\x0a\x6e\xfa\xed\xa9\x82\x9b\x1e\xf1\x69\x2e\x1e\x4e\x73\xf1\x70\x9a\x03\x37\x87\x07\x7c\xc7\x26\x89\xa8\x88\xea\x6e\x39\x59\x2c\xd9\x48\x96\x5d\x47\x1b\xab\x3d\x1c\x7a\x3e\x6b\x58\x42\x8f\xde\x9c\xbc\x3e\x7d\x77\x7c\xf6\xe9\xf2\xec\xf8\xf5\xc9\x07\xbe\x62\x48\x85\x91\x29\x65\x12\xb2\x23\xbf\x20\xe3\x26\xff\xb5\x2e\x23\xde\x3d\x34\x11\x2d\x67\x7d\x37\x30\x61\x2b\x33\x00\x2b\x1d\x04\x87\x9a\x7f\xb1\x10\xa3\x1d\x80\x75\x18\x0f\x9e\x97\x95\x08\x50\x18\x40\x12\x7e\x0c\x32\x33\x7f\xf1\x37\x72\x8f\xad\x88\x7d\xb6\x7f\x46\xa4\x91\x78\x1a\xf8\xd5\xba\x73\xb1\xf7\x9d\x73\xd1\xb5\xa8\xf7\x7d\x28\xd7\x0e\xd6\x2b\xe8\x8b\x9b\x83\x84\x22\xbe\xc0\x36\x2a\xf4\xd2\xe5\x4c\x02\x4a\xec\x97\xad\x2f\xed\xc6\xf6\x24\x10\x65\x08\xe6\x86\x20\x2b\xe3\xd7\x75\xd1\xb9\xff\x03\x64\x0c\x75\x1e\xfd\x0e\x94\xfe\x4f\x88\xe2\xad\xaa\xb2\x38\xab\x55\x53\x78\x2d\xc2\xe8\x9a\xc2\xf8\xee\xbf\x85\x0e\x37\x13\xc2\x37\xa4\x45\xd5\xd8\x15\x04\x73\x10\xe4\x6d\xb8\x2e\x41\x3e\xfd\x11\x04\xf9\x8a\xba\xff\x6e\xcc\x7a\x7e\xfe\x8a\x4f\x82\x5f\xd9\xee\xbe\x1f\xcb\x17\xfe\x09\x5a\x55\x87\x8c\xdb\x50\xac\x7b\xc8\x78\x66\xc4\x37\x1c\xa2\xfa\x55\xb1\xde\x54\xf1\x5b\x95\x87\x5f\xbe\xb4\xf7\xc3\x67\xc1\x26\x5e\xc6\x5a\xec\xdd\x18\xa9\x4e\xf0\x56\xc2\x49\x22\xb0\x08\x75\x0a\x3d\x4f\x9e\xe8\x13\x82\xfe\xe2\xaf\x1b\x66\x14\x37\x62\x75\x84\x55\x07\xa8\x76\x60\x26\x15\xfe\x6b\x93\xb1\xc2\x4e\xc1\x7c\x64\x79\xa5\xce\x4d\xf1\x41\x67\xe7\xdf\x80\x20\x8e\x2d\xb3\xf1\xdc\x4b\xe7\xee\xea\xc8\xe0\x11\x06\xff\x86\x61\xb9\xe2\xd6\x0e\x9d\x07\xf1\x3d\x63\x53\x20\xd4\xbc\x9f\x8b\xdb\x75\x86\x1a\xde\x39\xc1\xff\x21\xef\xeb\x97\xdb\x36\x96\x7c\xff\xe7\x53\xb4\x73\x6f\x42\x52\x04\x41\x50\xb1\x73\x62\xd1\x8a\xca\x49\x94\xb3\xda\x72\x24\x5f\xdb\xd9\xdd\x2a\x45\xf7\x14\x48\x40\x12\x24\x12\xa0\x01\x28\x26\x65\x6b\xdf\x69\x5f\x61\x9f\xec\xd6\xaf\x31\x03\x0c\x80\x19\x00\x94\x7d\x72\xcf\xa9\xa5\x54\x36\x05\xcc\xf4\xf4\x7c\xf5\xf4\xf4\x67\x26\x94\xfb\x13\x7a\xcb\xa9\x27\xc6\xce\x67\x74\x16\x62\xe3\xc7\x2e\xeb\xec\x9b\x50\xd9\xfc\x19\x27\xc8\x23\xa4\x58\x8f\x1c\x18\xb4\x94\x9f\xca\xcb\x9d\x4f\xe5\xef\x67\x4d\x63\xd8\xf1\x7c\x78\xf1\x27\x8f\xe9\x9f\x79\x24\xcb\xf1\x2d\x9f\xc7\xcb\xdd\xcf\xe3\xe7\x5f\xfa\x3c\x1e\x97\x0f\x64\x09\x70\x87\xf1\xa7\x90\xc6\x2c\x57\x08\x6b\x4f\xeb\x72\x05\x94\x85\x5c\x61\x5c\x16\x42\x38\x34\x66\x81\xc5\xb8\x0c\xc3\x11\x90\xab\x72\x17\x1d\x64\x47\x0f\xf9\x54\x40\x2e\x17\x3e\x15\x80\xeb\x4f\xeb\x80\x4f\x05\xe0\xf2\xd3\x13\x01\xb7\xdc\xda\x89\x80\x5b\x7f\x5a\x87\x7b\xa2\x81\xab\x59\xd7\x9f\x71\xf9\x61\x5f\xd9\xae\x6b\xbb\x6d\x09\x67\xc0\xb0\x6c\x93\xbb\xf9\x0e\xcb\x16\xa2\xd4\xcc\x05\x2d\x45\x32\xfb\x77\x5b\xab\x57\x2d\x22\x3f\x1b\x21\x53\x35\x97\x70\x61\x7b\x60\x27\x06\x31\x21\xd3\xa2\xa9\x66\x15\xe3\x77\x4b\x87\xfa\xad\xa0\x2f\x8e\x2e\x6e\x8d\x2d\x95\xb2\xd9\xeb\x0d\x5c\x58\xb8\xf9\xc4\xc5\xee\x7a\x32\x37\x05\x52\x40\x80\x18\x73\x13\x6f\x83\xab\x10\xc6\x5d\x97\x97\x7e\xdc\xd0\x88\x0b\xed\xb9\x79\x02\xf0\x83\x84\xff\x87\x34\x9e\xeb\xfb\xaa\x50\x90\x8d\x0d\xd7\xe7\xc1\x76\x38\xdb\x45\xb9\x89\x39\xde\xc8\xbc\xe9\x05\xb3\x67\x9e\xc7\x2d\x0a\x6f\x3b\x16\x6e\x77\x4a\xe4\x2c\xc3\xdb\x16\x51\xfc\x86\xe3\xc0\x3e\xd9\xfa\xcd\x36\x3a\xc5\xd4\x4a\x09\x80\x7e\xe8\x15\xc0\x0b\x99\xf1\x3e\x9f\x65\xce\xea\x3f\xc8\x87\xdd\xa2\x2d\x0d\xe9\xa0\x32\xf9\x48\x3a\x7d\x44\x1b\x3a\x80\xbe\xa8\xd1\xf6\xa5\xc0\xa9\x39\xd7\x80\xc4\x07\x3c\x4a\x86\x92\x34\x6d\x35\x56\x11\xe0\x05\xb1\xd9\x62\xac\x38\xfb\xbf\x34\x47\xb7\x68\xa3\x38\x72\xca\x67\x51\x86\x09\xde\xcc\xa3\xf4\x9a\xd3\x5e\xdd\xb7\x1a\x56\x8a\xc1\xc9\xb0\xea\x30\x3e\x1b\x51\x70\x43\x07\xcd\x1d\x10\x9d\x38\x39\x3e\x3e\xa6\xbf\x3c\x7b\x4a\x83\x7d\xc7\xf9\x7e\x48\xdf\xd9\xdf\x1e\xf0\x39\xc4\x47\x8b\x93\xe5\x76\xcc\x8d\xf3\xd2\xa8\x10\xf2\xb4\x42\x2f\xcb\x64\x39\x1d\xef\x11\x8d\x1d\xd8\xc9\xd1\xf0\x0b\x99\x08\x6c\x4a\x59\xfd\x37\xbe\x01\xee\xb6\x54\x6c\x6b\x2a\x96\xed\x1a\x25\x29\x96\xb6\xd4\x64\x42\x3f\xfb\xa9\x1f\xaf\x82\xd0\x47\x5a\x8d\xc5\xb5\x3c\x54\xe6\xc1\x15\x2e\x83\xad\x8a\x20\x26\xc9\x88\x74\xdb\xb6\xb1\xb8\x34\x0e\x00\xc4\x2e\x15\x4a\x1f\x33\xc5\x92\xd4\x7e\xec\xce\x7a\x0d\x45\x08\xbc\xea\x66\x31\x6b\xb3\x04\x69\x6e\x88\x87\x74\xe3\xb7\xb7\xb4\x6d\x6a\xc9\xdc\xf5\xd4\x46\x7a\xe8\x38\x31\xcf\x83\x98\x8b\xd7\xb1\x5f\xc4\x43\x06\x63\xca\xd2\x5b\xb0\xf3\x32\x10\x60\x83\x61\x50\xa6\x87\xc3\xc1\xe5\xce\x68\x8e\x98\xbe\x69\x17\x67\xbb\x12\x72\xf2\xa1\x76\x08\xbb\x84\xef\x16\x92\x6d\xe9\x45\x9f\xd9\x5d\xce\xb7\x6d\xa6\xb0\x30\x2a\xcc\x57\x87\x58\x54\xb9\x2a\x74\x48\x2f\x44\xc7\xf2\x6c\xee\x7c\x63\x38\x22\x97\x0e\x1a\x23\x34\x65\x43\x02\x68\x18\x16\x67\x46\x73\x7a\x41\x37\x33\x9a\x8f\x46\xcd\x8b\xb5\x58\xb0\x8b\xf3\x39\x9b\xa7\x6d\xf9\x4b\xdb\x92\xc5\x8f\xe8\xc5\x86\x2b\xbc\x40\xaa\xf1\x79\x07\xe3\xf7\x0e\x76\x6f\x0f\xbd\xdd\xde\x18\x56\xe4\x64\x42\x1b\x7a\x41\xdb\x23\x7a\xcd\xd1\xc2\x37\x0b\x79\x05\xca\xfc\xd6\xa3\xcb\x5d\x08\x00\x7a\x3b\x14\xdb\xd0\xca\xa8\x0e\x52\x7e\xf1\x91\x9c\x5a\x92\xc4\x9b\x59\x29\x4c\x0c\x5c\x77\xca\x93\x3b\xae\x9a\xb7\x19\xcf\xc7\x4a\x10\x71\x74\x65\xb3\xc0\xc1\x94\x5c\x47\x71\x6a\xc2\x3c\x8b\x45\x27\xb3\x4e\xba\x6a\x86\xfc\xad\x5a\x1b\x89\xf1\xf3\x44\x6e\x9c\x20\x18\x75\xb8\x5c\x92\x42\x63\xe6\xa6\x05\xde\xe6\x41\xa2\xb9\x08\xee\x2d\x75\xe5\xbc\x3d\x37\x79\x26\x29\xe3\x21\x82\xd1\x11\x36\xea\xd3\x0e\xfa\xfd\xed\x22\xcb\x70\x66\x72\x67\x97\xcd\xc3\x2e\xdc\x6d\x31\xa4\x96\xcb\x7f\x3c\xbe\x11\xcb\xf8\xa6\x7d\xfd\x17\x86\x89\x37\x33\x0a\x20\x35\x79\x22\xcc\x12\x61\x90\x78\x0e\xeb\x44\x33\xcf\x2d\x3f\xe3\x31\x17\x6d\x2e\xb4\x59\x9c\xdf\xb0\x09\x72\xb3\xc3\x6e\x03\x55\xce\x20\x8c\xb1\xf0\xce\x6f\x2e\x66\x3b\x6e\x21\x61\xb5\x59\x36\xcf\x87\xf2\x39\xf3\xe4\xcc\x09\x76\xa1\xac\x5e\xaa\xba\x24\xcd\xa4\xe4\xe6\xc0\x0e\xc6\x4a\xe6\x88\xb2\x68\x3c\xde\x9a\x63\xe9\x0b\x8f\x96\xa3\x5e\x1b\x3f\xd8\x38\xd7\xc8\x1f\x10\x2d\x97\xd1\x07\x74\x45\xc3\x46\x99\x59\xef\xc9\x24\xe7\xb0\x46\x0e\xd1\xfc\x2e\x25\x95\xe5\xaa\xf1\x5c\x1f\xdc\xd8\x4b\xb4\xda\xb5\xca\x4f\x46\x38\xf4\x7c\x57\x4b\xb4\xc6\xad\xb0\xe1\xdc\xb6\xdb\x70\x4a\x96\x74\xd7\xd9\x57\x88\xc7\x22\x0f\x17\x23\x3b\x05\xa2\x31\xda\xd0\x98\x46\x5b\x9c\x1c\xf9\xe3\x6f\xbe\xa1\x31\x1e\x8f\x4b\x8f\x4d\x4d\x5c\x22\x5d\x21\x4a\xf8\x94\x85\xa9\x37\x0c\x96\xe8\x82\x12\x12\x79\x0b\x3a\x6c\xd1\x56\x1b\x9f\xaf\x8b\x44\x87\xbd\x27\x21\xc7\xa8\x88\x2c\xf8\xf9\xa9\xe1\x39\x64\x19\x15\x19\x10\x39\xf4\x35\x2f\x8d\x8a\x04\x67\x2c\x9f\x8f\xcb\xcf\xc9\x31\xb4\xeb\x18\xda\x75\x64\xbb\x15\x38\xa7\xb2\xdd\x4a\xf9\x53\x03\xfc\x53\x03\xfc\x53\x09\xbf\xf2\xfc\xc4\x00\xff\xc4\x00\xff\xc4\x00\xff\x44\x07\xff\x0b\x0b\x81\x22\xef\x6e\xd9\x59\xc2\x69\xd3\xbb\x22\x21\xa2\xc7\xcc\x68\x42\x51\x45\x00\xfd\xeb\xd9\xcf\xbf\xbd\x3a\xeb\x64\x5c\x23\x5a\x87\xd8\x68\x15\x79\x3b\x8a\x8d\xde\x5b\x94\xb4\x4b\x8b\x0c\x84\x31\x13\x06\x4d\x77\x16\x06\xf5\x9a\x6f\xc8\xb8\xaa\xcb\xfb\x70\xbe\xb1\xb3\xd8\xb5\x7c\x2d\xde\x0a\x65\x93\xbc\x22\xdb\x4d\x84\xd9\x16\x92\x03\x3b\xc1\xff\x20\x5b\x38\x30\xb7\x76\x4e\xb0\x65\x79\xd3\x9e\xef\x2a\x4c\x2a\xf7\x61\x93\xdf\xf2\xd5\x1e\xf0\x15\xdf\x8c\xb2\xb8\x05\x64\x98\x6f\x33\xcc\x37\x02\xe3\xcd\x23\x31\xde\x0c\x77\xa2\xbb\xdc\xb6\xb2\xf8\xe0\x01\xfc\xbc\xf5\x60\x3b\xbe\x5b\x2c\x03\x2f\x70\xd9\xfc\x87\xc3\xad\x1d\xd0\x7b\x44\xe0\x09\xae\xc2\xc1\x16\x06\x94\x6c\xc2\x31\xd8\xd0\x04\xf6\xf0\x83\xed\xd0\xec\xa0\x3d\x99\xb0\x83\x38\x88\xf8\xfb\x2d\x1e\x7c\x60\xff\x7d\x72\x90\x5d\x28\x46\xda\x0c\x06\x60\xac\x9f\x48\xa9\xa2\x7c\x50\xfd\x6c\x9b\xcc\xda\xf1\x0b\xcc\xd9\xa4\x6c\x63\x41\x7e\xe9\x18\xec\x44\xe4\x4f\x06\xaf\xa1\xc1\xf7\x76\x42\x7b\xc6\x12\xf9\xd5\x4f\x3e\x68\x47\x47\x9d\x9f\xdd\xa6\x57\x2c\x91\x4d\x26\x6a\x46\xf6\x47\x8e\x43\x88\x39\x7a\xdc\x39\xf6\xf9\xc4\x93\xd5\xab\xbe\x1a\x30\x6d\x2f\x8b\x31\xb6\xba\x5b\xa6\xc1\x7a\x89\xfc\x5a\xf3\x6d\x39\x07\xbe\x96\x0e\x0a\x40\xac\x5f\x0b\xfd\xab\xbf\x8f\x8b\x44\xe6\x13\x31\xc6\x7f\x9f\x3e\x35\xd8\x1b\xcb\x81\x7e\xd4\xa0\x52\x48\x23\xad\xba\x67\xa4\xd1\x71\xa0\xec\x49\x4d\x1f\xe2\xd0\x88\xc2\x1a\x04\x47\xc0\x2d\x1f\xe5\x8e\x16\xae\xa3\x85\x7b\x2a\xe0\x96\xcb\x9e\x0a\xb8\xf5\xa7\x75\xb8\xa7\x02\x6e\xf9\xe9\x89\x80\x5b\x6e\xed\x44\xc0\xad\x3f\xad\xc3\x3d\x11\x70\xd5\xb2\x5f\x76\xa5\xae\x97\x5f\x4e\xd5\xc3\xb0\xb0\x52\x5d\x6f\xd7\x23\x3b\x6d\x3f\xaf\x3f\x4f\xbb\xb3\xbf\xf3\x81\x2e\x8b\xa8\x9f\xff\xb1\xda\x9d\x8c\xb4\xfe\xcf\x56\xef\x88\xcd\xa6\x78\x93\x04\xb9\xf3\x4e\xf1\xd0\xee\xe9\x01\x34\x68\x7d\x2a\x0b\xd0\x65\x7b\xfb\x2f\xa1\xd7\xf9\x33\x14\x34\x26\xdd\x51\x45\x69\xb3\x6d\x54\xd3\x90\xcb\x9e\x54\xc3\xd9\x3f\x81\xde\xa3\x8b\xac\x9d\x7e\x71\x13\xb6\x8e\x8d\xe8\x8e\x6d\x3e\x58\x50\x8e\xdb\x50\x48\x5e\x44\x77\x21\x0b\x69\x12\xbb\x69\xfb\x96\xd5\x23\xf2\xa5\xa1\x70\x97\x14\x80\x5f\x46\x5b\xd1\xc6\xd9\x7d\x31\x05\x4c\xef\xf1\x9a\x07\x55\x3a\xe6\x7e\x41\x85\x86\x1e\xa5\x92\xd2\x41\x5f\xb3\xa4\x83\x68\x58\x5a\x15\xb9\xfa\x32\x0a\x21\x4a\x67\xf1\xba\xc5\xb2\x9c\xb9\x7c\x25\xe5\xcc\xad\x12\x64\x97\xc6\xac\xbd\xc0\xfa\x48\x55\x21\x7b\x2e\x76\x4f\x2d\xa1\xfc\x31\xe2\x75\x06\x01\xb6\x90\x5b\x7b\x2c\x90\x53\xc5\xd7\x90\x31\x43\x74\x05\xb4\x2e\xef\x62\xa6\x87\x45\x4c\xb0\xcd\x82\x16\x6e\x88\x40\x15\xc1\x55\x18\xc5\xa6\xd8\x0a\x85\xc6\x05\xda\x96\xe6\xd4\x81\x28\x25\x44\xcc\xf3\x8b\x5c\x63\x32\xca\x34\x26\x34\x22\x97\x63\x64\xe2\xa8\x69\x0e\xe2\x96\xd5\xfb\xba\x49\x14\x6c\x20\x3d\x18\x59\xb7\x09\xc5\xcd\xc2\x16\x9b\x7c\xe0\x36\x2c\xb9\xd1\x68\xeb\xef\xd4\xb0\x49\x84\x08\x62\x64\x09\xf9\xe1\x48\xc8\x0f\x39\xf3\xe5\x78\x43\x23\x21\x38\x74\x7a\x55\x68\x02\x22\x13\x06\xe9\x7d\x8f\x10\xa3\xeb\x28\x49\x82\xb9\xea\x7e\xac\x7c\xbe\xbc\xdc\x50\xeb\x18\xaa\x09\xbc\x1d\x5d\x96\x38\xd5\x0a\x2b\x6b\x1b\x78\xe4\xf3\xfb\x0b\xfa\x38\x8f\xa2\xa5\xef\x86\x9f\x32\xad\xd4\x03\xfd\xbb\x8c\x65\x16\xd1\x22\xba\x0b\x53\x69\x05\x3d\x66\x77\xa6\xb2\x83\xe3\x01\xdb\x08\x5a\x99\xc5\x1e\xfc\x7c\xa2\x98\x9c\x56\x5e\x58\xc6\x68\xe7\xab\x5b\x52\xe6\x87\xef\x1f\xef\x3f\xda\xce\x0d\x0b\xb6\xc8\xbc\x9b\x8b\x94\x03\x83\x61\x91\x91\x91\xb3\x95\x90\x18\x27\xc8\x75\xe6\x41\xe8\xc6\x42\x01\x7b\x40\x1f\xef\x0d\x59\x50\xb0\x15\xe8\x5e\x4d\xee\x8e\x3f\x0e\xe9\xc9\x93\xfb\xe2\x8f\x69\xf1\xb5\xf9\x9c\xd2\xe4\x52\x99\x7e\x6b\x51\x5f\xa2\x89\x58\xf2\x61\x94\xfe\x18\x45\x4b\x8b\xee\x9b\xa8\x79\x81\x17\x30\x19\xd2\x7d\x63\xce\x93\x86\x7d\xde\xd1\x19\xb7\xea\x84\xab\x2f\x85\xeb\xeb\x1f\x6a\x9e\x3b\x73\xb8\xb5\xaa\xbb\x6c\x33\x73\xba\xbb\xab\xae\x88\x62\x6d\xf7\xf4\x30\xa9\x9b\x7f\x6e\x13\x4e\x2f\x3d\xaf\x82\x4e\x79\x23\x97\x62\x27\xb5\xe1\xf1\x47\x9e\x85\xf0\x0f\x19\x89\xa8\xc0\xc5\x1c\x89\xa8\x69\x62\x79\x81\x8a\x98\x77\x88\x9d\x25\xbc\x89\x9b\xa3\xe0\xc9\x85\xf0\x39\x94\xee\x4b\x39\xa6\xad\xdc\x4d\xb0\xba\x5b\x55\x6e\xed\xde\xba\xea\x55\xaf\xcf\x58\xc0\xdc\x7e\x1a\x91\x53\xf7\x7d\xa5\xd8\x47\x22\x78\x78\x08\x2c\x71\x75\x50\xc1\x47\xab\x20\x4d\x7d\xcf\x48\x6e\xbd\xf5\x05\x7d\x94\x74\xf6\xe7\x12\x22\x36\x09\x5f\x11\x8b\x1c\xf4\xe0\xd7\x97\xff\x91\x85\x8a\x4f\x82\x3f\x4a\xb6\xd0\x74\x1e\xaf\x14\x28\x6f\x54\xcc\xab\x40\xbe\xd7\x83\x50\xa1\xf5\xb9\xeb\x83\x61\x75\x5c\xd4\x5c\xaa\x07\xf4\xd1\x5b\x3f\xf4\xbb\x54\x2b\x27\x5c\x6d\xaa\x58\x1e\xf2\x5a\xbd\x78\xd5\xa9\x5e\x05\xcd\x4a\x2d\xdd\xe9\xc3\xed\x96\x45\x30\xde\x1a\x79\x1f\x8c\xc4\x97\x4f\x1e\x93\xd0\x50\x5b\x83\xe9\x13\xe2\x22\x14\xa1\x54\x83\xe4\xdf\x90\xd7\xf1\x04\xb9\xdc\xd1\x9e\x63\x21\x7f\xa7\x45\xd3\x67\x2d\x09\x8f\xa5\x6f\xa3\x45\xff\xf9\x9f\xde\x9a\x46\x25\x87\xc6\x78\xa5\xb4\x61\x04\xf1\x44\x6d\x1c\x8b\xdb\xb1\xe8\x7b\x34\x2d\xdc\xbf\x83\xf0\xea\x57\x4c\x02\xb2\xf8\x95\xd7\xfa\x01\xc6\xe5\xd3\xce\xd7\xcf\xff\xcf\x84\x80\x99\xcb\x4c\x8c\x7c\x2b\x16\xa6\xda\xc0\x60\x8d\x6c\x7b\x4c\xf1\xa7\xce\xd0\xa6\xb7\x28\x2e\x2f\x0f\x1c\x9e\x17\x34\x10\x79\x8e\x9d\xec\x66\x21\x5e\x71\x2c\x57\x7e\xf3\xa2\xcc\xe4\xa8\xc0\x6f\x8b\xad\x99\xef\xc5\x31\x18\xc8\xb7\x2f\x7f\x39\xfe\xdb\xc9\xe9\xbb\xe3\xbf\x1e\xbf\x11\x1b\xbc\xfc\xac\x75\xab\x9e\x5c\x66\x51\xeb\xd4\x35\xcf\xf8\x15\x19\xa2\x05\x1f\x96\x16\x0a\x47\x19\x0a\xef\xbf\xff\x8b\xa3\xb1\xdc\xe2\xba\xc3\xd4\xad\x10\xcb\x68\x9d\x5d\x4c\x48\xf4\x85\x0d\x45\x85\x3b\x52\xb7\xee\xed\x43\xbf\xb9\x46\x65\xd3\xde\xb6\xee\x59\x6e\xb3\xb4\x67\x6f\xdb\x36\x2b\x96\xc4\xac\x69\x71\xaa\x7b\xe2\x56\x33\x47\x56\x6d\x86\x2c\x9a\x7e\x57\xe2\xb7\x86\x8d\x47\x3c\x86\x7a\xea\x8f\xf6\xa7\x3c\xdc\x79\x9a\xec\xdb\x61\x36\x27\xeb\x38\xf2\xee\x16\x85\x64\x24\x70\x97\x48\xeb\xc2\x89\xbf\xed\x9e\x1e\x2a\x21\xf4\xaf\x50\xe1\x50\x7f\xea\x83\xe5\x53\x01\x9b\xb5\x6c\x35\x09\x13\xd2\xdc\x7e\xf3\x0d\x85\x99\x9a\x91\x23\x21\x03\xe1\xfa\x52\xfd\xf4\x09\x01\xe2\xeb\xcb\xd5\xdc\x96\xc4\x33\xa1\x3d\x01\x55\x06\x15\x9f\x66\xe2\xbb\xc6\x9a\x07\xfa\x0c\xdd\x1d\x08\x07\x27\x54\x18\x8c\xc3\x61\xdd\x2a\x80\xdf\xd0\xa9\xe9\xcd\xf8\xc4\x58\x27\x7b\x53\x56\x48\x64\xd0\x9c\x61\xdd\x4a\x82\xdf\x8c\x9d\x61\xd5\x0e\xe3\x71\xf4\x2d\x79\x7f\x07\x21\x63\x1c\x45\x79\x0c\x69\x03\xc9\x53\xae\x3c\x7b\x39\x1f\x94\x5b\x49\x7d\x89\x90\x1e\x19\x2e\x6f\x80\x0a\xdf\xda\xde\xc7\xe5\x0d\xd9\xb4\x1f\x91\xd6\xdf\xa2\xd8\xa2\xd8\x5f\x5b\x94\x7e\x81\x1b\x9c\xb9\x40\xc2\x05\x1a\x20\x08\xa1\xbb\xb9\x00\xce\xed\xea\x70\x8d\xe8\xa9\xb9\xc2\xb5\xbb\xbc\xac\xb1\x07\x7d\xc7\x7e\xd6\x37\xf1\x07\x10\x55\x08\x17\xd1\xc9\xa9\x7b\x3a\x91\x94\x78\x62\x96\x59\x33\x4b\x91\x88\x1b\x23\x84\xc9\x6c\x2d\xf0\xe4\x71\x46\x02\xf4\x84\xd5\x99\xec\x63\x0a\x46\x7f\x20\xc0\x09\x68\x47\x6c\x6d\x71\x40\xd2\x97\x42\x6c\xdb\x9d\x58\x00\xf8\x0e\x84\x01\x53\x35\x3f\x49\x83\x95\x9b\xaa\xe7\x9a\xf2\xc1\x84\xfd\xea\xa6\xd7\x36\xef\x1d\x1a\x6d\x9a\x2c\x46\xf2\x82\x74\x27\xe3\x16\x4e\xa2\xc6\x44\xea\x90\x4a\xbb\x49\x92\x25\x2d\x2b\xaa\xbb\x89\x3c\xb0\x2c\xec\xab\x50\x9a\x18\x8a\x08\xfa\x99\x99\xa1\xd8\x73\xd9\x49\x6a\x37\x4d\x8b\x8c\x50\x9f\x80\x1d\x13\xc3\xd5\x30\x29\x38\xa0\x16\x91\x7f\x79\xf9\x4e\x64\x9f\x1b\x2c\x0c\x0c\x56\xde\xc6\x80\x42\x79\x83\x46\x3a\x78\xc4\x76\xdf\x97\xe1\x71\x43\xd8\x6c\xf6\x9d\xfe\xac\x71\x57\xe4\x9d\x1f\x84\x0d\x8d\x95\x34\x02\x9c\xc1\x5d\x26\xd6\xd9\x17\x36\xc4\xbe\x48\xc8\x40\x3e\xe3\x60\x9a\xad\xf2\xe8\xb4\x8f\x89\x1c\x17\x71\xac\xf9\x9f\x29\x67\x07\xa8\xc4\x2e\xe5\x43\x6c\x12\x89\xcb\x2a\xa1\x50\x72\x80\x49\x0a\x6d\xce\xa3\x71\x76\x39\xe8\xfb\xfd\xa1\x18\x87\x66\xcc\xcc\x43\x11\xd7\x28\x84\x69\x16\x5a\xbb\x57\x07\x45\x09\xd2\x09\xf6\x77\xdf\xa2\x45\x5e\x3e\xb3\x26\x8b\x0d\x74\x16\xd1\xdd\xd2\x03\x1f\x29\x55\x60\x32\xf8\x67\x90\xd0\xe2\x1a\x8c\x9c\xa7\xa4\xd7\x00\xf3\x25\x8e\xb2\x0f\x6e\x42\x88\x1c\x5c\xbe\x16\x2b\x1f\xce\xb2\x12\x24\xf4\x81\x5b\x58\xb8\x50\x0a\xb9\xb9\x5d\x11\x78\x78\x6e\x72\xb0\x99\xa4\x43\x3e\xb6\xae\xfd\x70\xe1\x17\x66\x56\x73\x7f\x19\x7d\xb0\x84\xa3\x8d\x02\xc4\xd4\x58\x69\xdf\x81\x28\x70\x4e\x44\x3d\x6e\xbc\x7e\xe3\x76\x4b\x2c\x6c\x9a\xd8\x6e\x58\x18\x20\x72\xd8\x49\xde\x7a\xd6\xb6\x59\x5e\xc0\xfa\x88\x92\x86\xd0\x6d\xa2\x23\xa7\xfe\x87\x34\x0a\xc7\x6f\xdc\xf5\x75\x12\x85\x14\xa4\x7e\xdc\xc2\x3c\x4a\xa9\x55\xb3\x0a\x41\x6a\xa0\x62\x33\xaa\x72\x15\xe2\xe4\x93\xec\x68\x9a\xb9\x39\xe6\xa6\x4b\xa9\xc5\xf7\x5c\xec\x99\x8e\x09\x24\xca\x14\x91\x52\x7b\x41\x44\x43\x65\x43\x26\xc2\xc1\x7a\x00\x59\x54\x23\x3c\xfc\x56\xc0\xc5\x36\x64\x95\x55\x70\x8d\xb2\xc3\x7a\xf6\x97\xfc\x5c\x88\x39\xe7\x21\x5b\xaa\xcd\x7d\x8a\x42\x5f\xf1\x82\xc7\x19\x72\x19\x84\xee\x52\xde\xc1\x64\x45\xab\xd7\xd2\x10\xd6\xa7\x6f\x5f\x65\x69\x22\x9e\x3f\x7f\xfe\x9c\x06\xfe\xf8\xe9\x90\xc6\xe3\x1f\xf8\xd9\x14\x7f\x7f\x3b\xb4\x28\x89\xe4\x81\x95\xe0\x7b\x3d\x42\x7e\xd2\xd3\x37\x50\x7c\x26\x13\x76\x31\x64\x12\xe7\x7b\xb4\x88\x62\x44\x4d\x35\x19\xd4\x6b\x76\x05\xce\x01\x1c\x44\xe3\xb1\xe1\xa2\x65\xa4\xad\x09\x8d\xe9\x5b\x8c\x3f\x93\xd4\x59\xaf\x0b\xae\xb0\xa1\x7d\x9a\x5e\x57\xba\x29\x33\x4f\x06\xa1\xc8\x73\xcd\x96\x63\x18\x12\x11\xb6\xe0\xe9\x63\xc7\x85\x47\x3f\x8a\xe9\x29\xfe\x1f\xb0\x81\x9a\xbb\x5e\xc7\x91\xbb\xb8\x06\x30\xb7\x80\x3b\xc7\x17\x37\xde\x0e\x39\x33\x65\x10\xde\x71\xdc\xf9\x2e\xed\x74\xd8\xb2\x95\xb8\x8e\x87\x87\xd4\x07\x66\x7d\x30\x1b\x4f\x62\x7f\x0d\xee\x2d\xc4\x01\xdb\x07\xa2\xfd\x6e\xeb\x59\x34\x7f\xa6\x86\xe9\xcf\x71\x61\x97\x1c\x4b\xe8\xcf\xe0\x93\xe3\xfb\xa0\xf7\x79\x77\xef\xd6\x22\xaf\x50\x97\x4e\x8a\x96\xfc\x0d\x3c\x69\xc4\x6e\x10\xea\xd0\x30\x08\xfd\x84\xe7\x2f\xc8\x48\xb9\xbf\xdc\xe2\x82\xe0\xbb\x0d\x3e\x6d\xea\x0f\x0f\x09\x8f\x41\x1b\x35\xd3\x88\xd3\x52\x8b\x52\x96\x65\xd7\x18\xfd\x7d\xab\xc5\xc2\xa4\xfa\x61\x3c\x52\x41\x06\xd3\xa1\xed\xbf\x1f\x6c\x9a\x45\x7a\xba\x0f\xc8\x69\x3a\xdb\xa9\xca\x8e\x61\xdd\x1f\x7a\x5d\x8a\x35\xb0\x30\xea\x0f\x24\x91\xc6\xd0\x80\xd5\x9f\x64\x87\xb2\x98\xcf\x46\x6b\xdd\x1a\x9b\xd4\x09\x2c\xae\x23\xca\x22\x96\x09\xc1\x62\x04\xb1\x5a\x2e\x2d\x72\x3e\x3a\xd6\xd3\x07\x08\x6a\x9e\xe1\xeb\xb7\x0f\x72\x07\xe0\xcc\xe4\xe5\xdb\xb5\x19\x71\x5b\x40\x73\x61\x94\x8a\xdb\x45\x91\xb8\xb8\x62\x05\x00\x86\x66\x55\xe4\xab\xe0\xc4\xc7\xbb\x2c\xff\x51\xc8\x94\x60\x24\x69\xeb\x74\x28\xa4\x3a\xd7\x6e\xfc\x32\x85\x55\x07\x68\xc3\xb3\x1d\x08\x83\xe8\xc6\x3b\x21\x56\x92\xc2\xcf\x8c\x4a\x94\x07\xd0\xde\x75\xd7\x41\x04\x60\xdc\x75\xda\x20\x91\xa6\x9f\x15\x1d\xd2\x93\x58\x6c\xba\x58\x6c\xba\xd9\x97\x5c\xe1\x1d\x77\xd7\x43\xef\x71\x6f\x1f\x7a\x3b\xe0\x26\x2e\xf1\xad\xa3\x38\xad\x44\x3a\xb5\x68\xf5\x58\x59\x5a\x48\x7b\x1a\xd3\x61\x3c\xad\x9b\xe2\xe2\x69\xdd\x74\xd8\xa1\x3d\x6d\x84\x5f\x1d\x5c\x47\x0b\xd7\x11\x70\xcb\x4f\x4f\x05\xdc\xfa\x53\x9d\x49\xb2\x0e\xee\xa9\x16\xee\x89\x80\x5b\xee\xc5\x89\x16\xee\x89\x16\xee\x89\x66\x1c\x1e\x27\xf9\x33\x69\x36\x78\xbd\x97\x4a\xa8\xf0\x77\xb4\x49\xce\x80\x41\x9a\xb7\xba\x5b\xee\x68\x94\xbc\xb0\xc8\x57\xf2\xa0\xaf\x60\x2f\xf5\xca\xa2\xcd\x32\xb2\x68\x73\x1d\xc0\x8e\xea\x95\x45\x5b\xfc\xb9\xc5\x9f\xf7\x0d\x22\xbb\xb9\x0b\xdb\x11\x88\x7f\x7e\xc4\xb7\xcf\x90\x0e\x76\xb4\x7b\x1d\x08\xc3\xe7\xbf\x58\x66\x23\x67\x1a\xda\x8b\x2e\xb6\xcc\x96\xa2\x48\xc1\xf1\xf1\xdf\xff\xe5\x1c\xf5\xba\x98\xb8\x7e\xfa\xa4\x33\x0d\xfd\xd8\xeb\x60\xb1\x2a\x1c\xa6\x4a\xc1\xf1\x59\xc9\x80\x7b\x48\x90\x08\xad\x35\x56\x49\x24\x4b\x48\x14\x5b\x8d\x55\xed\x44\xf5\x9f\xda\x08\x67\x24\xa9\x24\x10\x88\x6f\x85\x57\x55\xfe\x78\xb3\x68\x65\x78\xe0\xdf\x04\x93\x63\xdc\x95\x33\xeb\x6a\xb3\xc1\x48\x27\x31\x88\x34\xd3\xde\x6b\xb2\x70\xaf\x0f\x9e\xd6\x46\xb9\x14\x09\xdf\x3c\x46\xba\x79\x6c\xeb\x77\xb5\xef\x1d\xd2\xb2\x54\x11\x76\xaa\x98\xaa\xda\xc7\xc7\x0e\x5d\x81\xd7\xb9\x73\x31\xeb\x50\xb4\x43\xc6\xbe\xa6\xe3\xae\x4d\x42\xbd\x9b\xbf\x70\x59\x4c\x59\x36\xa2\x67\x29\x5d\xf1\xb2\x6c\x34\x6f\x62\x32\xd4\x95\x24\x9f\xa9\x9f\xcd\xe2\x55\xbb\x2d\xeb\x76\xf1\xaa\x9b\x35\xeb\x71\x98\xdc\xc5\x3e\xec\xf4\xd7\xb0\x6b\x65\x5b\x69\xd5\xa2\x95\x37\x30\x9a\x4c\x23\x42\x66\xe7\x56\x53\x56\x94\x45\x40\x81\x57\x34\xa4\xfb\x85\x36\x72\xc4\xfd\xc2\x12\xf1\x1f\x40\xa8\x05\xa6\x20\xd2\xfc\x35\x98\xb5\xc9\xf1\x61\xc6\xad\x28\x96\xd9\xf2\x96\xb3\x2d\x37\x65\x57\x52\xb3\x2a\xbd\x62\x3b\xd4\x57\x38\x0c\xe0\x4b\x7e\x31\x23\x24\x2e\xa4\xfb\x52\xfe\x72\x2d\x14\x9c\x10\x22\x6a\x83\x7e\xe0\xe5\xe1\x41\x87\xf4\xf6\xff\xbc\x79\xd7\x94\xbb\xa1\xc0\x68\xbb\x78\x35\xa3\xf1\x98\xb3\xbf\x3b\xcd\x32\xb1\x45\xf3\xda\xdf\xb2\x3f\xee\x16\x11\x16\xe8\xeb\x1c\x99\x86\xf2\xd7\x41\x5e\x7e\x52\x20\xff\xa9\x51\xe4\x57\x24\x7b\xe3\xa3\x16\xe1\x3d\x90\xf5\xfe\x36\x0b\x3d\x11\xb4\x0b\xf5\x36\x8c\x25\xdb\x06\xdf\x76\xc3\x13\x3f\x1b\xc6\x75\xb3\x38\xbf\xad\xe3\x2a\xcb\xe8\x3e\xe0\xd0\xb7\xd7\x01\xed\x81\x29\xa0\x11\xb8\x02\xda\x03\x47\xd0\xd2\x1c\x23\x89\x01\x95\x15\x91\xc6\x63\xa5\xa0\xcb\x09\x3a\x94\x3f\x46\x74\xcf\x51\x27\x46\xd4\x60\x32\x2f\x27\x71\xc0\x40\x27\x34\x17\x7d\xe0\xfa\x80\x5f\xee\x1a\x3f\x16\xd8\x5f\xb7\x64\xea\x44\xeb\x59\xb6\x34\x80\xfe\x9a\x41\xcf\x1e\x43\x08\x01\x08\x60\x16\x3b\x51\x42\x6c\xff\x45\xd3\xd4\x8f\x46\x86\x19\x6e\x3d\x26\xee\xf3\x88\x19\xb3\x47\xdc\x56\xca\x56\xd0\xa0\x3e\x9f\x69\x04\xfd\xf7\x35\x0d\x4c\x3c\x9d\x5d\x75\x83\x79\x60\xe9\xa6\x85\x13\x3a\x5e\x81\xd3\x6a\xb3\x01\x4c\x3c\xc5\x7a\xef\x6d\xad\x45\xc5\x84\x6f\xfa\xa7\xda\x01\xa6\xd1\xcf\x8c\xc0\x60\x48\xb9\x11\x74\xd5\x52\x27\xf1\x1e\xfa\xed\x95\x6a\x06\x41\x4d\xd5\xca\x43\x5b\xab\x1a\xaf\xba\x56\xad\xa0\x5a\xa9\xa8\xbd\xff\x08\x50\xe5\xab\x4f\xe2\x3d\xde\x18\x50\x16\x52\x3f\x6c\xdf\x8e\x7f\x0a\x5b\xc0\x92\x3d\x1e\xda\x9b\x4a\x63\xc0\xef\x2d\xc5\x04\x1d\x42\x9b\xa3\xac\xd2\x01\x25\x9e\x99\xe4\xc2\x0a\x90\xe2\x95\xb1\x09\xc5\xe4\xef\xfb\xce\x26\x7f\x86\xa6\xb2\xbd\x9d\x78\x74\x24\x85\x3a\xa1\x55\x8c\xd9\xc1\xe7\xda\xfc\x25\xac\xad\x81\x1c\x38\xf6\x13\x3f\x4c\xb5\x59\x53\x94\x8d\x1d\x84\xb9\x76\x45\x35\xa0\x02\xff\xa4\x33\x89\xc9\xb6\x73\xb9\xc3\x48\x0b\xbe\xa6\xcb\x60\xe3\x7b\x15\x6b\x56\xd3\x5e\xf9\x87\xb4\xe3\xad\xa8\xba\x77\x37\xcc\x6d\x01\x50\xd9\x5f\xad\xd5\x77\xdf\xd8\x4d\xf5\x1f\xb3\xbb\x15\x78\x3b\xd9\xfb\x8a\xf3\xeb\x12\xe7\x57\x2a\x42\x3e\xf7\x1a\x04\xe1\x8a\x7f\x87\xd1\xca\xf7\x39\xef\x34\x61\xc1\x8b\x58\x4b\x99\xd8\x19\x3b\x73\xfa\x9c\x86\x7f\xfa\xa6\xe1\xe5\x3e\xe6\xcb\x47\xb1\x69\xe4\x90\xab\x8d\x98\x77\xc7\x97\x3f\x1b\x4f\xa3\xd4\x3f\x80\xa7\x16\xdf\x2a\xfe\xd5\xfd\xc3\x7d\xbb\x88\x83\x75\xda\x4f\xa4\x9f\x44\xba\x5d\xfb\x16\x0d\xc6\xce\xd0\x4e\xa3\x5f\xd0\x07\x5c\x1b\x82\x84\xfa\x4e\x5f\x11\xfa\xec\x71\xd0\x2c\xdf\xbe\xb2\x51\x16\xca\x54\x67\x5a\xab\x31\x76\xfa\xff\x64\x1b\x3c\x43\xff\x11\x36\xf7\xc6\xaa\xed\x9b\x5a\x56\xdc\x7d\x3b\xeb\x6b\x3e\x66\x23\x33\xa4\xcf\xde\xc2\x1d\xb7\xea\x7e\x93\x81\xea\x51\xdd\x08\xbf\xb4\x97\xf7\x9d\x7f\xa8\xbd\xec\x97\x0e\x41\xb1\x5f\x57\xf5\x0d\x9a\x6d\xf2\xf2\xda\xc8\x4c\xdf\xb3\x11\x4c\xab\xd6\xa4\xc0\x68\x1d\x47\x6b\x3f\x4e\x83\xb2\x71\xbd\xb0\x99\xfb\xe5\xec\xcd\xaf\x2f\xdf\x51\x34\xbf\xf1\x17\x29\x0d\x12\xdf\x2f\x70\xb6\x17\x51\x78\x19\x5c\x95\xe4\xd3\x2a\x08\x51\xf7\x50\x9d\xdb\xbd\x6c\x6e\x05\x8a\x6f\xfd\xb5\x1b\xbb\x69\x14\xd3\x01\xf5\xed\xf2\xce\x47\x5c\x0b\xba\x8a\xa3\xbb\x75\xa9\x94\x65\x2c\x15\xdc\xfb\x74\x40\xdf\xd6\x5f\x27\xfe\x22\x62\xcd\xfe\x5f\x95\x72\x4e\xbd\xdc\x25\x9c\xcb\x82\x28\xfc\x6b\xad\xd1\xdf\x37\x2f\x9d\xbe\x25\xc4\x28\x88\x24\xc0\x4a\x25\x8c\x61\xb2\x76\x17\x7e\x0b\x24\xd1\xa0\x5a\xea\x61\x66\xa0\x0e\xff\xa8\x14\x8b\x97\xcf\x23\x49\x96\xa1\x6e\x07\x9a\x25\x6b\x3e\x82\x68\xe9\xab\x3e\x8a\x6a\x31\xa8\x9d\xc8\x16\x34\x2b\x49\x0a\x53\x80\x47\x51\xae\xe9\xe7\x50\x2e\xb3\x25\x0e\x24\x0c\x22\x53\x8b\x11\x73\x89\x7d\x60\xf5\xf4\x2f\x85\x7b\x7a\x8c\xce\x25\x69\x6c\x27\xeb\x65\x90\x0e\xfa\x76\x5f\x4d\xb6\xa2\xf9\x5c\x4d\x11\x06\x33\x23\x09\x76\xbe\x61\x5b\xea\xec\x2b\x75\xea\xbb\xb8\xa5\x72\x79\x17\x1f\x52\xa9\x6d\xf9\xbc\x19\x46\x10\xa6\xaf\xe1\x2c\x7d\x08\x69\xee\xb9\x73\xd1\x5c\x5a\xee\x7a\xa5\xca\xb4\xa5\x0a\xa7\x18\x12\xea\x30\x9b\xcd\xb8\x5b\xca\x87\x69\x7e\xd7\xcd\xea\x1e\x49\x24\x0b\xc3\x81\x03\xf9\xa8\x19\xd6\xd2\x87\x56\x37\x87\xd8\x2c\xeb\x96\xeb\xe7\x6a\x7f\xc8\xc2\xe7\xab\xa9\x95\x4d\xe9\xd5\xbe\x95\xcd\x53\x60\x31\xc4\xb1\x59\x04\x9d\xaf\xc1\xab\x29\x7c\xb2\xb0\x05\x50\xa3\x4b\x5c\x0f\x34\x89\xb2\x5f\xa3\xd1\x4f\x9f\xe8\xaa\xc5\xb0\x44\x0c\x40\xa9\x7f\xc9\xdd\x3c\x49\x63\x36\x40\x0c\x8c\x7b\xa4\x6a\x62\x19\xd0\x0b\x74\x0b\x5f\x46\x87\x68\xbc\x0d\x53\xb5\xf5\xd1\x61\x75\x15\x8e\x34\xf8\x04\x3c\x92\x1a\x76\xa3\x83\x88\x4e\xfe\x64\xc3\xba\x2f\xc6\xb2\x63\xfb\xbc\x5e\x82\x96\x86\x01\x99\x57\x5a\x01\xf6\x90\xfa\x63\x78\x2c\x89\xbf\x67\xbd\x47\x20\x2d\xa8\xa3\xb2\x63\x8c\x45\xf1\x9b\xaf\x72\x1a\xc9\x7d\x2c\x4e\x13\xb5\x6f\x90\x30\x97\x68\x46\xfd\x1c\x36\x13\x56\xfc\x1c\x95\x30\xb2\x63\x9f\x8f\xaa\x2c\x21\xe5\x1b\xff\xea\x78\xb3\x1e\x50\xff\xf7\xdf\xbd\x8f\xe8\xfe\xd5\x3e\x8c\xb5\x1f\x7e\xff\xfd\xc7\xbe\x45\xfd\xab\x3e\xb5\x50\x40\xfc\xf6\xff\xf7\x37\xfd\xa2\x0b\x65\xf4\xf2\x8e\x34\xe3\x78\x50\xc2\xb1\xa5\x43\x07\xcd\x93\xf4\xd0\x6b\xe2\xba\x93\x34\xfe\x22\x3c\x70\xa6\x61\xea\xcc\x09\xbb\x09\xaa\x06\xab\xf5\xd2\xcf\xbb\xca\xfa\x29\xb5\x8d\xe2\xfc\xc7\x95\x12\x26\x8f\x51\xcc\x8c\xae\xf2\xc2\xf3\xc3\x68\x15\x84\x78\x95\xc5\x6e\x55\x1e\xe4\xa6\x5a\x2e\xad\xa3\x24\x80\x17\xb8\x0a\x5e\x46\x8b\x12\x48\x9a\x33\x63\xc1\x85\x3c\xb8\x44\xac\x43\x29\x8d\x2e\xb5\x7a\x72\x59\xc8\xa9\x55\xf8\x2a\x26\x41\xc6\x46\xe5\x90\xd8\xd4\x4c\x8b\x2b\x9e\x2f\xa3\x0f\x7e\x92\x0a\xbc\x42\x7f\xe1\x27\x09\x42\x58\xa4\x91\x0a\x3e\x1f\x6b\x35\x36\x01\x5b\xbf\x2d\xb7\x26\x2e\xef\x7c\x55\x08\xb4\x3f\x65\xb7\x97\x4f\xf9\xa4\xe4\x4e\xb0\xd0\xb1\x4d\x79\xa0\x5f\x14\xb6\x08\x3c\xb8\xba\xfe\x1b\x9a\x02\x43\x29\x26\x76\x30\xc4\xf0\x94\x7a\x5b\x63\xef\x56\x75\xb1\x73\x43\xf5\x0a\x8b\xb7\xf2\xba\xb0\x78\x02\x5c\x89\xc9\x5b\x79\x26\x5a\x0f\x06\xc9\x8d\x63\x8b\x3c\xc7\x22\x6f\x9f\x4d\x68\xfc\xcd\x9a\xbd\xe3\x42\xc7\x6a\x09\xce\x0b\xcd\x5f\xe6\xe9\xfb\xf7\x35\x91\xf1\x6a\xd2\xed\xb3\xd3\xe3\x06\x1a\x15\xe2\x38\xf7\x9c\x1d\x6b\x79\xa8\x15\xea\x6b\x19\xce\x58\x1c\x29\xb4\xf2\x72\x56\xb8\xe9\x48\x15\x2e\xd1\x87\x59\x64\x9a\x59\xaf\xd1\x2e\xbd\x84\xc0\xca\x1b\xce\xda\xc1\xde\x36\xf0\x01\x8c\xe7\x93\x4c\x55\x1b\x66\x69\x3f\x07\x30\x08\x46\x00\x51\x7b\x99\x72\x17\xdb\xed\x31\xd5\x70\x2f\xe6\x7e\xca\x8f\x08\x08\xb3\xbf\x6f\xf5\x5a\x4a\xf2\x6f\xbf\xba\xfa\x71\xba\x00\xe3\x23\xea\xab\x1b\xa1\x8f\x5b\x6c\x79\x63\xe1\xbc\xc2\x2c\xb4\x9c\xff\x0d\x67\xb8\xb8\x0f\x57\xbc\xd6\x0f\xda\xca\x9f\x5c\xa2\xd9\x52\xfe\xd9\x28\x1c\x0b\xac\x98\xbe\x08\xdd\x06\x05\x29\xc8\x6c\x81\x32\xd3\x1d\xf8\x15\xb5\x99\xac\xae\xb0\xf6\x9f\xdc\x0a\x13\x5a\xfc\x57\xe8\x38\x42\x19\x73\x61\x4a\x43\xfb\x2a\xf5\xb3\x69\x3c\xa2\x50\x5c\xa3\x66\xbd\xdd\xac\x62\x0c\x03\x94\x9b\x17\xe5\x71\x75\x36\x76\x2a\x9d\x58\x0c\x63\x9e\xd4\xdc\x09\x37\x8b\x4e\xd9\x89\x02\xe1\xa1\xa9\xa7\xbd\xca\x67\x32\x21\x31\xf4\x1c\x3b\x01\xa7\xef\xb4\xb0\x32\x5b\x05\x21\x0e\xaa\x1a\x49\x4d\xaf\xdd\xe2\x7c\x52\x15\xb4\xf5\x23\x45\xf9\xc0\xb4\xc7\xb3\xf1\xaf\xbc\x5b\xd0\x98\x2d\x7c\x8c\x91\x83\x3c\x91\x42\x94\x5e\x9f\xfd\xfb\xdb\xbf\xbd\x3b\x3e\x3d\x87\xe3\xe2\x06\x86\xe4\xf0\x51\x54\xec\x82\xe0\xb9\x78\x54\x3c\x18\x71\xa9\x03\xfe\xd7\x60\x03\x95\x2d\x89\x95\x07\xbd\x5d\x68\x2f\x56\xeb\x81\x87\x7c\xbb\x00\x03\x57\xc8\xec\x9b\x87\x35\x30\x95\xfa\x2f\x2d\x1c\x34\x91\x47\x12\xd3\x37\x25\x5e\x22\x24\x2b\xbc\x25\xf5\x85\xea\x04\xcb\x18\x85\x04\x32\x27\x10\x58\x6f\x5a\xb6\xb7\x55\x3e\xa1\x23\x87\xce\x64\x79\xa2\xfa\x8c\x75\x09\x5a\x1d\x5a\xe4\x99\x73\xfe\xcb\x8f\x07\x6e\xdb\x73\x84\xcb\x98\x8c\x4a\xed\x4d\x1b\x83\x10\xf2\xd6\xf0\xf6\x79\x12\x70\xc8\x1e\x62\xa0\x86\x6d\x86\xda\x7c\x32\x79\x0d\xf7\x3e\x1e\x1e\x6f\x7f\xd6\x7c\xc0\x85\x55\x64\xb3\x3e\x84\x53\x6a\xc4\x39\x74\x5a\x80\x63\x79\x85\xd5\xf0\xdc\x62\x7c\x5a\x40\x37\x40\x36\x10\x97\x0c\x2c\xa6\x69\xe5\x89\x36\x3d\x67\x68\x91\x37\x6d\x9e\xb2\xd0\x51\x47\xc0\xdb\x17\x68\x86\xe6\xf9\xca\x46\xbd\x56\xa3\x61\x86\x43\x27\x33\x0a\x9d\xda\xc2\x85\x5f\x5f\xcc\x47\x68\xf5\xfd\x2e\xc4\x2d\xf3\x08\xcd\x6f\x02\x70\x54\x5d\x46\x09\x3c\x54\x23\x84\x56\x0f\x9d\x89\xc7\xc9\x8f\xc3\xe9\xc4\x9b\x6a\xc1\x65\xd2\x2a\x76\x68\x0c\xa7\xd9\x28\xf9\x15\x0b\x7b\x1a\x8a\x71\xdc\x0c\x6d\x44\xba\x1f\xf2\xea\xd4\x42\xc3\x6f\x06\x0b\xdc\x9f\xd3\x01\x16\x13\x2c\x3d\x6a\xf8\x39\xa2\x73\x0c\x57\x71\x3a\x00\x45\xe5\x4f\xba\x30\x56\x3d\x40\x55\xa7\x5c\x55\xfd\x93\x2e\x66\xbd\x16\x1a\xe5\x6f\x0c\xbe\xac\xe2\xd8\x72\xe3\xcf\xba\x05\x36\xdd\xf4\x16\x51\xf8\x87\x1f\xa7\xd2\x9e\x47\xdc\x53\xd6\x71\xb0\xe2\x0b\x99\xdd\xca\xb7\x0b\x38\x2a\xd7\x6e\x62\xb2\xfe\x70\xe3\xb6\xb4\x18\x85\x3d\x27\x5f\xfd\xae\xe1\xf1\x9c\x79\x53\xb2\x45\x91\xdd\x34\x4a\xa3\x0d\x0e\x16\x58\xb0\x26\x08\xb6\x00\x23\x54\x72\x8a\xf4\x95\xb2\xf4\xee\xe3\xe7\x3e\xd2\x50\x0a\x7c\x64\x1e\xf1\x28\x3b\xec\x55\xf7\xc4\x3d\xf6\x6b\x52\xd3\xa4\x0b\x9e\xeb\xd1\xc1\x46\x00\xf0\xf5\xd9\xbf\xff\xed\xf5\x9b\xe3\x9f\x4e\xde\x9e\x9c\x9d\xca\x8b\xad\x23\xd9\xb9\x34\xaa\x14\xd0\x58\x71\xa8\x10\x55\xe0\x61\xa1\xce\xc8\xf5\x17\xe3\xe7\x8e\xf3\x97\xe9\xf3\xe7\xfb\xcf\x9e\xfe\xe5\xa9\xf3\xfc\xf9\x3e\xba\x5b\x7b\xa6\xd7\x90\x0c\x5e\xfb\x31\x64\xf3\x09\x3d\x7b\x4a\xcb\x28\x5a\x17\x8e\x93\x09\x9f\x95\x21\x78\xa3\x2a\x30\x7b\x68\x40\xaf\xbf\x8e\x3e\x0c\x86\xb9\x09\x4b\x5d\x45\x11\x3e\xf4\x9b\xca\x57\x2e\xad\x61\x87\x3b\xeb\x6b\x9e\x53\x78\x7d\xac\xa3\x0f\xa5\x8b\x6b\xd8\xb4\x07\x6e\x2d\x6a\x48\x33\x0c\x39\xeb\xca\x4d\xaf\xb3\xb4\x1f\x59\x84\x2c\x64\x95\x02\x73\x3c\x0a\x69\xd8\x7e\x63\x35\xef\xad\xd7\x6e\xa2\x5a\xe7\xe7\x71\x3c\x80\x7e\x70\x59\x8c\x45\x25\x32\x96\xdd\xab\xc2\x2a\xd8\x6b\x55\x83\x12\x76\x8c\xfd\xb4\x8f\x58\x9b\xb2\x31\x98\x66\x7d\xf3\x8d\xb6\x05\xfc\x72\x13\xbf\x80\xbb\xf6\x07\x21\x5f\xfc\x02\x5d\x08\x25\x8e\xbc\x12\x22\x52\x23\x24\xd9\x0d\x01\xdc\xf0\xbb\x76\xe3\xc4\xff\x65\x19\xb9\x29\x40\xe2\x0e\x8c\xfb\xc9\x93\x01\x5b\xa3\x31\xb9\x68\x0b\x24\x27\xcc\x32\x4b\xcc\x63\x3e\x94\x03\x1a\xe1\x50\x34\xb3\x1c\x06\x96\xa2\x70\x15\xc4\x9e\xf4\xdd\xc5\x75\x76\x15\x09\x16\x01\x26\x25\x93\xe1\x31\x99\x16\xbc\x7c\x84\x28\x66\x59\xa8\x08\x2e\x2d\xd3\x7e\x2c\x32\xbd\xb5\xff\xfe\xce\x4d\x55\x95\xb2\xf2\x99\x4c\x30\xf9\x22\xe0\x15\x9a\xd3\x18\x6c\xd6\x88\xc5\x88\xce\xf7\xbf\xb7\xe8\xe9\xf4\xc2\xca\xf2\x8c\xc0\xe1\xc3\x2f\xe4\x78\xa6\x96\xe4\xa5\x26\xba\xa4\xfd\xef\xe9\xea\xce\x8d\x3d\xd9\x46\xec\xa7\x6e\x10\xfa\x9e\x4d\x83\xdf\x98\x14\x8d\x68\x6a\x3f\x13\x11\x2c\xe0\x1c\x4d\xe7\xcf\xa1\x8e\xbb\x28\x55\x53\x89\x80\xf2\xb9\xa5\xc3\x0a\xc6\x47\xbc\x95\x16\x7e\xb0\x1c\x54\xde\x28\x6e\x0c\x23\x84\x5e\x81\x3e\xba\x6b\xf2\x86\x06\xc1\x8a\xca\xe3\x37\x48\x25\x78\xef\x04\x59\xd0\x97\x86\x75\x26\x9b\xdf\x0a\x9e\xaf\xcd\x2f\xb3\x48\x7f\xd4\xca\xcb\xe7\xc5\xf9\x8a\xbe\xb5\xa5\xa3\x05\xfd\x40\xb7\x34\x54\x1f\xb0\x84\x46\x56\xe9\xb8\x94\x35\x84\x2c\xc8\xe2\xde\x98\x41\x09\x7a\x52\xa0\x6e\x2c\x09\x42\xb7\xe9\x30\x24\x45\xff\x44\x2a\xa8\x4d\xb5\x9b\x9b\x0e\xdd\x34\x74\x51\x84\x11\xc0\x35\x78\xc8\xbe\x6f\x67\xa7\xc7\x36\x98\x52\x53\xfa\x0a\x41\x34\x6e\x0b\x73\xcf\xad\x55\x5e\x94\x35\x26\x96\x0e\x68\xfb\x79\x9c\xcb\xae\x36\x30\xd2\xba\x33\x8d\xf4\x56\xdc\x1a\x23\x98\x92\x76\x5f\x63\x12\x63\x83\x2d\x49\x58\xe6\x51\x0e\xec\x51\x0d\xd8\xab\xc2\x56\xc5\xea\x05\xf6\xcc\x49\x49\x49\x14\xc7\xd0\x16\x36\x32\x82\x21\xd3\x1b\xf0\xb0\x34\x3f\xa4\x52\x20\x9b\x3d\xad\xad\xab\xca\x9f\xfc\x93\x58\x9a\xbf\x96\x26\xce\x3b\xdb\x8d\xeb\x6b\x56\xd8\xa0\x96\x7a\xbb\x5b\x85\x98\x6b\x3f\xc6\x30\x24\x87\xb6\x93\xe1\xb9\xd6\xa4\x2d\x29\xa4\xe1\x15\x93\x36\xd5\xac\x7c\xff\x69\xc5\xac\x5c\xdb\x00\x7e\x8f\x84\x9d\x79\xd9\x34\xe4\x29\x0d\xff\xdc\x0d\x1d\x84\xec\x45\x43\x73\x76\xb4\xe0\xaf\x53\x76\x82\x9c\x53\x50\xda\x71\xd2\xa0\x14\xbb\xd5\xcd\xea\x04\x89\xaa\x12\xe3\x05\xcd\xd3\x25\xa0\x58\xdd\xee\x2b\xa5\x6e\xd4\x08\x43\xd1\x54\x45\x03\x87\x9b\x4e\xa5\x2f\xb8\x0e\x16\xca\xc2\x7c\x07\xab\x0d\xb0\x9c\x34\x10\x69\xbe\xb1\x15\xa3\x98\xae\x38\x66\x56\x9c\x11\x9e\x77\x67\xb8\x74\xff\xed\xf5\xd9\x5b\x1e\x10\xb7\xb8\x7c\x49\x70\xa5\xba\x39\xc5\x52\x1b\x11\x30\x4e\x8f\xff\x6a\x49\xaa\xbe\x13\x35\x99\x2b\x24\x20\xdf\xf3\x7c\x75\xfa\xee\x69\xa7\x4d\x9f\xcb\x16\x78\xec\x6a\xdb\x6e\xfe\xd0\x6f\xac\x50\xd9\x69\xf3\x0e\x1b\x4d\xc4\xf8\x52\x77\xd9\xbc\xc5\xf4\xca\x7c\x51\x91\x41\x65\xcd\x25\x20\xb7\x0a\xdb\x42\x4f\x86\x08\x13\xa6\x2d\x31\x99\xe4\x9a\x51\x8a\xda\xf2\x64\xf9\x74\x28\xbd\x3d\x5a\x79\xb6\xc4\xd4\x67\xf9\xc9\x8c\x2a\xfa\xb2\xf5\x86\x38\x82\x79\xfb\x89\x48\x9b\x22\xaa\xb2\x5d\x87\x56\xef\xdf\xd9\xd9\x4c\x41\xe4\xd4\x3d\xed\xef\xac\x4e\x69\x83\x9f\xc1\xae\x04\x05\x83\x96\x67\x38\x6b\x1e\x3e\x64\x80\x31\x38\xd6\xcc\x2d\xda\xb7\xe8\xbb\xa7\x16\xed\x3f\xb3\xa8\x8f\x75\xda\x6f\xb9\x85\x15\xa8\xf8\x48\x70\x59\xec\x4a\x00\xf7\xe9\x87\xfc\xd1\xeb\xb3\xb7\x8d\x40\xf0\x7b\x44\x65\xaf\x09\x58\x4e\xb0\x9b\x5d\x6b\xcd\x03\x12\x66\xd5\x9c\xaf\xbe\xa8\xf8\x45\x26\x50\xc8\xe7\xe0\x4c\x39\x30\xb4\x83\x18\x0b\x9f\x58\xe6\xec\x20\x10\x58\x53\xbb\x2d\xb3\x93\x07\x31\x15\xa1\x8c\x3b\xae\xc9\x87\x5e\xd3\x29\xab\xad\xb6\xcb\x89\xf7\x68\x4f\x45\x19\xce\x59\xc8\x36\x3f\x5c\x47\x6a\xae\x99\x3d\xc1\x7f\xda\x6d\xb4\x2f\x87\x72\x28\xff\x2a\x31\x1b\xa6\x15\x2a\xba\x2f\xb5\x9e\xa5\x0b\x24\x10\x1d\x5a\x8a\xfd\xa8\x41\x61\xc0\xc3\xd4\x71\x9c\xe0\xf8\x9e\x51\x69\x8b\x5d\x36\xbc\x28\xe3\x02\x17\x0b\x7f\x9d\xca\x13\x5d\x06\xdc\x6e\xeb\x34\x8f\xef\x19\x42\xf1\x82\xf8\xff\xeb\xdb\xb3\xd3\x5d\xfa\xcc\xfd\x6a\xd2\xb4\xea\xa7\x7f\x42\x2f\x97\x81\x9b\xf8\x99\xf4\xef\xc7\xe0\x4a\xda\x5e\xaf\xfc\xf4\x3a\xf2\x34\x6e\xf1\x93\x89\xcc\x02\xf9\x9a\xd5\x23\x33\xf9\x02\xc0\xb2\x57\x7c\x9c\xe2\x46\xe3\xce\x23\xd5\xc0\x48\x7c\x00\x21\x91\x49\x4d\x00\x86\x55\x10\x33\x09\x21\xb9\x9b\x77\x81\x20\x04\x2f\xb8\x05\x8a\x58\x30\x39\x04\xc4\x83\xe9\x00\x01\x41\x3a\x3d\x1c\x6a\xaf\x71\x87\xac\x8f\x18\x5a\x89\xfd\x95\x8b\x18\x83\xd8\x92\x9c\xb0\x5a\x5f\x6c\x11\xad\xd6\x6e\xec\xbf\x8b\xb8\xd8\x62\xa5\xd1\x2d\xa0\x18\xf3\x3d\xbe\x4c\xf9\xaa\x9b\x10\x11\x49\x12\x0e\x06\x67\xf3\x1b\xc5\x56\xa4\xea\x7e\x30\xc8\x0b\xe9\x0e\x00\xb1\x28\xf2\x4a\x65\x74\x1e\x2a\x0d\x63\xd0\xde\x9c\xfc\xdb\xcb\x77\xc7\xf4\x2f\xc7\xaf\x5e\x1f\xbf\xa1\x5f\x7e\x3b\xfd\xe9\xdd\xc9\xd9\xe9\xdb\x4a\xc9\x7c\x39\xe6\xf1\x29\xb4\x52\x57\x70\x23\x10\x45\x84\x7a\x4f\x4c\x81\x5d\x66\x03\x0b\xc5\x34\x73\x03\x01\x1d\x51\x40\x07\x14\xd4\x35\xe5\x1a\x84\x73\x52\x55\x17\xd7\xb9\x49\xc1\xb3\x47\x97\x39\xff\x2d\xae\x8d\xfa\xfe\x94\x0f\x56\x6d\x02\x30\x74\x2a\xb1\xe8\x5e\xcf\x20\xa1\xbb\x53\xfd\x2b\x84\x3a\x70\x85\xc8\x43\x5f\x02\xab\xcb\x85\x22\x1b\x61\x70\x35\xd3\x29\x25\x5c\x30\xcb\xbd\x31\x47\x4a\x00\x07\xe7\x9e\x07\xa3\x91\x04\x24\x5f\xa8\x1f\x64\x4b\xca\xc5\x70\xe3\xdc\x48\x61\xd6\x28\x5a\xbb\x47\xa4\x0b\x80\xef\x3b\x7c\x2c\x99\x4e\xbc\x18\xa1\xf2\x34\xaa\x4f\xcd\x71\x55\x52\x76\x96\x93\x19\xd5\x89\x4e\x86\x07\x06\x32\x96\xf8\x52\xcc\x51\xe2\x7e\x8a\x3c\xff\x65\x3a\x18\x8f\x6f\x86\xbc\x8a\x9e\x7e\x3f\xa3\xa1\x71\xc9\xc5\xc2\xfc\x17\x47\xf7\x0d\x0e\x01\xb0\x2d\xb5\x63\x40\xb3\xdc\x7e\xca\x36\x78\xf9\xec\xcb\xb7\x57\x42\x1b\xb6\x26\xd9\x1a\x57\x17\xd7\xe6\xc0\xb2\x5b\xed\xf4\x61\x71\xb9\x16\xcd\xf5\xeb\xa3\xd9\xea\x4d\x26\x43\xd5\xbf\x0d\x9a\x22\xca\x63\x44\xb7\xa6\x97\xb7\x4d\xa1\xe6\x11\x71\x6b\xab\xbd\x0c\x94\xe2\x4b\x1d\xf5\x4c\x22\x47\x78\x79\xdf\x54\xd3\x6f\xd5\x4a\x23\x21\xa0\x1a\xc5\xa9\x3e\xb3\x48\x70\xa8\x06\x74\x6a\xc4\x48\x1f\xa0\x5e\x64\x53\xfc\xf4\x89\x94\xc4\xbd\x2e\x1d\xd1\x5c\x24\x7e\x18\xdf\x80\x2e\xe9\x21\x37\x27\xed\x15\x42\xe7\x27\x87\xa4\xf4\x55\x07\x09\x1d\xc5\xf6\xd6\x90\x4b\xf4\xf0\x16\x4c\xfc\xb2\xb1\x6f\xf2\xfe\x73\xd4\x6b\x8f\xf9\x24\x10\x91\xfd\x7b\xb2\x59\xd0\xff\xe5\x1e\xc3\xb7\x77\xac\x4b\x90\xa5\xec\x01\x79\xef\xd6\xec\xd3\xac\x25\x65\x14\x91\x7b\x63\xd9\x06\x1b\xab\x50\x86\x86\x11\xdb\x9b\x6d\x07\x06\xd9\x2a\x53\x9e\x1d\xd1\x2d\x1d\xd0\xb2\x19\x3f\x26\xf4\x88\xbc\x6d\x88\xf2\x58\x84\xd0\x71\x72\x92\x1a\x20\xcf\x98\x8c\x45\x84\xb8\x36\x4f\x64\x80\x9b\xbc\x2b\xd9\xf3\x1f\xc4\xe3\xee\xc3\x95\x21\xaf\x19\xac\x7c\x88\x30\xb5\x62\x26\x74\xe3\xd5\x48\x9a\xf6\x7a\x65\x9e\x14\x09\x0d\xd1\x8f\x10\xe2\x1d\x17\xb4\x2a\xf0\xa4\xd4\x37\x08\x33\xf9\x83\x55\xe4\xc9\xc9\x0c\x4c\x05\x6e\x7b\xf4\x1b\x9e\x44\x71\xce\xb3\x66\xf5\x59\x4e\x4b\x1f\x10\x09\xb4\x62\xf5\x98\x57\xcc\x7c\x9f\x59\xc9\x87\xab\x65\x7f\xea\x8f\xa6\x7d\x61\xe3\x34\xbf\x4b\x55\xf5\x9f\xfa\x4e\x46\x02\xdb\x9b\xe8\x09\x67\x10\xa6\xac\xea\x84\x3d\xde\x69\x74\x8c\x18\xc9\x09\xeb\x3c\x57\x01\xfe\x71\x37\x5a\x62\x2a\x06\x76\x00\xc6\xa2\x48\x30\x13\xe2\x66\xfb\xc3\x21\xad\x82\x10\xd4\x22\xc4\xfd\x75\xe5\x6e\x9a\xc7\xb7\xc0\x24\x79\x09\x5d\xe0\x20\x9a\xdf\x34\x34\x79\xc6\x0e\xa3\xf6\x3a\x8e\xd2\x08\xce\xde\xb9\x14\xc7\x5e\xb8\xcb\x65\x56\x19\x11\x4d\xcf\x85\x67\x29\xc3\xbc\xe8\x37\xe3\x20\xef\x1e\x7b\xf4\x53\x76\x33\xad\x30\x37\x27\xa1\xb0\x17\xcd\xd8\x9f\xe8\x52\xcc\x77\x22\x0b\x9c\xdd\xc9\x5d\xb0\x47\xc7\x57\xb6\x34\x40\xe1\x0b\x6e\x7f\xff\xd9\xb3\x7e\x76\x8f\x9d\x7e\x27\xd7\x7a\x42\xe7\xd3\x67\xc8\xb0\x75\x61\xae\x77\x79\x89\x6a\xdf\xa1\xaa\x52\x6d\xdf\xa2\x67\x16\x3d\xbb\x68\x99\xd7\x34\x02\x90\xb3\x3b\x79\xa5\x06\x8f\x76\x12\x5a\x12\x5b\xe3\x11\x79\xa3\x3f\x8c\x32\x4b\x27\xa3\xa7\x9a\x1b\xc7\xaf\xf4\x6f\x98\x08\xe8\x5f\xc1\xef\x2a\xf3\xf5\x93\x0c\x52\xcf\xc4\x1d\xe5\xde\x52\x3a\xb4\x8b\x82\x40\x03\x1c\x5a\x5c\xf0\x30\x78\x04\xd6\x0a\x7e\x76\xf8\x7e\x01\xf3\x30\x31\xa7\xc3\x99\xa9\x33\xe7\x4c\x31\x1d\xba\x00\xb7\xf5\xf2\xd5\xeb\x7f\x79\xf9\xe3\xf1\xbb\x3c\xdb\x03\xe3\x2c\x02\xe8\x0a\xba\x66\x92\x11\xc9\x0e\xdc\xd0\x8b\x12\x5a\x37\xa3\x51\xbb\x5c\x0e\x35\x10\xf1\xe9\x87\x7c\xd6\x90\x2d\xd8\x34\x04\xf5\x9a\x60\xbf\x2e\x72\xd9\xd4\xb0\xf4\x50\x77\x0c\xaa\x1f\xa5\xec\xe8\x50\x22\x32\xc9\x11\xf9\xd4\xa9\xfe\x05\x7d\x7d\x28\xab\xec\x2c\xaf\xeb\xc2\xe2\x0a\xa2\x80\x91\x35\xe4\x9f\x36\x92\x1b\x83\x60\x8c\x3e\x9a\x1a\x19\x28\x4b\x95\x7e\xa0\x29\x1d\xa9\xeb\xc0\x41\x40\xb0\xbe\x2d\x44\x4a\x82\x09\x9e\xc2\xe0\x17\xd2\xa6\x21\x8d\x6a\x60\xf1\x2b\x93\xa5\x1c\x51\x3f\x33\xa5\xf7\x47\x7d\x5d\x0e\x91\x86\x5e\xe8\x84\x67\x9a\x4e\x80\xfd\x5d\xfa\xa1\x45\xf7\x9a\x95\xaa\xa4\x1b\xca\xf5\x0f\x06\x26\xc7\x17\x72\xdd\x8f\xdd\x72\xbc\xdb\xbd\x7a\x19\xb9\x2b\x70\x4f\xea\x3b\x76\x7f\xc6\x11\xc8\xe8\x1e\x7b\x0d\xb7\x1f\xc3\xb6\xc4\x38\x1e\xa2\x14\x86\xdd\xb0\xdd\x70\xcf\x36\x2b\x52\xba\x08\x2a\x3b\x10\x25\xd1\xd0\xcb\x75\x5b\x37\x79\xc0\x46\x23\x18\x81\x03\x6c\xd3\xc6\x55\x47\xa4\x8f\x85\x38\x66\xbf\x54\x84\x1f\xec\x30\x34\x72\x78\x46\x87\x98\x5e\xf9\x44\xd3\xe7\x7c\x0a\xdb\xf0\x49\xd2\xdc\x0f\x3b\xbf\xce\xf9\xa4\x5b\xe3\xbe\x01\xa7\x5d\xf6\x6f\x4d\x9a\x6a\x5e\xef\x0a\xaf\x51\xc7\x1e\x53\x57\x32\x83\x9a\x99\x5a\x94\x06\x68\xb9\x21\x4d\x88\xad\x5a\x18\x76\x84\xc3\x66\x84\x70\x29\xf8\x8f\xd7\x67\x6f\xde\x55\x9e\xe7\x17\x56\x1c\x43\x21\x33\x81\x83\xea\xd9\x80\xb5\xf3\xeb\xcf\xe5\x25\xc3\x13\x03\x7e\x26\xba\x24\xcf\xbf\x84\x79\x32\xb8\x18\xd9\xef\x3e\xf8\xa9\xec\xb9\xed\xae\x3c\xed\xd4\x65\xaf\x07\xc5\x58\x0d\x86\xf4\x51\xf6\x37\xc7\x6b\x46\x0f\xda\xc3\x0a\x24\x00\xfa\x6a\x5c\xb2\x19\x6d\xf2\xc3\x3f\x82\x38\x0a\x21\x58\x85\x49\x28\x3c\x35\xee\xd6\xeb\x28\x4e\xa1\xd8\xbe\x5b\xfa\x36\x36\x58\x5c\xbd\xa3\xa8\x0b\x4d\xf4\x27\x2b\x0e\x49\x5b\xff\x2e\xcc\xb0\xf4\xb8\x43\x65\x38\xda\x4e\x55\x8a\x1c\x2a\x1d\xe9\x19\x12\x4e\xc7\xdb\x75\x1a\x21\x61\x7f\xbc\xa5\x8f\x24\xfe\x3c\xa4\xd8\x7f\x7f\x17\xc4\xfe\xa0\x9f\x3d\xe9\x0f\x31\x12\x0b\x37\x5d\x5c\xd3\xc0\x1f\xd2\x47\xcd\xea\x9c\x4c\xe8\xc7\x38\xfa\x90\xf8\xb1\xb6\x8f\x75\x6c\xaf\x96\xd1\xdc\x5d\xda\x39\x8e\x66\x7c\x8b\xdd\xf1\x30\x2c\x65\x8a\x7d\xb0\x3e\x7e\x95\x61\xf8\xd5\xc1\xfe\x77\x0f\x17\xd6\x57\x5e\xec\xaf\xbf\x3a\x38\x97\xd3\x3a\x10\x1d\xb1\xb2\xa1\xb1\xc4\xd0\x0c\x0b\x6c\x40\xea\x7f\xce\xd2\x24\xe4\x9d\xb6\x27\xcb\x60\x3e\x01\x28\xce\x39\x07\x77\x9d\x28\x4c\x09\xa9\xd1\xe2\xc0\xf3\x05\xe6\xe0\x00\x03\x57\x4d\x5e\x8f\x75\x29\xa6\xf1\x43\x10\x7a\xd1\x07\x4e\x30\x57\x99\xc7\x52\x01\x3b\x6b\xba\x5c\xaa\x3a\xb5\xa5\xa2\x84\x1a\xb3\x9e\x86\x4c\xd4\x66\x3f\x2b\x99\x0f\x54\xd1\xab\xaf\x0e\xa6\x0f\x17\x0f\xd6\xc7\x07\xeb\x3c\x1b\xb0\x8b\x61\x6f\x32\xf9\x5f\x94\x44\x77\xf1\xc2\xff\xd5\x5d\xaf\x83\xf0\xea\xb7\x37\xaf\x0e\xf1\xd2\xbe\x49\xec\x95\xbb\xee\xfd\xbf\x01\x00\x64\x17\x67\x6a\x3d\xa9\x07\x00")
 
 func drepJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -1755,8 +1755,8 @@ require=(function(){function r(e,n,t){function o(i,f){if(!n[i]){if(!e[i]){var c=
             var getBalance = new Method({
                 name: 'getBalance',
                 call: 'chain_getBalance',
-                params: 1,
-                inputFormatter: [formatters.inputAddressFormatter]
+                params: 2,
+                inputFormatter: [formatters.inputAddressFormatter, formatters.inputDefaultBlockNumberFormatter]
             });
 
             var getBlock = new Method({
@@ -1776,8 +1776,8 @@ require=(function(){function r(e,n,t){function o(i,f){if(!n[i]){if(!e[i]){var c=
             var getNonce = new Method({
                 name: 'getNonce',
                 call: 'chain_getNonce',
-                params: 1,
-                inputFormatter: [formatters.inputAddressFormatter]
+                params: 2,
+                inputFormatter: [formatters.inputAddressFormatter, formatters.inputDefaultBlockNumberFormatter]
             });
 
             var getPreviousBlockHash = new Method({
@@ -1790,8 +1790,8 @@ require=(function(){function r(e,n,t){function o(i,f){if(!n[i]){if(!e[i]){var c=
             var getReputation = new Method({
                 name: 'getReputation',
                 call: 'chain_getReputation',
-                params: 1,
-                inputFormatter: [formatters.inputAddressFormatter]
+                params: 2,
+                inputFormatter: [formatters.inputAddressFormatter, formatters.inputDefaultBlockNumberFormatter]
             });
 
             var getTransactionByBlockHeightAndIndex = new Method({
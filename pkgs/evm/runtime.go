@@ -81,7 +81,7 @@ func (evmService *EvmService) Call(database store.StoreInterface, tx *types.Tran
 	// about the transaction and calling mechanisms.
 	vmenv := vm.NewEVM(context, state, evmService.Config)
 
-	ret, _, vmerr := vmenv.Call(*sender, *tx.To(), vmenv.ChainId, tx.Data.Data, tx.Gas(), tx.Amount())
+	ret, _, vmerr := vmenv.Call(*sender, *tx.To(), vmenv.ChainId, tx.GetData(), tx.Gas(), tx.Amount())
 	if vmerr != nil {
 		dlog.Debug("call VM returned with error", "err", vmerr)
 		return nil, vmerr
@@ -89,10 +89,13 @@ func (evmService *EvmService) Call(database store.StoreInterface, tx *types.Tran
 	return ret, nil
 }
 
-func (evmService *EvmService) Eval(state vm.VMState, tx *types.Transaction, header *types.BlockHeader, gas uint64, value *big.Int) (ret []byte, gasUsed uint64, contractAddr crypto.CommonAddress, failed bool, err error) {
+// Eval run the contract creation or call of tx, vmerr report the failure of contract execution
+// which still consume gas, while err means the tx can not be executed at all. leftOverGas is the
+// part of gas not consumed by the vm
+func (evmService *EvmService) Eval(state vm.VMState, tx *types.Transaction, header *types.BlockHeader, gas uint64, value *big.Int) (ret []byte, leftOverGas uint64, contractAddr crypto.CommonAddress, vmerr error, err error) {
 	sender, err := tx.From()
 	if err != nil {
-		return nil, uint64(0), crypto.CommonAddress{}, false, err
+		return nil, uint64(0), crypto.CommonAddress{}, nil, err
 	}
 	contractCreation := tx.To() == nil || tx.To().IsEmpty()
 
@@ -101,18 +104,15 @@ func (evmService *EvmService) Eval(state vm.VMState, tx *types.Transaction, head
 	// Create a new environment which holds all relevant information
 	// about the transaction and calling mechanisms.
 	vmenv := vm.NewEVM(context, state, evmService.Config)
-	var (
-		// vm errors do not effect consensus and are therefor
-		// not assigned to err, except for insufficient balance
-		// error.
-		vmerr error
-	)
+	// vm errors do not effect consensus and are therefor
+	// not assigned to err, except for insufficient balance
+	// error.
 	if contractCreation {
-		ret, contractAddr, gas, vmerr = vmenv.Create(*sender, tx.Data.Data, gas, value)
+		ret, contractAddr, gas, vmerr = vmenv.Create(*sender, tx.GetData(), gas, value)
 	} else {
 		// Increment the nonce for the next transaction
 		state.SetNonce(sender, state.GetNonce(sender)+1)
-		ret, gas, vmerr = vmenv.Call(*sender, *tx.To(), vmenv.ChainId, tx.Data.Data, gas, value)
+		ret, gas, vmerr = vmenv.Call(*sender, *tx.To(), vmenv.ChainId, tx.GetData(), gas, value)
 	}
 	if vmerr != nil {
 		dlog.Debug("VM returned with error", "err", vmerr)
@@ -120,11 +120,11 @@ func (evmService *EvmService) Eval(state vm.VMState, tx *types.Transaction, head
 		// sufficient balance to make the transfer happen. The first
 		// balance transfer may never fail.
 		if vmerr == vm.ErrInsufficientBalance {
-			return nil, uint64(0), crypto.CommonAddress{}, false, vmerr
+			return nil, uint64(0), crypto.CommonAddress{}, nil, vmerr
 		}
 	}
 
-	return ret, gas, contractAddr, vmerr != nil, err
+	return ret, gas, contractAddr, vmerr, err
 }
 
 func (evmService *EvmService) DefaultConfig() *vm.VMConfig {
@@ -2,6 +2,7 @@ package evm
 
 import (
 	"github.com/drep-project/DREP-Chain/chain"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/pkgs/evm/vm"
 	"github.com/drep-project/DREP-Chain/types"
 )
@@ -28,14 +29,20 @@ type EvmDeployTransactionExecutor struct {
 func (vmDeployTransactionExecutor *EvmDeployTransactionExecutor) ExecuteTransaction(context *chain.ExecuteTransactionContext) *types.ExecuteTransactionResult {
 	state := vm.NewState(context.TrieStore(), context.Header().Height)
 
-	ret, gas, addr, failed, err := vmDeployTransactionExecutor.vm.Eval(
+	gas := context.GasRemained()
+	ret, leftOverGas, addr, vmerr, err := vmDeployTransactionExecutor.vm.Eval(
 		state,
 		context.Tx(),
 		context.Header(),
 		//vmDeployTransactionExecutor.vm.Chain,
-		context.GasRemained(),
+		gas,
 		context.Value())
-	context.UseGas(gas)
+	//分叉前按剩余的gas收费，执行越省gas的交易反而付费越多；分叉后按实际消耗的gas收费
+	if params.IsEvmGasUsedFork(context.Header().Height) {
+		context.UseGas(gas - leftOverGas)
+	} else {
+		context.UseGas(leftOverGas)
+	}
 
 	refund := context.GasUsed() / 2
 	if refund > state.GetRefund() {
@@ -48,7 +55,29 @@ func (vmDeployTransactionExecutor *EvmDeployTransactionExecutor) ExecuteTransact
 		log.TxType = context.Tx().Type()
 	}
 
-	return &types.ExecuteTransactionResult{TxResult: ret, ContractTxExecuteFail: failed, ContractTxLog: logs, Txerror: err, ContractAddr: addr}
+	return &types.ExecuteTransactionResult{
+		TxResult:              ret,
+		ContractTxExecuteFail: vmerr != nil,
+		ContractTxStatus:      receiptStatus(vmerr),
+		ContractTxErr:         vmerr,
+		ContractTxLog:         logs,
+		Txerror:               err,
+		ContractAddr:          addr,
+	}
+}
+
+// receiptStatus classify the vm error of a contract execution into receipt status
+func receiptStatus(vmerr error) uint64 {
+	switch vmerr {
+	case nil:
+		return types.ReceiptStatusSuccessful
+	case vm.ErrExecutionReverted:
+		return types.ReceiptStatusReverted
+	case vm.ErrOutOfGas, vm.ErrCodeStoreOutOfGas:
+		return types.ReceiptStatusOutOfGas
+	default:
+		return types.ReceiptStatusInvalid
+	}
 }
 
 // ***********CALL**************//
@@ -103,7 +103,14 @@ func gasSStore(evm *EVM, contract *Contract, stack *Stack, mem *Memory, memorySi
 	var (
 		y, x = stack.Back(1), stack.Back(0)
 	)
-	val, err := evm.State.Load(x)
+	var val []byte
+	var err error
+	if params.IsContractStorageFork(evm.BlockNumber.Uint64()) {
+		val, err = evm.State.GetState(&contract.ContractAddr, x)
+	} else {
+		//升级前计算gas时读取的是没有按合约地址区分的slot，保留以便重放历史区块
+		val, err = evm.State.Load(x)
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -627,19 +627,7 @@ func opMstore8(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memo
 
 func opSload(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
 	loc := stack.peek()
-
-	//fmt.Println("opSload -1:",loc.Text(16))
-	//fmt.Println("opSload -1:",contract.ContractAddr.String())
-	modifiedLoc := new(big.Int).SetBytes(sha3.HashS256(contract.ContractAddr.Bytes(), loc.Bytes()))
-	//	//b, err := interpreter.EVM.State.Load(modifiedLoc)
-	//	//if err != nil {
-	//	//	return nil, err
-	//	//}
-	//	//loc.SetBytes(b)
-	//val := interpreter.evm.StateDB.GetState(contract.Address(), common.BigToHash(loc))
-	//loc.SetBytes(val.Bytes())
-
-	b, err := interpreter.EVM.State.Load(modifiedLoc) //GetState(contract.Address(), common.BigToHash(loc))
+	b, err := interpreter.EVM.State.GetState(&contract.ContractAddr, loc)
 	if err != nil {
 		return nil, err
 	}
@@ -650,14 +638,8 @@ func opSload(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory
 
 func opSstore(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
 	loc, val := stack.pop(), stack.pop()
-	modifiedLoc := new(big.Int).SetBytes(sha3.HashS256(contract.ContractAddr.Bytes(), loc.Bytes()))
-	interpreter.EVM.State.Store(modifiedLoc, val)
-	//fmt.Println("opSstore -1:",loc.Text(16))
-	//fmt.Println("opSstore -1:",contract.ContractAddr.String())
-	//fmt.Println("opSstore 0:",modifiedLoc.Text(16))
-	//fmt.Println("opSstore 1:", val.Text(16))
-	//interpreter.EVM.State.Store(loc, val)
-	interpreter.IntPool.put(val)
+	interpreter.EVM.State.SetState(&contract.ContractAddr, loc, val)
+	interpreter.IntPool.put(loc, val)
 	return nil, nil
 }
 
@@ -742,7 +724,7 @@ func opCreate(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memor
 	contract.Gas += returnGas
 	interpreter.IntPool.put(value, offset, size)
 
-	if suberr == errExecutionReverted {
+	if suberr == ErrExecutionReverted {
 		//interpreter.EVM.State.dt.Discard()
 		return res, nil
 	}
@@ -771,7 +753,7 @@ func opCreate2(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memo
 	//contract.Gas += returnGas
 	//interpreter.IntPool.put(endowment, offset, size, salt)
 	//
-	//if suberr == errExecutionReverted {
+	//if suberr == ErrExecutionReverted {
 	//	return res, nil
 	//}
 	//return nil, nil
@@ -799,7 +781,7 @@ func opCall(pc *uint64, interpreter *EVMInterpreter, contract *Contract, memory
 	} else {
 		stack.push(interpreter.IntPool.get().SetUint64(1))
 	}
-	if err == nil || err == errExecutionReverted {
+	if err == nil || err == ErrExecutionReverted {
 		memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
 		//interpreter.EVM.State.dt.Discard()
 	}
@@ -830,7 +812,7 @@ func opCallCode(pc *uint64, interpreter *EVMInterpreter, contract *Contract, mem
 	} else {
 		stack.push(interpreter.IntPool.get().SetUint64(1))
 	}
-	if err == nil || err == errExecutionReverted {
+	if err == nil || err == ErrExecutionReverted {
 		memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
 		//interpreter.EVM.State.dt.Discard()
 	}
@@ -856,7 +838,7 @@ func opDelegateCall(pc *uint64, interpreter *EVMInterpreter, contract *Contract,
 	} else {
 		stack.push(interpreter.IntPool.get().SetUint64(1))
 	}
-	if err == nil || err == errExecutionReverted {
+	if err == nil || err == ErrExecutionReverted {
 		memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
 		//interpreter.EVM.State.dt.Discard()
 	}
@@ -883,7 +865,7 @@ func opStaticCall(pc *uint64, interpreter *EVMInterpreter, contract *Contract, m
 	} else {
 		stack.push(interpreter.IntPool.get().SetUint64(1))
 	}
-	if err == nil || err == errExecutionReverted {
+	if err == nil || err == ErrExecutionReverted {
 		memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
 		//interpreter.EVM.State.dt.Discard()
 	}
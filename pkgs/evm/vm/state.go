@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/DREP-Chain/types"
 )
 
@@ -33,7 +34,8 @@ type VMState interface {
 	SubRefund(gas uint64)
 	GetRefund() uint64
 	Load(x *big.Int) ([]byte, error)
-	Store(x, y *big.Int) error
+	GetState(addr *crypto.CommonAddress, key *big.Int) ([]byte, error)
+	SetState(addr *crypto.CommonAddress, key, value *big.Int) error
 	Exist(contractAddr crypto.CommonAddress) bool
 	Empty(addr *crypto.CommonAddress) bool
 	HasSuicided(addr crypto.CommonAddress) bool
@@ -152,6 +154,8 @@ func (self *State) GetRefund() uint64 {
 	return self.refund
 }
 
+// Load read the state trie with x as key, before ContractStorageForkHeight the slots of contracts
+// were stored in the state trie directly
 func (s *State) Load(x *big.Int) ([]byte, error) {
 	val, _ := s.db.Get(x.Bytes())
 	return val, nil
@@ -161,6 +165,21 @@ func (s *State) Store(x, y *big.Int) error {
 	return s.db.Put(x.Bytes(), y.Bytes())
 }
 
+func (s *State) GetState(addr *crypto.CommonAddress, key *big.Int) ([]byte, error) {
+	if !params.IsContractStorageFork(s.height) {
+		return s.Load(new(big.Int).SetBytes(store.LegacyContractStateKey(addr, key.Bytes())))
+	}
+	val, _ := s.db.GetContractState(addr, key.Bytes())
+	return val, nil
+}
+
+func (s *State) SetState(addr *crypto.CommonAddress, key, value *big.Int) error {
+	if !params.IsContractStorageFork(s.height) {
+		return s.Store(new(big.Int).SetBytes(store.LegacyContractStateKey(addr, key.Bytes())), value)
+	}
+	return s.db.PutContractState(addr, key.Bytes(), value.Bytes())
+}
+
 func (s *State) Exist(contractAddr crypto.CommonAddress) bool {
 	return len(s.db.GetByteCode(&contractAddr)) > 0
 }
@@ -15,6 +15,7 @@ type GetProducer func(root []byte, num int) ([]crypto.CommonAddress, error)
 type BlockAnalysis struct {
 	Config           HistoryConfig
 	getBlock         func(uint64) (*types.Block, error)
+	getReceipts      func(crypto.Hash) []*types.Receipt
 	eventNewBlockSub event.Subscription
 	newBlockChan     chan *types.ChainEvent
 
@@ -27,10 +28,11 @@ type BlockAnalysis struct {
 	readyToQuit     chan struct{}
 }
 
-func NewBlockAnalysis(config HistoryConfig, consensusService *service.ConsensusService, trieStore dbinterface.KeyValueStore, getBlock func(uint64) (*types.Block, error)) *BlockAnalysis {
+func NewBlockAnalysis(config HistoryConfig, consensusService *service.ConsensusService, trieStore dbinterface.KeyValueStore, getBlock func(uint64) (*types.Block, error), getReceipts func(crypto.Hash) []*types.Receipt) *BlockAnalysis {
 	blockAnalysis := &BlockAnalysis{}
 	blockAnalysis.Config = config
 	blockAnalysis.getBlock = getBlock
+	blockAnalysis.getReceipts = getReceipts
 	blockAnalysis.trieStore = trieStore
 	blockAnalysis.consensusService = consensusService
 	blockAnalysis.newBlockChan = make(chan *types.ChainEvent, 1000)
@@ -92,7 +94,7 @@ func (blockAnalysis *BlockAnalysis) process() error {
 	for {
 		select {
 		case block := <-blockAnalysis.newBlockChan:
-			blockAnalysis.store.InsertRecord(block.Block)
+			blockAnalysis.store.InsertRecord(block.Block, block.Receipts)
 		case block := <-blockAnalysis.detachBlockChan:
 			blockAnalysis.store.DelRecord(block)
 		default:
@@ -141,7 +143,7 @@ func (blockAnalysis *BlockAnalysis) Rebuild(from, end int) error {
 		if exist {
 			blockAnalysis.store.DelRecord(block)
 		}
-		blockAnalysis.store.InsertRecord(block)
+		blockAnalysis.store.InsertRecord(block, blockAnalysis.getReceipts(*block.Header.Hash()))
 	}
 	return nil
 }
@@ -14,12 +14,14 @@ const (
 	TX_PREFIX                 = "TX"
 	TX_SEND_HISTORY_PREFIX    = "SEND_TXHISTORY"
 	TX_RECEIVE_HISTORY_PREFIX = "RECEIVE_TXHISTORY"
+	TX_RESULT_PREFIX          = "TX_RESULT"
 )
 
 // LevelDbStore used to save data to level db, there are 3 kinds of prefix in db.
 // "TX" for transaction collection,   							format "TX" + hash
 // "SEND_TXHISTORY" for transaction group by sender addr,   	format "SEND_TXHISTORY" + addr + hash
 // "RECEIVE_TXHISTORY" for transaction group by receive addr	format "RECEIVE_TXHISTORY" + addr + hash
+// "TX_RESULT" for execution result of transaction				format "TX_RESULT" + hash
 type LevelDbStore struct {
 	getProducer   GetProducer
 	path          string
@@ -53,8 +55,8 @@ func (store *LevelDbStore) ExistRecord(block *types.Block) (bool, error) {
 }
 
 // InsertRecord check block ,if tx exist, save to to history and send history , if to is not nil, save tx receive history
-func (store *LevelDbStore) InsertRecord(block *types.Block) {
-	for _, tx := range block.Data.TxList {
+func (store *LevelDbStore) InsertRecord(block *types.Block, receipts []*types.Receipt) {
+	for index, tx := range block.Data.TxList {
 		rawdata := tx.AsPersistentMessage()
 		txHash := tx.TxHash()
 		key := store.txKey(txHash)
@@ -79,6 +81,14 @@ func (store *LevelDbStore) InsertRecord(block *types.Block) {
 				return
 			}
 		}
+
+		if index < len(receipts) && receipts[index] != nil {
+			result, _ := binary.Marshal(new(RpcTxResult).FromReceipt(receipts[index]))
+			err = store.db.Put(store.txResultKey(txHash), result, nil)
+			if err != nil {
+				return
+			}
+		}
 	}
 }
 
@@ -96,6 +106,7 @@ func (store *LevelDbStore) DelRecord(block *types.Block) {
 			receiveHistoryKey := store.txReceiveHistoryKey(to, txHash)
 			store.db.Delete(receiveHistoryKey, nil)
 		}
+		store.db.Delete(store.txResultKey(txHash), nil)
 	}
 }
 
@@ -120,6 +131,10 @@ func (store *LevelDbStore) GetTransaction(txHash *crypto.Hash) (*RpcTransaction,
 	}
 	rpcTx := &RpcTransaction{}
 	rpcTx.FromTx(tx)
+	result, err := store.db.Get(store.txResultKey(txHash), nil)
+	if err == nil {
+		binary.Unmarshal(result, &rpcTx.RpcTxResult)
+	}
 	return rpcTx, nil
 }
 
@@ -208,6 +223,13 @@ func (store *LevelDbStore) txKey(hash *crypto.Hash) []byte {
 	return buf[:]
 }
 
+func (store *LevelDbStore) txResultKey(hash *crypto.Hash) []byte {
+	buf := [41]byte{} //9+32
+	copy(buf[:9], []byte(TX_RESULT_PREFIX)[:9])
+	copy(buf[9:], hash[:])
+	return buf[:]
+}
+
 func (store *LevelDbStore) txSendHistoryKey(addr *crypto.CommonAddress, hash *crypto.Hash) []byte {
 	buf := [66]byte{}
 	copy(buf[:14], []byte(TX_SEND_HISTORY_PREFIX)[:14])
@@ -31,7 +31,7 @@ func makeData(path string) (*LevelDbStore, []*types.Block) {
 	for i := 1; i < 10; i++ {
 		block := randomBlock()
 		testData = append(testData, block)
-		levelDbStore.InsertRecord(block)
+		levelDbStore.InsertRecord(block, nil)
 	}
 	return levelDbStore, testData
 }
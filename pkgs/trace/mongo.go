@@ -61,7 +61,7 @@ func NewMongoDbStore(url string, getProducer GetProducer, consensusMode string,
 	return store, nil
 }
 
-func (store *MongogDbStore) InsertRecord(block *types.Block) {
+func (store *MongogDbStore) InsertRecord(block *types.Block, receipts []*types.Receipt) {
 	ctx, _ := context.WithTimeout(context.Background(), 5*time.Second)
 	rpcTxs := make([]interface{}, block.Data.TxCount)
 	rpcHeader := RpcBlockHeader{}
@@ -106,6 +106,11 @@ func (store *MongogDbStore) InsertRecord(block *types.Block) {
 		viewTx.FromTx(tx)
 		viewTx.Height = block.Header.Height
 		viewTxs[index] = viewTx
+
+		if index < len(receipts) && receipts[index] != nil {
+			rpcTx.RpcTxResult.FromReceipt(receipts[index])
+			viewTx.FromReceipt(receipts[index])
+		}
 	}
 	store.txCol.InsertMany(ctx, rpcTxs, nil)
 	store.viewTxCol.InsertMany(ctx, viewTxs, nil)
@@ -170,6 +175,7 @@ func (store *MongogDbStore) GetTransaction(txHash *crypto.Hash) (*RpcTransaction
 	if err != nil {
 		return nil, err
 	}
+	rpcTx.Payload, _ = rpcTx.ToTx().DecodePayload()
 	return rpcTx, nil
 }
 
@@ -191,6 +197,9 @@ func (store *MongogDbStore) GetSendTransactionsByAddr(addr *crypto.CommonAddress
 	if err != nil {
 		return rpcTx
 	}
+	for _, tx := range rpcTx {
+		tx.Payload, _ = tx.ToTx().DecodePayload()
+	}
 	return rpcTx
 }
 
@@ -212,6 +221,9 @@ func (store *MongogDbStore) GetReceiveTransactionsByAddr(addr *crypto.CommonAddr
 	if err != nil {
 		return rpcTx
 	}
+	for _, tx := range rpcTx {
+		tx.Payload, _ = tx.ToTx().DecodePayload()
+	}
 	return rpcTx
 }
 
@@ -22,7 +22,7 @@ func makeMongoData(dbName string) (*MongogDbStore, []*types.Block) {
 	for i := 1; i < 10; i++ {
 		block := randomBlock()
 		testData = append(testData, block)
-		mongoStore.InsertRecord(block)
+		mongoStore.InsertRecord(block, nil)
 	}
 	return mongoStore, testData
 }
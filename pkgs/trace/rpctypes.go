@@ -16,6 +16,16 @@ type RpcTransaction struct {
 	From                  crypto.CommonAddress
 	types.TransactionData `bson:",inline"`
 	Sig                   common.Bytes
+	Payload               types.TxPayload `bson:"-"`
+	RpcTxResult           `bson:",inline"`
+}
+
+// RpcTxResult the execution result of transaction taken from its receipt
+type RpcTxResult struct {
+	Status       uint64 //see types.ReceiptStatusSuccessful etc.
+	GasUsed      uint64
+	RevertReason common.Bytes
+	FailReason   string
 }
 
 type RpcBlock struct {
@@ -39,9 +49,18 @@ func (rpcTransaction *RpcTransaction) FromTx(tx *types.Transaction) *RpcTransact
 	rpcTransaction.TransactionData = tx.Data
 	rpcTransaction.From = *from
 	rpcTransaction.Sig = common.Bytes(tx.Sig)
+	rpcTransaction.Payload, _ = tx.DecodePayload()
 	return rpcTransaction
 }
 
+func (rpcTxResult *RpcTxResult) FromReceipt(receipt *types.Receipt) *RpcTxResult {
+	rpcTxResult.Status = receipt.Status
+	rpcTxResult.GasUsed = receipt.GasUsed
+	rpcTxResult.RevertReason = common.Bytes(receipt.RevertReason)
+	rpcTxResult.FailReason = receipt.FailReason
+	return rpcTxResult
+}
+
 func (rpcTx *RpcTransaction) ToTx() *types.Transaction {
 	tx := &types.Transaction{}
 	tx.Data = rpcTx.TransactionData
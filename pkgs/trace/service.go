@@ -72,7 +72,8 @@ func (traceService *TraceService) Init(executeContext *app.ExecuteContext) error
 	if !traceService.Config.Enable {
 		return nil
 	}
-	traceService.blockAnalysis = NewBlockAnalysis(*traceService.Config, traceService.ConsensusService, traceService.DatabaseService.LevelDb(), traceService.ChainService.GetBlockByHeight)
+	chainStore := &chainService.ChainStore{KeyValueStore: traceService.DatabaseService.LevelDb()}
+	traceService.blockAnalysis = NewBlockAnalysis(*traceService.Config, traceService.ConsensusService, traceService.DatabaseService.LevelDb(), traceService.ChainService.GetBlockByHeight, chainStore.GetReceipts)
 
 	traceService.apis = []app.API{
 		app.API{
@@ -9,7 +9,7 @@ import (
 type IStore interface {
 	ExistRecord(block *types.Block) (bool, error)
 
-	InsertRecord(block *types.Block)
+	InsertRecord(block *types.Block, receipts []*types.Receipt)
 
 	DelRecord(block *types.Block)
 
@@ -28,6 +28,11 @@ type ViewTransaction struct {
 	Data      string //hex
 	Sig       string
 	Height    uint64
+
+	Status       uint64 //see types.ReceiptStatusSuccessful etc.
+	GasUsed      uint64
+	RevertReason string //hex
+	FailReason   string
 }
 
 type ViewBlock struct {
@@ -104,6 +109,16 @@ func (rpcTransaction *ViewTransaction) FromTx(tx *types.Transaction) *ViewTransa
 	return rpcTransaction
 }
 
+func (rpcTransaction *ViewTransaction) FromReceipt(receipt *types.Receipt) *ViewTransaction {
+	rpcTransaction.Status = receipt.Status
+	rpcTransaction.GasUsed = receipt.GasUsed
+	if receipt.RevertReason != nil {
+		rpcTransaction.RevertReason = common.Encode(receipt.RevertReason)
+	}
+	rpcTransaction.FailReason = receipt.FailReason
+	return rpcTransaction
+}
+
 func (rpcBlock *ViewBlock) From(block *types.Block, addresses []crypto.CommonAddress) *ViewBlock {
 	txs := make([]*ViewTransaction, len(block.Data.TxList))
 	for i, tx := range block.Data.TxList {
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/binary"
+)
+
+type AliasAction uint8
+
+const (
+	AliasSetAction      AliasAction = iota //永久设置别名
+	AliasTransferAction                    //别名转给其他地址
+	AliasReleaseAction                     //持有人主动释放别名
+	AliasLeaseAction                       //租用别名
+	AliasRenewAction                       //续租别名
+	AliasExpireAction                      //租期到期被释放
+)
+
+//租用别名交易的数据部分，别名未被设置时租用，已经租用时续租
+type AliasLeaseData struct {
+	Alias  string
+	Period uint64 //租用的高度数
+}
+
+func (ld AliasLeaseData) check() error {
+	if ld.Alias == "" {
+		return ErrEmptyAlias
+	}
+	if ld.Period == 0 || ld.Period > MaxAliasLeasePeriod {
+		return ErrAliasLeasePeriod
+	}
+	return nil
+}
+
+func (ld *AliasLeaseData) Marshal() ([]byte, error) {
+	err := ld.check()
+	if err != nil {
+		return nil, err
+	}
+	return binary.Marshal(ld)
+}
+
+func (ld *AliasLeaseData) Unmarshal(data []byte) error {
+	err := binary.Unmarshal(data, ld)
+	if err != nil {
+		return err
+	}
+	return ld.check()
+}
+
+//租用中的别名，超过ExpireHeight后被释放
+type AliasLease struct {
+	Alias        string
+	Owner        crypto.CommonAddress
+	ExpireHeight uint64
+}
+
+// Expired report whether the lease has ended before the block of given height
+func (lease *AliasLease) Expired(height uint64) bool {
+	return height > lease.ExpireHeight
+}
+
+//别名的一条变更记录
+type AliasRecord struct {
+	Alias  string
+	Owner  crypto.CommonAddress
+	Action AliasAction
+	Height uint64
+	TxHash crypto.Hash //租期到期释放时为空
+}
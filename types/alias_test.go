@@ -0,0 +1,41 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestLeaseAliasPayload(t *testing.T) {
+	tx, err := NewLeaseAliasTransaction("tom123", 100, big.NewInt(1), big.NewInt(30000), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := payload.(*AliasLeaseData)
+	if data.Alias != "tom123" || data.Period != 100 {
+		t.Fatalf("lease data mismatch %v", data)
+	}
+
+	if _, err := NewLeaseAliasTransaction("", 100, big.NewInt(1), big.NewInt(30000), 0); err != ErrEmptyAlias {
+		t.Fatalf("expect ErrEmptyAlias, got %v", err)
+	}
+	if _, err := NewLeaseAliasTransaction("tom123", 0, big.NewInt(1), big.NewInt(30000), 0); err != ErrAliasLeasePeriod {
+		t.Fatalf("expect ErrAliasLeasePeriod, got %v", err)
+	}
+	if _, err := NewLeaseAliasTransaction("tom123", MaxAliasLeasePeriod+1, big.NewInt(1), big.NewInt(30000), 0); err != ErrAliasLeasePeriod {
+		t.Fatalf("expect ErrAliasLeasePeriod, got %v", err)
+	}
+}
+
+func TestAliasLeaseExpired(t *testing.T) {
+	lease := &AliasLease{Alias: "tom123", ExpireHeight: 100}
+	if lease.Expired(100) {
+		t.Fatal("lease should be valid at expire height")
+	}
+	if !lease.Expired(101) {
+		t.Fatal("lease should expire after expire height")
+	}
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/binary"
+	"math/big"
+)
+
+const (
+	MaxBatchOperations = 16 //一个批量交易中最多包含的操作数
+)
+
+var (
+	//可以放在批量交易中执行的交易类型，其他类型在单独交易中才做的检查在子交易中不会执行
+	batchOperationTypes = map[TxType]struct{}{
+		TransferType:     {},
+		VoteCreditType:   {},
+		CallContractType: {},
+	}
+)
+
+//批量交易中的单个操作，执行时作为一个共享nonce和gas的子交易
+type BatchOperation struct {
+	Type   TxType
+	To     crypto.CommonAddress
+	Amount common.Big
+	Data   common.Bytes
+}
+
+//批量交易的数据部分，所有操作要么全部成功，要么全部不生效
+type BatchData struct {
+	Operations []BatchOperation
+}
+
+func (bd BatchData) check() error {
+	if len(bd.Operations) == 0 {
+		return ErrEmptyBatch
+	}
+	if len(bd.Operations) > MaxBatchOperations {
+		return ErrTooManyBatchOperations
+	}
+	for _, op := range bd.Operations {
+		if _, ok := batchOperationTypes[op.Type]; !ok {
+			return ErrUnsupportBatchOperation
+		}
+		if op.Amount.ToInt().Sign() < 0 {
+			return ErrUnsupportBatchOperation
+		}
+	}
+	return nil
+}
+
+func (bd *BatchData) Marshal() ([]byte, error) {
+	err := bd.check()
+	if err != nil {
+		return nil, err
+	}
+	return binary.Marshal(bd)
+}
+
+func (bd *BatchData) Unmarshal(data []byte) error {
+	err := binary.Unmarshal(data, bd)
+	if err != nil {
+		return err
+	}
+	return bd.check()
+}
+
+// SubTransaction build the transaction executed for the operation at index of batch tx
+func (bd *BatchData) SubTransaction(tx *Transaction, index int) *Transaction {
+	op := bd.Operations[index]
+	data := tx.Data
+	data.Type = op.Type
+	data.To = op.To
+	data.Amount = op.Amount
+	data.Data = op.Data
+	return &Transaction{Data: data, Sig: tx.Sig}
+}
+
+//批量交易中单个操作的执行结果，记录在receipt的log中
+type BatchOperationReceipt struct {
+	Index   int
+	Type    TxType
+	GasUsed uint64
+	Result  common.Bytes
+}
+
+func NewBatchOperation(txType TxType, to crypto.CommonAddress, amount *big.Int, data []byte) BatchOperation {
+	return BatchOperation{
+		Type:   txType,
+		To:     to,
+		Amount: *(*common.Big)(amount),
+		Data:   data,
+	}
+}
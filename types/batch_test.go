@@ -0,0 +1,62 @@
+package types
+
+import (
+	"crypto/rand"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"math/big"
+	"testing"
+)
+
+func TestBatchPayload(t *testing.T) {
+	p, _ := crypto.GenerateKey(rand.Reader)
+	to := crypto.PubkeyToAddress(p.PubKey())
+
+	operations := []BatchOperation{
+		NewBatchOperation(TransferType, to, big.NewInt(100), nil),
+		NewBatchOperation(VoteCreditType, to, big.NewInt(200), nil),
+	}
+	tx, err := NewBatchTransaction(operations, big.NewInt(1), big.NewInt(100000), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := payload.(*BatchData)
+	if len(batch.Operations) != len(operations) {
+		t.Fatalf("operation count mismatch, got %d, want %d", len(batch.Operations), len(operations))
+	}
+
+	subTx := batch.SubTransaction(tx, 1)
+	if subTx.Type() != VoteCreditType || subTx.Nonce() != tx.Nonce() || *subTx.To() != to || subTx.Amount().Cmp(big.NewInt(200)) != 0 {
+		t.Fatal("sub transaction not built from operation")
+	}
+}
+
+func TestBatchPayloadCheck(t *testing.T) {
+	if _, err := NewBatchTransaction(nil, big.NewInt(1), big.NewInt(100000), 0); err != ErrEmptyBatch {
+		t.Fatalf("expect %v, got %v", ErrEmptyBatch, err)
+	}
+
+	nested := []BatchOperation{NewBatchOperation(BatchType, crypto.CommonAddress{}, big.NewInt(0), nil)}
+	if _, err := NewBatchTransaction(nested, big.NewInt(1), big.NewInt(100000), 0); err != ErrUnsupportBatchOperation {
+		t.Fatalf("expect %v, got %v", ErrUnsupportBatchOperation, err)
+	}
+
+	for _, txType := range []TxType{CreateContractType, TimeLockType, EvidenceType, RedelegateType, RegisterMultiSigType, SetAliasType, LeaseAliasType} {
+		operations := []BatchOperation{NewBatchOperation(txType, crypto.CommonAddress{}, big.NewInt(0), nil)}
+		if _, err := NewBatchTransaction(operations, big.NewInt(1), big.NewInt(100000), 0); err != ErrUnsupportBatchOperation {
+			t.Fatalf("type %d: expect %v, got %v", txType, ErrUnsupportBatchOperation, err)
+		}
+	}
+
+	tooMany := make([]BatchOperation, MaxBatchOperations+1)
+	for i := range tooMany {
+		tooMany[i] = NewBatchOperation(TransferType, crypto.CommonAddress{}, big.NewInt(1), nil)
+	}
+	if _, err := NewBatchTransaction(tooMany, big.NewInt(1), big.NewInt(100000), 0); err != ErrTooManyBatchOperations {
+		t.Fatalf("expect %v, got %v", ErrTooManyBatchOperations, err)
+	}
+}
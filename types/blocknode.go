@@ -28,6 +28,10 @@ const (
 	// has failed validation, thus the block is also invalid.
 	StatusInvalidAncestor
 
+	// statusFinal indicates that the block or one of its descendants carries a
+	// proof of finality from consensus, the chain never reorganizes across it.
+	StatusFinal
+
 	// statusNone indicates that the block has no validation state flags set.
 	//
 	// NOTE: This must be defined last in order to avoid influencing iota.
@@ -55,6 +59,12 @@ func (status BlockStatus) KnownInvalid() bool {
 	return status&(StatusValidateFailed|StatusInvalidAncestor) != 0
 }
 
+// KnownFinal returns whether the block is known to be final. A block is final
+// once itself or any of its descendants in the main chain is proved final.
+func (status BlockStatus) KnownFinal() bool {
+	return status&StatusFinal != 0
+}
+
 type BlockNode struct {
 	// NOTE: Additions, deletions, or modifications to the order of the
 	// definitions in this struct should not be changed without considering
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/common/hexutil"
+	"github.com/drep-project/DREP-Chain/crypto"
+)
+
+// BlockNumberOrHash select a block in rpc params, either by height or by block hash.
+// The json form is a height (number, hex quantity, "latest", "pending" or "earliest")
+// or a 32 bytes hex block hash.
+type BlockNumberOrHash struct {
+	BlockNumber *common.BlockNumber
+	BlockHash   *crypto.Hash
+}
+
+func BlockNumberOrHashWithNumber(number common.BlockNumber) *BlockNumberOrHash {
+	return &BlockNumberOrHash{BlockNumber: &number}
+}
+
+func BlockNumberOrHashWithHash(hash crypto.Hash) *BlockNumberOrHash {
+	return &BlockNumberOrHash{BlockHash: &hash}
+}
+
+// Latest report whether the tip is selected
+func (bnh *BlockNumberOrHash) Latest() bool {
+	if bnh == nil {
+		return true
+	}
+	if bnh.BlockHash != nil {
+		return false
+	}
+	return bnh.BlockNumber == nil || *bnh.BlockNumber < 0
+}
+
+func (bnh *BlockNumberOrHash) UnmarshalJSON(data []byte) error {
+	input := strings.TrimSpace(string(data))
+	if len(input) == 0 || input[0] != '"' {
+		height, err := strconv.ParseUint(input, 10, 63)
+		if err != nil {
+			return ErrInvalidBlockSelector
+		}
+		number := common.BlockNumber(height)
+		bnh.BlockNumber = &number
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	if len(str) == 2+2*crypto.HashLength && strings.HasPrefix(str, "0x") {
+		bytes, err := hexutil.Decode(str)
+		if err != nil {
+			return err
+		}
+		hash := crypto.BytesToHash(bytes)
+		bnh.BlockHash = &hash
+		return nil
+	}
+	if !strings.HasPrefix(str, "0x") && str != "latest" && str != "pending" && str != "earliest" {
+		height, err := strconv.ParseUint(str, 10, 63)
+		if err != nil {
+			return ErrInvalidBlockSelector
+		}
+		number := common.BlockNumber(height)
+		bnh.BlockNumber = &number
+		return nil
+	}
+	number := new(common.BlockNumber)
+	if err := number.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	bnh.BlockNumber = number
+	return nil
+}
+
+func (bnh BlockNumberOrHash) MarshalJSON() ([]byte, error) {
+	if bnh.BlockHash != nil {
+		return json.Marshal(bnh.BlockHash.String())
+	}
+	if bnh.BlockNumber == nil || *bnh.BlockNumber < 0 {
+		return json.Marshal("latest")
+	}
+	return json.Marshal(uint64(*bnh.BlockNumber))
+}
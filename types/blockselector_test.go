@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+)
+
+func TestBlockNumberOrHashUnmarshal(t *testing.T) {
+	heights := map[string]uint64{
+		`100`:        100,
+		`"100"`:      100,
+		`"0x64"`:     100,
+		`"earliest"`: 0,
+	}
+	for input, height := range heights {
+		var bnh BlockNumberOrHash
+		if err := json.Unmarshal([]byte(input), &bnh); err != nil {
+			t.Fatalf("unmarshal %s fail, %v", input, err)
+		}
+		if bnh.Latest() || bnh.BlockNumber == nil || uint64(*bnh.BlockNumber) != height {
+			t.Fatalf("unmarshal %s got %v", input, bnh.BlockNumber)
+		}
+	}
+
+	for _, input := range []string{`"latest"`, `"pending"`} {
+		var bnh BlockNumberOrHash
+		if err := json.Unmarshal([]byte(input), &bnh); err != nil || !bnh.Latest() {
+			t.Fatalf("unmarshal %s should select tip, %v", input, err)
+		}
+	}
+	var nilSelector *BlockNumberOrHash
+	if !nilSelector.Latest() {
+		t.Fatal("nil selector should select tip")
+	}
+
+	hash := crypto.Hash{1, 2, 3}
+	var bnh BlockNumberOrHash
+	if err := json.Unmarshal([]byte(`"`+hash.String()+`"`), &bnh); err != nil {
+		t.Fatal(err)
+	}
+	if bnh.Latest() || bnh.BlockHash == nil || *bnh.BlockHash != hash {
+		t.Fatalf("unmarshal hash got %v", bnh.BlockHash)
+	}
+
+	for _, input := range []string{`-1`, `"abc"`, `1.5`} {
+		if err := json.Unmarshal([]byte(input), &BlockNumberOrHash{}); err == nil {
+			t.Fatalf("unmarshal %s should fail", input)
+		}
+	}
+}
@@ -16,6 +16,19 @@ const (
 	CandidateType         //申请成为候选出块节点
 	CancelCandidateType   //申请成为候选出块节点
 	RegisterProducer
+	BatchType            //多个操作原子执行
+	RegisterMultiSigType //注册M-of-N多签账户
+	TimeLockType         //锁定金额，到期后转给接收方
+	CancelTimeLockType   //到期前撤销可撤销的锁定
+	TransferAliasType    //把别名转给其他地址
+	ReleaseAliasType     //释放别名
+	LeaseAliasType       //租用或续租别名
+	RedelegateType       //把投给一个候选人的票直接转投给另一个，不需要等待撤销周期
+	EvidenceType         //举证出块节点双签，罚没其抵押并暂停其出块资格
+)
+
+const (
+	MaxAliasLeasePeriod = 6220800 * 10 //别名单次最多租用10年
 )
 
 var (
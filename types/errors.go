@@ -3,5 +3,23 @@ package types
 import "errors"
 
 var (
-	ErrOutOfGas = errors.New("out of gas")
+	ErrOutOfGas                = errors.New("out of gas")
+	ErrUnsupportPayloadVersion = errors.New("not support payload version")
+	ErrUnsupportTxVersion      = errors.New("not support transaction version at this height")
+	ErrEmptyAlias              = errors.New("empty alias")
+	ErrEmptyBatch              = errors.New("batch without operation")
+	ErrTooManyBatchOperations  = errors.New("too many operations in batch")
+	ErrUnsupportBatchOperation = errors.New("not support operation type in batch")
+	ErrMultiSigKeys            = errors.New("multisig key set empty, duplicated or too large")
+	ErrMultiSigThreshold       = errors.New("multisig threshold not reached")
+	ErrMultiSigSigner          = errors.New("multisig signer not in key set or signed twice")
+	ErrNotMultiSigTx           = errors.New("transaction not signed by multisig account")
+	ErrTimeLockCondition       = errors.New("time lock without unlock height or time")
+	ErrSponsored               = errors.New("transaction already sponsored")
+	ErrSponsorNotCommitted     = errors.New("sponsor not committed by sender")
+	ErrSponsorMismatch         = errors.New("sponsor mismatch the one committed by sender")
+	ErrAliasLeasePeriod        = errors.New("alias lease period out of range")
+	ErrInvalidBlockSelector    = errors.New("block selector must be a height or a block hash")
+	ErrEmptyRedelegateFrom     = errors.New("redelegate without the candidate to move credit from")
+	ErrEmptyEvidence           = errors.New("evidence without two consensus messages")
 )
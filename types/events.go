@@ -19,9 +19,10 @@ type NewMinedBlockEvent struct{ Block *Block }
 type RemovedLogsEvent struct{ Logs []*Log }
 
 type ChainEvent struct {
-	Block *Block
-	Hash  crypto.Hash
-	Logs  []*Log
+	Block    *Block
+	Hash     crypto.Hash
+	Receipts []*Receipt
+	Logs     []*Log
 }
 
 type ChainSideEvent struct {
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/binary"
+)
+
+const (
+	MaxMultiSigKeys = 16 //多签账户最多包含的公钥数
+
+	//多签交易Sig的首字节，compact签名的首字节固定在27-34之间，不会与之冲突
+	MultiSigFlag byte = 0
+)
+
+//M-of-N多签账户，注册交易的数据部分，同时保存在账户的Storage中
+type MultiSigAccount struct {
+	Threshold uint64
+	PubKeys   []*secp256k1.PublicKey
+}
+
+func (account MultiSigAccount) check() error {
+	if len(account.PubKeys) == 0 || len(account.PubKeys) > MaxMultiSigKeys {
+		return ErrMultiSigKeys
+	}
+	if account.Threshold == 0 || account.Threshold > uint64(len(account.PubKeys)) {
+		return ErrMultiSigThreshold
+	}
+	for i, pk := range account.PubKeys {
+		if pk == nil {
+			return ErrMultiSigKeys
+		}
+		for _, other := range account.PubKeys[:i] {
+			if pk.IsEqual(other) {
+				return ErrMultiSigKeys
+			}
+		}
+	}
+	return nil
+}
+
+func (account *MultiSigAccount) Marshal() ([]byte, error) {
+	err := account.check()
+	if err != nil {
+		return nil, err
+	}
+	return binary.Marshal(account)
+}
+
+func (account *MultiSigAccount) Unmarshal(data []byte) error {
+	err := binary.Unmarshal(data, account)
+	if err != nil {
+		return err
+	}
+	return account.check()
+}
+
+// Address derive the account address from threshold and key set, the same ordered key set with
+// the same threshold always map to the same address, no private key exists for it
+func (account *MultiSigAccount) Address() crypto.CommonAddress {
+	data, _ := binary.Marshal(account)
+	return crypto.BytesToAddress(sha3.Keccak256([]byte("MultiSig"), data)[12:])
+}
+
+// VerifyTransaction check the signatures carried by tx come from distinct keys of the account,
+// and the number of them reach the threshold
+func (account *MultiSigAccount) VerifyTransaction(tx *Transaction) error {
+	proof, err := tx.MultiSigProof()
+	if err != nil {
+		return err
+	}
+	signed := make([]bool, len(account.PubKeys))
+	count := uint64(0)
+	for _, sig := range proof.Sigs {
+		pk, _, err := secp256k1.RecoverCompact(sig, tx.TxHash().Bytes())
+		if err != nil {
+			return err
+		}
+		index := account.indexOf(pk)
+		if index < 0 {
+			return ErrMultiSigSigner
+		}
+		if signed[index] {
+			return ErrMultiSigSigner
+		}
+		signed[index] = true
+		count++
+	}
+	if count < account.Threshold {
+		return ErrMultiSigThreshold
+	}
+	return nil
+}
+
+func (account *MultiSigAccount) indexOf(pk *secp256k1.PublicKey) int {
+	for i, key := range account.PubKeys {
+		if key.IsEqual(pk) {
+			return i
+		}
+	}
+	return -1
+}
+
+//多签交易的签名部分，包含发送方多签账户地址以及各个持有人对交易hash的签名
+type MultiSigProof struct {
+	Account crypto.CommonAddress
+	Sigs    [][]byte
+}
+
+// IsMultiSig report whether the tx is sent from a multisig account
+func (tx *Transaction) IsMultiSig() bool {
+	sig := tx.SenderSig()
+	return len(sig) > 0 && sig[0] == MultiSigFlag
+}
+
+// MultiSigProof decode the signatures of a multisig transaction
+func (tx *Transaction) MultiSigProof() (*MultiSigProof, error) {
+	if !tx.IsMultiSig() {
+		return nil, ErrNotMultiSigTx
+	}
+	proof := &MultiSigProof{}
+	err := binary.Unmarshal(tx.SenderSig()[1:], proof)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// SetMultiSig fill the Sig of tx with the signatures collected from the holders of the account
+func (tx *Transaction) SetMultiSig(account crypto.CommonAddress, sigs [][]byte) error {
+	data, err := binary.Marshal(&MultiSigProof{Account: account, Sigs: sigs})
+	if err != nil {
+		return err
+	}
+	tx.Sig = append([]byte{MultiSigFlag}, data...)
+	tx.from.Store(&account)
+	return nil
+}
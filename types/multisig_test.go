@@ -0,0 +1,94 @@
+package types
+
+import (
+	"crypto/rand"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/binary"
+	"math/big"
+	"testing"
+)
+
+func TestMultiSigTransaction(t *testing.T) {
+	privs := []*secp256k1.PrivateKey{}
+	account := &MultiSigAccount{Threshold: 2}
+	for i := 0; i < 3; i++ {
+		priv, _ := crypto.GenerateKey(rand.Reader)
+		privs = append(privs, priv)
+		account.PubKeys = append(account.PubKeys, priv.PubKey())
+	}
+	outsider, _ := crypto.GenerateKey(rand.Reader)
+	addr := account.Address()
+
+	tx := NewTransaction(crypto.CommonAddress{}, big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0)
+	sign := func(signers ...*secp256k1.PrivateKey) *Transaction {
+		sigs := [][]byte{}
+		for _, priv := range signers {
+			sig, err := secp256k1.SignCompact(priv, tx.TxHash().Bytes(), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sigs = append(sigs, sig)
+		}
+		multiSigTx := &Transaction{Data: tx.Data}
+		if err := multiSigTx.SetMultiSig(addr, sigs); err != nil {
+			t.Fatal(err)
+		}
+		// decode from wire so that From is recovered from Sig instead of cache
+		decoded := &Transaction{}
+		if err := binary.Unmarshal(multiSigTx.AsPersistentMessage(), decoded); err != nil {
+			t.Fatal(err)
+		}
+		return decoded
+	}
+
+	signed := sign(privs[0], privs[2])
+	from, err := signed.From()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *from != addr {
+		t.Fatalf("sender mismatch, got %s, want %s", from.String(), addr.String())
+	}
+	if err := account.VerifyTransaction(signed); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := account.VerifyTransaction(sign(privs[1])); err != ErrMultiSigThreshold {
+		t.Fatalf("expect %v, got %v", ErrMultiSigThreshold, err)
+	}
+	if err := account.VerifyTransaction(sign(privs[1], privs[1])); err != ErrMultiSigSigner {
+		t.Fatalf("expect %v, got %v", ErrMultiSigSigner, err)
+	}
+	if err := account.VerifyTransaction(sign(privs[1], outsider)); err != ErrMultiSigSigner {
+		t.Fatalf("expect %v, got %v", ErrMultiSigSigner, err)
+	}
+}
+
+func TestMultiSigPayload(t *testing.T) {
+	priv, _ := crypto.GenerateKey(rand.Reader)
+	account := &MultiSigAccount{Threshold: 1, PubKeys: []*secp256k1.PublicKey{priv.PubKey()}}
+	tx, err := NewRegisterMultiSigTransaction(account, big.NewInt(1), big.NewInt(30000), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.(*MultiSigAccount).Address() != account.Address() {
+		t.Fatal("decoded multisig account address mismatch")
+	}
+
+	invalid := []*MultiSigAccount{
+		{Threshold: 1},
+		{Threshold: 0, PubKeys: []*secp256k1.PublicKey{priv.PubKey()}},
+		{Threshold: 2, PubKeys: []*secp256k1.PublicKey{priv.PubKey()}},
+		{Threshold: 1, PubKeys: []*secp256k1.PublicKey{priv.PubKey(), priv.PubKey()}},
+	}
+	for i, account := range invalid {
+		if _, err := account.Marshal(); err == nil {
+			t.Fatalf("invalid multisig account %d accepted", i)
+		}
+	}
+}
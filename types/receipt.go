@@ -21,6 +21,7 @@ import (
 	"github.com/drep-project/DREP-Chain/common"
 	"github.com/drep-project/DREP-Chain/crypto"
 	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/params"
 )
 
 const (
@@ -29,6 +30,18 @@ const (
 
 	// ReceiptStatusSuccessful is the status code of a transaction if execution succeeded.
 	ReceiptStatusSuccessful = uint64(1)
+
+	// ReceiptStatusReverted is the status code of a transaction if the contract reverted,
+	// the data passed to REVERT is kept as RevertReason.
+	ReceiptStatusReverted = uint64(2)
+
+	// ReceiptStatusOutOfGas is the status code of a transaction if execution ran out of gas.
+	ReceiptStatusOutOfGas = uint64(3)
+
+	// ReceiptStatusInvalid is the status code of a transaction if execution aborted by any
+	// other vm error, such as invalid opcode or stack underflow. It shares the value of
+	// ReceiptStatusFailed so that receipts written before the distinction stay failed.
+	ReceiptStatusInvalid = ReceiptStatusFailed
 )
 
 // Receipt represents the results of a transaction.
@@ -49,6 +62,42 @@ type Receipt struct {
 	// transaction corresponding to this receipt.
 	BlockHash   crypto.Hash
 	BlockNumber uint64
+
+	// Failure information: empty when the transaction succeeded. FailReason is the text of
+	// the vm error for human, it is not hashed into ReceiptRoot. They are stored apart from
+	// the receipt as ReceiptFailure, so that the receipts stored before still decode.
+	RevertReason []byte `binary:"ignore"`
+	FailReason   string `binary:"ignore"`
+}
+
+// ReceiptFailure is the failure information of a receipt, stored under the hash of the
+// transaction. BlockHash tells the receipts of the same transaction in different branches apart.
+type ReceiptFailure struct {
+	BlockHash    crypto.Hash
+	RevertReason []byte
+	FailReason   string
+}
+
+// hashedReceipt is the part of Receipt hashed into ReceiptRoot, in the layout of the receipts
+// before the failure information was added so that the hash of those receipts stay the same
+type hashedReceipt struct {
+	PostState         []byte
+	Status            uint64
+	CumulativeGasUsed uint64
+	Logs              []*Log
+	Bloom             Bloom
+	TxHash            crypto.Hash
+	ContractAddress   crypto.CommonAddress
+	GasUsed           uint64
+	BlockHash         crypto.Hash
+	BlockNumber       uint64
+}
+
+// hashedRevertedReceipt is hashed for the reverted receipts, the data passed to REVERT is
+// part of the consensus while the text of the error is not
+type hashedRevertedReceipt struct {
+	hashedReceipt
+	RevertReason []byte
 }
 
 // NewReceipt creates a barebone transaction receipt, copying the init fields.
@@ -62,6 +111,45 @@ func NewReceipt(root []byte, failed bool, cumulativeGasUsed uint64) *Receipt {
 	return r
 }
 
+// NewExecuteReceipt creates a receipt carrying the execution status of a transaction,
+// a failed contract execution is reported as reverted, out of gas or invalid.
+func NewExecuteReceipt(root []byte, etr *ExecuteTransactionResult, cumulativeGasUsed uint64) *Receipt {
+	r := NewReceipt(root, etr.ContractTxExecuteFail, cumulativeGasUsed)
+	if !etr.ContractTxExecuteFail {
+		return r
+	}
+	r.Status = etr.ContractTxStatus
+	if etr.ContractTxErr != nil {
+		r.FailReason = etr.ContractTxErr.Error()
+	}
+	if r.Status == ReceiptStatusReverted {
+		r.RevertReason = common.CopyBytes(etr.TxResult)
+	}
+	return r
+}
+
+// Failure return the failure information of the receipt, nil if the transaction succeeded
+func (r *Receipt) Failure() *ReceiptFailure {
+	if r.RevertReason == nil && r.FailReason == "" {
+		return nil
+	}
+	return &ReceiptFailure{BlockHash: r.BlockHash, RevertReason: r.RevertReason, FailReason: r.FailReason}
+}
+
+// SetFailure fill the failure information of the receipt if it belongs to the same block
+func (r *Receipt) SetFailure(failure *ReceiptFailure) {
+	if failure == nil || failure.BlockHash != r.BlockHash {
+		return
+	}
+	r.RevertReason = failure.RevertReason
+	r.FailReason = failure.FailReason
+}
+
+// Succeeded report whether the transaction executed without error
+func (r *Receipt) Succeeded() bool {
+	return r.Status == ReceiptStatusSuccessful
+}
+
 //func (r *Receipt) MarshalBinary() ([]byte, error) {
 //	return binary.Marshal(r)
 //}
@@ -70,8 +158,32 @@ func NewReceipt(root []byte, failed bool, cumulativeGasUsed uint64) *Receipt {
 //	return binary.Unmarshal(data, r)
 //}
 
+// ReceiptHash return the hash of the receipt in ReceiptRoot. Before ReceiptStatusForkHeight
+// all failures are hashed as ReceiptStatusFailed, as the receipts were at that time.
 func (r *Receipt) ReceiptHash() *crypto.Hash {
-	b, _ := binary.Marshal(r)
+	hashed := hashedReceipt{
+		PostState:         r.PostState,
+		Status:            r.Status,
+		CumulativeGasUsed: r.CumulativeGasUsed,
+		Logs:              r.Logs,
+		Bloom:             r.Bloom,
+		TxHash:            r.TxHash,
+		ContractAddress:   r.ContractAddress,
+		GasUsed:           r.GasUsed,
+		BlockHash:         r.BlockHash,
+		BlockNumber:       r.BlockNumber,
+	}
+	var b []byte
+	if !params.IsReceiptStatusFork(r.BlockNumber) {
+		if hashed.Status != ReceiptStatusSuccessful {
+			hashed.Status = ReceiptStatusFailed
+		}
+		b, _ = binary.Marshal(&hashed)
+	} else if r.Status == ReceiptStatusReverted {
+		b, _ = binary.Marshal(&hashedRevertedReceipt{hashed, r.RevertReason})
+	} else {
+		b, _ = binary.Marshal(&hashed)
+	}
 	h := sha3.Keccak256(b)
 	hash := &crypto.Hash{}
 	hash.SetBytes(h)
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/params"
+	"github.com/drep-project/binary"
+)
+
+func TestNewExecuteReceipt(t *testing.T) {
+	receipt := NewExecuteReceipt(nil, &ExecuteTransactionResult{TxResult: []byte{1}}, 100)
+	if !receipt.Succeeded() || receipt.RevertReason != nil || receipt.FailReason != "" {
+		t.Fatalf("unexpected receipt of succeeded tx %v", receipt)
+	}
+
+	revertErr := errors.New("evm: execution reverted")
+	receipt = NewExecuteReceipt(nil, &ExecuteTransactionResult{
+		TxResult:              []byte{8, 195, 121, 160},
+		ContractTxExecuteFail: true,
+		ContractTxStatus:      ReceiptStatusReverted,
+		ContractTxErr:         revertErr,
+	}, 100)
+	if receipt.Succeeded() || receipt.Status != ReceiptStatusReverted {
+		t.Fatalf("expect reverted status, got %d", receipt.Status)
+	}
+	if string(receipt.RevertReason) != string([]byte{8, 195, 121, 160}) || receipt.FailReason != revertErr.Error() {
+		t.Fatalf("revert reason not kept %v", receipt)
+	}
+
+	receipt = NewExecuteReceipt(nil, &ExecuteTransactionResult{
+		TxResult:              []byte{1},
+		ContractTxExecuteFail: true,
+		ContractTxStatus:      ReceiptStatusOutOfGas,
+		ContractTxErr:         errors.New("out of gas"),
+	}, 100)
+	if receipt.Status != ReceiptStatusOutOfGas || receipt.RevertReason != nil {
+		t.Fatalf("unexpected receipt of out of gas tx %v", receipt)
+	}
+
+	receipt = NewExecuteReceipt(nil, &ExecuteTransactionResult{ContractTxExecuteFail: true}, 100)
+	if receipt.Status != ReceiptStatusInvalid {
+		t.Fatalf("unclassified failure should be invalid, got %d", receipt.Status)
+	}
+}
+
+func TestReceiptHash(t *testing.T) {
+	defer func(height uint64) { params.ReceiptStatusForkHeight = height }(params.ReceiptStatusForkHeight)
+	params.ReceiptStatusForkHeight = 10
+
+	newReceipt := func(height uint64, status uint64, reason string) *Receipt {
+		receipt := NewExecuteReceipt(nil, &ExecuteTransactionResult{
+			TxResult:              []byte{8, 195, 121, 160},
+			ContractTxExecuteFail: true,
+			ContractTxStatus:      status,
+			ContractTxErr:         errors.New(reason),
+		}, 100)
+		receipt.BlockNumber = height
+		return receipt
+	}
+
+	//错误描述不影响hash
+	if *newReceipt(10, ReceiptStatusReverted, "a").ReceiptHash() != *newReceipt(10, ReceiptStatusReverted, "b").ReceiptHash() {
+		t.Fatal("fail reason should not be hashed")
+	}
+	if *newReceipt(10, ReceiptStatusReverted, "a").ReceiptHash() == *newReceipt(10, ReceiptStatusOutOfGas, "a").ReceiptHash() {
+		t.Fatal("status should be hashed after fork")
+	}
+	reverted := newReceipt(10, ReceiptStatusReverted, "a")
+	reverted.RevertReason = []byte{1}
+	if *reverted.ReceiptHash() == *newReceipt(10, ReceiptStatusReverted, "a").ReceiptHash() {
+		t.Fatal("revert data should be hashed after fork")
+	}
+
+	//分叉前与原来的receipt编码一致，所有失败状态都记为Failed
+	legacy := &Receipt{Status: ReceiptStatusFailed, CumulativeGasUsed: 100, Logs: []*Log{}, BlockNumber: 9}
+	for _, status := range []uint64{ReceiptStatusFailed, ReceiptStatusReverted, ReceiptStatusOutOfGas} {
+		if *newReceipt(9, status, "a").ReceiptHash() != *legacy.ReceiptHash() {
+			t.Fatalf("status %d before fork should hash as failed", status)
+		}
+	}
+}
+
+//失败信息不写入receipt的编码，升级前存储的receipt仍可解码
+func TestReceiptEncodingCompatible(t *testing.T) {
+	receipt := NewExecuteReceipt(nil, &ExecuteTransactionResult{
+		ContractTxExecuteFail: true,
+		ContractTxStatus:      ReceiptStatusReverted,
+		ContractTxErr:         errors.New("evm: execution reverted"),
+		TxResult:              []byte{1},
+	}, 100)
+	receipt.Logs = []*Log{}
+	legacy := hashedReceipt{Status: receipt.Status, CumulativeGasUsed: 100, Logs: []*Log{}}
+	encoded, err := binary.Marshal(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyEncoded, err := binary.Marshal(&legacy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encoded, legacyEncoded) {
+		t.Fatal("failure information changed the encoding of receipt")
+	}
+	decoded := &Receipt{}
+	if err := binary.Unmarshal(legacyEncoded, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Status != ReceiptStatusReverted || decoded.CumulativeGasUsed != 100 {
+		t.Fatalf("legacy receipt decoded wrong, %v", decoded)
+	}
+
+	decoded.SetFailure(receipt.Failure())
+	if !bytes.Equal(decoded.RevertReason, []byte{1}) || decoded.FailReason != "evm: execution reverted" {
+		t.Fatal("failure information not restored")
+	}
+	other := &Receipt{BlockHash: crypto.Hash{1}}
+	other.SetFailure(receipt.Failure())
+	if other.RevertReason != nil || other.FailReason != "" {
+		t.Fatal("failure information of another block restored")
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+)
+
+//一个区块的奖励分配记录，和收据一起按块hash保存
+//leader得到 LeaderReward + GasFee + Remainder，Remainder是支持者按比例分配后除不尽的部分，没有支持者时为全部支持者奖励
+//RewardRemainderForkHeight之前这部分不发放，记为Burned，Remainder为0
+type BlockReward struct {
+	Height       uint64
+	Leader       crypto.CommonAddress
+	LeaderReward common.Big
+	GasFee       common.Big
+	Remainder    common.Big
+	Burned       common.Big
+	Supporters   []SupporterReward //按地址排序
+}
+
+//支持者按投给leader的credit分得的奖励
+type SupporterReward struct {
+	Addr   crypto.CommonAddress
+	Credit common.Big
+	Bonus  common.Big
+}
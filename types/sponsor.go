@@ -0,0 +1,132 @@
+package types
+
+import (
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/binary"
+	"math/big"
+)
+
+const (
+	//代付交易Sig的首字节，gas由sponsor支付，发送方只支付金额
+	SponsoredFlag byte = 1
+)
+
+//代付交易的签名部分，SenderSig为发送方原本的签名(单签或多签)，SponsorSig为代付方对SponsorHash的签名
+type SponsorProof struct {
+	SenderSig  []byte
+	SponsorSig []byte
+}
+
+// IsSponsored report whether the gas of tx is paid by a sponsor
+func (tx *Transaction) IsSponsored() bool {
+	return len(tx.Sig) > 0 && tx.Sig[0] == SponsoredFlag
+}
+
+func (tx *Transaction) sponsorProof() (*SponsorProof, error) {
+	proof := &SponsorProof{}
+	err := binary.Unmarshal(tx.Sig[1:], proof)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// SenderSig return the signature of sender, which is the Sig itself unless the tx is sponsored
+func (tx *Transaction) SenderSig() []byte {
+	if !tx.IsSponsored() {
+		return tx.Sig
+	}
+	proof, err := tx.sponsorProof()
+	if err != nil {
+		return nil
+	}
+	return proof.SenderSig
+}
+
+// SponsorHash is the message signed by sponsor, it differs from TxHash so that
+// the signature of sponsor can not be replayed as a transaction sent by sponsor
+func (tx *Transaction) SponsorHash() []byte {
+	return sha3.Keccak256([]byte("Sponsor"), tx.TxHash().Bytes())
+}
+
+// CommittedSponsor return the sponsor address signed by sender in the extension of tx, nil if none
+func (tx *Transaction) CommittedSponsor() *crypto.CommonAddress {
+	extension, _ := tx.Extension()
+	if extension == nil || extension.Sponsor.IsEmpty() {
+		return nil
+	}
+	return &extension.Sponsor
+}
+
+// Sponsor recover the address paying gas for tx, return nil without error if tx is not sponsored.
+// The sponsor must be the one committed by sender, so that a relayer can neither strip nor swap it
+func (tx *Transaction) Sponsor() (*crypto.CommonAddress, error) {
+	committed := tx.CommittedSponsor()
+	if !tx.IsSponsored() {
+		if committed != nil {
+			return nil, ErrSponsorMismatch
+		}
+		return nil, nil
+	}
+	if sc := tx.sponsor.Load(); sc != nil {
+		return sc.(*crypto.CommonAddress), nil
+	}
+	if committed == nil {
+		return nil, ErrSponsorNotCommitted
+	}
+	proof, err := tx.sponsorProof()
+	if err != nil {
+		return nil, err
+	}
+	pk, _, err := secp256k1.RecoverCompact(proof.SponsorSig, tx.SponsorHash())
+	if err != nil {
+		return nil, err
+	}
+	addr := crypto.PubkeyToAddress(pk)
+	if addr != *committed {
+		return nil, ErrSponsorMismatch
+	}
+	tx.sponsor.Store(&addr)
+	return &addr, nil
+}
+
+// CommitSponsor record the sponsor in the data signed by sender, tx must be signed afterwards
+func (tx *Transaction) CommitSponsor(sponsor crypto.CommonAddress) error {
+	extension, err := tx.Extension()
+	if err != nil {
+		return err
+	}
+	if extension == nil {
+		extension = &TxExtension{}
+	}
+	extension.Sponsor = sponsor
+	return tx.SetExtension(extension)
+}
+
+// SetSponsor wrap the signature of sender together with the signature of sponsor
+func (tx *Transaction) SetSponsor(sponsorSig []byte) error {
+	if tx.IsSponsored() {
+		return ErrSponsored
+	}
+	data, err := binary.Marshal(&SponsorProof{SenderSig: tx.Sig, SponsorSig: sponsorSig})
+	if err != nil {
+		return err
+	}
+	tx.Sig = append([]byte{SponsoredFlag}, data...)
+	return nil
+}
+
+// GasCost is the max fee paid by gas payer
+func (tx *Transaction) GasCost() *big.Int {
+	return new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))
+}
+
+// SenderCost is the max cost paid by sender, gas excluded if tx is sponsored
+func (tx *Transaction) SenderCost() *big.Int {
+	if tx.IsSponsored() {
+		return tx.Amount()
+	}
+	return tx.Cost()
+}
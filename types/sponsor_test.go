@@ -0,0 +1,104 @@
+package types
+
+import (
+	"crypto/rand"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/binary"
+	"math/big"
+	"testing"
+)
+
+func TestSponsoredTransaction(t *testing.T) {
+	senderPriv, _ := crypto.GenerateKey(rand.Reader)
+	sponsorPriv, _ := crypto.GenerateKey(rand.Reader)
+	sender := crypto.PubkeyToAddress(senderPriv.PubKey())
+	sponsor := crypto.PubkeyToAddress(sponsorPriv.PubKey())
+
+	tx := NewTransaction(crypto.CommonAddress{1}, big.NewInt(10), big.NewInt(2), big.NewInt(30000), 0)
+	if err := tx.CommitSponsor(sponsor); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := secp256k1.SignCompact(senderPriv, tx.TxHash().Bytes(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Sig = sig
+	sponsorSig, err := secp256k1.SignCompact(sponsorPriv, tx.SponsorHash(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.SetSponsor(sponsorSig); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.SetSponsor(sponsorSig); err != ErrSponsored {
+		t.Fatalf("expect %v, got %v", ErrSponsored, err)
+	}
+
+	decoded := &Transaction{}
+	if err := binary.Unmarshal(tx.AsPersistentMessage(), decoded); err != nil {
+		t.Fatal(err)
+	}
+	from, err := decoded.From()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *from != sender {
+		t.Fatalf("sender mismatch, got %s, want %s", from.String(), sender.String())
+	}
+	payer, err := decoded.Sponsor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payer == nil || *payer != sponsor {
+		t.Fatal("sponsor mismatch")
+	}
+	if decoded.SenderCost().Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("sender of sponsored tx pay for amount only, got %v", decoded.SenderCost())
+	}
+	if decoded.GasCost().Cmp(big.NewInt(60000)) != 0 {
+		t.Fatalf("gas cost mismatch, got %v", decoded.GasCost())
+	}
+
+	// a relayer can neither strip nor swap the sponsor committed by sender
+	stripped := &Transaction{Data: tx.Data, Sig: sig}
+	if _, err := stripped.Sponsor(); err != ErrSponsorMismatch {
+		t.Fatalf("expect %v, got %v", ErrSponsorMismatch, err)
+	}
+	otherPriv, _ := crypto.GenerateKey(rand.Reader)
+	otherSig, err := secp256k1.SignCompact(otherPriv, tx.SponsorHash(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	swapped := &Transaction{Data: tx.Data, Sig: sig}
+	if err := swapped.SetSponsor(otherSig); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := swapped.Sponsor(); err != ErrSponsorMismatch {
+		t.Fatalf("expect %v, got %v", ErrSponsorMismatch, err)
+	}
+
+	// sponsor must be committed by sender
+	uncommitted := NewTransaction(crypto.CommonAddress{1}, big.NewInt(10), big.NewInt(2), big.NewInt(30000), 0)
+	uncommitted.Sig, err = secp256k1.SignCompact(senderPriv, uncommitted.TxHash().Bytes(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncommittedSponsorSig, err := secp256k1.SignCompact(sponsorPriv, uncommitted.SponsorHash(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uncommitted.SetSponsor(uncommittedSponsorSig); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uncommitted.Sponsor(); err != ErrSponsorNotCommitted {
+		t.Fatalf("expect %v, got %v", ErrSponsorNotCommitted, err)
+	}
+
+	// signature of sponsor must not be usable as a transaction sent by sponsor
+	replay := &Transaction{Data: tx.Data, Sig: sponsorSig}
+	replayFrom, err := replay.From()
+	if err == nil && *replayFrom == sponsor {
+		t.Fatal("sponsor signature replayed as sponsor transaction")
+	}
+}
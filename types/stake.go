@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/drep-project/DREP-Chain/common"
 	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/binary"
 	"math/big"
 )
 
@@ -53,3 +54,94 @@ func (CancelCreditDetail) Error() string {
 //func (i *IntersetData)Push(key, value HeightValue)  {
 //	i[key] = value
 //}
+
+//某地址投出的一笔票，现在撤销时本金在MaturityHeight可以取回，ProjectedPayout是届时取回的金额
+//链上不发放利息，Interest为0，ProjectedPayout等于本金
+type VoteDetail struct {
+	Candidate       crypto.CommonAddress
+	Amount          common.Big
+	Height          uint64
+	Interest        common.Big
+	MaturityHeight  uint64
+	ProjectedPayout common.Big
+}
+
+//已撤销、等待到期退回余额的抵押，到达MaturityHeight后计入余额
+type UnbondingDetail struct {
+	Height         uint64
+	Amount         common.Big
+	MaturityHeight uint64
+	Matured        bool
+}
+
+//转投交易的数据部分，把投给From的票转投给交易的To，金额为交易的Amount
+type RedelegateData struct {
+	From crypto.CommonAddress
+}
+
+func (rd *RedelegateData) Marshal() ([]byte, error) {
+	if rd.From.IsEmpty() {
+		return nil, ErrEmptyRedelegateFrom
+	}
+	return binary.Marshal(rd)
+}
+
+func (rd *RedelegateData) Unmarshal(data []byte) error {
+	err := binary.Unmarshal(data, rd)
+	if err != nil {
+		return err
+	}
+	if rd.From.IsEmpty() {
+		return ErrEmptyRedelegateFrom
+	}
+	return nil
+}
+
+//候选人的惩罚记录，JailedUntil之前不会被选为出块节点
+//Slashed不能放在最后，为0的大数在末尾时无法解码
+type SlashInfo struct {
+	Slashed        common.Big //累计被罚没的抵押
+	MissedBlocks   uint64     //连续没有参与签名的块数
+	EvidenceHeight uint64     //最近一次被举证双签的共识高度，不早于此高度的证据不再受理
+	JailedUntil    uint64     //解禁高度
+}
+
+//从候选人撤销、尚未到期的一笔抵押，记在候选人名下，到期前候选人被惩罚时一起按比例罚没
+type UnbondingCredit struct {
+	Addr   crypto.CommonAddress //撤销抵押的地址，币存放在该地址stakeStorage的CC中
+	Height uint64               //撤销的高度
+	Value  common.Big
+}
+
+//从候选人转投出去的一笔票，记在原候选人名下，转投后changeInterval内原候选人被惩罚时，在新候选人处按比例罚没
+type RedelegatedCredit struct {
+	Addr   crypto.CommonAddress //转投的投票地址
+	To     crypto.CommonAddress //转投到的候选人，票存放在该候选人stakeStorage的RC中
+	Height uint64               //转投的高度
+	Value  common.Big
+}
+
+//双签举证交易的数据部分，First和Second是同一出块节点签名的两条冲突的共识消息，由共识模块解码验证
+type EvidenceData struct {
+	MsgType uint64 //共识消息的类型
+	First   []byte
+	Second  []byte
+}
+
+func (ed *EvidenceData) Marshal() ([]byte, error) {
+	if len(ed.First) == 0 || len(ed.Second) == 0 {
+		return nil, ErrEmptyEvidence
+	}
+	return binary.Marshal(ed)
+}
+
+func (ed *EvidenceData) Unmarshal(data []byte) error {
+	err := binary.Unmarshal(data, ed)
+	if err != nil {
+		return err
+	}
+	if len(ed.First) == 0 || len(ed.Second) == 0 {
+		return ErrEmptyEvidence
+	}
+	return nil
+}
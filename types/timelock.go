@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	drepbinary "github.com/drep-project/binary"
+)
+
+//定时转账交易的数据部分，金额和接收方使用交易本身的Amount和To
+//UnlockHeight和UnlockTime为0表示不限制，两者都满足时到期
+type TimeLockData struct {
+	UnlockHeight uint64
+	UnlockTime   uint64
+	Revocable    bool //到期前发送方可以撤销
+}
+
+func (td TimeLockData) check() error {
+	if td.UnlockHeight == 0 && td.UnlockTime == 0 {
+		return ErrTimeLockCondition
+	}
+	return nil
+}
+
+func (td *TimeLockData) Marshal() ([]byte, error) {
+	err := td.check()
+	if err != nil {
+		return nil, err
+	}
+	return drepbinary.Marshal(td)
+}
+
+func (td *TimeLockData) Unmarshal(data []byte) error {
+	err := drepbinary.Unmarshal(data, td)
+	if err != nil {
+		return err
+	}
+	return td.check()
+}
+
+//链上锁定中的金额，Id由创建它的交易hash和操作序号计算
+type TimeLock struct {
+	Id   crypto.Hash
+	From crypto.CommonAddress
+	To   crypto.CommonAddress
+
+	Lock       HeightValue //到期高度以及锁定的金额
+	UnlockTime uint64
+	Revocable  bool
+}
+
+// TimeLockId return the id of the lock created by the operation at index of tx,
+// the index is 0 if the tx is not a batch
+func TimeLockId(txHash *crypto.Hash, index int) crypto.Hash {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, uint64(index))
+	return crypto.Bytes2Hash(sha3.Keccak256(txHash.Bytes(), indexBytes))
+}
+
+func NewTimeLock(tx *Transaction, index int, from *crypto.CommonAddress, data *TimeLockData) *TimeLock {
+	return &TimeLock{
+		Id:   TimeLockId(tx.TxHash(), index),
+		From: *from,
+		To:   *tx.To(),
+		Lock: HeightValue{
+			CreditHeight: data.UnlockHeight,
+			CreditValue:  *(*common.Big)(tx.Amount()),
+		},
+		UnlockTime: data.UnlockTime,
+		Revocable:  data.Revocable,
+	}
+}
+
+// Matured report whether the lock can be released in the block of given height and timestamp
+func (lock *TimeLock) Matured(height uint64, timestamp uint64) bool {
+	return height >= lock.Lock.CreditHeight && timestamp >= lock.UnlockTime
+}
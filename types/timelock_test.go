@@ -0,0 +1,51 @@
+package types
+
+import (
+	"crypto/rand"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"math/big"
+	"testing"
+)
+
+func TestTimeLockMatured(t *testing.T) {
+	priv, _ := crypto.GenerateKey(rand.Reader)
+	from := crypto.PubkeyToAddress(priv.PubKey())
+	to := crypto.CommonAddress{1}
+
+	data := &TimeLockData{UnlockHeight: 100, UnlockTime: 5000, Revocable: true}
+	tx, err := NewTimeLockTransaction(to, big.NewInt(10), data, big.NewInt(1), big.NewInt(30000), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lock := NewTimeLock(tx, 0, &from, payload.(*TimeLockData))
+	if lock.Id == *tx.TxHash() || lock.Id == NewTimeLock(tx, 1, &from, payload.(*TimeLockData)).Id {
+		t.Fatal("time lock id not derived from tx hash and operation index")
+	}
+	if lock.To != to || lock.Lock.CreditValue.ToInt().Int64() != 10 || !lock.Revocable {
+		t.Fatal("time lock not built from tx")
+	}
+
+	cases := []struct {
+		height    uint64
+		timestamp uint64
+		matured   bool
+	}{
+		{99, 5000, false},
+		{100, 4999, false},
+		{100, 5000, true},
+		{200, 6000, true},
+	}
+	for _, c := range cases {
+		if lock.Matured(c.height, c.timestamp) != c.matured {
+			t.Fatalf("height %d time %d expect matured %v", c.height, c.timestamp, c.matured)
+		}
+	}
+
+	if _, err := NewTimeLockTransaction(to, big.NewInt(10), &TimeLockData{}, big.NewInt(1), big.NewInt(30000), 0); err != ErrTimeLockCondition {
+		t.Fatalf("expect %v, got %v", ErrTimeLockCondition, err)
+	}
+}
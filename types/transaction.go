@@ -21,6 +21,7 @@ type Transaction struct {
 	signMessage atomic.Value `json:"-" binary:"ignore" bson:"-"`
 	message     atomic.Value `json:"-" binary:"ignore" bson:"-"`
 	from        atomic.Value `json:"-" binary:"ignore"`
+	sponsor     atomic.Value `json:"-" binary:"ignore"`
 }
 
 type TransactionData struct {
@@ -36,6 +37,13 @@ type TransactionData struct {
 	Data      []byte
 }
 
+//TxVersionExtension版本交易Data的外层，扩展字段和原来的Data一起由发送方签名
+type TxExtension struct {
+	ValidUntilHeight uint64               //超过此高度交易不能再被打包，0表示永不过期
+	Sponsor          crypto.CommonAddress //发送方指定的gas代付地址，为空表示由发送方支付
+	Data             []byte
+}
+
 func (tx *Transaction) Time() int64 {
 	return tx.Data.Timestamp
 }
@@ -43,6 +51,62 @@ func (tx *Transaction) Time() int64 {
 func (tx *Transaction) Nonce() uint64 {
 	return tx.Data.Nonce
 }
+
+// Extension decode the extension wrapping the data of tx, return nil without error if
+// the version of tx has no extension
+func (tx *Transaction) Extension() (*TxExtension, error) {
+	if tx.Data.Version != TxVersionExtension {
+		return nil, nil
+	}
+	extension := &TxExtension{}
+	err := binary.Unmarshal(tx.Data.Data, extension)
+	if err != nil {
+		return nil, err
+	}
+	return extension, nil
+}
+
+// SetExtension wrap the data of tx with the extension and upgrade tx to TxVersionExtension,
+// the Data of extension is replaced by the data of tx, tx must be signed afterwards
+func (tx *Transaction) SetExtension(extension *TxExtension) error {
+	ext := *extension
+	ext.Data = tx.GetData()
+	data, err := binary.Marshal(&ext)
+	if err != nil {
+		return err
+	}
+	tx.Data.Version = TxVersionExtension
+	tx.Data.Data = data
+	return nil
+}
+
+// CheckVersion return an error if the version of tx is not active at height or its
+// extension is malformed
+func (tx *Transaction) CheckVersion(height uint64) error {
+	if tx.Data.Version != TxVersionExtension {
+		return nil
+	}
+	if !params.IsTxExtensionFork(height) {
+		return ErrUnsupportTxVersion
+	}
+	_, err := tx.Extension()
+	return err
+}
+
+func (tx *Transaction) ValidUntilHeight() uint64 {
+	extension, _ := tx.Extension()
+	if extension == nil {
+		return 0
+	}
+	return extension.ValidUntilHeight
+}
+
+// Expired report whether the tx can not be included in the block of given height any more
+func (tx *Transaction) Expired(height uint64) bool {
+	validUntil := tx.ValidUntilHeight()
+	return validUntil != 0 && height > validUntil
+}
+
 func (tx *Transaction) Type() TxType {
 	return tx.Data.Type
 }
@@ -55,8 +119,17 @@ func (tx *Transaction) From() (*crypto.CommonAddress, error) {
 	if sc := tx.from.Load(); sc != nil {
 		return sc.(*crypto.CommonAddress), nil
 	}
+	// the signatures of multisig tx are checked against the key set stored in account while executing
+	if tx.IsMultiSig() {
+		proof, err := tx.MultiSigProof()
+		if err != nil {
+			return nil, err
+		}
+		tx.from.Store(&proof.Account)
+		return &proof.Account, nil
+	}
 
-	pk, _, err := secp256k1.RecoverCompact(tx.Sig, tx.TxHash().Bytes())
+	pk, _, err := secp256k1.RecoverCompact(tx.SenderSig(), tx.TxHash().Bytes())
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +144,16 @@ type CrossChainTransaction struct {
 	Trans     []*Transaction
 }
 
+// GetData return the data of tx, unwrapped from the extension if tx has one
 func (tx *Transaction) GetData() []byte {
-	return tx.Data.Data
+	if tx.Data.Version != TxVersionExtension {
+		return tx.Data.Data
+	}
+	extension, err := tx.Extension()
+	if err != nil {
+		return nil
+	}
+	return extension.Data
 }
 
 func (tx *Transaction) Cost() *big.Int {
@@ -294,10 +375,170 @@ func NewCancleCandidateTransaction(amount, gasPrice, gasLimit *big.Int, nonce ui
 	return &Transaction{Data: txData}
 }
 
+func NewBatchTransaction(operations []BatchOperation, gasPrice, gasLimit *big.Int, nonce uint64) (*Transaction, error) {
+	data, err := (&BatchData{Operations: operations}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      BatchType,
+		Amount:    *(*common.Big)(new(big.Int)),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      data,
+	}
+	return &Transaction{Data: txData}, nil
+}
+
+//注册M-of-N多签账户，账户地址由公钥集合和阈值决定
+func NewRegisterMultiSigTransaction(account *MultiSigAccount, gasPrice, gasLimit *big.Int, nonce uint64) (*Transaction, error) {
+	data, err := account.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      RegisterMultiSigType,
+		Amount:    *(*common.Big)(new(big.Int)),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      data,
+	}
+	return &Transaction{Data: txData}, nil
+}
+
+//锁定金额，满足到期条件后由链自动转给to
+func NewTimeLockTransaction(to crypto.CommonAddress, amount *big.Int, lock *TimeLockData, gasPrice, gasLimit *big.Int, nonce uint64) (*Transaction, error) {
+	data, err := lock.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      TimeLockType,
+		To:        to,
+		Amount:    *(*common.Big)(amount),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      data,
+	}
+	return &Transaction{Data: txData}, nil
+}
+
+//撤销lockId对应的锁定，金额退回发送方
+func NewCancelTimeLockTransaction(lockId crypto.Hash, gasPrice, gasLimit *big.Int, nonce uint64) *Transaction {
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      CancelTimeLockType,
+		Amount:    *(*common.Big)(new(big.Int)),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      lockId.Bytes(),
+	}
+	return &Transaction{Data: txData}
+}
+
+//把发送方的别名转给to
+func NewTransferAliasTransaction(to crypto.CommonAddress, gasPrice, gasLimit *big.Int, nonce uint64) *Transaction {
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      TransferAliasType,
+		To:        to,
+		Amount:    *(*common.Big)(new(big.Int)),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+	}
+	return &Transaction{Data: txData}
+}
+
+//释放发送方的别名，其他地址可以重新设置
+func NewReleaseAliasTransaction(gasPrice, gasLimit *big.Int, nonce uint64) *Transaction {
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      ReleaseAliasType,
+		Amount:    *(*common.Big)(new(big.Int)),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+	}
+	return &Transaction{Data: txData}
+}
+
+//租用别名period个高度，发送方已经租用该别名时续租
+func NewLeaseAliasTransaction(alias string, period uint64, gasPrice, gasLimit *big.Int, nonce uint64) (*Transaction, error) {
+	data, err := (&AliasLeaseData{Alias: alias, Period: period}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      LeaseAliasType,
+		Amount:    *(*common.Big)(new(big.Int)),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      data,
+	}
+	return &Transaction{Data: txData}, nil
+}
+
+//把投给from的amount票转投给to，票保留原来的投票高度
+func NewRedelegateTransaction(from, to crypto.CommonAddress, amount, gasPrice, gasLimit *big.Int, nonce uint64) (*Transaction, error) {
+	data, err := (&RedelegateData{From: from}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      RedelegateType,
+		To:        to,
+		Amount:    *(*common.Big)(amount),
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      data,
+	}
+	return &Transaction{Data: txData}, nil
+}
+
+func NewEvidenceTransaction(accused crypto.CommonAddress, evidence *EvidenceData, gasPrice, gasLimit *big.Int, nonce uint64) (*Transaction, error) {
+	data, err := evidence.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	txData := TransactionData{
+		Version:   common.Version,
+		Nonce:     nonce,
+		Type:      EvidenceType,
+		To:        accused,
+		GasPrice:  *(*common.Big)(gasPrice),
+		GasLimit:  *(*common.Big)(gasLimit),
+		Timestamp: int64(time.Now().Unix()),
+		Data:      data,
+	}
+	return &Transaction{Data: txData}, nil
+}
+
 type ExecuteTransactionResult struct {
 	TxResult              []byte               //Transaction execution results
 	ContractTxExecuteFail bool                 //contract transaction execution results
 	ContractTxLog         []*Log               //contract transaction execution logs
 	Txerror               error                //transaction execution fail info
 	ContractAddr          crypto.CommonAddress //create new contract address
+	ContractTxStatus      uint64               //receipt status of a failed contract execution, see ReceiptStatusReverted etc.
+	ContractTxErr         error                //vm error of a failed contract execution
 }
@@ -1,7 +1,15 @@
 package types
 
 import (
+	"crypto/rand"
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"github.com/drep-project/DREP-Chain/crypto/secp256k1"
+	"github.com/drep-project/DREP-Chain/crypto/sha3"
+	"github.com/drep-project/DREP-Chain/params"
 	"github.com/drep-project/binary"
+	"math"
+	"math/big"
 	"testing"
 )
 
@@ -13,3 +21,130 @@ func TestMarshal(t *testing.T) {
 	block2 := &TransactionData{}
 	binary.Unmarshal(bytes, block2)
 }
+
+func TestChainIdBoundToSignature(t *testing.T) {
+	priv, _ := crypto.GenerateKey(rand.Reader)
+	addr := crypto.PubkeyToAddress(priv.PubKey())
+
+	tx := NewTransaction(addr, big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0)
+	tx.Data.ChainId = 1
+	sig, err := secp256k1.SignCompact(priv, tx.TxHash().Bytes(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Sig = sig
+
+	from, err := tx.From()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *from != addr {
+		t.Fatalf("sender mismatch, got %s, want %s", from.String(), addr.String())
+	}
+
+	// replay the same signature with another chainId
+	replay := &Transaction{Data: tx.Data, Sig: tx.Sig}
+	replay.Data.ChainId = 2
+	replayFrom, err := replay.From()
+	if err == nil && *replayFrom == addr {
+		t.Fatal("signature of chain 1 recovered the same sender on chain 2")
+	}
+}
+
+func TestTxExpired(t *testing.T) {
+	tx := NewTransaction(crypto.CommonAddress{}, big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0)
+	tx.Data.Data = []byte("memo")
+	if tx.Expired(math.MaxUint64) {
+		t.Fatal("tx without valid until height never expire")
+	}
+
+	if err := tx.SetExtension(&TxExtension{ValidUntilHeight: 100}); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Expired(100) {
+		t.Fatal("tx is valid at valid until height")
+	}
+	if !tx.Expired(101) {
+		t.Fatal("tx is invalid after valid until height")
+	}
+	if string(tx.GetData()) != "memo" {
+		t.Fatalf("data wrapped by extension, got %x", tx.GetData())
+	}
+
+	bytes, err := binary.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &Transaction{}
+	if err := binary.Unmarshal(bytes, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ValidUntilHeight() != 100 {
+		t.Fatalf("valid until height lost, got %d", decoded.ValidUntilHeight())
+	}
+}
+
+func TestTxExtensionFork(t *testing.T) {
+	defer func(height uint64) { params.TxExtensionForkHeight = height }(params.TxExtensionForkHeight)
+	params.TxExtensionForkHeight = 10
+
+	tx := NewTransaction(crypto.CommonAddress{}, big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0)
+	if err := tx.CheckVersion(0); err != nil {
+		t.Fatalf("tx without extension rejected, %v", err)
+	}
+	tx.SetExtension(&TxExtension{ValidUntilHeight: 100})
+	if err := tx.CheckVersion(9); err != ErrUnsupportTxVersion {
+		t.Fatalf("tx with extension before fork, have error %v, want %v", err, ErrUnsupportTxVersion)
+	}
+	if err := tx.CheckVersion(10); err != nil {
+		t.Fatal(err)
+	}
+	tx.Data.Data = []byte{0xff}
+	if err := tx.CheckVersion(10); err == nil {
+		t.Fatal("malformed extension accepted")
+	}
+}
+
+// 交易的签名内容与加入扩展之前的编码相同，历史交易的hash和签名保持有效
+func TestTxSignMessageCompatible(t *testing.T) {
+	type legacyTransactionData struct {
+		Version   int32
+		Nonce     uint64
+		Type      TxType
+		To        crypto.CommonAddress
+		ChainId   ChainIdType
+		Amount    common.Big
+		GasPrice  common.Big
+		GasLimit  common.Big
+		Timestamp int64
+		Data      []byte
+	}
+	tx := NewTransaction(crypto.CommonAddress{1}, big.NewInt(1), big.NewInt(2), big.NewInt(30000), 3)
+	tx.Data.Data = []byte("memo")
+	d := tx.Data
+	legacy, _ := binary.Marshal(legacyTransactionData{d.Version, d.Nonce, d.Type, d.To, d.ChainId, d.Amount, d.GasPrice, d.GasLimit, d.Timestamp, d.Data})
+	if string(legacy) != string(tx.AsSignMessage()) {
+		t.Fatalf("sign message changed, have %x, want %x", tx.AsSignMessage(), legacy)
+	}
+}
+
+// tx inclusion proofs use the tx hash as the leaf of TxRoot, which hashes the marshaled tx data
+func TestTxHashIsTxRootLeaf(t *testing.T) {
+	txs := make([]*Transaction, 3)
+	leaves := make([][]byte, len(txs))
+	for i := range txs {
+		txs[i] = NewTransaction(crypto.CommonAddress{byte(i)}, big.NewInt(1), big.NewInt(1), big.NewInt(30000), uint64(i))
+		b, _ := binary.Marshal(txs[i].Data)
+		leaves[i] = sha3.Keccak256(b)
+	}
+	merkle := common.NewMerkle(leaves)
+	for i, tx := range txs {
+		proof, err := merkle.Proof(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !common.VerifyMerkleProof(merkle.Root.Hash, tx.TxHash().Bytes(), proof) {
+			t.Fatalf("tx %d hash not proved against tx root", i)
+		}
+	}
+}
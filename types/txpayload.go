@@ -0,0 +1,98 @@
+package types
+
+import (
+	"sync"
+)
+
+//交易Data部分的结构化内容，每种交易类型可以注册自己的payload
+type TxPayload interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+type payloadKey struct {
+	txType  TxType
+	version int32
+}
+
+//payload编码的版本，按交易自身的Version选择解码器。common.Version升级后新的编码注册到新版本，
+//旧版本的注册必须保留，否则历史交易无法解码
+const (
+	TxPayloadVersion1 int32 = 1
+	//Data外层包裹TxExtension，payload编码与版本1相同，TxExtensionForkHeight之前无效
+	TxVersionExtension int32 = 2
+)
+
+var (
+	payloadLock     sync.RWMutex
+	payloadTypes    = map[TxType]struct{}{}
+	payloadRegistry = map[payloadKey]func() TxPayload{}
+)
+
+func init() {
+	for _, version := range []int32{TxPayloadVersion1, TxVersionExtension} {
+		RegisterTxPayload(SetAliasType, version, func() TxPayload { return new(AliasData) })
+		RegisterTxPayload(CandidateType, version, func() TxPayload { return &CandidateData{} })
+		RegisterTxPayload(BatchType, version, func() TxPayload { return &BatchData{} })
+		RegisterTxPayload(RegisterMultiSigType, version, func() TxPayload { return &MultiSigAccount{} })
+		RegisterTxPayload(TimeLockType, version, func() TxPayload { return &TimeLockData{} })
+		RegisterTxPayload(LeaseAliasType, version, func() TxPayload { return &AliasLeaseData{} })
+		RegisterTxPayload(RedelegateType, version, func() TxPayload { return &RedelegateData{} })
+		RegisterTxPayload(EvidenceType, version, func() TxPayload { return &EvidenceData{} })
+	}
+}
+
+// RegisterTxPayload bind a payload constructor to the given tx type and tx version,
+// registering the same type and version again replaces the previous one
+func RegisterTxPayload(txType TxType, version int32, newPayload func() TxPayload) {
+	payloadLock.Lock()
+	defer payloadLock.Unlock()
+	payloadTypes[txType] = struct{}{}
+	payloadRegistry[payloadKey{txType, version}] = newPayload
+}
+
+// NewTxPayload create an empty payload for the given tx type and tx version,
+// the bool result is false if the tx type carry raw data without payload
+func NewTxPayload(txType TxType, version int32) (TxPayload, bool, error) {
+	payloadLock.RLock()
+	defer payloadLock.RUnlock()
+	if _, ok := payloadTypes[txType]; !ok {
+		return nil, false, nil
+	}
+	newPayload, ok := payloadRegistry[payloadKey{txType, version}]
+	if !ok {
+		return nil, true, ErrUnsupportPayloadVersion
+	}
+	return newPayload(), true, nil
+}
+
+// DecodePayload decode the data of transaction to the payload registered for its type,
+// return nil without error if the type of transaction has no payload
+func (tx *Transaction) DecodePayload() (TxPayload, error) {
+	payload, ok, err := NewTxPayload(tx.Type(), tx.Data.Version)
+	if !ok || err != nil {
+		return nil, err
+	}
+	if err := payload.Unmarshal(tx.GetData()); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+//别名交易的数据部分
+type AliasData string
+
+func (alias AliasData) Marshal() ([]byte, error) {
+	if alias == "" {
+		return nil, ErrEmptyAlias
+	}
+	return []byte(alias), nil
+}
+
+func (alias *AliasData) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		return ErrEmptyAlias
+	}
+	*alias = AliasData(data)
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"crypto/rand"
+	"github.com/drep-project/DREP-Chain/common"
+	"github.com/drep-project/DREP-Chain/crypto"
+	"math/big"
+	"testing"
+)
+
+func TestDecodeCandidatePayload(t *testing.T) {
+	p, _ := crypto.GenerateKey(rand.Reader)
+	cd := &CandidateData{
+		Pubkey: p.PubKey(),
+		Node:   "enode://e77d64fecbb1c7e78231507fdd58c963cdc1e0ed0bec29b5a65de32b992d596f@149.129.172.91:44444",
+	}
+	data, err := cd.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := NewCandidateTransaction(big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0, data)
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, ok := payload.(*CandidateData)
+	if !ok {
+		t.Fatalf("unexpected payload type %T", payload)
+	}
+	if decoded.Node != cd.Node {
+		t.Fatalf("node mismatch, got %s, want %s", decoded.Node, cd.Node)
+	}
+
+	tx = NewCandidateTransaction(big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0, []byte("not a candidate"))
+	if _, err := tx.DecodePayload(); err == nil {
+		t.Fatal("invalid candidate payload decoded")
+	}
+
+	tx = NewCandidateTransaction(big.NewInt(1), big.NewInt(1), big.NewInt(30000), 0, data)
+	tx.Data.Version = 100
+	if _, err := tx.DecodePayload(); err != ErrUnsupportPayloadVersion {
+		t.Fatalf("expect %v, got %v", ErrUnsupportPayloadVersion, err)
+	}
+}
+
+func TestDecodeRawPayload(t *testing.T) {
+	tx := NewCallContractTransaction(crypto.CommonAddress{}, []byte{1, 2, 3}, big.NewInt(0), big.NewInt(1), big.NewInt(30000), 0)
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload != nil {
+		t.Fatalf("contract call should carry raw data, got %T", payload)
+	}
+}
+
+func TestDecodePayloadAfterVersionBump(t *testing.T) {
+	data := []byte("drepalias")
+	tx := NewAliasTransaction(string(data), big.NewInt(1), big.NewInt(30000), 0)
+
+	//the txs signed before the bump keep their version and must still be decoded
+	defer func(version int32) { common.Version = version }(common.Version)
+	common.Version = TxPayloadVersion1 + 1
+	payload, err := tx.DecodePayload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alias := string(*payload.(*AliasData)); alias != string(data) {
+		t.Fatalf("alias mismatch, got %s, want %s", alias, data)
+	}
+}
@@ -4,19 +4,21 @@ import "github.com/drep-project/DREP-Chain/crypto"
 
 //本模块的消息只能在调用本模块（chain及对应的子模块）的函数中使用
 const (
-	MsgTypeBlockReq     = 1 //同步块请求
-	MsgTypeBlockResp    = 2 //同步块回复
-	MsgTypeBlock        = 3 //新块通知
-	MsgTypeTransaction  = 4 //广播交易
-	MsgTypePeerState    = 5 //Peer状态回复/或者状态通知
-	MsgTypePeerStateReq = 6 //peer状态请求
-	MsgTypeHeaderReq    = 7 //请求区块头
-	MsgTypeHeaderRsp    = 8 //请求区块头回复
+	MsgTypeBlockReq     = 1  //同步块请求
+	MsgTypeBlockResp    = 2  //同步块回复
+	MsgTypeBlock        = 3  //新块通知
+	MsgTypeTransaction  = 4  //广播交易
+	MsgTypePeerState    = 5  //Peer状态回复/或者状态通知
+	MsgTypePeerStateReq = 6  //peer状态请求
+	MsgTypeHeaderReq    = 7  //请求区块头
+	MsgTypeHeaderRsp    = 8  //请求区块头回复
+	MsgTypeStateReq     = 9  //请求状态树节点
+	MsgTypeStateRsp     = 10 //状态树节点回复
 
 	MaxMsgSize = 20 << 20 //每个消息最大大小20MB
 )
 
-var NumberOfMsg = 9 //本模块定义的消息个数
+var NumberOfMsg = 11 //本模块定义的消息个数
 
 type Transactions []Transaction
 
@@ -39,6 +41,18 @@ type BlockResp struct {
 	Blocks []*Block
 }
 
+// 按hash请求状态树Root下的节点
+type StateReq struct {
+	Root   crypto.Hash
+	Hashes []crypto.Hash
+}
+
+// 回复本地找到的节点数据，节点的hash由接收方计算
+type StateRsp struct {
+	Root  crypto.Hash
+	Nodes [][]byte
+}
+
 type PeerState struct {
 	Height uint64
 }